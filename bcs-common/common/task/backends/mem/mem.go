@@ -0,0 +1,287 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mem implement machinery v2 backend iface in memory
+package mem
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/backends/iface"
+	"github.com/RichardKnop/machinery/v2/common"
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/log"
+	"github.com/RichardKnop/machinery/v2/tasks"
+)
+
+const (
+	// cleanupInterval 过期结果清理间隔
+	cleanupInterval = time.Minute
+)
+
+type groupItem struct {
+	meta     *tasks.GroupMeta
+	expireAt time.Time
+}
+
+type stateItem struct {
+	state    *tasks.TaskState
+	expireAt time.Time
+}
+
+type memBackend struct {
+	common.Backend
+	mtx         sync.RWMutex
+	groups      map[string]*groupItem
+	states      map[string]*stateItem
+	lastCleanup time.Time
+}
+
+// New ..
+func New(conf *config.Config) iface.Backend {
+	backend := memBackend{
+		Backend:     common.NewBackend(conf),
+		groups:      make(map[string]*groupItem),
+		states:      make(map[string]*stateItem),
+		lastCleanup: time.Now(),
+	}
+
+	return &backend
+}
+
+// InitGroup Group related functions
+func (b *memBackend) InitGroup(groupUUID string, taskUUIDs []string) error {
+	expiresIn := b.getExpiresIn()
+	groupMeta := &tasks.GroupMeta{
+		GroupUUID: groupUUID,
+		TaskUUIDs: taskUUIDs,
+		CreatedAt: time.Now().UTC(),
+		TTL:       int64(expiresIn.Seconds()),
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.groups[groupUUID] = &groupItem{meta: groupMeta, expireAt: time.Now().Add(expiresIn)}
+	return nil
+}
+
+// GroupCompleted ..
+func (b *memBackend) GroupCompleted(groupUUID string, groupTaskCount int) (bool, error) {
+	groupMeta, err := b.getGroupMeta(groupUUID)
+	if err != nil {
+		return false, err
+	}
+
+	taskStates, err := b.getStates(groupMeta.TaskUUIDs...)
+	if err != nil {
+		return false, err
+	}
+
+	var countSuccessTasks = 0
+	for _, taskState := range taskStates {
+		if taskState.IsCompleted() {
+			countSuccessTasks++
+		}
+	}
+
+	return countSuccessTasks == groupTaskCount, nil
+}
+
+// GroupTaskStates ..
+func (b *memBackend) GroupTaskStates(groupUUID string, groupTaskCount int) ([]*tasks.TaskState, error) {
+	groupMeta, err := b.getGroupMeta(groupUUID)
+	if err != nil {
+		return nil, err
+	}
+	if len(groupMeta.TaskUUIDs) != groupTaskCount {
+		return nil, fmt.Errorf("group task count not equal, %d != %d", len(groupMeta.TaskUUIDs), groupTaskCount)
+	}
+
+	return b.getStates(groupMeta.TaskUUIDs...)
+}
+
+// TriggerChord ..
+func (b *memBackend) TriggerChord(groupUUID string) (bool, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	item, ok := b.groups[groupUUID]
+	if !ok || item.expireAt.Before(time.Now()) {
+		return false, fmt.Errorf("task %s not exist", groupUUID)
+	}
+
+	if item.meta.ChordTriggered {
+		return false, nil
+	}
+
+	// Set flag to true
+	item.meta.ChordTriggered = true
+	return true, nil
+}
+
+func (b *memBackend) getGroupMeta(groupUUID string) (*tasks.GroupMeta, error) {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+
+	item, ok := b.groups[groupUUID]
+	if !ok || item.expireAt.Before(time.Now()) {
+		return nil, fmt.Errorf("task %s not exist", groupUUID)
+	}
+
+	meta := *item.meta
+	return &meta, nil
+}
+
+// SetStatePending updates task state to PENDING
+func (b *memBackend) SetStatePending(signature *tasks.Signature) error {
+	taskState := tasks.NewPendingTaskState(signature)
+	return b.updateState(taskState)
+}
+
+// SetStateReceived updates task state to RECEIVED
+func (b *memBackend) SetStateReceived(signature *tasks.Signature) error {
+	taskState := tasks.NewReceivedTaskState(signature)
+	return b.updateState(taskState)
+}
+
+// SetStateStarted updates task state to STARTED
+func (b *memBackend) SetStateStarted(signature *tasks.Signature) error {
+	taskState := tasks.NewStartedTaskState(signature)
+	return b.updateState(taskState)
+}
+
+// SetStateRetry updates task state to RETRY
+func (b *memBackend) SetStateRetry(signature *tasks.Signature) error {
+	taskState := tasks.NewRetryTaskState(signature)
+	return b.updateState(taskState)
+}
+
+// SetStateSuccess updates task state to SUCCESS
+func (b *memBackend) SetStateSuccess(signature *tasks.Signature, results []*tasks.TaskResult) error {
+	taskState := tasks.NewSuccessTaskState(signature, results)
+	return b.updateState(taskState)
+}
+
+// SetStateFailure updates task state to FAILURE
+func (b *memBackend) SetStateFailure(signature *tasks.Signature, err string) error {
+	taskState := tasks.NewFailureTaskState(signature, err)
+	return b.updateState(taskState)
+}
+
+// GetState ..
+func (b *memBackend) GetState(taskUUID string) (*tasks.TaskState, error) {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+
+	return b.getState(taskUUID)
+}
+
+// getState 调用方需持有锁
+func (b *memBackend) getState(taskUUID string) (*tasks.TaskState, error) {
+	item, ok := b.states[taskUUID]
+	if !ok || item.expireAt.Before(time.Now()) {
+		return nil, fmt.Errorf("task %s not exist", taskUUID)
+	}
+
+	state := *item.state
+	return &state, nil
+}
+
+// PurgeState ..
+func (b *memBackend) PurgeState(taskUUID string) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	delete(b.states, taskUUID)
+	return nil
+}
+
+// PurgeGroupMeta ..
+func (b *memBackend) PurgeGroupMeta(groupUUID string) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	delete(b.groups, groupUUID)
+	return nil
+}
+
+// getStates returns multiple task states
+func (b *memBackend) getStates(taskUUIDs ...string) ([]*tasks.TaskState, error) {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+
+	taskStates := make([]*tasks.TaskState, 0, len(taskUUIDs))
+	for _, taskUUID := range taskUUIDs {
+		state, err := b.getState(taskUUID)
+		if err != nil {
+			return nil, err
+		}
+		taskStates = append(taskStates, state)
+	}
+
+	return taskStates, nil
+}
+
+// updateState saves current task state
+func (b *memBackend) updateState(taskState *tasks.TaskState) error {
+	expiresIn := b.getExpiresIn()
+	taskState.TTL = int64(expiresIn.Seconds())
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	// merge new task state
+	if state, err := b.getState(taskState.TaskUUID); err == nil {
+		taskState.CreatedAt = state.CreatedAt
+		taskState.TaskName = state.TaskName
+	}
+
+	b.states[taskState.TaskUUID] = &stateItem{state: taskState, expireAt: time.Now().Add(expiresIn)}
+	b.cleanupExpired()
+
+	log.DEBUG.Printf("update taskstate %s %s, %s", taskState.TaskName, taskState.TaskUUID, taskState.State)
+	return nil
+}
+
+// cleanupExpired 清理过期的结果, 调用方需持有锁
+func (b *memBackend) cleanupExpired() {
+	now := time.Now()
+	if now.Sub(b.lastCleanup) < cleanupInterval {
+		return
+	}
+	b.lastCleanup = now
+
+	for k, item := range b.states {
+		if item.expireAt.Before(now) {
+			delete(b.states, k)
+		}
+	}
+	for k, item := range b.groups {
+		if item.expireAt.Before(now) {
+			delete(b.groups, k)
+		}
+	}
+}
+
+// getExpiresIn returns expiration for a stored task state
+func (b *memBackend) getExpiresIn() time.Duration {
+	expiresIn := b.GetConfig().ResultsExpireIn
+	if expiresIn <= 0 {
+		// expire results after 1 hour by default
+		expiresIn = config.DefaultResultsExpireIn
+	}
+
+	return time.Duration(expiresIn) * time.Second
+}
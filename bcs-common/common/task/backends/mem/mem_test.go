@@ -0,0 +1,65 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mem
+
+import (
+	"testing"
+
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroup(t *testing.T) {
+	backend := New(&config.Config{})
+
+	task1 := &tasks.Signature{UUID: "task-1", Name: "test"}
+	task2 := &tasks.Signature{UUID: "task-2", Name: "test"}
+	require.NoError(t, backend.InitGroup("group", []string{task1.UUID, task2.UUID}))
+	require.NoError(t, backend.SetStatePending(task1))
+	require.NoError(t, backend.SetStatePending(task2))
+
+	require.NoError(t, backend.SetStateSuccess(task1, nil))
+	completed, err := backend.GroupCompleted("group", 2)
+	require.NoError(t, err)
+	assert.False(t, completed)
+
+	require.NoError(t, backend.SetStateFailure(task2, "failed"))
+	completed, err = backend.GroupCompleted("group", 2)
+	require.NoError(t, err)
+	assert.True(t, completed)
+
+	states, err := backend.GroupTaskStates("group", 2)
+	require.NoError(t, err)
+	assert.Len(t, states, 2)
+
+	state, err := backend.GetState(task2.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, tasks.StateFailure, state.State)
+	assert.Equal(t, "test", state.TaskName)
+
+	triggered, err := backend.TriggerChord("group")
+	require.NoError(t, err)
+	assert.True(t, triggered)
+	triggered, err = backend.TriggerChord("group")
+	require.NoError(t, err)
+	assert.False(t, triggered)
+
+	require.NoError(t, backend.PurgeState(task1.UUID))
+	_, err = backend.GetState(task1.UUID)
+	assert.Error(t, err)
+	require.NoError(t, backend.PurgeGroupMeta("group"))
+	_, err = backend.GroupCompleted("group", 2)
+	assert.Error(t, err)
+}
@@ -0,0 +1,276 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mem is broker use memory, tasks are lost after the process exits
+package mem
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/brokers/errs"
+	"github.com/RichardKnop/machinery/v2/brokers/iface"
+	"github.com/RichardKnop/machinery/v2/common"
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/log"
+	"github.com/RichardKnop/machinery/v2/tasks"
+)
+
+type delayTask struct {
+	msg   []byte
+	timer *time.Timer
+}
+
+type memBroker struct {
+	common.Broker
+	ctx         context.Context
+	wg          sync.WaitGroup
+	mtx         sync.Mutex
+	pendingTask map[string][][]byte
+	delayedTask map[string]*delayTask
+	notify      chan struct{}
+}
+
+// New ..
+func New(ctx context.Context, conf *config.Config) iface.Broker {
+	broker := memBroker{
+		Broker:      common.NewBroker(conf),
+		ctx:         ctx,
+		pendingTask: make(map[string][][]byte),
+		delayedTask: make(map[string]*delayTask),
+		notify:      make(chan struct{}, 1),
+	}
+
+	return &broker
+}
+
+// StartConsuming ...
+func (b *memBroker) StartConsuming(consumerTag string, concurrency int, taskProcessor iface.TaskProcessor) (bool, error) {
+	if concurrency < 1 {
+		concurrency = runtime.NumCPU()
+	}
+	b.Broker.StartConsuming(consumerTag, concurrency, taskProcessor)
+
+	log.INFO.Printf("[*] Waiting for messages, concurrency=%d. To exit press CTRL+C", concurrency)
+
+	b.wg.Add(1)
+	defer b.wg.Done()
+
+	// Channel to which we will push tasks ready for processing by worker
+	deliveries := make(chan *tasks.Signature)
+	var consumeWg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		consumeWg.Add(1)
+		go func() {
+			defer consumeWg.Done()
+			for signature := range deliveries {
+				b.consumeOne(signature, taskProcessor)
+			}
+		}()
+	}
+	defer func() {
+		close(deliveries)
+		consumeWg.Wait()
+		log.INFO.Printf("stop all consuming and handle done")
+	}()
+
+	queue := getQueue(b.GetConfig(), taskProcessor)
+	for {
+		var signature *tasks.Signature
+		if taskProcessor.PreConsumeHandler() {
+			signature = b.nextTask(queue)
+		}
+
+		if signature == nil {
+			select {
+			case <-b.GetStopChan():
+				return b.GetRetry(), nil
+			case <-b.ctx.Done():
+				return b.GetRetry(), nil
+			case <-b.notify:
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+
+		select {
+		case deliveries <- signature:
+		case <-b.GetStopChan():
+			// 未被处理的任务放回队列
+			_ = b.Publish(context.Background(), signature)
+			return b.GetRetry(), nil
+		case <-b.ctx.Done():
+			_ = b.Publish(context.Background(), signature)
+			return b.GetRetry(), nil
+		}
+	}
+}
+
+// consumeOne processes a single message using TaskProcessor
+func (b *memBroker) consumeOne(signature *tasks.Signature, taskProcessor iface.TaskProcessor) {
+	// If the task is not registered, we requeue it,
+	// there might be different workers for processing specific tasks
+	if !b.IsTaskRegistered(signature.Name) {
+		if signature.IgnoreWhenTaskNotRegistered {
+			log.INFO.Printf("Task %s not registered with this worker, just ignore", signature.Name)
+			return
+		}
+		log.INFO.Printf("Task %s not registered with this worker. Requeuing message", signature.Name)
+		_ = b.Publish(context.Background(), signature)
+		return
+	}
+
+	if err := taskProcessor.Process(signature); err != nil {
+		log.ERROR.Printf("process task %s failed, err: %s", signature.UUID, err)
+	}
+}
+
+// StopConsuming 停止
+func (b *memBroker) StopConsuming() {
+	b.Broker.StopConsuming()
+
+	b.wg.Wait()
+}
+
+// Publish put task to memory queue
+func (b *memBroker) Publish(ctx context.Context, signature *tasks.Signature) error {
+	// Adjust routing key (this decides which queue the message will be published to)
+	b.Broker.AdjustRoutingKey(signature)
+
+	msg, err := json.Marshal(signature)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %s", err)
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	// Check the ETA signature field, if it is set and it is in the future,
+	// delay the task
+	if signature.ETA != nil && signature.ETA.After(time.Now()) {
+		key := fmt.Sprintf("%s/%s", signature.RoutingKey, signature.UUID)
+		if old, ok := b.delayedTask[key]; ok {
+			old.timer.Stop()
+		}
+		routingKey := signature.RoutingKey
+		b.delayedTask[key] = &delayTask{
+			msg: msg,
+			timer: time.AfterFunc(time.Until(*signature.ETA), func() {
+				b.mtx.Lock()
+				defer b.mtx.Unlock()
+
+				task, ok := b.delayedTask[key]
+				if !ok {
+					return
+				}
+				delete(b.delayedTask, key)
+				b.pushPendingTask(routingKey, task.msg)
+			}),
+		}
+		log.DEBUG.Printf("Publish delayed queue[%s] new message: %s", key, string(msg))
+		return nil
+	}
+
+	b.pushPendingTask(signature.RoutingKey, msg)
+	log.DEBUG.Printf("Publish queue[%s] new message: %s", signature.RoutingKey, string(msg))
+	return nil
+}
+
+// pushPendingTask 调用方需持有锁
+func (b *memBroker) pushPendingTask(queue string, msg []byte) {
+	b.pendingTask[queue] = append(b.pendingTask[queue], msg)
+
+	select {
+	case b.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (b *memBroker) nextTask(queue string) *tasks.Signature {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for len(b.pendingTask[queue]) > 0 {
+		msg := b.pendingTask[queue][0]
+		b.pendingTask[queue] = b.pendingTask[queue][1:]
+
+		signature, err := decodeTask(msg)
+		if err != nil {
+			log.ERROR.Printf("decode task failed, err: %s", err)
+			continue
+		}
+		return signature
+	}
+
+	return nil
+}
+
+// GetPendingTasks 获取执行队列, 任务统计可使用
+func (b *memBroker) GetPendingTasks(queue string) ([]*tasks.Signature, error) {
+	if queue == "" {
+		queue = b.GetConfig().DefaultQueue
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	result := make([]*tasks.Signature, 0, len(b.pendingTask[queue]))
+	for _, msg := range b.pendingTask[queue] {
+		signature, err := decodeTask(msg)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, signature)
+	}
+
+	return result, nil
+}
+
+// GetDelayedTasks 任务统计可使用
+func (b *memBroker) GetDelayedTasks() ([]*tasks.Signature, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	result := make([]*tasks.Signature, 0, len(b.delayedTask))
+	for _, task := range b.delayedTask {
+		signature, err := decodeTask(task.msg)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, signature)
+	}
+
+	return result, nil
+}
+
+func decodeTask(msg []byte) (*tasks.Signature, error) {
+	signature := new(tasks.Signature)
+	decoder := json.NewDecoder(bytes.NewReader(msg))
+	decoder.UseNumber()
+	if err := decoder.Decode(signature); err != nil {
+		return nil, errs.NewErrCouldNotUnmarshalTaskSignature(msg, err)
+	}
+	return signature, nil
+}
+
+func getQueue(config *config.Config, taskProcessor iface.TaskProcessor) string {
+	customQueue := taskProcessor.CustomQueue()
+	if customQueue == "" {
+		return config.DefaultQueue
+	}
+	return customQueue
+}
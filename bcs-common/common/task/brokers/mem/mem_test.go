@@ -0,0 +1,89 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mem
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type processor struct {
+	mtx       sync.Mutex
+	processed []string
+}
+
+func (p *processor) Process(signature *tasks.Signature) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.processed = append(p.processed, signature.UUID)
+	return nil
+}
+
+func (p *processor) CustomQueue() string {
+	return ""
+}
+
+func (p *processor) PreConsumeHandler() bool {
+	return true
+}
+
+func (p *processor) count() int {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return len(p.processed)
+}
+
+func TestConsume(t *testing.T) {
+	broker := New(context.Background(), &config.Config{DefaultQueue: "machinery_tasks"})
+	broker.SetRegisteredTaskNames([]string{"test"})
+
+	eta := time.Now().Add(time.Second)
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "task-1", Name: "test"}))
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "task-2", Name: "test", ETA: &eta}))
+	require.NoError(t, broker.Publish(context.Background(),
+		&tasks.Signature{UUID: "task-3", Name: "not-registered", IgnoreWhenTaskNotRegistered: true}))
+
+	pending, err := broker.GetPendingTasks("")
+	require.NoError(t, err)
+	assert.Len(t, pending, 2)
+	delayed, err := broker.GetDelayedTasks()
+	require.NoError(t, err)
+	assert.Len(t, delayed, 1)
+
+	p := &processor{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := broker.StartConsuming("test", 2, p)
+		assert.NoError(t, err)
+	}()
+
+	assert.Eventually(t, func() bool { return p.count() == 2 }, time.Second*5, time.Millisecond*100)
+	assert.ElementsMatch(t, []string{"task-1", "task-2"}, p.processed)
+
+	broker.StopConsuming()
+	<-done
+
+	pending, err = broker.GetPendingTasks("")
+	require.NoError(t, err)
+	assert.Len(t, pending, 0)
+}
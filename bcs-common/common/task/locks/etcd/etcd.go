@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/RichardKnop/machinery/v2/config"
@@ -40,6 +41,8 @@ type etcdLock struct {
 	ctx     context.Context
 	client  *clientv3.Client
 	retries int
+	// sessions 已获取锁的 session, 用于主动释放锁
+	sessions sync.Map
 }
 
 // New ..
@@ -120,10 +123,21 @@ func (l *etcdLock) Lock(key string, unixTsToExpireNs int64) error {
 		return err
 	}
 
+	l.sessions.Store(k, s)
 	log.INFO.Printf("acquired lock=%s, duration=%s", key, ttl)
 	return nil
 }
 
+// Unlock 主动释放锁, 撤销 session 的租约
+func (l *etcdLock) Unlock(key string) error {
+	k := fmt.Sprintf(lockKey, strings.TrimRight(key, "/"))
+	s, ok := l.sessions.LoadAndDelete(k)
+	if !ok {
+		return nil
+	}
+	return s.(*concurrency.Session).Close()
+}
+
 // GetLockExpireNs 获取锁的过期时间
 func GetLockExpireNs(duration time.Duration) int64 {
 	return time.Now().Add(duration).UnixNano()
@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mem implement the lock interface in memory, only works in single process.
+package mem
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/locks/iface"
+	"github.com/RichardKnop/machinery/v2/log"
+)
+
+var (
+	// ErrLockFailed ..
+	ErrLockFailed = errors.New("mem lock: failed to acquire lock")
+)
+
+type memLock struct {
+	mtx     sync.Mutex
+	locks   map[string]int64
+	retries int
+}
+
+// New ..
+func New(retries int) iface.Lock {
+	lock := memLock{
+		locks:   make(map[string]int64),
+		retries: retries,
+	}
+
+	return &lock
+}
+
+// LockWithRetries lock with retries
+func (l *memLock) LockWithRetries(key string, unixTsToExpireNs int64) error {
+	i := 0
+	for ; i < l.retries; i++ {
+		err := l.Lock(key, unixTsToExpireNs)
+		if err == nil {
+			// 成功拿到锁，返回
+			return nil
+		}
+
+		log.DEBUG.Printf("acquired lock=%s failed, retries=%d, err=%s", key, i, err)
+		time.Sleep(time.Millisecond * 100)
+	}
+
+	log.INFO.Printf("acquired lock=%s failed, retries=%d", key, i)
+	return ErrLockFailed
+}
+
+// Lock 锁在unixTsToExpireNs后自动释放
+func (l *memLock) Lock(key string, unixTsToExpireNs int64) error {
+	k := strings.TrimRight(key, "/")
+	now := time.Now().UnixNano()
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if expireNs, ok := l.locks[k]; ok && expireNs > now {
+		return ErrLockFailed
+	}
+
+	// 顺便清理过期的锁
+	for lk, expireNs := range l.locks {
+		if expireNs <= now {
+			delete(l.locks, lk)
+		}
+	}
+	l.locks[k] = unixTsToExpireNs
+
+	log.INFO.Printf("acquired lock=%s, duration=%s", key, time.Duration(unixTsToExpireNs-now))
+	return nil
+}
+
+// Unlock 主动释放锁
+func (l *memLock) Unlock(key string) error {
+	k := strings.TrimRight(key, "/")
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	delete(l.locks, k)
+	return nil
+}
+
+// GetLockExpireNs 获取锁的过期时间
+func GetLockExpireNs(duration time.Duration) int64 {
+	return time.Now().Add(duration).UnixNano()
+}
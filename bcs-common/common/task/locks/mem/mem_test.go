@@ -0,0 +1,49 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mem
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLock(t *testing.T) {
+	locker := New(3)
+
+	lockDuration := time.Millisecond * 200
+	assert.NoError(t, locker.Lock("test_lock", GetLockExpireNs(lockDuration)))
+	assert.ErrorIs(t, locker.Lock("test_lock/", GetLockExpireNs(lockDuration)), ErrLockFailed)
+	assert.NoError(t, locker.Lock("other_lock", GetLockExpireNs(lockDuration)))
+
+	// 重试期间锁过期
+	st := time.Now()
+	assert.NoError(t, locker.LockWithRetries("test_lock", GetLockExpireNs(lockDuration)))
+	assert.True(t, time.Since(st) >= lockDuration/2, "lock should wait for expiration")
+
+	locker = New(2)
+	assert.NoError(t, locker.Lock("test_lock", GetLockExpireNs(time.Minute)))
+	assert.ErrorIs(t, locker.LockWithRetries("test_lock", GetLockExpireNs(time.Minute)), ErrLockFailed)
+}
+
+func TestUnlock(t *testing.T) {
+	locker := New(1)
+
+	assert.NoError(t, locker.Lock("test_lock", GetLockExpireNs(time.Minute)))
+	assert.ErrorIs(t, locker.Lock("test_lock", GetLockExpireNs(time.Minute)), ErrLockFailed)
+
+	// 主动释放后可以立即获取锁
+	assert.NoError(t, locker.(*memLock).Unlock("test_lock/"))
+	assert.NoError(t, locker.Lock("test_lock", GetLockExpireNs(time.Minute)))
+}
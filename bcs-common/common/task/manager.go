@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"runtime/debug"
 	"sync"
 	"time"
@@ -30,6 +31,7 @@ import (
 	"github.com/RichardKnop/machinery/v2/tasks"
 
 	irevoker "github.com/Tencent/bk-bcs/bcs-common/common/task/revokers/iface"
+	"github.com/Tencent/bk-bcs/bcs-common/common/task/steps/approval"
 	istep "github.com/Tencent/bk-bcs/bcs-common/common/task/steps/iface"
 	istore "github.com/Tencent/bk-bcs/bcs-common/common/task/stores/iface"
 	"github.com/Tencent/bk-bcs/bcs-common/common/task/types"
@@ -40,8 +42,23 @@ const (
 	DefaultWorkerConcurrency = 10
 	// DefaultMaxRetryDuration default max retry second
 	DefaultMaxRetryDuration = 30 * time.Second
+
+	// taskStateLockNum 任务状态分段锁数量, DAG模式下并行step串行更新任务状态
+	taskStateLockNum = 64
+	// taskStateLockKey 任务状态分布式锁
+	taskStateLockKey = "bcs-task-state/%s"
+	// taskStateLockTTL 任务状态分布式锁自动释放时间, 防止进程退出后锁无法释放
+	taskStateLockTTL = 30 * time.Second
+	// taskStateLockTimeout 等待任务状态分布式锁的超时时间
+	taskStateLockTimeout = 30 * time.Second
 )
 
+// Unlocker 支持主动释放的锁, 任务状态使用 ManagerConfig.Lock 加锁时要求实现该接口,
+// 否则只能依赖过期释放, 此时退化为进程内锁. locks/mem, locks/etcd 已实现
+type Unlocker interface {
+	Unlock(key string) error
+}
+
 // BrokerConfig config for go-machinery broker
 
 // TaskManager manager for task server
@@ -57,6 +74,8 @@ type TaskManager struct { // nolint
 	cfg               *ManagerConfig
 	store             istore.Store
 
+	taskStateLocks [taskStateLockNum]sync.Mutex
+
 	ctx    context.Context
 	cancel context.CancelFunc
 }
@@ -104,6 +123,11 @@ func (m *TaskManager) Init(cfg *ManagerConfig) error {
 	}
 	m.cfg = cfg
 	m.store = cfg.Store
+	if cfg.Lock != nil {
+		if _, ok := cfg.Lock.(Unlocker); !ok {
+			log.WARNING.Printf("lock %T does not implement Unlocker, task state is only locked in process", cfg.Lock)
+		}
+	}
 
 	if m.stepExecutors == nil {
 		m.stepExecutors = make(map[istep.StepName]istep.StepExecutor)
@@ -217,8 +241,15 @@ func (m *TaskManager) RetryAll(task *types.Task) error {
 	return m.dispatchAt(task, "")
 }
 
-// RetryAt reset status to running and dispatch tasks which begin with stepName
+// RetryAt reset status to running and dispatch tasks which begin with stepName,
+// DAG task reset stepName, failed steps and their downstream steps and dispatch the ready steps
 func (m *TaskManager) RetryAt(task *types.Task, stepName string) error {
+	if task.IsDAG() {
+		if err := resetDAGSteps(task, stepName); err != nil {
+			return err
+		}
+	}
+
 	task.SetStatus(types.TaskStatusRunning)
 	task.SetMessage("task retrying")
 
@@ -244,6 +275,148 @@ func (m *TaskManager) Revoke(task *types.Task) error {
 	return m.cfg.Revoker.Revoke(context.Background(), task.TaskID)
 }
 
+// Pause pause the running task at step boundary, the running steps continue to finish
+// and the following steps are not dispatched until Resume
+func (m *TaskManager) Pause(task *types.Task) error {
+	unlock, err := m.lockTaskState(task.GetTaskID())
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	latest, err := GetGlobalStorage().GetTask(context.Background(), task.GetTaskID())
+	if err != nil {
+		return err
+	}
+	if latest.GetStatus() != types.TaskStatusInit && latest.GetStatus() != types.TaskStatusRunning {
+		return fmt.Errorf("task %s is %s, can not be paused", task.GetTaskID(), latest.GetStatus())
+	}
+
+	latest.SetStatus(types.TaskStatusPaused).
+		SetMessage("task has been paused").
+		SetLastUpdate(time.Now())
+	if err := GetGlobalStorage().UpdateTask(context.Background(), latest); err != nil {
+		return err
+	}
+
+	task.SetStatus(latest.GetStatus()).SetMessage(latest.GetMessage())
+	return nil
+}
+
+// Resume resume the paused task, dispatch the steps which are not passed
+func (m *TaskManager) Resume(task *types.Task) error {
+	unlock, err := m.lockTaskState(task.GetTaskID())
+	if err != nil {
+		return err
+	}
+	latest, err := m.resumeTask(task.GetTaskID())
+	unlock()
+	if err != nil {
+		return err
+	}
+
+	task.SetStatus(latest.GetStatus()).SetMessage(latest.GetMessage())
+	return m.dispatchResume(latest)
+}
+
+// Approve approve the approval step, resume the task if it is paused at the step
+func (m *TaskManager) Approve(task *types.Task, stepName, approver, comment string) error {
+	return m.approve(task, stepName, approval.ResultApproved, approver, comment)
+}
+
+// Reject reject the approval step, the step failed and the task failed if not skip on failed
+func (m *TaskManager) Reject(task *types.Task, stepName, approver, comment string) error {
+	return m.approve(task, stepName, approval.ResultRejected, approver, comment)
+}
+
+func (m *TaskManager) approve(task *types.Task, stepName, result, approver, comment string) error {
+	paused, err := m.setApprovalResult(task.GetTaskID(), stepName, result, approver, comment)
+	if err != nil {
+		return err
+	}
+
+	// 任务未暂停在审批step, 审批结果在step执行时生效
+	if !paused {
+		return nil
+	}
+	return m.Resume(task)
+}
+
+// setApprovalResult save approval result to step params, return true if task is paused at the step
+func (m *TaskManager) setApprovalResult(taskID, stepName, result, approver, comment string) (bool, error) {
+	unlock, err := m.lockTaskState(taskID)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	latest, err := GetGlobalStorage().GetTask(context.Background(), taskID)
+	if err != nil {
+		return false, err
+	}
+	step, ok := latest.GetStep(stepName)
+	if !ok {
+		return false, fmt.Errorf("step %s is not exist", stepName)
+	}
+	if step.Executor != string(approval.ApprovalStep) {
+		return false, fmt.Errorf("step %s is not an approval step", stepName)
+	}
+	if step.GetStatus() != types.TaskStatusNotStarted && step.GetStatus() != types.TaskStatusPaused {
+		return false, fmt.Errorf("step %s is %s, can not be approved", stepName, step.GetStatus())
+	}
+
+	step.AddParam(approval.ResultKey, result).
+		AddParam(approval.ApproverKey, approver).
+		AddParam(approval.CommentKey, comment)
+	if err := GetGlobalStorage().UpdateTask(context.Background(), latest); err != nil {
+		return false, err
+	}
+
+	return latest.GetStatus() == types.TaskStatusPaused && step.GetStatus() == types.TaskStatusPaused, nil
+}
+
+// resumeTask set paused task to running, 调用方需持有task state锁
+func (m *TaskManager) resumeTask(taskID string) (*types.Task, error) {
+	latest, err := GetGlobalStorage().GetTask(context.Background(), taskID)
+	if err != nil {
+		return nil, err
+	}
+	if latest.GetStatus() != types.TaskStatusPaused {
+		return nil, fmt.Errorf("task %s is %s, can not be resumed", taskID, latest.GetStatus())
+	}
+
+	latest.SetStatus(types.TaskStatusRunning).
+		SetMessage("task resuming").
+		SetLastUpdate(time.Now())
+	if err := GetGlobalStorage().UpdateTask(context.Background(), latest); err != nil {
+		return nil, err
+	}
+	return latest, nil
+}
+
+// dispatchResume dispatch the steps of resumed task
+func (m *TaskManager) dispatchResume(task *types.Task) error {
+	if task.IsDAG() {
+		readySteps := task.GetReadySteps()
+		if len(readySteps) == 0 {
+			// 运行中的step结束后继续下发后续step
+			return nil
+		}
+		return m.dispatchSteps(task, readySteps)
+	}
+
+	for _, step := range task.Steps {
+		// 运行中的step结束后继续执行后续step
+		if step.GetStatus() == types.TaskStatusRunning {
+			return nil
+		}
+		if !step.IsPassed() {
+			return m.dispatchFrom(task, step.GetName())
+		}
+	}
+	return fmt.Errorf("task %s has no step to resume", task.GetTaskID())
+}
+
 // Dispatch dispatch task
 func (m *TaskManager) Dispatch(task *types.Task) error {
 	if err := task.Validate(); err != nil {
@@ -257,47 +430,70 @@ func (m *TaskManager) Dispatch(task *types.Task) error {
 	return m.dispatchAt(task, "")
 }
 
-func (m *TaskManager) transTaskToSignature(task *types.Task, stepNameBegin string) []*tasks.Signature {
+// transTaskToSignature build signatures of steps, empty stepNameBegin means all steps, otherwise only the
+// named step. If toEnd is true, the steps from stepNameBegin to the end are built, used by Resume
+func (m *TaskManager) transTaskToSignature(task *types.Task, stepNameBegin string, toEnd bool) []*tasks.Signature {
 	var signatures []*tasks.Signature
 
+	begin := stepNameBegin == ""
 	for _, step := range task.Steps {
 		// skip steps which before begin step, empty str not skip any steps
-		if step.Name != "" && stepNameBegin != "" && step.Name != stepNameBegin {
+		if !begin && step.Name != stepNameBegin {
 			continue
 		}
+		begin = toEnd || stepNameBegin == ""
 
-		// build signature from step
-		signature := &tasks.Signature{
-			UUID: fmt.Sprintf("%s-%s", task.TaskID, step.Name),
-			Name: step.Executor,
-			ETA:  step.ETA,
-			// two parameters: taskID, stepName
-			Args: []tasks.Arg{
-				{
-					Name:  "task_id",
-					Type:  "string",
-					Value: task.GetTaskID(),
-				},
-				{
-					Name:  "step_name",
-					Type:  "string",
-					Value: step.Name,
-				},
+		signatures = append(signatures, newStepSignature(task, step))
+	}
+
+	return signatures
+}
+
+// newStepSignature build signature from step
+func newStepSignature(task *types.Task, step *types.Step) *tasks.Signature {
+	return &tasks.Signature{
+		UUID: fmt.Sprintf("%s-%s", task.TaskID, step.Name),
+		Name: step.Executor,
+		ETA:  step.ETA,
+		// two parameters: taskID, stepName
+		Args: []tasks.Arg{
+			{
+				Name:  "task_id",
+				Type:  "string",
+				Value: task.GetTaskID(),
 			},
+			{
+				Name:  "step_name",
+				Type:  "string",
+				Value: step.Name,
+			},
+		},
 
-			IgnoreWhenTaskNotRegistered: true,
-		}
+		IgnoreWhenTaskNotRegistered: true,
+	}
+}
 
-		signatures = append(signatures, signature)
+// dispatchAt task to machinery, empty stepNameBegin dispatch all steps, otherwise only the named step
+func (m *TaskManager) dispatchAt(task *types.Task, stepNameBegin string) error {
+	// DAG任务只下发依赖已满足的step, 后续step在依赖完成后下发
+	if task.IsDAG() {
+		readySteps := task.GetReadySteps()
+		if len(readySteps) == 0 {
+			return fmt.Errorf("task %s has no step ready to dispatch", task.GetTaskID())
+		}
+		return m.dispatchSteps(task, readySteps)
 	}
 
-	return signatures
+	return m.dispatchChain(task, m.transTaskToSignature(task, stepNameBegin, false))
 }
 
-// dispatchAt task to machinery
-func (m *TaskManager) dispatchAt(task *types.Task, stepNameBegin string) error {
-	signatures := m.transTaskToSignature(task, stepNameBegin)
+// dispatchFrom dispatch the steps from stepNameBegin to the end, used by Resume
+func (m *TaskManager) dispatchFrom(task *types.Task, stepNameBegin string) error {
+	return m.dispatchChain(task, m.transTaskToSignature(task, stepNameBegin, true))
+}
 
+// dispatchChain send signatures to machinery as chain
+func (m *TaskManager) dispatchChain(task *types.Task, signatures []*tasks.Signature) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -317,6 +513,120 @@ func (m *TaskManager) dispatchAt(task *types.Task, stepNameBegin string) error {
 	return nil
 }
 
+// dispatchSteps send steps to machinery concurrently, used by DAG task
+func (m *TaskManager) dispatchSteps(task *types.Task, steps []*types.Step) error {
+	for _, step := range steps {
+		// send task to machinery & ctx for tracing
+		_, err := m.server.SendTaskWithContext(context.Background(), newStepSignature(task, step))
+		if err != nil {
+			return fmt.Errorf("send step %s to machinery failed: %s", step.GetName(), err.Error())
+		}
+	}
+	return nil
+}
+
+// resetDAGSteps reset stepName, failed steps and their downstream steps to not started
+func resetDAGSteps(task *types.Task, stepName string) error {
+	step, ok := task.GetStep(stepName)
+	if !ok {
+		return fmt.Errorf("step %s is not exist", stepName)
+	}
+
+	resetSteps := []*types.Step{step}
+	resetSteps = append(resetSteps, task.GetDownstreamSteps(stepName)...)
+	for _, s := range task.Steps {
+		if s.GetStatus() == types.TaskStatusFailure && !s.IsPassed() {
+			resetSteps = append(resetSteps, s)
+			resetSteps = append(resetSteps, task.GetDownstreamSteps(s.GetName())...)
+		}
+	}
+	for _, s := range resetSteps {
+		s.RetryCount = 0
+		s.Status = types.TaskStatusNotStarted
+	}
+
+	if !task.IsStepDependenciesPassed(step) {
+		return fmt.Errorf("step %s dependencies %v are not passed", stepName, step.GetDependsOn())
+	}
+
+	task.CurrentStep = ""
+	task.CallbackResult = ""
+	task.CallbackMessage = ""
+	return nil
+}
+
+// lockTaskState lock task state, return unlock func. The steps of DAG task run in different workers,
+// so the state is locked by ManagerConfig.Lock if it implements Unlocker, otherwise only in process
+func (m *TaskManager) lockTaskState(taskID string) (func(), error) {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(taskID))
+	l := &m.taskStateLocks[h.Sum32()%taskStateLockNum]
+	l.Lock()
+
+	if m.cfg == nil || m.cfg.Lock == nil {
+		return l.Unlock, nil
+	}
+	unlocker, ok := m.cfg.Lock.(Unlocker)
+	if !ok {
+		return l.Unlock, nil
+	}
+
+	key := fmt.Sprintf(taskStateLockKey, taskID)
+	deadline := time.Now().Add(taskStateLockTimeout)
+	for {
+		err := m.cfg.Lock.Lock(key, time.Now().Add(taskStateLockTTL).UnixNano())
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			l.Unlock()
+			return nil, fmt.Errorf("lock task %s state failed: %v", taskID, err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	return func() {
+		if err := unlocker.Unlock(key); err != nil {
+			log.ERROR.Printf("unlock task %s state failed: %v", taskID, err)
+		}
+		l.Unlock()
+	}, nil
+}
+
+// dispatchChildSteps dispatch the ready child steps after step passed in DAG task
+func (m *TaskManager) dispatchChildSteps(state *State, readySteps []*types.Step) {
+	if len(readySteps) == 0 {
+		return
+	}
+
+	err := m.dispatchSteps(state.task, readySteps)
+	if err == nil {
+		return
+	}
+
+	log.ERROR.Printf("task %s step %s dispatch child steps failed: %v", state.task.GetTaskID(), state.stepName, err)
+	unlock, lockErr := m.lockTaskState(state.task.GetTaskID())
+	if lockErr != nil {
+		log.ERROR.Printf("task %s step %s save dispatch failure failed: %v", state.task.GetTaskID(), state.stepName,
+			lockErr)
+		return
+	}
+	defer unlock()
+
+	if syncErr := state.syncTaskState(); syncErr != nil {
+		log.ERROR.Printf("task %s sync steps failed: %v", state.task.GetTaskID(), syncErr)
+	}
+	if state.isTaskTerminated() {
+		return
+	}
+	state.task.SetEndTime(time.Now()).
+		SetStatus(types.TaskStatusFailure).
+		SetMessage(fmt.Sprintf("dispatch child steps of step %s failed, err=%s", state.stepName, err))
+	if saveErr := state.saveTaskState(); saveErr != nil {
+		log.ERROR.Printf("task %s save task state failed: %v", state.task.GetTaskID(), saveErr)
+	}
+}
+
 // registerStepWorkers build machinery workers for all step worker
 func (m *TaskManager) registerStepWorkers() error {
 	allTasks := make(map[string]interface{}, 0)
@@ -338,7 +648,13 @@ func (m *TaskManager) doWork(taskID string, stepName string) error { // nolint
 
 	log.INFO.Printf("start to execute task[%s] stepName[%s]", taskID, stepName)
 
+	unlock, err := m.lockTaskState(taskID)
+	if err != nil {
+		log.ERROR.Printf("task[%s] stepName[%s] lock task state failed: %v", taskID, stepName, err)
+		return tasks.NewErrRetryTaskLater(err.Error(), DefaultMaxRetryDuration)
+	}
 	state, err := m.getTaskState(taskID, stepName)
+	unlock()
 	if err != nil {
 		log.ERROR.Printf("task[%s] stepName[%s] getTaskState failed: %v",
 			taskID, stepName, err)
@@ -399,23 +715,10 @@ func (m *TaskManager) doWork(taskID string, stepName string) error { // nolint
 			taskID, stepName, time.Since(start), stepErr)
 
 		if stepErr == nil {
-			// step成功处理流程
-			// 先更新state状态
-			state.updateStepSuccess(start)
-			if state.isLastStep(step) {
-				state.tryCallback(nil)
-				// 在所有步骤都成功时，但是callback失败了，把callback失败信息作为task失败信息
-				if state.task.GetCallbackResult() == types.CallbackResultFailure {
-					state.task.SetStatus(types.TaskStatusFailure).
-						SetMessage(state.task.GetCallbackMessage())
-				}
-			}
-			if saveErr := state.saveTaskState(); saveErr != nil {
-				msg := fmt.Sprintf("save task %s step %s update step success failed, err=%v", taskID, stepName, err)
-				log.INFO.Println(msg)
-				return tasks.NewErrRetryTaskLater(msg, DefaultMaxRetryDuration)
-			}
-			return nil
+			return m.dealWithStepSuccess(state, start, taskID, stepName)
+		}
+		if errors.Is(stepErr, istep.ErrPaused) {
+			return m.dealWithStepPause(state, start, taskID, stepName, stepErr)
 		}
 		return m.dealWithStepFailure(state, start, taskID, stepName, stepErr)
 
@@ -443,22 +746,54 @@ func (m *TaskManager) doWork(taskID string, stepName string) error { // nolint
 	}
 }
 
-func (m *TaskManager) dealWithStepFailure(
-	state *State, start time.Time, taskID, stepName string, stepErr error) error {
+func (m *TaskManager) dealWithStepSuccess(state *State, start time.Time, taskID, stepName string) error {
+	readySteps, err := m.updateStepSuccess(state, start)
+	if err != nil {
+		msg := fmt.Sprintf("save task %s step %s update step success failed, err=%v", taskID, stepName, err)
+		log.INFO.Println(msg)
+		return tasks.NewErrRetryTaskLater(msg, DefaultMaxRetryDuration)
+	}
+	m.dispatchChildSteps(state, readySteps)
+	return nil
+}
+
+// updateStepSuccess save step success state, return the ready child steps of DAG task
+func (m *TaskManager) updateStepSuccess(state *State, start time.Time) ([]*types.Step, error) {
+	unlock, err := m.lockTaskState(state.task.GetTaskID())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := state.syncTaskState(); err != nil {
+		return nil, err
+	}
 
 	step := state.step
+	terminated := state.isTaskTerminated()
+	// step成功处理流程
 	// 先更新state状态
-	state.updateStepFailure(start, stepErr, nil)
-	// case1: 当步骤执行失败时，并且没有重试次数时，执行callback
-	// case2: 当跳过失败步骤跳过，并且是最后一步时，执行callback
-	// case3: 当前步骤是主动取消时，执行callback
-	if step.GetRetryCount() >= step.MaxRetries ||
-		(step.GetSkipOnFailed() && state.isLastStep(step)) ||
-		errors.Is(stepErr, istep.ErrRevoked) {
-
-		state.tryCallback(stepErr)
+	state.updateStepSuccess(start)
+	if !terminated && state.isLastStep(step) {
+		state.tryCallback(nil)
+		// 在所有步骤都成功时，但是callback失败了，把callback失败信息作为task失败信息
+		if state.task.GetCallbackResult() == types.CallbackResultFailure {
+			state.task.SetStatus(types.TaskStatusFailure).
+				SetMessage(state.task.GetCallbackMessage())
+		}
 	}
 	if err := state.saveTaskState(); err != nil {
+		return nil, err
+	}
+	return state.readyChildSteps(), nil
+}
+
+func (m *TaskManager) dealWithStepFailure(
+	state *State, start time.Time, taskID, stepName string, stepErr error) error {
+
+	step := state.step
+	readySteps, err := m.updateStepFailure(state, start, stepErr)
+	if err != nil {
 		msg := fmt.Sprintf("savetask %s step %s update step failure failed, err=%v", taskID, stepName, err)
 		log.INFO.Println(msg)
 		return tasks.NewErrRetryTaskLater(msg, DefaultMaxRetryDuration)
@@ -473,6 +808,7 @@ func (m *TaskManager) dealWithStepFailure(
 	}
 
 	if step.GetSkipOnFailed() {
+		m.dispatchChildSteps(state, readySteps)
 		return nil
 	}
 
@@ -480,9 +816,77 @@ func (m *TaskManager) dealWithStepFailure(
 	return retErr
 }
 
+// updateStepFailure save step failure state, return the ready child steps of DAG task
+func (m *TaskManager) updateStepFailure(state *State, start time.Time, stepErr error) ([]*types.Step, error) {
+	unlock, err := m.lockTaskState(state.task.GetTaskID())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := state.syncTaskState(); err != nil {
+		return nil, err
+	}
+
+	step := state.step
+	terminated := state.isTaskTerminated()
+	// 先更新state状态
+	state.updateStepFailure(start, stepErr, nil)
+	// case1: 当步骤执行失败时，并且没有重试次数时，执行callback
+	// case2: 当跳过失败步骤跳过，并且是最后一步时，执行callback
+	// case3: 当前步骤是主动取消时，执行callback
+	if !terminated && (step.GetRetryCount() >= step.MaxRetries ||
+		(step.GetSkipOnFailed() && state.isLastStep(step)) ||
+		errors.Is(stepErr, istep.ErrRevoked)) {
+
+		state.tryCallback(stepErr)
+	}
+	if err := state.saveTaskState(); err != nil {
+		return nil, err
+	}
+	return state.readyChildSteps(), nil
+}
+
+func (m *TaskManager) dealWithStepPause(
+	state *State, start time.Time, taskID, stepName string, stepErr error) error {
+
+	unlock, err := m.lockTaskState(taskID)
+	if err != nil {
+		log.ERROR.Printf("task %s step %s lock task state failed: %v", taskID, stepName, err)
+		return tasks.NewErrRetryTaskLater(err.Error(), DefaultMaxRetryDuration)
+	}
+	defer unlock()
+
+	if err := state.syncTaskState(); err != nil {
+		msg := fmt.Sprintf("sync task %s step %s state failed, err=%v", taskID, stepName, err)
+		log.INFO.Println(msg)
+		return tasks.NewErrRetryTaskLater(msg, DefaultMaxRetryDuration)
+	}
+
+	state.updateStepPaused(start, stepErr)
+	if err := state.saveTaskState(); err != nil {
+		msg := fmt.Sprintf("save task %s step %s update step paused failed, err=%v", taskID, stepName, err)
+		log.INFO.Println(msg)
+		return tasks.NewErrRetryTaskLater(msg, DefaultMaxRetryDuration)
+	}
+	// 暂停后不再执行后续step, 等待Resume
+	retErr := fmt.Errorf("task %s step %s paused, err=%w", taskID, stepName, stepErr)
+	return retErr
+}
+
 func (m *TaskManager) dealWithTaskRevoke(
 	state *State, start time.Time, taskID, stepName string, stepErr error) error {
 
+	unlock, err := m.lockTaskState(taskID)
+	if err != nil {
+		log.ERROR.Printf("task %s step %s lock task state failed: %v", taskID, stepName, err)
+		return tasks.NewErrRetryTaskLater(err.Error(), DefaultMaxRetryDuration)
+	}
+	defer unlock()
+
+	if err := state.syncTaskState(); err != nil {
+		log.ERROR.Printf("task %s step %s sync steps failed: %v", taskID, stepName, err)
+	}
 	state.updateStepFailure(start, stepErr, &taskEndStatus{status: types.TaskStatusRevoked})
 	if err := state.saveTaskState(); err != nil {
 		msg := fmt.Sprintf("save task %s step %s update step failure failed, err=%v", taskID, stepName, err)
@@ -497,6 +901,16 @@ func (m *TaskManager) dealWithTaskRevoke(
 func (m *TaskManager) dealWithTaskTimeout(
 	state *State, start time.Time, taskID, stepName string, stepErr error) error {
 
+	unlock, err := m.lockTaskState(taskID)
+	if err != nil {
+		log.ERROR.Printf("task %s step %s lock task state failed: %v", taskID, stepName, err)
+		return tasks.NewErrRetryTaskLater(err.Error(), DefaultMaxRetryDuration)
+	}
+	defer unlock()
+
+	if err := state.syncTaskState(); err != nil {
+		log.ERROR.Printf("task %s step %s sync steps failed: %v", taskID, stepName, err)
+	}
 	state.updateStepFailure(start, stepErr, &taskEndStatus{status: types.TaskStatusTimeout})
 	if err := state.saveTaskState(); err != nil {
 		msg := fmt.Sprintf("task %s step %s update step failure failed, err=%v", taskID, stepName, err)
@@ -14,14 +14,28 @@ package task
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
+	eagerbackend "github.com/RichardKnop/machinery/v2/backends/eager"
+	eagerbroker "github.com/RichardKnop/machinery/v2/brokers/eager"
+	ibroker "github.com/RichardKnop/machinery/v2/brokers/iface"
+	"github.com/RichardKnop/machinery/v2/config"
+	eagerlock "github.com/RichardKnop/machinery/v2/locks/eager"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	membackend "github.com/Tencent/bk-bcs/bcs-common/common/task/backends/mem"
+	membroker "github.com/Tencent/bk-bcs/bcs-common/common/task/brokers/mem"
+	memlock "github.com/Tencent/bk-bcs/bcs-common/common/task/locks/mem"
+	memrevoker "github.com/Tencent/bk-bcs/bcs-common/common/task/revokers/mem"
+	"github.com/Tencent/bk-bcs/bcs-common/common/task/steps/approval"
 	hellostep "github.com/Tencent/bk-bcs/bcs-common/common/task/steps/hello"
 	istep "github.com/Tencent/bk-bcs/bcs-common/common/task/steps/iface"
+	istore "github.com/Tencent/bk-bcs/bcs-common/common/task/stores/iface"
 	"github.com/Tencent/bk-bcs/bcs-common/common/task/stores/mem"
 	mysqlstore "github.com/Tencent/bk-bcs/bcs-common/common/task/stores/mysql"
 	"github.com/Tencent/bk-bcs/bcs-common/common/task/types"
@@ -108,3 +122,378 @@ func TestDoWorkWithMySQL(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+// dagRecorder record the executed steps of dag test
+type dagRecorder struct {
+	mtx      sync.Mutex
+	executed []string
+}
+
+func (r *dagRecorder) Execute(c *istep.Context) error {
+	r.mtx.Lock()
+	r.executed = append(r.executed, c.GetName())
+	r.mtx.Unlock()
+
+	if _, ok := c.GetParam("fail"); ok {
+		return fmt.Errorf("step %s failed", c.GetName())
+	}
+	return nil
+}
+
+var dagExecutor = &dagRecorder{}
+
+// pauseManager pause the task in pause-record step
+var pauseManager *TaskManager
+
+func init() {
+	istep.Register("dag-record", dagExecutor)
+	istep.Register("pause-record", istep.StepExecutorFunc(func(c *istep.Context) error {
+		if err := pauseManager.Pause(&types.Task{TaskID: c.GetTaskID()}); err != nil {
+			return err
+		}
+		return dagExecutor.Execute(c)
+	}))
+	approval.Register()
+}
+
+// newEagerTaskManager run steps synchronously in process
+func newEagerTaskManager(t *testing.T) *TaskManager {
+	broker := eagerbroker.New()
+	mgr := NewTaskManager()
+	require.NoError(t, mgr.Init(&ManagerConfig{
+		ModuleName: "dag-test",
+		WorkerName: "dag-test",
+		Broker:     broker,
+		Backend:    eagerbackend.New(),
+		Lock:       eagerlock.New(),
+		Store:      mem.New(),
+	}))
+	broker.(interface {
+		AssignWorker(p ibroker.TaskProcessor)
+	}).AssignWorker(mgr.worker)
+	return mgr
+}
+
+func TestDispatchDAG(t *testing.T) {
+	mgr := newEagerTaskManager(t)
+
+	info := types.TaskInfo{
+		TaskType: "example-test",
+		TaskName: "dag",
+		Creator:  "bcs",
+	}
+
+	// a -> (b, c) -> d, b failed with skip
+	task := types.NewTask(info)
+	task.Steps = []*types.Step{
+		types.NewStep("a", "dag-record"),
+		types.NewStep("b", "dag-record", types.WithDependsOn("a")).AddParam("fail", "true").SetSkipOnFailed(true),
+		types.NewStep("c", "dag-record", types.WithDependsOn("a")),
+		types.NewStep("d", "dag-record", types.WithDependsOn("b", "c")),
+	}
+
+	dagExecutor.executed = nil
+	require.NoError(t, mgr.Dispatch(task))
+
+	got, err := mgr.GetTaskWithID(context.Background(), task.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, types.TaskStatusSuccess, got.GetStatus())
+	assert.Equal(t, []string{"a", "b", "c", "d"}, dagExecutor.executed)
+
+	b, _ := got.GetStep("b")
+	assert.Equal(t, types.TaskStatusFailure, b.GetStatus())
+	d, _ := got.GetStep("d")
+	assert.Equal(t, types.TaskStatusSuccess, d.GetStatus())
+}
+
+func TestDispatchDAGFailed(t *testing.T) {
+	mgr := newEagerTaskManager(t)
+
+	info := types.TaskInfo{
+		TaskType: "example-test",
+		TaskName: "dag",
+		Creator:  "bcs",
+	}
+
+	// a -> (b, c) -> d, b failed
+	task := types.NewTask(info)
+	task.Steps = []*types.Step{
+		types.NewStep("a", "dag-record"),
+		types.NewStep("b", "dag-record", types.WithDependsOn("a")).AddParam("fail", "true"),
+		types.NewStep("c", "dag-record", types.WithDependsOn("a")),
+		types.NewStep("d", "dag-record", types.WithDependsOn("b", "c")),
+	}
+
+	dagExecutor.executed = nil
+	require.NoError(t, mgr.Dispatch(task))
+
+	got, err := mgr.GetTaskWithID(context.Background(), task.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, types.TaskStatusFailure, got.GetStatus())
+	// eager模式下b失败后任务结束, c不再执行
+	assert.Equal(t, []string{"a", "b"}, dagExecutor.executed)
+	d, _ := got.GetStep("d")
+	assert.Equal(t, types.TaskStatusNotStarted, d.GetStatus())
+
+	// retry at b, a is passed and not executed again
+	b, _ := got.GetStep("b")
+	delete(b.Params, "fail")
+	dagExecutor.executed = nil
+	require.NoError(t, mgr.RetryAt(got, "b"))
+	got, err = mgr.GetTaskWithID(context.Background(), task.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, types.TaskStatusSuccess, got.GetStatus())
+	assert.Equal(t, []string{"b", "c", "d"}, dagExecutor.executed)
+
+	assert.Error(t, mgr.RetryAt(got, "not-exist"))
+}
+
+func TestTaskManagerInMemory(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	serverConfig := &config.Config{
+		DefaultQueue:    "machinery_tasks",
+		ResultsExpireIn: 3600,
+		NoUnixSignals:   true,
+	}
+	mgr := NewTaskManager()
+	require.NoError(t, mgr.Init(&ManagerConfig{
+		ModuleName:   "mem-test",
+		WorkerName:   "mem-test",
+		WorkerNum:    2,
+		Broker:       membroker.New(ctx, serverConfig),
+		Backend:      membackend.New(serverConfig),
+		Lock:         memlock.New(3),
+		Revoker:      memrevoker.New(ctx),
+		Store:        mem.New(),
+		ServerConfig: serverConfig,
+	}))
+	go func() {
+		_ = mgr.Run()
+	}()
+	defer mgr.Stop()
+
+	info := types.TaskInfo{
+		TaskType: "example-test",
+		TaskName: "mem",
+		Creator:  "bcs",
+	}
+	task := types.NewTask(info)
+	task.Steps = []*types.Step{
+		types.NewStep("a", "dag-record"),
+		types.NewStep("b", "dag-record"),
+	}
+	require.NoError(t, mgr.Dispatch(task))
+
+	assert.Eventually(t, func() bool {
+		got, err := mgr.GetTaskWithID(context.Background(), task.TaskID)
+		return err == nil && got.GetStatus() == types.TaskStatusSuccess
+	}, time.Second*10, time.Millisecond*100)
+}
+
+func TestPauseAndResume(t *testing.T) {
+	mgr := newEagerTaskManager(t)
+	pauseManager = mgr
+
+	info := types.TaskInfo{
+		TaskType: "example-test",
+		TaskName: "pause",
+		Creator:  "bcs",
+	}
+
+	// a执行期间暂停, a执行完成后不再执行b
+	task := types.NewTask(info)
+	task.Steps = []*types.Step{
+		types.NewStep("a", "pause-record"),
+		types.NewStep("b", "dag-record"),
+		types.NewStep("c", "dag-record"),
+	}
+
+	dagExecutor.executed = nil
+	require.NoError(t, mgr.Dispatch(task))
+
+	got, err := mgr.GetTaskWithID(context.Background(), task.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, types.TaskStatusPaused, got.GetStatus())
+	assert.Equal(t, []string{"a"}, dagExecutor.executed)
+	a, _ := got.GetStep("a")
+	assert.Equal(t, types.TaskStatusSuccess, a.GetStatus())
+
+	assert.Error(t, mgr.Pause(got))
+
+	require.NoError(t, mgr.Resume(got))
+	got, err = mgr.GetTaskWithID(context.Background(), task.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, types.TaskStatusSuccess, got.GetStatus())
+	assert.Equal(t, []string{"a", "b", "c"}, dagExecutor.executed)
+
+	assert.Error(t, mgr.Resume(got))
+}
+
+func TestApproval(t *testing.T) {
+	mgr := newEagerTaskManager(t)
+
+	info := types.TaskInfo{
+		TaskType: "example-test",
+		TaskName: "approval",
+		Creator:  "bcs",
+	}
+	newApprovalTask := func() *types.Task {
+		task := types.NewTask(info)
+		task.Steps = []*types.Step{
+			types.NewStep("a", "dag-record"),
+			approval.NewStep("approve"),
+			types.NewStep("b", "dag-record"),
+		}
+		return task
+	}
+
+	// 审批通过
+	task := newApprovalTask()
+	dagExecutor.executed = nil
+	require.NoError(t, mgr.Dispatch(task))
+
+	got, err := mgr.GetTaskWithID(context.Background(), task.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, types.TaskStatusPaused, got.GetStatus())
+	assert.Equal(t, []string{"a"}, dagExecutor.executed)
+	step, _ := got.GetStep("approve")
+	assert.Equal(t, types.TaskStatusPaused, step.GetStatus())
+
+	assert.Error(t, mgr.Approve(got, "a", "admin", "ok"))
+	require.NoError(t, mgr.Approve(got, "approve", "admin", "ok"))
+	got, err = mgr.GetTaskWithID(context.Background(), task.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, types.TaskStatusSuccess, got.GetStatus())
+	assert.Equal(t, []string{"a", "b"}, dagExecutor.executed)
+	step, _ = got.GetStep("approve")
+	approver, _ := step.GetParam(approval.ApproverKey)
+	assert.Equal(t, "admin", approver)
+
+	// 审批拒绝
+	task = newApprovalTask()
+	dagExecutor.executed = nil
+	require.NoError(t, mgr.Dispatch(task))
+	require.NoError(t, mgr.Reject(task, "approve", "admin", "not allowed"))
+
+	got, err = mgr.GetTaskWithID(context.Background(), task.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, types.TaskStatusFailure, got.GetStatus())
+	assert.Equal(t, []string{"a"}, dagExecutor.executed)
+	assert.Contains(t, got.GetMessage(), "not allowed")
+}
+
+// slowStore 保存任务前增加延迟, 放大并发更新任务状态的冲突窗口
+type slowStore struct {
+	istore.Store
+}
+
+func (s *slowStore) UpdateTask(ctx context.Context, task *types.Task) error {
+	time.Sleep(10 * time.Millisecond)
+	return s.Store.UpdateTask(ctx, task)
+}
+
+// TestDAGWithConcurrentWorkers 两个 manager 模拟不同进程的 worker 并发执行 DAG 并行 step,
+// 任务状态依赖 ManagerConfig.Lock 串行更新
+func TestDAGWithConcurrentWorkers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	serverConfig := &config.Config{
+		DefaultQueue:    "dag-concurrent-test",
+		ResultsExpireIn: 3600,
+		NoUnixSignals:   true,
+	}
+	// 非 eager broker, 派发的 step 由下面的 worker 协程执行
+	broker := membroker.New(ctx, serverConfig)
+	backend := membackend.New(serverConfig)
+	lock := memlock.New(3)
+	store := &slowStore{Store: mem.New()}
+
+	mgrs := make([]*TaskManager, 0, 2)
+	for i := 0; i < 2; i++ {
+		mgr := NewTaskManager()
+		require.NoError(t, mgr.Init(&ManagerConfig{
+			ModuleName:   "dag-concurrent-test",
+			WorkerName:   fmt.Sprintf("dag-concurrent-test-%d", i),
+			Broker:       broker,
+			Backend:      backend,
+			Lock:         lock,
+			Store:        store,
+			ServerConfig: serverConfig,
+		}))
+		mgrs = append(mgrs, mgr)
+	}
+
+	// a -> (b0 ... b7) -> z
+	task := types.NewTask(types.TaskInfo{TaskType: "example-test", TaskName: "dag-concurrent", Creator: "bcs"})
+	task.Steps = []*types.Step{types.NewStep("a", "dag-record")}
+	parallel := make([]string, 0, 8)
+	for i := 0; i < 8; i++ {
+		name := fmt.Sprintf("b%d", i)
+		parallel = append(parallel, name)
+		task.Steps = append(task.Steps, types.NewStep(name, "dag-record", types.WithDependsOn("a")))
+	}
+	task.Steps = append(task.Steps, types.NewStep("z", "dag-record", types.WithDependsOn(parallel...)))
+
+	dagExecutor.mtx.Lock()
+	dagExecutor.executed = nil
+	dagExecutor.mtx.Unlock()
+	require.NoError(t, mgrs[0].Dispatch(task))
+	require.NoError(t, mgrs[0].doWork(task.TaskID, "a"))
+
+	// 并行 step 分散到两个 manager 的 worker 并发执行
+	var wg sync.WaitGroup
+	for i, name := range parallel {
+		wg.Add(1)
+		go func(mgr *TaskManager, stepName string) {
+			defer wg.Done()
+			assert.NoError(t, mgr.doWork(task.TaskID, stepName))
+		}(mgrs[i%2], name)
+	}
+	wg.Wait()
+	require.NoError(t, mgrs[1].doWork(task.TaskID, "z"))
+
+	got, err := mgrs[1].GetTaskWithID(context.Background(), task.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, types.TaskStatusSuccess, got.GetStatus())
+	for _, step := range got.Steps {
+		assert.Equal(t, types.TaskStatusSuccess, step.GetStatus(), "step %s", step.GetName())
+	}
+	dagExecutor.mtx.Lock()
+	defer dagExecutor.mtx.Unlock()
+	assert.Len(t, dagExecutor.executed, len(task.Steps))
+	assert.Equal(t, "z", dagExecutor.executed[len(dagExecutor.executed)-1])
+}
+
+func TestTransTaskToSignature(t *testing.T) {
+	task := types.NewTask(types.TaskInfo{TaskType: "example-test", TaskName: "chain", Creator: "bcs"})
+	task.Steps = []*types.Step{
+		types.NewStep("a", "hello"),
+		types.NewStep("b", "hello"),
+		types.NewStep("c", "hello"),
+	}
+
+	tests := []struct {
+		name      string
+		stepBegin string
+		toEnd     bool
+		expect    []string
+	}{
+		{name: "all steps", stepBegin: "", expect: []string{"a", "b", "c"}},
+		{name: "retry at the named step only", stepBegin: "b", expect: []string{"b"}},
+		{name: "resume from the named step to the end", stepBegin: "b", toEnd: true, expect: []string{"b", "c"}},
+		{name: "step not exist", stepBegin: "d", toEnd: true, expect: []string{}},
+	}
+	mgr := &TaskManager{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0)
+			for _, signature := range mgr.transTaskToSignature(task, tt.stepBegin, tt.toEnd) {
+				got = append(got, signature.Args[1].Value.(string))
+			}
+			assert.Equal(t, tt.expect, got)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mem is revoker use memory, only works in single process
+package mem
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/task/revokers/iface"
+)
+
+const (
+	// revokeExpire 未注册的取消信号保留时间, 和etcd实现保持一致
+	revokeExpire = time.Minute * 2
+)
+
+type revokeSign struct {
+	taskID       string
+	registerTime time.Time
+	ctx          context.Context
+	cancel       context.CancelFunc
+}
+
+type memRevoker struct {
+	ctx           context.Context
+	mtx           sync.Mutex
+	revokeSignMap map[string]*revokeSign
+	// 任务还未执行时收到的取消信号
+	pendingRevoke map[string]time.Time
+}
+
+// New ..
+func New(ctx context.Context) iface.Revoker {
+	revoker := memRevoker{
+		ctx:           ctx,
+		revokeSignMap: map[string]*revokeSign{},
+		pendingRevoke: map[string]time.Time{},
+	}
+
+	go revoker.Run()
+
+	return &revoker
+}
+
+// Run cleanup revoke sign
+func (r *memRevoker) Run() {
+	ticker := time.NewTicker(time.Minute * 10)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			r.cleanupRevokeSign()
+		}
+	}
+}
+
+// Revoke mem revoker send sign
+func (r *memRevoker) Revoke(ctx context.Context, taskID string) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	sign, ok := r.revokeSignMap[taskID]
+	if !ok {
+		r.pendingRevoke[taskID] = time.Now()
+		return nil
+	}
+
+	sign.cancel()
+	delete(r.revokeSignMap, taskID)
+	return nil
+}
+
+// RevokeCtx mem revoker ctx
+func (r *memRevoker) RevokeCtx(taskID string) context.Context {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	sign, ok := r.revokeSignMap[taskID]
+	if ok {
+		sign.registerTime = time.Now()
+		return sign.ctx
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// 注册前已经收到取消信号
+	if revokeTime, ok := r.pendingRevoke[taskID]; ok {
+		delete(r.pendingRevoke, taskID)
+		if time.Since(revokeTime) < revokeExpire {
+			cancel()
+			return ctx
+		}
+	}
+
+	sign = &revokeSign{
+		taskID:       taskID,
+		registerTime: time.Now(),
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+	r.revokeSignMap[taskID] = sign
+
+	return sign.ctx
+}
+
+func (r *memRevoker) cleanupRevokeSign() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	for taskID, sign := range r.revokeSignMap {
+		if time.Since(sign.registerTime) > time.Hour*24*3 {
+			sign.cancel()
+			delete(r.revokeSignMap, taskID)
+		}
+	}
+	for taskID, revokeTime := range r.pendingRevoke {
+		if time.Since(revokeTime) > revokeExpire {
+			delete(r.pendingRevoke, taskID)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mem
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevoke(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	revoker := New(ctx)
+
+	// 执行中的任务
+	revokeCtx := revoker.RevokeCtx("task-1")
+	assert.NoError(t, revokeCtx.Err())
+	assert.Equal(t, revokeCtx, revoker.RevokeCtx("task-1"))
+	assert.NoError(t, revoker.Revoke(context.Background(), "task-1"))
+	assert.ErrorIs(t, revokeCtx.Err(), context.Canceled)
+
+	// 还未执行的任务
+	assert.NoError(t, revoker.Revoke(context.Background(), "task-2"))
+	assert.ErrorIs(t, revoker.RevokeCtx("task-2").Err(), context.Canceled)
+
+	assert.NoError(t, revoker.RevokeCtx("task-3").Err())
+}
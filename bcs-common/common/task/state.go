@@ -88,14 +88,12 @@ func NewState(task *types.Task, stepName string) *State {
 
 // isTaskTerminated is terminated
 func (s *State) isTaskTerminated() bool {
-	status := s.task.GetStatus()
-	if status == types.TaskStatusFailure ||
-		status == types.TaskStatusSuccess ||
-		status == types.TaskStatusRevoked ||
-		status == types.TaskStatusTimeout {
-		return true
-	}
-	return false
+	return isTerminatedStatus(s.task.GetStatus())
+}
+
+// isTaskPaused is paused
+func (s *State) isTaskPaused() bool {
+	return s.task.GetStatus() == types.TaskStatusPaused
 }
 
 // isReadyToStep check if step is ready to step
@@ -115,6 +113,10 @@ func (s *State) isReadyToStep(stepName string) (*types.Step, error) {
 	if !ok {
 		return nil, fmt.Errorf("step %s is not exist", stepName)
 	}
+	// DAG模式下依赖的step需要全部通过
+	if s.task.IsDAG() && !s.task.IsStepDependenciesPassed(curStep) {
+		return nil, fmt.Errorf("step %s dependencies %v are not passed", stepName, curStep.GetDependsOn())
+	}
 	s.task.SetCurrentStep(stepName).SetLastUpdate(nowTime)
 
 	defer func() {
@@ -210,12 +212,21 @@ func (s *State) updateStepSuccess(start time.Time) {
 		SetMessage(fmt.Sprintf("step %s running successfully", s.step.Name)).
 		SetLastUpdate(endTime)
 
+	// DAG模式下并行的step可能已经结束了任务
+	if s.isTaskTerminated() {
+		return
+	}
+
 	taskStartTime := s.task.GetStartTime()
-	s.task.SetStatus(types.TaskStatusRunning).
-		SetExecutionTime(taskStartTime, endTime).
-		SetMessage(fmt.Sprintf("step %s running successfully", s.step.Name)).
+	s.task.SetExecutionTime(taskStartTime, endTime).
 		SetLastUpdate(endTime)
 
+	// 暂停的任务保持暂停, 在Resume后继续执行后续step
+	if !s.isTaskPaused() {
+		s.task.SetStatus(types.TaskStatusRunning).
+			SetMessage(fmt.Sprintf("step %s running successfully", s.step.Name))
+	}
+
 	if s.isLastStep(s.step) {
 		s.task.SetEndTime(endTime).
 			SetStatus(types.TaskStatusSuccess).
@@ -251,6 +262,11 @@ func (s *State) updateStepFailure(start time.Time, stepErr error, taskStatus *ta
 		SetMessage(stepFailMsg).
 		SetLastUpdate(endTime)
 
+	// DAG模式下并行的step可能已经结束了任务
+	if s.isTaskTerminated() {
+		return
+	}
+
 	taskStartTime := s.task.GetStartTime()
 	s.task.SetExecutionTime(taskStartTime, endTime).
 		SetLastUpdate(endTime)
@@ -282,14 +298,18 @@ func (s *State) updateStepFailure(start time.Time, stepErr error, taskStatus *ta
 
 	// 重试流程中
 	if !errors.Is(stepErr, istep.ErrRevoked) && s.step.GetRetryCount() < s.step.MaxRetries {
-		s.task.SetStatus(types.TaskStatusRunning).SetMessage(taskFailMsg)
+		if !s.isTaskPaused() {
+			s.task.SetStatus(types.TaskStatusRunning).SetMessage(taskFailMsg)
+		}
 		return
 	}
 
 	// 忽略错误
 	if s.step.GetSkipOnFailed() {
-		msg := fmt.Sprintf("step %s running failed, with skip on failed", s.step.Name)
-		s.task.SetStatus(types.TaskStatusRunning).SetMessage(msg)
+		if !s.isTaskPaused() {
+			msg := fmt.Sprintf("step %s running failed, with skip on failed", s.step.Name)
+			s.task.SetStatus(types.TaskStatusRunning).SetMessage(msg)
+		}
 		return
 	}
 
@@ -299,6 +319,26 @@ func (s *State) updateStepFailure(start time.Time, stepErr error, taskStatus *ta
 		SetMessage(taskFailMsg)
 }
 
+// updateStepPaused step主动暂停, 任务暂停直到Resume或者审批
+func (s *State) updateStepPaused(start time.Time, stepErr error) {
+	endTime := time.Now()
+	s.step.SetEndTime(endTime).
+		SetExecutionTime(start, endTime).
+		SetStatus(types.TaskStatusPaused).
+		SetMessage(stepErr.Error()).
+		SetLastUpdate(endTime)
+
+	if s.isTaskTerminated() {
+		return
+	}
+
+	taskStartTime := s.task.GetStartTime()
+	s.task.SetExecutionTime(taskStartTime, endTime).
+		SetStatus(types.TaskStatusPaused).
+		SetMessage(fmt.Sprintf("task paused at step %s, %s", s.step.Name, stepErr)).
+		SetLastUpdate(endTime)
+}
+
 func (s *State) isLastStep(step *types.Step) bool {
 	count := len(s.task.Steps)
 	// 没有step也就没有后续流程, 返回true
@@ -306,6 +346,19 @@ func (s *State) isLastStep(step *types.Step) bool {
 		return true
 	}
 
+	// DAG模式下其他step都通过时才是最后一步
+	if s.task.IsDAG() {
+		if !step.IsCompleted() {
+			return false
+		}
+		for _, other := range s.task.Steps {
+			if other.GetName() != step.GetName() && !other.IsPassed() {
+				return false
+			}
+		}
+		return true
+	}
+
 	// 非最后一步
 	if step.GetName() != s.task.Steps[count-1].Name {
 		return false
@@ -315,6 +368,70 @@ func (s *State) isLastStep(step *types.Step) bool {
 	return step.IsCompleted()
 }
 
+// syncTaskState 执行结果落库前先同步最新的task状态, 任务可能在step执行期间被暂停或结束;
+// DAG模式下其他step由并行的worker更新, 只保留当前step的执行结果, 调用方需持有task state锁
+func (s *State) syncTaskState() error {
+	latest, err := GetGlobalStorage().GetTask(context.Background(), s.task.GetTaskID())
+	if err != nil {
+		return fmt.Errorf("get task %s information failed, %s", s.task.GetTaskID(), err.Error())
+	}
+	// 内存存储返回的是同一个对象, 无需同步
+	if latest == s.task {
+		return nil
+	}
+
+	// 任务已经被暂停或结束
+	if latest.GetStatus() == types.TaskStatusPaused || isTerminatedStatus(latest.GetStatus()) {
+		s.task.SetStatus(latest.GetStatus()).
+			SetMessage(latest.GetMessage()).
+			SetEndTime(latest.GetEndTime())
+	}
+
+	if !s.task.IsDAG() {
+		return nil
+	}
+	steps := make([]*types.Step, 0, len(latest.Steps))
+	for _, step := range latest.Steps {
+		if step.GetName() == s.step.GetName() {
+			steps = append(steps, s.step)
+			continue
+		}
+		steps = append(steps, step)
+	}
+	s.task.Steps = steps
+
+	// 并行step写入的公共参数
+	for k, v := range latest.CommonParams {
+		if _, ok := s.task.GetCommonParam(k); !ok {
+			s.task.AddCommonParam(k, v)
+		}
+	}
+
+	return nil
+}
+
+// readyChildSteps return the child steps which are ready to dispatch after current step passed
+func (s *State) readyChildSteps() []*types.Step {
+	if !s.task.IsDAG() || s.isTaskTerminated() || s.isTaskPaused() || !s.step.IsPassed() {
+		return nil
+	}
+
+	readySteps := make([]*types.Step, 0)
+	for _, child := range s.task.GetChildSteps(s.step.GetName()) {
+		if child.GetStatus() == types.TaskStatusNotStarted && s.task.IsStepDependenciesPassed(child) {
+			readySteps = append(readySteps, child)
+		}
+	}
+	return readySteps
+}
+
+func isTerminatedStatus(status string) bool {
+	return status == types.TaskStatusFailure ||
+		status == types.TaskStatusSuccess ||
+		status == types.TaskStatusRevoked ||
+		status == types.TaskStatusTimeout
+}
+
 // GetTask get task
 func (s *State) GetTask() *types.Task {
 	return s.task
@@ -0,0 +1,73 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package approval defines the manual approval step, the task is paused at the step
+// until it is approved or rejected by TaskManager.Approve / TaskManager.Reject.
+package approval
+
+import (
+	"fmt"
+
+	istep "github.com/Tencent/bk-bcs/bcs-common/common/task/steps/iface"
+	"github.com/Tencent/bk-bcs/bcs-common/common/task/types"
+)
+
+// 注意, Step名称不能修改
+const (
+	// ApprovalStep ...
+	ApprovalStep istep.StepName = "APPROVAL"
+)
+
+// step params of approval
+const (
+	// ResultKey 审批结果
+	ResultKey = "approvalResult"
+	// ApproverKey 审批人
+	ApproverKey = "approver"
+	// CommentKey 审批意见
+	CommentKey = "approvalComment"
+)
+
+const (
+	// ResultApproved 审批通过
+	ResultApproved = "approved"
+	// ResultRejected 审批拒绝
+	ResultRejected = "rejected"
+)
+
+type approval struct{}
+
+// Execute 未审批时暂停任务, 审批通过后step成功, 审批拒绝后step失败
+func (a *approval) Execute(c *istep.Context) error {
+	result, _ := c.GetParam(ResultKey)
+	approver, _ := c.GetParam(ApproverKey)
+	comment, _ := c.GetParam(CommentKey)
+
+	switch result {
+	case ResultApproved:
+		return nil
+	case ResultRejected:
+		return fmt.Errorf("rejected by %s, comment: %s", approver, comment)
+	default:
+		return fmt.Errorf("%w: waiting for approval", istep.ErrPaused)
+	}
+}
+
+// NewStep return a new approval step
+func NewStep(name string, opts ...types.StepOption) *types.Step {
+	return types.NewStep(name, string(ApprovalStep), opts...)
+}
+
+// Register ...
+func Register() {
+	istep.Register(ApprovalStep, &approval{})
+}
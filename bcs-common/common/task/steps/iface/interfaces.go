@@ -20,6 +20,8 @@ import (
 var (
 	// ErrParamNotFound 参数未找到
 	ErrParamNotFound = errors.New("param not found")
+	// ErrPaused step暂停执行, 任务暂停直到Resume或者审批
+	ErrPaused = errors.New("paused")
 )
 
 // StepExecutor that client must implement
@@ -15,6 +15,7 @@ package mem
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync"
 
@@ -22,15 +23,16 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-common/common/task/types"
 )
 
+// memStore 保存task的副本, 避免并发执行的step共享同一个task对象
 type memStore struct {
 	mtx   sync.Mutex
-	tasks map[string]*types.Task
+	tasks map[string][]byte
 }
 
 // New new memStore
 func New() iface.Store {
 	s := &memStore{
-		tasks: make(map[string]*types.Task),
+		tasks: make(map[string][]byte),
 	}
 	return s
 }
@@ -41,11 +43,7 @@ func (s *memStore) EnsureTable(ctx context.Context, dst ...any) error {
 }
 
 func (s *memStore) CreateTask(ctx context.Context, task *types.Task) error {
-	s.mtx.Lock()
-	defer s.mtx.Unlock()
-
-	s.tasks[task.GetTaskID()] = task
-	return nil
+	return s.saveTask(task)
 }
 
 func (s *memStore) ListTask(ctx context.Context, opt *iface.ListOption) (*iface.Pagination[types.Task], error) {
@@ -53,7 +51,19 @@ func (s *memStore) ListTask(ctx context.Context, opt *iface.ListOption) (*iface.
 }
 
 func (s *memStore) UpdateTask(ctx context.Context, task *types.Task) error {
-	s.tasks[task.GetTaskID()] = task
+	return s.saveTask(task)
+}
+
+func (s *memStore) saveTask(task *types.Task) error {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.tasks[task.GetTaskID()] = data
 	return nil
 }
 
@@ -65,11 +75,16 @@ func (s *memStore) GetTask(ctx context.Context, taskID string) (*types.Task, err
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
-	t, ok := s.tasks[taskID]
-	if ok {
-		return t, nil
+	data, ok := s.tasks[taskID]
+	if !ok {
+		return nil, fmt.Errorf("not found")
+	}
+
+	t := &types.Task{}
+	if err := json.Unmarshal(data, t); err != nil {
+		return nil, err
 	}
-	return nil, fmt.Errorf("not found")
+	return t, nil
 }
 
 func (s *memStore) PatchTask(ctx context.Context, opt *iface.PatchOption) error {
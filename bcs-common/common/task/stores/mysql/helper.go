@@ -30,6 +30,7 @@ func getStepRecord(t *types.Task) []*StepRecord {
 			Status:              step.Status,
 			Message:             step.Message,
 			SkipOnFailed:        step.SkipOnFailed,
+			DependsOn:           step.DependsOn,
 			ETA:                 step.ETA,
 			RetryCount:          step.RetryCount,
 			MaxRetries:          step.MaxRetries,
@@ -125,6 +125,7 @@ type StepRecord struct {
 	Message             string            `json:"message" gorm:"type:text"`
 	ETA                 *time.Time        `json:"eta"`
 	SkipOnFailed        bool              `json:"skipOnFailed"`
+	DependsOn           []string          `json:"dependsOn" gorm:"type:text;serializer:json"`
 	RetryCount          uint32            `json:"retryCount"`
 	MaxRetries          uint32            `json:"maxRetries"`
 	ExecutionTime       uint32            `json:"executionTime"`
@@ -172,6 +173,7 @@ func (t *StepRecord) ToStep() *types.Step {
 		Message:             t.Message,
 		ETA:                 t.ETA,
 		SkipOnFailed:        t.SkipOnFailed,
+		DependsOn:           t.DependsOn,
 		RetryCount:          t.RetryCount,
 		MaxRetries:          t.MaxRetries,
 		ExecutionTime:       t.ExecutionTime,
@@ -0,0 +1,151 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package types for task
+package types
+
+import (
+	"fmt"
+)
+
+// IsDAG return true when any step declares dependsOn, the steps are scheduled by dependencies
+// instead of the Steps sequence
+func (t *Task) IsDAG() bool {
+	for _, step := range t.Steps {
+		if len(step.DependsOn) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// validateDependsOn 校验依赖的step存在且没有环
+func (t *Task) validateDependsOn() error {
+	if !t.IsDAG() {
+		return nil
+	}
+
+	inDegree := make(map[string]int, len(t.Steps))
+	for _, step := range t.Steps {
+		inDegree[step.Name] = 0
+	}
+	for _, step := range t.Steps {
+		uniq := make(map[string]struct{}, len(step.DependsOn))
+		for _, dep := range step.DependsOn {
+			if dep == step.Name {
+				return fmt.Errorf("step %s depends on itself", step.Name)
+			}
+			if _, ok := inDegree[dep]; !ok {
+				return fmt.Errorf("step %s depends on not exist step %s", step.Name, dep)
+			}
+			if _, ok := uniq[dep]; ok {
+				return fmt.Errorf("step %s depends on step %s repeatedly", step.Name, dep)
+			}
+			uniq[dep] = struct{}{}
+		}
+		inDegree[step.Name] = len(step.DependsOn)
+	}
+
+	// kahn 拓扑排序, 无法排完的step在环上
+	queue := make([]string, 0, len(t.Steps))
+	for _, step := range t.Steps {
+		if inDegree[step.Name] == 0 {
+			queue = append(queue, step.Name)
+		}
+	}
+	visited := 0
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		visited++
+		for _, child := range t.GetChildSteps(name) {
+			inDegree[child.Name]--
+			if inDegree[child.Name] == 0 {
+				queue = append(queue, child.Name)
+			}
+		}
+	}
+	if visited != len(t.Steps) {
+		return fmt.Errorf("steps dependsOn has cycle")
+	}
+
+	return nil
+}
+
+// GetChildSteps return the steps which directly depend on stepName
+func (t *Task) GetChildSteps(stepName string) []*Step {
+	children := make([]*Step, 0)
+	for _, step := range t.Steps {
+		for _, dep := range step.DependsOn {
+			if dep == stepName {
+				children = append(children, step)
+				break
+			}
+		}
+	}
+	return children
+}
+
+// GetDownstreamSteps return all the steps which directly or indirectly depend on stepName
+func (t *Task) GetDownstreamSteps(stepName string) []*Step {
+	downstream := make([]*Step, 0)
+	visited := map[string]struct{}{stepName: {}}
+	queue := []string{stepName}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, child := range t.GetChildSteps(name) {
+			if _, ok := visited[child.Name]; ok {
+				continue
+			}
+			visited[child.Name] = struct{}{}
+			downstream = append(downstream, child)
+			queue = append(queue, child.Name)
+		}
+	}
+	return downstream
+}
+
+// IsStepDependenciesPassed return true when all the dependencies of step are passed
+func (t *Task) IsStepDependenciesPassed(step *Step) bool {
+	for _, dep := range step.DependsOn {
+		depStep, ok := t.GetStep(dep)
+		if !ok || !depStep.IsPassed() {
+			return false
+		}
+	}
+	return true
+}
+
+// GetReadySteps return the not started or paused steps whose dependencies are all passed
+func (t *Task) GetReadySteps() []*Step {
+	ready := make([]*Step, 0)
+	for _, step := range t.Steps {
+		if step.Status != TaskStatusNotStarted && step.Status != TaskStatusPaused {
+			continue
+		}
+		if t.IsStepDependenciesPassed(step) {
+			ready = append(ready, step)
+		}
+	}
+	return ready
+}
+
+// IsAllStepsPassed return true when all the steps are passed
+func (t *Task) IsAllStepsPassed() bool {
+	for _, step := range t.Steps {
+		if !step.IsPassed() {
+			return false
+		}
+	}
+	return true
+}
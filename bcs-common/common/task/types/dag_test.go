@@ -0,0 +1,59 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDependsOn(t *testing.T) {
+	newTask := func(steps ...*Step) *Task {
+		task := NewTask(TaskInfo{TaskType: "example-test", TaskName: "dag", Creator: "bcs"})
+		task.Steps = steps
+		return task
+	}
+
+	task := newTask(
+		NewStep("a", "hello"),
+		NewStep("b", "hello", WithDependsOn("a")),
+		NewStep("c", "hello", WithDependsOn("a")),
+		NewStep("d", "hello", WithDependsOn("b", "c")),
+	)
+	assert.NoError(t, task.Validate())
+	assert.True(t, task.IsDAG())
+	assert.Len(t, task.GetReadySteps(), 1)
+	assert.Len(t, task.GetDownstreamSteps("a"), 3)
+
+	cases := map[string]*Task{
+		"itself":    newTask(NewStep("a", "hello", WithDependsOn("a"))),
+		"not exist": newTask(NewStep("a", "hello", WithDependsOn("b"))),
+		"repeatedly": newTask(
+			NewStep("a", "hello"),
+			NewStep("b", "hello", WithDependsOn("a", "a")),
+		),
+		"cycle": newTask(
+			NewStep("a", "hello", WithDependsOn("c")),
+			NewStep("b", "hello", WithDependsOn("a")),
+			NewStep("c", "hello", WithDependsOn("b")),
+		),
+	}
+	for msg, task := range cases {
+		err := task.Validate()
+		if assert.Error(t, err, msg) {
+			assert.True(t, strings.Contains(err.Error(), msg), err.Error())
+		}
+	}
+}
@@ -23,6 +23,7 @@ type StepOptions struct {
 	MaxRetries          uint32
 	SkipFailed          bool
 	MaxExecutionSeconds uint32
+	DependsOn           []string
 }
 
 // StepOption xxx
@@ -49,6 +50,13 @@ func WithMaxExecutionSeconds(execSecs uint32) StepOption {
 	}
 }
 
+// WithDependsOn xxx
+func WithDependsOn(stepNames ...string) StepOption {
+	return func(opt *StepOptions) {
+		opt.DependsOn = stepNames
+	}
+}
+
 // NewStep return a new step by default params
 func NewStep(name string, executor string, opts ...StepOption) *Step {
 	defaultOptions := &StepOptions{MaxRetries: 0}
@@ -65,6 +73,7 @@ func NewStep(name string, executor string, opts ...StepOption) *Step {
 		Message:             "",
 		RetryCount:          0,
 		SkipOnFailed:        defaultOptions.SkipFailed,
+		DependsOn:           defaultOptions.DependsOn,
 		MaxRetries:          defaultOptions.MaxRetries,
 		MaxExecutionSeconds: defaultOptions.MaxExecutionSeconds,
 	}
@@ -179,6 +188,15 @@ func (s *Step) IsCompleted() bool {
 	return false
 }
 
+// IsPassed return step is passed or not, the steps depend on it can run when it is passed
+func (s *Step) IsPassed() bool {
+	if s.Status == TaskStatusSuccess {
+		return true
+	}
+	// 失败跳过
+	return s.Status == TaskStatusFailure && s.SkipOnFailed && s.IsCompleted()
+}
+
 // SetStatus set status
 func (s *Step) SetStatus(stat string) *Step {
 	s.Status = stat
@@ -207,6 +225,17 @@ func (s *Step) SetSkipOnFailed(skipOnFailed bool) *Step {
 	return s
 }
 
+// GetDependsOn get step dependencies
+func (s *Step) GetDependsOn() []string {
+	return s.DependsOn
+}
+
+// SetDependsOn set step dependencies
+func (s *Step) SetDependsOn(stepNames ...string) *Step {
+	s.DependsOn = stepNames
+	return s
+}
+
 // SetMaxTries set step max retry count
 func (s *Step) SetMaxTries(count uint32) *Step {
 	s.MaxRetries = count
@@ -376,5 +376,5 @@ func (t *Task) Validate() error {
 		uniq[s.Name] = struct{}{}
 	}
 
-	return nil
+	return t.validateDependsOn()
 }
@@ -46,6 +46,8 @@ const (
 	TaskStatusRevoked = "REVOKED"
 	// TaskStatusNotStarted force task terminate
 	TaskStatusNotStarted = "NOTSTARTED"
+	// TaskStatusPaused task paused, step waiting for resume or approval
+	TaskStatusPaused = "PAUSED"
 
 	// CallbackResultSuccess callback success
 	CallbackResultSuccess = "SUCCESS"
@@ -97,6 +99,7 @@ type Step struct {
 	Message             string            `json:"message"`
 	ETA                 *time.Time        `json:"eta"` // 延迟执行时间(Estimated Time of Arrival)
 	SkipOnFailed        bool              `json:"skipOnFailed"`
+	DependsOn           []string          `json:"dependsOn"` // 依赖的step, 为空时按Steps顺序执行
 	RetryCount          uint32            `json:"retryCount"`
 	MaxRetries          uint32            `json:"maxRetries"`
 	ExecutionTime       uint32            `json:"executionTime"`
@@ -208,4 +208,12 @@ type WatchOptions struct {
 	// The value-change event will be checked if it's different from last status. If not then this event
 	// will be ignored. And it will not trigger timeout reset.
 	MustDiff string `json:"mustDiff"`
+
+	// Resume watch after the event with this resourceVersion, which is returned in every watch event.
+	// Watch will be ended with an expired event if the resourceVersion is too old, then client should re-list.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// Receive bookmark events which only carry the latest resourceVersion, so that client can resume
+	// from a fresh resourceVersion even if there is no event matched for a long time.
+	AllowBookmarks bool `json:"allowBookmarks"`
 }
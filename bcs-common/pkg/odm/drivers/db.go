@@ -104,6 +104,8 @@ const (
 	EventError = "error"
 	// EventClose close event
 	EventClose = "close"
+	// EventBookmark bookmark event, only carries the latest resume token without data
+	EventBookmark = "bookmark"
 )
 
 // WatchEvent event returned by watch interface
@@ -117,6 +119,11 @@ type WatchEvent struct {
 	UpdatedFields  map[string]interface{}
 	RemovedFields  []string
 	Data           operator.M
+	// ResumeToken opaque token of the event, which increases monotonically in one collection,
+	// watch can be resumed after the event with this token
+	ResumeToken string
+	// Err reason of error event
+	Err error
 }
 
 // Watch interface for watch action
@@ -141,6 +148,13 @@ type Watch interface {
 	// the least significant 32 bits are an incrementing ordinal for operations within a given second.
 	WithStartTimestamp(uint32, uint32) Watch
 
+	// WithResumeToken set resume token that watch start after, the event with the token is not returned
+	WithResumeToken(token string) Watch
+
+	// WithBookmarkInterval set interval of bookmark event, which carries the latest resume token
+	// even if there is no event matched, 0 for no bookmark event
+	WithBookmarkInterval(interval time.Duration) Watch
+
 	// DoWatch do watch action
 	DoWatch(ctx context.Context) (chan *WatchEvent, error)
 }
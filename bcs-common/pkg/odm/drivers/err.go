@@ -21,4 +21,6 @@ var (
 	ErrTableRecordNotFound = errors.New("record not found")
 	// ErrTableRecordDuplicateKey database index key is duplicate
 	ErrTableRecordDuplicateKey = errors.New("duplicate key error")
+	// ErrWatchResumeTokenExpired resume token of watch is no longer in the history of database
+	ErrWatchResumeTokenExpired = errors.New("watch resume token expired")
 )
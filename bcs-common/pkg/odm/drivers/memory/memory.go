@@ -287,6 +287,16 @@ func (w *DBTableWatcher) WithStartTimestamp(timeSec uint32, index uint32) driver
 	return w
 }
 
+// WithResumeToken set resume token that watch start after
+func (w *DBTableWatcher) WithResumeToken(token string) drivers.Watch {
+	return w
+}
+
+// WithBookmarkInterval set interval of bookmark event
+func (w *DBTableWatcher) WithBookmarkInterval(interval time.Duration) drivers.Watch {
+	return w
+}
+
 // DoWatch do watch action
 func (w *DBTableWatcher) DoWatch(ctx context.Context) (chan *drivers.WatchEvent, error) {
 	return nil, nil
@@ -21,6 +21,7 @@ const (
 	updateDescriptionKey = "updateDescription" // nolint
 	updatedFieldsKey     = "updatedFields"     // nolint
 	removedFieldsKey     = "removedFields"     // nolint
+	resumeTokenDataKey   = "_data"
 
 	operationTypeInsert       = "insert"
 	operationTypeUpdate       = "update"
@@ -37,4 +38,9 @@ const (
 	mongoAuthMichanismPlain  = "PLAIN"
 	mongoAuthMichanismGssAPI = "GSSAPI"
 	mongoAuthMichanismX509   = "MONGODB-X509"
+
+	// error codes returned by mongodb when resume token is not in oplog any more
+	errCodeCappedPositionLost      = 136
+	errCodeChangeStreamFatal       = 280
+	errCodeChangeStreamHistoryLost = 286
 )
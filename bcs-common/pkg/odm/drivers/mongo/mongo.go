@@ -541,6 +541,8 @@ type Watcher struct {
 	isFull           bool
 	maxAwaitDuration time.Duration
 	startTimestamp   *primitive.Timestamp
+	resumeToken      string
+	bookmarkInterval time.Duration
 	conditions       []*operator.Condition
 	*Collection
 }
@@ -572,6 +574,18 @@ func (w *Watcher) WithStartTimestamp(timeSec uint32, index uint32) drivers.Watch
 	return w
 }
 
+// WithResumeToken set resume token that watch start after
+func (w *Watcher) WithResumeToken(token string) drivers.Watch {
+	w.resumeToken = token
+	return w
+}
+
+// WithBookmarkInterval set interval of bookmark event
+func (w *Watcher) WithBookmarkInterval(interval time.Duration) drivers.Watch {
+	w.bookmarkInterval = interval
+	return w
+}
+
 // DoWatch do watch action
 func (w *Watcher) DoWatch(ctx context.Context) (chan *drivers.WatchEvent, error) {
 	changeStreamOpt := &mopt.ChangeStreamOptions{}
@@ -581,6 +595,9 @@ func (w *Watcher) DoWatch(ctx context.Context) (chan *drivers.WatchEvent, error)
 	if w.startTimestamp != nil {
 		changeStreamOpt.StartAtOperationTime = w.startTimestamp
 	}
+	if len(w.resumeToken) != 0 {
+		changeStreamOpt.ResumeAfter = bson.M{resumeTokenDataKey: w.resumeToken}
+	}
 	if w.maxAwaitDuration > 0 {
 		changeStreamOpt.MaxAwaitTime = &w.maxAwaitDuration
 	}
@@ -606,6 +623,9 @@ func (w *Watcher) DoWatch(ctx context.Context) (chan *drivers.WatchEvent, error)
 	}()
 	changeStream, err = w.mCli.Database(w.dbName).Collection(w.collectionName).Watch(ctx, filters, changeStreamOpt)
 	if err != nil {
+		if isResumeTokenExpired(err) {
+			return nil, fmt.Errorf("%w: %s", drivers.ErrWatchResumeTokenExpired, err.Error())
+		}
 		return nil, err
 	}
 
@@ -614,32 +634,92 @@ func (w *Watcher) DoWatch(ctx context.Context) (chan *drivers.WatchEvent, error)
 		defer func(changeStream *mongo.ChangeStream, ctx context.Context) {
 			_ = changeStream.Close(ctx)
 		}(changeStream, ctx)
-		errEvent := &drivers.WatchEvent{
-			Type:           drivers.EventError,
-			DBName:         w.dbName,
-			CollectionName: w.collectionName,
-		}
-		for changeStream.Next(ctx) {
+		lastToken := w.resumeToken
+		lastBookmark := time.Now()
+		for w.next(ctx, changeStream) {
+			if changeStream.Current == nil {
+				// no event in this batch, send bookmark with the post batch resume token if needed
+				token := decodeResumeToken(changeStream.ResumeToken())
+				if len(token) == 0 || token == lastToken || time.Since(lastBookmark) < w.bookmarkInterval {
+					continue
+				}
+				lastToken = token
+				lastBookmark = time.Now()
+				if !sendWatchEvent(ctx, eventChannel, &drivers.WatchEvent{
+					Type:           drivers.EventBookmark,
+					DBName:         w.dbName,
+					CollectionName: w.collectionName,
+					ResumeToken:    token,
+				}) {
+					return
+				}
+				continue
+			}
 			var data Event
 			if err := changeStream.Decode(&data); err != nil {
 				blog.Errorf("decode data failed, err %s", err.Error())
-				eventChannel <- errEvent
+				sendWatchEvent(ctx, eventChannel, w.errEvent(err))
 				return
 			}
 
 			newEvent, err := convertMongoEvent(data)
 			if err != nil {
 				blog.Errorf("convert mongo event failed, err %s", err.Error())
-				eventChannel <- errEvent
+				sendWatchEvent(ctx, eventChannel, w.errEvent(err))
+				return
+			}
+			lastToken = newEvent.ResumeToken
+			if !sendWatchEvent(ctx, eventChannel, newEvent) {
 				return
 			}
-			eventChannel <- newEvent
+		}
+		if streamErr := changeStream.Err(); streamErr != nil && ctx.Err() == nil {
+			blog.Errorf("change stream of %s.%s break, err %s", w.dbName, w.collectionName, streamErr.Error())
+			if isResumeTokenExpired(streamErr) {
+				streamErr = fmt.Errorf("%w: %s", drivers.ErrWatchResumeTokenExpired, streamErr.Error())
+			}
+			sendWatchEvent(ctx, eventChannel, w.errEvent(streamErr))
 		}
 	}()
 
 	return eventChannel, nil
 }
 
+// next move change stream to next event, when bookmark is enabled, it returns true with nil Current
+// if there is no event in one batch, so that caller can send bookmark event
+func (w *Watcher) next(ctx context.Context, changeStream *mongo.ChangeStream) bool {
+	if w.bookmarkInterval <= 0 {
+		return changeStream.Next(ctx)
+	}
+	if changeStream.TryNext(ctx) {
+		return true
+	}
+	if changeStream.Err() != nil || ctx.Err() != nil {
+		return false
+	}
+	changeStream.Current = nil
+	return true
+}
+
+// sendWatchEvent send event to channel, return false when ctx is done before the event is received
+func sendWatchEvent(ctx context.Context, ch chan<- *drivers.WatchEvent, event *drivers.WatchEvent) bool {
+	select {
+	case ch <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (w *Watcher) errEvent(err error) *drivers.WatchEvent {
+	return &drivers.WatchEvent{
+		Type:           drivers.EventError,
+		DBName:         w.dbName,
+		CollectionName: w.collectionName,
+		Err:            err,
+	}
+}
+
 // Client returns the underlying mongo.Client instance.
 func (db *DB) Client() *mongo.Client {
 	return db.mCli
@@ -13,11 +13,13 @@
 package mongo
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
 	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
@@ -101,5 +103,35 @@ func convertMongoEvent(data Event) (*drivers.WatchEvent, error) {
 		blog.Errorf("unsupport event type %s", data.OpType)
 		return nil, fmt.Errorf("unsupport event type %s", data.OpType)
 	}
+	if token, ok := data.ID[resumeTokenDataKey].(string); ok {
+		newEvent.ResumeToken = token
+	}
 	return newEvent, nil
 }
+
+// decodeResumeToken get token string from resume token document, e.g. {"_data": "8262..."}
+func decodeResumeToken(raw bson.Raw) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	value, err := raw.LookupErr(resumeTokenDataKey)
+	if err != nil {
+		return ""
+	}
+	token, ok := value.StringValueOK()
+	if !ok {
+		return ""
+	}
+	return token
+}
+
+// isResumeTokenExpired check if error is caused by resume token which is not in oplog any more
+func isResumeTokenExpired(err error) bool {
+	var serverErr mongo.ServerError
+	if !errors.As(err, &serverErr) {
+		return false
+	}
+	return serverErr.HasErrorCode(errCodeChangeStreamHistoryLost) ||
+		serverErr.HasErrorCode(errCodeChangeStreamFatal) ||
+		serverErr.HasErrorCode(errCodeCappedPositionLost)
+}
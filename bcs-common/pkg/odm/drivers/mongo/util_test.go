@@ -0,0 +1,93 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+)
+
+// TestConvertMongoEventResumeToken test resume token in converted event
+func TestConvertMongoEventResumeToken(t *testing.T) {
+	event, err := convertMongoEvent(Event{
+		ID:           bson.M{resumeTokenDataKey: "8262A1B2C3000000012B"},
+		OpType:       operationTypeInsert,
+		FullDocument: bson.M{"name": "test"},
+		Ns:           &EventNs{DBName: "db", ColName: "col"},
+	})
+	if err != nil {
+		t.Fatalf("convert mongo event failed, err %s", err.Error())
+	}
+	if event.ResumeToken != "8262A1B2C3000000012B" {
+		t.Errorf("expect resume token 8262A1B2C3000000012B, but got %s", event.ResumeToken)
+	}
+}
+
+// TestDecodeResumeToken test decode resume token document
+func TestDecodeResumeToken(t *testing.T) {
+	raw, err := bson.Marshal(bson.M{resumeTokenDataKey: "8262A1B2C3000000012B"})
+	if err != nil {
+		t.Fatalf("marshal resume token failed, err %s", err.Error())
+	}
+	if token := decodeResumeToken(raw); token != "8262A1B2C3000000012B" {
+		t.Errorf("expect resume token 8262A1B2C3000000012B, but got %s", token)
+	}
+	if token := decodeResumeToken(nil); token != "" {
+		t.Errorf("expect empty resume token, but got %s", token)
+	}
+	raw, _ = bson.Marshal(bson.M{"other": 1})
+	if token := decodeResumeToken(raw); token != "" {
+		t.Errorf("expect empty resume token, but got %s", token)
+	}
+}
+
+// TestIsResumeTokenExpired test resume token expired error
+func TestIsResumeTokenExpired(t *testing.T) {
+	testCases := []struct {
+		err      error
+		expected bool
+	}{
+		{mongo.CommandError{Code: errCodeChangeStreamHistoryLost}, true},
+		{fmt.Errorf("watch failed: %w", mongo.CommandError{Code: errCodeChangeStreamFatal}), true},
+		{mongo.CommandError{Code: errCodeCappedPositionLost}, true},
+		{mongo.CommandError{Code: 11000}, false},
+		{errors.New("network error"), false},
+	}
+	for _, testCase := range testCases {
+		if result := isResumeTokenExpired(testCase.err); result != testCase.expected {
+			t.Errorf("expect %v for error %v, but got %v", testCase.expected, testCase.err, result)
+		}
+	}
+}
+
+// TestSendWatchEvent test watch event sending is canceled by ctx
+func TestSendWatchEvent(t *testing.T) {
+	ch := make(chan *drivers.WatchEvent, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if !sendWatchEvent(ctx, ch, &drivers.WatchEvent{Type: drivers.EventBookmark}) {
+		t.Fatalf("send to channel with free buffer failed")
+	}
+	cancel()
+	// channel is full and nobody receives, sending must not block after ctx is done
+	if sendWatchEvent(ctx, ch, &drivers.WatchEvent{Type: drivers.EventBookmark}) {
+		t.Fatalf("send to full channel succeeded after ctx done")
+	}
+}
@@ -514,6 +514,25 @@ type ActionStatus struct {
 	// Empty for Global actions (backward compatible).
 	// +optional
 	ClusterStatuses []ClusterActionStatus `json:"clusterStatuses,omitempty"`
+
+	// Preview is the rendered result of the action, only set when the execution mode is DryRun
+	// +optional
+	Preview *ActionPreview `json:"preview,omitempty"`
+}
+
+// ActionPreview records what an action would do in DryRun mode
+type ActionPreview struct {
+	// Operation is the resolved operation, e.g. Create, Apply, Patch, Delete or the HTTP method
+	// +optional
+	Operation string `json:"operation,omitempty"`
+
+	// Params are the resolved parameters used to render the action
+	// +optional
+	Params map[string]string `json:"params,omitempty"`
+
+	// Manifests are the rendered target objects in YAML
+	// +optional
+	Manifests []string `json:"manifests,omitempty"`
 }
 
 // ClusterActionStatus tracks execution state of a single cluster within a PerCluster action.
@@ -66,6 +66,12 @@ const (
 	OperationTypeRevert = "Revert"
 )
 
+// Execution Mode constants for DRPlanExecution
+const (
+	// ExecutionModeDryRun renders every action into the execution status without applying anything
+	ExecutionModeDryRun = "DryRun"
+)
+
 // Failure Policy constants
 const (
 	// FailurePolicyStop stops execution immediately on first failure
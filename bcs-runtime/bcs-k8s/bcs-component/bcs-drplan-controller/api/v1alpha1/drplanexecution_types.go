@@ -13,6 +13,8 @@
 package v1alpha1
 
 import (
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -33,8 +35,10 @@ type DRPlanExecutionSpec struct {
 	// Mode is the execution mode for Execute operation.
 	// It is used by action-level `when` conditions (e.g. install/upgrade path selection).
 	// When not set, executors keep backward-compatible behavior and do not filter by mode.
+	// DryRun previews the plan: every action renders its resolved params and target objects
+	// into status.stageStatuses without side effects, and `when` conditions are not evaluated.
 	// +optional
-	// +kubebuilder:validation:Enum=Install;Upgrade;Delete;Rollback
+	// +kubebuilder:validation:Enum=Install;Upgrade;Delete;Rollback;DryRun
 	Mode string `json:"mode,omitempty"`
 
 	// RevertExecutionRef specifies which execution to revert (required for Revert operation).
@@ -224,6 +228,12 @@ type DRPlanExecution struct {
 	Status DRPlanExecutionStatus `json:"status,omitzero"`
 }
 
+// IsDryRun returns true if the execution only previews the plan without side effects
+func (in *DRPlanExecution) IsDryRun() bool {
+	return in != nil && in.Spec.OperationType == OperationTypeExecute &&
+		strings.EqualFold(strings.TrimSpace(in.Spec.Mode), ExecutionModeDryRun)
+}
+
 // +kubebuilder:object:root=true
 
 // DRPlanExecutionList contains a list of DRPlanExecution
@@ -219,6 +219,12 @@ func (r *DRPlanExecutionReconciler) applyPlanStatusFromExecution(execution *drv1
 	if execution.Status.Phase != drv1alpha1.PhaseSucceeded {
 		return
 	}
+	// A DryRun execution changes nothing, so it must not move the plan phase or become the revert target
+	if execution.IsDryRun() {
+		klog.Infof("Execution %s/%s is a DryRun, keep plan %s/%s phase=%s",
+			execution.Namespace, execution.Name, plan.Namespace, plan.Name, plan.Status.Phase)
+		return
+	}
 	plan.Status.LastExecutionRef = execution.Name
 	plan.Status.LastExecutionTime = execution.Status.CompletionTime
 	switch execution.Spec.OperationType {
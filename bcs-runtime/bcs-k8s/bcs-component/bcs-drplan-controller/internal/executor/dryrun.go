@@ -0,0 +1,113 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	drv1alpha1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-drplan-controller/api/v1alpha1"
+)
+
+// redactedValue replaces sensitive values in the dry-run preview
+const redactedValue = "******"
+
+// sensitiveHeaderKeywords are the header name keywords whose values are redacted in the preview
+var sensitiveHeaderKeywords = []string{"authorization", "cookie", "token", "secret", "password"}
+
+type dryRunContextKey struct{}
+
+// withDryRun marks the context so that action executors only render their targets
+func withDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunContextKey{}, true)
+}
+
+// isDryRun reports whether action executors should preview instead of applying changes
+func isDryRun(ctx context.Context) bool {
+	dryRun, ok := ctx.Value(dryRunContextKey{}).(bool)
+	return ok && dryRun
+}
+
+// previewActionStatus records the rendered objects of an action into its status and
+// marks it succeeded. objects are marshaled to YAML one manifest per object.
+func previewActionStatus(
+	status *drv1alpha1.ActionStatus,
+	operation string,
+	params map[string]interface{},
+	objects ...interface{},
+) (*drv1alpha1.ActionStatus, error) {
+	preview := &drv1alpha1.ActionPreview{
+		Operation: operation,
+		Params:    previewParams(params),
+		Manifests: make([]string, 0, len(objects)),
+	}
+	for _, obj := range objects {
+		manifest, err := yaml.Marshal(obj)
+		if err != nil {
+			status.Phase = drv1alpha1.PhaseFailed
+			status.CompletionTime = &metav1.Time{Time: time.Now()}
+			status.Message = fmt.Sprintf("failed to render preview: %v", err)
+			return status, fmt.Errorf("failed to render preview: %w", err)
+		}
+		preview.Manifests = append(preview.Manifests, string(manifest))
+	}
+
+	status.Preview = preview
+	status.Phase = drv1alpha1.PhaseSucceeded
+	status.CompletionTime = &metav1.Time{Time: time.Now()}
+	status.Message = fmt.Sprintf("Dry run: rendered %d object(s) for %s, no changes applied", len(preview.Manifests), operation)
+	return status, nil
+}
+
+// previewParams converts resolved params to strings, non-string values are encoded as JSON
+func previewParams(params map[string]interface{}) map[string]string {
+	if len(params) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(params))
+	for k, v := range params {
+		if s, ok := v.(string); ok {
+			result[k] = s
+			continue
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			result[k] = fmt.Sprintf("%v", v)
+			continue
+		}
+		result[k] = string(data)
+	}
+	return result
+}
+
+// redactHeaders returns a copy of headers with sensitive values masked
+func redactHeaders(headers map[string]string) map[string]string {
+	result := make(map[string]string, len(headers))
+	for k, v := range headers {
+		result[k] = v
+		lower := strings.ToLower(k)
+		for _, keyword := range sensitiveHeaderKeywords {
+			if strings.Contains(lower, keyword) {
+				result[k] = redactedValue
+				break
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,178 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	drv1alpha1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-drplan-controller/api/v1alpha1"
+)
+
+func TestKubernetesResourceDryRunRendersWithoutApply(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add corev1 scheme: %v", err)
+	}
+	k8sClient := fakeclient.NewClientBuilder().WithScheme(scheme).Build()
+	executor := NewKubernetesResourceActionExecutor(k8sClient)
+
+	action := &drv1alpha1.Action{
+		Name: "create-configmap",
+		Type: drv1alpha1.ActionTypeKubernetesResource,
+		Resource: &drv1alpha1.KubernetesResourceAction{
+			Manifest: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: $(params.name)
+  namespace: default
+data:
+  region: $(params.region)
+`,
+		},
+	}
+	params := map[string]interface{}{"name": "demo", "region": "ap-shanghai"}
+
+	status, err := executor.Execute(withDryRun(context.Background()), action, params)
+	if err != nil {
+		t.Fatalf("expected dry run to succeed, got %v", err)
+	}
+	if status.Phase != drv1alpha1.PhaseSucceeded {
+		t.Fatalf("expected phase Succeeded, got %s", status.Phase)
+	}
+	if status.Outputs != nil {
+		t.Fatalf("expected no outputs in dry run, got %+v", status.Outputs)
+	}
+	if status.Preview == nil || len(status.Preview.Manifests) != 1 {
+		t.Fatalf("expected one rendered manifest, got %+v", status.Preview)
+	}
+	if status.Preview.Operation != drv1alpha1.OperationCreate {
+		t.Fatalf("expected operation Create, got %s", status.Preview.Operation)
+	}
+	if !strings.Contains(status.Preview.Manifests[0], "region: ap-shanghai") {
+		t.Fatalf("expected rendered manifest, got %s", status.Preview.Manifests[0])
+	}
+	if status.Preview.Params["name"] != "demo" {
+		t.Fatalf("expected resolved param name=demo, got %v", status.Preview.Params)
+	}
+
+	got := &corev1.ConfigMap{}
+	err = k8sClient.Get(context.Background(), client.ObjectKey{Name: "demo", Namespace: "default"}, got)
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected ConfigMap not to be created, got err=%v", err)
+	}
+}
+
+func TestJobDryRunRendersWithoutCreate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := batchv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add batchv1 scheme: %v", err)
+	}
+	k8sClient := fakeclient.NewClientBuilder().WithScheme(scheme).Build()
+	executor := NewJobActionExecutor(k8sClient)
+
+	action := &drv1alpha1.Action{
+		Name: "migrate",
+		Type: drv1alpha1.ActionTypeJob,
+		Job: &drv1alpha1.JobAction{
+			Namespace: "$(params.ns)",
+		},
+	}
+
+	status, err := executor.Execute(withDryRun(context.Background()), action, map[string]interface{}{"ns": "dr"})
+	if err != nil {
+		t.Fatalf("expected dry run to succeed, got %v", err)
+	}
+	if status.Preview == nil || len(status.Preview.Manifests) != 1 {
+		t.Fatalf("expected one rendered manifest, got %+v", status.Preview)
+	}
+	manifest := status.Preview.Manifests[0]
+	if !strings.Contains(manifest, "kind: Job") || !strings.Contains(manifest, "namespace: dr") {
+		t.Fatalf("expected rendered Job in namespace dr, got %s", manifest)
+	}
+
+	jobs := &batchv1.JobList{}
+	if err := k8sClient.List(context.Background(), jobs); err != nil {
+		t.Fatalf("failed to list jobs: %v", err)
+	}
+	if len(jobs.Items) != 0 {
+		t.Fatalf("expected no Job to be created, got %d", len(jobs.Items))
+	}
+}
+
+func TestHTTPDryRunDoesNotSendRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s in dry run", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	action := &drv1alpha1.Action{
+		Name: "notify",
+		Type: drv1alpha1.ActionTypeHTTP,
+		HTTP: &drv1alpha1.HTTPAction{
+			URL:    server.URL + "/failover/$(params.cluster)",
+			Method: "POST",
+			Headers: map[string]string{
+				"Authorization": "Bearer $(params.token)",
+				"X-Request-ID":  "drill",
+			},
+		},
+	}
+	params := map[string]interface{}{"cluster": "BCS-K8S-00001", "token": "secret"}
+
+	status, err := NewHTTPActionExecutor().Execute(withDryRun(context.Background()), action, params)
+	if err != nil {
+		t.Fatalf("expected dry run to succeed, got %v", err)
+	}
+	if status.Preview == nil || len(status.Preview.Manifests) != 1 {
+		t.Fatalf("expected one rendered request, got %+v", status.Preview)
+	}
+	request := status.Preview.Manifests[0]
+	if !strings.Contains(request, "/failover/BCS-K8S-00001") {
+		t.Fatalf("expected rendered url, got %s", request)
+	}
+	if strings.Contains(request, "Bearer secret") || !strings.Contains(request, redactedValue) {
+		t.Fatalf("expected Authorization header to be redacted, got %s", request)
+	}
+	if !strings.Contains(request, "X-Request-ID: drill") {
+		t.Fatalf("expected plain header to be kept, got %s", request)
+	}
+}
+
+func TestPreviewParams(t *testing.T) {
+	got := previewParams(map[string]interface{}{
+		"name":     "demo",
+		"replicas": 3,
+		"clusters": []string{"a", "b"},
+	})
+	want := map[string]string{"name": "demo", "replicas": "3", "clusters": `["a","b"]`}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("param %s: expected %s, got %s", k, v, got[k])
+		}
+	}
+	if previewParams(nil) != nil {
+		t.Errorf("expected nil params for empty input")
+	}
+}
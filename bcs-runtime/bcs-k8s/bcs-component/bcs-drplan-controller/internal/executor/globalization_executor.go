@@ -89,6 +89,10 @@ func (e *GlobalizationActionExecutor) Execute(ctx context.Context, action *drv1a
 		obj.Object["spec"] = specMap
 	}
 
+	if isDryRun(ctx) {
+		return previewActionStatus(status, operation, params, obj.Object)
+	}
+
 	if err := e.applyGlobalization(ctx, obj, operation); err != nil {
 		return failGlobalizationStatus(status, err.Error()), err
 	}
@@ -88,6 +88,10 @@ func (e *HelmChartActionExecutor) Execute(ctx context.Context, action *drv1alpha
 		obj.Object["spec"] = specMap
 	}
 
+	if isDryRun(ctx) {
+		return previewActionStatus(status, operation, params, obj.Object)
+	}
+
 	if err := e.applyHelmChart(ctx, obj, operation); err != nil {
 		return failHelmChartStatus(status, err.Error()), err
 	}
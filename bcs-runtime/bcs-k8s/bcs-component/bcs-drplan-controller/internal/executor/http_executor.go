@@ -53,6 +53,19 @@ func (e *HTTPActionExecutor) Execute(ctx context.Context, action *drv1alpha1.Act
 		return status, err
 	}
 
+	if isDryRun(ctx) {
+		if method == "" {
+			method = "GET"
+		}
+		request := map[string]interface{}{
+			"method":  method,
+			"url":     url,
+			"headers": redactHeaders(headers),
+			"body":    body,
+		}
+		return previewActionStatus(status, method, params, request)
+	}
+
 	httpResp, err := e.executeHTTPWithRetry(ctx, action, status, url, body, method, headers, retryConfig)
 	if err != nil {
 		e.setHTTPActionStatusFailed(status, err.Error())
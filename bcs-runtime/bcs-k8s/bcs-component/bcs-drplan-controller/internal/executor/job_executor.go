@@ -53,6 +53,12 @@ func (e *JobActionExecutor) Execute(ctx context.Context, action *drv1alpha1.Acti
 		return status, err
 	}
 
+	if isDryRun(ctx) {
+		job := e.buildJob(action, jobNamespace)
+		job.SetGroupVersionKind(batchv1.SchemeGroupVersion.WithKind("Job"))
+		return previewActionStatus(status, drv1alpha1.OperationCreate, params, job)
+	}
+
 	job, err := e.createAndRunJob(ctx, action, jobNamespace)
 	if err != nil {
 		e.setJobActionStatusFailed(status, fmt.Sprintf("Failed to create Job: %v", err))
@@ -91,6 +97,16 @@ func (e *JobActionExecutor) renderJobNamespace(action *drv1alpha1.Action, params
 }
 
 func (e *JobActionExecutor) createAndRunJob(ctx context.Context, action *drv1alpha1.Action, jobNamespace string) (*batchv1.Job, error) {
+	job := e.buildJob(action, jobNamespace)
+	klog.V(4).Infof("Creating Job in namespace %s", job.Namespace)
+	if err := e.client.Create(ctx, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// buildJob builds the Job object of the action without creating it
+func (e *JobActionExecutor) buildJob(action *drv1alpha1.Action, jobNamespace string) *batchv1.Job {
 	job := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: fmt.Sprintf("%s-", action.Name),
@@ -101,11 +117,7 @@ func (e *JobActionExecutor) createAndRunJob(ctx context.Context, action *drv1alp
 	if action.Job.TTLSecondsAfterFinished != nil {
 		job.Spec.TTLSecondsAfterFinished = action.Job.TTLSecondsAfterFinished
 	}
-	klog.V(4).Infof("Creating Job in namespace %s", job.Namespace)
-	if err := e.client.Create(ctx, job); err != nil {
-		return nil, err
-	}
-	return job, nil
+	return job
 }
 
 func (e *JobActionExecutor) setJobActionStatusSuccess(status *drv1alpha1.ActionStatus, job *batchv1.Job) {
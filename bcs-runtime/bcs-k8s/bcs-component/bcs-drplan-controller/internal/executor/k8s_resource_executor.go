@@ -57,6 +57,10 @@ func (e *KubernetesResourceActionExecutor) Execute(ctx context.Context, action *
 		return status, err
 	}
 
+	if isDryRun(ctx) {
+		return previewActionStatus(status, operation, params, obj.Object)
+	}
+
 	klog.V(4).Infof("Performing %s operation on %s %s/%s", operation, obj.GetKind(), obj.GetNamespace(), obj.GetName())
 
 	if err := e.performK8sResourceOperation(ctx, operation, obj); err != nil {
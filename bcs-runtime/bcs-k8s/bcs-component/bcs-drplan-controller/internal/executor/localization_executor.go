@@ -88,6 +88,10 @@ func (e *LocalizationActionExecutor) Execute(ctx context.Context, action *drv1al
 		obj.Object["spec"] = specMap
 	}
 
+	if isDryRun(ctx) {
+		return previewActionStatus(status, operation, params, obj.Object)
+	}
+
 	if err := e.applyLocalization(ctx, obj, operation); err != nil {
 		return failLocalizationStatus(status, err.Error()), err
 	}
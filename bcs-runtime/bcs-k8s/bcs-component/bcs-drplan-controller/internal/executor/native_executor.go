@@ -552,6 +552,13 @@ func (e *NativeWorkflowExecutor) executePerClusterBatch(
 		return precheckedStatuses, nil
 	}
 
+	// Dry run previews the parent Subscriptions only: per-cluster fan-out creates
+	// child resources in member clusters and cannot be rendered without side effects.
+	if isDryRun(ctx) {
+		executableStatuses, previewErr := e.executeGlobalBatchFallback(ctx, executableActions, params, failurePolicy)
+		return mergePerClusterBatchStatuses(actions, precheckedStatuses, executableStatuses), previewErr
+	}
+
 	for _, action := range executableActions {
 		if action.Subscription == nil {
 			return nil, fmt.Errorf("PerCluster action %s has nil Subscription spec", action.Name)
@@ -1383,6 +1390,10 @@ func (e *NativePlanExecutor) ExecutePlan(ctx context.Context, plan *drv1alpha1.D
 	klog.Infof("Executing plan: %s/%s", plan.Namespace, plan.Name)
 	progressRecorder := newExecutionProgressRecorder(e, execution)
 	ctx = withExecutionProgressRecorder(ctx, progressRecorder)
+	if execution.IsDryRun() {
+		klog.Infof("Plan %s/%s is executed in DryRun mode, no changes will be applied", plan.Namespace, plan.Name)
+		ctx = withDryRun(ctx)
+	}
 
 	// Initialize execution status
 	if execution.Status.StageStatuses == nil {
@@ -1462,6 +1473,9 @@ func (e *NativePlanExecutor) ExecutePlan(ctx context.Context, plan *drv1alpha1.D
 	if allSucceeded {
 		execution.Status.Phase = drv1alpha1.PhaseSucceeded
 		execution.Status.Message = "All stages completed successfully"
+		if execution.IsDryRun() {
+			execution.Status.Message = "Dry run completed successfully, no changes were applied"
+		}
 		if shouldCleanupHistoricalSubscriptions(execution) {
 			cleaned, err := e.cleanupHistoricalSubscriptionOutputs(ctx, plan, execution)
 			if err != nil {
@@ -1661,6 +1675,7 @@ func newSubscriptionObjectRef(ref corev1.ObjectReference) *unstructured.Unstruct
 
 // resolveExecutionParams resolves plan-level globals first, then execution-level overrides.
 // Reserved key "mode" is sourced from execution.Spec.Mode and not overridden by Params.
+// DryRun does not set "mode" so that `when` conditions keep every action in the preview.
 func (e *NativePlanExecutor) resolveExecutionParams(
 	ctx context.Context,
 	plan *drv1alpha1.DRPlan,
@@ -1677,7 +1692,7 @@ func (e *NativePlanExecutor) resolveExecutionParams(
 		}
 	}
 
-	if mode := strings.TrimSpace(execution.Spec.Mode); mode != "" {
+	if mode := strings.TrimSpace(execution.Spec.Mode); mode != "" && !execution.IsDryRun() {
 		globalParams["mode"] = strings.ToLower(mode)
 	}
 
@@ -1917,6 +1932,13 @@ func (e *NativePlanExecutor) validateAndFetchRevertTarget(
 		return nil, fmt.Errorf("target must be an Execute operation")
 	}
 
+	if targetExecution.IsDryRun() {
+		execution.Status.Phase = drv1alpha1.PhaseFailed
+		execution.Status.Message = "Cannot revert a DryRun execution, it has no changes applied"
+		klog.Errorf("Target execution %s/%s is a DryRun execution", targetExecution.Namespace, targetExecution.Name)
+		return nil, fmt.Errorf("target must not be a DryRun execution")
+	}
+
 	// Allow revert for terminal phases only. A Failed execution may have partially
 	// applied changes that need to be cleaned up, so both Succeeded and Failed are valid.
 	isTerminal := targetExecution.Status.Phase == drv1alpha1.PhaseSucceeded ||
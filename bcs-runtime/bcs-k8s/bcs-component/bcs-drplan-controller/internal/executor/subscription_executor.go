@@ -98,6 +98,10 @@ func (e *SubscriptionActionExecutor) Execute(
 		sub.SetName(subName)
 		sub.SetNamespace(subNamespace)
 
+		if isDryRun(ctx) {
+			return previewActionStatus(status, drv1alpha1.OperationDelete, params, sub.Object)
+		}
+
 		if applyErr := e.applySubscription(ctx, sub, action.Subscription.Operation); applyErr != nil {
 			status.Phase = drv1alpha1.PhaseFailed
 			status.CompletionTime = &metav1.Time{Time: time.Now()}
@@ -119,8 +123,11 @@ func (e *SubscriptionActionExecutor) Execute(
 		return status, nil
 	}
 
-	if cleanupErr := e.applyHookPreCleanup(ctx, action, subNamespace, subName); cleanupErr != nil {
-		return failSubscriptionStatus(status, fmt.Sprintf("hook pre-cleanup failed: %v", cleanupErr)), cleanupErr
+	// Dry run must not touch existing subscriptions, so pre-cleanup is skipped.
+	if !isDryRun(ctx) {
+		if cleanupErr := e.applyHookPreCleanup(ctx, action, subNamespace, subName); cleanupErr != nil {
+			return failSubscriptionStatus(status, fmt.Sprintf("hook pre-cleanup failed: %v", cleanupErr)), cleanupErr
+		}
 	}
 
 	// Build rendered subscription payload used both for create and waitReady
@@ -141,6 +148,14 @@ func (e *SubscriptionActionExecutor) Execute(
 	sub.SetNamespace(subNamespace)
 	sub.Object["spec"] = renderedSub.specMap
 
+	if isDryRun(ctx) {
+		operation := action.Subscription.Operation
+		if operation == "" {
+			operation = drv1alpha1.OperationCreate
+		}
+		return previewActionStatus(status, operation, params, sub.Object)
+	}
+
 	if err := e.applySubscription(ctx, sub, action.Subscription.Operation); err != nil {
 		status.Phase = drv1alpha1.PhaseFailed
 		status.CompletionTime = &metav1.Time{Time: time.Now()}
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -113,6 +114,12 @@ func (w *DRPlanExecutionWebhook) validateExecution(ctx context.Context, executio
 		errors = append(errors, fmt.Sprintf("plan %s is not ready (phase=%s)", execution.Spec.PlanRef, plan.Status.Phase))
 	}
 
+	// DryRun only previews an Execute operation
+	if execution.Spec.OperationType == drv1alpha1.OperationTypeRevert &&
+		strings.EqualFold(strings.TrimSpace(execution.Spec.Mode), drv1alpha1.ExecutionModeDryRun) {
+		errors = append(errors, "mode DryRun is only supported for Execute operation")
+	}
+
 	// Validate revert operation
 	if execution.Spec.OperationType == drv1alpha1.OperationTypeRevert {
 		// revertExecutionRef is required for Revert
@@ -132,6 +139,10 @@ func (w *DRPlanExecutionWebhook) validateExecution(ctx context.Context, executio
 				if targetExecution.Spec.OperationType != drv1alpha1.OperationTypeExecute {
 					errors = append(errors, fmt.Sprintf("referenced execution %s must be an Execute operation, got %s", execution.Spec.RevertExecutionRef, targetExecution.Spec.OperationType))
 				}
+				// A DryRun execution has no changes to revert
+				if targetExecution.IsDryRun() {
+					errors = append(errors, fmt.Sprintf("referenced execution %s is a DryRun execution and cannot be reverted", execution.Spec.RevertExecutionRef))
+				}
 				// Validate target execution is in a terminal phase (Succeeded or Failed).
 				// Failed executions may have partial side-effects that need cleanup via Revert.
 				isTerminal := targetExecution.Status.Phase == drv1alpha1.PhaseSucceeded ||
@@ -233,6 +233,7 @@ func (a *GeneralController) deleteGPA(obj interface{}) {
 		return
 	}
 	metricsServer.ResetScalerMetrics(namespace, name)
+	scalercore.ReleaseTriggers(namespace, name)
 
 	a.queue.Forget(key)
 }
@@ -291,6 +292,8 @@ func (a *GeneralController) reconcileKey(ctx context.Context, key string) (delet
 		delete(a.scaleDownEvents, key)
 		a.scaleDownEventsLock.Unlock()
 
+		scalercore.ReleaseTriggers(namespace, name)
+
 		return true, nil
 	}
 	if err != nil {
@@ -517,6 +520,22 @@ func (a *GeneralController) computeReplicas(ctx context.Context, gpa *autoscalin
 		klog.V(4).Infof("Time-Mode: proposing %v desired replicas (based on %s from %s) for %s (priority: %d)",
 			tmDesiredReplicas, tmName, tmTimestamp, reference, gpa.Spec.TimeMode.Proirity)
 	}
+	if gpa.Spec.EventMode != nil {
+		evDesiredReplicas, evName, evStatuses, evTimestamp, evErr := a.computeReplicasForSimple(gpa,
+			scale, scalercore.NewEventScaler(gpa.Spec.EventMode.Triggers, a.eventRecorder))
+		if evErr != nil {
+			errs = append(errs, evErr)
+		}
+		if evDesiredReplicas != -1 {
+			results = append(results, result{
+				evDesiredReplicas, evName, evStatuses, evTimestamp, gpa.Spec.EventMode.Proirity,
+			})
+		}
+		klog.V(4).Infof("Event-Mode: proposing %v desired replicas (based on %s from %s) for %s (priority: %d)",
+			evDesiredReplicas, evName, evTimestamp, reference, gpa.Spec.EventMode.Proirity)
+	} else {
+		scalercore.ReleaseTriggers(gpa.Namespace, gpa.Name)
+	}
 	var err error
 	if len(errs) > 0 {
 		err = errors.Join(errs...)
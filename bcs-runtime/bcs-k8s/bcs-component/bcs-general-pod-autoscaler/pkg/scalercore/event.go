@@ -12,19 +12,34 @@
 
 package scalercore
 
-import autoscalingv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	autoscalingv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/monitor"
+)
 
 var _ Scaler = &EventScaler{}
 
 // EventScaler event scaler
 type EventScaler struct {
-	schedule string
+	triggers []autoscalingv1.ScaleTriggers
 	name     string
+	// recorder records the failed triggers when other triggers still work, could be nil
+	recorder record.EventRecorder
 }
 
 // NewEventScaler new event scaler
-func NewEventScaler(schedule string) Scaler {
-	return &EventScaler{schedule: schedule, name: Event}
+func NewEventScaler(triggers []autoscalingv1.ScaleTriggers, recorder record.EventRecorder) Scaler {
+	return &EventScaler{triggers: triggers, name: Event, recorder: recorder}
 }
 
 // Run  run
@@ -32,12 +47,94 @@ func (e *EventScaler) Run(stopCh <-chan struct{}) error {
 	return nil
 }
 
-// GetReplicas get replicas
-func (e *EventScaler) GetReplicas(*autoscalingv1.GeneralPodAutoscaler, int32) (int32, error) {
-	return 0, nil
+// GetReplicas returns the max replicas proposed by all triggers, every trigger proposes
+// ceil(metricValue / targetValue) replicas. Triggers whose value does not exceed the activationValue
+// propose nothing. If some triggers failed, the result is only used when it does not scale down,
+// and the failed triggers are reported by a warning event.
+func (e *EventScaler) GetReplicas(gpa *autoscalingv1.GeneralPodAutoscaler, currentReplicas int32) (int32, error) {
+	var max int32 = -1
+	errs := make([]error, 0)
+	triggerCache.prune(gpa.Namespace, gpa.Name, len(e.triggers))
+	for index, trigger := range e.triggers {
+		replicas, err := e.getTriggerReplicas(gpa, index, trigger, currentReplicas)
+		if err != nil {
+			klog.Errorf("GPA %s/%s event trigger %s failed: %v", gpa.Namespace, gpa.Name, triggerName(trigger), err)
+			errs = append(errs, fmt.Errorf("trigger %s: %v", triggerName(trigger), err))
+			continue
+		}
+		klog.V(6).Infof("Trigger %s recommend %v replicas", triggerName(trigger), replicas)
+		if replicas > max {
+			max = replicas
+		}
+	}
+	if len(errs) == 0 {
+		return max, nil
+	}
+	err := errors.Join(errs...)
+	// like metric mode, the unavailable triggers block scaling down
+	if max == -1 || max < currentReplicas {
+		return -1, err
+	}
+	klog.Warningf("GPA %s/%s scales with part of event triggers: %v", gpa.Namespace, gpa.Name, err)
+	if e.recorder != nil {
+		e.recorder.Event(gpa, v1.EventTypeWarning, "FailedGetEventTriggers", err.Error())
+	}
+	return max, nil
 }
 
 // ScalerName scaler name
 func (e *EventScaler) ScalerName() string {
 	return e.name
 }
+
+// getTriggerReplicas returns the replicas proposed by one trigger, -1 means the trigger is not active
+func (e *EventScaler) getTriggerReplicas(gpa *autoscalingv1.GeneralPodAutoscaler, index int,
+	trigger autoscalingv1.ScaleTriggers, currentReplicas int32) (int32, error) {
+	var metricsServer monitor.PrometheusMetricServer
+	var replicas int32 = -1
+	var err error
+	startTime := time.Now()
+	defer func() {
+		recordEventPromMetrics(gpa, metricsServer, triggerName(trigger), startTime, replicas, currentReplicas, err)
+	}()
+
+	spec, err := parseTriggerSpec(trigger)
+	if err != nil {
+		return -1, err
+	}
+	t, err := triggerCache.get(gpa.Namespace, gpa.Name, index, trigger)
+	if err != nil {
+		return -1, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), spec.timeout)
+	defer cancel()
+	value, err := t.GetMetricValue(ctx)
+	if err != nil {
+		// rebuild the connection in the next reconcile
+		triggerCache.evict(gpa.Namespace, gpa.Name, index)
+		return -1, err
+	}
+	if value <= spec.activationValue {
+		klog.V(4).Infof("Trigger %s value %v is not greater than activation value %v",
+			triggerName(trigger), value, spec.activationValue)
+		return -1, nil
+	}
+	replicas = int32(math.Ceil(value / spec.targetValue))
+	return replicas, nil
+}
+
+func recordEventPromMetrics(gpa *autoscalingv1.GeneralPodAutoscaler, ms monitor.PrometheusMetricServer,
+	metricName string, t time.Time, targetReplicas, currentReplicas int32, err error) {
+
+	ms.RecordGPAScalerMetric(gpa, "event", metricName, int64(targetReplicas), int64(currentReplicas))
+	ms.RecordGPAScalerDesiredReplicas(gpa, "event", targetReplicas)
+	if err != nil {
+		ms.RecordGPAScalerError(gpa, "event", metricName)
+		ms.RecordScalerExecDuration(gpa, metricName, "event", "failure", time.Since(t))
+		ms.RecordScalerMetricExecDuration(gpa, metricName, "event", "failure", time.Since(t))
+	} else {
+		ms.RecordScalerExecDuration(gpa, metricName, "event", "success", time.Since(t))
+		ms.RecordScalerMetricExecDuration(gpa, metricName, "event", "success", time.Since(t))
+	}
+}
@@ -0,0 +1,258 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scalercore
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+)
+
+type fakeTrigger struct {
+	value  float64
+	err    error
+	closed bool
+}
+
+func (f *fakeTrigger) GetMetricValue(context.Context) (float64, error) {
+	return f.value, f.err
+}
+
+func (f *fakeTrigger) Close() error {
+	f.closed = true
+	return nil
+}
+
+func init() {
+	RegisterTrigger("fake", func(_ string, metadata map[string]string) (Trigger, error) {
+		if metadata["error"] != "" {
+			return &fakeTrigger{err: errors.New(metadata["error"])}, nil
+		}
+		v, err := strconv.ParseFloat(metadata["value"], 64)
+		if err != nil {
+			return nil, err
+		}
+		return &fakeTrigger{value: v}, nil
+	})
+}
+
+func fakeTriggerSpec(value, target string) v1alpha1.ScaleTriggers {
+	return v1alpha1.ScaleTriggers{
+		Type:     "fake",
+		Metadata: map[string]string{"value": value, targetValueKey: target},
+	}
+}
+
+func Test_EventGetReplicas(t *testing.T) {
+	gpa := &v1alpha1.GeneralPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpa", Namespace: "default"},
+	}
+	failed := v1alpha1.ScaleTriggers{
+		Type:     "fake",
+		Metadata: map[string]string{"error": "connection refused", targetValueKey: "1"},
+	}
+	inactive := fakeTriggerSpec("5", "1")
+	inactive.Metadata[activationValueKey] = "10"
+	for _, c := range []struct {
+		name     string
+		triggers []v1alpha1.ScaleTriggers
+		current  int32
+		desired  int32
+		hasErr   bool
+		events   int
+	}{
+		{
+			name:     "single trigger",
+			triggers: []v1alpha1.ScaleTriggers{fakeTriggerSpec("101", "10")},
+			current:  1,
+			desired:  11,
+		},
+		{
+			name:     "multi triggers, use the max",
+			triggers: []v1alpha1.ScaleTriggers{fakeTriggerSpec("20", "10"), fakeTriggerSpec("30", "5")},
+			current:  1,
+			desired:  6,
+		},
+		{
+			name:     "inactive trigger",
+			triggers: []v1alpha1.ScaleTriggers{inactive},
+			current:  1,
+			desired:  -1,
+		},
+		{
+			name:     "failed trigger blocks scale down",
+			triggers: []v1alpha1.ScaleTriggers{fakeTriggerSpec("2", "1"), failed},
+			current:  5,
+			desired:  -1,
+			hasErr:   true,
+		},
+		{
+			name:     "failed trigger does not block scale up",
+			triggers: []v1alpha1.ScaleTriggers{fakeTriggerSpec("8", "1"), failed},
+			current:  5,
+			desired:  8,
+			events:   1,
+		},
+		{
+			name:     "unsupported trigger",
+			triggers: []v1alpha1.ScaleTriggers{{Type: "unknown", Metadata: map[string]string{targetValueKey: "1"}}},
+			current:  1,
+			desired:  -1,
+			hasErr:   true,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			replicas, err := NewEventScaler(c.triggers, recorder).GetReplicas(gpa, c.current)
+			if (err != nil) != c.hasErr {
+				t.Fatalf("expect error %v, got %v", c.hasErr, err)
+			}
+			if replicas != c.desired {
+				t.Errorf("desired replicas: %v, get: %v", c.desired, replicas)
+			}
+			if len(recorder.Events) != c.events {
+				t.Errorf("expect %d events, got %d", c.events, len(recorder.Events))
+			}
+		})
+	}
+}
+
+func Test_TriggerCache(t *testing.T) {
+	gpa := &v1alpha1.GeneralPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+	}
+	defer ReleaseTriggers(gpa.Namespace, gpa.Name)
+	scaler := NewEventScaler([]v1alpha1.ScaleTriggers{fakeTriggerSpec("1", "1"), fakeTriggerSpec("2", "1")}, nil)
+	if _, err := scaler.GetReplicas(gpa, 1); err != nil {
+		t.Fatalf("get replicas failed: %v", err)
+	}
+	first, err := triggerCache.get(gpa.Namespace, gpa.Name, 0, fakeTriggerSpec("1", "1"))
+	if err != nil {
+		t.Fatalf("get trigger failed: %v", err)
+	}
+
+	// the trigger is reused when the spec is not changed
+	if _, err = scaler.GetReplicas(gpa, 1); err != nil {
+		t.Fatalf("get replicas failed: %v", err)
+	}
+	reused, _ := triggerCache.get(gpa.Namespace, gpa.Name, 0, fakeTriggerSpec("1", "1"))
+	if reused != first {
+		t.Errorf("expect the trigger to be reused")
+	}
+
+	// the trigger is rebuilt when the spec changed, and the removed trigger is closed
+	second, _ := triggerCache.get(gpa.Namespace, gpa.Name, 1, fakeTriggerSpec("2", "1"))
+	scaler = NewEventScaler([]v1alpha1.ScaleTriggers{fakeTriggerSpec("3", "1")}, nil)
+	if _, err = scaler.GetReplicas(gpa, 1); err != nil {
+		t.Fatalf("get replicas failed: %v", err)
+	}
+	if !first.(*fakeTrigger).closed || !second.(*fakeTrigger).closed {
+		t.Errorf("expect the stale triggers to be closed")
+	}
+
+	// all triggers are closed after release
+	current, _ := triggerCache.get(gpa.Namespace, gpa.Name, 0, fakeTriggerSpec("3", "1"))
+	ReleaseTriggers(gpa.Namespace, gpa.Name)
+	if !current.(*fakeTrigger).closed {
+		t.Errorf("expect the trigger to be closed after release")
+	}
+	if _, ok := triggerCache.entries[gpa.Namespace+"/"+gpa.Name]; ok {
+		t.Errorf("expect the cache of gpa to be removed")
+	}
+}
+
+func Test_HTTPTriggers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Token") != "abc" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/query":
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector",` +
+				`"result":[{"metric":{},"value":[1700000000,"42.5"]}]}}`))
+		case "/queue":
+			_, _ = w.Write([]byte(`{"data":{"queues":[{"length":7},{"length":"13"}]}}`))
+		}
+	}))
+	defer server.Close()
+
+	for _, c := range []struct {
+		name        string
+		triggerType string
+		metadata    map[string]string
+		value       float64
+		hasErr      bool
+	}{
+		{
+			name:        "prometheus vector",
+			triggerType: PrometheusTrigger,
+			metadata:    map[string]string{"serverAddress": server.URL, "query": "up", "header.Token": "abc"},
+			value:       42.5,
+		},
+		{
+			name:        "http json number",
+			triggerType: HTTPJSONTrigger,
+			metadata: map[string]string{"url": server.URL + "/queue", "valueLocation": "data.queues.0.length",
+				"header.Token": "abc"},
+			value: 7,
+		},
+		{
+			name:        "http json string",
+			triggerType: HTTPJSONTrigger,
+			metadata: map[string]string{"url": server.URL + "/queue", "valueLocation": "data.queues.1.length",
+				"header.Token": "abc"},
+			value: 13,
+		},
+		{
+			name:        "http json field not found",
+			triggerType: HTTPJSONTrigger,
+			metadata: map[string]string{"url": server.URL + "/queue", "valueLocation": "data.queues.2.length",
+				"header.Token": "abc"},
+			hasErr: true,
+		},
+		{
+			name:        "bad status code",
+			triggerType: HTTPJSONTrigger,
+			metadata:    map[string]string{"url": server.URL + "/queue", "valueLocation": "data"},
+			hasErr:      true,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			builder, ok := GetTriggerBuilder(c.triggerType)
+			if !ok {
+				t.Fatalf("trigger %s not registered", c.triggerType)
+			}
+			trigger, err := builder("default", c.metadata)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer trigger.Close()
+			value, err := trigger.GetMetricValue(context.Background())
+			if (err != nil) != c.hasErr {
+				t.Fatalf("expect error %v, got %v", c.hasErr, err)
+			}
+			if value != c.value {
+				t.Errorf("expect value %v, get %v", c.value, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scalercore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	autoscalingv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+)
+
+const (
+	// KafkaTrigger scale by kafka consumer group lag
+	KafkaTrigger = "kafka"
+	// RedisTrigger scale by redis list length
+	RedisTrigger = "redis"
+	// PrometheusTrigger scale by prometheus query result
+	PrometheusTrigger = "prometheus"
+	// HTTPJSONTrigger scale by a numeric value of a http json response
+	HTTPJSONTrigger = "http-json"
+
+	// targetValueKey is the metadata key of the value one replica can handle
+	targetValueKey = "targetValue"
+	// activationValueKey is the metadata key of the value below which the trigger does not propose replicas
+	activationValueKey = "activationValue"
+	// timeoutKey is the metadata key of the trigger timeout, such as 10s
+	timeoutKey = "timeout"
+
+	defaultTriggerTimeout = 10 * time.Second
+)
+
+// Trigger is an external event source used by EventMode
+type Trigger interface {
+	// GetMetricValue returns the current value of the event source
+	GetMetricValue(ctx context.Context) (float64, error)
+	// Close releases the connections held by the trigger
+	Close() error
+}
+
+// TriggerBuilder builds a trigger from the namespace of the gpa and the trigger metadata
+type TriggerBuilder func(namespace string, metadata map[string]string) (Trigger, error)
+
+var (
+	triggerLock     sync.RWMutex
+	triggerBuilders = map[string]TriggerBuilder{}
+)
+
+func init() {
+	RegisterTrigger(KafkaTrigger, newKafkaTrigger)
+	RegisterTrigger(RedisTrigger, newRedisTrigger)
+	RegisterTrigger(PrometheusTrigger, newPrometheusTrigger)
+	RegisterTrigger(HTTPJSONTrigger, newHTTPJSONTrigger)
+}
+
+// RegisterTrigger registers a trigger builder for the trigger type, the later one overrides the former
+func RegisterTrigger(triggerType string, builder TriggerBuilder) {
+	triggerLock.Lock()
+	defer triggerLock.Unlock()
+	triggerBuilders[triggerType] = builder
+}
+
+// GetTriggerBuilder returns the trigger builder of the trigger type
+func GetTriggerBuilder(triggerType string) (TriggerBuilder, bool) {
+	triggerLock.RLock()
+	defer triggerLock.RUnlock()
+	builder, ok := triggerBuilders[triggerType]
+	return builder, ok
+}
+
+// SupportedTriggers returns all registered trigger types
+func SupportedTriggers() []string {
+	triggerLock.RLock()
+	defer triggerLock.RUnlock()
+	types := make([]string, 0, len(triggerBuilders))
+	for t := range triggerBuilders {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// triggerSpec is the common part of all trigger metadata
+type triggerSpec struct {
+	targetValue     float64
+	activationValue float64
+	timeout         time.Duration
+}
+
+// parseTriggerSpec parses the common metadata of a trigger
+func parseTriggerSpec(trigger autoscalingv1.ScaleTriggers) (*triggerSpec, error) {
+	spec := &triggerSpec{timeout: defaultTriggerTimeout}
+	target, ok := trigger.Metadata[targetValueKey]
+	if !ok {
+		return nil, fmt.Errorf("trigger %s metadata %s must be set", triggerName(trigger), targetValueKey)
+	}
+	v, err := strconv.ParseFloat(target, 64)
+	if err != nil {
+		return nil, fmt.Errorf("trigger %s metadata %s is invalid: %v", triggerName(trigger), targetValueKey, err)
+	}
+	if v <= 0 {
+		return nil, fmt.Errorf("trigger %s metadata %s must be greater than 0", triggerName(trigger), targetValueKey)
+	}
+	spec.targetValue = v
+	if activation, ok := trigger.Metadata[activationValueKey]; ok {
+		spec.activationValue, err = strconv.ParseFloat(activation, 64)
+		if err != nil {
+			return nil, fmt.Errorf("trigger %s metadata %s is invalid: %v", triggerName(trigger),
+				activationValueKey, err)
+		}
+	}
+	if timeout, ok := trigger.Metadata[timeoutKey]; ok {
+		spec.timeout, err = time.ParseDuration(timeout)
+		if err != nil {
+			return nil, fmt.Errorf("trigger %s metadata %s is invalid: %v", triggerName(trigger), timeoutKey, err)
+		}
+	}
+	return spec, nil
+}
+
+// ValidateTrigger checks whether the trigger type is registered and its metadata can be parsed
+func ValidateTrigger(trigger autoscalingv1.ScaleTriggers) error {
+	if _, ok := GetTriggerBuilder(trigger.Type); !ok {
+		return fmt.Errorf("trigger type %s is not supported, supported types: %v", trigger.Type, SupportedTriggers())
+	}
+	_, err := parseTriggerSpec(trigger)
+	return err
+}
+
+// triggerName returns the name of the trigger, type is used when name is empty
+func triggerName(trigger autoscalingv1.ScaleTriggers) string {
+	if trigger.Name != "" {
+		return trigger.Name
+	}
+	return trigger.Type
+}
+
+// getRequiredMetadata returns the value of the key, error if not found or empty
+func getRequiredMetadata(metadata map[string]string, key string) (string, error) {
+	v := metadata[key]
+	if v == "" {
+		return "", fmt.Errorf("metadata %s must be set", key)
+	}
+	return v, nil
+}
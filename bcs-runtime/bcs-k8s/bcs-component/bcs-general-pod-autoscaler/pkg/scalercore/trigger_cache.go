@@ -0,0 +1,124 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scalercore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/klog/v2"
+
+	autoscalingv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+)
+
+// triggers are cached per gpa and trigger index, so the kafka/redis connections are reused between reconciles
+var triggerCache = &cachedTriggers{entries: map[string]map[int]*cachedTrigger{}}
+
+type cachedTrigger struct {
+	fingerprint string
+	trigger     Trigger
+}
+
+type cachedTriggers struct {
+	sync.Mutex
+	// gpa key -> trigger index -> trigger
+	entries map[string]map[int]*cachedTrigger
+}
+
+// get returns the cached trigger, the trigger is rebuilt when its type or metadata changed
+func (c *cachedTriggers) get(namespace, name string, index int, trigger autoscalingv1.ScaleTriggers) (Trigger, error) {
+	key := namespace + "/" + name
+	fingerprint := triggerFingerprint(trigger)
+	c.Lock()
+	if cached, ok := c.entries[key][index]; ok && cached.fingerprint == fingerprint {
+		c.Unlock()
+		return cached.trigger, nil
+	}
+	c.Unlock()
+
+	builder, ok := GetTriggerBuilder(trigger.Type)
+	if !ok {
+		return nil, fmt.Errorf("trigger type %s is not supported", trigger.Type)
+	}
+	t, err := builder(namespace, trigger.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if c.entries[key] == nil {
+		c.entries[key] = map[int]*cachedTrigger{}
+	}
+	if old, ok := c.entries[key][index]; ok {
+		closeTrigger(key, old.trigger)
+	}
+	c.entries[key][index] = &cachedTrigger{fingerprint: fingerprint, trigger: t}
+	return t, nil
+}
+
+// evict closes and removes the trigger, it will be rebuilt in the next reconcile
+func (c *cachedTriggers) evict(namespace, name string, index int) {
+	key := namespace + "/" + name
+	c.Lock()
+	defer c.Unlock()
+	if old, ok := c.entries[key][index]; ok {
+		closeTrigger(key, old.trigger)
+		delete(c.entries[key], index)
+	}
+}
+
+// prune closes the triggers whose index is not less than num, for triggers removed from the gpa
+func (c *cachedTriggers) prune(namespace, name string, num int) {
+	key := namespace + "/" + name
+	c.Lock()
+	defer c.Unlock()
+	for index, old := range c.entries[key] {
+		if index >= num {
+			closeTrigger(key, old.trigger)
+			delete(c.entries[key], index)
+		}
+	}
+	if len(c.entries[key]) == 0 {
+		delete(c.entries, key)
+	}
+}
+
+// ReleaseTriggers closes all cached triggers of the gpa, should be called when the gpa is deleted
+// or its event mode is removed
+func ReleaseTriggers(namespace, name string) {
+	triggerCache.prune(namespace, name, 0)
+}
+
+func closeTrigger(key string, t Trigger) {
+	if err := t.Close(); err != nil {
+		klog.Warningf("close trigger of GPA %s failed: %v", key, err)
+	}
+}
+
+// triggerFingerprint returns the type and sorted metadata of the trigger
+func triggerFingerprint(trigger autoscalingv1.ScaleTriggers) string {
+	keys := make([]string, 0, len(trigger.Metadata))
+	for k := range trigger.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString(trigger.Type)
+	for _, k := range keys {
+		b.WriteString("\x00" + k + "=" + trigger.Metadata[k])
+	}
+	return b.String()
+}
@@ -0,0 +1,234 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scalercore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// headerPrefix is the metadata key prefix of the request headers, such as header.Authorization
+	headerPrefix = "header."
+)
+
+var triggerClient = &http.Client{}
+
+// prometheusTrigger scales by the result of a prometheus instant query
+// metadata:
+//   - serverAddress: prometheus address, such as http://prometheus.monitoring.svc:9090
+//   - query: the promql, it should return a single sample or a scalar
+//   - header.xxx: optional, request headers
+type prometheusTrigger struct {
+	address string
+	query   string
+	headers map[string]string
+}
+
+func newPrometheusTrigger(_ string, metadata map[string]string) (Trigger, error) {
+	address, err := getRequiredMetadata(metadata, "serverAddress")
+	if err != nil {
+		return nil, err
+	}
+	query, err := getRequiredMetadata(metadata, "query")
+	if err != nil {
+		return nil, err
+	}
+	return &prometheusTrigger{
+		address: strings.TrimSuffix(address, "/"),
+		query:   query,
+		headers: getHeaders(metadata),
+	}, nil
+}
+
+// prometheusResponse is the response of prometheus query api
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// GetMetricValue returns the value of the query result, empty result is treated as 0
+func (p *prometheusTrigger) GetMetricValue(ctx context.Context) (float64, error) {
+	u := fmt.Sprintf("%s/api/v1/query?query=%s", p.address, url.QueryEscape(p.query))
+	body, err := doTriggerRequest(ctx, u, p.headers)
+	if err != nil {
+		return 0, err
+	}
+	resp := &prometheusResponse{}
+	if err = json.Unmarshal(body, resp); err != nil {
+		return 0, fmt.Errorf("unmarshal prometheus response failed: %v", err)
+	}
+	if resp.Status != "success" {
+		return 0, fmt.Errorf("prometheus query failed: %s", resp.Error)
+	}
+
+	var sample []interface{}
+	switch resp.Data.ResultType {
+	case "scalar":
+		if err = json.Unmarshal(resp.Data.Result, &sample); err != nil {
+			return 0, fmt.Errorf("unmarshal prometheus scalar failed: %v", err)
+		}
+	case "vector":
+		vector := make([]struct {
+			Value []interface{} `json:"value"`
+		}, 0)
+		if err = json.Unmarshal(resp.Data.Result, &vector); err != nil {
+			return 0, fmt.Errorf("unmarshal prometheus vector failed: %v", err)
+		}
+		if len(vector) == 0 {
+			return 0, nil
+		}
+		if len(vector) > 1 {
+			return 0, fmt.Errorf("prometheus query returned %d samples, only one is allowed", len(vector))
+		}
+		sample = vector[0].Value
+	default:
+		return 0, fmt.Errorf("prometheus result type %s is not supported", resp.Data.ResultType)
+	}
+	if len(sample) != 2 {
+		return 0, fmt.Errorf("prometheus sample %v is invalid", sample)
+	}
+	return toFloat64(sample[1])
+}
+
+// Close do nothing
+func (p *prometheusTrigger) Close() error {
+	return nil
+}
+
+// httpJSONTrigger scales by a numeric field of a json http api
+// metadata:
+//   - url: the http api, it is requested by GET
+//   - valueLocation: the path of the field, separated by dot, such as data.queues.0.length
+//   - header.xxx: optional, request headers
+type httpJSONTrigger struct {
+	url           string
+	valueLocation []string
+	headers       map[string]string
+}
+
+func newHTTPJSONTrigger(_ string, metadata map[string]string) (Trigger, error) {
+	u, err := getRequiredMetadata(metadata, "url")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = url.ParseRequestURI(u); err != nil {
+		return nil, fmt.Errorf("metadata url is invalid: %v", err)
+	}
+	location, err := getRequiredMetadata(metadata, "valueLocation")
+	if err != nil {
+		return nil, err
+	}
+	return &httpJSONTrigger{
+		url:           u,
+		valueLocation: strings.Split(location, "."),
+		headers:       getHeaders(metadata),
+	}, nil
+}
+
+// GetMetricValue returns the value at valueLocation of the response
+func (h *httpJSONTrigger) GetMetricValue(ctx context.Context) (float64, error) {
+	body, err := doTriggerRequest(ctx, h.url, h.headers)
+	if err != nil {
+		return 0, err
+	}
+	var value interface{}
+	if err = json.Unmarshal(body, &value); err != nil {
+		return 0, fmt.Errorf("unmarshal response of %s failed: %v", h.url, err)
+	}
+	for _, key := range h.valueLocation {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			field, ok := v[key]
+			if !ok {
+				return 0, fmt.Errorf("field %s not found in response", strings.Join(h.valueLocation, "."))
+			}
+			value = field
+		case []interface{}:
+			index, ierr := strconv.Atoi(key)
+			if ierr != nil || index < 0 || index >= len(v) {
+				return 0, fmt.Errorf("index %s is invalid for array of length %d", key, len(v))
+			}
+			value = v[index]
+		default:
+			return 0, fmt.Errorf("field %s not found in response", strings.Join(h.valueLocation, "."))
+		}
+	}
+	return toFloat64(value)
+}
+
+// Close do nothing
+func (h *httpJSONTrigger) Close() error {
+	return nil
+}
+
+// doTriggerRequest sends a GET request and returns the body of a 200 response
+func doTriggerRequest(ctx context.Context, u string, headers map[string]string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := triggerClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad status code %d from the server: %s", resp.StatusCode, u)
+	}
+	return body, nil
+}
+
+// getHeaders returns the request headers from the metadata
+func getHeaders(metadata map[string]string) map[string]string {
+	headers := make(map[string]string)
+	for k, v := range metadata {
+		if strings.HasPrefix(k, headerPrefix) {
+			headers[strings.TrimPrefix(k, headerPrefix)] = v
+		}
+	}
+	return headers
+}
+
+// toFloat64 converts json number or numeric string to float64
+func toFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("value %s is not a number", v)
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("value %v is not a number", value)
+	}
+}
@@ -0,0 +1,161 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scalercore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// kafkaTrigger scales by the total lag of a consumer group on a topic
+// metadata:
+//   - bootstrapServers: kafka brokers, separated by comma
+//   - consumerGroup: the consumer group
+//   - topic: the topic consumed by the consumer group
+//   - version: optional, kafka version, such as 2.8.0
+//   - sasl/username/password: optional, sasl mechanism (plain, scram_sha256, scram_sha512) and its credential
+type kafkaTrigger struct {
+	client        sarama.Client
+	admin         sarama.ClusterAdmin
+	consumerGroup string
+	topic         string
+}
+
+func newKafkaTrigger(_ string, metadata map[string]string) (Trigger, error) {
+	servers, err := getRequiredMetadata(metadata, "bootstrapServers")
+	if err != nil {
+		return nil, err
+	}
+	group, err := getRequiredMetadata(metadata, "consumerGroup")
+	if err != nil {
+		return nil, err
+	}
+	topic, err := getRequiredMetadata(metadata, "topic")
+	if err != nil {
+		return nil, err
+	}
+
+	config := sarama.NewConfig()
+	// bound the broker requests by the trigger timeout, so requests abandoned by a cancelled context end in time
+	timeout := defaultTriggerTimeout
+	if v := metadata[timeoutKey]; v != "" {
+		if timeout, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("metadata %s is invalid: %v", timeoutKey, err)
+		}
+	}
+	config.Net.DialTimeout = timeout
+	config.Net.ReadTimeout = timeout
+	config.Net.WriteTimeout = timeout
+	if v := metadata["version"]; v != "" {
+		version, verr := sarama.ParseKafkaVersion(v)
+		if verr != nil {
+			return nil, fmt.Errorf("metadata version is invalid: %v", verr)
+		}
+		config.Version = version
+	}
+	if mechanism := metadata["sasl"]; mechanism != "" {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.User = metadata["username"]
+		config.Net.SASL.Password = metadata["password"]
+		switch strings.ToLower(mechanism) {
+		case "plain":
+			config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		case "scram_sha256":
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+		case "scram_sha512":
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+		default:
+			return nil, fmt.Errorf("metadata sasl %s is not supported", mechanism)
+		}
+	}
+
+	client, err := sarama.NewClient(strings.Split(servers, ","), config)
+	if err != nil {
+		return nil, fmt.Errorf("create kafka client failed: %v", err)
+	}
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("create kafka cluster admin failed: %v", err)
+	}
+	return &kafkaTrigger{client: client, admin: admin, consumerGroup: group, topic: topic}, nil
+}
+
+// GetMetricValue returns the sum of the lag of all partitions.
+// sarama does not accept context, so the broker requests run in a goroutine and ctx bounds the wait.
+func (k *kafkaTrigger) GetMetricValue(ctx context.Context) (float64, error) {
+	type lagResult struct {
+		lag int64
+		err error
+	}
+	ch := make(chan lagResult, 1)
+	go func() {
+		lag, err := k.getTotalLag(ctx)
+		ch <- lagResult{lag: lag, err: err}
+	}()
+	select {
+	case <-ctx.Done():
+		return 0, fmt.Errorf("get lag of consumer group %s failed: %v", k.consumerGroup, ctx.Err())
+	case r := <-ch:
+		return float64(r.lag), r.err
+	}
+}
+
+// getTotalLag sums the lag of all partitions, stops early when ctx is done
+func (k *kafkaTrigger) getTotalLag(ctx context.Context) (int64, error) {
+	partitions, err := k.client.Partitions(k.topic)
+	if err != nil {
+		return 0, fmt.Errorf("get partitions of topic %s failed: %v", k.topic, err)
+	}
+	offsets, err := k.admin.ListConsumerGroupOffsets(k.consumerGroup, map[string][]int32{k.topic: partitions})
+	if err != nil {
+		return 0, fmt.Errorf("list offsets of consumer group %s failed: %v", k.consumerGroup, err)
+	}
+	if offsets.Err != sarama.ErrNoError {
+		return 0, fmt.Errorf("list offsets of consumer group %s failed: %v", k.consumerGroup, offsets.Err)
+	}
+
+	var total int64
+	for _, partition := range partitions {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		latest, err := k.client.GetOffset(k.topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			return 0, fmt.Errorf("get latest offset of %s/%d failed: %v", k.topic, partition, err)
+		}
+		block := offsets.GetBlock(k.topic, partition)
+		// the consumer group has not committed on this partition, count all messages as lag
+		if block == nil || block.Offset < 0 {
+			oldest, err := k.client.GetOffset(k.topic, partition, sarama.OffsetOldest)
+			if err != nil {
+				return 0, fmt.Errorf("get oldest offset of %s/%d failed: %v", k.topic, partition, err)
+			}
+			total += latest - oldest
+			continue
+		}
+		if lag := latest - block.Offset; lag > 0 {
+			total += lag
+		}
+	}
+	return total, nil
+}
+
+// Close closes the admin and the underlying client
+func (k *kafkaTrigger) Close() error {
+	return k.admin.Close()
+}
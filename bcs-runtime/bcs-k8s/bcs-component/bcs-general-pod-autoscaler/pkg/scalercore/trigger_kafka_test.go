@@ -0,0 +1,48 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scalercore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// blockingKafkaClient blocks on Partitions until released, methods not used are not implemented
+type blockingKafkaClient struct {
+	sarama.Client
+	release chan struct{}
+}
+
+func (c *blockingKafkaClient) Partitions(string) ([]int32, error) {
+	<-c.release
+	return nil, nil
+}
+
+func TestKafkaTriggerTimeout(t *testing.T) {
+	client := &blockingKafkaClient{release: make(chan struct{})}
+	defer close(client.release)
+	trigger := &kafkaTrigger{client: client, consumerGroup: "group", topic: "topic"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	started := time.Now()
+	if _, err := trigger.GetMetricValue(ctx); err == nil {
+		t.Fatalf("expect timeout error")
+	}
+	if cost := time.Since(started); cost > time.Second {
+		t.Fatalf("expect GetMetricValue to return when ctx is done, cost %s", cost)
+	}
+}
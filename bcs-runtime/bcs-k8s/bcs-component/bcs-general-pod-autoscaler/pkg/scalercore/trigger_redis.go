@@ -0,0 +1,71 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scalercore
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisTrigger scales by the length of a redis list
+// metadata:
+//   - address: redis address, such as redis.default.svc:6379
+//   - listName: the list key
+//   - database: optional, the redis db, default 0
+//   - username/password: optional, the redis credential
+type redisTrigger struct {
+	client   *redis.Client
+	listName string
+}
+
+func newRedisTrigger(_ string, metadata map[string]string) (Trigger, error) {
+	address, err := getRequiredMetadata(metadata, "address")
+	if err != nil {
+		return nil, err
+	}
+	listName, err := getRequiredMetadata(metadata, "listName")
+	if err != nil {
+		return nil, err
+	}
+	db := 0
+	if v := metadata["database"]; v != "" {
+		db, err = strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("metadata database is invalid: %v", err)
+		}
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr:     address,
+		Username: metadata["username"],
+		Password: metadata["password"],
+		DB:       db,
+	})
+	return &redisTrigger{client: client, listName: listName}, nil
+}
+
+// GetMetricValue returns the length of the list
+func (r *redisTrigger) GetMetricValue(ctx context.Context) (float64, error) {
+	length, err := r.client.LLen(ctx, r.listName).Result()
+	if err != nil {
+		return 0, fmt.Errorf("get length of list %s failed: %v", r.listName, err)
+	}
+	return float64(length), nil
+}
+
+// Close closes the redis client
+func (r *redisTrigger) Close() error {
+	return r.client.Close()
+}
@@ -27,6 +27,7 @@ import (
 	apivalidation "k8s.io/kubernetes/pkg/apis/core/validation"
 
 	autoscaling "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/scalercore"
 )
 
 const (
@@ -249,6 +250,10 @@ func validateEvent(triggers []autoscaling.ScaleTriggers, fldPath *field.Path) fi
 		}
 		if len(trigger.Metadata) == 0 {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("medadata"), "trigger medadata must set"))
+			continue
+		}
+		if err := scalercore.ValidateTrigger(trigger); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("triggers"), trigger.Type, err.Error()))
 		}
 	}
 	return allErrs
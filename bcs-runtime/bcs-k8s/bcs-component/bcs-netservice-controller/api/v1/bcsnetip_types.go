@@ -13,11 +13,24 @@
 package v1
 
 import (
+	"fmt"
+	"net"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// IPFamilyV4 IPv4 地址族, 与 CNI 结果中的 version 一致
+	IPFamilyV4 = "4"
+	// IPFamilyV6 IPv6 地址族
+	IPFamilyV6 = "6"
+)
+
 // BCSNetIPSpec defines the desired state of BCSNetIP
 type BCSNetIPSpec struct {
+	// IP地址, IPv6 地址不能直接作为资源名称, 以此字段为准
+	IP string `json:"ip,omitempty"`
 	// 所属网段
 	Net string `json:"net"`
 	// 网段掩码
@@ -66,6 +79,43 @@ type BCSNetIPList struct {
 	Items           []BCSNetIP `json:"items"`
 }
 
+// GetIP 返回IP地址, 兼容未设置 spec.ip 的历史数据
+func (ip *BCSNetIP) GetIP() string {
+	if ip.Spec.IP != "" {
+		return ip.Spec.IP
+	}
+	return ip.Name
+}
+
+// GetIPFamily 返回IP地址族, 非法地址返回空
+func GetIPFamily(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ""
+	}
+	if parsed.To4() != nil {
+		return IPFamilyV4
+	}
+	return IPFamilyV6
+}
+
+// GetNetIPName 返回IP对应的 BCSNetIP 资源名称
+// IPv4 直接使用地址, IPv6 使用完整展开形式并将 : 替换为 -, 如 fd00-0000-0000-0000-0000-0000-0000-0001
+func GetNetIPName(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ip
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		return v4.String()
+	}
+	segments := make([]string, 0, net.IPv6len/2)
+	for i := 0; i < net.IPv6len; i += 2 {
+		segments = append(segments, fmt.Sprintf("%02x%02x", parsed[i], parsed[i+1]))
+	}
+	return strings.Join(segments, "-")
+}
+
 func init() {
 	SchemeBuilder.Register(&BCSNetIP{}, &BCSNetIPList{})
 }
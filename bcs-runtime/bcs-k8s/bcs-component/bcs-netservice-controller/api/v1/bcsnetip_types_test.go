@@ -0,0 +1,36 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import "testing"
+
+func TestGetNetIPName(t *testing.T) {
+	tests := []struct {
+		name string
+		ip   string
+		want string
+	}{
+		{name: "ipv4", ip: "10.0.0.1", want: "10.0.0.1"},
+		{name: "ipv4 mapped ipv6", ip: "::ffff:10.0.0.1", want: "10.0.0.1"},
+		{name: "ipv6 short", ip: "fd00::1", want: "fd00-0000-0000-0000-0000-0000-0000-0001"},
+		{name: "ipv6 full", ip: "FD00:0:0:0:0:0:0:1", want: "fd00-0000-0000-0000-0000-0000-0000-0001"},
+		{name: "invalid", ip: "not-an-ip", want: "not-an-ip"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetNetIPName(tt.ip); got != tt.want {
+				t.Errorf("GetNetIPName(%s) = %s, want %s", tt.ip, got, tt.want)
+			}
+		})
+	}
+}
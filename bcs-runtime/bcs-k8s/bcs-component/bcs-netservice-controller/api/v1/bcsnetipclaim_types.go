@@ -28,6 +28,8 @@ type BCSNetIPClaimSpec struct {
 type BCSNetIPClaimStatus struct {
 	// BCSNetIPName is name for BCSNetIP bounded with this claim
 	BoundedIP string `json:"boundedIP"`
+	// DualStackBoundedIP is name for BCSNetIP of the other ip family bounded with this claim in dual-stack pool
+	DualStackBoundedIP string `json:"dualStackBoundedIP,omitempty"`
 	// Phase represents the state of this claim
 	Phase string `json:"phase,omitempty"`
 }
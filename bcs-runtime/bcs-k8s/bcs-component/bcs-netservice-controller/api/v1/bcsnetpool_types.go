@@ -18,7 +18,7 @@ import (
 
 // BCSNetPoolSpec defines the desired state of BCSNetPool
 type BCSNetPoolSpec struct {
-	// 网段
+	// 网段, 支持 IPv4 或 IPv6
 	Net string `json:"net"`
 	// 网段掩码
 	Mask int `json:"mask"`
@@ -28,6 +28,43 @@ type BCSNetPoolSpec struct {
 	Hosts []string `json:"hosts,omitempty"`
 	// 可用的IP
 	AvailableIPs []string `json:"availableIPs,omitempty"`
+	// 双栈网段池中另一地址族的网段, 地址族需与 Net 不同, 每个 Pod 会同时分配两个地址族的IP
+	DualStack *BCSNetPoolSubnet `json:"dualStack,omitempty"`
+}
+
+// BCSNetPoolSubnet defines one subnet of BCSNetPool
+type BCSNetPoolSubnet struct {
+	// 网段
+	Net string `json:"net"`
+	// 网段掩码
+	Mask int `json:"mask"`
+	// 网段网关
+	Gateway string `json:"gateway"`
+	// 可用的IP
+	AvailableIPs []string `json:"availableIPs,omitempty"`
+}
+
+// Subnets 返回网段池的所有网段, 第一个为主网段
+func (s *BCSNetPoolSpec) Subnets() []BCSNetPoolSubnet {
+	subnets := []BCSNetPoolSubnet{{
+		Net:          s.Net,
+		Mask:         s.Mask,
+		Gateway:      s.Gateway,
+		AvailableIPs: s.AvailableIPs,
+	}}
+	if s.DualStack != nil {
+		subnets = append(subnets, *s.DualStack)
+	}
+	return subnets
+}
+
+// AllocatableNum 可分配的 Pod 数量, 双栈网段池取两个地址族的较小值
+func (s *BCSNetPoolSpec) AllocatableNum() int {
+	num := len(s.AvailableIPs)
+	if s.DualStack != nil && len(s.DualStack.AvailableIPs) < num {
+		num = len(s.DualStack.AvailableIPs)
+	}
+	return num
 }
 
 // BCSNetPoolStatus defines the observed state of BCSNetPool
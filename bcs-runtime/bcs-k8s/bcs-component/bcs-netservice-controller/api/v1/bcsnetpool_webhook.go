@@ -67,16 +67,11 @@ func (c *bcsNetPoolClient) ValidateCreate(ctx context.Context, obj runtime.Objec
 	}
 
 	blog.Infof("validate create pool %s", pool.Name)
-	if net.ParseIP(pool.Spec.Net) == nil {
-		return nil, fmt.Errorf("spec.net %s is not valid when creating bcsnetpool %s", pool.Spec.Net, pool.Name)
+	if err := validatePoolSpec(&pool.Spec); err != nil {
+		return nil, fmt.Errorf("%s when creating bcsnetpool %s", err.Error(), pool.Name)
 	}
-	if net.ParseIP(pool.Spec.Gateway) == nil {
-		return nil, fmt.Errorf("spec.gateway is not valid %s when creating bcsnetpool %s", pool.Spec.Gateway, pool.Name)
-	}
-	for _, ip := range pool.Spec.AvailableIPs {
-		if net.ParseIP(ip) == nil {
-			return nil, fmt.Errorf("%s in spec.availableIPs is not valid when creating bcsnetpool %s", ip, pool.Name)
-		}
+	if err := c.checkPoolOverlap(ctx, pool); err != nil {
+		return nil, err
 	}
 	return nil, nil
 }
@@ -94,28 +89,27 @@ func (c *bcsNetPoolClient) ValidateUpdate(ctx context.Context, oldObj runtime.Ob
 	}
 
 	blog.Infof("validate update pool %s", pool.Name)
-	if net.ParseIP(pool.Spec.Net) == nil {
-		return nil, fmt.Errorf("spec.net %s is not valid when updating bcsnetpool %s", pool.Spec.Net, pool.Name)
+	if err := validatePoolSpec(&pool.Spec); err != nil {
+		return nil, fmt.Errorf("%s when updating bcsnetpool %s", err.Error(), pool.Name)
 	}
-	if net.ParseIP(pool.Spec.Gateway) == nil {
-		return nil, fmt.Errorf("spec.gateway is not valid %s when updating bcsnetpool %s", pool.Spec.Gateway, pool.Name)
-	}
-	for _, ip := range pool.Spec.AvailableIPs {
-		if net.ParseIP(ip) == nil {
-			return nil, fmt.Errorf("%s in spec.availableIPs is not valid when updating bcsnetpool %s", ip, pool.Name)
-		}
+	if err := c.checkPoolOverlap(ctx, pool); err != nil {
+		return nil, err
 	}
 
-	// 找出更新操作中要删除的已存在的IP
+	// 找出更新操作中要删除的已存在的IP, 包括双栈网段中的IP
 	var delIPList []string
 	newIPMap := make(map[string]bool)
-	for _, v := range pool.Spec.AvailableIPs {
-		newIPMap[v] = true
+	for _, subnet := range pool.Spec.Subnets() {
+		for _, v := range subnet.AvailableIPs {
+			newIPMap[GetNetIPName(v)] = true
+		}
 	}
 
-	for _, v := range oldPool.Spec.AvailableIPs {
-		if _, exists := newIPMap[v]; !exists {
-			delIPList = append(delIPList, v)
+	for _, subnet := range oldPool.Spec.Subnets() {
+		for _, v := range subnet.AvailableIPs {
+			if _, exists := newIPMap[GetNetIPName(v)]; !exists {
+				delIPList = append(delIPList, v)
+			}
 		}
 	}
 
@@ -134,7 +128,7 @@ func (c *bcsNetPoolClient) ValidateDelete(ctx context.Context, obj runtime.Objec
 func (c *bcsNetPoolClient) checkActiveIP(ctx context.Context, s []string, pool *BCSNetPool) error {
 	for _, ip := range s {
 		netIP := &BCSNetIP{}
-		if err := c.client.Get(ctx, types.NamespacedName{Name: ip}, netIP); err != nil {
+		if err := c.client.Get(ctx, types.NamespacedName{Name: GetNetIPName(ip)}, netIP); err != nil {
 			if k8serrors.IsNotFound(err) {
 				blog.Warnf("BCSNetIP %s missing in pool %s", ip, pool.Name)
 				continue
@@ -147,3 +141,107 @@ func (c *bcsNetPoolClient) checkActiveIP(ctx context.Context, s []string, pool *
 	}
 	return nil
 }
+
+// validatePoolSpec 校验网段池各网段的地址族, 双栈网段池的两个网段地址族必须不同
+func validatePoolSpec(spec *BCSNetPoolSpec) error {
+	subnets := spec.Subnets()
+	fields := []string{"spec", "spec.dualStack"}
+	for i, subnet := range subnets {
+		if err := validateSubnet(fields[i], &subnet); err != nil {
+			return err
+		}
+	}
+	if len(subnets) > 1 && GetIPFamily(subnets[0].Net) == GetIPFamily(subnets[1].Net) {
+		return fmt.Errorf("spec.dualStack.net %s should not be the same ip family as spec.net %s",
+			subnets[1].Net, subnets[0].Net)
+	}
+	return nil
+}
+
+// validateSubnet 校验网段, 网关和可用IP的地址族需与网段一致, 可用IP需在网段内且不能重复
+func validateSubnet(field string, subnet *BCSNetPoolSubnet) error {
+	family := GetIPFamily(subnet.Net)
+	if family == "" {
+		return fmt.Errorf("%s.net %s is not valid", field, subnet.Net)
+	}
+	maxMask := 32
+	if family == IPFamilyV6 {
+		maxMask = 128
+	}
+	if subnet.Mask <= 0 || subnet.Mask > maxMask {
+		return fmt.Errorf("%s.mask %d is not valid for ipv%s", field, subnet.Mask, family)
+	}
+	if GetIPFamily(subnet.Gateway) != family {
+		return fmt.Errorf("%s.gateway %s is not valid for ipv%s", field, subnet.Gateway, family)
+	}
+
+	ipNet := &net.IPNet{IP: net.ParseIP(subnet.Net), Mask: net.CIDRMask(subnet.Mask, maxMask)}
+	ipSet := make(map[string]struct{}, len(subnet.AvailableIPs))
+	for _, ip := range subnet.AvailableIPs {
+		if GetIPFamily(ip) != family {
+			return fmt.Errorf("%s in %s.availableIPs is not valid for ipv%s", ip, field, family)
+		}
+		if !ipNet.Contains(net.ParseIP(ip)) {
+			return fmt.Errorf("%s in %s.availableIPs is not in net %s/%d", ip, field, subnet.Net, subnet.Mask)
+		}
+		name := GetNetIPName(ip)
+		if _, ok := ipSet[name]; ok {
+			return fmt.Errorf("%s in %s.availableIPs is duplicated", ip, field)
+		}
+		ipSet[name] = struct{}{}
+	}
+	return nil
+}
+
+// checkPoolOverlap 校验与其他网段池的冲突, 同一IP不能属于多个网段池, 同地址族网段不能部分重叠
+func (c *bcsNetPoolClient) checkPoolOverlap(ctx context.Context, pool *BCSNetPool) error {
+	poolList := &BCSNetPoolList{}
+	if err := c.client.List(ctx, poolList); err != nil {
+		return fmt.Errorf("list bcsnetpool failed, %s", err.Error())
+	}
+
+	ipOwners := make(map[string]string)
+	for _, other := range poolList.Items {
+		if other.Name == pool.Name {
+			continue
+		}
+		for _, otherSubnet := range other.Spec.Subnets() {
+			for _, ip := range otherSubnet.AvailableIPs {
+				ipOwners[GetNetIPName(ip)] = other.Name
+			}
+			for _, subnet := range pool.Spec.Subnets() {
+				if subnetPartialOverlap(&subnet, &otherSubnet) {
+					return fmt.Errorf("net %s/%d of bcsnetpool %s overlaps with net %s/%d of bcsnetpool %s",
+						subnet.Net, subnet.Mask, pool.Name, otherSubnet.Net, otherSubnet.Mask, other.Name)
+				}
+			}
+		}
+	}
+
+	for _, subnet := range pool.Spec.Subnets() {
+		for _, ip := range subnet.AvailableIPs {
+			if owner, ok := ipOwners[GetNetIPName(ip)]; ok {
+				return fmt.Errorf("ip %s of bcsnetpool %s already exists in bcsnetpool %s", ip, pool.Name, owner)
+			}
+		}
+	}
+	return nil
+}
+
+// subnetPartialOverlap 同地址族的两个网段是否部分重叠, 完全相同的网段允许分配给不同主机
+func subnetPartialOverlap(a, b *BCSNetPoolSubnet) bool {
+	family := GetIPFamily(a.Net)
+	if family == "" || family != GetIPFamily(b.Net) {
+		return false
+	}
+	bits := 32
+	if family == IPFamilyV6 {
+		bits = 128
+	}
+	netA := &net.IPNet{IP: net.ParseIP(a.Net).Mask(net.CIDRMask(a.Mask, bits)), Mask: net.CIDRMask(a.Mask, bits)}
+	netB := &net.IPNet{IP: net.ParseIP(b.Net).Mask(net.CIDRMask(b.Mask, bits)), Mask: net.CIDRMask(b.Mask, bits)}
+	if a.Mask == b.Mask && netA.IP.Equal(netB.IP) {
+		return false
+	}
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP)
+}
@@ -0,0 +1,143 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import "testing"
+
+func TestValidateSubnet(t *testing.T) {
+	tests := []struct {
+		name    string
+		subnet  BCSNetPoolSubnet
+		wantErr bool
+	}{
+		{
+			name:   "ipv4",
+			subnet: BCSNetPoolSubnet{Net: "10.0.0.0", Mask: 24, Gateway: "10.0.0.1", AvailableIPs: []string{"10.0.0.2"}},
+		},
+		{
+			name:   "ipv6",
+			subnet: BCSNetPoolSubnet{Net: "fd00::", Mask: 64, Gateway: "fd00::1", AvailableIPs: []string{"fd00::2"}},
+		},
+		{
+			name:    "invalid net",
+			subnet:  BCSNetPoolSubnet{Net: "10.0.0", Mask: 24, Gateway: "10.0.0.1"},
+			wantErr: true,
+		},
+		{
+			name:    "ipv4 mask too large",
+			subnet:  BCSNetPoolSubnet{Net: "10.0.0.0", Mask: 33, Gateway: "10.0.0.1"},
+			wantErr: true,
+		},
+		{
+			name:    "zero mask",
+			subnet:  BCSNetPoolSubnet{Net: "fd00::", Mask: 0, Gateway: "fd00::1"},
+			wantErr: true,
+		},
+		{
+			name:    "gateway family mismatch",
+			subnet:  BCSNetPoolSubnet{Net: "fd00::", Mask: 64, Gateway: "10.0.0.1"},
+			wantErr: true,
+		},
+		{
+			name:    "available ip family mismatch",
+			subnet:  BCSNetPoolSubnet{Net: "10.0.0.0", Mask: 24, Gateway: "10.0.0.1", AvailableIPs: []string{"fd00::2"}},
+			wantErr: true,
+		},
+		{
+			name:    "available ip out of net",
+			subnet:  BCSNetPoolSubnet{Net: "10.0.0.0", Mask: 24, Gateway: "10.0.0.1", AvailableIPs: []string{"10.0.1.2"}},
+			wantErr: true,
+		},
+		{
+			name: "duplicated ipv6 in different forms",
+			subnet: BCSNetPoolSubnet{Net: "fd00::", Mask: 64, Gateway: "fd00::1",
+				AvailableIPs: []string{"fd00::2", "fd00:0:0:0:0:0:0:2"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateSubnet("spec", &tt.subnet); (err != nil) != tt.wantErr {
+				t.Errorf("validateSubnet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSubnetPartialOverlap(t *testing.T) {
+	tests := []struct {
+		name string
+		a    BCSNetPoolSubnet
+		b    BCSNetPoolSubnet
+		want bool
+	}{
+		{
+			name: "same ipv4 net",
+			a:    BCSNetPoolSubnet{Net: "10.0.0.0", Mask: 24},
+			b:    BCSNetPoolSubnet{Net: "10.0.0.0", Mask: 24},
+			want: false,
+		},
+		{
+			name: "same net with host bits",
+			a:    BCSNetPoolSubnet{Net: "10.0.0.0", Mask: 24},
+			b:    BCSNetPoolSubnet{Net: "10.0.0.5", Mask: 24},
+			want: false,
+		},
+		{
+			name: "ipv4 contains",
+			a:    BCSNetPoolSubnet{Net: "10.0.0.0", Mask: 16},
+			b:    BCSNetPoolSubnet{Net: "10.0.1.0", Mask: 24},
+			want: true,
+		},
+		{
+			name: "ipv4 disjoint",
+			a:    BCSNetPoolSubnet{Net: "10.0.0.0", Mask: 24},
+			b:    BCSNetPoolSubnet{Net: "10.0.1.0", Mask: 24},
+			want: false,
+		},
+		{
+			name: "ipv6 contains",
+			a:    BCSNetPoolSubnet{Net: "fd00::", Mask: 48},
+			b:    BCSNetPoolSubnet{Net: "fd00:0:0:1::", Mask: 64},
+			want: true,
+		},
+		{
+			name: "ipv6 disjoint",
+			a:    BCSNetPoolSubnet{Net: "fd00::", Mask: 64},
+			b:    BCSNetPoolSubnet{Net: "fd01::", Mask: 64},
+			want: false,
+		},
+		{
+			name: "different family",
+			a:    BCSNetPoolSubnet{Net: "10.0.0.0", Mask: 8},
+			b:    BCSNetPoolSubnet{Net: "fd00::", Mask: 64},
+			want: false,
+		},
+		{
+			name: "invalid net",
+			a:    BCSNetPoolSubnet{Net: "invalid", Mask: 8},
+			b:    BCSNetPoolSubnet{Net: "10.0.0.0", Mask: 24},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := subnetPartialOverlap(&tt.a, &tt.b); got != tt.want {
+				t.Errorf("subnetPartialOverlap() = %v, want %v", got, tt.want)
+			}
+			if got := subnetPartialOverlap(&tt.b, &tt.a); got != tt.want {
+				t.Errorf("subnetPartialOverlap() reversed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
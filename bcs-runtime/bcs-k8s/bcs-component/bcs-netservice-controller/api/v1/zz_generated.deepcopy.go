@@ -272,6 +272,11 @@ func (in *BCSNetPoolSpec) DeepCopyInto(out *BCSNetPoolSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DualStack != nil {
+		in, out := &in.DualStack, &out.DualStack
+		*out = new(BCSNetPoolSubnet)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BCSNetPoolSpec.
@@ -299,3 +304,23 @@ func (in *BCSNetPoolStatus) DeepCopy() *BCSNetPoolStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BCSNetPoolSubnet) DeepCopyInto(out *BCSNetPoolSubnet) {
+	*out = *in
+	if in.AvailableIPs != nil {
+		in, out := &in.AvailableIPs, &out.AvailableIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BCSNetPoolSubnet.
+func (in *BCSNetPoolSubnet) DeepCopy() *BCSNetPoolSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(BCSNetPoolSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
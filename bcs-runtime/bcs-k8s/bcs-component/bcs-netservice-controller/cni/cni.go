@@ -29,6 +29,7 @@ import (
 	"github.com/containernetworking/plugins/pkg/utils/hwaddr"
 	"github.com/pkg/errors"
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-netservice-controller/cni/logging"
 	cnitypes "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-netservice-controller/cni/types"
@@ -107,13 +108,18 @@ func setupVeth(netns ns.NetNS, ifName string) (*current.Interface, *current.Inte
 	return hostIface, contIface, nil
 }
 
-func configIface(netns ns.NetNS, hostIface *current.Interface, contIface *current.Interface, ipv4Addr net.IP, generateStaticVethHostMac bool) error {
+// configIface 配置 veth 地址及路由, ipv4Addr 和 ipv6Addr 至少有一个不为空, 双栈时同时配置
+func configIface(netns ns.NetNS, hostIface *current.Interface, contIface *current.Interface,
+	ipv4Addr, ipv6Addr net.IP, generateStaticVethHostMac bool) error {
 	hostVeth, err := netlink.LinkByName(hostIface.Name)
 	if err != nil {
 		return errors.Wrapf(err, "failed get link %s", hostIface.Name)
 	}
 
 	if generateStaticVethHostMac {
+		if ipv4Addr == nil {
+			return errors.New("generateStaticVethHostMac requires ipv4 address")
+		}
 		var hwAddr net.HardwareAddr
 		// 根据ip 设置一个固定mac地址(kubevirt bridge 网络模式下需要)
 		hwAddr, err = hwaddr.GenerateHardwareAddr4(ipv4Addr, hwaddr.PrivateMACPrefix)
@@ -131,14 +137,16 @@ func configIface(netns ns.NetNS, hostIface *current.Interface, contIface *curren
 		return errors.Wrapf(err, "failed to set link %q up", hostIface.Name)
 	}
 	// Add host route
-	addrHostAddr := &net.IPNet{
-		IP:   ipv4Addr,
-		Mask: net.CIDRMask(32, 32)}
-	if err = netlink.RouteReplace(&netlink.Route{
-		LinkIndex: hostVeth.Attrs().Index,
-		Scope:     netlink.SCOPE_LINK,
-		Dst:       addrHostAddr}); err != nil {
-		return errors.Wrap(err, "failed to add host route")
+	for _, addr := range []net.IP{ipv4Addr, ipv6Addr} {
+		if addr == nil {
+			continue
+		}
+		if err = netlink.RouteReplace(&netlink.Route{
+			LinkIndex: hostVeth.Attrs().Index,
+			Scope:     netlink.SCOPE_LINK,
+			Dst:       netlink.NewIPNet(addr)}); err != nil {
+			return errors.Wrap(err, "failed to add host route")
+		}
 	}
 
 	return netns.Do(func(_ ns.NetNS) error {
@@ -151,49 +159,102 @@ func configIface(netns ns.NetNS, hostIface *current.Interface, contIface *curren
 			return errors.Wrapf(err, "setup NS network: failed to seti link %q up", contIface.Name)
 		}
 
-		if err = netlink.AddrAdd(contVeth, &netlink.Addr{IPNet: netlink.NewIPNet(ipv4Addr)}); err != nil {
-			return errors.Wrapf(err, "setup NS network: failed to add IP addr to %q", contIface.Name)
+		if ipv4Addr != nil {
+			if err = configContainerIPv4(contVeth, hostVeth, ipv4Addr); err != nil {
+				return err
+			}
 		}
-
-		// Add a connected route to a dummy next hop (169.254.1.1)
-		// # ip route show
-		// default via 169.254.1.1 dev eth0  src 10.0.32.140
-		// 169.254.1.1 dev eth0  scope link
-		gw := net.IPv4(169, 254, 1, 1)
-		if err = netlink.RouteAdd(&netlink.Route{
-			LinkIndex: contVeth.Attrs().Index,
-			Scope:     netlink.SCOPE_LINK,
-			Dst:       netlink.NewIPNet(gw),
-		}); err != nil {
-			return errors.Wrap(err, "setup NS network: failed to direct route")
+		if ipv6Addr != nil {
+			if err = configContainerIPv6(contVeth, hostVeth, ipv6Addr); err != nil {
+				return err
+			}
 		}
+		return nil
+	})
+}
 
-		defaultRoute := netlink.Route{
-			LinkIndex: contVeth.Attrs().Index,
-			Dst:       &net.IPNet{IP: net.IPv4zero, Mask: net.CIDRMask(0, 32)},
-			Scope:     netlink.SCOPE_UNIVERSE,
-			Gw:        gw,
-			Src:       ipv4Addr,
-		}
+// configContainerIPv4 配置容器内 ipv4 地址, 默认路由及网关静态 ARP
+func configContainerIPv4(contVeth, hostVeth netlink.Link, ipv4Addr net.IP) error {
+	if err := netlink.AddrAdd(contVeth, &netlink.Addr{IPNet: netlink.NewIPNet(ipv4Addr)}); err != nil {
+		return errors.Wrapf(err, "setup NS network: failed to add IP addr to %q", contVeth.Attrs().Name)
+	}
 
-		if err = netlink.RouteReplace(&defaultRoute); err != nil {
-			return errors.Wrap(err, "setup NS network: failed to add default gateway")
-		}
+	// Add a connected route to a dummy next hop (169.254.1.1)
+	// # ip route show
+	// default via 169.254.1.1 dev eth0  src 10.0.32.140
+	// 169.254.1.1 dev eth0  scope link
+	gw := net.IPv4(169, 254, 1, 1)
+	if err := netlink.RouteAdd(&netlink.Route{
+		LinkIndex: contVeth.Attrs().Index,
+		Scope:     netlink.SCOPE_LINK,
+		Dst:       netlink.NewIPNet(gw),
+	}); err != nil {
+		return errors.Wrap(err, "setup NS network: failed to direct route")
+	}
 
-		// add static ARP entry for default gateway
-		// we are using routed mode on the host and container need this static ARP entry to resolve its default gateway.
-		neigh := &netlink.Neigh{
-			LinkIndex:    contVeth.Attrs().Index,
-			State:        netlink.NUD_PERMANENT,
-			IP:           gw,
-			HardwareAddr: hostVeth.Attrs().HardwareAddr,
-		}
+	defaultRoute := netlink.Route{
+		LinkIndex: contVeth.Attrs().Index,
+		Dst:       &net.IPNet{IP: net.IPv4zero, Mask: net.CIDRMask(0, 32)},
+		Scope:     netlink.SCOPE_UNIVERSE,
+		Gw:        gw,
+		Src:       ipv4Addr,
+	}
 
-		if err = netlink.NeighAdd(neigh); err != nil {
-			return errors.Wrap(err, "setup NS network: failed to add static ARP")
-		}
-		return nil
-	})
+	if err := netlink.RouteReplace(&defaultRoute); err != nil {
+		return errors.Wrap(err, "setup NS network: failed to add default gateway")
+	}
+
+	// add static ARP entry for default gateway
+	// we are using routed mode on the host and container need this static ARP entry to resolve its default gateway.
+	neigh := &netlink.Neigh{
+		LinkIndex:    contVeth.Attrs().Index,
+		State:        netlink.NUD_PERMANENT,
+		IP:           gw,
+		HardwareAddr: hostVeth.Attrs().HardwareAddr,
+	}
+
+	if err := netlink.NeighAdd(neigh); err != nil {
+		return errors.Wrap(err, "setup NS network: failed to add static ARP")
+	}
+	return nil
+}
+
+// configContainerIPv6 配置容器内 ipv6 地址, 默认路由及网关静态邻居
+// 路由模式下容器地址为 /128, 关闭 DAD 避免地址处于 tentative 状态
+func configContainerIPv6(contVeth, hostVeth netlink.Link, ipv6Addr net.IP) error {
+	if err := netlink.AddrAdd(contVeth, &netlink.Addr{
+		IPNet: netlink.NewIPNet(ipv6Addr),
+		Flags: unix.IFA_F_NODAD,
+	}); err != nil {
+		return errors.Wrapf(err, "setup NS network: failed to add IPv6 addr to %q", contVeth.Attrs().Name)
+	}
+
+	// use link-local dummy next hop (fe80::1)
+	// # ip -6 route show
+	// default via fe80::1 dev eth0
+	gw := net.ParseIP("fe80::1")
+	defaultRoute := netlink.Route{
+		LinkIndex: contVeth.Attrs().Index,
+		Dst:       &net.IPNet{IP: net.IPv6zero, Mask: net.CIDRMask(0, 128)},
+		Scope:     netlink.SCOPE_UNIVERSE,
+		Gw:        gw,
+	}
+	if err := netlink.RouteReplace(&defaultRoute); err != nil {
+		return errors.Wrap(err, "setup NS network: failed to add ipv6 default gateway")
+	}
+
+	// add static neighbor entry for ipv6 default gateway
+	neigh := &netlink.Neigh{
+		LinkIndex:    contVeth.Attrs().Index,
+		Family:       netlink.FAMILY_V6,
+		State:        netlink.NUD_PERMANENT,
+		IP:           gw,
+		HardwareAddr: hostVeth.Attrs().HardwareAddr,
+	}
+	if err := netlink.NeighAdd(neigh); err != nil {
+		return errors.Wrap(err, "setup NS network: failed to add static ipv6 neighbor")
+	}
+	return nil
 }
 
 func cmdAdd(args *skel.CmdArgs) (retErr error) {
@@ -236,19 +297,23 @@ func cmdAdd(args *skel.CmdArgs) (retErr error) {
 	}
 	logging.Debugf("get result from ipam: %v", result)
 
-	var ipv4Addr net.IP
+	var ipv4Addr, ipv6Addr net.IP
 	for _, ipc := range result.IPs {
-		if ipc.Version == "4" {
+		switch ipc.Version {
+		case "4":
 			ipv4Addr = ipc.Address.IP
+		case "6":
+			ipv6Addr = ipc.Address.IP
 		}
 		// All addresses belong to the ipvlan interface
 		ipc.Interface = current.Int(0)
 	}
-	if ipv4Addr == nil {
-		return errors.New("no ipv4 address from ipam")
+	if ipv4Addr == nil && ipv6Addr == nil {
+		return errors.New("no ipv4 or ipv6 address from ipam")
 	}
 
-	if err := configIface(netns, hostIface, contIface, ipv4Addr, conf.GenerateStaticVethHostMac); err != nil {
+	if err := configIface(netns, hostIface, contIface, ipv4Addr, ipv6Addr,
+		conf.GenerateStaticVethHostMac); err != nil {
 		return err
 	}
 
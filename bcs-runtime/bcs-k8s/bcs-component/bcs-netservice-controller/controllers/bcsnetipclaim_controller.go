@@ -69,11 +69,16 @@ func (r *BCSNetIPClaimReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	// claim is deleted
 	if claim.DeletionTimestamp != nil {
 		if claim.Status.Phase == constant.BCSNetIPClaimBoundedStatus {
-			if err := r.unboundIP(ctx, claim); err != nil {
-				return ctrl.Result{
-					Requeue:      true,
-					RequeueAfter: 5 * time.Second,
-				}, err
+			for _, ipName := range []string{claim.Status.BoundedIP, claim.Status.DualStackBoundedIP} {
+				if ipName == "" {
+					continue
+				}
+				if err := r.unboundIP(ctx, claim, ipName); err != nil {
+					return ctrl.Result{
+						Requeue:      true,
+						RequeueAfter: 5 * time.Second,
+					}, err
+				}
 			}
 		}
 
@@ -116,9 +121,10 @@ func (r *BCSNetIPClaimReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	return ctrl.Result{}, nil
 }
 
-func (r *BCSNetIPClaimReconciler) unboundIP(ctx context.Context, claim *netservicev1.BCSNetIPClaim) error {
+func (r *BCSNetIPClaimReconciler) unboundIP(ctx context.Context, claim *netservicev1.BCSNetIPClaim,
+	ipName string) error {
 	netIP := &netservicev1.BCSNetIP{}
-	if err := r.Get(ctx, types.NamespacedName{Name: claim.Status.BoundedIP}, netIP); err != nil {
+	if err := r.Get(ctx, types.NamespacedName{Name: ipName}, netIP); err != nil {
 		return err
 	}
 	if netIP.Status.Phase == constant.BCSNetIPActiveStatus {
@@ -126,7 +132,7 @@ func (r *BCSNetIPClaimReconciler) unboundIP(ctx context.Context, claim *netservi
 			return err
 		}
 		return fmt.Errorf("delete claim %s failed, bounded BCSNetIP %s in Active status",
-			fmt.Sprintf("%s/%s", claim.Namespace, claim.Name), claim.Status.BoundedIP)
+			fmt.Sprintf("%s/%s", claim.Namespace, claim.Name), ipName)
 	}
 	claimKey := utils.GetNamespacedNameKey(claim.GetNamespace(), claim.GetName())
 	if netIP.Status.IPClaimKey == "" {
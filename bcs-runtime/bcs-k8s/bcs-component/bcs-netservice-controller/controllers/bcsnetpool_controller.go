@@ -207,13 +207,15 @@ func (r *BCSNetPoolReconciler) removeFinalizerForPool(netPool *netservicev1.BCSN
 func (r *BCSNetPoolReconciler) syncBCSNetIP(
 	ctx context.Context, netPool *netservicev1.BCSNetPool) (ctrl.Result, error) {
 	blog.Infof("syncing BCSNetIP...")
-	// create BCSNetIP based on BCSNetPool if not exists
-	for _, ip := range netPool.Spec.AvailableIPs {
-		if err := r.createBCSNetIP(ctx, netPool, ip); err != nil {
-			return ctrl.Result{
-				Requeue:      true,
-				RequeueAfter: 5 * time.Second,
-			}, err
+	// create BCSNetIP based on BCSNetPool if not exists, including IPs of dual-stack subnet
+	for _, subnet := range netPool.Spec.Subnets() {
+		for _, ip := range subnet.AvailableIPs {
+			if err := r.createBCSNetIP(ctx, netPool, &subnet, ip); err != nil {
+				return ctrl.Result{
+					Requeue:      true,
+					RequeueAfter: 5 * time.Second,
+				}, err
+			}
 		}
 	}
 
@@ -309,20 +311,23 @@ func (r *BCSNetPoolReconciler) updateIPClaimStatus(ctx context.Context, ip *nets
 	return nil
 }
 
-// createBCSNetIP creates IP for a Pool
-func (r *BCSNetPoolReconciler) createBCSNetIP(ctx context.Context, netPool *netservicev1.BCSNetPool, ip string) error {
+// createBCSNetIP creates IP for a Pool, IPv6 address is converted to valid resource name
+func (r *BCSNetPoolReconciler) createBCSNetIP(ctx context.Context, netPool *netservicev1.BCSNetPool,
+	subnet *netservicev1.BCSNetPoolSubnet, ip string) error {
 	netIP := &netservicev1.BCSNetIP{}
-	if err := r.Get(ctx, types.NamespacedName{Name: ip}, netIP); err != nil {
+	name := netservicev1.GetNetIPName(ip)
+	if err := r.Get(ctx, types.NamespacedName{Name: name}, netIP); err != nil {
 		if k8serrors.IsNotFound(err) {
 			newNetIP := &netservicev1.BCSNetIP{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:   ip,
+					Name:   name,
 					Labels: map[string]string{constant.PodLabelKeyForPool: netPool.Name, constant.FixIPLabel: "false"},
 				},
 				Spec: netservicev1.BCSNetIPSpec{
-					Net:     netPool.Spec.Net,
-					Mask:    netPool.Spec.Mask,
-					Gateway: netPool.Spec.Gateway,
+					IP:      ip,
+					Net:     subnet.Net,
+					Mask:    subnet.Mask,
+					Gateway: subnet.Gateway,
 				},
 			}
 			if cerr := r.Create(ctx, newNetIP); cerr != nil {
@@ -359,8 +364,10 @@ func (r *BCSNetPoolReconciler) deleteBCSNetIP(ctx context.Context, netPool *nets
 
 	delIPList := make(map[string]string)
 	newIPMap := make(map[string]bool)
-	for _, v := range netPool.Spec.AvailableIPs {
-		newIPMap[v] = true
+	for _, subnet := range netPool.Spec.Subnets() {
+		for _, v := range subnet.AvailableIPs {
+			newIPMap[netservicev1.GetNetIPName(v)] = true
+		}
 	}
 
 	for k, v := range curIPlist {
@@ -393,7 +400,7 @@ func (r *BCSNetPoolReconciler) syncNodeResource(ctx context.Context, netPool *ne
 		for _, hostIP := range netPool.Spec.Hosts {
 			for _, address := range node.Status.Addresses {
 				if address.Type == corev1.NodeInternalIP && address.Address == hostIP {
-					ipNum := len(netPool.Spec.AvailableIPs)
+					ipNum := netPool.Spec.AllocatableNum()
 					if netPool.DeletionTimestamp != nil {
 						ipNum = 0
 					}
@@ -50,14 +50,24 @@ type NetIPAllocateRequest struct {
 }
 
 // NetIPAllocateReponseData represents allocate BCSNetIP response
+// IPAddr/Gateway/Mask is the primary ip, IPs contains all ips allocated, including dual-stack ip
 type NetIPAllocateReponseData struct {
-	Host         string `json:"host"`
-	ContainerID  string `json:"containerID"`
-	IPAddr       string `json:"ipAddr"`
-	PodName      string `json:"podName"`
-	PodNamespace string `json:"podNamespace"`
-	Gateway      string `json:"gateway"`
-	Mask         int    `json:"mask"`
+	Host         string             `json:"host"`
+	ContainerID  string             `json:"containerID"`
+	IPAddr       string             `json:"ipAddr"`
+	PodName      string             `json:"podName"`
+	PodNamespace string             `json:"podNamespace"`
+	Gateway      string             `json:"gateway"`
+	Mask         int                `json:"mask"`
+	IPs          []*NetIPAllocateIP `json:"ips,omitempty"`
+}
+
+// NetIPAllocateIP represents one allocated ip
+type NetIPAllocateIP struct {
+	Version string `json:"version"`
+	IPAddr  string `json:"ipAddr"`
+	Gateway string `json:"gateway"`
+	Mask    int    `json:"mask"`
 }
 
 // NetIPDeleteRequest represents delete BCSNetIP request
@@ -78,19 +88,39 @@ type NetIPResponse struct {
 }
 
 // get allocate response data object from request, BCSNetIP and BCSNetPool
-func getAllocateResponseData(req *NetIPAllocateRequest, bcsip *v1.BCSNetIP,
+// dualStackIP is nil for single-stack pool, host gateway only overrides the ip of the same family
+func getAllocateResponseData(req *NetIPAllocateRequest, bcsip, dualStackIP *v1.BCSNetIP,
 	bcspool *v1.BCSNetPool, gateway string) *NetIPAllocateReponseData {
-	if gateway == "" {
-		gateway = bcspool.Spec.Gateway
+	ips := []*NetIPAllocateIP{getAllocateIP(bcsip, bcspool.Spec.Gateway, bcspool.Spec.Mask, gateway)}
+	if dualStackIP != nil && bcspool.Spec.DualStack != nil {
+		ips = append(ips, getAllocateIP(dualStackIP, bcspool.Spec.DualStack.Gateway,
+			bcspool.Spec.DualStack.Mask, gateway))
 	}
 	return &NetIPAllocateReponseData{
 		Host:         req.Host,
 		ContainerID:  req.ContainerID,
-		IPAddr:       bcsip.Name,
+		IPAddr:       ips[0].IPAddr,
 		PodName:      req.PodName,
 		PodNamespace: req.PodNamespace,
-		Gateway:      gateway,
-		Mask:         bcspool.Spec.Mask,
+		Gateway:      ips[0].Gateway,
+		Mask:         ips[0].Mask,
+		IPs:          ips,
+	}
+}
+
+// getAllocateIP get allocated ip info, use host gateway if it is the same ip family
+func getAllocateIP(bcsip *v1.BCSNetIP, poolGateway string, mask int, hostGateway string) *NetIPAllocateIP {
+	ip := bcsip.GetIP()
+	family := v1.GetIPFamily(ip)
+	gateway := poolGateway
+	if hostGateway != "" && v1.GetIPFamily(hostGateway) == family {
+		gateway = hostGateway
+	}
+	return &NetIPAllocateIP{
+		Version: family,
+		IPAddr:  ip,
+		Gateway: gateway,
+		Mask:    mask,
 	}
 }
 
@@ -221,22 +251,22 @@ func (c *HttpServerClient) AllocateIP(request *restful.Request, response *restfu
 		}
 		if ipClaim.Status.Phase == constant.BCSNetIPClaimPendingStatus {
 			// allocate ip for pending ip claim
-			targetIP, bcspool, aerr := c.allocateNewIPForClaim(netIPReq, targetIP, ipClaim)
+			targetIP, dualStackIP, bcspool, aerr := c.allocateNewIPForClaim(netIPReq, targetIP, ipClaim)
 			if aerr != nil {
 				response.WriteEntity(responseData(2, aerr.Error(), false, requestID, nil)) // nolint
 				return
 			}
-			data := getAllocateResponseData(netIPReq, targetIP, bcspool, gateway)
+			data := getAllocateResponseData(netIPReq, targetIP, dualStackIP, bcspool, gateway)
 			response.WriteEntity(responseData(0, "success", true, requestID, data))
 			return
 		} else if ipClaim.Status.Phase == constant.BCSNetIPClaimBoundedStatus {
 			// get ip from ip claim
-			bcsNetIP, bcsNetPool, aerr := c.allocateIPByClaim(netIPReq, ipClaim)
+			bcsNetIP, dualStackIP, bcsNetPool, aerr := c.allocateIPByClaim(netIPReq, ipClaim)
 			if aerr != nil {
 				response.WriteEntity(responseData(2, aerr.Error(), false, requestID, nil)) // nolint
 				return
 			}
-			data := getAllocateResponseData(netIPReq, bcsNetIP, bcsNetPool, gateway)
+			data := getAllocateResponseData(netIPReq, bcsNetIP, dualStackIP, bcsNetPool, gateway)
 			message := fmt.Sprintf("allocate IP [%s] from BCSNetIPClaim %s/%s for Host %s success",
 				bcsNetIP.Name, ipClaim.GetNamespace(), ipClaim.GetName(), netIPReq.Host)
 			blog.Infof(message)
@@ -256,88 +286,181 @@ func (c *HttpServerClient) AllocateIP(request *restful.Request, response *restfu
 		response.WriteEntity(responseData(2, message, false, requestID, nil)) // nolint
 		return
 	}
+	bcspool, err := c.getPoolByIP(targetIP)
+	if err != nil {
+		response.WriteEntity(responseData(2, err.Error(), false, requestID, nil)) // nolint
+		return
+	}
+	oldStatus := targetIP.Status
 	if uerr := c.updateIPStatus(targetIP, netIPReq, "", "", false); uerr != nil {
 		response.WriteEntity(responseData(2, uerr.Error(), false, requestID, nil)) // nolint
 		return
 	}
-	message := fmt.Sprintf("allocate IP [%s] for Host %s success", targetIP.Name, netIPReq.Host)
-	blog.Infof(message)
-	bcspool, err := c.getPoolByIP(targetIP)
+	// 双栈IP分配失败时回滚已分配的IP, CNI ADD 失败后不一定会调用 DEL 释放该IP
+	dualStackIP, err := c.allocateDualStackIP(netIPReq, bcspool, "", false)
 	if err != nil {
+		c.rollbackIPStatus(targetIP, oldStatus)
 		response.WriteEntity(responseData(2, err.Error(), false, requestID, nil)) // nolint
 		return
 	}
-	data := getAllocateResponseData(netIPReq, targetIP, bcspool, gateway)
+	message := fmt.Sprintf("allocate IP [%s] for Host %s success", targetIP.GetIP(), netIPReq.Host)
+	blog.Infof(message)
+	data := getAllocateResponseData(netIPReq, targetIP, dualStackIP, bcspool, gateway)
 	response.WriteEntity(responseData(0, message, true, requestID, data)) // nolint
 }
 
 // allocateNewIPForClaim xxx
 func (c *HttpServerClient) allocateNewIPForClaim(
 	netIPReq *NetIPAllocateRequest, targetIP *v1.BCSNetIP, ipClaim *v1.BCSNetIPClaim) (
-	*v1.BCSNetIP, *v1.BCSNetPool, error) {
+	*v1.BCSNetIP, *v1.BCSNetIP, *v1.BCSNetPool, error) {
 	// do fixed ip bound
 	if targetIP == nil {
 		message := fmt.Sprintf("no available IP for pod %s/%s", netIPReq.PodNamespace, netIPReq.PodName)
 		blog.Errorf(message)
-		return nil, nil, fmt.Errorf(message)
+		return nil, nil, nil, fmt.Errorf(message)
+	}
+	// get pool by ip
+	bcspool, err := c.getPoolByIP(targetIP)
+	if err != nil {
+		message := fmt.Sprintf("get pool failed, err %s", err.Error())
+		blog.Errorf(message)
+		return nil, nil, nil, fmt.Errorf(message)
 	}
+	claimKey := utils.GetNamespacedNameKey(netIPReq.PodNamespace, ipClaim.GetName())
+	oldStatus := targetIP.Status
 	// update ip status
-	if err := c.updateIPStatus(targetIP, netIPReq,
-		utils.GetNamespacedNameKey(netIPReq.PodNamespace, ipClaim.GetName()), "", true); err != nil {
+	if err = c.updateIPStatus(targetIP, netIPReq, claimKey, "", true); err != nil {
 		message := fmt.Sprintf("update IP %s status, failed, err %s", targetIP.GetName(), err.Error())
 		blog.Errorf(message)
-		return nil, nil, fmt.Errorf(message)
+		return nil, nil, nil, fmt.Errorf(message)
+	}
+	// 双栈IP分配失败时回滚已分配的IP, claim 未绑定, CNI DEL 不会释放该IP
+	dualStackIP, err := c.allocateDualStackIP(netIPReq, bcspool, claimKey, true)
+	if err != nil {
+		c.rollbackIPStatus(targetIP, oldStatus)
+		return nil, nil, nil, err
 	}
 	// bound ip claim
-	if err := c.boundClaimIP(ipClaim, targetIP); err != nil {
+	if err = c.boundClaimIP(ipClaim, targetIP, dualStackIP); err != nil {
 		message := fmt.Sprintf("bound BCSNetIP %s to BCSNetIPClaim %s/%s failed, err %s",
 			targetIP.GetName(), ipClaim.GetNamespace(), ipClaim.GetName(), err.Error())
 		blog.Errorf(message)
-		return nil, nil, fmt.Errorf(message)
+		c.rollbackIPStatus(targetIP, oldStatus)
+		if dualStackIP != nil {
+			c.rollbackIPStatus(dualStackIP, v1.BCSNetIPStatus{Phase: constant.BCSNetIPAvailableStatus})
+		}
+		return nil, nil, nil, fmt.Errorf(message)
 	}
 	message := fmt.Sprintf("allocate fixed IP [%s] for Host %s success", targetIP.Name, netIPReq.Host)
 	blog.Infof(message)
-	// get pool by ip
-	bcspool, err := c.getPoolByIP(targetIP)
-	if err != nil {
-		message := fmt.Sprintf("get pool failed, err %s", err.Error())
-		blog.Errorf(message)
-		return nil, nil, fmt.Errorf(message)
-	}
-	return targetIP, bcspool, nil
+	return targetIP, dualStackIP, bcspool, nil
 }
 
 // allocateIPByClaim xxx
 func (c *HttpServerClient) allocateIPByClaim(
-	netIPReq *NetIPAllocateRequest, ipClaim *v1.BCSNetIPClaim) (*v1.BCSNetIP, *v1.BCSNetPool, error) {
+	netIPReq *NetIPAllocateRequest, ipClaim *v1.BCSNetIPClaim) (*v1.BCSNetIP, *v1.BCSNetIP, *v1.BCSNetPool, error) {
 	ipName := ipClaim.Status.BoundedIP
 	bcsNetIP, bcsNetPool, err := c.getIPAndPool(ipName)
 	if err != nil {
 		message := fmt.Sprintf("get BCSNetIP and BCSNetPool by BCSNetIP name %s failed, err %s",
 			ipName, err.Error())
 		blog.Errorf(message)
-		return nil, nil, fmt.Errorf(message)
+		return nil, nil, nil, fmt.Errorf(message)
+	}
+	oldStatus := bcsNetIP.Status
+	if err = c.activateClaimIP(netIPReq, ipClaim, bcsNetIP); err != nil {
+		return nil, nil, nil, err
 	}
+
+	if bcsNetPool.Spec.DualStack == nil {
+		return bcsNetIP, nil, bcsNetPool, nil
+	}
+	// 网段池改为双栈前绑定的 claim, 补充分配另一地址族的固定IP
+	if ipClaim.Status.DualStackBoundedIP == "" {
+		claimKey := utils.GetNamespacedNameKey(ipClaim.GetNamespace(), ipClaim.GetName())
+		dualStackIP, aerr := c.allocateDualStackIP(netIPReq, bcsNetPool, claimKey, true)
+		if aerr != nil {
+			c.rollbackIPStatus(bcsNetIP, oldStatus)
+			return nil, nil, nil, aerr
+		}
+		if err = c.boundClaimIP(ipClaim, bcsNetIP, dualStackIP); err != nil {
+			message := fmt.Sprintf("bound BCSNetIP %s to BCSNetIPClaim %s/%s failed, err %s",
+				dualStackIP.GetName(), ipClaim.GetNamespace(), ipClaim.GetName(), err.Error())
+			blog.Errorf(message)
+			c.rollbackIPStatus(bcsNetIP, oldStatus)
+			c.rollbackIPStatus(dualStackIP, v1.BCSNetIPStatus{Phase: constant.BCSNetIPAvailableStatus})
+			return nil, nil, nil, errors.New(message)
+		}
+		return bcsNetIP, dualStackIP, bcsNetPool, nil
+	}
+	dualStackIP := &v1.BCSNetIP{}
+	if err = c.K8SClient.Get(context.Background(), types.NamespacedName{Name: ipClaim.Status.DualStackBoundedIP},
+		dualStackIP); err != nil {
+		message := fmt.Sprintf("get BCSNetIP %s failed, err %s", ipClaim.Status.DualStackBoundedIP, err.Error())
+		blog.Errorf(message)
+		return nil, nil, nil, errors.New(message)
+	}
+	if err = c.activateClaimIP(netIPReq, ipClaim, dualStackIP); err != nil {
+		c.rollbackIPStatus(bcsNetIP, oldStatus)
+		return nil, nil, nil, err
+	}
+	return bcsNetIP, dualStackIP, bcsNetPool, nil
+}
+
+// activateClaimIP activate reserved BCSNetIP bound with claim
+func (c *HttpServerClient) activateClaimIP(netIPReq *NetIPAllocateRequest, ipClaim *v1.BCSNetIPClaim,
+	bcsNetIP *v1.BCSNetIP) error {
 	if bcsNetIP.Status.Phase != constant.BCSNetIPReservedStatus {
 		if err := utils.FixActiveIP(c.K8SClient, bcsNetIP); err != nil {
-			return nil, nil, err
+			return err
 		}
 		message := fmt.Sprintf(
 			"BCSNetIP %s bound with BCSNetIPClaim %s/%s is not in reserved status, BCSNetIP status %v",
 			bcsNetIP.Name, ipClaim.Name, ipClaim.Namespace, bcsNetIP.Status)
 		blog.Errorf(message)
-		return nil, nil, fmt.Errorf(message)
+		return errors.New(message)
 	}
 	// update ip status
 	if err := c.updateIPStatus(
 		bcsNetIP, netIPReq, utils.GetNamespacedNameKey(ipClaim.GetNamespace(),
 			ipClaim.GetName()), ipClaim.Spec.ExpiredDuration, true); err != nil {
 		message := fmt.Sprintf("update BCSNetIP %s status failed, err %s",
-			ipName, err.Error())
+			bcsNetIP.Name, err.Error())
 		blog.Errorf(message)
-		return nil, nil, fmt.Errorf(message)
+		return errors.New(message)
 	}
-	return bcsNetIP, bcsNetPool, nil
+	return nil
+}
+
+// allocateDualStackIP allocate ip of the other family for dual-stack pool, return nil for single-stack pool
+func (c *HttpServerClient) allocateDualStackIP(netIPReq *NetIPAllocateRequest, pool *v1.BCSNetPool,
+	claimKey string, fixed bool) (*v1.BCSNetIP, error) {
+	if pool.Spec.DualStack == nil {
+		return nil, nil
+	}
+	targetIP := c.getTargetIP(c.getAvailableDualStackIPs(pool))
+	if targetIP == nil {
+		message := fmt.Sprintf("no available dual-stack IP in pool %s for pod %s/%s",
+			pool.Name, netIPReq.PodNamespace, netIPReq.PodName)
+		blog.Errorf(message)
+		return nil, errors.New(message)
+	}
+	if err := c.updateIPStatus(targetIP, netIPReq, claimKey, "", fixed); err != nil {
+		return nil, err
+	}
+	blog.Infof("allocate dual-stack IP [%s] for Host %s success", targetIP.GetIP(), netIPReq.Host)
+	return targetIP, nil
+}
+
+// rollbackIPStatus 分配失败时恢复IP分配前的状态, 恢复失败只记录日志, 由调用方返回分配失败的错误
+func (c *HttpServerClient) rollbackIPStatus(ip *v1.BCSNetIP, status v1.BCSNetIPStatus) {
+	ip.Status = status
+	ip.Status.UpdateTime = metav1.Now()
+	if err := c.K8SClient.Status().Update(context.Background(), ip); err != nil {
+		blog.Errorf("rollback IP [%s] status to %s failed, err %s", ip.GetIP(), status.Phase, err.Error())
+		return
+	}
+	blog.Infof("rollback IP [%s] status to %s success", ip.GetIP(), status.Phase)
 }
 
 // update IP Status
@@ -355,7 +478,7 @@ func (c *HttpServerClient) updateIPStatus(ip *v1.BCSNetIP, netIPReq *NetIPAlloca
 		KeepDuration: duration,
 	}
 	if err := c.K8SClient.Status().Update(context.Background(), ip); err != nil {
-		message := fmt.Sprintf("update IP [%s] status failed, err %s", netIPReq.IPAddr, err.Error())
+		message := fmt.Sprintf("update IP [%s] status failed, err %s", ip.GetIP(), err.Error())
 		blog.Errorf(message)
 		return errors.New(message)
 	}
@@ -363,23 +486,20 @@ func (c *HttpServerClient) updateIPStatus(ip *v1.BCSNetIP, netIPReq *NetIPAlloca
 }
 
 // get Available IPs
+// 双栈网段池中另一地址族没有可用IP时, 跳过该网段池
 func (c *HttpServerClient) getAvailableIPs(netPoolList *v1.BCSNetPoolList, netIPReq *NetIPAllocateRequest) (
 	[]*v1.BCSNetIP, error) {
 	var availableIP []*v1.BCSNetIP
 	found := false
-	for _, pool := range netPoolList.Items {
+	for i := range netPoolList.Items {
+		pool := &netPoolList.Items[i]
 		if utils.StringInSlice(pool.Spec.Hosts, netIPReq.Host) {
 			found = true
-			for _, v := range pool.Spec.AvailableIPs {
-				netIP := &v1.BCSNetIP{}
-				if err := c.K8SClient.Get(context.Background(), types.NamespacedName{Name: v}, netIP); err != nil {
-					blog.Warnf("get BCSNetIP [%s] failed, %s", v, err.Error())
-					continue
-				}
-				if netIP.Status.Phase == constant.BCSNetIPAvailableStatus {
-					availableIP = append(availableIP, netIP)
-				}
+			if pool.Spec.DualStack != nil && len(c.getAvailableDualStackIPs(pool)) == 0 {
+				blog.Warnf("no available dual-stack IP in pool %s", pool.Name)
+				continue
 			}
+			availableIP = append(availableIP, c.getAvailableIPsByList(pool.Spec.AvailableIPs)...)
 		}
 	}
 	// if host not found in pools, return error
@@ -392,6 +512,31 @@ func (c *HttpServerClient) getAvailableIPs(netPoolList *v1.BCSNetPoolList, netIP
 	return availableIP, nil
 }
 
+// getAvailableDualStackIPs get available ips of dual-stack subnet in pool
+func (c *HttpServerClient) getAvailableDualStackIPs(pool *v1.BCSNetPool) []*v1.BCSNetIP {
+	if pool.Spec.DualStack == nil {
+		return nil
+	}
+	return c.getAvailableIPsByList(pool.Spec.DualStack.AvailableIPs)
+}
+
+// getAvailableIPsByList get BCSNetIP in available status by ip list
+func (c *HttpServerClient) getAvailableIPsByList(ips []string) []*v1.BCSNetIP {
+	var availableIP []*v1.BCSNetIP
+	for _, v := range ips {
+		netIP := &v1.BCSNetIP{}
+		if err := c.K8SClient.Get(context.Background(), types.NamespacedName{Name: v1.GetNetIPName(v)},
+			netIP); err != nil {
+			blog.Warnf("get BCSNetIP [%s] failed, %s", v, err.Error())
+			continue
+		}
+		if netIP.Status.Phase == constant.BCSNetIPAvailableStatus {
+			availableIP = append(availableIP, netIP)
+		}
+	}
+	return availableIP
+}
+
 // get Target IP
 // 随机打散分配
 func (c *HttpServerClient) getTargetIP(availableIP []*v1.BCSNetIP) *v1.BCSNetIP {
@@ -432,9 +577,12 @@ func (c *HttpServerClient) getClaim(ns, name string) (*v1.BCSNetIPClaim, error)
 	return retClaim, nil
 }
 
-// bound Claim IP
-func (c *HttpServerClient) boundClaimIP(claim *v1.BCSNetIPClaim, netIP *v1.BCSNetIP) error {
+// bound Claim IP, dualStackIP is nil for single-stack pool
+func (c *HttpServerClient) boundClaimIP(claim *v1.BCSNetIPClaim, netIP, dualStackIP *v1.BCSNetIP) error {
 	claim.Status.BoundedIP = netIP.Name
+	if dualStackIP != nil {
+		claim.Status.DualStackBoundedIP = dualStackIP.Name
+	}
 	claim.Status.Phase = constant.BCSNetIPClaimBoundedStatus
 	if err := c.K8SClient.Status().Update(context.Background(), claim); err != nil {
 		blog.Errorf("update BCSNetIPClaim %s/%s status failed, err %v", claim.Namespace, claim.Name, err)
@@ -465,20 +613,36 @@ func (c *HttpServerClient) DeleteIP(request *restful.Request, response *restful.
 		response.WriteEntity(responseData(2, message, false, requestID, nil)) // nolint
 		return
 	}
-	var netIP *v1.BCSNetIP
-	for _, ip := range netIPList.Items {
+	// 双栈网段池中一个容器会占用两个 BCSNetIP, 需全部释放
+	var netIPs []*v1.BCSNetIP
+	for i := range netIPList.Items {
+		ip := &netIPList.Items[i]
 		if ip.Status.ContainerID == netIPReq.ContainerID && ip.Status.PodNamespace == netIPReq.PodNamespace &&
 			ip.Status.PodName == netIPReq.PodName && ip.Status.Host == netIPReq.Host {
-			netIP = &ip
-			break
+			netIPs = append(netIPs, ip)
 		}
 	}
-	if netIP == nil {
+	if len(netIPs) == 0 {
 		message := fmt.Sprintf("didn't find related BCSNetIP instance for container %s", netIPReq.ContainerID)
 		blog.Errorf(message)
 		response.WriteEntity(responseData(0, message, true, requestID, nil)) // nolint
 		return
 	}
+	var names []string
+	for _, netIP := range netIPs {
+		if err := c.releaseIP(netIP); err != nil {
+			response.WriteEntity(responseData(2, err.Error(), false, requestID, nil)) // nolint
+			return
+		}
+		names = append(names, netIP.Name)
+	}
+	message := fmt.Sprintf("deactive IP %v success, it's available now", names)
+	blog.Infof(message)
+	response.WriteEntity(responseData(0, message, true, requestID, netIPReq)) // nolint
+}
+
+// releaseIP release BCSNetIP, fixed ip will be reserved for claim
+func (c *HttpServerClient) releaseIP(netIP *v1.BCSNetIP) error {
 	claimKey := netIP.Status.IPClaimKey
 	if len(claimKey) != 0 {
 		claim := &v1.BCSNetIPClaim{}
@@ -487,15 +651,13 @@ func (c *HttpServerClient) DeleteIP(request *restful.Request, response *restful.
 		if err != nil {
 			message := fmt.Sprintf("invalid IPClaimKey %s of BCSNetIP %s instance", claimKey, netIP.GetName())
 			blog.Errorf(message)
-			response.WriteEntity(responseData(2, message, false, requestID, nil)) // nolint
-			return
+			return errors.New(message)
 		}
 		err = c.K8SClient.Get(context.Background(), types.NamespacedName{Name: podName, Namespace: podNamespace}, claim)
 		if err != nil {
 			message := fmt.Sprintf("get IPClaim by IPClaimKey %s of BCSNetIP %s instance", claimKey, netIP.GetName())
 			blog.Errorf(message)
-			response.WriteEntity(responseData(2, message, false, requestID, nil)) // nolint
-			return
+			return errors.New(message)
 		}
 		netIP.Status = v1.BCSNetIPStatus{
 			Phase:      constant.BCSNetIPReservedStatus,
@@ -513,12 +675,9 @@ func (c *HttpServerClient) DeleteIP(request *restful.Request, response *restful.
 	if err := c.K8SClient.Status().Update(context.Background(), netIP); err != nil {
 		message := fmt.Sprintf("update IP [%s] status failed", netIP.Name)
 		blog.Errorf(message)
-		response.WriteEntity(responseData(2, message, false, requestID, nil)) // nolint
-		return
+		return errors.New(message)
 	}
-	message := fmt.Sprintf("deactive IP [%s] success, it's available now", netIP.Name)
-	blog.Infof(message)
-	response.WriteEntity(responseData(0, message, true, requestID, netIPReq)) // nolint
+	return nil
 }
 
 // get IP And Pool
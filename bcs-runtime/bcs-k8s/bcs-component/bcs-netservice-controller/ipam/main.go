@@ -138,22 +138,49 @@ func cmdAdd(args *skel.CmdArgs) error {
 
 	// create results
 	result := &current.Result{}
-	// ip info
-	ip, ipAddr, _ := net.ParseCIDR(resp.Data.IPAddr + "/" + strconv.Itoa(resp.Data.Mask))
-	iface := 0
-	ipConf := &current.IPConfig{
-		Version:   "4",
-		Interface: &iface,
-		Address:   net.IPNet{IP: ip, Mask: ipAddr.Mask},
-		Gateway:   net.ParseIP(resp.Data.Gateway),
-	}
-	result.IPs = []*current.IPConfig{ipConf}
-	// route info, if no gateway info ,use ipinfo.Gateway
-	for _, configRoute := range ipamConf.Routes {
+	// ip info, dual-stack pool returns ipv4 and ipv6 in ips
+	allocateIPs := resp.Data.IPs
+	if len(allocateIPs) == 0 {
+		allocateIPs = []*client.AllocateIP{{
+			Version: "4",
+			IPAddr:  resp.Data.IPAddr,
+			Gateway: resp.Data.Gateway,
+			Mask:    resp.Data.Mask,
+		}}
+	}
+	gateways := make(map[string]net.IP)
+	for _, allocateIP := range allocateIPs {
+		ip, ipAddr, perr := net.ParseCIDR(allocateIP.IPAddr + "/" + strconv.Itoa(allocateIP.Mask))
+		if perr != nil {
+			blog.Errorf("parse allocated ip %s/%d failed, err %s", allocateIP.IPAddr, allocateIP.Mask, perr.Error())
+			return fmt.Errorf("parse allocated ip %s/%d failed, err %s", allocateIP.IPAddr, allocateIP.Mask,
+				perr.Error())
+		}
+		iface := 0
+		ipConf := &current.IPConfig{
+			Version:   allocateIP.Version,
+			Interface: &iface,
+			Address:   net.IPNet{IP: ip, Mask: ipAddr.Mask},
+			Gateway:   net.ParseIP(allocateIP.Gateway),
+		}
+		result.IPs = append(result.IPs, ipConf)
+		gateways[allocateIP.Version] = ipConf.Gateway
+	}
+	// route info, if no gateway info ,use gateway of the same ip family
+	for i := range ipamConf.Routes {
+		configRoute := ipamConf.Routes[i]
 		if configRoute.GW == nil {
+			version := "4"
+			if configRoute.Dst.IP.To4() == nil {
+				version = "6"
+			}
+			gw, ok := gateways[version]
+			if !ok {
+				continue
+			}
 			route := &types.Route{
 				Dst: configRoute.Dst,
-				GW:  net.ParseIP(resp.Data.Gateway),
+				GW:  gw,
 			}
 			result.Routes = append(result.Routes, route)
 		} else {
@@ -32,14 +32,23 @@ type AllocateReq struct {
 
 // AllocateRespData data of allocate response
 type AllocateRespData struct {
-	PodName      string `json:"podName"`
-	PodNamespace string `json:"podNamespace"`
-	IPAddr       string `json:"ipAddr"`
-	ContainerID  string `json:"containerID"`
-	Host         string `json:"host"`
-	Mask         int    `json:"mask"`
-	MacAddr      string `json:"macAddr,omitempty"`
-	Gateway      string `json:"gateway"`
+	PodName      string        `json:"podName"`
+	PodNamespace string        `json:"podNamespace"`
+	IPAddr       string        `json:"ipAddr"`
+	ContainerID  string        `json:"containerID"`
+	Host         string        `json:"host"`
+	Mask         int           `json:"mask"`
+	MacAddr      string        `json:"macAddr,omitempty"`
+	Gateway      string        `json:"gateway"`
+	IPs          []*AllocateIP `json:"ips,omitempty"`
+}
+
+// AllocateIP one of allocated ips, version is 4 or 6
+type AllocateIP struct {
+	Version string `json:"version"`
+	IPAddr  string `json:"ipAddr"`
+	Gateway string `json:"gateway"`
+	Mask    int    `json:"mask"`
 }
 
 // AllocateResp allocate response
@@ -13,12 +13,21 @@
 package v1alpha1
 
 import (
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DescheduleType the type of policy
 type DescheduleType string
 
+const (
+	// DescheduleTypeConverge converge the pods to less nodes
+	DescheduleTypeConverge DescheduleType = "Converge"
+	// DescheduleTypeBalance spread the pods across nodes and zones
+	DescheduleTypeBalance DescheduleType = "Balance"
+)
+
 // DescheduleSpec the spec of DeschedulePolicy
 type DescheduleSpec struct {
 	// +optional
@@ -34,7 +43,6 @@ type DescheduleSpec struct {
 	// Balance defines balance strategy, it will decentralize the pods in cluster.
 	// This strategy is to keep the utilization of each node in the cluster as balanced
 	// as possible
-	// DOTO: 当前版本未实现
 	Balance DescheduleBalanceStrategy `json:"balance,omitempty" protobuf:"bytes,3,opt,name=balance"`
 }
 
@@ -95,8 +103,60 @@ type SequenceItem struct {
 	Kind string `json:"kind,omitempty" protobuf:"bytes,1,opt,name=kind"`
 }
 
-// DescheduleBalanceStrategy balance strategy
-type DescheduleBalanceStrategy struct{}
+// DescheduleBalanceStrategy defines balance strategy of DeschedulePolicy. It moves pods from the
+// nodes above HighWaterLevel, and spreads the replicas of same workload across nodes and zones.
+type DescheduleBalanceStrategy struct {
+	// Disabled defines whether this strategy is disabled
+	// +kubebuilder:default=false
+	Disabled bool `json:"disabled,omitempty" protobuf:"bytes,1,opt,name=disabled"`
+
+	// TimeRange is the crontab format string, it will do strategy
+	// with its define.
+	TimeRange string `json:"timeRange,omitempty" protobuf:"bytes,2,opt,name=timeRange"`
+
+	// MaxPods defines the maximum number of pods that migrate at once
+	MaxPods int32 `json:"maxPods,omitempty" protobuf:"bytes,3,opt,name=maxPods"`
+
+	// LowWaterLevel represents the water level that the target nodes should be lower than
+	LowWaterLevel float32 `json:"lowWaterLevel,omitempty" protobuf:"bytes,4,opt,name=lowWaterLevel"`
+	// HighWaterLevel represents the water level that the nodes should not exceed
+	HighWaterLevel float32 `json:"highWaterLevel,omitempty" protobuf:"bytes,5,opt,name=highWaterLevel"`
+
+	// MaxSkew defines the maximum allowed difference of the replicas of a workload between
+	// nodes or zones, default 1
+	// +optional
+	MaxSkew int32 `json:"maxSkew,omitempty" protobuf:"bytes,6,opt,name=maxSkew"`
+	// ZoneLabel is the node label that defines the zone, default topology.kubernetes.io/zone
+	// +optional
+	ZoneLabel string `json:"zoneLabel,omitempty" protobuf:"bytes,7,opt,name=zoneLabel"`
+}
+
+// IsBalance returns whether the policy uses balance strategy, the type is case-insensitive
+func (in *DescheduleSpec) IsBalance() bool {
+	return strings.EqualFold(string(in.Type), string(DescheduleTypeBalance))
+}
+
+// IsValidType returns whether the type of policy is supported, empty means Converge
+func (in *DescheduleSpec) IsValidType() bool {
+	return in.Type == "" || in.IsBalance() ||
+		strings.EqualFold(string(in.Type), string(DescheduleTypeConverge))
+}
+
+// IsDisabled returns whether the strategy of the policy type is disabled
+func (in *DescheduleSpec) IsDisabled() bool {
+	if in.IsBalance() {
+		return in.Balance.Disabled
+	}
+	return in.Converge.Disabled
+}
+
+// TimeRange returns the crontab of the strategy of the policy type
+func (in *DescheduleSpec) TimeRange() string {
+	if in.IsBalance() {
+		return in.Balance.TimeRange
+	}
+	return in.Converge.TimeRange
+}
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -105,10 +165,15 @@ type DescheduleBalanceStrategy struct{}
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:resource:shortName=dspolcy
-// +kubebuilder:printcolumn:name="DISABLED",type=boolean,JSONPath=".spec.converge.disabled"
-// +kubebuilder:printcolumn:name="TIME",type=string,JSONPath=".spec.converge.timeRange"
-// +kubebuilder:printcolumn:name="HIGH WATER",type=string,JSONPath=".spec.converge.highWaterLevel"
-// +kubebuilder:printcolumn:name="LOW WATER",type=string,JSONPath=".spec.converge.lowWaterLevel"
+// +kubebuilder:printcolumn:name="TYPE",type=string,JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="CONVERGE DISABLED",type=boolean,JSONPath=".spec.converge.disabled"
+// +kubebuilder:printcolumn:name="CONVERGE TIME",type=string,JSONPath=".spec.converge.timeRange"
+// +kubebuilder:printcolumn:name="BALANCE DISABLED",type=boolean,JSONPath=".spec.balance.disabled"
+// +kubebuilder:printcolumn:name="BALANCE TIME",type=string,JSONPath=".spec.balance.timeRange"
+// +kubebuilder:printcolumn:name="CONVERGE HIGH WATER",type=string,JSONPath=".spec.converge.highWaterLevel",priority=1
+// +kubebuilder:printcolumn:name="CONVERGE LOW WATER",type=string,JSONPath=".spec.converge.lowWaterLevel",priority=1
+// +kubebuilder:printcolumn:name="BALANCE HIGH WATER",type=string,JSONPath=".spec.balance.highWaterLevel",priority=1
+// +kubebuilder:printcolumn:name="BALANCE LOW WATER",type=string,JSONPath=".spec.balance.lowWaterLevel",priority=1
 type DeschedulePolicy struct {
 	metav1.TypeMeta `json:",inline" protobuf:"bytes,1,opt,name=typeMeta"`
 
@@ -0,0 +1,41 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import "testing"
+
+func TestDescheduleSpecType(t *testing.T) {
+	tests := []struct {
+		typ       DescheduleType
+		isBalance bool
+		valid     bool
+	}{
+		{typ: "", isBalance: false, valid: true},
+		{typ: DescheduleTypeConverge, isBalance: false, valid: true},
+		{typ: "converge", isBalance: false, valid: true},
+		{typ: DescheduleTypeBalance, isBalance: true, valid: true},
+		{typ: "balance", isBalance: true, valid: true},
+		{typ: "spread", isBalance: false, valid: false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.typ), func(t *testing.T) {
+			spec := &DescheduleSpec{Type: tt.typ}
+			if spec.IsBalance() != tt.isBalance {
+				t.Errorf("expect IsBalance %v, got %v", tt.isBalance, spec.IsBalance())
+			}
+			if spec.IsValidType() != tt.valid {
+				t.Errorf("expect IsValidType %v, got %v", tt.valid, spec.IsValidType())
+			}
+		})
+	}
+}
@@ -189,6 +189,7 @@ func (m *CacheManager) buildPods(ctx context.Context, podItems []*calculator.Pod
 				originalPod.Namespace, originalPod.Name, err.Error())
 		} else {
 			podMap[apis.WorkloadName] = ownerName
+			podItem.Workload = ownerName
 			workloadPods[ownerName] = append(workloadPods[ownerName], originalPod)
 		}
 		podMap[apis.PodNameLabel] = originalPod.Name
@@ -0,0 +1,384 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package balance 定义均衡策略的本地计算实现
+package balance
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-repack-descheduler/pkg/apis/tkex/v1alpha1"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-repack-descheduler/pkg/controller/calculator"
+)
+
+const (
+	// PlanTag the tag of balance plan
+	PlanTag = "balance"
+
+	defaultZoneLabel = "topology.kubernetes.io/zone"
+	defaultMaxSkew   = 1
+)
+
+// CalculatorBalance calculates the balance plans with the cluster snapshot in request.
+type CalculatorBalance struct {
+	strategy *v1alpha1.DescheduleBalanceStrategy
+}
+
+// NewCalculatorBalance create the instance of balance calculator
+func NewCalculatorBalance(strategy *v1alpha1.DescheduleBalanceStrategy) calculator.CalculateInterface {
+	return &CalculatorBalance{
+		strategy: strategy,
+	}
+}
+
+// nodeState is the state of node during calculating
+type nodeState struct {
+	name     string
+	zone     string
+	node     *corev1.Node
+	allocCPU float64
+	allocMem float64
+	usedCPU  float64
+	usedMem  float64
+	// pods the movable pods on the node
+	pods []*calculator.PodItem
+	// replicas the replicas of each workload on the node
+	replicas map[string]int
+}
+
+// usage returns the max usage of cpu and memory
+func (n *nodeState) usage() float64 {
+	return n.usageWith(0, 0)
+}
+
+// usageWith returns the usage after adding the cpu and memory
+func (n *nodeState) usageWith(cpu, mem float64) float64 {
+	var cpuUsage, memUsage float64
+	if n.allocCPU > 0 {
+		cpuUsage = (n.usedCPU + cpu) / n.allocCPU
+	}
+	if n.allocMem > 0 {
+		memUsage = (n.usedMem + mem) / n.allocMem
+	}
+	if cpuUsage > memUsage {
+		return cpuUsage
+	}
+	return memUsage
+}
+
+type balancer struct {
+	lowWaterLevel  float64
+	highWaterLevel float64
+	maxPods        int
+	maxSkew        int
+
+	nodes     []*nodeState
+	nodesMap  map[string]*nodeState
+	workloads map[string][]*calculator.PodItem
+	planned   map[string]struct{}
+	plans     []calculator.ResponseMigratePlan
+}
+
+// Calculate returns the balance plan. Firstly it moves pods out of the nodes that above HighWaterLevel,
+// then spreads the replicas of each workload across zones and nodes. The target node should not exceed
+// HighWaterLevel after moved.
+func (c *CalculatorBalance) Calculate(ctx context.Context, req *calculator.CalculateConvergeRequest) (
+	plan calculator.ResultPlan, err error) {
+	if req == nil || req.Original == nil {
+		return plan, errors.Errorf("balance calculator request original data is empty")
+	}
+	b := c.newBalancer(req.Original)
+	b.drainHotNodes()
+	b.spreadWorkloads()
+	blog.Infof("balance calculator calculated %d migrate plans", len(b.plans))
+	return calculator.ResultPlan{
+		PlanCount: 1,
+		Plans: []calculator.ResponsePlan{
+			{
+				PlanTags:    []string{PlanTag},
+				MigratePlan: b.plans,
+			},
+		},
+	}, nil
+}
+
+func (c *CalculatorBalance) newBalancer(data *calculator.CalculateOriginalData) *balancer {
+	b := &balancer{
+		lowWaterLevel:  float64(c.strategy.LowWaterLevel),
+		highWaterLevel: float64(c.strategy.HighWaterLevel),
+		maxPods:        int(c.strategy.MaxPods),
+		maxSkew:        int(c.strategy.MaxSkew),
+		nodesMap:       make(map[string]*nodeState),
+		workloads:      make(map[string][]*calculator.PodItem),
+		planned:        make(map[string]struct{}),
+	}
+	if b.maxSkew <= 0 {
+		b.maxSkew = defaultMaxSkew
+	}
+	if b.highWaterLevel <= 0 {
+		b.highWaterLevel = 1
+	}
+	zoneLabel := c.strategy.ZoneLabel
+	if zoneLabel == "" {
+		zoneLabel = defaultZoneLabel
+	}
+	for _, item := range data.Nodes {
+		if item.OriginalNode == nil {
+			continue
+		}
+		ns := &nodeState{
+			name:     item.Container,
+			zone:     item.OriginalNode.Labels[zoneLabel],
+			node:     item.OriginalNode,
+			allocCPU: item.OriginalNode.Status.Allocatable.Cpu().AsApproximateFloat64(),
+			allocMem: item.OriginalNode.Status.Allocatable.Memory().AsApproximateFloat64(),
+			replicas: make(map[string]int),
+		}
+		b.nodes = append(b.nodes, ns)
+		b.nodesMap[ns.name] = ns
+	}
+	// sort by name to make the result stable
+	sort.Slice(b.nodes, func(i, j int) bool { return b.nodes[i].name < b.nodes[j].name })
+	for _, pod := range data.Pods {
+		ns, ok := b.nodesMap[pod.Container]
+		if !ok {
+			continue
+		}
+		ns.usedMem += pod.Index1
+		ns.usedCPU += pod.Index2
+		if pod.IsAllowMigrate == 0 || pod.Workload == "" || pod.OriginalPod == nil {
+			continue
+		}
+		ns.pods = append(ns.pods, pod)
+		ns.replicas[pod.Workload]++
+		b.workloads[pod.Workload] = append(b.workloads[pod.Workload], pod)
+	}
+	return b
+}
+
+func (b *balancer) full() bool {
+	return b.maxPods > 0 && len(b.plans) >= b.maxPods
+}
+
+// drainHotNodes moves pods out of the nodes that above HighWaterLevel
+func (b *balancer) drainHotNodes() {
+	hotNodes := make([]*nodeState, 0)
+	for _, ns := range b.nodes {
+		if ns.usage() > b.highWaterLevel {
+			hotNodes = append(hotNodes, ns)
+		}
+	}
+	sort.SliceStable(hotNodes, func(i, j int) bool { return hotNodes[i].usage() > hotNodes[j].usage() })
+	for _, ns := range hotNodes {
+		// move the pods of the most crowded workload first
+		pods := append([]*calculator.PodItem(nil), ns.pods...)
+		sort.SliceStable(pods, func(i, j int) bool {
+			ri, rj := ns.replicas[pods[i].Workload], ns.replicas[pods[j].Workload]
+			if ri != rj {
+				return ri > rj
+			}
+			return pods[i].Index2 > pods[j].Index2
+		})
+		for _, pod := range pods {
+			if ns.usage() <= b.highWaterLevel || b.full() {
+				break
+			}
+			target := b.selectTarget(pod, ns, "", true)
+			if target == nil {
+				continue
+			}
+			b.move(pod, ns, target)
+		}
+	}
+}
+
+// spreadWorkloads spreads the replicas of each workload across zones, then across nodes
+func (b *balancer) spreadWorkloads() {
+	workloads := make([]string, 0, len(b.workloads))
+	for wk := range b.workloads {
+		workloads = append(workloads, wk)
+	}
+	sort.Strings(workloads)
+	for _, wk := range workloads {
+		if len(b.workloads[wk]) < 2 {
+			continue
+		}
+		for !b.full() && b.spreadOnce(wk, true) {
+		}
+		for !b.full() && b.spreadOnce(wk, false) {
+		}
+	}
+}
+
+// spreadOnce moves one replica of the workload from the most crowded zone(or node) to the least one,
+// returns false if the skew is within MaxSkew or no pod can be moved.
+func (b *balancer) spreadOnce(workload string, byZone bool) bool {
+	counts := make(map[string]int)
+	for _, ns := range b.nodes {
+		key := ns.name
+		if byZone {
+			key = ns.zone
+		}
+		counts[key] += ns.replicas[workload]
+	}
+	if byZone && len(counts) < 2 {
+		return false
+	}
+	// find the source node which has the most replicas in the most crowded domain
+	var source *nodeState
+	for _, ns := range b.nodes {
+		if ns.replicas[workload] == 0 || b.movablePod(ns, workload) == nil {
+			continue
+		}
+		if source == nil || b.domainCount(counts, ns, byZone) > b.domainCount(counts, source, byZone) ||
+			(b.domainCount(counts, ns, byZone) == b.domainCount(counts, source, byZone) &&
+				ns.replicas[workload] > source.replicas[workload]) {
+			source = ns
+		}
+	}
+	if source == nil {
+		return false
+	}
+	pod := b.movablePod(source, workload)
+	excludeDomain := source.name
+	if byZone {
+		excludeDomain = source.zone
+	}
+	target := b.selectTarget(pod, source, excludeDomain, false)
+	if target == nil {
+		return false
+	}
+	if b.domainCount(counts, source, byZone)-b.domainCount(counts, target, byZone) <= b.maxSkew {
+		return false
+	}
+	b.move(pod, source, target)
+	return true
+}
+
+func (b *balancer) domainCount(counts map[string]int, ns *nodeState, byZone bool) int {
+	if byZone {
+		return counts[ns.zone]
+	}
+	return counts[ns.name]
+}
+
+// movablePod returns a pod of the workload on the node that has not been planned
+func (b *balancer) movablePod(ns *nodeState, workload string) *calculator.PodItem {
+	for _, pod := range ns.pods {
+		if pod.Workload != workload {
+			continue
+		}
+		if _, ok := b.planned[pod.Item]; ok {
+			continue
+		}
+		return pod
+	}
+	return nil
+}
+
+// selectTarget returns the best node for the pod. Candidates should fit the pod and not exceed
+// HighWaterLevel after moved. If preferLow is true, the nodes below LowWaterLevel are preferred.
+// Then the node in the zone that has less replicas of the workload, the node that has less replicas,
+// and the node with lower usage are preferred.
+func (b *balancer) selectTarget(pod *calculator.PodItem, source *nodeState, excludeDomain string,
+	preferLow bool) *nodeState {
+	if _, ok := b.planned[pod.Item]; ok {
+		return nil
+	}
+	zoneCounts := make(map[string]int)
+	for _, ns := range b.nodes {
+		zoneCounts[ns.zone] += ns.replicas[pod.Workload]
+	}
+	var target *nodeState
+	for _, ns := range b.nodes {
+		if ns == source || (excludeDomain != "" && (ns.name == excludeDomain || ns.zone == excludeDomain)) {
+			continue
+		}
+		if ns.usageWith(pod.Index2, pod.Index1) > b.highWaterLevel || !podFitsNode(pod.OriginalPod, ns) {
+			continue
+		}
+		if target == nil || b.better(pod.Workload, ns, target, zoneCounts, preferLow) {
+			target = ns
+		}
+	}
+	return target
+}
+
+// better returns whether node a is better than node b
+func (b *balancer) better(workload string, a, c *nodeState, zoneCounts map[string]int, preferLow bool) bool {
+	if preferLow {
+		aLow, cLow := a.usage() < b.lowWaterLevel, c.usage() < b.lowWaterLevel
+		if aLow != cLow {
+			return aLow
+		}
+	}
+	if zoneCounts[a.zone] != zoneCounts[c.zone] {
+		return zoneCounts[a.zone] < zoneCounts[c.zone]
+	}
+	if a.replicas[workload] != c.replicas[workload] {
+		return a.replicas[workload] < c.replicas[workload]
+	}
+	return a.usage() < c.usage()
+}
+
+// move records the plan and updates the state of nodes
+func (b *balancer) move(pod *calculator.PodItem, source, target *nodeState) {
+	b.planned[pod.Item] = struct{}{}
+	source.usedCPU -= pod.Index2
+	source.usedMem -= pod.Index1
+	source.replicas[pod.Workload]--
+	target.usedCPU += pod.Index2
+	target.usedMem += pod.Index1
+	target.replicas[pod.Workload]++
+	b.plans = append(b.plans, calculator.ResponseMigratePlan{
+		Item: pod.Item,
+		From: source.name,
+		To:   target.name,
+	})
+	blog.V(4).Infof("balance calculator plan '%s': %s -> %s", pod.Item, source.name, target.name)
+}
+
+// podFitsNode checks the node is schedulable for the pod with nodeSelector and taints
+func podFitsNode(pod *corev1.Pod, ns *nodeState) bool {
+	node := ns.node
+	if node.Spec.Unschedulable {
+		return false
+	}
+	for k, v := range pod.Spec.NodeSelector {
+		if node.Labels[k] != v {
+			return false
+		}
+	}
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect != corev1.TaintEffectNoSchedule && taint.Effect != corev1.TaintEffectNoExecute {
+			continue
+		}
+		tolerated := false
+		for j := range pod.Spec.Tolerations {
+			if pod.Spec.Tolerations[j].ToleratesTaint(taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+	return true
+}
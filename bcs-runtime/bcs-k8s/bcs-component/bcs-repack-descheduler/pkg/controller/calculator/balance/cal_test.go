@@ -0,0 +1,238 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package balance
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-repack-descheduler/pkg/apis/tkex/v1alpha1"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-repack-descheduler/pkg/controller/calculator"
+)
+
+// testNode returns a node with 10 cores and 10 bytes memory, so the usage equals to the used value / 10
+func testNode(name, zone string) *calculator.NodeItem {
+	return &calculator.NodeItem{
+		Container: name,
+		OriginalNode: &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{defaultZoneLabel: zone}},
+			Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10"),
+				corev1.ResourceMemory: resource.MustParse("10"),
+			}},
+		},
+	}
+}
+
+func testPod(name, node, workload string, cpu float64) *calculator.PodItem {
+	return &calculator.PodItem{
+		Item:           "default/" + name,
+		Index1:         cpu,
+		Index2:         cpu,
+		Container:      node,
+		IsAllowMigrate: 1,
+		Workload:       workload,
+		OriginalPod:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}},
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	tainted := testNode("node-b", "zone-a")
+	tainted.OriginalNode.Spec.Taints = []corev1.Taint{{Key: "dedicated", Effect: corev1.TaintEffectNoSchedule}}
+	unschedulable := testNode("node-b", "zone-b")
+	unschedulable.OriginalNode.Spec.Unschedulable = true
+	fixed := testPod("fixed", "node-a", "wk", 5)
+	fixed.IsAllowMigrate = 0
+
+	tests := []struct {
+		name     string
+		strategy v1alpha1.DescheduleBalanceStrategy
+		nodes    []*calculator.NodeItem
+		pods     []*calculator.PodItem
+		// expect pod -> target node
+		expect map[string]string
+	}{
+		{
+			name:     "drain hot node to the node below low water level",
+			strategy: v1alpha1.DescheduleBalanceStrategy{LowWaterLevel: 0.3, HighWaterLevel: 0.8, MaxSkew: 10},
+			nodes:    []*calculator.NodeItem{testNode("node-a", "zone-a"), testNode("node-b", "zone-a")},
+			pods: []*calculator.PodItem{
+				testPod("a1", "node-a", "wk1", 5), testPod("a2", "node-a", "wk2", 4),
+			},
+			expect: map[string]string{"default/a1": "node-b"},
+		},
+		{
+			name:     "spread the replicas across zones",
+			strategy: v1alpha1.DescheduleBalanceStrategy{HighWaterLevel: 0.9},
+			nodes: []*calculator.NodeItem{
+				testNode("node-a", "zone-a"), testNode("node-b", "zone-a"), testNode("node-c", "zone-b"),
+			},
+			pods: []*calculator.PodItem{
+				testPod("p1", "node-a", "wk", 1), testPod("p2", "node-a", "wk", 1),
+				testPod("p3", "node-b", "wk", 1), testPod("p4", "node-b", "wk", 1),
+			},
+			expect: map[string]string{"default/p1": "node-c", "default/p3": "node-c"},
+		},
+		{
+			name:     "skew within max skew",
+			strategy: v1alpha1.DescheduleBalanceStrategy{HighWaterLevel: 0.9, MaxSkew: 2},
+			nodes:    []*calculator.NodeItem{testNode("node-a", "zone-a"), testNode("node-b", "zone-a")},
+			pods: []*calculator.PodItem{
+				testPod("p1", "node-a", "wk", 1), testPod("p2", "node-a", "wk", 1),
+			},
+			expect: map[string]string{},
+		},
+		{
+			name:     "max pods limits the plans",
+			strategy: v1alpha1.DescheduleBalanceStrategy{HighWaterLevel: 0.9, MaxPods: 1},
+			nodes:    []*calculator.NodeItem{testNode("node-a", "zone-a"), testNode("node-b", "zone-a")},
+			pods: []*calculator.PodItem{
+				testPod("p1", "node-a", "wk", 1), testPod("p2", "node-a", "wk", 1),
+				testPod("p3", "node-a", "wk", 1), testPod("p4", "node-a", "wk", 1),
+			},
+			expect: map[string]string{"default/p1": "node-b"},
+		},
+		{
+			name:     "target exceeds high water level",
+			strategy: v1alpha1.DescheduleBalanceStrategy{HighWaterLevel: 0.5},
+			nodes:    []*calculator.NodeItem{testNode("node-a", "zone-a"), testNode("node-b", "zone-a")},
+			pods: []*calculator.PodItem{
+				testPod("a1", "node-a", "wk1", 6), testPod("b1", "node-b", "wk2", 4),
+			},
+			expect: map[string]string{},
+		},
+		{
+			name:     "tainted node is not a target",
+			strategy: v1alpha1.DescheduleBalanceStrategy{HighWaterLevel: 0.9},
+			nodes:    []*calculator.NodeItem{testNode("node-a", "zone-a"), tainted},
+			pods: []*calculator.PodItem{
+				testPod("p1", "node-a", "wk", 1), testPod("p2", "node-a", "wk", 1),
+				testPod("p3", "node-a", "wk", 1),
+			},
+			expect: map[string]string{},
+		},
+		{
+			name:     "unschedulable node is not a target",
+			strategy: v1alpha1.DescheduleBalanceStrategy{HighWaterLevel: 0.9},
+			nodes:    []*calculator.NodeItem{testNode("node-a", "zone-a"), unschedulable},
+			pods: []*calculator.PodItem{
+				testPod("p1", "node-a", "wk", 1), testPod("p2", "node-a", "wk", 1),
+				testPod("p3", "node-a", "wk", 1),
+			},
+			expect: map[string]string{},
+		},
+		{
+			name:     "pod not allowed to migrate",
+			strategy: v1alpha1.DescheduleBalanceStrategy{HighWaterLevel: 0.4},
+			nodes:    []*calculator.NodeItem{testNode("node-a", "zone-a"), testNode("node-b", "zone-a")},
+			pods:     []*calculator.PodItem{fixed},
+			expect:   map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy := tt.strategy
+			result, err := NewCalculatorBalance(&strategy).Calculate(context.Background(),
+				&calculator.CalculateConvergeRequest{
+					Original: &calculator.CalculateOriginalData{Nodes: tt.nodes, Pods: tt.pods},
+				})
+			if err != nil {
+				t.Fatalf("calculate failed: %v", err)
+			}
+			if len(result.Plans) != 1 {
+				t.Fatalf("expect 1 plan, got %d", len(result.Plans))
+			}
+			got := make(map[string]string)
+			for _, plan := range result.Plans[0].MigratePlan {
+				got[plan.Item] = plan.To
+			}
+			if len(got) != len(tt.expect) {
+				t.Fatalf("expect plans %v, got %v", tt.expect, got)
+			}
+			for item, to := range tt.expect {
+				if got[item] != to {
+					t.Errorf("expect %s moved to %s, got %v", item, to, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateEmptyRequest(t *testing.T) {
+	c := NewCalculatorBalance(&v1alpha1.DescheduleBalanceStrategy{})
+	if _, err := c.Calculate(context.Background(), &calculator.CalculateConvergeRequest{}); err == nil {
+		t.Errorf("expect error with empty original data")
+	}
+}
+
+func TestPodFitsNode(t *testing.T) {
+	node := &nodeState{node: &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"pool": "a"}},
+		Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+			{Key: "dedicated", Value: "game", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "soft", Effect: corev1.TaintEffectPreferNoSchedule},
+		}},
+	}}
+	toleration := corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "game",
+		Effect: corev1.TaintEffectNoSchedule}
+	tests := []struct {
+		name   string
+		spec   corev1.PodSpec
+		expect bool
+	}{
+		{name: "taint not tolerated", spec: corev1.PodSpec{}, expect: false},
+		{name: "taint tolerated", spec: corev1.PodSpec{Tolerations: []corev1.Toleration{toleration}}, expect: true},
+		{
+			name: "node selector matched",
+			spec: corev1.PodSpec{NodeSelector: map[string]string{"pool": "a"},
+				Tolerations: []corev1.Toleration{toleration}},
+			expect: true,
+		},
+		{
+			name: "node selector not matched",
+			spec: corev1.PodSpec{NodeSelector: map[string]string{"pool": "b"},
+				Tolerations: []corev1.Toleration{toleration}},
+			expect: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := podFitsNode(&corev1.Pod{Spec: tt.spec}, node); got != tt.expect {
+				t.Errorf("expect %v, got %v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestNodeUsage(t *testing.T) {
+	tests := []struct {
+		name   string
+		node   nodeState
+		expect float64
+	}{
+		{name: "cpu is higher", node: nodeState{allocCPU: 10, allocMem: 10, usedCPU: 6, usedMem: 2}, expect: 0.6},
+		{name: "memory is higher", node: nodeState{allocCPU: 10, allocMem: 10, usedCPU: 1, usedMem: 5}, expect: 0.5},
+		{name: "zero allocatable", node: nodeState{usedCPU: 1, usedMem: 1}, expect: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.node.usage(); got != tt.expect {
+				t.Errorf("expect %v, got %v", tt.expect, got)
+			}
+		})
+	}
+}
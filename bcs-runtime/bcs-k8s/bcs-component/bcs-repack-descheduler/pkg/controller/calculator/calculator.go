@@ -87,6 +87,7 @@ type PodItem struct {
 	IsAllowMigrate    int32       `json:"is_allow_migrate"`
 	MigrationPriority int32       `json:"migration_priority"`
 	OriginalPod       *corev1.Pod `json:"-"`
+	Workload          string      `json:"-"`
 }
 
 // NodeItem defines every node
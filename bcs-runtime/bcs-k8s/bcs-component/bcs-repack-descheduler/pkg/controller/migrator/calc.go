@@ -29,6 +29,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-repack-descheduler/pkg/apis"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-repack-descheduler/pkg/apis/tkex/v1alpha1"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-repack-descheduler/pkg/controller/calculator"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-repack-descheduler/pkg/controller/calculator/balance"
 )
 
 var (
@@ -40,6 +41,7 @@ var (
 func (m *descheduleMigratorManager) SendCalculateJob(policy *v1alpha1.DeschedulePolicy) {
 	m.Lock()
 	defer m.Unlock()
+	m.policy = policy.DeepCopy()
 	if _, ok := calcJobs.Load(apis.NamespacedNamePolicy(policy)); !ok {
 		calcJobs.Store(apis.NamespacedNamePolicy(policy), struct{}{})
 		go m.calculateJobRun(policy.Namespace, policy.Name)
@@ -93,6 +95,12 @@ func (m *descheduleMigratorManager) calculate(namespace, name string) {
 		blog.Errorf("calculate job have not result plans")
 		return
 	}
+	// 均衡策略没有需要迁移的 Pod 时，清理上次的计划
+	if len(resultPlan.Plans[0].MigratePlan) == 0 {
+		blog.Infof("calculate job for '%s/%s' have no pods need to migrate", namespace, name)
+		m.plans = make(map[string][]*calculator.ResponseMigratePlan)
+		return
+	}
 	// DOTO: 暂时取第一个 plan
 	migratePlans := resultPlan.Plans[0].MigratePlan
 	bs, _ := json.Marshal(migratePlans)
@@ -133,6 +141,17 @@ func (m *descheduleMigratorManager) calculateFromRemoteOrLocal() (
 	if err != nil {
 		return nil, errors.Wrapf(err, "build calculator request failed")
 	}
+	m.RLock()
+	policy := m.policy
+	m.RUnlock()
+	// 均衡策略仅使用本地计算
+	if policy != nil && policy.Spec.IsBalance() {
+		result, balanceErr := balance.NewCalculatorBalance(&policy.Spec.Balance).Calculate(ctx, req)
+		if balanceErr != nil {
+			return nil, errors.Wrapf(balanceErr, "calculate balance failed")
+		}
+		return &result, nil
+	}
 	result, err := m.calculatorRemote.Calculate(ctx, req)
 	if err == nil {
 		return &result, nil
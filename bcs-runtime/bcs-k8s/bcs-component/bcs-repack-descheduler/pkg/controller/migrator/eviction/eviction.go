@@ -37,6 +37,8 @@ type WorkloadEvictionMessage struct {
 	TraceID string
 	Node    string
 	Plans   []*calculator.ResponseMigratePlan
+	// SkipCordon will not cordon the node before eviction
+	SkipCordon bool
 }
 
 // EvictInterface defines the interface of evict.
@@ -66,9 +68,11 @@ func NewEvictManager() EvictInterface {
 // EvictNode 驱逐节点上的 Pod 实例
 func (m *EvictManager) EvictNode(ctx context.Context, message *WorkloadEvictionMessage) {
 	blog.Infof("eviction node '%s' is started, trace=%s", message.Node, message.TraceID)
-	if err := m.cacheManager.CordonNode(ctx, message.Node); err != nil {
-		blog.Errorf("eviction node '%s' cordon failed: %s, trace=%s", message.Node, err.Error(), message.TraceID)
-		return
+	if !message.SkipCordon {
+		if err := m.cacheManager.CordonNode(ctx, message.Node); err != nil {
+			blog.Errorf("eviction node '%s' cordon failed: %s, trace=%s", message.Node, err.Error(), message.TraceID)
+			return
+		}
 	}
 	wg := &sync.WaitGroup{}
 	wg.Add(len(message.Plans))
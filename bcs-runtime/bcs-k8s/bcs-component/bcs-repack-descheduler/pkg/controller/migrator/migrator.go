@@ -39,6 +39,8 @@ func (m *descheduleMigratorManager) Migrate() {
 	}
 	m.migrating.Store(true)
 	m.workloadPlansMap = &sync.Map{}
+	// 均衡策略只迁移部分 Pod，不需要封锁源节点
+	skipCordon := m.policy != nil && m.policy.Spec.IsBalance()
 	m.Unlock()
 	defer m.migrating.Store(false)
 
@@ -52,6 +54,7 @@ func (m *descheduleMigratorManager) Migrate() {
 	}
 	runner := &migratorRunner{
 		op:           options.GlobalConfigHandler().GetOptions(),
+		skipCordon:   skipCordon,
 		plans:        m.workloadPlansMap,
 		evictManager: eviction.NewEvictManager(),
 	}
@@ -91,6 +94,7 @@ func (m *descheduleMigratorManager) filterWorkloadPlans(ctx context.Context, ns
 
 type migratorRunner struct {
 	op           *options.DeSchedulerOption
+	skipCordon   bool
 	plans        *sync.Map
 	nodePlans    *sync.Map
 	evictManager eviction.EvictInterface
@@ -128,9 +132,10 @@ func (r *migratorRunner) run(ctx context.Context, wg *sync.WaitGroup, nodeCh cha
 			}
 			plans := v.([]*calculator.ResponseMigratePlan)
 			r.evictManager.EvictNode(ctx, &eviction.WorkloadEvictionMessage{
-				TraceID: uuid.New().String(),
-				Node:    node,
-				Plans:   plans,
+				TraceID:    uuid.New().String(),
+				Node:       node,
+				Plans:      plans,
+				SkipCordon: r.skipCordon,
 			})
 		case <-ctx.Done():
 			return
@@ -34,7 +34,7 @@ func (m *descheduleMigratorManager) CreateMigrateJob(policy *v1alpha1.Deschedule
 	if m.migrateJob != nil && m.migrateJob.stopped.Load() {
 		m.migrateJob = nil
 	}
-	timeRange := policy.Spec.Converge.TimeRange
+	timeRange := policy.Spec.TimeRange()
 	if m.migrateJob == nil {
 		m.migrateJob = &cronJobInstance{
 			timeRange: timeRange,
@@ -52,6 +52,9 @@ type descheduleMigratorManager struct {
 
 	op *options.DeSchedulerOption
 
+	// policy 当前生效的 Policy
+	policy *v1alpha1.DeschedulePolicy
+
 	// plans 存储计算到的迁移计划
 	plans map[string][]*calculator.ResponseMigratePlan
 
@@ -45,7 +45,7 @@ func (m *ControllerManager) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		m.migrator.DeleteMigrateJob(policy)
 		return ctrl.Result{}, nil
 	}
-	if !policy.Spec.Converge.Disabled {
+	if !policy.Spec.IsDisabled() {
 		if err := m.migrator.CreateMigrateJob(policy); err != nil {
 			blog.Errorf("policy '%s' create migrate job failed: %s", req.NamespacedName, err.Error())
 			return ctrl.Result{
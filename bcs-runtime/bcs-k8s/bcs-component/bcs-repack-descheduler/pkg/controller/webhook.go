@@ -14,6 +14,7 @@
 package controller
 
 import (
+	"strings"
 	"time"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
@@ -42,16 +43,41 @@ func (m *ControllerManager) RegisterValidateUpdate(policy *v1alpha1.DeschedulePo
 }
 
 func (m *ControllerManager) validate(policy *v1alpha1.DeschedulePolicy) error {
-	strategy := &policy.Spec.Converge
-	if strategy.TimeRange == "" {
-		return errors.Errorf("converge timeRange cannot be empty")
+	if !policy.Spec.IsValidType() {
+		return errors.Errorf("policy type '%s' is not supported, must be one of %s/%s", policy.Spec.Type,
+			v1alpha1.DescheduleTypeConverge, v1alpha1.DescheduleTypeBalance)
 	}
-	schedule, err := cron.ParseStandard(strategy.TimeRange)
+	if policy.Spec.IsBalance() {
+		if err := validateBalance(&policy.Spec.Balance); err != nil {
+			return err
+		}
+	}
+	timeRange := policy.Spec.TimeRange()
+	if timeRange == "" {
+		return errors.Errorf("%s timeRange cannot be empty", strings.ToLower(string(policy.Spec.Type)))
+	}
+	schedule, err := cron.ParseStandard(timeRange)
 	if err != nil {
-		return errors.Wrapf(err, "converge spec.converge.timeRange parse failed, timeRange=%s",
-			strategy.TimeRange)
+		return errors.Wrapf(err, "%s timeRange parse failed, timeRange=%s",
+			strings.ToLower(string(policy.Spec.Type)), timeRange)
 	}
 	blog.Infof("[Webhook] %s/%s next time is '%s'", policy.Namespace, policy.Name,
 		schedule.Next(time.Now()).Format("2006-01-02 15:04:05"))
 	return nil
 }
+
+func validateBalance(strategy *v1alpha1.DescheduleBalanceStrategy) error {
+	if strategy.LowWaterLevel < 0 || strategy.LowWaterLevel > 1 {
+		return errors.Errorf("balance lowWaterLevel must be in [0, 1]")
+	}
+	if strategy.HighWaterLevel <= 0 || strategy.HighWaterLevel > 1 {
+		return errors.Errorf("balance highWaterLevel must be in (0, 1]")
+	}
+	if strategy.LowWaterLevel > strategy.HighWaterLevel {
+		return errors.Errorf("balance lowWaterLevel cannot be greater than highWaterLevel")
+	}
+	if strategy.MaxPods < 0 || strategy.MaxSkew < 0 {
+		return errors.Errorf("balance maxPods and maxSkew cannot be negative")
+	}
+	return nil
+}
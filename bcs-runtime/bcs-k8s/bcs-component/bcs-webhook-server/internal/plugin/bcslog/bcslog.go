@@ -40,6 +40,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/metrics"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/plugin"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/pluginutil"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/types"
 )
@@ -150,6 +151,21 @@ func (h *Hooker) AnnotationKey() string {
 	return ""
 }
 
+// SupportDryRun bcslog reads log configs from informer cache and patches containers
+func (h *Hooker) SupportDryRun() bool {
+	return true
+}
+
+// Priority bcslog rewrites the whole container, runs after other plugins so that their patches are kept
+func (h *Hooker) Priority() int {
+	return 90
+}
+
+// FailurePolicy log env injection should not block creating pod
+func (h *Hooker) FailurePolicy() plugin.FailurePolicy {
+	return plugin.FailurePolicyIgnore
+}
+
 // Handle implements webhook plugin interface
 func (h *Hooker) Handle(ar v1beta1.AdmissionReview) *v1beta1.AdmissionResponse {
 	req := ar.Request
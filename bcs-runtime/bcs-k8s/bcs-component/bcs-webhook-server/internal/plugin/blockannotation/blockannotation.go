@@ -51,6 +51,11 @@ func (ab *AnnotationBlocker) AnnotationKey() string {
 	return ab.conf.AnnotationKey
 }
 
+// SupportDryRun annoblocker only checks annotations of the object
+func (ab *AnnotationBlocker) SupportDryRun() bool {
+	return true
+}
+
 // Init init plugin
 func (ab *AnnotationBlocker) Init(configFilePath string) error {
 	fileBytes, err := ioutil.ReadFile(configFilePath)
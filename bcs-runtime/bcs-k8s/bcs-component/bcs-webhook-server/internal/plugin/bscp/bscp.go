@@ -40,6 +40,16 @@ func (h *Hooker) AnnotationKey() string {
 	return AnnotationKey
 }
 
+// SupportDryRun bscp sidecar is injected by patch only
+func (h *Hooker) SupportDryRun() bool {
+	return true
+}
+
+// Priority bscp rewrites containers and inserts sidecars, runs after plugins which patch fields of containers
+func (h *Hooker) Priority() int {
+	return 80
+}
+
 // Init init webhook plugin, plugin should read config from file configFilePath
 func (h *Hooker) Init(configFilePath string) error {
 	fileBytes, err := ioutil.ReadFile(configFilePath)
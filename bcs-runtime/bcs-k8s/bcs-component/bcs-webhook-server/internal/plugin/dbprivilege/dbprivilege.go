@@ -80,6 +80,11 @@ func (h *Hooker) AnnotationKey() string {
 	return ""
 }
 
+// Priority dbpriv rewrites init containers, runs after randhostport which patches init container ports
+func (h *Hooker) Priority() int {
+	return 50
+}
+
 // Init implements plugin interface
 func (h *Hooker) Init(configFilePath string) error {
 	fileBytes, err := ioutil.ReadFile(configFilePath) // nolint
@@ -77,6 +77,11 @@ func (h *Hooker) AnnotationKey() string {
 	return ""
 }
 
+// SupportDryRun implements plugin.DryRunPlugin
+func (h *Hooker) SupportDryRun() bool {
+	return true
+}
+
 // Close implements plugin interface
 func (h *Hooker) Close() error {
 	return nil
@@ -97,6 +97,11 @@ func (h *Handler) AnnotationKey() string {
 	return ""
 }
 
+// SupportDryRun filterclb only validates services and ingresses
+func (h *Handler) SupportDryRun() bool {
+	return true
+}
+
 // Init init the filterclb plugin
 func (h *Handler) Init(configFilePath string) error {
 	gvkArrayString := make([]string, 0, 4)
@@ -80,6 +80,16 @@ func (gi *Injector) AnnotationKey() string {
 	return pluginAnnotationKey
 }
 
+// SupportDryRun gpu resources are injected by patch only
+func (gi *Injector) SupportDryRun() bool {
+	return true
+}
+
+// Priority gpu injector replaces container resources, runs before plugins which rewrite the whole container
+func (gi *Injector) Priority() int {
+	return 20
+}
+
 // Init do init action, load config
 func (gi *Injector) Init(configFilePath string) error {
 	fileBytes, err := os.ReadFile(configFilePath)
@@ -28,6 +28,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/metrics"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/plugin"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/plugin/imageacceleration/cachemanager"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/pluginutil"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/types"
@@ -59,6 +60,16 @@ func (h *Handler) AnnotationKey() string {
 	return ""
 }
 
+// Priority imageacceleration replaces container images, runs before plugins which rewrite the whole container
+func (h *Handler) Priority() int {
+	return 10
+}
+
+// FailurePolicy image acceleration is optional, the original image is kept when it fails
+func (h *Handler) FailurePolicy() plugin.FailurePolicy {
+	return plugin.FailurePolicyIgnore
+}
+
 // Handle do hook function
 func (h *Handler) Handle(ar v1beta1.AdmissionReview) *v1beta1.AdmissionResponse {
 	req := ar.Request
@@ -42,6 +42,16 @@ func (p *PatchMount) AnnotationKey() string {
 	return pluginAnnotationKey
 }
 
+// SupportDryRun mounts are patched without creating any resource
+func (p *PatchMount) SupportDryRun() bool {
+	return true
+}
+
+// Priority patchmount appends volumes and volume mounts, runs before plugins which rewrite the whole container
+func (p *PatchMount) Priority() int {
+	return 40
+}
+
 // Init initialize the plugin
 func (p *PatchMount) Init(configFilePath string) error {
 	return nil
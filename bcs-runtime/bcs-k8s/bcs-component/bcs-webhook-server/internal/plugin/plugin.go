@@ -28,3 +28,33 @@ type Interface interface {
 	// Close called when webhook plugin exit
 	Close() error
 }
+
+// FailurePolicy defines how plugin chain handles plugin failure
+type FailurePolicy string
+
+const (
+	// FailurePolicyFail reject the object when plugin failed
+	FailurePolicyFail FailurePolicy = "Fail"
+	// FailurePolicyIgnore ignore the failed plugin and its patches, continue with next plugin
+	FailurePolicyIgnore FailurePolicy = "Ignore"
+)
+
+// DefaultPriority priority for plugin which does not implement OrderedPlugin
+const DefaultPriority = 100
+
+// OrderedPlugin optional interface for plugin to declare its position in plugin chain,
+// plugin with smaller priority handles the object earlier
+type OrderedPlugin interface {
+	Priority() int
+}
+
+// FailurePolicyPlugin optional interface for plugin to declare its failure policy, default is Fail
+type FailurePolicyPlugin interface {
+	FailurePolicy() FailurePolicy
+}
+
+// DryRunPlugin optional interface for plugin to declare that it has no side effect when handling object,
+// plugins which do not support dry run are skipped by dry run requests
+type DryRunPlugin interface {
+	SupportDryRun() bool
+}
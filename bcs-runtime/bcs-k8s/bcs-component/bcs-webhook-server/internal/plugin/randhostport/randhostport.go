@@ -70,6 +70,16 @@ func (hpi *HostPortInjector) AnnotationKey() string {
 	return pluginAnnotationKey
 }
 
+// Priority randhostport patches container ports and env, runs before plugins which rewrite the whole container
+func (hpi *HostPortInjector) Priority() int {
+	return 30
+}
+
+// SupportDryRun host ports are chosen without updating port cache for dry run request
+func (hpi *HostPortInjector) SupportDryRun() bool {
+	return true
+}
+
 // Init init host port injector kubeclient
 func (hpi *HostPortInjector) Init(configFilePath string) error {
 	var err error
@@ -178,7 +188,7 @@ func (hpi *HostPortInjector) Handle(ar v1beta1.AdmissionReview) *v1beta1.Admissi
 		}
 	}
 
-	patches, err := hpi.injectToPod(pod)
+	patches, err := hpi.injectToPod(pod, req.DryRun != nil && *req.DryRun)
 	if err != nil {
 		blog.Errorf("inject to pod %s/%s failed, err %s", pod.GetName(), pod.GetNamespace(), err.Error())
 		metrics.ReportBcsWebhookServerPluginLantency(pluginName, metrics.StatusFailure, started)
@@ -212,8 +222,9 @@ func (hpi *HostPortInjector) injectRequired(pod *corev1.Pod) bool {
 	return true
 }
 
+// injectToPod generate patches of host ports, port cache and next order port are not changed when dryRun is true
 // nolint funlen
-func (hpi *HostPortInjector) injectToPod(pod *corev1.Pod) ([]types.PatchOperation, error) {
+func (hpi *HostPortInjector) injectToPod(pod *corev1.Pod, dryRun bool) ([]types.PatchOperation, error) {
 	portStrs := getPortStringsFromPodAnnotations(pod.Annotations)
 	if len(portStrs) == 0 {
 		return nil, fmt.Errorf("pod %s/%s does not specify container port to inject random hostport",
@@ -260,11 +271,24 @@ func (hpi *HostPortInjector) injectToPod(pod *corev1.Pod) ([]types.PatchOperatio
 			portEntry := hpi.portCache.PopPortEntry()
 			hostPorts = append(hostPorts, portEntry)
 		}
-		for _, hostPort := range hostPorts {
-			hostPort.Quantity++
-			hpi.portCache.PushPortEntry(hostPort)
+		if dryRun {
+			// put back the popped entries unchanged, return copies to avoid sharing entries with cache
+			for index, hostPort := range hostPorts {
+				hpi.portCache.PushPortEntry(hostPort)
+				hostPorts[index] = &PortEntry{Port: hostPort.Port, Quantity: hostPort.Quantity}
+			}
+		} else {
+			for _, hostPort := range hostPorts {
+				hostPort.Quantity++
+				hpi.portCache.PushPortEntry(hostPort)
+			}
 		}
 		hpi.portCache.Unlock()
+	} else if dryRun {
+		// get order host port without moving the next assign port
+		for i := 0; i < needInjectCount; i++ {
+			hostPorts = append(hostPorts, &PortEntry{Port: hpi.peekOrderPort(i)})
+		}
 	} else {
 		// get order host port
 		for i := 0; i < needInjectCount; i++ {
@@ -584,6 +608,12 @@ func (hpi *HostPortInjector) OnDelete(obj interface{}) {
 	}
 }
 
+// peekOrderPort get the offset-th port from next assign port in order mode
+func (hpi *HostPortInjector) peekOrderPort(offset int) uint64 {
+	period := hpi.conf.EndPort - hpi.conf.StartPort + 1
+	return hpi.conf.StartPort + (hpi.nextAssignPort-hpi.conf.StartPort+uint64(offset))%period
+}
+
 func (hpi *HostPortInjector) getOrderPortEntry() *PortEntry {
 	portEntry := &PortEntry{
 		Port: hpi.nextAssignPort,
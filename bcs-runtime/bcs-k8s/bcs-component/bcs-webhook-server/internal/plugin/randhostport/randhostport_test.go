@@ -1542,7 +1542,21 @@ func TestInjectPod(t *testing.T) { // nolint
 			portCache: portCache,
 			conf:      &HostPortInjectorConfig{},
 		}
-		patches, err := hpi.injectToPod(test.Pod)
+		quantities := make(map[uint64]uint64)
+		for _, entry := range test.PortsList {
+			quantities[entry.Port] = entry.Quantity
+		}
+		// dry run should return the same patches without changing port cache
+		dryRunPatches, dryRunErr := hpi.injectToPod(test.Pod, true)
+		for port, quantity := range quantities {
+			if entry := portCache.GetPortEntry(port); entry == nil || entry.Quantity != quantity {
+				t.Errorf("port %d changed by dry run, got %+v", port, entry)
+			}
+		}
+		patches, err := hpi.injectToPod(test.Pod, false)
+		if (dryRunErr == nil) != (err == nil) || !reflect.DeepEqual(dryRunPatches, patches) {
+			t.Errorf("dry run result %v %v differs from %v %v", dryRunPatches, dryRunErr, patches, err)
+		}
 		if err == nil {
 			if test.HasErr {
 				t.Errorf("expect err but get no err")
@@ -1585,3 +1599,24 @@ func TestInjectPod(t *testing.T) { // nolint
 		}
 	}
 }
+
+// TestPeekOrderPort test peekOrderPort does not move next assign port
+func TestPeekOrderPort(t *testing.T) {
+	hpi := &HostPortInjector{
+		conf:           &HostPortInjectorConfig{StartPort: 31000, EndPort: 31002, EnableOrderAssign: true},
+		nextAssignPort: 31001,
+	}
+	for offset, expect := range []uint64{31001, 31002, 31000, 31001} {
+		if port := hpi.peekOrderPort(offset); port != expect {
+			t.Errorf("offset %d expect port %d, got %d", offset, expect, port)
+		}
+	}
+	if hpi.nextAssignPort != 31001 {
+		t.Errorf("next assign port should not be changed, got %d", hpi.nextAssignPort)
+	}
+	for _, expect := range []uint64{31001, 31002, 31000} {
+		if entry := hpi.getOrderPortEntry(); entry.Port != expect {
+			t.Errorf("expect order port %d, got %d", expect, entry.Port)
+		}
+	}
+}
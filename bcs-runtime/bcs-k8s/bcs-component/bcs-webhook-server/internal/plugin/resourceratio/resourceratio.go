@@ -60,6 +60,11 @@ func (r *ResourceRatio) AnnotationKey() string {
 	return ""
 }
 
+// SupportDryRun resource ratio only patches container resources
+func (r *ResourceRatio) SupportDryRun() bool {
+	return true
+}
+
 // Init init the plugin
 func (r *ResourceRatio) Init(configFilePath string) error {
 	configData, err := os.ReadFile(configFilePath)
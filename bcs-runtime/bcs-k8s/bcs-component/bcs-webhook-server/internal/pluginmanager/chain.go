@@ -0,0 +1,528 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pluginmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	jsonpatch "github.com/evanphx/json-patch"
+	"k8s.io/api/admission/v1beta1"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/plugin"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/pluginutil"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/types"
+)
+
+const (
+	// chainConfigFile optional config file in plugin dir to override plugin priority and failure policy
+	chainConfigFile = "chain.conf"
+	// patchPathAppend json patch path suffix for appending to array
+	patchPathAppend = "/-"
+)
+
+// ChainConfig config for kubernetes plugin chain
+// {"plugins": {"randhostport": {"priority": 10, "failurePolicy": "Ignore"}}}
+type ChainConfig struct {
+	Plugins map[string]*ChainPluginConfig `json:"plugins"`
+}
+
+// ChainPluginConfig overrides priority and failure policy declared by plugin
+type ChainPluginConfig struct {
+	Priority      *int                 `json:"priority,omitempty"`
+	FailurePolicy plugin.FailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// ChainNode plugin in plugin chain
+type ChainNode struct {
+	Name          string
+	Plugin        plugin.Interface
+	Priority      int
+	FailurePolicy plugin.FailurePolicy
+	// DryRun whether plugin supports dry run
+	DryRun bool
+}
+
+// ChainResult result of running plugin chain
+type ChainResult struct {
+	// Response admission response for apiserver, patch is the combined patch of all plugins
+	Response *v1beta1.AdmissionResponse `json:"-"`
+	Allowed  bool                       `json:"allowed"`
+	Message  string                     `json:"message,omitempty"`
+	Patches  []types.PatchOperation     `json:"patches"`
+	// Object the object patched by all plugins
+	Object  json.RawMessage `json:"object,omitempty"`
+	Plugins []*PluginResult `json:"plugins"`
+}
+
+// PluginResult result of one plugin in plugin chain
+type PluginResult struct {
+	Name              string                 `json:"name"`
+	Priority          int                    `json:"priority"`
+	FailurePolicy     plugin.FailurePolicy   `json:"failurePolicy"`
+	Skipped           bool                   `json:"skipped,omitempty"`
+	DryRunUnsupported bool                   `json:"dryRunUnsupported,omitempty"`
+	Ignored           bool                   `json:"ignored,omitempty"`
+	Error             string                 `json:"error,omitempty"`
+	Conflicts         []string               `json:"conflicts,omitempty"`
+	Patches           []types.PatchOperation `json:"patches,omitempty"`
+	CostMillis        int64                  `json:"costMillis"`
+}
+
+// loadChainConfig load chain config, return empty config when file does not exist
+func loadChainConfig(configFilePath string) (*ChainConfig, error) {
+	chainConf := &ChainConfig{}
+	data, err := os.ReadFile(configFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return chainConf, nil
+		}
+		return nil, fmt.Errorf("read plugin chain config %s failed, err %s", configFilePath, err.Error())
+	}
+	if err = json.Unmarshal(data, chainConf); err != nil {
+		return nil, fmt.Errorf("decode plugin chain config %s failed, err %s", configFilePath, err.Error())
+	}
+	return chainConf, nil
+}
+
+// newChain create plugin chain sorted by priority
+func newChain(names []string, plugins []plugin.Interface, chainConf *ChainConfig) ([]*ChainNode, error) {
+	chain := make([]*ChainNode, 0, len(plugins))
+	for index, p := range plugins {
+		node := &ChainNode{
+			Name:          names[index],
+			Plugin:        p,
+			Priority:      plugin.DefaultPriority,
+			FailurePolicy: plugin.FailurePolicyFail,
+		}
+		if op, ok := p.(plugin.OrderedPlugin); ok {
+			node.Priority = op.Priority()
+		}
+		if fp, ok := p.(plugin.FailurePolicyPlugin); ok && len(fp.FailurePolicy()) != 0 {
+			node.FailurePolicy = fp.FailurePolicy()
+		}
+		if dp, ok := p.(plugin.DryRunPlugin); ok {
+			node.DryRun = dp.SupportDryRun()
+		}
+		if conf, ok := chainConf.Plugins[node.Name]; ok && conf != nil {
+			if conf.Priority != nil {
+				node.Priority = *conf.Priority
+			}
+			if len(conf.FailurePolicy) != 0 {
+				node.FailurePolicy = conf.FailurePolicy
+			}
+		}
+		if node.FailurePolicy != plugin.FailurePolicyFail && node.FailurePolicy != plugin.FailurePolicyIgnore {
+			return nil, fmt.Errorf("invalid failure policy %s of plugin %s", node.FailurePolicy, node.Name)
+		}
+		chain = append(chain, node)
+	}
+	sort.SliceStable(chain, func(i, j int) bool {
+		return chain[i].Priority < chain[j].Priority
+	})
+	return chain, nil
+}
+
+// patchRecord json patch path written by plugin, and the value after patched
+type patchRecord struct {
+	pluginName string
+	path       string
+	exists     bool
+	value      interface{}
+}
+
+// RunKubernetesChain pass the object through plugin chain, each plugin gets the object patched by earlier plugins.
+// A plugin fails when it rejects the object, returns invalid patch, or changes the value of a path
+// written by earlier plugins (adding to map or array written by earlier plugins is not a conflict),
+// failed plugin is handled by its failure policy.
+// All plugins run for requests from apiserver, including server-side dry run requests,
+// plugins which support dry run should not commit any state when request is marked as dryRun.
+func (m *Manager) RunKubernetesChain(ar v1beta1.AdmissionReview) *ChainResult {
+	return m.runKubernetesChain(ar, false)
+}
+
+// DryRunKubernetesChain pass the object through plugin chain for dry run api,
+// plugins which do not support dry run are skipped.
+func (m *Manager) DryRunKubernetesChain(ar v1beta1.AdmissionReview) *ChainResult {
+	return m.runKubernetesChain(ar, true)
+}
+
+// runKubernetesChain run plugin chain, skip plugins which do not support dry run when skipNoDryRun is true
+// nolint funlen
+func (m *Manager) runKubernetesChain(ar v1beta1.AdmissionReview, skipNoDryRun bool) *ChainResult {
+	req := ar.Request
+	result := &ChainResult{
+		Allowed: true,
+		Patches: make([]types.PatchOperation, 0),
+		Plugins: make([]*PluginResult, 0, len(m.chain)),
+	}
+	current := req.Object.Raw
+	var records []*patchRecord
+	for _, node := range m.chain {
+		pr := &PluginResult{
+			Name:          node.Name,
+			Priority:      node.Priority,
+			FailurePolicy: node.FailurePolicy,
+		}
+		result.Plugins = append(result.Plugins, pr)
+		if skipNoDryRun && !node.DryRun {
+			pr.Skipped = true
+			pr.DryRunUnsupported = true
+			continue
+		}
+		// case 1: if plugin annotation key is empty, always pass object to plugin
+		// case 2: if plugin annotation key is not empty, pass object to plugin if the object has the annotation key
+		annotationKey := node.Plugin.AnnotationKey()
+		if len(annotationKey) != 0 {
+			annotationObj := current
+			if req.Operation == v1beta1.Delete {
+				annotationObj = req.OldObject.Raw
+			}
+			if !hasAnnotation(annotationObj, annotationKey) {
+				pr.Skipped = true
+				continue
+			}
+		}
+
+		startTime := time.Now()
+		blog.Infof("start %s %s/%s hook by plugin %s", req.Kind.Kind, req.Namespace, req.Name, node.Name)
+		nodeReq := *req
+		nodeReq.Object.Raw = current
+		nodeReview := ar
+		nodeReview.Request = &nodeReq
+		resp := handleWithRecover(node, nodeReview)
+		pr.CostMillis = time.Since(startTime).Milliseconds()
+		blog.Infof("end %s %s/%s hook by plugin %s, cost %d Milliseconds",
+			req.Kind.Kind, req.Namespace, req.Name, node.Name, pr.CostMillis)
+
+		var patches []types.PatchOperation
+		modified, err := func() ([]byte, error) {
+			if resp == nil {
+				return nil, fmt.Errorf("plugin %s returns empty response", node.Name)
+			}
+			if !resp.Allowed {
+				message := "rejected"
+				if resp.Result != nil && len(resp.Result.Message) != 0 {
+					message = resp.Result.Message
+				}
+				return nil, fmt.Errorf("plugin %s not allowed, %s", node.Name, message)
+			}
+			if len(resp.Patch) == 0 {
+				return current, nil
+			}
+			if err := json.Unmarshal(resp.Patch, &patches); err != nil {
+				return nil, fmt.Errorf("decode plugin %s patches failed, err %s", node.Name, err.Error())
+			}
+			if len(patches) == 0 {
+				return current, nil
+			}
+			patchObj, err := jsonpatch.DecodePatch(resp.Patch)
+			if err != nil {
+				return nil, fmt.Errorf("decode plugin %s patch failed, err %s", node.Name, err.Error())
+			}
+			patched, err := patchObj.Apply(current)
+			if err != nil {
+				return nil, fmt.Errorf("apply plugin %s patch failed, err %s", node.Name, err.Error())
+			}
+			if err = shiftRecords(records, current, patches); err != nil {
+				return nil, err
+			}
+			pr.Conflicts, err = checkConflicts(records, node.Name, patches, current, patched)
+			if err != nil {
+				return nil, err
+			}
+			if len(pr.Conflicts) != 0 {
+				return nil, fmt.Errorf("plugin %s patches conflict with earlier plugins: %s",
+					node.Name, strings.Join(pr.Conflicts, "; "))
+			}
+			return patched, nil
+		}()
+		if err != nil {
+			pr.Error = err.Error()
+			if node.FailurePolicy == plugin.FailurePolicyIgnore {
+				pr.Ignored = true
+				blog.Warnf("ignore failed plugin %s for %s %s/%s, err %s",
+					node.Name, req.Kind.Kind, req.Namespace, req.Name, err.Error())
+				continue
+			}
+			blog.Errorf("plugin %s failed for %s %s/%s, err %s",
+				node.Name, req.Kind.Kind, req.Namespace, req.Name, err.Error())
+			result.Allowed = false
+			result.Message = err.Error()
+			// keep the response of plugin which rejects the object
+			if resp != nil && !resp.Allowed {
+				result.Response = resp
+			} else {
+				result.Response = pluginutil.ToAdmissionResponse(err)
+			}
+			return result
+		}
+		pr.Patches = patches
+		result.Patches = append(result.Patches, patches...)
+		if len(patches) != 0 {
+			records, err = updateRecords(records, node.Name, patches, modified)
+			if err != nil {
+				blog.Warnf("update patch records of plugin %s failed, err %s", node.Name, err.Error())
+			}
+		}
+		current = modified
+	}
+
+	patchesBytes, err := json.Marshal(result.Patches)
+	if err != nil {
+		blog.Errorf("encoding patches failed, err %s", err.Error())
+		result.Allowed = false
+		result.Message = err.Error()
+		result.Response = pluginutil.ToAdmissionResponse(fmt.Errorf("encoding patches failed, err %s", err.Error()))
+		return result
+	}
+	result.Object = current
+	result.Response = &v1beta1.AdmissionResponse{
+		Allowed: true,
+		Patch:   patchesBytes,
+		PatchType: func() *v1beta1.PatchType {
+			pt := v1beta1.PatchTypeJSONPatch
+			return &pt
+		}(),
+	}
+	return result
+}
+
+// handleWithRecover call plugin Handle, treat panic as plugin failure
+func handleWithRecover(node *ChainNode, ar v1beta1.AdmissionReview) (resp *v1beta1.AdmissionResponse) {
+	defer func() {
+		if r := recover(); r != nil {
+			blog.Errorf("plugin %s panic, %v", node.Name, r)
+			resp = pluginutil.ToAdmissionResponse(fmt.Errorf("plugin %s panic, %v", node.Name, r))
+		}
+	}()
+	return node.Plugin.Handle(ar)
+}
+
+// hasAnnotation check if object has annotation key
+func hasAnnotation(raw []byte, key string) bool {
+	obj := struct {
+		Metadata struct {
+			Annotations map[string]string `json:"annotations"`
+		} `json:"metadata"`
+	}{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return false
+	}
+	_, ok := obj.Metadata.Annotations[key]
+	return ok
+}
+
+// checkConflicts check if patched object keeps values of paths written by other plugins,
+// only paths which overlap the new patches are checked.
+// Patch under a path written by other plugins only conflicts when it overwrites an existing value,
+// e.g. adding a new annotation key after another plugin replaced the annotations is allowed.
+func checkConflicts(records []*patchRecord, pluginName string, patches []types.PatchOperation,
+	original, patched []byte) ([]string, error) {
+	if len(records) == 0 {
+		return nil, nil
+	}
+	var originalDoc, doc interface{}
+	if err := json.Unmarshal(original, &originalDoc); err != nil {
+		return nil, fmt.Errorf("decode original object failed, err %s", err.Error())
+	}
+	if err := json.Unmarshal(patched, &doc); err != nil {
+		return nil, fmt.Errorf("decode patched object failed, err %s", err.Error())
+	}
+	var conflicts []string
+	for _, record := range records {
+		if record.pluginName == pluginName {
+			continue
+		}
+		for _, patch := range patches {
+			if strings.HasSuffix(patch.Path, patchPathAppend) || !pathOverlap(patch.Path, record.path) {
+				continue
+			}
+			conflict := false
+			if strings.HasPrefix(patch.Path, record.path+"/") {
+				conflict = overwritesValue(originalDoc, patch)
+			} else {
+				value, exists := lookupJSONPointer(doc, record.path)
+				conflict = exists != record.exists || !reflect.DeepEqual(value, record.value)
+			}
+			if conflict {
+				conflicts = append(conflicts, fmt.Sprintf("%s %s overwrites %s written by plugin %s",
+					patch.Op, patch.Path, record.path, record.pluginName))
+				break
+			}
+		}
+	}
+	return conflicts, nil
+}
+
+// shiftRecords move recorded paths of array elements after patch inserts into the array,
+// e.g. sidecar inserted at /spec/containers/0 moves record /spec/containers/0/image to /spec/containers/1/image
+func shiftRecords(records []*patchRecord, original []byte, patches []types.PatchOperation) error {
+	if len(records) == 0 {
+		return nil
+	}
+	var doc interface{}
+	if err := json.Unmarshal(original, &doc); err != nil {
+		return fmt.Errorf("decode original object failed, err %s", err.Error())
+	}
+	for _, patch := range patches {
+		if patch.Op != types.PatchOperationAdd || strings.HasSuffix(patch.Path, patchPathAppend) {
+			continue
+		}
+		index := strings.LastIndex(patch.Path, "/")
+		if index < 0 {
+			continue
+		}
+		parentPath := patch.Path[:index]
+		parent, ok := lookupJSONPointer(doc, parentPath)
+		if !ok {
+			continue
+		}
+		if _, isArray := parent.([]interface{}); !isArray {
+			continue
+		}
+		inserted, err := strconv.Atoi(patch.Path[index+1:])
+		if err != nil {
+			continue
+		}
+		for _, record := range records {
+			if !strings.HasPrefix(record.path, parentPath+"/") {
+				continue
+			}
+			token, suffix := record.path[len(parentPath)+1:], ""
+			if i := strings.Index(token, "/"); i >= 0 {
+				token, suffix = token[:i], token[i:]
+			}
+			recordIndex, err := strconv.Atoi(token)
+			if err != nil || recordIndex < inserted {
+				continue
+			}
+			record.path = fmt.Sprintf("%s/%d%s", parentPath, recordIndex+1, suffix)
+		}
+	}
+	return nil
+}
+
+// overwritesValue check if patch changes or removes an existing value of the object before patched,
+// adding new key to map or inserting into array does not overwrite
+func overwritesValue(doc interface{}, patch types.PatchOperation) bool {
+	switch patch.Op {
+	case types.PatchOperationAdd:
+		index := strings.LastIndex(patch.Path, "/")
+		parent, ok := lookupJSONPointer(doc, patch.Path[:index])
+		if !ok {
+			return false
+		}
+		parentMap, ok := parent.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		old, exists := parentMap[unescapeJSONPointerToken(patch.Path[index+1:])]
+		return exists && !jsonValueEqual(old, patch.Value)
+	case types.PatchOperationReplace:
+		old, exists := lookupJSONPointer(doc, patch.Path)
+		return exists && !jsonValueEqual(old, patch.Value)
+	case "test":
+		return false
+	default:
+		// remove, and move/copy whose source is not recorded in patch operation
+		return true
+	}
+}
+
+// jsonValueEqual compare decoded json value with patch value
+func jsonValueEqual(decoded, value interface{}) bool {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+	var v interface{}
+	if err = json.Unmarshal(data, &v); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(decoded, v)
+}
+
+// updateRecords record paths written by plugin, and refresh values of all records with patched object
+func updateRecords(records []*patchRecord, pluginName string, patches []types.PatchOperation,
+	patched []byte) ([]*patchRecord, error) {
+	var doc interface{}
+	if err := json.Unmarshal(patched, &doc); err != nil {
+		return records, fmt.Errorf("decode patched object failed, err %s", err.Error())
+	}
+	for _, patch := range patches {
+		if strings.HasSuffix(patch.Path, patchPathAppend) {
+			continue
+		}
+		found := false
+		for _, record := range records {
+			if record.path == patch.Path {
+				record.pluginName = pluginName
+				found = true
+				break
+			}
+		}
+		if !found {
+			records = append(records, &patchRecord{pluginName: pluginName, path: patch.Path})
+		}
+	}
+	for _, record := range records {
+		record.value, record.exists = lookupJSONPointer(doc, record.path)
+	}
+	return records, nil
+}
+
+// pathOverlap check if one json pointer is the same as or the parent of another
+func pathOverlap(a, b string) bool {
+	return a == b || strings.HasPrefix(b, a+"/") || strings.HasPrefix(a, b+"/")
+}
+
+// lookupJSONPointer get value of json pointer in decoded json document
+func lookupJSONPointer(doc interface{}, pointer string) (interface{}, bool) {
+	if pointer == "" {
+		return doc, true
+	}
+	current := doc
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = unescapeJSONPointerToken(token)
+		switch v := current.(type) {
+		case map[string]interface{}:
+			next, ok := v[token]
+			if !ok {
+				return nil, false
+			}
+			current = next
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, false
+			}
+			current = v[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// unescapeJSONPointerToken unescape ~1 and ~0 in json pointer token
+func unescapeJSONPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
@@ -0,0 +1,277 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pluginmanager
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/plugin"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/types"
+)
+
+// testPlugin returns fixed patches, records the object it received
+type testPlugin struct {
+	annotationKey string
+	priority      int
+	patches       []types.PatchOperation
+	dryRun        bool
+	received      string
+}
+
+func (p *testPlugin) AnnotationKey() string { return p.annotationKey }
+
+func (p *testPlugin) Init(configFilePath string) error { return nil }
+
+func (p *testPlugin) Priority() int { return p.priority }
+
+func (p *testPlugin) Close() error { return nil }
+
+func (p *testPlugin) SupportDryRun() bool { return p.dryRun }
+
+func (p *testPlugin) Handle(ar v1beta1.AdmissionReview) *v1beta1.AdmissionResponse {
+	p.received = string(ar.Request.Object.Raw)
+	patchesBytes, _ := json.Marshal(p.patches)
+	return &v1beta1.AdmissionResponse{Allowed: true, Patch: patchesBytes}
+}
+
+func newTestManager(t *testing.T, names []string, plugins []plugin.Interface, chainConf *ChainConfig) *Manager {
+	if chainConf == nil {
+		chainConf = &ChainConfig{}
+	}
+	chain, err := newChain(names, plugins, chainConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Manager{chain: chain}
+}
+
+func newTestReview(obj string) v1beta1.AdmissionReview {
+	return v1beta1.AdmissionReview{
+		Request: &v1beta1.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Kind: "Pod"},
+			Operation: v1beta1.Create,
+			Object:    runtime.RawExtension{Raw: []byte(obj)},
+		},
+	}
+}
+
+func TestRunKubernetesChainOrder(t *testing.T) {
+	first := &testPlugin{priority: 10, patches: []types.PatchOperation{
+		{Op: "add", Path: "/metadata/annotations", Value: map[string]string{"second": "true"}},
+	}}
+	second := &testPlugin{annotationKey: "second", priority: 20, patches: []types.PatchOperation{
+		{Op: "add", Path: "/spec/volumes/-", Value: map[string]string{"name": "v2"}},
+	}}
+	// second is registered first, but runs after first by priority
+	m := newTestManager(t, []string{"second", "first"}, []plugin.Interface{second, first}, nil)
+	result := m.RunKubernetesChain(newTestReview(`{"metadata":{},"spec":{"volumes":[]}}`))
+	if !result.Allowed {
+		t.Fatalf("expect allowed, got %s", result.Message)
+	}
+	if result.Plugins[0].Name != "first" || result.Plugins[1].Skipped {
+		t.Fatalf("unexpected plugin results %+v %+v", result.Plugins[0], result.Plugins[1])
+	}
+	if second.received != `{"metadata":{"annotations":{"second":"true"}},"spec":{"volumes":[]}}` {
+		t.Fatalf("second plugin should receive patched object, got %s", second.received)
+	}
+	if len(result.Patches) != 2 {
+		t.Fatalf("expect 2 patches, got %d", len(result.Patches))
+	}
+}
+
+func TestRunKubernetesChainConflict(t *testing.T) {
+	first := &testPlugin{patches: []types.PatchOperation{
+		{Op: "add", Path: "/metadata/labels", Value: map[string]string{"a": "1"}},
+	}}
+	// replace parent path but keep the value written by first plugin
+	keep := &testPlugin{patches: []types.PatchOperation{
+		{Op: "replace", Path: "/metadata", Value: map[string]interface{}{
+			"labels": map[string]string{"a": "1"}, "name": "pod"}},
+	}}
+	overwrite := &testPlugin{patches: []types.PatchOperation{
+		{Op: "replace", Path: "/metadata/labels/a", Value: "2"},
+	}}
+
+	m := newTestManager(t, []string{"first", "keep", "overwrite"},
+		[]plugin.Interface{first, keep, overwrite}, nil)
+	result := m.RunKubernetesChain(newTestReview(`{"metadata":{}}`))
+	if result.Allowed {
+		t.Fatalf("expect conflict")
+	}
+	if len(result.Plugins) != 3 || len(result.Plugins[1].Conflicts) != 0 || len(result.Plugins[2].Conflicts) != 2 {
+		t.Fatalf("unexpected plugin results %+v", result.Plugins)
+	}
+
+	m = newTestManager(t, []string{"first", "keep", "overwrite"},
+		[]plugin.Interface{first, keep, overwrite}, &ChainConfig{Plugins: map[string]*ChainPluginConfig{
+			"overwrite": {FailurePolicy: plugin.FailurePolicyIgnore},
+		}})
+	result = m.RunKubernetesChain(newTestReview(`{"metadata":{}}`))
+	if !result.Allowed || !result.Plugins[2].Ignored {
+		t.Fatalf("expect ignored plugin, got %+v", result.Plugins[2])
+	}
+	if string(result.Object) != `{"metadata":{"labels":{"a":"1"},"name":"pod"}}` {
+		t.Fatalf("unexpected object %s", string(result.Object))
+	}
+}
+
+func TestRunKubernetesChainAddUnderParent(t *testing.T) {
+	pod := `{"metadata":{"annotations":{"randhostport.webhook.bkbcs.tencent.com":"true",` +
+		`"task.bkbcs.tencent.com/gpu-type":"v100"}},"spec":{"containers":[{"name":"c1",` +
+		`"resources":{"requests":{"cpu":"1"}}}]}}`
+	// randhostport replaces the whole annotations, see generateAnnotationsPatch
+	randhostport := &testPlugin{priority: 10, patches: []types.PatchOperation{
+		{Op: "replace", Path: "/metadata/annotations", Value: map[string]string{
+			"randhostport.webhook.bkbcs.tencent.com":      "true",
+			"task.bkbcs.tencent.com/gpu-type":             "v100",
+			"randhostport.webhook.bkbcs.tencent.com.8080": "31000",
+		}},
+	}}
+	// gpu adds annotation keys and replaces resources, see generateAnnotationPatch
+	gpu := &testPlugin{priority: 20, patches: []types.PatchOperation{
+		{Op: "replace", Path: "/spec/containers/0/resources/requests/cpu", Value: "8"},
+		{Op: "add", Path: "/metadata/annotations/tke.cloud.tencent.com~1networks", Value: "tke-route-eni"},
+	}}
+	m := newTestManager(t, []string{"randhostport", "gpu"}, []plugin.Interface{randhostport, gpu}, nil)
+	result := m.RunKubernetesChain(newTestReview(pod))
+	if !result.Allowed {
+		t.Fatalf("expect allowed, got %s", result.Message)
+	}
+	obj := struct {
+		Metadata struct {
+			Annotations map[string]string `json:"annotations"`
+		} `json:"metadata"`
+	}{}
+	if err := json.Unmarshal(result.Object, &obj); err != nil {
+		t.Fatal(err)
+	}
+	if len(obj.Metadata.Annotations) != 4 ||
+		obj.Metadata.Annotations["randhostport.webhook.bkbcs.tencent.com.8080"] != "31000" ||
+		obj.Metadata.Annotations["tke.cloud.tencent.com/networks"] != "tke-route-eni" {
+		t.Fatalf("unexpected annotations %v", obj.Metadata.Annotations)
+	}
+
+	// adding the same value is not an overwrite, changing the value written by randhostport is
+	same := &testPlugin{priority: 20, patches: []types.PatchOperation{
+		{Op: "add", Path: "/metadata/annotations/randhostport.webhook.bkbcs.tencent.com.8080", Value: "31000"},
+	}}
+	overwrite := &testPlugin{priority: 30, patches: []types.PatchOperation{
+		{Op: "add", Path: "/metadata/annotations/randhostport.webhook.bkbcs.tencent.com.8080", Value: "32000"},
+	}}
+	m = newTestManager(t, []string{"randhostport", "same", "overwrite"},
+		[]plugin.Interface{randhostport, same, overwrite}, nil)
+	result = m.RunKubernetesChain(newTestReview(pod))
+	if result.Allowed {
+		t.Fatalf("expect conflict")
+	}
+	// conflicts with both the annotations written by randhostport and the key written by same
+	if len(result.Plugins[1].Conflicts) != 0 || len(result.Plugins[2].Conflicts) != 2 {
+		t.Fatalf("unexpected plugin results %+v %+v", result.Plugins[1], result.Plugins[2])
+	}
+}
+
+func TestRunKubernetesChainInsertIntoArray(t *testing.T) {
+	first := &testPlugin{patches: []types.PatchOperation{
+		{Op: "replace", Path: "/spec/containers", Value: []map[string]string{{"name": "c1"}}},
+	}}
+	// inserting into array written by first plugin keeps existing elements
+	insert := &testPlugin{patches: []types.PatchOperation{
+		{Op: "add", Path: "/spec/containers/0", Value: map[string]string{"name": "sidecar"}},
+	}}
+	remove := &testPlugin{patches: []types.PatchOperation{
+		{Op: "remove", Path: "/spec/containers/1"},
+	}}
+	m := newTestManager(t, []string{"first", "insert", "remove"},
+		[]plugin.Interface{first, insert, remove}, nil)
+	result := m.RunKubernetesChain(newTestReview(`{"spec":{"containers":[]}}`))
+	if result.Allowed {
+		t.Fatalf("expect conflict")
+	}
+	if len(result.Plugins[1].Conflicts) != 0 || len(result.Plugins[2].Conflicts) != 1 {
+		t.Fatalf("unexpected plugin results %+v %+v", result.Plugins[1], result.Plugins[2])
+	}
+}
+
+func TestRunKubernetesChainInsertArrayElement(t *testing.T) {
+	image := &testPlugin{priority: 1, patches: []types.PatchOperation{
+		{Op: "replace", Path: "/spec/containers/0/image", Value: "accelerated"},
+	}}
+	sidecar := &testPlugin{priority: 2, patches: []types.PatchOperation{
+		{Op: "add", Path: "/spec/containers/0", Value: map[string]string{"name": "sidecar"}},
+	}}
+	overwrite := &testPlugin{priority: 3, patches: []types.PatchOperation{
+		{Op: "replace", Path: "/spec/containers/1/image", Value: "other"},
+	}}
+	m := newTestManager(t, []string{"image", "sidecar", "overwrite"},
+		[]plugin.Interface{image, sidecar, overwrite}, &ChainConfig{
+			Plugins: map[string]*ChainPluginConfig{"overwrite": {FailurePolicy: plugin.FailurePolicyIgnore}},
+		})
+	result := m.RunKubernetesChain(newTestReview(`{"spec":{"containers":[{"name":"app","image":"origin"}]}}`))
+	if !result.Allowed || len(result.Plugins[1].Conflicts) != 0 {
+		t.Fatalf("inserting sidecar should not conflict, got %s", result.Message)
+	}
+	// record of image plugin moves with the inserted container
+	if len(result.Plugins[2].Conflicts) != 1 || !result.Plugins[2].Ignored {
+		t.Fatalf("expect conflict with moved record, got %+v", result.Plugins[2])
+	}
+	expect := `{"spec":{"containers":[{"name":"sidecar"},{"image":"accelerated","name":"app"}]}}`
+	if string(result.Object) != expect {
+		t.Fatalf("unexpected object %s", string(result.Object))
+	}
+}
+
+func TestRunKubernetesChainDryRun(t *testing.T) {
+	pure := &testPlugin{dryRun: true, patches: []types.PatchOperation{
+		{Op: "add", Path: "/metadata/labels", Value: map[string]string{"a": "1"}},
+	}}
+	sideEffect := &testPlugin{patches: []types.PatchOperation{
+		{Op: "add", Path: "/metadata/annotations", Value: map[string]string{"b": "2"}},
+	}}
+	m := newTestManager(t, []string{"pure", "sideEffect"}, []plugin.Interface{pure, sideEffect}, nil)
+	ar := newTestReview(`{"metadata":{}}`)
+	dryRun := true
+	ar.Request.DryRun = &dryRun
+	result := m.DryRunKubernetesChain(ar)
+	if !result.Allowed {
+		t.Fatalf("expect allowed, got %s", result.Message)
+	}
+	if result.Plugins[0].Skipped || !result.Plugins[1].Skipped || !result.Plugins[1].DryRunUnsupported {
+		t.Fatalf("unexpected plugin results %+v %+v", result.Plugins[0], result.Plugins[1])
+	}
+	if sideEffect.received != "" {
+		t.Fatalf("plugin without dry run support should not be called")
+	}
+	if string(result.Object) != `{"metadata":{"labels":{"a":"1"}}}` {
+		t.Fatalf("unexpected object %s", string(result.Object))
+	}
+
+	// server-side dry run request from apiserver still runs all plugins
+	result = m.RunKubernetesChain(ar)
+	if !result.Allowed || result.Plugins[1].Skipped || sideEffect.received == "" {
+		t.Fatalf("expect all plugins called for apiserver dry run request, got %+v", result.Plugins[1])
+	}
+}
+
+func TestNewChainInvalidFailurePolicy(t *testing.T) {
+	_, err := newChain([]string{"p"}, []plugin.Interface{&testPlugin{}}, &ChainConfig{
+		Plugins: map[string]*ChainPluginConfig{"p": {FailurePolicy: "Unknown"}},
+	})
+	if err == nil {
+		t.Fatalf("expect error for invalid failure policy")
+	}
+}
@@ -67,6 +67,8 @@ type Manager struct {
 	activePluginNames      []string
 	activeMesosPlugins     []plugin.MesosPlugin
 	activeMesosPluginNames []string
+	// chain kubernetes plugins sorted by priority
+	chain []*ChainNode
 }
 
 // NewManager create new manager
@@ -117,6 +119,30 @@ func (m *Manager) InitPlugins(names []string) error {
 			return fmt.Errorf("unsupported cluster mode %s", m.clusterMode)
 		}
 	}
+	if m.clusterMode == options.EngineTypeKubernetes {
+		return m.initChain()
+	}
+	return nil
+}
+
+// initChain sort kubernetes plugins by priority, plugins with the same priority keep the given order
+func (m *Manager) initChain() error {
+	chainConf, err := loadChainConfig(filepath.Join(m.configDir, chainConfigFile))
+	if err != nil {
+		return err
+	}
+	chain, err := newChain(m.activePluginNames, m.activePlugins, chainConf)
+	if err != nil {
+		return err
+	}
+	m.chain = chain
+	m.activePlugins = make([]plugin.Interface, 0, len(chain))
+	m.activePluginNames = make([]string, 0, len(chain))
+	for _, node := range chain {
+		m.activePlugins = append(m.activePlugins, node.Plugin)
+		m.activePluginNames = append(m.activePluginNames, node.Name)
+		blog.Infof("plugin chain: %s, priority %d, failure policy %s", node.Name, node.Priority, node.FailurePolicy)
+	}
 	return nil
 }
 
@@ -130,6 +156,11 @@ func (m *Manager) GetKubernetesPluginNames() []string {
 	return m.activePluginNames
 }
 
+// GetKubernetesPluginChain get k8s plugin chain sorted by priority
+func (m *Manager) GetKubernetesPluginChain() []*ChainNode {
+	return m.chain
+}
+
 // GetMesosPlugins get mesos plugins
 func (m *Manager) GetMesosPlugins() []plugin.MesosPlugin {
 	return m.activeMesosPlugins
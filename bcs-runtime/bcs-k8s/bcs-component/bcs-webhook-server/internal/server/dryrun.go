@@ -0,0 +1,172 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	v1 "k8s.io/api/admission/v1"
+	"k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sunstruct "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/convert"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/metrics"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/options"
+)
+
+const (
+	// kindAdmissionReview kind of admission review
+	kindAdmissionReview = "AdmissionReview"
+	// bearerPrefix prefix of Authorization header
+	bearerPrefix = "Bearer "
+)
+
+// K8sHookDryRun pass object through plugin chain and return the combined patch and result of each plugin.
+// body is an AdmissionReview or a raw object, for raw object, operation (default CREATE) and namespace
+// can be set by query parameters. Request is marked as dryRun, plugins which do not support dry run are skipped.
+// The api requires bearer token configured by dry_run_token_file, and is disabled when token is not set.
+func (ws *WebhookServer) K8sHookDryRun(w http.ResponseWriter, r *http.Request) {
+	var (
+		handler = "K8sHookDryRun"
+		method  = "POST"
+		started = time.Now()
+	)
+
+	if code, err := ws.checkDryRunAuth(r); err != nil {
+		http.Error(w, err.Error(), code)
+		metrics.ReportBcsWebhookServerAPIMetrics(handler, method, strconv.Itoa(code), started)
+		return
+	}
+	if ws.EngineType == options.EngineTypeMesos || r.Method != http.MethodPost {
+		http.Error(w, "only support POST for kubernetes", http.StatusBadRequest)
+		metrics.ReportBcsWebhookServerAPIMetrics(handler, method, strconv.Itoa(http.StatusBadRequest), started)
+		return
+	}
+	var body []byte
+	if r.Body != nil {
+		if data, err := ioutil.ReadAll(r.Body); err == nil {
+			body = data
+		}
+	}
+	if len(body) == 0 {
+		http.Error(w, "no body found", http.StatusBadRequest)
+		metrics.ReportBcsWebhookServerAPIMetrics(handler, method, strconv.Itoa(http.StatusBadRequest), started)
+		return
+	}
+
+	ar, err := decodeDryRunReview(body, r)
+	if err != nil {
+		blog.Errorf("decode dry run request failed, err %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		metrics.ReportBcsWebhookServerAPIMetrics(handler, method, strconv.Itoa(http.StatusBadRequest), started)
+		return
+	}
+	result := ws.runK8sHook(*ar, true)
+	resp, err := json.Marshal(result)
+	if err != nil {
+		blog.Errorf("Could not encode response: %v", err)
+		http.Error(w, fmt.Sprintf("could encode response: %v", err), http.StatusInternalServerError)
+		metrics.ReportBcsWebhookServerAPIMetrics(handler, method, strconv.Itoa(http.StatusInternalServerError), started)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(resp); err != nil {
+		blog.Errorf("Could not write response: %v", err)
+		metrics.ReportBcsWebhookServerAPIMetrics(handler, method, strconv.Itoa(http.StatusInternalServerError), started)
+		return
+	}
+	metrics.ReportBcsWebhookServerAPIMetrics(handler, method, strconv.Itoa(http.StatusOK), started)
+}
+
+// checkDryRunAuth check bearer token of dry run request, return http status code when failed
+func (ws *WebhookServer) checkDryRunAuth(r *http.Request) (int, error) {
+	if ws.dryRunToken == "" {
+		return http.StatusNotFound, fmt.Errorf("dry run api is disabled")
+	}
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, bearerPrefix) ||
+		subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, bearerPrefix)), []byte(ws.dryRunToken)) != 1 {
+		return http.StatusUnauthorized, fmt.Errorf("unauthorized")
+	}
+	return http.StatusOK, nil
+}
+
+// decodeDryRunReview decode AdmissionReview or raw object to v1beta1 AdmissionReview with dryRun set
+func decodeDryRunReview(body []byte, r *http.Request) (*v1beta1.AdmissionReview, error) {
+	typeMeta := &metav1.TypeMeta{}
+	if err := json.Unmarshal(body, typeMeta); err != nil {
+		return nil, fmt.Errorf("could not decode body: %v", err)
+	}
+	dryRun := true
+	if typeMeta.Kind == kindAdmissionReview {
+		obj, gvk, err := deserializer.Decode(body, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode body: %v", err)
+		}
+		var ar *v1beta1.AdmissionReview
+		switch *gvk {
+		case v1beta1.SchemeGroupVersion.WithKind(kindAdmissionReview):
+			ar, _ = obj.(*v1beta1.AdmissionReview)
+		case v1.SchemeGroupVersion.WithKind(kindAdmissionReview):
+			if review, ok := obj.(*v1.AdmissionReview); ok && review.Request != nil {
+				ar = &v1beta1.AdmissionReview{Request: convert.ConvertAdmissionRequestToV1beta1(review.Request)}
+			}
+		}
+		if ar == nil || ar.Request == nil {
+			return nil, fmt.Errorf("invalid AdmissionReview %s", gvk.String())
+		}
+		ar.Request.DryRun = &dryRun
+		return ar, nil
+	}
+
+	obj := &k8sunstruct.Unstructured{}
+	if err := obj.UnmarshalJSON(body); err != nil {
+		return nil, fmt.Errorf("could not decode object: %v", err)
+	}
+	gvk := obj.GroupVersionKind()
+	operation := v1beta1.Create
+	if op := r.URL.Query().Get("operation"); len(op) != 0 {
+		operation = v1beta1.Operation(strings.ToUpper(op))
+	}
+	namespace := obj.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = r.URL.Query().Get("namespace")
+	}
+	req := &v1beta1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+		Name:      obj.GetName(),
+		Namespace: namespace,
+		Operation: operation,
+		DryRun:    &dryRun,
+	}
+	switch operation {
+	case v1beta1.Delete:
+		req.OldObject = runtime.RawExtension{Raw: body}
+	case v1beta1.Update:
+		req.Object = runtime.RawExtension{Raw: body}
+		req.OldObject = runtime.RawExtension{Raw: body}
+	default:
+		req.Object = runtime.RawExtension{Raw: body}
+	}
+	return &v1beta1.AdmissionReview{Request: req}, nil
+}
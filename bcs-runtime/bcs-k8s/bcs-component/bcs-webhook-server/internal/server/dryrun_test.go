@@ -0,0 +1,59 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/pluginmanager"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/options"
+)
+
+func TestK8sHookDryRunAuth(t *testing.T) {
+	opt := &options.ServerOption{EngineType: options.EngineTypeKubernetes}
+	pod := `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"p","namespace":"default"}}`
+
+	testCases := []struct {
+		title  string
+		token  string
+		header string
+		code   int
+	}{
+		{title: "disabled", token: "", header: "Bearer ", code: http.StatusNotFound},
+		{title: "no token", token: "secret", header: "", code: http.StatusUnauthorized},
+		{title: "wrong token", token: "secret", header: "Bearer wrong", code: http.StatusUnauthorized},
+		{title: "valid token", token: "secret", header: "Bearer secret", code: http.StatusOK},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.title, func(t *testing.T) {
+			server := &WebhookServer{
+				Opt:         opt,
+				EngineType:  opt.EngineType,
+				PluginMgr:   pluginmanager.NewManager(opt.EngineType, opt.PluginDir),
+				dryRunToken: testCase.token,
+			}
+			req := httptest.NewRequest(http.MethodPost, "/bcs/webhook/inject/v1/k8s/dryrun", strings.NewReader(pod))
+			if testCase.header != "" {
+				req.Header.Set("Authorization", testCase.header)
+			}
+			w := httptest.NewRecorder()
+			server.K8sHookDryRun(w, req)
+			if w.Code != testCase.code {
+				t.Errorf("expect code %d, but get %d, body %s", testCase.code, w.Code, w.Body.String())
+			}
+		})
+	}
+}
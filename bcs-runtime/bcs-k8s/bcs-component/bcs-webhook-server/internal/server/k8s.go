@@ -21,7 +21,6 @@ import (
 	"time"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
-	jsonpatch "github.com/evanphx/json-patch"
 	v1 "k8s.io/api/admission/v1"
 	"k8s.io/api/admission/v1beta1"
 	k8sunstruct "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -31,6 +30,7 @@ import (
 
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/convert"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/metrics"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/pluginmanager"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/pluginutil"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/types"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/options"
@@ -159,11 +159,15 @@ func (ws *WebhookServer) K8sHook(w http.ResponseWriter, r *http.Request) {
 	metrics.ReportBcsWebhookServerAPIMetrics(handler, method, strconv.Itoa(http.StatusOK), started)
 }
 
-// nolint funlen
+// doK8sHook do k8s hook and return admission response
 func (ws *WebhookServer) doK8sHook(ar v1beta1.AdmissionReview) *v1beta1.AdmissionResponse {
+	return ws.runK8sHook(ar, false).Response
+}
+
+// runK8sHook pass object through plugin chain, plugins which do not support dry run are skipped
+// when dryRunAPI is true
+func (ws *WebhookServer) runK8sHook(ar v1beta1.AdmissionReview, dryRunAPI bool) *pluginmanager.ChainResult {
 	req := ar.Request
-	plugins := ws.PluginMgr.GetKubernetesPlugins()
-	pluginNames := ws.PluginMgr.GetKubernetesPluginNames()
 
 	runtimeObj := req.Object
 	if req.Operation == v1beta1.Delete {
@@ -173,7 +177,7 @@ func (ws *WebhookServer) doK8sHook(ar v1beta1.AdmissionReview) *v1beta1.Admissio
 	tmpMapIf := make(map[string]interface{})
 	if err := json.Unmarshal(runtimeObj.Raw, &tmpMapIf); err != nil {
 		blog.Errorf("decode %s to map[string]interface failed, err %s", string(runtimeObj.Raw), err.Error())
-		return pluginutil.ToAdmissionResponse(
+		return newChainFailedResult(
 			fmt.Errorf("decode data to map[string]interface failed failed, err %s", err.Error()))
 	}
 	tmpUnstruct := &k8sunstruct.Unstructured{}
@@ -199,15 +203,15 @@ func (ws *WebhookServer) doK8sHook(ar v1beta1.AdmissionReview) *v1beta1.Admissio
 	if types.IsIgnoredNamespace(tmpUnstructNs) {
 		tmpAnnotation := tmpUnstruct.GetAnnotations()
 		if tmpAnnotation == nil {
-			return &v1beta1.AdmissionResponse{Allowed: true}
+			return newChainSkippedResult()
 		}
 		value, ok := tmpAnnotation[types.BcsWebhookAnnotationInjectKey]
 		if !ok {
-			return &v1beta1.AdmissionResponse{Allowed: true}
+			return newChainSkippedResult()
 		}
 		switch value {
 		default:
-			return &v1beta1.AdmissionResponse{Allowed: true}
+			return newChainSkippedResult()
 		// NOCC:goconst/string(设计如此)
 		case "y", "yes", "true", "on": // nolint
 			// do nothing, let it go
@@ -215,67 +219,26 @@ func (ws *WebhookServer) doK8sHook(ar v1beta1.AdmissionReview) *v1beta1.Admissio
 	}
 	blog.Infof("%s %s/%s hooked", tmpUnstructKind, tmpUnstructName, tmpUnstructNs)
 
-	var patches []types.PatchOperation
-	// traverse each plugins
-	for index, p := range plugins {
-		annotationKey := p.AnnotationKey()
-		// case 1: if plugin annotation key is empty, always pass object to plugin
-		// case 2: if plugin annotation key is not empty, pass object to plugin if the object has the annotation key
-		if len(annotationKey) != 0 {
-			if _, ok := tmpUnstruct.GetAnnotations()[annotationKey]; !ok {
-				continue
-			}
-		}
-
-		startTime := time.Now()
-		blog.Infof("start %s %s/%s hook by plugin %s",
-			tmpUnstructKind, tmpUnstructName, tmpUnstructNs, pluginNames[index])
-		// do webhook
-		tmpResponse := p.Handle(ar)
-		blog.Infof("end %s %s/%s hook by plugin %s, cost %d Milliseconds", tmpUnstructKind, tmpUnstructName,
-			tmpUnstructNs, pluginNames[index], time.Since(startTime).Milliseconds())
-
-		// when one plugin is not allowed, just return response
-		if !tmpResponse.Allowed {
-			return tmpResponse
-		}
-		if len(tmpResponse.Patch) != 0 {
-			newPatches := make([]types.PatchOperation, 0)
-			err := json.Unmarshal(tmpResponse.Patch, &newPatches)
-			if err != nil {
-				blog.Errorf("decode plugin patches failed, err %s", err.Error())
-				return pluginutil.ToAdmissionResponse(
-					fmt.Errorf("decode plugin patches failed, err %s", err.Error()))
-			}
-			patches = append(patches, newPatches...)
-			// change the input for next plugin
-			patchObj, err := jsonpatch.DecodePatch(tmpResponse.Patch)
-			if err != nil {
-				blog.Errorf("decode patch failed, err %s", err.Error())
-				return pluginutil.ToAdmissionResponse(
-					fmt.Errorf("decode patch failed, err %s", err.Error()))
-			}
-			modified, err := patchObj.Apply(req.Object.Raw)
-			if err != nil {
-				blog.Errorf("apply patch failed, err %s", err.Error())
-				return pluginutil.ToAdmissionResponse(
-					fmt.Errorf("apply patch failed, err %s", err.Error()))
-			}
-			req.Object.Raw = modified
-		}
+	if dryRunAPI {
+		return ws.PluginMgr.DryRunKubernetesChain(ar)
 	}
-	patchesBytes, err := json.Marshal(patches)
-	if err != nil {
-		blog.Errorf("encoding patches failed, err %s", err.Error())
-		return pluginutil.ToAdmissionResponse(fmt.Errorf("encoding patches failed, err %s", err.Error()))
+	return ws.PluginMgr.RunKubernetesChain(ar)
+}
+
+// newChainSkippedResult result for object not hooked
+func newChainSkippedResult() *pluginmanager.ChainResult {
+	return &pluginmanager.ChainResult{
+		Response: &v1beta1.AdmissionResponse{Allowed: true},
+		Allowed:  true,
+		Patches:  make([]types.PatchOperation, 0),
 	}
-	reviewResponse := v1beta1.AdmissionResponse{
-		Allowed: true,
-		Patch:   patchesBytes,
-		PatchType: func() *v1beta1.PatchType {
-			pt := v1beta1.PatchTypeJSONPatch
-			return &pt
-		}(),
+}
+
+// newChainFailedResult result for object failed to hook
+func newChainFailedResult(err error) *pluginmanager.ChainResult {
+	return &pluginmanager.ChainResult{
+		Response: pluginutil.ToAdmissionResponse(err),
+		Message:  err.Error(),
+		Patches:  make([]types.PatchOperation, 0),
 	}
-	return &reviewResponse
 }
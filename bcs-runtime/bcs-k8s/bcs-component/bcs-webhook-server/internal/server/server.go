@@ -40,6 +40,8 @@ type WebhookServer struct {
 	PluginMgr  *pluginmanager.Manager
 	EngineType string // kubernetes or mesos
 	PluginDir  string
+	// dryRunToken bearer token for dry run api, dry run api is disabled when empty
+	dryRunToken string
 }
 
 // NewWebhookServer new webhook server from options
@@ -68,11 +70,24 @@ func NewWebhookServer(opt *options.ServerOption) (*WebhookServer, error) {
 		}
 	}
 
+	var dryRunToken string
+	if opt.DryRunTokenFile != "" {
+		data, rerr := os.ReadFile(opt.DryRunTokenFile)
+		if rerr != nil {
+			return nil, fmt.Errorf("read dry run token file failed, err %s", rerr.Error())
+		}
+		dryRunToken = strings.TrimSpace(string(data))
+		if dryRunToken == "" {
+			return nil, fmt.Errorf("dry run token file %s is empty", opt.DryRunTokenFile)
+		}
+	}
+
 	whsvr := &WebhookServer{
-		Opt:        opt,
-		EngineType: opt.EngineType,
-		PluginDir:  opt.PluginDir,
-		PluginMgr:  pm,
+		Opt:         opt,
+		EngineType:  opt.EngineType,
+		PluginDir:   opt.PluginDir,
+		PluginMgr:   pm,
+		dryRunToken: dryRunToken,
 		Server: &http.Server{
 			Addr:      fmt.Sprintf("%s:%v", opt.Address, opt.Port),
 			TLSConfig: &tls.Config{Certificates: []tls.Certificate{pair}}, // nolint TLS MinVersion too low
@@ -87,6 +102,7 @@ func (ws *WebhookServer) Run() {
 	// define http server and server handler
 	mux := http.NewServeMux()
 	mux.HandleFunc("/bcs/webhook/inject/v1/k8s", ws.K8sHook)
+	mux.HandleFunc("/bcs/webhook/inject/v1/k8s/dryrun", ws.K8sHookDryRun)
 	mux.HandleFunc("/bcs/webhook/inject/v1/mesos", ws.MesosHook)
 	ws.Server.Handler = mux
 
@@ -28,13 +28,14 @@ type ServerOption struct {
 	conf.LogConfig
 	conf.ProcessConfig
 
-	Address        string `json:"address" short:"a" value:"0.0.0.0" usage:"IP address to listen on for this service"`
-	Port           uint   `json:"port" short:"p" value:"443" usage:"Port to listen on for this service"`
-	ServerCertFile string `json:"server_cert_file" value:"" usage:"Server public key file(*.crt). If both server_cert_file and server_key_file are set, it will set up an HTTPS server"`
-	ServerKeyFile  string `json:"server_key_file" value:"" usage:"Server private key file(*.key). If both server_cert_file and server_key_file are set, it will set up an HTTPS server"`
-	EngineType     string `json:"engine_type" value:"kubernetes" usage:"the platform that bcs-webhook-server runs in, kubernetes or mesos"`
-	PluginDir      string `json:"plugin_dir" value:"./plugins" usage:"directory for bcs webhook plugins"`
-	Plugins        string `json:"plugins" value:"" usage:"plugin names, call plugin Handle in this order"`
+	Address         string `json:"address" short:"a" value:"0.0.0.0" usage:"IP address to listen on for this service"`
+	Port            uint   `json:"port" short:"p" value:"443" usage:"Port to listen on for this service"`
+	ServerCertFile  string `json:"server_cert_file" value:"" usage:"Server public key file(*.crt). If both server_cert_file and server_key_file are set, it will set up an HTTPS server"`
+	ServerKeyFile   string `json:"server_key_file" value:"" usage:"Server private key file(*.key). If both server_cert_file and server_key_file are set, it will set up an HTTPS server"`
+	EngineType      string `json:"engine_type" value:"kubernetes" usage:"the platform that bcs-webhook-server runs in, kubernetes or mesos"`
+	PluginDir       string `json:"plugin_dir" value:"./plugins" usage:"directory for bcs webhook plugins"`
+	Plugins         string `json:"plugins" value:"" usage:"plugin names, call plugin Handle in this order when plugins have the same priority"`
+	DryRunTokenFile string `json:"dry_run_token_file" value:"" usage:"file of bearer token for dry run api, dry run api is disabled when not set"`
 }
 
 const (
@@ -0,0 +1,138 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package apply xxx
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	klog "k8s.io/klog/v2"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cli/bcs-cluster-manager/cmd/printer"
+	clusterMgr "github.com/Tencent/bk-bcs/bcs-services/bcs-cli/bcs-cluster-manager/pkg"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cli/bcs-cluster-manager/pkg/manager/types"
+)
+
+const applyClusterSpecURL = "/bcsapi/v4/clustermanager/v1/clusters/%s/apply"
+
+var (
+	applyLong = templates.LongDesc(i18n.T(`
+	apply a declarative cluster spec from yaml file. the spec describes desired state of
+	cluster, node groups, node templates and autoscaling option, only the declared fields
+	are compared with current state and the minimal create/update operations are executed.`))
+
+	applyExample = templates.Examples(i18n.T(`
+	# show the plan without changing anything
+	kubectl-bcs-cluster-manager apply -f cluster.yaml --dry-run
+
+	# apply the spec, file template:
+	cluster:
+	  clusterID: BCS-K8S-xxxxx
+	  labels:
+	    env: prod
+	nodeTemplates:
+	- name: default-template
+	  labels:
+	    app: xxx
+	nodeGroups:
+	- name: ng-default
+	  enableAutoscale: true
+	  autoScaling:
+	    minSize: 1
+	    maxSize: 10
+	autoScalingOption:
+	  isScaleDownEnable: true
+	  expander: random
+	kubectl-bcs-cluster-manager apply -f cluster.yaml`))
+
+	filename  string
+	clusterID string
+	dryRun    bool
+	output    string
+)
+
+// NewApplyCmd 创建apply子命令实例
+func NewApplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "apply",
+		Short:   i18n.T("apply a declarative cluster spec from yaml file."),
+		Long:    applyLong,
+		Example: applyExample,
+		Run:     apply,
+	}
+
+	cmd.Flags().StringVarP(&filename, "filename", "f", "", "cluster spec yaml file (required)")
+	cmd.Flags().StringVarP(&clusterID, "clusterID", "c", "",
+		"cluster ID, default to cluster.clusterID in spec file")
+	cmd.Flags().BoolVarP(&dryRun, "dry-run", "", false, "only show the plan, don't apply any change")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "output format, one of json")
+	_ = cmd.MarkFlagRequired("filename")
+
+	return cmd
+}
+
+func apply(cmd *cobra.Command, args []string) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		klog.Fatalf("read yaml file failed: %v", err)
+	}
+
+	spec, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		klog.Fatalf("convert yaml file to json failed: %v", err)
+	}
+
+	if clusterID == "" {
+		meta := struct {
+			Cluster struct {
+				ClusterID string `json:"clusterID"`
+			} `json:"cluster"`
+		}{}
+		if err = json.Unmarshal(spec, &meta); err != nil {
+			klog.Fatalf("unmarshal spec failed: %v", err)
+		}
+		clusterID = meta.Cluster.ClusterID
+	}
+	if clusterID == "" {
+		klog.Fatalf("clusterID is required, set it by --clusterID or cluster.clusterID in spec")
+	}
+
+	req := types.ApplyClusterSpecReq{
+		ClusterID: clusterID,
+		Spec:      spec,
+		DryRun:    dryRun,
+		Operator:  viper.GetString("operator"),
+	}
+	resp := &types.ApplyClusterSpecResp{}
+	err = clusterMgr.NewClusterMgrClient(&clusterMgr.Config{
+		APIServer: viper.GetString("apiserver"),
+		AuthToken: viper.GetString("authtoken"),
+		Operator:  viper.GetString("operator"),
+	}).Post(fmt.Sprintf(applyClusterSpecURL, clusterID), req, resp)
+	if err != nil {
+		klog.Fatalf("apply cluster spec failed: %v", err)
+	}
+
+	printer.PrintApplyClusterSpecInTable(output, resp)
+
+	if resp.Code != 0 {
+		klog.Fatalf("apply cluster spec failed: %s", resp.Message)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package printer
+
+import (
+	"fmt"
+
+	klog "k8s.io/klog/v2"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cli/bcs-cluster-manager/pkg/manager/types"
+)
+
+// PrintApplyClusterSpecInTable prints the plan or result of apply cluster spec
+func PrintApplyClusterSpecInTable(flagOutput string, resp *types.ApplyClusterSpecResp) {
+	if flagOutput == outputTypeJSON {
+		if err := encodeJSON(resp); err != nil {
+			klog.Fatalf("apply cluster spec output json to stdout failed: %s", err.Error())
+		}
+		return
+	}
+	tw := defaultTableWriter()
+	tw.SetHeader(func() []string {
+		return []string{
+			"TYPE", "ID", "NAME", "ACTION", "FIELD", "OLD", "NEW", "TASK", "MESSAGE",
+		}
+	}())
+	tw.SetAutoMergeCells(true)
+	for _, item := range resp.Data {
+		if len(item.Diffs) == 0 {
+			tw.Append([]string{
+				item.ResourceType, item.ResourceID, item.Name, item.Action, "", "", "", item.TaskID, item.Message,
+			})
+			continue
+		}
+		for _, diff := range item.Diffs {
+			tw.Append([]string{
+				item.ResourceType, item.ResourceID, item.Name, item.Action, diff.Field,
+				diff.Old, diff.New, item.TaskID, item.Message,
+			})
+		}
+	}
+	tw.Render()
+
+	fmt.Printf("\n%d resource(s) in spec, %d to create, %d to update\n", len(resp.Data),
+		countSpecChanges(resp.Data, "create"), countSpecChanges(resp.Data, "update"))
+}
+
+func countSpecChanges(changes []*types.SpecChange, action string) int {
+	count := 0
+	for _, change := range changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return count
+}
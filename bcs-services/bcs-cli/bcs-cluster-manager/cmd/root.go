@@ -20,6 +20,7 @@ import (
 	klog "k8s.io/klog/v2"
 
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cli/bcs-cluster-manager/cmd/add"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cli/bcs-cluster-manager/cmd/apply"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cli/bcs-cluster-manager/cmd/check"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cli/bcs-cluster-manager/cmd/clean"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cli/bcs-cluster-manager/cmd/cordon"
@@ -91,6 +92,7 @@ func NewRootCommand() *cobra.Command {
 	rootCmd.AddCommand(remove.NewRemoveCmd())
 	rootCmd.AddCommand(retry.NewRetryCmd())
 	rootCmd.AddCommand(uncordon.NewUncordonCmd())
+	rootCmd.AddCommand(apply.NewApplyCmd())
 
 	return rootCmd
 }
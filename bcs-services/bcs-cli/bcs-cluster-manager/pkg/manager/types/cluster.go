@@ -13,6 +13,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
 )
 
@@ -317,3 +319,37 @@ type ClusterMgr interface {
 	// ListCommon 查询公共集群及公共集群所属权限
 	ListCommon() (ListCommonClusterResp, error)
 }
+
+// ApplyClusterSpecReq 声明式应用集群规格request
+type ApplyClusterSpecReq struct {
+	ClusterID string          `json:"clusterID"`
+	Spec      json.RawMessage `json:"spec"`
+	DryRun    bool            `json:"dryRun"`
+	Operator  string          `json:"operator"`
+}
+
+// ApplyClusterSpecResp 声明式应用集群规格response
+type ApplyClusterSpecResp struct {
+	Code    uint32        `json:"code,omitempty"`
+	Message string        `json:"message,omitempty"`
+	Result  bool          `json:"result,omitempty"`
+	Data    []*SpecChange `json:"data,omitempty"`
+}
+
+// SpecChange 单个资源的变更计划及执行结果
+type SpecChange struct {
+	ResourceType string           `json:"resourceType,omitempty"`
+	ResourceID   string           `json:"resourceID,omitempty"`
+	Name         string           `json:"name,omitempty"`
+	Action       string           `json:"action,omitempty"`
+	Diffs        []*SpecFieldDiff `json:"diffs,omitempty"`
+	TaskID       string           `json:"taskID,omitempty"`
+	Message      string           `json:"message,omitempty"`
+}
+
+// SpecFieldDiff 字段差异
+type SpecFieldDiff struct {
+	Field string `json:"field,omitempty"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
@@ -174,6 +174,18 @@ func NewClusterManagerEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "ClusterManager.UpgradeClusterVersion",
+			Path:    []string{"/clustermanager/v1/clusters/{clusterID}/version/upgrade"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "ClusterManager.ApplyClusterSpec",
+			Path:    []string{"/clustermanager/v1/clusters/{clusterID}/apply"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 		{
 			Name:    "ClusterManager.CreateVirtualCluster",
 			Path:    []string{"/clustermanager/v1/vcluster"},
@@ -522,6 +534,12 @@ func NewClusterManagerEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "ClusterManager.UpgradeNodeGroupVersion",
+			Path:    []string{"/clustermanager/v1/nodegroup/{nodeGroupID}/version/upgrade"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 		{
 			Name:    "ClusterManager.GetProviderResourceUsage",
 			Path:    []string{"/clustermanager/v1/providers/{providerID}/resource_usage"},
@@ -1044,6 +1062,8 @@ type ClusterManagerService interface {
 	ListCommonCluster(ctx context.Context, in *ListCommonClusterReq, opts ...client.CallOption) (*ListCommonClusterResp, error)
 	AddSubnetToCluster(ctx context.Context, in *AddSubnetToClusterReq, opts ...client.CallOption) (*AddSubnetToClusterResp, error)
 	SwitchClusterUnderlayNetwork(ctx context.Context, in *SwitchClusterUnderlayNetworkReq, opts ...client.CallOption) (*SwitchClusterUnderlayNetworkResp, error)
+	UpgradeClusterVersion(ctx context.Context, in *UpgradeClusterVersionReq, opts ...client.CallOption) (*UpgradeClusterVersionResp, error)
+	ApplyClusterSpec(ctx context.Context, in *ApplyClusterSpecRequest, opts ...client.CallOption) (*ApplyClusterSpecResponse, error)
 	CreateVirtualCluster(ctx context.Context, in *CreateVirtualClusterReq, opts ...client.CallOption) (*CreateVirtualClusterResp, error)
 	DeleteVirtualCluster(ctx context.Context, in *DeleteVirtualClusterReq, opts ...client.CallOption) (*DeleteVirtualClusterResp, error)
 	UpdateVirtualClusterQuota(ctx context.Context, in *UpdateVirtualClusterQuotaReq, opts ...client.CallOption) (*UpdateVirtualClusterQuotaResp, error)
@@ -1108,6 +1128,7 @@ type ClusterManagerService interface {
 	TransNodeGroupToNodeTemplate(ctx context.Context, in *TransNodeGroupToNodeTemplateRequest, opts ...client.CallOption) (*TransNodeGroupToNodeTemplateResponse, error)
 	EnableNodeGroupAutoScale(ctx context.Context, in *EnableNodeGroupAutoScaleRequest, opts ...client.CallOption) (*EnableNodeGroupAutoScaleResponse, error)
 	DisableNodeGroupAutoScale(ctx context.Context, in *DisableNodeGroupAutoScaleRequest, opts ...client.CallOption) (*DisableNodeGroupAutoScaleResponse, error)
+	UpgradeNodeGroupVersion(ctx context.Context, in *UpgradeNodeGroupVersionReq, opts ...client.CallOption) (*UpgradeNodeGroupVersionResp, error)
 	GetProviderResourceUsage(ctx context.Context, in *GetProviderResourceUsageRequest, opts ...client.CallOption) (*GetProviderResourceUsageResponse, error)
 	GetProjectResourceQuotaUsage(ctx context.Context, in *GetProjectResourceQuotaUsageRequest, opts ...client.CallOption) (*GetProjectResourceQuotaUsageResponse, error)
 	// * Task information management *
@@ -1459,6 +1480,26 @@ func (c *clusterManagerService) SwitchClusterUnderlayNetwork(ctx context.Context
 	return out, nil
 }
 
+func (c *clusterManagerService) UpgradeClusterVersion(ctx context.Context, in *UpgradeClusterVersionReq, opts ...client.CallOption) (*UpgradeClusterVersionResp, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.UpgradeClusterVersion", in)
+	out := new(UpgradeClusterVersionResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) ApplyClusterSpec(ctx context.Context, in *ApplyClusterSpecRequest, opts ...client.CallOption) (*ApplyClusterSpecResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.ApplyClusterSpec", in)
+	out := new(ApplyClusterSpecResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterManagerService) CreateVirtualCluster(ctx context.Context, in *CreateVirtualClusterReq, opts ...client.CallOption) (*CreateVirtualClusterResp, error) {
 	req := c.c.NewRequest(c.name, "ClusterManager.CreateVirtualCluster", in)
 	out := new(CreateVirtualClusterResp)
@@ -2039,6 +2080,16 @@ func (c *clusterManagerService) DisableNodeGroupAutoScale(ctx context.Context, i
 	return out, nil
 }
 
+func (c *clusterManagerService) UpgradeNodeGroupVersion(ctx context.Context, in *UpgradeNodeGroupVersionReq, opts ...client.CallOption) (*UpgradeNodeGroupVersionResp, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.UpgradeNodeGroupVersion", in)
+	out := new(UpgradeNodeGroupVersionResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterManagerService) GetProviderResourceUsage(ctx context.Context, in *GetProviderResourceUsageRequest, opts ...client.CallOption) (*GetProviderResourceUsageResponse, error) {
 	req := c.c.NewRequest(c.name, "ClusterManager.GetProviderResourceUsage", in)
 	out := new(GetProviderResourceUsageResponse)
@@ -2886,6 +2937,8 @@ type ClusterManagerHandler interface {
 	ListCommonCluster(context.Context, *ListCommonClusterReq, *ListCommonClusterResp) error
 	AddSubnetToCluster(context.Context, *AddSubnetToClusterReq, *AddSubnetToClusterResp) error
 	SwitchClusterUnderlayNetwork(context.Context, *SwitchClusterUnderlayNetworkReq, *SwitchClusterUnderlayNetworkResp) error
+	UpgradeClusterVersion(context.Context, *UpgradeClusterVersionReq, *UpgradeClusterVersionResp) error
+	ApplyClusterSpec(context.Context, *ApplyClusterSpecRequest, *ApplyClusterSpecResponse) error
 	CreateVirtualCluster(context.Context, *CreateVirtualClusterReq, *CreateVirtualClusterResp) error
 	DeleteVirtualCluster(context.Context, *DeleteVirtualClusterReq, *DeleteVirtualClusterResp) error
 	UpdateVirtualClusterQuota(context.Context, *UpdateVirtualClusterQuotaReq, *UpdateVirtualClusterQuotaResp) error
@@ -2950,6 +3003,7 @@ type ClusterManagerHandler interface {
 	TransNodeGroupToNodeTemplate(context.Context, *TransNodeGroupToNodeTemplateRequest, *TransNodeGroupToNodeTemplateResponse) error
 	EnableNodeGroupAutoScale(context.Context, *EnableNodeGroupAutoScaleRequest, *EnableNodeGroupAutoScaleResponse) error
 	DisableNodeGroupAutoScale(context.Context, *DisableNodeGroupAutoScaleRequest, *DisableNodeGroupAutoScaleResponse) error
+	UpgradeNodeGroupVersion(context.Context, *UpgradeNodeGroupVersionReq, *UpgradeNodeGroupVersionResp) error
 	GetProviderResourceUsage(context.Context, *GetProviderResourceUsageRequest, *GetProviderResourceUsageResponse) error
 	GetProjectResourceQuotaUsage(context.Context, *GetProjectResourceQuotaUsageRequest, *GetProjectResourceQuotaUsageResponse) error
 	// * Task information management *
@@ -3084,6 +3138,8 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		ListCommonCluster(ctx context.Context, in *ListCommonClusterReq, out *ListCommonClusterResp) error
 		AddSubnetToCluster(ctx context.Context, in *AddSubnetToClusterReq, out *AddSubnetToClusterResp) error
 		SwitchClusterUnderlayNetwork(ctx context.Context, in *SwitchClusterUnderlayNetworkReq, out *SwitchClusterUnderlayNetworkResp) error
+		UpgradeClusterVersion(ctx context.Context, in *UpgradeClusterVersionReq, out *UpgradeClusterVersionResp) error
+		ApplyClusterSpec(ctx context.Context, in *ApplyClusterSpecRequest, out *ApplyClusterSpecResponse) error
 		CreateVirtualCluster(ctx context.Context, in *CreateVirtualClusterReq, out *CreateVirtualClusterResp) error
 		DeleteVirtualCluster(ctx context.Context, in *DeleteVirtualClusterReq, out *DeleteVirtualClusterResp) error
 		UpdateVirtualClusterQuota(ctx context.Context, in *UpdateVirtualClusterQuotaReq, out *UpdateVirtualClusterQuotaResp) error
@@ -3142,6 +3198,7 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		TransNodeGroupToNodeTemplate(ctx context.Context, in *TransNodeGroupToNodeTemplateRequest, out *TransNodeGroupToNodeTemplateResponse) error
 		EnableNodeGroupAutoScale(ctx context.Context, in *EnableNodeGroupAutoScaleRequest, out *EnableNodeGroupAutoScaleResponse) error
 		DisableNodeGroupAutoScale(ctx context.Context, in *DisableNodeGroupAutoScaleRequest, out *DisableNodeGroupAutoScaleResponse) error
+		UpgradeNodeGroupVersion(ctx context.Context, in *UpgradeNodeGroupVersionReq, out *UpgradeNodeGroupVersionResp) error
 		GetProviderResourceUsage(ctx context.Context, in *GetProviderResourceUsageRequest, out *GetProviderResourceUsageResponse) error
 		GetProjectResourceQuotaUsage(ctx context.Context, in *GetProjectResourceQuotaUsageRequest, out *GetProjectResourceQuotaUsageResponse) error
 		CreateTask(ctx context.Context, in *CreateTaskRequest, out *CreateTaskResponse) error
@@ -3367,6 +3424,18 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.UpgradeClusterVersion",
+		Path:    []string{"/clustermanager/v1/clusters/{clusterID}/version/upgrade"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.ApplyClusterSpec",
+		Path:    []string{"/clustermanager/v1/clusters/{clusterID}/apply"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "ClusterManager.CreateVirtualCluster",
 		Path:    []string{"/clustermanager/v1/vcluster"},
@@ -3715,6 +3784,12 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.UpgradeNodeGroupVersion",
+		Path:    []string{"/clustermanager/v1/nodegroup/{nodeGroupID}/version/upgrade"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "ClusterManager.GetProviderResourceUsage",
 		Path:    []string{"/clustermanager/v1/providers/{providerID}/resource_usage"},
@@ -4306,6 +4381,14 @@ func (h *clusterManagerHandler) SwitchClusterUnderlayNetwork(ctx context.Context
 	return h.ClusterManagerHandler.SwitchClusterUnderlayNetwork(ctx, in, out)
 }
 
+func (h *clusterManagerHandler) UpgradeClusterVersion(ctx context.Context, in *UpgradeClusterVersionReq, out *UpgradeClusterVersionResp) error {
+	return h.ClusterManagerHandler.UpgradeClusterVersion(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) ApplyClusterSpec(ctx context.Context, in *ApplyClusterSpecRequest, out *ApplyClusterSpecResponse) error {
+	return h.ClusterManagerHandler.ApplyClusterSpec(ctx, in, out)
+}
+
 func (h *clusterManagerHandler) CreateVirtualCluster(ctx context.Context, in *CreateVirtualClusterReq, out *CreateVirtualClusterResp) error {
 	return h.ClusterManagerHandler.CreateVirtualCluster(ctx, in, out)
 }
@@ -4538,6 +4621,10 @@ func (h *clusterManagerHandler) DisableNodeGroupAutoScale(ctx context.Context, i
 	return h.ClusterManagerHandler.DisableNodeGroupAutoScale(ctx, in, out)
 }
 
+func (h *clusterManagerHandler) UpgradeNodeGroupVersion(ctx context.Context, in *UpgradeNodeGroupVersionReq, out *UpgradeNodeGroupVersionResp) error {
+	return h.ClusterManagerHandler.UpgradeNodeGroupVersion(ctx, in, out)
+}
+
 func (h *clusterManagerHandler) GetProviderResourceUsage(ctx context.Context, in *GetProviderResourceUsageRequest, out *GetProviderResourceUsageResponse) error {
 	return h.ClusterManagerHandler.GetProviderResourceUsage(ctx, in, out)
 }
@@ -10951,6 +10951,35 @@ func (m *NodeGroup) validate(all bool) error {
 
 	// no validation rules for ExtraInfo
 
+	if all {
+		switch v := interface{}(m.GetAutoRepair()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NodeGroupValidationError{
+					field:  "AutoRepair",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NodeGroupValidationError{
+					field:  "AutoRepair",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAutoRepair()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NodeGroupValidationError{
+				field:  "AutoRepair",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return NodeGroupMultiError(errors)
 	}
@@ -11037,6 +11066,123 @@ var _NodeGroup_Status_InLookup = map[string]struct{}{
 	"DELETED":        {},
 }
 
+// Validate checks the field values on NodeGroupAutoRepair with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NodeGroupAutoRepair) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NodeGroupAutoRepair with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NodeGroupAutoRepairMultiError, or nil if none found.
+func (m *NodeGroupAutoRepair) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NodeGroupAutoRepair) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enable
+
+	// no validation rules for GracePeriod
+
+	if m.GetMaxConcurrentRepairs() > 100 {
+		err := NodeGroupAutoRepairValidationError{
+			field:  "MaxConcurrentRepairs",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return NodeGroupAutoRepairMultiError(errors)
+	}
+
+	return nil
+}
+
+// NodeGroupAutoRepairMultiError is an error wrapping multiple validation
+// errors returned by NodeGroupAutoRepair.ValidateAll() if the designated
+// constraints aren't met.
+type NodeGroupAutoRepairMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NodeGroupAutoRepairMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NodeGroupAutoRepairMultiError) AllErrors() []error { return m }
+
+// NodeGroupAutoRepairValidationError is the validation error returned by
+// NodeGroupAutoRepair.Validate if the designated constraints aren't met.
+type NodeGroupAutoRepairValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NodeGroupAutoRepairValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NodeGroupAutoRepairValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NodeGroupAutoRepairValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NodeGroupAutoRepairValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NodeGroupAutoRepairValidationError) ErrorName() string {
+	return "NodeGroupAutoRepairValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NodeGroupAutoRepairValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNodeGroupAutoRepair.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NodeGroupAutoRepairValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NodeGroupAutoRepairValidationError{}
+
 // Validate checks the field values on CloudArea with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -17957,57 +18103,1675 @@ func (m *SwitchClusterUnderlayNetworkReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Disable
+	// no validation rules for Disable
+
+	if all {
+		switch v := interface{}(m.GetSubnet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SwitchClusterUnderlayNetworkReqValidationError{
+					field:  "Subnet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SwitchClusterUnderlayNetworkReqValidationError{
+					field:  "Subnet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubnet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SwitchClusterUnderlayNetworkReqValidationError{
+				field:  "Subnet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IsStaticIpMode
+
+	// no validation rules for ClaimExpiredSeconds
+
+	// no validation rules for Operator
+
+	if len(errors) > 0 {
+		return SwitchClusterUnderlayNetworkReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SwitchClusterUnderlayNetworkReqMultiError is an error wrapping multiple
+// validation errors returned by SwitchClusterUnderlayNetworkReq.ValidateAll()
+// if the designated constraints aren't met.
+type SwitchClusterUnderlayNetworkReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SwitchClusterUnderlayNetworkReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SwitchClusterUnderlayNetworkReqMultiError) AllErrors() []error { return m }
+
+// SwitchClusterUnderlayNetworkReqValidationError is the validation error
+// returned by SwitchClusterUnderlayNetworkReq.Validate if the designated
+// constraints aren't met.
+type SwitchClusterUnderlayNetworkReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SwitchClusterUnderlayNetworkReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SwitchClusterUnderlayNetworkReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SwitchClusterUnderlayNetworkReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SwitchClusterUnderlayNetworkReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SwitchClusterUnderlayNetworkReqValidationError) ErrorName() string {
+	return "SwitchClusterUnderlayNetworkReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SwitchClusterUnderlayNetworkReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSwitchClusterUnderlayNetworkReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SwitchClusterUnderlayNetworkReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SwitchClusterUnderlayNetworkReqValidationError{}
+
+var _SwitchClusterUnderlayNetworkReq_ClusterID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on SwitchClusterUnderlayNetworkResp with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SwitchClusterUnderlayNetworkResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SwitchClusterUnderlayNetworkResp with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SwitchClusterUnderlayNetworkRespMultiError, or nil if none found.
+func (m *SwitchClusterUnderlayNetworkResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SwitchClusterUnderlayNetworkResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SwitchClusterUnderlayNetworkRespValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SwitchClusterUnderlayNetworkRespValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SwitchClusterUnderlayNetworkRespValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWebAnnotations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SwitchClusterUnderlayNetworkRespValidationError{
+					field:  "WebAnnotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SwitchClusterUnderlayNetworkRespValidationError{
+					field:  "WebAnnotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SwitchClusterUnderlayNetworkRespValidationError{
+				field:  "WebAnnotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCluster()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SwitchClusterUnderlayNetworkRespValidationError{
+					field:  "Cluster",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SwitchClusterUnderlayNetworkRespValidationError{
+					field:  "Cluster",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCluster()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SwitchClusterUnderlayNetworkRespValidationError{
+				field:  "Cluster",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SwitchClusterUnderlayNetworkRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// SwitchClusterUnderlayNetworkRespMultiError is an error wrapping multiple
+// validation errors returned by
+// SwitchClusterUnderlayNetworkResp.ValidateAll() if the designated
+// constraints aren't met.
+type SwitchClusterUnderlayNetworkRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SwitchClusterUnderlayNetworkRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SwitchClusterUnderlayNetworkRespMultiError) AllErrors() []error { return m }
+
+// SwitchClusterUnderlayNetworkRespValidationError is the validation error
+// returned by SwitchClusterUnderlayNetworkResp.Validate if the designated
+// constraints aren't met.
+type SwitchClusterUnderlayNetworkRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SwitchClusterUnderlayNetworkRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SwitchClusterUnderlayNetworkRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SwitchClusterUnderlayNetworkRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SwitchClusterUnderlayNetworkRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SwitchClusterUnderlayNetworkRespValidationError) ErrorName() string {
+	return "SwitchClusterUnderlayNetworkRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SwitchClusterUnderlayNetworkRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSwitchClusterUnderlayNetworkResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SwitchClusterUnderlayNetworkRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SwitchClusterUnderlayNetworkRespValidationError{}
+
+// Validate checks the field values on UpgradeClusterVersionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpgradeClusterVersionReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpgradeClusterVersionReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpgradeClusterVersionReqMultiError, or nil if none found.
+func (m *UpgradeClusterVersionReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpgradeClusterVersionReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 1 || l > 100 {
+		err := UpgradeClusterVersionReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !strings.HasPrefix(m.GetClusterID(), "BCS-") {
+		err := UpgradeClusterVersionReqValidationError{
+			field:  "ClusterID",
+			reason: "value does not have prefix \"BCS-\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpgradeClusterVersionReq_ClusterID_Pattern.MatchString(m.GetClusterID()) {
+		err := UpgradeClusterVersionReqValidationError{
+			field:  "ClusterID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 32 {
+		err := UpgradeClusterVersionReqValidationError{
+			field:  "Version",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SkipPreCheck
+
+	// no validation rules for UpgradeNodeGroups
+
+	if all {
+		switch v := interface{}(m.GetStrategy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpgradeClusterVersionReqValidationError{
+					field:  "Strategy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpgradeClusterVersionReqValidationError{
+					field:  "Strategy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStrategy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpgradeClusterVersionReqValidationError{
+				field:  "Strategy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Operator
+
+	if len(errors) > 0 {
+		return UpgradeClusterVersionReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpgradeClusterVersionReqMultiError is an error wrapping multiple validation
+// errors returned by UpgradeClusterVersionReq.ValidateAll() if the designated
+// constraints aren't met.
+type UpgradeClusterVersionReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpgradeClusterVersionReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpgradeClusterVersionReqMultiError) AllErrors() []error { return m }
+
+// UpgradeClusterVersionReqValidationError is the validation error returned by
+// UpgradeClusterVersionReq.Validate if the designated constraints aren't met.
+type UpgradeClusterVersionReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpgradeClusterVersionReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpgradeClusterVersionReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpgradeClusterVersionReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpgradeClusterVersionReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpgradeClusterVersionReqValidationError) ErrorName() string {
+	return "UpgradeClusterVersionReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpgradeClusterVersionReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpgradeClusterVersionReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpgradeClusterVersionReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpgradeClusterVersionReqValidationError{}
+
+var _UpgradeClusterVersionReq_ClusterID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on UpgradeClusterVersionResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpgradeClusterVersionResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpgradeClusterVersionResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpgradeClusterVersionRespMultiError, or nil if none found.
+func (m *UpgradeClusterVersionResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpgradeClusterVersionResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpgradeClusterVersionRespValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpgradeClusterVersionRespValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpgradeClusterVersionRespValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWebAnnotations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpgradeClusterVersionRespValidationError{
+					field:  "WebAnnotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpgradeClusterVersionRespValidationError{
+					field:  "WebAnnotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpgradeClusterVersionRespValidationError{
+				field:  "WebAnnotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpgradeClusterVersionRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpgradeClusterVersionRespMultiError is an error wrapping multiple validation
+// errors returned by UpgradeClusterVersionResp.ValidateAll() if the
+// designated constraints aren't met.
+type UpgradeClusterVersionRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpgradeClusterVersionRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpgradeClusterVersionRespMultiError) AllErrors() []error { return m }
+
+// UpgradeClusterVersionRespValidationError is the validation error returned by
+// UpgradeClusterVersionResp.Validate if the designated constraints aren't met.
+type UpgradeClusterVersionRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpgradeClusterVersionRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpgradeClusterVersionRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpgradeClusterVersionRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpgradeClusterVersionRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpgradeClusterVersionRespValidationError) ErrorName() string {
+	return "UpgradeClusterVersionRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpgradeClusterVersionRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpgradeClusterVersionResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpgradeClusterVersionRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpgradeClusterVersionRespValidationError{}
+
+// Validate checks the field values on NodeGroupUpgradeStrategy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NodeGroupUpgradeStrategy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NodeGroupUpgradeStrategy with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NodeGroupUpgradeStrategyMultiError, or nil if none found.
+func (m *NodeGroupUpgradeStrategy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NodeGroupUpgradeStrategy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxSurge
+
+	// no validation rules for DrainTimeout
+
+	// no validation rules for IgnoreDaemonSets
+
+	// no validation rules for DeleteLocalData
+
+	// no validation rules for Force
+
+	if len(errors) > 0 {
+		return NodeGroupUpgradeStrategyMultiError(errors)
+	}
+
+	return nil
+}
+
+// NodeGroupUpgradeStrategyMultiError is an error wrapping multiple validation
+// errors returned by NodeGroupUpgradeStrategy.ValidateAll() if the designated
+// constraints aren't met.
+type NodeGroupUpgradeStrategyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NodeGroupUpgradeStrategyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NodeGroupUpgradeStrategyMultiError) AllErrors() []error { return m }
+
+// NodeGroupUpgradeStrategyValidationError is the validation error returned by
+// NodeGroupUpgradeStrategy.Validate if the designated constraints aren't met.
+type NodeGroupUpgradeStrategyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NodeGroupUpgradeStrategyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NodeGroupUpgradeStrategyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NodeGroupUpgradeStrategyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NodeGroupUpgradeStrategyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NodeGroupUpgradeStrategyValidationError) ErrorName() string {
+	return "NodeGroupUpgradeStrategyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NodeGroupUpgradeStrategyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNodeGroupUpgradeStrategy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NodeGroupUpgradeStrategyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NodeGroupUpgradeStrategyValidationError{}
+
+// Validate checks the field values on UpgradeNodeGroupVersionReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpgradeNodeGroupVersionReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpgradeNodeGroupVersionReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpgradeNodeGroupVersionReqMultiError, or nil if none found.
+func (m *UpgradeNodeGroupVersionReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpgradeNodeGroupVersionReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetNodeGroupID()); l < 5 || l > 100 {
+		err := UpgradeNodeGroupVersionReqValidationError{
+			field:  "NodeGroupID",
+			reason: "value length must be between 5 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpgradeNodeGroupVersionReq_NodeGroupID_Pattern.MatchString(m.GetNodeGroupID()) {
+		err := UpgradeNodeGroupVersionReqValidationError{
+			field:  "NodeGroupID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStrategy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpgradeNodeGroupVersionReqValidationError{
+					field:  "Strategy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpgradeNodeGroupVersionReqValidationError{
+					field:  "Strategy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStrategy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpgradeNodeGroupVersionReqValidationError{
+				field:  "Strategy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Operator
+
+	if len(errors) > 0 {
+		return UpgradeNodeGroupVersionReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpgradeNodeGroupVersionReqMultiError is an error wrapping multiple
+// validation errors returned by UpgradeNodeGroupVersionReq.ValidateAll() if
+// the designated constraints aren't met.
+type UpgradeNodeGroupVersionReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpgradeNodeGroupVersionReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpgradeNodeGroupVersionReqMultiError) AllErrors() []error { return m }
+
+// UpgradeNodeGroupVersionReqValidationError is the validation error returned
+// by UpgradeNodeGroupVersionReq.Validate if the designated constraints aren't met.
+type UpgradeNodeGroupVersionReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpgradeNodeGroupVersionReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpgradeNodeGroupVersionReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpgradeNodeGroupVersionReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpgradeNodeGroupVersionReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpgradeNodeGroupVersionReqValidationError) ErrorName() string {
+	return "UpgradeNodeGroupVersionReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpgradeNodeGroupVersionReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpgradeNodeGroupVersionReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpgradeNodeGroupVersionReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpgradeNodeGroupVersionReqValidationError{}
+
+var _UpgradeNodeGroupVersionReq_NodeGroupID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on UpgradeNodeGroupVersionResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpgradeNodeGroupVersionResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpgradeNodeGroupVersionResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpgradeNodeGroupVersionRespMultiError, or nil if none found.
+func (m *UpgradeNodeGroupVersionResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpgradeNodeGroupVersionResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpgradeNodeGroupVersionRespValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpgradeNodeGroupVersionRespValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpgradeNodeGroupVersionRespValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWebAnnotations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpgradeNodeGroupVersionRespValidationError{
+					field:  "WebAnnotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpgradeNodeGroupVersionRespValidationError{
+					field:  "WebAnnotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpgradeNodeGroupVersionRespValidationError{
+				field:  "WebAnnotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpgradeNodeGroupVersionRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpgradeNodeGroupVersionRespMultiError is an error wrapping multiple
+// validation errors returned by UpgradeNodeGroupVersionResp.ValidateAll() if
+// the designated constraints aren't met.
+type UpgradeNodeGroupVersionRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpgradeNodeGroupVersionRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpgradeNodeGroupVersionRespMultiError) AllErrors() []error { return m }
+
+// UpgradeNodeGroupVersionRespValidationError is the validation error returned
+// by UpgradeNodeGroupVersionResp.Validate if the designated constraints
+// aren't met.
+type UpgradeNodeGroupVersionRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpgradeNodeGroupVersionRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpgradeNodeGroupVersionRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpgradeNodeGroupVersionRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpgradeNodeGroupVersionRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpgradeNodeGroupVersionRespValidationError) ErrorName() string {
+	return "UpgradeNodeGroupVersionRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpgradeNodeGroupVersionRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpgradeNodeGroupVersionResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpgradeNodeGroupVersionRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpgradeNodeGroupVersionRespValidationError{}
+
+// Validate checks the field values on ClusterSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClusterSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClusterSpecMultiError, or
+// nil if none found.
+func (m *ClusterSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCluster()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterSpecValidationError{
+					field:  "Cluster",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterSpecValidationError{
+					field:  "Cluster",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCluster()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterSpecValidationError{
+				field:  "Cluster",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetNodeGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClusterSpecValidationError{
+						field:  fmt.Sprintf("NodeGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClusterSpecValidationError{
+						field:  fmt.Sprintf("NodeGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClusterSpecValidationError{
+					field:  fmt.Sprintf("NodeGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetNodeTemplates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClusterSpecValidationError{
+						field:  fmt.Sprintf("NodeTemplates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClusterSpecValidationError{
+						field:  fmt.Sprintf("NodeTemplates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClusterSpecValidationError{
+					field:  fmt.Sprintf("NodeTemplates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAutoScalingOption()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterSpecValidationError{
+					field:  "AutoScalingOption",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterSpecValidationError{
+					field:  "AutoScalingOption",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAutoScalingOption()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterSpecValidationError{
+				field:  "AutoScalingOption",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ClusterSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterSpecMultiError is an error wrapping multiple validation errors
+// returned by ClusterSpec.ValidateAll() if the designated constraints aren't met.
+type ClusterSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterSpecMultiError) AllErrors() []error { return m }
+
+// ClusterSpecValidationError is the validation error returned by
+// ClusterSpec.Validate if the designated constraints aren't met.
+type ClusterSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterSpecValidationError) ErrorName() string { return "ClusterSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterSpecValidationError{}
+
+// Validate checks the field values on ApplyClusterSpecRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyClusterSpecRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplyClusterSpecRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplyClusterSpecRequestMultiError, or nil if none found.
+func (m *ApplyClusterSpecRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplyClusterSpecRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 1 || l > 100 {
+		err := ApplyClusterSpecRequestValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !strings.HasPrefix(m.GetClusterID(), "BCS-") {
+		err := ApplyClusterSpecRequestValidationError{
+			field:  "ClusterID",
+			reason: "value does not have prefix \"BCS-\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ApplyClusterSpecRequest_ClusterID_Pattern.MatchString(m.GetClusterID()) {
+		err := ApplyClusterSpecRequestValidationError{
+			field:  "ClusterID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSpec() == nil {
+		err := ApplyClusterSpecRequestValidationError{
+			field:  "Spec",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// skipping validation for spec
+
+	// no validation rules for DryRun
+
+	// no validation rules for Operator
+
+	if len(errors) > 0 {
+		return ApplyClusterSpecRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplyClusterSpecRequestMultiError is an error wrapping multiple validation
+// errors returned by ApplyClusterSpecRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApplyClusterSpecRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplyClusterSpecRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplyClusterSpecRequestMultiError) AllErrors() []error { return m }
+
+// ApplyClusterSpecRequestValidationError is the validation error returned by
+// ApplyClusterSpecRequest.Validate if the designated constraints aren't met.
+type ApplyClusterSpecRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyClusterSpecRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyClusterSpecRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyClusterSpecRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyClusterSpecRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyClusterSpecRequestValidationError) ErrorName() string {
+	return "ApplyClusterSpecRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyClusterSpecRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyClusterSpecRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyClusterSpecRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyClusterSpecRequestValidationError{}
+
+var _ApplyClusterSpecRequest_ClusterID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on SpecFieldDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SpecFieldDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SpecFieldDiff with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SpecFieldDiffMultiError, or
+// nil if none found.
+func (m *SpecFieldDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SpecFieldDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Old
+
+	// no validation rules for New
+
+	if len(errors) > 0 {
+		return SpecFieldDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// SpecFieldDiffMultiError is an error wrapping multiple validation errors
+// returned by SpecFieldDiff.ValidateAll() if the designated constraints
+// aren't met.
+type SpecFieldDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SpecFieldDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SpecFieldDiffMultiError) AllErrors() []error { return m }
+
+// SpecFieldDiffValidationError is the validation error returned by
+// SpecFieldDiff.Validate if the designated constraints aren't met.
+type SpecFieldDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SpecFieldDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SpecFieldDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SpecFieldDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SpecFieldDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SpecFieldDiffValidationError) ErrorName() string { return "SpecFieldDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SpecFieldDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpecFieldDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SpecFieldDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SpecFieldDiffValidationError{}
+
+// Validate checks the field values on SpecChange with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SpecChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SpecChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SpecChangeMultiError, or
+// nil if none found.
+func (m *SpecChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SpecChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceID
+
+	// no validation rules for Name
 
-	if all {
-		switch v := interface{}(m.GetSubnet()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SwitchClusterUnderlayNetworkReqValidationError{
-					field:  "Subnet",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Action
+
+	for idx, item := range m.GetDiffs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SpecChangeValidationError{
+						field:  fmt.Sprintf("Diffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SpecChangeValidationError{
+						field:  fmt.Sprintf("Diffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SwitchClusterUnderlayNetworkReqValidationError{
-					field:  "Subnet",
+				return SpecChangeValidationError{
+					field:  fmt.Sprintf("Diffs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSubnet()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SwitchClusterUnderlayNetworkReqValidationError{
-				field:  "Subnet",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for IsStaticIpMode
+	}
 
-	// no validation rules for ClaimExpiredSeconds
+	// no validation rules for TaskID
 
-	// no validation rules for Operator
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return SwitchClusterUnderlayNetworkReqMultiError(errors)
+		return SpecChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// SwitchClusterUnderlayNetworkReqMultiError is an error wrapping multiple
-// validation errors returned by SwitchClusterUnderlayNetworkReq.ValidateAll()
-// if the designated constraints aren't met.
-type SwitchClusterUnderlayNetworkReqMultiError []error
+// SpecChangeMultiError is an error wrapping multiple validation errors
+// returned by SpecChange.ValidateAll() if the designated constraints aren't met.
+type SpecChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SwitchClusterUnderlayNetworkReqMultiError) Error() string {
+func (m SpecChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18016,12 +19780,11 @@ func (m SwitchClusterUnderlayNetworkReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SwitchClusterUnderlayNetworkReqMultiError) AllErrors() []error { return m }
+func (m SpecChangeMultiError) AllErrors() []error { return m }
 
-// SwitchClusterUnderlayNetworkReqValidationError is the validation error
-// returned by SwitchClusterUnderlayNetworkReq.Validate if the designated
-// constraints aren't met.
-type SwitchClusterUnderlayNetworkReqValidationError struct {
+// SpecChangeValidationError is the validation error returned by
+// SpecChange.Validate if the designated constraints aren't met.
+type SpecChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18029,24 +19792,22 @@ type SwitchClusterUnderlayNetworkReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SwitchClusterUnderlayNetworkReqValidationError) Field() string { return e.field }
+func (e SpecChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SwitchClusterUnderlayNetworkReqValidationError) Reason() string { return e.reason }
+func (e SpecChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SwitchClusterUnderlayNetworkReqValidationError) Cause() error { return e.cause }
+func (e SpecChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SwitchClusterUnderlayNetworkReqValidationError) Key() bool { return e.key }
+func (e SpecChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SwitchClusterUnderlayNetworkReqValidationError) ErrorName() string {
-	return "SwitchClusterUnderlayNetworkReqValidationError"
-}
+func (e SpecChangeValidationError) ErrorName() string { return "SpecChangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SwitchClusterUnderlayNetworkReqValidationError) Error() string {
+func (e SpecChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18058,14 +19819,14 @@ func (e SwitchClusterUnderlayNetworkReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSwitchClusterUnderlayNetworkReq.%s: %s%s",
+		"invalid %sSpecChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SwitchClusterUnderlayNetworkReqValidationError{}
+var _ error = SpecChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -18073,27 +19834,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SwitchClusterUnderlayNetworkReqValidationError{}
+} = SpecChangeValidationError{}
 
-var _SwitchClusterUnderlayNetworkReq_ClusterID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
-
-// Validate checks the field values on SwitchClusterUnderlayNetworkResp with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *SwitchClusterUnderlayNetworkResp) Validate() error {
+// Validate checks the field values on ApplyClusterSpecResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyClusterSpecResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SwitchClusterUnderlayNetworkResp with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// SwitchClusterUnderlayNetworkRespMultiError, or nil if none found.
-func (m *SwitchClusterUnderlayNetworkResp) ValidateAll() error {
+// ValidateAll checks the field values on ApplyClusterSpecResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplyClusterSpecResponseMultiError, or nil if none found.
+func (m *ApplyClusterSpecResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SwitchClusterUnderlayNetworkResp) validate(all bool) error {
+func (m *ApplyClusterSpecResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -18106,108 +19864,54 @@ func (m *SwitchClusterUnderlayNetworkResp) validate(all bool) error {
 
 	// no validation rules for Result
 
-	if all {
-		switch v := interface{}(m.GetTask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SwitchClusterUnderlayNetworkRespValidationError{
-					field:  "Task",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SwitchClusterUnderlayNetworkRespValidationError{
-					field:  "Task",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SwitchClusterUnderlayNetworkRespValidationError{
-				field:  "Task",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetWebAnnotations()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SwitchClusterUnderlayNetworkRespValidationError{
-					field:  "WebAnnotations",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApplyClusterSpecResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApplyClusterSpecResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SwitchClusterUnderlayNetworkRespValidationError{
-					field:  "WebAnnotations",
+				return ApplyClusterSpecResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWebAnnotations()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SwitchClusterUnderlayNetworkRespValidationError{
-				field:  "WebAnnotations",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	if all {
-		switch v := interface{}(m.GetCluster()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SwitchClusterUnderlayNetworkRespValidationError{
-					field:  "Cluster",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SwitchClusterUnderlayNetworkRespValidationError{
-					field:  "Cluster",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCluster()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SwitchClusterUnderlayNetworkRespValidationError{
-				field:  "Cluster",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
 	}
 
 	if len(errors) > 0 {
-		return SwitchClusterUnderlayNetworkRespMultiError(errors)
+		return ApplyClusterSpecResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SwitchClusterUnderlayNetworkRespMultiError is an error wrapping multiple
-// validation errors returned by
-// SwitchClusterUnderlayNetworkResp.ValidateAll() if the designated
+// ApplyClusterSpecResponseMultiError is an error wrapping multiple validation
+// errors returned by ApplyClusterSpecResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SwitchClusterUnderlayNetworkRespMultiError []error
+type ApplyClusterSpecResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SwitchClusterUnderlayNetworkRespMultiError) Error() string {
+func (m ApplyClusterSpecResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18216,12 +19920,11 @@ func (m SwitchClusterUnderlayNetworkRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SwitchClusterUnderlayNetworkRespMultiError) AllErrors() []error { return m }
+func (m ApplyClusterSpecResponseMultiError) AllErrors() []error { return m }
 
-// SwitchClusterUnderlayNetworkRespValidationError is the validation error
-// returned by SwitchClusterUnderlayNetworkResp.Validate if the designated
-// constraints aren't met.
-type SwitchClusterUnderlayNetworkRespValidationError struct {
+// ApplyClusterSpecResponseValidationError is the validation error returned by
+// ApplyClusterSpecResponse.Validate if the designated constraints aren't met.
+type ApplyClusterSpecResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18229,24 +19932,24 @@ type SwitchClusterUnderlayNetworkRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e SwitchClusterUnderlayNetworkRespValidationError) Field() string { return e.field }
+func (e ApplyClusterSpecResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SwitchClusterUnderlayNetworkRespValidationError) Reason() string { return e.reason }
+func (e ApplyClusterSpecResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SwitchClusterUnderlayNetworkRespValidationError) Cause() error { return e.cause }
+func (e ApplyClusterSpecResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SwitchClusterUnderlayNetworkRespValidationError) Key() bool { return e.key }
+func (e ApplyClusterSpecResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SwitchClusterUnderlayNetworkRespValidationError) ErrorName() string {
-	return "SwitchClusterUnderlayNetworkRespValidationError"
+func (e ApplyClusterSpecResponseValidationError) ErrorName() string {
+	return "ApplyClusterSpecResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SwitchClusterUnderlayNetworkRespValidationError) Error() string {
+func (e ApplyClusterSpecResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18258,14 +19961,14 @@ func (e SwitchClusterUnderlayNetworkRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSwitchClusterUnderlayNetworkResp.%s: %s%s",
+		"invalid %sApplyClusterSpecResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SwitchClusterUnderlayNetworkRespValidationError{}
+var _ error = ApplyClusterSpecResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18273,7 +19976,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SwitchClusterUnderlayNetworkRespValidationError{}
+} = ApplyClusterSpecResponseValidationError{}
 
 // Validate checks the field values on CreateVirtualClusterReq with the rules
 // defined in the proto definition for this message. If any rules are
@@ -33830,6 +35533,35 @@ func (m *CreateNodeGroupRequest) validate(all bool) error {
 
 	// no validation rules for OnlyCreateInfo
 
+	if all {
+		switch v := interface{}(m.GetAutoRepair()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateNodeGroupRequestValidationError{
+					field:  "AutoRepair",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateNodeGroupRequestValidationError{
+					field:  "AutoRepair",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAutoRepair()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateNodeGroupRequestValidationError{
+				field:  "AutoRepair",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateNodeGroupRequestMultiError(errors)
 	}
@@ -34620,6 +36352,35 @@ func (m *UpdateNodeGroupRequest) validate(all bool) error {
 
 	// no validation rules for ExtraInfo
 
+	if all {
+		switch v := interface{}(m.GetAutoRepair()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateNodeGroupRequestValidationError{
+					field:  "AutoRepair",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateNodeGroupRequestValidationError{
+					field:  "AutoRepair",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAutoRepair()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateNodeGroupRequestValidationError{
+				field:  "AutoRepair",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return UpdateNodeGroupRequestMultiError(errors)
 	}
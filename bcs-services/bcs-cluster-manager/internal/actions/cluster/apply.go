@@ -0,0 +1,552 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	"google.golang.org/protobuf/proto"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/autoscalingoption"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/nodegroup"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/nodetemplate"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+const (
+	// SpecActionCreate resource will be created
+	SpecActionCreate = "create"
+	// SpecActionUpdate resource will be updated
+	SpecActionUpdate = "update"
+	// SpecActionUnchanged resource is already in desired state
+	SpecActionUnchanged = "unchanged"
+)
+
+var (
+	clusterSpecFields             = newSpecFieldSet(&cmproto.Cluster{}, &cmproto.UpdateClusterReq{})
+	nodeGroupCreateFields         = newSpecFieldSet(&cmproto.NodeGroup{}, &cmproto.CreateNodeGroupRequest{})
+	nodeGroupUpdateFields         = newSpecFieldSet(&cmproto.NodeGroup{}, &cmproto.UpdateNodeGroupRequest{})
+	nodeTemplateCreateFields      = newSpecFieldSet(&cmproto.NodeTemplate{}, &cmproto.CreateNodeTemplateRequest{})
+	nodeTemplateUpdateFields      = newSpecFieldSet(&cmproto.NodeTemplate{}, &cmproto.UpdateNodeTemplateRequest{})
+	autoScalingOptionCreateFields = newSpecFieldSet(&cmproto.ClusterAutoScalingOption{},
+		&cmproto.CreateAutoScalingOptionRequest{})
+	autoScalingOptionUpdateFields = newSpecFieldSet(&cmproto.ClusterAutoScalingOption{},
+		&cmproto.UpdateAutoScalingOptionRequest{})
+)
+
+// specChange planed change of single resource and the function to apply it
+type specChange struct {
+	change *cmproto.SpecChange
+	apply  func(change *cmproto.SpecChange) error
+}
+
+// ApplySpecAction action for apply declarative cluster spec
+type ApplySpecAction struct {
+	ctx    context.Context
+	model  store.ClusterManagerModel
+	locker lock.DistributedLock
+
+	req  *cmproto.ApplyClusterSpecRequest
+	resp *cmproto.ApplyClusterSpecResponse
+
+	cluster *cmproto.Cluster
+	changes []*specChange
+}
+
+// NewApplySpecAction create apply cluster spec action
+func NewApplySpecAction(model store.ClusterManagerModel, locker lock.DistributedLock) *ApplySpecAction {
+	return &ApplySpecAction{
+		model:  model,
+		locker: locker,
+	}
+}
+
+func (aa *ApplySpecAction) setResp(code uint32, msg string) {
+	aa.resp.Code = code
+	aa.resp.Message = msg
+	aa.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+
+	aa.resp.Data = make([]*cmproto.SpecChange, 0, len(aa.changes))
+	for i := range aa.changes {
+		aa.resp.Data = append(aa.resp.Data, aa.changes[i].change)
+	}
+}
+
+func (aa *ApplySpecAction) validate() error {
+	if err := aa.req.Validate(); err != nil {
+		return err
+	}
+
+	spec := aa.req.GetSpec()
+	if spec.GetCluster().GetClusterID() != "" && spec.GetCluster().GetClusterID() != aa.req.ClusterID {
+		return fmt.Errorf("spec cluster %s mismatch with request cluster %s",
+			spec.GetCluster().GetClusterID(), aa.req.ClusterID)
+	}
+	if spec.GetAutoScalingOption().GetClusterID() != "" &&
+		spec.GetAutoScalingOption().GetClusterID() != aa.req.ClusterID {
+		return fmt.Errorf("spec autoScalingOption cluster %s mismatch with request cluster %s",
+			spec.GetAutoScalingOption().GetClusterID(), aa.req.ClusterID)
+	}
+
+	groups := make(map[string]struct{})
+	for i, group := range spec.GetNodeGroups() {
+		if group.GetNodeGroupID() == "" && group.GetName() == "" {
+			return fmt.Errorf("spec nodeGroups[%d] nodeGroupID or name is required", i)
+		}
+		if group.GetClusterID() != "" && group.GetClusterID() != aa.req.ClusterID {
+			return fmt.Errorf("spec nodeGroups[%d] cluster %s mismatch with request cluster %s",
+				i, group.GetClusterID(), aa.req.ClusterID)
+		}
+		key := group.GetNodeGroupID() + "/" + group.GetName()
+		if _, ok := groups[key]; ok {
+			return fmt.Errorf("spec nodeGroups[%d] %s duplicate", i, key)
+		}
+		groups[key] = struct{}{}
+	}
+
+	templates := make(map[string]struct{})
+	for i, template := range spec.GetNodeTemplates() {
+		if template.GetNodeTemplateID() == "" && template.GetName() == "" {
+			return fmt.Errorf("spec nodeTemplates[%d] nodeTemplateID or name is required", i)
+		}
+		key := template.GetNodeTemplateID() + "/" + template.GetName()
+		if _, ok := templates[key]; ok {
+			return fmt.Errorf("spec nodeTemplates[%d] %s duplicate", i, key)
+		}
+		templates[key] = struct{}{}
+	}
+
+	return nil
+}
+
+func (aa *ApplySpecAction) getCluster() error {
+	cluster, err := aa.model.GetCluster(aa.ctx, aa.req.ClusterID)
+	if err != nil {
+		blog.Errorf("ApplyClusterSpec get cluster %s failed: %v", aa.req.ClusterID, err)
+		return err
+	}
+	aa.cluster = cluster
+
+	return nil
+}
+
+func (aa *ApplySpecAction) addChange(resourceType common.ResourceType, resourceID, name, action string,
+	diffs []*cmproto.SpecFieldDiff, ignored []string, apply func(change *cmproto.SpecChange) error) {
+	change := &cmproto.SpecChange{
+		ResourceType: resourceType.String(),
+		ResourceID:   resourceID,
+		Name:         name,
+		Action:       action,
+		Diffs:        diffs,
+	}
+	if len(ignored) > 0 {
+		change.Message = fmt.Sprintf("fields [%s] can't be applied and are ignored", strings.Join(ignored, ","))
+	}
+
+	aa.changes = append(aa.changes, &specChange{change: change, apply: apply})
+}
+
+// planCluster cluster must exist, it is only updated
+func (aa *ApplySpecAction) planCluster() {
+	desired := aa.req.GetSpec().GetCluster()
+	if desired == nil {
+		return
+	}
+
+	diffs, ignored := clusterSpecFields.diffSpec(aa.cluster, desired)
+	if len(diffs) == 0 {
+		aa.addChange(common.Cluster, aa.cluster.ClusterID, aa.cluster.ClusterName, SpecActionUnchanged,
+			diffs, ignored, nil)
+		return
+	}
+
+	aa.addChange(common.Cluster, aa.cluster.ClusterID, aa.cluster.ClusterName, SpecActionUpdate,
+		diffs, ignored, func(change *cmproto.SpecChange) error {
+			req := &cmproto.UpdateClusterReq{}
+			clusterSpecFields.buildRequest(clusterSpecFields.mergeSpec(aa.cluster, desired), req)
+			req.ClusterID = aa.cluster.ClusterID
+			req.ProjectID = aa.cluster.ProjectID
+			req.Updater = aa.req.Operator
+
+			resp := &cmproto.UpdateClusterResp{}
+			NewUpdateAction(aa.model).Handle(aa.ctx, req, resp)
+			if resp.Code != common.BcsErrClusterManagerSuccess {
+				return errors.New(resp.Message)
+			}
+			return nil
+		})
+}
+
+// planNodeTemplates node templates belong to project of cluster
+func (aa *ApplySpecAction) planNodeTemplates() error {
+	desiredTemplates := aa.req.GetSpec().GetNodeTemplates()
+	if len(desiredTemplates) == 0 {
+		return nil
+	}
+
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		"projectid": aa.cluster.ProjectID,
+	})
+	templates, err := aa.model.ListNodeTemplate(aa.ctx, cond, &storeopt.ListOption{})
+	if err != nil {
+		blog.Errorf("ApplyClusterSpec list project %s nodeTemplates failed: %v", aa.cluster.ProjectID, err)
+		return err
+	}
+
+	for i := range desiredTemplates {
+		desired := desiredTemplates[i]
+
+		var current *cmproto.NodeTemplate
+		for j := range templates {
+			if (desired.NodeTemplateID != "" && templates[j].NodeTemplateID == desired.NodeTemplateID) ||
+				(desired.NodeTemplateID == "" && templates[j].Name == desired.Name) {
+				current = decodeNodeTemplateScripts(templates[j])
+				break
+			}
+		}
+
+		if current == nil {
+			if desired.NodeTemplateID != "" {
+				return fmt.Errorf("nodeTemplate %s not found in project %s", desired.NodeTemplateID,
+					aa.cluster.ProjectID)
+			}
+			diffs, ignored := nodeTemplateCreateFields.diffSpec(nil, desired)
+			aa.addChange(common.NodeTemplate, "", desired.Name, SpecActionCreate, diffs, ignored,
+				aa.createNodeTemplate(desired))
+			continue
+		}
+
+		diffs, ignored := nodeTemplateUpdateFields.diffSpec(current, desired)
+		if len(diffs) == 0 {
+			aa.addChange(common.NodeTemplate, current.NodeTemplateID, current.Name, SpecActionUnchanged,
+				diffs, ignored, nil)
+			continue
+		}
+		aa.addChange(common.NodeTemplate, current.NodeTemplateID, current.Name, SpecActionUpdate, diffs, ignored,
+			aa.updateNodeTemplate(current, desired))
+	}
+
+	return nil
+}
+
+func (aa *ApplySpecAction) createNodeTemplate(desired *cmproto.NodeTemplate) func(*cmproto.SpecChange) error {
+	return func(change *cmproto.SpecChange) error {
+		req := &cmproto.CreateNodeTemplateRequest{}
+		nodeTemplateCreateFields.buildRequest(desired, req)
+		req.ProjectID = aa.cluster.ProjectID
+		req.Creator = aa.req.Operator
+
+		resp := &cmproto.CreateNodeTemplateResponse{}
+		nodetemplate.NewCreateAction(aa.model).Handle(aa.ctx, req, resp)
+		if resp.Code != common.BcsErrClusterManagerSuccess {
+			return errors.New(resp.Message)
+		}
+
+		// create response doesn't carry template, find it by unique name in project
+		cond := operator.NewLeafCondition(operator.Eq, operator.M{
+			"projectid": aa.cluster.ProjectID,
+			"name":      req.Name,
+		})
+		templates, err := aa.model.ListNodeTemplate(aa.ctx, cond, &storeopt.ListOption{})
+		if err == nil && len(templates) > 0 {
+			change.ResourceID = templates[0].NodeTemplateID
+		}
+		return nil
+	}
+}
+
+func (aa *ApplySpecAction) updateNodeTemplate(current, desired *cmproto.NodeTemplate) func(*cmproto.SpecChange) error {
+	return func(change *cmproto.SpecChange) error {
+		req := &cmproto.UpdateNodeTemplateRequest{}
+		nodeTemplateUpdateFields.buildRequest(nodeTemplateUpdateFields.mergeSpec(current, desired), req)
+		req.ProjectID = aa.cluster.ProjectID
+		req.NodeTemplateID = current.NodeTemplateID
+		req.Updater = aa.req.Operator
+
+		resp := &cmproto.UpdateNodeTemplateResponse{}
+		nodetemplate.NewUpdateAction(aa.model).Handle(aa.ctx, req, resp)
+		if resp.Code != common.BcsErrClusterManagerSuccess {
+			return errors.New(resp.Message)
+		}
+		return nil
+	}
+}
+
+// decodeNodeTemplateScripts scripts are stored in base64 but requested in plain text, decode
+// them before compare with spec
+func decodeNodeTemplateScripts(template *cmproto.NodeTemplate) *cmproto.NodeTemplate {
+	out := proto.Clone(template).(*cmproto.NodeTemplate)
+	if script, err := base64.StdEncoding.DecodeString(out.UserScript); err == nil {
+		out.UserScript = string(script)
+	}
+	if script, err := base64.StdEncoding.DecodeString(out.PreStartUserScript); err == nil {
+		out.PreStartUserScript = string(script)
+	}
+
+	return out
+}
+
+// planNodeGroups node groups belong to cluster
+func (aa *ApplySpecAction) planNodeGroups() error {
+	desiredGroups := aa.req.GetSpec().GetNodeGroups()
+	if len(desiredGroups) == 0 {
+		return nil
+	}
+
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		"clusterid": aa.cluster.ClusterID,
+	})
+	groups, err := aa.model.ListNodeGroup(aa.ctx, cond, &storeopt.ListOption{})
+	if err != nil {
+		blog.Errorf("ApplyClusterSpec list cluster %s nodeGroups failed: %v", aa.cluster.ClusterID, err)
+		return err
+	}
+
+	for i := range desiredGroups {
+		desired := desiredGroups[i]
+
+		var current *cmproto.NodeGroup
+		for j := range groups {
+			if groups[j].Status == common.StatusDeleting || groups[j].Status == common.StatusDeleted {
+				continue
+			}
+			if (desired.NodeGroupID != "" && groups[j].NodeGroupID == desired.NodeGroupID) ||
+				(desired.NodeGroupID == "" && groups[j].Name == desired.Name) {
+				current = groups[j]
+				break
+			}
+		}
+
+		if current == nil {
+			if desired.NodeGroupID != "" {
+				return fmt.Errorf("nodeGroup %s not found in cluster %s", desired.NodeGroupID,
+					aa.cluster.ClusterID)
+			}
+			diffs, ignored := nodeGroupCreateFields.diffSpec(nil, desired)
+			aa.addChange(common.NodeGroup, "", desired.Name, SpecActionCreate, diffs, ignored,
+				aa.createNodeGroup(desired))
+			continue
+		}
+
+		diffs, ignored := nodeGroupUpdateFields.diffSpec(current, desired)
+		if len(diffs) == 0 {
+			aa.addChange(common.NodeGroup, current.NodeGroupID, current.Name, SpecActionUnchanged,
+				diffs, ignored, nil)
+			continue
+		}
+		aa.addChange(common.NodeGroup, current.NodeGroupID, current.Name, SpecActionUpdate, diffs, ignored,
+			aa.updateNodeGroup(current, desired))
+	}
+
+	return nil
+}
+
+func (aa *ApplySpecAction) createNodeGroup(desired *cmproto.NodeGroup) func(*cmproto.SpecChange) error {
+	return func(change *cmproto.SpecChange) error {
+		req := &cmproto.CreateNodeGroupRequest{}
+		nodeGroupCreateFields.buildRequest(desired, req)
+		req.ClusterID = aa.cluster.ClusterID
+		req.Creator = aa.req.Operator
+		if req.Region == "" {
+			req.Region = aa.cluster.Region
+		}
+		if req.Provider == "" {
+			req.Provider = aa.cluster.Provider
+		}
+
+		resp := &cmproto.CreateNodeGroupResponse{}
+		nodegroup.NewCreateAction(aa.model, aa.locker).Handle(aa.ctx, req, resp)
+		if resp.Code != common.BcsErrClusterManagerSuccess {
+			return errors.New(resp.Message)
+		}
+		change.ResourceID = resp.GetData().GetNodeGroup().GetNodeGroupID()
+		change.TaskID = resp.GetData().GetTask().GetTaskID()
+		return nil
+	}
+}
+
+func (aa *ApplySpecAction) updateNodeGroup(current, desired *cmproto.NodeGroup) func(*cmproto.SpecChange) error {
+	return func(change *cmproto.SpecChange) error {
+		req := &cmproto.UpdateNodeGroupRequest{}
+		nodeGroupUpdateFields.buildRequest(nodeGroupUpdateFields.mergeSpec(current, desired), req)
+		req.ClusterID = aa.cluster.ClusterID
+		req.NodeGroupID = current.NodeGroupID
+		req.Updater = aa.req.Operator
+
+		resp := &cmproto.UpdateNodeGroupResponse{}
+		nodegroup.NewUpdateAction(aa.model).Handle(aa.ctx, req, resp)
+		if resp.Code != common.BcsErrClusterManagerSuccess {
+			return errors.New(resp.Message)
+		}
+		return nil
+	}
+}
+
+// planAutoScalingOption cluster has at most one autoscaling option
+func (aa *ApplySpecAction) planAutoScalingOption() error {
+	desired := aa.req.GetSpec().GetAutoScalingOption()
+	if desired == nil {
+		return nil
+	}
+
+	current, err := aa.model.GetAutoScalingOption(aa.ctx, aa.cluster.ClusterID)
+	if err != nil && !errors.Is(err, drivers.ErrTableRecordNotFound) {
+		blog.Errorf("ApplyClusterSpec get cluster %s autoScalingOption failed: %v", aa.cluster.ClusterID, err)
+		return err
+	}
+
+	if current == nil {
+		diffs, ignored := autoScalingOptionCreateFields.diffSpec(nil, desired)
+		aa.addChange(common.AutoScalingOption, aa.cluster.ClusterID, aa.cluster.ClusterName, SpecActionCreate,
+			diffs, ignored, func(change *cmproto.SpecChange) error {
+				req := &cmproto.CreateAutoScalingOptionRequest{}
+				autoScalingOptionCreateFields.buildRequest(desired, req)
+				req.ClusterID = aa.cluster.ClusterID
+				req.Creator = aa.req.Operator
+				if req.Provider == "" {
+					req.Provider = aa.cluster.Provider
+				}
+
+				resp := &cmproto.CreateAutoScalingOptionResponse{}
+				autoscalingoption.NewCreateAction(aa.model).Handle(aa.ctx, req, resp)
+				if resp.Code != common.BcsErrClusterManagerSuccess {
+					return errors.New(resp.Message)
+				}
+				return nil
+			})
+		return nil
+	}
+
+	diffs, ignored := autoScalingOptionUpdateFields.diffSpec(current, desired)
+	if len(diffs) == 0 {
+		aa.addChange(common.AutoScalingOption, aa.cluster.ClusterID, aa.cluster.ClusterName, SpecActionUnchanged,
+			diffs, ignored, nil)
+		return nil
+	}
+	aa.addChange(common.AutoScalingOption, aa.cluster.ClusterID, aa.cluster.ClusterName, SpecActionUpdate,
+		diffs, ignored, func(change *cmproto.SpecChange) error {
+			req := &cmproto.UpdateAutoScalingOptionRequest{}
+			autoScalingOptionUpdateFields.buildRequest(autoScalingOptionUpdateFields.mergeSpec(current, desired), req)
+			req.ClusterID = aa.cluster.ClusterID
+			req.ProjectID = aa.cluster.ProjectID
+			req.Updater = aa.req.Operator
+
+			resp := &cmproto.UpdateAutoScalingOptionResponse{}
+			autoscalingoption.NewUpdateAction(aa.model).Handle(aa.ctx, req, resp)
+			if resp.Code != common.BcsErrClusterManagerSuccess {
+				return errors.New(resp.Message)
+			}
+			return nil
+		})
+
+	return nil
+}
+
+// plan compute changes in order: cluster, node templates, node groups, autoscaling option.
+// node templates are applied before node groups which may be created from them
+func (aa *ApplySpecAction) plan() error {
+	aa.planCluster()
+	if err := aa.planNodeTemplates(); err != nil {
+		return err
+	}
+	if err := aa.planNodeGroups(); err != nil {
+		return err
+	}
+
+	return aa.planAutoScalingOption()
+}
+
+// applyChanges apply changes one by one, stop at the first failure
+func (aa *ApplySpecAction) applyChanges() (int, error) {
+	applied := 0
+	for _, c := range aa.changes {
+		if c.apply == nil {
+			continue
+		}
+		if err := c.apply(c.change); err != nil {
+			blog.Errorf("ApplyClusterSpec[%s] %s %s[%s] failed: %v", aa.cluster.ClusterID,
+				c.change.Action, c.change.ResourceType, c.change.Name, err)
+			c.change.Message = strings.TrimSpace(c.change.Message + " " + err.Error())
+			return applied, fmt.Errorf("%s %s[%s] failed: %s", c.change.Action, c.change.ResourceType,
+				c.change.Name, err.Error())
+		}
+		applied++
+	}
+
+	return applied, nil
+}
+
+// Handle apply cluster spec request
+func (aa *ApplySpecAction) Handle(ctx context.Context,
+	req *cmproto.ApplyClusterSpecRequest, resp *cmproto.ApplyClusterSpecResponse) {
+	if req == nil || resp == nil {
+		blog.Errorf("ApplyClusterSpec failed, req or resp is empty")
+		return
+	}
+	aa.ctx = ctx
+	aa.req = req
+	aa.resp = resp
+
+	if err := aa.validate(); err != nil {
+		aa.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err := aa.getCluster(); err != nil {
+		aa.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+	if err := aa.plan(); err != nil {
+		aa.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if req.DryRun {
+		aa.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+		return
+	}
+
+	applied, applyErr := aa.applyChanges()
+	if applied > 0 {
+		err := aa.model.CreateOperationLog(ctx, &cmproto.OperationLog{
+			ResourceType: common.Cluster.String(),
+			ResourceID:   aa.cluster.ClusterID,
+			TaskID:       "",
+			Message:      fmt.Sprintf("集群[%s]应用声明式规格, 变更资源%d个", aa.cluster.ClusterID, applied),
+			OpUser:       req.Operator,
+			CreateTime:   time.Now().Format(time.RFC3339),
+			ClusterID:    aa.cluster.ClusterID,
+			ProjectID:    aa.cluster.ProjectID,
+			ResourceName: aa.cluster.ClusterName,
+		})
+		if err != nil {
+			blog.Errorf("ApplyClusterSpec[%s] CreateOperationLog failed: %v", aa.cluster.ClusterID, err)
+		}
+	}
+	if applyErr != nil {
+		aa.setResp(common.BcsErrClusterManagerCommonErr, applyErr.Error())
+		return
+	}
+
+	aa.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+}
@@ -0,0 +1,276 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"encoding/json"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+// specMetaFields are maintained by cluster-manager itself, they identify the resource or
+// record its lifecycle, so they are never compared or applied from a declarative spec
+var specMetaFields = map[string]struct{}{
+	"clusterID":      {},
+	"projectID":      {},
+	"nodeGroupID":    {},
+	"nodeTemplateID": {},
+	"creator":        {},
+	"updater":        {},
+	"createTime":     {},
+	"updateTime":     {},
+	"status":         {},
+}
+
+// specField resource field and the request field which carries it
+type specField struct {
+	res protoreflect.FieldDescriptor
+	req protoreflect.FieldDescriptor
+	// wrapped request field is a google.protobuf wrapper of resource scalar field
+	wrapped bool
+}
+
+// specFieldSet fields of resource which can be applied by request, key is json name
+type specFieldSet map[string]specField
+
+// newSpecFieldSet match resource fields with request fields by json name, only fields
+// with compatible types are kept
+func newSpecFieldSet(res, req proto.Message) specFieldSet {
+	fields := make(specFieldSet)
+
+	resFields := res.ProtoReflect().Descriptor().Fields()
+	reqFields := req.ProtoReflect().Descriptor().Fields()
+	for i := 0; i < resFields.Len(); i++ {
+		resFd := resFields.Get(i)
+		if _, ok := specMetaFields[resFd.JSONName()]; ok {
+			continue
+		}
+		reqFd := reqFields.ByJSONName(resFd.JSONName())
+		if reqFd == nil {
+			continue
+		}
+
+		switch {
+		case sameFieldType(resFd, reqFd):
+			fields[resFd.JSONName()] = specField{res: resFd, req: reqFd}
+		case isWrapperOf(reqFd, resFd):
+			fields[resFd.JSONName()] = specField{res: resFd, req: reqFd, wrapped: true}
+		}
+	}
+
+	return fields
+}
+
+func sameFieldType(a, b protoreflect.FieldDescriptor) bool {
+	if a.IsList() != b.IsList() || a.IsMap() != b.IsMap() {
+		return false
+	}
+	if a.IsMap() {
+		return sameFieldType(a.MapKey(), b.MapKey()) && sameFieldType(a.MapValue(), b.MapValue())
+	}
+	if a.Kind() != b.Kind() {
+		return false
+	}
+
+	switch a.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return a.Message().FullName() == b.Message().FullName()
+	case protoreflect.EnumKind:
+		return a.Enum().FullName() == b.Enum().FullName()
+	default:
+		return true
+	}
+}
+
+// isWrapperOf check wrapper is google.protobuf.XXXValue of scalar field
+func isWrapperOf(wrapper, scalar protoreflect.FieldDescriptor) bool {
+	if wrapper.IsList() || wrapper.IsMap() || scalar.IsList() || scalar.IsMap() ||
+		wrapper.Kind() != protoreflect.MessageKind {
+		return false
+	}
+	if wrapper.Message().FullName().Parent() != "google.protobuf" {
+		return false
+	}
+	value := wrapper.Message().Fields().ByName("value")
+	return value != nil && value.Kind() == scalar.Kind()
+}
+
+// buildRequest fill request fields with resource field values
+func (fs specFieldSet) buildRequest(res, req proto.Message) {
+	src := res.ProtoReflect()
+	dst := req.ProtoReflect()
+
+	for _, f := range fs {
+		if !src.Has(f.res) {
+			continue
+		}
+		v := src.Get(f.res)
+		if f.wrapped {
+			wrapper := dst.NewField(f.req).Message()
+			wrapper.Set(wrapper.Descriptor().Fields().ByName("value"), v)
+			v = protoreflect.ValueOfMessage(wrapper)
+		}
+		dst.Set(f.req, v)
+	}
+}
+
+// mergeSpec overwrite current with fields populated in desired, nested messages are merged
+// field by field, fields out of the set are left untouched. the merged object is a copy,
+// current is not modified
+func (fs specFieldSet) mergeSpec(current, desired proto.Message) proto.Message {
+	merged := proto.Clone(current)
+	dst := merged.ProtoReflect()
+
+	desired.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if _, ok := fs[fd.JSONName()]; ok {
+			mergeField(dst, fd, v)
+		}
+		return true
+	})
+
+	return merged
+}
+
+// mergeField set field of dst with v, populated fields of nested message are merged
+// recursively, lists and maps are replaced as a whole
+func mergeField(dst protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	if !isNestedMessage(fd) || !dst.Has(fd) {
+		dst.Set(fd, v)
+		return
+	}
+
+	nested := dst.Mutable(fd).Message()
+	v.Message().Range(func(nfd protoreflect.FieldDescriptor, nv protoreflect.Value) bool {
+		mergeField(nested, nfd, nv)
+		return true
+	})
+}
+
+// isNestedMessage singular message field which is merged and compared field by field,
+// google.protobuf well-known types are treated as values
+func isNestedMessage(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() &&
+		fd.Message().FullName().Parent() != "google.protobuf"
+}
+
+// diffSpec compare fields populated in desired with current, current can be nil when
+// resource will be created. nested messages are compared field by field and the diff
+// field is the json path. fields which are different but can't be applied by request
+// are returned as ignored
+func (fs specFieldSet) diffSpec(current, desired proto.Message) ([]*cmproto.SpecFieldDiff, []string) {
+	var (
+		diffs   = make([]*cmproto.SpecFieldDiff, 0)
+		ignored = make([]string, 0)
+		src     = desired.ProtoReflect()
+		cur     protoreflect.Message
+	)
+	if current != nil {
+		cur = current.ProtoReflect()
+	}
+
+	src.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		name := fd.JSONName()
+		if _, ok := specMetaFields[name]; ok {
+			return true
+		}
+
+		fieldDiffs := diffField(cur, src, fd, name)
+		if len(fieldDiffs) == 0 {
+			return true
+		}
+		if _, ok := fs[name]; !ok {
+			ignored = append(ignored, name)
+			return true
+		}
+		diffs = append(diffs, fieldDiffs...)
+		return true
+	})
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Field < diffs[j].Field
+	})
+	sort.Strings(ignored)
+
+	return diffs, ignored
+}
+
+// diffField compare field fd populated in desired with current, current can be nil
+func diffField(current, desired protoreflect.Message, fd protoreflect.FieldDescriptor,
+	path string) []*cmproto.SpecFieldDiff {
+	if isNestedMessage(fd) {
+		var nestedCurrent protoreflect.Message
+		if current != nil && current.Has(fd) {
+			nestedCurrent = current.Get(fd).Message()
+		}
+		nestedDesired := desired.Get(fd).Message()
+
+		diffs := make([]*cmproto.SpecFieldDiff, 0)
+		nestedDesired.Range(func(nfd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			diffs = append(diffs, diffField(nestedCurrent, nestedDesired, nfd, path+"."+nfd.JSONName())...)
+			return true
+		})
+		return diffs
+	}
+
+	newValue := singleFieldMessage(desired, fd)
+	oldValue := desired.New().Interface()
+	if current != nil {
+		oldValue = singleFieldMessage(current, fd)
+	}
+	if proto.Equal(oldValue, newValue) {
+		return nil
+	}
+
+	return []*cmproto.SpecFieldDiff{{
+		Field: path,
+		Old:   formatFieldValue(oldValue, fd.JSONName()),
+		New:   formatFieldValue(newValue, fd.JSONName()),
+	}}
+}
+
+// singleFieldMessage copy of message which only has field fd
+func singleFieldMessage(m protoreflect.Message, fd protoreflect.FieldDescriptor) proto.Message {
+	out := m.New()
+	if m.Has(fd) {
+		out.Set(fd, m.Get(fd))
+	}
+	return out.Interface()
+}
+
+// formatFieldValue render field value in json, empty string when field not set
+func formatFieldValue(m proto.Message, name string) string {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	values := make(map[string]json.RawMessage)
+	if err = json.Unmarshal(data, &values); err != nil {
+		return ""
+	}
+	value, ok := values[name]
+	if !ok {
+		return ""
+	}
+
+	// protojson output is unstable by design, compact it for readable diff
+	out, err := json.Marshal(value)
+	if err != nil {
+		return string(value)
+	}
+	return string(out)
+}
@@ -0,0 +1,349 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+func clusterField(name string) protoreflect.FieldDescriptor {
+	return (&cmproto.Cluster{}).ProtoReflect().Descriptor().Fields().ByJSONName(name)
+}
+
+func updateClusterField(name string) protoreflect.FieldDescriptor {
+	return (&cmproto.UpdateClusterReq{}).ProtoReflect().Descriptor().Fields().ByJSONName(name)
+}
+
+func TestSameFieldType(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b protoreflect.FieldDescriptor
+		want bool
+	}{
+		{"string", clusterField("clusterName"), updateClusterField("clusterName"), true},
+		{"same message", clusterField("networkSettings"), updateClusterField("networkSettings"), true},
+		{"same map", clusterField("labels"), updateClusterField("labels"), true},
+		{"map and list", clusterField("master"), updateClusterField("master"), false},
+		{"scalar and wrapper", clusterField("isExclusive"), updateClusterField("isExclusive"), false},
+		{"different kind", clusterField("isExclusive"), clusterField("clusterName"), false},
+		{"different message", clusterField("networkSettings"), clusterField("clusterBasicSettings"), false},
+		{"different map value", clusterField("labels"), clusterField("bcsAddons"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameFieldType(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameFieldType(%s, %s) = %v, want %v", tt.a.FullName(), tt.b.FullName(), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsWrapperOf(t *testing.T) {
+	tests := []struct {
+		name            string
+		wrapper, scalar protoreflect.FieldDescriptor
+		want            bool
+	}{
+		{"bool wrapper", updateClusterField("isExclusive"), clusterField("isExclusive"), true},
+		{"string wrapper", updateClusterField("description"), clusterField("description"), true},
+		{"wrapper kind mismatch", updateClusterField("isExclusive"), clusterField("description"), false},
+		{"not wrapper message", updateClusterField("networkSettings"), clusterField("networkSettings"), false},
+		{"scalar", updateClusterField("clusterName"), clusterField("clusterName"), false},
+		{"list", updateClusterField("master"), clusterField("master"), false},
+		{"map", clusterField("labels"), clusterField("labels"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isWrapperOf(tt.wrapper, tt.scalar); got != tt.want {
+				t.Errorf("isWrapperOf(%s, %s) = %v, want %v",
+					tt.wrapper.FullName(), tt.scalar.FullName(), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewSpecFieldSet(t *testing.T) {
+	tests := []struct {
+		field   string
+		exist   bool
+		wrapped bool
+	}{
+		{field: "clusterName", exist: true},
+		{field: "labels", exist: true},
+		{field: "isExclusive", exist: true, wrapped: true},
+		{field: "description", exist: true, wrapped: true},
+		// list in request but map in resource
+		{field: "master", exist: false},
+		// maintained by cluster-manager
+		{field: "clusterID", exist: false},
+		{field: "status", exist: false},
+	}
+	for _, tt := range tests {
+		f, ok := clusterSpecFields[tt.field]
+		if ok != tt.exist || f.wrapped != tt.wrapped {
+			t.Errorf("field %s exist = %v, wrapped = %v, want %v, %v", tt.field, ok, f.wrapped, tt.exist, tt.wrapped)
+		}
+	}
+}
+
+func TestDiffSpec(t *testing.T) {
+	current := &cmproto.Cluster{
+		ClusterID:   "BCS-K8S-00001",
+		ClusterName: "old",
+		Region:      "ap-guangzhou",
+		Labels:      map[string]string{"env": "test"},
+		Status:      "RUNNING",
+	}
+	withNetwork := &cmproto.Cluster{
+		ClusterName: "old",
+		NetworkSettings: &cmproto.NetworkSetting{
+			ClusterIPv4CIDR: "10.0.0.0/16",
+			ServiceIPv4CIDR: "10.1.0.0/16",
+			MaxNodePodNum:   64,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		current     *cmproto.Cluster
+		desired     *cmproto.Cluster
+		wantDiffs   []*cmproto.SpecFieldDiff
+		wantIgnored []string
+	}{
+		{
+			name:    "no change",
+			current: current,
+			desired: &cmproto.Cluster{ClusterName: "old", Region: "ap-guangzhou"},
+		},
+		{
+			name:    "meta fields are skipped",
+			current: current,
+			desired: &cmproto.Cluster{ClusterID: "BCS-K8S-00002", Status: "DELETING", Creator: "admin"},
+		},
+		{
+			name:    "scalar and map",
+			current: current,
+			desired: &cmproto.Cluster{ClusterName: "new", Labels: map[string]string{"env": "prod"}},
+			wantDiffs: []*cmproto.SpecFieldDiff{
+				{Field: "clusterName", Old: `"old"`, New: `"new"`},
+				{Field: "labels", Old: `{"env":"test"}`, New: `{"env":"prod"}`},
+			},
+		},
+		{
+			name:    "wrapped field",
+			current: current,
+			desired: &cmproto.Cluster{IsExclusive: true, Description: "desc"},
+			wantDiffs: []*cmproto.SpecFieldDiff{
+				{Field: "description", Old: "", New: `"desc"`},
+				{Field: "isExclusive", Old: "", New: "true"},
+			},
+		},
+		{
+			name:    "nil message field in current",
+			current: current,
+			desired: &cmproto.Cluster{NetworkSettings: &cmproto.NetworkSetting{ClusterIPv4CIDR: "10.0.0.0/16"}},
+			wantDiffs: []*cmproto.SpecFieldDiff{
+				{Field: "networkSettings.clusterIPv4CIDR", Old: "", New: `"10.0.0.0/16"`},
+			},
+		},
+		{
+			name:    "partial nested spec unchanged",
+			current: withNetwork,
+			desired: &cmproto.Cluster{NetworkSettings: &cmproto.NetworkSetting{ClusterIPv4CIDR: "10.0.0.0/16"}},
+		},
+		{
+			name:    "partial nested spec changed",
+			current: withNetwork,
+			desired: &cmproto.Cluster{NetworkSettings: &cmproto.NetworkSetting{
+				ClusterIPv4CIDR: "10.0.0.0/16",
+				MaxNodePodNum:   128,
+			}},
+			wantDiffs: []*cmproto.SpecFieldDiff{
+				{Field: "networkSettings.maxNodePodNum", Old: "64", New: "128"},
+			},
+		},
+		{
+			name:        "field can not be applied",
+			current:     current,
+			desired:     &cmproto.Cluster{Master: map[string]*cmproto.Node{"10.0.0.1": {InnerIP: "10.0.0.1"}}},
+			wantIgnored: []string{"master"},
+		},
+		{
+			name:    "nil current when create",
+			current: nil,
+			desired: &cmproto.Cluster{ClusterID: "BCS-K8S-00002", ClusterName: "new",
+				Master: map[string]*cmproto.Node{"10.0.0.1": {InnerIP: "10.0.0.1"}}},
+			wantDiffs:   []*cmproto.SpecFieldDiff{{Field: "clusterName", Old: "", New: `"new"`}},
+			wantIgnored: []string{"master"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cur proto.Message
+			if tt.current != nil {
+				cur = tt.current
+			}
+			diffs, ignored := clusterSpecFields.diffSpec(cur, tt.desired)
+			if len(diffs) != len(tt.wantDiffs) {
+				t.Fatalf("diffSpec() diffs = %v, want %v", diffs, tt.wantDiffs)
+			}
+			for i := range diffs {
+				if !proto.Equal(diffs[i], tt.wantDiffs[i]) {
+					t.Errorf("diffSpec() diffs[%d] = %v, want %v", i, diffs[i], tt.wantDiffs[i])
+				}
+			}
+			if len(ignored) != 0 || len(tt.wantIgnored) != 0 {
+				if !reflect.DeepEqual(ignored, tt.wantIgnored) {
+					t.Errorf("diffSpec() ignored = %v, want %v", ignored, tt.wantIgnored)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeSpec(t *testing.T) {
+	current := &cmproto.Cluster{
+		ClusterID:   "BCS-K8S-00001",
+		ClusterName: "old",
+		Region:      "ap-guangzhou",
+		Labels:      map[string]string{"env": "test", "team": "bcs"},
+		Master:      map[string]*cmproto.Node{"10.0.0.1": {InnerIP: "10.0.0.1"}},
+		NetworkSettings: &cmproto.NetworkSetting{
+			ClusterIPv4CIDR: "10.0.0.0/16",
+			ServiceIPv4CIDR: "10.1.0.0/16",
+		},
+		Status: "RUNNING",
+	}
+	origin := proto.Clone(current)
+	desired := &cmproto.Cluster{
+		ClusterID:       "BCS-K8S-00002",
+		ClusterName:     "new",
+		IsExclusive:     true,
+		Labels:          map[string]string{"env": "prod"},
+		Master:          map[string]*cmproto.Node{"10.0.0.2": {InnerIP: "10.0.0.2"}},
+		NetworkSettings: &cmproto.NetworkSetting{ClusterIPv4CIDR: "10.2.0.0/16"},
+		Status:          "DELETING",
+	}
+
+	merged := clusterSpecFields.mergeSpec(current, desired).(*cmproto.Cluster)
+	if !proto.Equal(current, origin) {
+		t.Fatalf("mergeSpec() modified current: %v", current)
+	}
+
+	// populated fields in set are overwritten, maps are replaced as a whole
+	if merged.ClusterName != "new" || !merged.IsExclusive {
+		t.Errorf("merged clusterName = %s, isExclusive = %v", merged.ClusterName, merged.IsExclusive)
+	}
+	if !reflect.DeepEqual(merged.Labels, map[string]string{"env": "prod"}) {
+		t.Errorf("merged labels = %v", merged.Labels)
+	}
+	// nested messages are merged field by field
+	if merged.NetworkSettings.GetClusterIPv4CIDR() != "10.2.0.0/16" ||
+		merged.NetworkSettings.GetServiceIPv4CIDR() != "10.1.0.0/16" {
+		t.Errorf("merged networkSettings = %v", merged.NetworkSettings)
+	}
+	// fields not populated in desired are kept
+	if merged.Region != "ap-guangzhou" {
+		t.Errorf("merged region = %s", merged.Region)
+	}
+	// fields out of the set are kept
+	if merged.ClusterID != "BCS-K8S-00001" || merged.Status != "RUNNING" {
+		t.Errorf("merged clusterID = %s, status = %s", merged.ClusterID, merged.Status)
+	}
+	if _, ok := merged.Master["10.0.0.1"]; !ok || len(merged.Master) != 1 {
+		t.Errorf("merged master = %v", merged.Master)
+	}
+
+	// wrapped fields are converted when build request
+	req := &cmproto.UpdateClusterReq{}
+	clusterSpecFields.buildRequest(merged, req)
+	if req.ClusterName != "new" || req.GetIsExclusive() == nil || !req.GetIsExclusive().GetValue() {
+		t.Errorf("request clusterName = %s, isExclusive = %v", req.ClusterName, req.GetIsExclusive())
+	}
+	// unset scalar is not sent, wrapper keeps nil
+	if req.Description != nil || req.ClusterID != "" {
+		t.Errorf("request description = %v, clusterID = %s", req.Description, req.ClusterID)
+	}
+	if req.NetworkSettings.GetServiceIPv4CIDR() != "10.1.0.0/16" {
+		t.Errorf("request networkSettings = %v", req.NetworkSettings)
+	}
+}
+
+func TestNodeGroupPartialSpec(t *testing.T) {
+	current := &cmproto.NodeGroup{
+		NodeGroupID: "BCSNG-00001",
+		Name:        "group",
+		AutoScaling: &cmproto.AutoScalingGroup{
+			MinSize:     1,
+			MaxSize:     5,
+			DesiredSize: 3,
+			VpcID:       "vpc-1",
+			SubnetIDs:   []string{"subnet-1", "subnet-2"},
+		},
+		LaunchTemplate: &cmproto.LaunchConfiguration{InstanceType: "S5.LARGE8", CPU: 4, Mem: 8},
+	}
+	origin := proto.Clone(current)
+
+	// partial nested spec with the same values is unchanged
+	diffs, ignored := nodeGroupUpdateFields.diffSpec(current, &cmproto.NodeGroup{
+		AutoScaling:    &cmproto.AutoScalingGroup{MaxSize: 5},
+		LaunchTemplate: &cmproto.LaunchConfiguration{InstanceType: "S5.LARGE8"},
+	})
+	if len(diffs) != 0 || len(ignored) != 0 {
+		t.Fatalf("diffSpec() unchanged spec diffs = %v, ignored = %v", diffs, ignored)
+	}
+
+	desired := &cmproto.NodeGroup{
+		AutoScaling: &cmproto.AutoScalingGroup{MaxSize: 10, SubnetIDs: []string{"subnet-3"}},
+	}
+	diffs, _ = nodeGroupUpdateFields.diffSpec(current, desired)
+	wantDiffs := []*cmproto.SpecFieldDiff{
+		{Field: "autoScaling.maxSize", Old: "5", New: "10"},
+		{Field: "autoScaling.subnetIDs", Old: `["subnet-1","subnet-2"]`, New: `["subnet-3"]`},
+	}
+	if len(diffs) != len(wantDiffs) {
+		t.Fatalf("diffSpec() diffs = %v, want %v", diffs, wantDiffs)
+	}
+	for i := range diffs {
+		if !proto.Equal(diffs[i], wantDiffs[i]) {
+			t.Errorf("diffSpec() diffs[%d] = %v, want %v", i, diffs[i], wantDiffs[i])
+		}
+	}
+
+	merged := nodeGroupUpdateFields.mergeSpec(current, desired).(*cmproto.NodeGroup)
+	if !proto.Equal(current, origin) {
+		t.Fatalf("mergeSpec() modified current: %v", current)
+	}
+	req := &cmproto.UpdateNodeGroupRequest{}
+	nodeGroupUpdateFields.buildRequest(merged, req)
+
+	want := &cmproto.AutoScalingGroup{
+		MinSize:     1,
+		MaxSize:     10,
+		DesiredSize: 3,
+		VpcID:       "vpc-1",
+		SubnetIDs:   []string{"subnet-3"},
+	}
+	if !proto.Equal(req.AutoScaling, want) {
+		t.Errorf("request autoScaling = %v, want %v", req.AutoScaling, want)
+	}
+	if !proto.Equal(req.LaunchTemplate, current.LaunchTemplate) {
+		t.Errorf("request launchTemplate = %v, want %v", req.LaunchTemplate, current.LaunchTemplate)
+	}
+}
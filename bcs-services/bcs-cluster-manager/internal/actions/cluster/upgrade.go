@@ -0,0 +1,215 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/taskserver"
+)
+
+// UpgradeClusterVersionAction action for upgrade cluster kubernetes version
+type UpgradeClusterVersionAction struct {
+	ctx   context.Context
+	model store.ClusterManagerModel
+
+	req  *cmproto.UpgradeClusterVersionReq
+	resp *cmproto.UpgradeClusterVersionResp
+
+	cluster    *cmproto.Cluster
+	cloud      *cmproto.Cloud
+	nodeGroups []*cmproto.NodeGroup
+	task       *cmproto.Task
+}
+
+// NewUpgradeClusterVersionAction create upgrade cluster version action
+func NewUpgradeClusterVersionAction(model store.ClusterManagerModel) *UpgradeClusterVersionAction {
+	return &UpgradeClusterVersionAction{
+		model: model,
+	}
+}
+
+func (ua *UpgradeClusterVersionAction) setResp(code uint32, msg string) {
+	ua.resp.Code = code
+	ua.resp.Message = msg
+	ua.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+	ua.resp.Task = ua.task
+}
+
+func (ua *UpgradeClusterVersionAction) validate() error {
+	return ua.req.Validate()
+}
+
+func (ua *UpgradeClusterVersionAction) getRelativeData() error {
+	cloud, cluster, err := actions.GetCloudAndCluster(ua.model, "", ua.req.GetClusterID())
+	if err != nil {
+		return err
+	}
+	ua.cluster = cluster
+	ua.cloud = cloud
+
+	if !ua.req.GetUpgradeNodeGroups() {
+		return nil
+	}
+
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		"clusterid": ua.req.GetClusterID(),
+	})
+	groups, err := ua.model.ListNodeGroup(ua.ctx, cond, &storeopt.ListOption{})
+	if err != nil {
+		return err
+	}
+	for i := range groups {
+		if groups[i].Status == common.StatusDeleting {
+			continue
+		}
+		ua.nodeGroups = append(ua.nodeGroups, groups[i])
+	}
+
+	return nil
+}
+
+func (ua *UpgradeClusterVersionAction) checkClusterStatus() error {
+	switch ua.cluster.Status {
+	case common.StatusRunning, common.StatusUpgradeClusterFailed:
+		return nil
+	default:
+		return fmt.Errorf("cluster %s status %s not allow to upgrade", ua.cluster.ClusterID, ua.cluster.Status)
+	}
+}
+
+func (ua *UpgradeClusterVersionAction) upgradeClusterVersionTask() error {
+	provider, err := cloudprovider.GetClusterMgr(ua.cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cluster %s relative cloud provider %s failed, %s",
+			ua.req.ClusterID, ua.cloud.CloudProvider, err.Error())
+		return err
+	}
+
+	cmOption, err := cloudprovider.GetCredential(&cloudprovider.CredentialData{
+		Cloud:     ua.cloud,
+		AccountID: ua.cluster.CloudAccountID,
+	})
+	if err != nil {
+		blog.Errorf("get credential for cloudprovider %s/%s upgradeClusterVersion failed, %s",
+			ua.cloud.CloudID, ua.cloud.CloudProvider, err.Error())
+		return err
+	}
+	cmOption.Region = ua.cluster.Region
+
+	// upgrade cluster version task by task manager
+	task, err := provider.UpgradeClusterVersion(ua.cluster, ua.req.GetVersion(),
+		&cloudprovider.UpgradeClusterVersionOption{
+			CommonOption: *cmOption,
+			Cloud:        ua.cloud,
+			Operator:     ua.req.GetOperator(),
+			SkipPreCheck: ua.req.GetSkipPreCheck(),
+			NodeGroups:   ua.nodeGroups,
+			Strategy:     ua.req.GetStrategy(),
+		})
+	if err != nil {
+		blog.Errorf("upgrade cluster version %s by Cloud %s with provider %s failed, %s",
+			ua.cluster.ClusterID, ua.cloud.CloudID, ua.cloud.CloudProvider, err.Error())
+		return err
+	}
+
+	// create task and dispatch task
+	if err = ua.model.CreateTask(ua.ctx, task); err != nil {
+		blog.Errorf("save upgrade cluster version task for cluster %s failed, %s",
+			ua.cluster.ClusterID, err.Error(),
+		)
+		return err
+	}
+	// update cluster status before dispatch, task steps update the status after finished
+	previousStatus := ua.cluster.Status
+	ua.cluster.Status = common.StatusUpgrading
+	if err = ua.model.UpdateCluster(ua.ctx, ua.cluster); err != nil {
+		blog.Errorf("update cluster %s to status UPGRADING failed, %s", ua.cluster.ClusterID, err.Error())
+		return err
+	}
+	if err = taskserver.GetTaskServer().Dispatch(task); err != nil {
+		blog.Errorf("dispatch upgrade cluster version task for cluster %s failed, %s",
+			ua.cluster.ClusterID, err.Error(),
+		)
+		// restore cluster status, otherwise the UPGRADING status blocks retry
+		ua.cluster.Status = previousStatus
+		if uerr := ua.model.UpdateCluster(ua.ctx, ua.cluster); uerr != nil {
+			blog.Errorf("restore cluster %s status to %s failed, %s",
+				ua.cluster.ClusterID, previousStatus, uerr.Error())
+		}
+		return err
+	}
+
+	ua.task = task
+	return nil
+}
+
+// Handle upgrade cluster version action
+func (ua *UpgradeClusterVersionAction) Handle(ctx context.Context,
+	req *cmproto.UpgradeClusterVersionReq, resp *cmproto.UpgradeClusterVersionResp) {
+	if req == nil || resp == nil {
+		blog.Errorf("UpgradeClusterVersion failed, req or resp is empty")
+		return
+	}
+	ua.ctx = ctx
+	ua.req = req
+	ua.resp = resp
+
+	if err := ua.validate(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err := ua.getRelativeData(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+	if err := ua.checkClusterStatus(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+
+	if err := ua.upgradeClusterVersionTask(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerCloudProviderErr, err.Error())
+		return
+	}
+
+	// build operationLog
+	err := ua.model.CreateOperationLog(ctx, &cmproto.OperationLog{
+		ResourceType: common.Cluster.String(),
+		ResourceID:   ua.cluster.ClusterID,
+		TaskID:       ua.task.TaskID,
+		Message: fmt.Sprintf("集群[%s]升级版本至%s", ua.cluster.ClusterID,
+			ua.req.GetVersion()),
+		OpUser:       ua.req.GetOperator(),
+		CreateTime:   time.Now().Format(time.RFC3339),
+		ClusterID:    ua.cluster.ClusterID,
+		ProjectID:    ua.cluster.ProjectID,
+		ResourceName: ua.cluster.GetClusterName(),
+	})
+	if err != nil {
+		blog.Errorf("UpgradeClusterVersion[%s] CreateOperationLog failed: %v", ua.req.ClusterID, err)
+	}
+
+	ua.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+}
@@ -101,6 +101,7 @@ func (ca *CreateAction) constructNodeGroup() *cmproto.NodeGroup {
 		Provider:        ca.req.Provider,
 		Status:          common.StatusCreateNodeGroupCreating,
 		ConsumerID:      ca.req.ConsumerID,
+		AutoRepair:      ca.req.AutoRepair,
 		Creator:         ca.req.Creator,
 		CreateTime:      timeStr,
 		UpdateTime:      timeStr,
@@ -626,6 +626,8 @@ func (da *CleanNodesAction) handleTask() error {
 		da.setResp(common.BcsErrClusterManagerCloudProviderErr, err.Error())
 		return err
 	}
+	// task creator is nodeGroup creator, record request operator for filtering tasks by operator
+	setTaskOperator(task, da.req.Operator)
 
 	if err = da.model.CreateTask(da.ctx, task); err != nil {
 		blog.Errorf("save clean Node %v task from NodeGroup %s failed, %s",
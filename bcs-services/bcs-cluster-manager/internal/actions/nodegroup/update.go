@@ -107,6 +107,9 @@ func (ua *UpdateAction) modifyNodeGroupField() {
 	if len(ua.req.GetExtraInfo()) > 0 {
 		group.ExtraInfo = ua.req.GetExtraInfo()
 	}
+	if ua.req.AutoRepair != nil {
+		group.AutoRepair = ua.req.AutoRepair
+	}
 
 	// autoscaling
 	ua.modifyNodeGroupAutoScaling(group)
@@ -847,6 +850,9 @@ func (ua *UpdateDesiredNodeAction) handleTask(scaling uint32) error {
 		)
 		return err
 	}
+	// task creator is nodeGroup creator, record request operator for filtering tasks by operator
+	setTaskOperator(task, ua.req.Operator)
+
 	if err = ua.model.CreateTask(ua.ctx, task); err != nil {
 		blog.Errorf("save scaling task for NodeGroup %s failed, %s",
 			ua.group.NodeGroupID, err.Error(),
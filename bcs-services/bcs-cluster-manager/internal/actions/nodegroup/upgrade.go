@@ -0,0 +1,191 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/taskserver"
+)
+
+// UpgradeNodeGroupVersionAction rolling replace nodegroup nodes to cluster version
+type UpgradeNodeGroupVersionAction struct {
+	ctx     context.Context
+	model   store.ClusterManagerModel
+	cluster *cmproto.Cluster
+	group   *cmproto.NodeGroup
+	cloud   *cmproto.Cloud
+	task    *cmproto.Task
+	req     *cmproto.UpgradeNodeGroupVersionReq
+	resp    *cmproto.UpgradeNodeGroupVersionResp
+}
+
+// NewUpgradeNodeGroupVersionAction create upgrade nodegroup version action
+func NewUpgradeNodeGroupVersionAction(model store.ClusterManagerModel) *UpgradeNodeGroupVersionAction {
+	return &UpgradeNodeGroupVersionAction{
+		model: model,
+	}
+}
+
+func (ua *UpgradeNodeGroupVersionAction) setResp(code uint32, msg string) {
+	ua.resp.Code = code
+	ua.resp.Message = msg
+	ua.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+	ua.resp.Task = ua.task
+}
+
+func (ua *UpgradeNodeGroupVersionAction) validate() error {
+	return ua.req.Validate()
+}
+
+func (ua *UpgradeNodeGroupVersionAction) getRelativeResource() error {
+	group, err := ua.model.GetNodeGroup(ua.ctx, ua.req.NodeGroupID)
+	if err != nil {
+		blog.Errorf("find nodegroup %s failed when upgrade nodegroup version, err %s", ua.req.NodeGroupID, err.Error())
+		return err
+	}
+	ua.group = group
+
+	cloud, cluster, err := actions.GetCloudAndCluster(ua.model, group.Provider, group.ClusterID)
+	if err != nil {
+		blog.Errorf("can not get relative Cloud %s or Cluster %s when upgrade nodegroup %s version, %s",
+			group.Provider, group.ClusterID, ua.req.NodeGroupID, err.Error(),
+		)
+		return err
+	}
+	ua.cloud = cloud
+	ua.cluster = cluster
+
+	return nil
+}
+
+func (ua *UpgradeNodeGroupVersionAction) upgradeNodeGroupVersion() error {
+	mgr, err := cloudprovider.GetNodeGroupMgr(ua.cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get NodeGroup Manager cloudprovider %s/%s for upgrade nodegroup version in Cluster %s failed, %s",
+			ua.cloud.CloudID, ua.cloud.CloudProvider, ua.cluster.ClusterID, err.Error(),
+		)
+		return err
+	}
+	cmOption, err := cloudprovider.GetCredential(&cloudprovider.CredentialData{
+		Cloud:     ua.cloud,
+		AccountID: ua.cluster.CloudAccountID,
+	})
+	if err != nil {
+		blog.Errorf("get Credential for Cloud %s/%s when upgrade nodegroup version in cluster %s failed, %s",
+			ua.cloud.CloudID, ua.cloud.CloudProvider, ua.cluster.ClusterID, err.Error(),
+		)
+		return err
+	}
+	cmOption.Region = ua.group.Region
+
+	// cloud provider nodeGroup
+	task, err := mgr.UpgradeNodeGroupVersion(ua.group, &cloudprovider.UpgradeNodeGroupVersionOption{
+		CommonOption: *cmOption,
+		Cloud:        ua.cloud,
+		Cluster:      ua.cluster,
+		Operator:     ua.req.GetOperator(),
+		Strategy:     ua.req.GetStrategy(),
+	})
+	if err != nil {
+		blog.Errorf("upgrade nodegroup version in cloudprovider %s/%s for group %s failed, %s",
+			ua.cloud.CloudID, ua.cloud.CloudProvider, ua.group.NodeGroupID, err.Error())
+		return err
+	}
+
+	// create task and dispatch task
+	if err = ua.model.CreateTask(ua.ctx, task); err != nil {
+		blog.Errorf("save upgrade nodegroup version task for nodegroup %s failed, %s",
+			ua.group.NodeGroupID, err.Error(),
+		)
+		return err
+	}
+	// set nodegroup to updating before dispatch, task steps update the status after finished
+	ua.group.Status = common.StatusNodeGroupUpdating
+	ua.group.Updater = ua.req.GetOperator()
+	if err = ua.model.UpdateNodeGroup(ua.ctx, ua.group); err != nil {
+		blog.Errorf("update nodegroup %s status to updating failed, err %s", ua.group.NodeGroupID, err.Error())
+		return err
+	}
+	if err = taskserver.GetTaskServer().Dispatch(task); err != nil {
+		blog.Errorf("dispatch upgrade nodegroup version task for nodegroup %s failed, %s",
+			ua.group.NodeGroupID, err.Error(),
+		)
+		return err
+	}
+	ua.task = task
+
+	err = ua.model.CreateOperationLog(ua.ctx, &cmproto.OperationLog{
+		ResourceType: common.NodeGroup.String(),
+		ResourceID:   ua.group.NodeGroupID,
+		TaskID:       task.TaskID,
+		Message: fmt.Sprintf("节点池[%s]升级节点版本至%s", ua.group.NodeGroupID,
+			ua.cluster.GetClusterBasicSettings().GetVersion()),
+		OpUser:       ua.req.GetOperator(),
+		CreateTime:   time.Now().Format(time.RFC3339),
+		ClusterID:    ua.cluster.ClusterID,
+		ProjectID:    ua.cluster.ProjectID,
+		ResourceName: ua.group.GetName(),
+	})
+	if err != nil {
+		blog.Errorf("UpgradeNodeGroupVersion[%s] CreateOperationLog failed: %v", ua.group.NodeGroupID, err)
+	}
+	return nil
+}
+
+// Handle handle upgrade nodegroup version
+func (ua *UpgradeNodeGroupVersionAction) Handle(
+	ctx context.Context, req *cmproto.UpgradeNodeGroupVersionReq, resp *cmproto.UpgradeNodeGroupVersionResp) {
+	if req == nil || resp == nil {
+		blog.Errorf("UpgradeNodeGroupVersion failed, req or resp is empty")
+		return
+	}
+	ua.ctx = ctx
+	ua.req = req
+	ua.resp = resp
+
+	if err := ua.validate(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+
+	// getRelativeResource get cluster / cloud provider
+	if err := ua.getRelativeResource(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	// if nodegroup is updating, return error
+	if ua.group.Status == common.StatusNodeGroupUpdating {
+		blog.Errorf("nodegroup %s status is updating, can not upgrade version", ua.group.Name)
+		ua.setResp(common.BcsErrClusterManagerInvalidParameter,
+			fmt.Sprintf("nodegroup %s is updating", ua.group.NodeGroupID))
+		return
+	}
+
+	if err := ua.upgradeNodeGroupVersion(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerCloudProviderErr, err.Error())
+		return
+	}
+
+	ua.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+}
@@ -82,6 +82,14 @@ func virtualNodeID() string {
 	return "bcs-" + utils.RandomHexString(8)
 }
 
+// setTaskOperator record request operator in task commonParams
+func setTaskOperator(task *cmproto.Task, opUser string) {
+	if task.CommonParams == nil {
+		task.CommonParams = make(map[string]string)
+	}
+	task.CommonParams[cloudprovider.OperatorKey.String()] = opUser
+}
+
 func checkNodeGroupResourceValidate(ctx context.Context, provider string, nodeGroup *cmproto.NodeGroup,
 	operation string, scaleUpResource uint32) error {
 	ngr, err := cloudprovider.GetNodeGroupMgr(provider)
@@ -670,6 +670,8 @@ func (cm *ClusterManager) initDaemon() {
 		EnableAllocateCidrDaemon: cm.opt.Daemon.EnableAllocateCidr,
 		EnableInsTypeUsage:       cm.opt.Daemon.EnableInsTypeUsage,
 		EnableAzureTaint:         cm.opt.Daemon.EnableAzureTaint,
+		EnableAutoRepair:         cm.opt.Daemon.EnableAutoRepair,
+		AutoRepairLimit:          cm.opt.Daemon.AutoRepairLimit,
 	})
 }
 
@@ -94,6 +94,8 @@ var ActionPermissions = map[string]string{
 	"ClusterManager.AddSubnetToCluster":           cluster.CanManageClusterOperation,
 	"ClusterManager.UpdateClusterModule":          cluster.CanManageClusterOperation,
 	"ClusterManager.SwitchClusterUnderlayNetwork": cluster.CanManageClusterOperation,
+	"ClusterManager.UpgradeClusterVersion":        cluster.CanManageClusterOperation,
+	"ClusterManager.ApplyClusterSpec":             cluster.CanManageClusterOperation,
 	"ClusterManager.GetClusterSharedProject":      cluster.CanViewClusterOperation,
 	"ClusterManager.GetClustersMetaData":          "",
 
@@ -152,6 +154,7 @@ var ActionPermissions = map[string]string{
 	"ClusterManager.UpdateGroupMinMaxSize":          cluster.CanManageClusterOperation,
 	"ClusterManager.EnableNodeGroupAutoScale":       cluster.CanManageClusterOperation,
 	"ClusterManager.DisableNodeGroupAutoScale":      cluster.CanManageClusterOperation,
+	"ClusterManager.UpgradeNodeGroupVersion":        cluster.CanManageClusterOperation,
 	"ClusterManager.GetExternalNodeScriptByGroupID": cluster.CanViewClusterOperation,
 	"ClusterManager.TransNodeGroupToNodeTemplate":   cluster.CanManageClusterOperation,
 	"ClusterManager.CleanDbHistoryData":             "", // health类，无须权限
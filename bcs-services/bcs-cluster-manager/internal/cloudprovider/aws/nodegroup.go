@@ -366,6 +366,12 @@ func (ng *NodeGroup) SwitchNodeGroupAutoScaling(group *proto.NodeGroup, enable b
 	return task, nil
 }
 
+// UpgradeNodeGroupVersion rolling replace nodegroup nodes
+func (ng *NodeGroup) UpgradeNodeGroupVersion(group *proto.NodeGroup,
+	opt *cloudprovider.UpgradeNodeGroupVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // CreateAutoScalingOption create cluster autoscaling option, cloudprovider will
 // deploy cluster-autoscaler in backgroup according cloudprovider implementation
 func (ng *NodeGroup) CreateAutoScalingOption(scalingOption *proto.ClusterAutoScalingOption,
@@ -867,6 +867,12 @@ func (t *Task) BuildUpdateNodeGroupTask(group *proto.NodeGroup, opt *cloudprovid
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
 
+// BuildUpgradeNodeGroupVersionTask rolling replace nodegroup nodes
+func (t *Task) BuildUpgradeNodeGroupVersionTask(group *proto.NodeGroup,
+	opt *cloudprovider.UpgradeNodeGroupVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // BuildAddExternalNodeToCluster add external nodes task
 func (t *Task) BuildAddExternalNodeToCluster(group *proto.NodeGroup, nodes []*proto.Node,
 	opt *cloudprovider.AddExternalNodesOption) (*proto.Task, error) {
@@ -884,3 +890,9 @@ func (t *Task) BuildSwitchClusterNetworkTask(cls *proto.Cluster,
 	subnet *proto.SubnetSource, opt *cloudprovider.SwitchClusterNetworkOption) (*proto.Task, error) {
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
+
+// BuildUpgradeClusterVersionTask upgrade cluster kubernetes version
+func (t *Task) BuildUpgradeClusterVersionTask(cls *proto.Cluster, version string,
+	opt *cloudprovider.UpgradeClusterVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
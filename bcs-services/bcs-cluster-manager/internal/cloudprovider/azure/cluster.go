@@ -332,3 +332,9 @@ func (c *Cluster) CheckHighAvailabilityMasterNodes(cls *proto.Cluster, nodes []*
 	opt *cloudprovider.CheckHaMasterNodesOption) error {
 	return nil
 }
+
+// UpgradeClusterVersion upgrade cluster kubernetes version
+func (c *Cluster) UpgradeClusterVersion(cls *proto.Cluster, version string,
+	opt *cloudprovider.UpgradeClusterVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
@@ -936,8 +936,20 @@ func (t *Task) BuildUpdateNodeGroupTask(group *proto.NodeGroup, opt *cloudprovid
 	return task, nil
 }
 
+// BuildUpgradeNodeGroupVersionTask rolling replace nodegroup nodes
+func (t *Task) BuildUpgradeNodeGroupVersionTask(group *proto.NodeGroup,
+	opt *cloudprovider.UpgradeNodeGroupVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // BuildSwitchClusterNetworkTask switch cluster network mode
 func (t *Task) BuildSwitchClusterNetworkTask(cls *proto.Cluster,
 	subnet *proto.SubnetSource, opt *cloudprovider.SwitchClusterNetworkOption) (*proto.Task, error) {
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
+
+// BuildUpgradeClusterVersionTask upgrade cluster kubernetes version
+func (t *Task) BuildUpgradeClusterVersionTask(cls *proto.Cluster, version string,
+	opt *cloudprovider.UpgradeClusterVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
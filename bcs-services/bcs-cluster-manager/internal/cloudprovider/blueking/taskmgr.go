@@ -1008,6 +1008,12 @@ func (t *Task) BuildUpdateNodeGroupTask(group *proto.NodeGroup, opt *cloudprovid
 	return task, nil
 }
 
+// BuildUpgradeNodeGroupVersionTask rolling replace nodegroup nodes
+func (t *Task) BuildUpgradeNodeGroupVersionTask(group *proto.NodeGroup,
+	opt *cloudprovider.UpgradeNodeGroupVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // BuildMoveNodesToGroupTask when create cluster, we need to create background task,
 func (t *Task) BuildMoveNodesToGroupTask(nodes []*proto.Node, group *proto.NodeGroup,
 	opt *cloudprovider.MoveNodesOption) (*proto.Task, error) {
@@ -1394,3 +1400,9 @@ func (t *Task) BuildSwitchClusterNetworkTask(cls *proto.Cluster,
 	subnet *proto.SubnetSource, opt *cloudprovider.SwitchClusterNetworkOption) (*proto.Task, error) {
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
+
+// BuildUpgradeClusterVersionTask upgrade cluster kubernetes version
+func (t *Task) BuildUpgradeClusterVersionTask(cls *proto.Cluster, version string,
+	opt *cloudprovider.UpgradeClusterVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
@@ -61,6 +61,8 @@ func RegisterCommonActions() map[string]interface{} {
 		cloudprovider.CheckNodePodsAction:                CheckNodePodsTask,
 		cloudprovider.NodeDrainPodAction:                 NodeDrainPodTask,
 		cloudprovider.InstallLogCollectorAddonAction:     EnsureLogCollectorTask,
+		cloudprovider.CheckClusterUpgradeAction:          CheckClusterUpgradeTask,
+		cloudprovider.UpdateClusterVersionAction:         UpdateClusterVersionTask,
 	}
 }
 
@@ -0,0 +1,523 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/clusterops"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/options"
+)
+
+var (
+	// CheckClusterUpgradeActionStep 集群升级前置检查
+	CheckClusterUpgradeActionStep = cloudprovider.StepInfo{
+		StepMethod: cloudprovider.CheckClusterUpgradeAction,
+		StepName:   "集群升级前置检查",
+	}
+	// UpdateClusterVersionActionStep 更新集群版本信息
+	UpdateClusterVersionActionStep = cloudprovider.StepInfo{
+		StepMethod: cloudprovider.UpdateClusterVersionAction,
+		StepName:   "更新集群版本信息",
+	}
+)
+
+const (
+	// deprecatedAPIMetric apiserver metric which records requested deprecated apis since apiserver started
+	deprecatedAPIMetric = "apiserver_requested_deprecated_apis"
+
+	defaultUpgradeMaxSurge     = 1
+	defaultUpgradeDrainTimeout = 600
+)
+
+var metricLabelRegex = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// BuildCheckClusterUpgradeTaskStep build cluster upgrade pre-check step
+func BuildCheckClusterUpgradeTaskStep(task *proto.Task, clusterID, version string) {
+	checkStep := cloudprovider.InitTaskStep(CheckClusterUpgradeActionStep)
+
+	checkStep.Params[cloudprovider.ClusterIDKey.String()] = clusterID
+	checkStep.Params[cloudprovider.ClusterVersionKey.String()] = version
+
+	task.Steps[CheckClusterUpgradeActionStep.StepMethod] = checkStep
+	task.StepSequence = append(task.StepSequence, CheckClusterUpgradeActionStep.StepMethod)
+}
+
+// BuildUpdateClusterVersionTaskStep build update cluster version step
+func BuildUpdateClusterVersionTaskStep(task *proto.Task, clusterID, version string) {
+	updateStep := cloudprovider.InitTaskStep(UpdateClusterVersionActionStep)
+
+	updateStep.Params[cloudprovider.ClusterIDKey.String()] = clusterID
+	updateStep.Params[cloudprovider.ClusterVersionKey.String()] = version
+
+	task.Steps[UpdateClusterVersionActionStep.StepMethod] = updateStep
+	task.StepSequence = append(task.StepSequence, UpdateClusterVersionActionStep.StepMethod)
+}
+
+// CheckClusterUpgradeTask check cluster version skew, deprecated api usage and addon compatibility
+func CheckClusterUpgradeTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CheckClusterUpgradeTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CheckClusterUpgradeTask[%s]: run step %s, system: %s, old state: %s, params %v",
+		taskID, stepName, step.System, step.Status, step.Params)
+
+	// extract parameter
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+	if len(clusterID) == 0 || len(version) == 0 {
+		retErr := fmt.Errorf("CheckClusterUpgradeTask[%s] validateParameter failed: clusterID or version empty", taskID)
+		blog.Errorf(retErr.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDAndStepNameForContext(context.Background(), taskID, stepName)
+	problems, err := checkClusterUpgrade(ctx, clusterID, version)
+	if err != nil {
+		blog.Errorf("CheckClusterUpgradeTask[%s] cluster[%s] failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("CheckClusterUpgradeTask err: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	if len(problems) > 0 {
+		for _, p := range problems {
+			cloudprovider.GetStorageModel().CreateTaskStepLogError(context.Background(), taskID, stepName, p)
+		}
+		retErr := fmt.Errorf("CheckClusterUpgradeTask cluster[%s] upgrade to %s blocked: %s",
+			clusterID, version, strings.Join(problems, "; "))
+		blog.Errorf("CheckClusterUpgradeTask[%s] %v", taskID, retErr)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		fmt.Sprintf("cluster upgrade to %s pre-check successful", version))
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("task %s %s update to storage fatal", taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// checkClusterUpgrade return the reasons which block cluster upgrading
+func checkClusterUpgrade(ctx context.Context, clusterID, version string) ([]string, error) {
+	taskID, stepName := cloudprovider.GetTaskIDAndStepNameFromContext(ctx)
+
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err = CheckVersionSkew(cluster.GetClusterBasicSettings().GetVersion(), version); err != nil {
+		return []string{err.Error()}, nil
+	}
+
+	k8sOperator := clusterops.NewK8SOperator(options.GetGlobalCMOptions(), cloudprovider.GetStorageModel())
+	client, err := k8sOperator.GetClusterClient(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	problems := make([]string, 0)
+
+	// apiserver metrics only record requests since apiserver started, unreachable metrics is not fatal
+	metrics, err := client.Discovery().RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		blog.Warnf("checkClusterUpgrade[%s] cluster[%s] get apiserver metrics failed: %v", taskID, clusterID, err)
+		cloudprovider.GetStorageModel().CreateTaskStepLogWarn(context.Background(), taskID, stepName,
+			fmt.Sprintf("get apiserver metrics failed, skip deprecated api check: %v", err))
+	} else {
+		apis, errParse := ParseDeprecatedAPIs(metrics, version)
+		if errParse != nil {
+			return nil, errParse
+		}
+		for _, api := range apis {
+			problems = append(problems, fmt.Sprintf("deprecated api %s is still requested and removed in %s",
+				api.String(), api.RemovedRelease))
+		}
+	}
+
+	addonProblems, err := checkAddonCompatibility(ctx, client, version,
+		options.GetGlobalCMOptions().Upgrade.AddonRules)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(problems, addonProblems...), nil
+}
+
+// checkAddonCompatibility check addon image versions with compatibility rules of target version
+func checkAddonCompatibility(ctx context.Context, client kubernetes.Interface, version string,
+	rules []options.AddonCompatibility) ([]string, error) {
+	major, minor, err := parseKubeMinorVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	minorVersion := fmt.Sprintf("%d.%d", major, minor)
+
+	problems := make([]string, 0)
+	for _, rule := range rules {
+		minVersion := rule.MinVersions[minorVersion]
+		if minVersion == "" {
+			continue
+		}
+
+		var spec *corev1.PodSpec
+		switch strings.ToLower(rule.Kind) {
+		case "daemonset":
+			ds, errGet := client.AppsV1().DaemonSets(rule.Namespace).Get(ctx, rule.Name, metav1.GetOptions{})
+			if errGet != nil && !k8serrors.IsNotFound(errGet) {
+				return nil, errGet
+			}
+			if errGet == nil {
+				spec = &ds.Spec.Template.Spec
+			}
+		default:
+			deploy, errGet := client.AppsV1().Deployments(rule.Namespace).Get(ctx, rule.Name, metav1.GetOptions{})
+			if errGet != nil && !k8serrors.IsNotFound(errGet) {
+				return nil, errGet
+			}
+			if errGet == nil {
+				spec = &deploy.Spec.Template.Spec
+			}
+		}
+		// addon not installed
+		if spec == nil {
+			continue
+		}
+
+		tag := addonImageTag(spec, rule.Container)
+		if tag == "" || CompareVersion(tag, minVersion) >= 0 {
+			continue
+		}
+		problems = append(problems, fmt.Sprintf("addon %s/%s version %s is lower than %s required by kubernetes %s",
+			rule.Namespace, rule.Name, tag, minVersion, minorVersion))
+	}
+
+	return problems, nil
+}
+
+// addonImageTag get image tag of specified container, the first container is used when name is empty
+func addonImageTag(spec *corev1.PodSpec, container string) string {
+	for i := range spec.Containers {
+		if container != "" && spec.Containers[i].Name != container {
+			continue
+		}
+		image := spec.Containers[i].Image
+		if idx := strings.Index(image, "@"); idx >= 0 {
+			image = image[:idx]
+		}
+		idx := strings.LastIndex(image, ":")
+		if idx < 0 || strings.Contains(image[idx:], "/") {
+			return ""
+		}
+		return image[idx+1:]
+	}
+
+	return ""
+}
+
+// DeprecatedAPI deprecated api which is still requested in cluster
+type DeprecatedAPI struct {
+	Group          string
+	Version        string
+	Resource       string
+	RemovedRelease string
+}
+
+// String xxx
+func (api DeprecatedAPI) String() string {
+	if api.Group == "" {
+		return fmt.Sprintf("%s/%s", api.Version, api.Resource)
+	}
+	return fmt.Sprintf("%s/%s/%s", api.Group, api.Version, api.Resource)
+}
+
+// ParseDeprecatedAPIs parse apiserver metrics and return requested apis which are removed in target version
+func ParseDeprecatedAPIs(metrics []byte, version string) ([]DeprecatedAPI, error) {
+	major, minor, err := parseKubeMinorVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	apis := make([]DeprecatedAPI, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(metrics))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, deprecatedAPIMetric+"{") {
+			continue
+		}
+		end := strings.LastIndex(line, "}")
+		if end < 0 || strings.TrimSpace(line[end+1:]) == "0" {
+			continue
+		}
+
+		labels := make(map[string]string)
+		for _, kv := range metricLabelRegex.FindAllStringSubmatch(line[:end], -1) {
+			labels[kv[1]] = kv[2]
+		}
+		removed := labels["removed_release"]
+		if removed == "" {
+			continue
+		}
+		rMajor, rMinor, errParse := parseKubeMinorVersion(removed)
+		if errParse != nil {
+			continue
+		}
+		if rMajor < major || (rMajor == major && rMinor <= minor) {
+			apis = append(apis, DeprecatedAPI{
+				Group:          labels["group"],
+				Version:        labels["version"],
+				Resource:       labels["resource"],
+				RemovedRelease: removed,
+			})
+		}
+	}
+
+	return apis, scanner.Err()
+}
+
+// CheckVersionSkew only allow to upgrade one minor version each time
+func CheckVersionSkew(current, target string) error {
+	tMajor, tMinor, err := parseKubeMinorVersion(target)
+	if err != nil {
+		return err
+	}
+	// cluster version unknown, depend on cloud pre-check
+	if current == "" {
+		return nil
+	}
+	cMajor, cMinor, err := parseKubeMinorVersion(current)
+	if err != nil {
+		return err
+	}
+
+	if CompareVersion(target, current) <= 0 {
+		return fmt.Errorf("target version %s must be newer than current version %s", target, current)
+	}
+	if tMajor != cMajor || tMinor > cMinor+1 {
+		return fmt.Errorf("target version %s skips minor version from current version %s", target, current)
+	}
+
+	return nil
+}
+
+// IsNodeVersionOutdated check kubelet version(e.g. v1.26.1-tke.3) is older than cluster version
+func IsNodeVersionOutdated(kubeletVersion, clusterVersion string) bool {
+	return CompareVersion(kubeletVersion, clusterVersion) < 0
+}
+
+// CompareVersion compare kubernetes style version, prefix v and suffix after - are ignored
+func CompareVersion(v1, v2 string) int {
+	parts1 := strings.Split(trimVersion(v1), ".")
+	parts2 := strings.Split(trimVersion(v2), ".")
+
+	for i := 0; i < len(parts1) || i < len(parts2); i++ {
+		var num1, num2 int
+		if i < len(parts1) {
+			num1, _ = strconv.Atoi(parts1[i])
+		}
+		if i < len(parts2) {
+			num2, _ = strconv.Atoi(parts2[i])
+		}
+
+		if num1 < num2 {
+			return -1
+		} else if num1 > num2 {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func trimVersion(version string) string {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if idx := strings.IndexAny(version, "-+"); idx >= 0 {
+		version = version[:idx]
+	}
+	return version
+}
+
+func parseKubeMinorVersion(version string) (int, int, error) {
+	parts := strings.Split(trimVersion(version), ".")
+	if len(parts) < 2 {
+		return 0, 0, fmt.Errorf("invalid kubernetes version %s", version)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid kubernetes version %s", version)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid kubernetes version %s", version)
+	}
+
+	return major, minor, nil
+}
+
+// UpdateClusterVersionTask update cluster version in storage after control plane upgraded
+func UpdateClusterVersionTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("UpdateClusterVersionTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("UpdateClusterVersionTask[%s]: run step %s, system: %s, old state: %s, params %v",
+		taskID, stepName, step.System, step.Status, step.Params)
+
+	// extract parameter
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+	if len(clusterID) == 0 || len(version) == 0 {
+		retErr := fmt.Errorf("UpdateClusterVersionTask[%s] validateParameter failed: clusterID or version empty", taskID)
+		blog.Errorf(retErr.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("UpdateClusterVersionTask[%s] GetCluster[%s] failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("UpdateClusterVersionTask GetCluster failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	if cluster.ClusterBasicSettings == nil {
+		cluster.ClusterBasicSettings = &proto.ClusterBasicSetting{}
+	}
+	cluster.ClusterBasicSettings.Version = version
+	if err = cloudprovider.GetStorageModel().UpdateCluster(context.Background(), cluster); err != nil {
+		blog.Errorf("UpdateClusterVersionTask[%s] UpdateCluster[%s] failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("UpdateClusterVersionTask UpdateCluster failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		fmt.Sprintf("update cluster version to %s successful", version))
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("task %s %s update to storage fatal", taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// MarshalUpgradeStrategy trans nodeGroup upgrade strategy to task param
+func MarshalUpgradeStrategy(strategy *proto.NodeGroupUpgradeStrategy) string {
+	if strategy == nil {
+		strategy = &proto.NodeGroupUpgradeStrategy{}
+	}
+	data, _ := json.Marshal(strategy)
+	return string(data)
+}
+
+// UnmarshalUpgradeStrategy parse nodeGroup upgrade strategy and fill default value
+func UnmarshalUpgradeStrategy(data string) *proto.NodeGroupUpgradeStrategy {
+	strategy := &proto.NodeGroupUpgradeStrategy{}
+	if data != "" {
+		if err := json.Unmarshal([]byte(data), strategy); err != nil {
+			blog.Errorf("UnmarshalUpgradeStrategy[%s] failed: %v", data, err)
+		}
+	}
+	if strategy.MaxSurge == 0 {
+		strategy.MaxSurge = defaultUpgradeMaxSurge
+	}
+	if strategy.DrainTimeout == 0 {
+		strategy.DrainTimeout = defaultUpgradeDrainTimeout
+	}
+
+	return strategy
+}
+
+// CordonAndDrainNodes cordon nodes and evict pods before nodes are replaced
+func CordonAndDrainNodes(ctx context.Context, clusterID string, nodeNames []string,
+	strategy *proto.NodeGroupUpgradeStrategy) error {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+
+	k8sOperator := clusterops.NewK8SOperator(options.GetGlobalCMOptions(), cloudprovider.GetStorageModel())
+	for _, name := range nodeNames {
+		err := k8sOperator.ClusterUpdateScheduleNode(ctx, clusterops.NodeInfo{
+			ClusterID: clusterID,
+			NodeName:  name,
+			Desired:   true,
+		})
+		if err != nil {
+			blog.Errorf("CordonAndDrainNodes[%s] cordon node[%s] failed: %v", taskID, name, err)
+			return err
+		}
+	}
+
+	results := ExecNodeDrainPodTask(ctx, CreateNodeDrainPodTaskParas{
+		DrainHelper: clusterops.DrainHelper{
+			Force:               strategy.GetForce(),
+			IgnoreAllDaemonSets: strategy.GetIgnoreDaemonSets(),
+			DeleteLocalData:     strategy.GetDeleteLocalData(),
+			Timeout:             int(strategy.GetDrainTimeout()),
+		},
+		NodeNames: nodeNames,
+		ClusterID: clusterID,
+	})
+
+	failed := make([]string, 0)
+	for _, r := range results {
+		if r.Result != NodeDrainPodResultSuccess {
+			blog.Errorf("CordonAndDrainNodes[%s] drain node[%s] %s: %v", taskID, r.NodeName, r.Result, r.Err)
+			failed = append(failed, r.NodeName)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("drain nodes[%s] failed", strings.Join(failed, ","))
+	}
+
+	return nil
+}
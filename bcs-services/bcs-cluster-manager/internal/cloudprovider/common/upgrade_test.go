@@ -0,0 +1,101 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestCheckVersionSkew(t *testing.T) {
+	tests := []struct {
+		current string
+		target  string
+		wantErr bool
+	}{
+		{"1.26.1", "1.28.3", true},
+		{"1.26.1", "1.27.3", false},
+		{"v1.26.1-tke.3", "1.26.4", false},
+		{"1.27.3", "1.26.1", true},
+		{"1.27.3", "1.27.3", true},
+		{"", "1.28.3", false},
+		{"1.27.3", "invalid", true},
+	}
+
+	for _, tt := range tests {
+		err := CheckVersionSkew(tt.current, tt.target)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CheckVersionSkew(%s, %s) err = %v, wantErr %v", tt.current, tt.target, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCompareVersion(t *testing.T) {
+	tests := []struct {
+		v1   string
+		v2   string
+		want int
+	}{
+		{"1.26", "1.26.0", 0},
+		{"v1.26.1-tke.3", "1.26.1", 0},
+		{"1.9.0", "1.10.0", -1},
+		{"1.28.3", "1.28", 1},
+	}
+
+	for _, tt := range tests {
+		if got := CompareVersion(tt.v1, tt.v2); got != tt.want {
+			t.Errorf("CompareVersion(%s, %s) = %d, want %d", tt.v1, tt.v2, got, tt.want)
+		}
+	}
+
+	if !IsNodeVersionOutdated("v1.26.1-tke.3", "1.28.3") {
+		t.Errorf("IsNodeVersionOutdated expected true")
+	}
+}
+
+func TestParseDeprecatedAPIs(t *testing.T) {
+	metrics := []byte(`# HELP apiserver_requested_deprecated_apis [STABLE] Gauge of deprecated APIs that have been requested
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="policy",removed_release="1.25",resource="podsecuritypolicies",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="autoscaling",removed_release="1.26",resource="horizontalpodautoscalers",subresource="",version="v2beta2"} 1
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.29",resource="flowschemas",subresource="",version="v1beta2"} 1
+apiserver_requested_deprecated_apis{group="batch",removed_release="1.25",resource="cronjobs",subresource="",version="v1beta1"} 0
+apiserver_request_total{code="200"} 10
+`)
+
+	apis, err := ParseDeprecatedAPIs(metrics, "1.26.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(apis) != 2 {
+		t.Fatalf("ParseDeprecatedAPIs got %d apis, want 2: %v", len(apis), apis)
+	}
+	if apis[1].String() != "autoscaling/v2beta2/horizontalpodautoscalers" {
+		t.Errorf("unexpected api %s", apis[1].String())
+	}
+}
+
+func TestAddonImageTag(t *testing.T) {
+	spec := &corev1.PodSpec{Containers: []corev1.Container{
+		{Name: "sidecar", Image: "mirror.local:5000/sidecar"},
+		{Name: "coredns", Image: "mirror.local:5000/coredns/coredns:1.9.3@sha256:abc"},
+	}}
+
+	if tag := addonImageTag(spec, "coredns"); tag != "1.9.3" {
+		t.Errorf("addonImageTag got %s, want 1.9.3", tag)
+	}
+	if tag := addonImageTag(spec, ""); tag != "" {
+		t.Errorf("addonImageTag got %s, want empty", tag)
+	}
+}
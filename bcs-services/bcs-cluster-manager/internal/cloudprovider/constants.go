@@ -46,6 +46,8 @@ var (
 	RemoveExternalNodesFromCluster TaskType = "RemoveExternalNodesFromCluster"
 	// SwitchClusterUnderlayNetwork task
 	SwitchClusterUnderlayNetwork TaskType = "SwitchClusterUnderlayNetwork"
+	// UpgradeClusterVersion task
+	UpgradeClusterVersion TaskType = "UpgradeClusterVersion"
 
 	// CreateNodeGroup task
 	CreateNodeGroup TaskType = "CreateNodeGroup"
@@ -55,6 +57,8 @@ var (
 	DeleteNodeGroup TaskType = "DeleteNodeGroup"
 	// MoveNodesToNodeGroup task
 	MoveNodesToNodeGroup TaskType = "MoveNodesToNodeGroup"
+	// UpgradeNodeGroupVersion task
+	UpgradeNodeGroupVersion TaskType = "UpgradeNodeGroupVersion"
 
 	// SwitchNodeGroupAutoScaling task
 	SwitchNodeGroupAutoScaling TaskType = "SwitchNodeGroupAutoScaling"
@@ -127,6 +131,8 @@ var (
 	RemoveExternalNodesFromClusterTask TaskName = "集群下架第三方节点"
 	// SwitchClusterUnderlayNetworkTask xx
 	SwitchClusterUnderlayNetworkTask TaskName = "切换集群underlay网络模式"
+	// UpgradeClusterVersionTask xx
+	UpgradeClusterVersionTask TaskName = "升级集群版本"
 
 	// CreateNodeGroupTask task
 	// nodeGroup manager task
@@ -145,6 +151,8 @@ var (
 	UpdateAutoScalingOptionTask TaskName = "更新集群自动扩缩容配置"
 	// SwitchAutoScalingOptionStatusTask task
 	SwitchAutoScalingOptionStatusTask TaskName = "开启/关闭集群自动扩缩容"
+	// UpgradeNodeGroupVersionTask task
+	UpgradeNodeGroupVersionTask TaskName = "升级节点池版本"
 
 	// DebugBkSopsTaskName task
 	DebugBkSopsTaskName TaskName = "调试标准运维任务"
@@ -387,6 +395,15 @@ var (
 
 	// TransVPCIPsKey trans vpc nodes ip
 	TransVPCIPsKey ParamKey = "transVPCNodeIPs"
+
+	// ClusterVersionKey upgrade cluster target version
+	ClusterVersionKey ParamKey = "clusterVersion"
+	// SkipPreCheckKey skip cloud upgrade pre check
+	SkipPreCheckKey ParamKey = "skipPreCheck"
+	// UpgradeNodeGroupIDsKey nodeGroups need to rolling replace nodes
+	UpgradeNodeGroupIDsKey ParamKey = "upgradeNodeGroupIDs"
+	// UpgradeStrategyKey nodeGroup rolling replace strategy
+	UpgradeStrategyKey ParamKey = "upgradeStrategy"
 )
 
 const (
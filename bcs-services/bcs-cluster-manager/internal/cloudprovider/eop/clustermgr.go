@@ -221,3 +221,9 @@ func (c *Cluster) CheckHighAvailabilityMasterNodes(cls *cmproto.Cluster, nodes [
 	opt *cloudprovider.CheckHaMasterNodesOption) error {
 	return nil
 }
+
+// UpgradeClusterVersion upgrade cluster kubernetes version
+func (c *Cluster) UpgradeClusterVersion(cls *cmproto.Cluster, version string,
+	opt *cloudprovider.UpgradeClusterVersionOption) (*cmproto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
@@ -235,6 +235,12 @@ func (t *Task) BuildUpdateNodeGroupTask(group *proto.NodeGroup, opt *cloudprovid
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
 
+// BuildUpgradeNodeGroupVersionTask rolling replace nodegroup nodes
+func (t *Task) BuildUpgradeNodeGroupVersionTask(group *proto.NodeGroup,
+	opt *cloudprovider.UpgradeNodeGroupVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // BuildDeleteNodeGroupTask when delete nodegroup, we need to create background
 // task to clean all nodes in nodegroup, release all resource in cloudprovider,
 // finnally delete nodes information in local storage.
@@ -291,3 +297,9 @@ func (t *Task) BuildSwitchClusterNetworkTask(cls *proto.Cluster,
 	subnet *proto.SubnetSource, opt *cloudprovider.SwitchClusterNetworkOption) (*proto.Task, error) {
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
+
+// BuildUpgradeClusterVersionTask upgrade cluster kubernetes version
+func (t *Task) BuildUpgradeClusterVersionTask(cls *proto.Cluster, version string,
+	opt *cloudprovider.UpgradeClusterVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
@@ -0,0 +1,711 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/options"
+)
+
+var (
+	simulator     *Simulator
+	simulatorOnce sync.Once
+)
+
+// GetSimulator get global fake cloud simulator, config is loaded from cluster-manager options when first called
+func GetSimulator() *Simulator {
+	simulatorOnce.Do(func() {
+		cfg := Config{}
+		if opt := options.GetGlobalCMOptions(); opt != nil {
+			cfg = NewConfigFromOptions(opt.FakeCloud)
+		}
+		simulator = NewSimulator(cfg)
+	})
+
+	return simulator
+}
+
+// Simulator in-memory fake cloud, supports api latency and failure injection
+type Simulator struct {
+	lock sync.RWMutex
+
+	config Config
+	calls  map[string]uint32
+	random *rand.Rand
+
+	clusters  map[string]*Cluster
+	nodePools map[string]*NodePool
+	instances map[string]*Instance
+	vpcs      map[string]*Vpc
+	subnets   map[string]*Subnet
+	// ipIndex innerIP -> instanceID
+	ipIndex map[string]string
+	// ipOffset subnetID -> next allocate offset
+	ipOffset map[string]uint32
+}
+
+// NewSimulator create fake cloud simulator with default inventory
+func NewSimulator(cfg Config) *Simulator {
+	s := &Simulator{
+		random: rand.New(rand.NewSource(time.Now().UnixNano())), // nolint
+	}
+	s.config = cfg
+	s.reset()
+
+	return s
+}
+
+// SetConfig update simulator config and reset failure injection counters
+func (s *Simulator) SetConfig(cfg Config) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.config = cfg
+	s.calls = make(map[string]uint32)
+}
+
+// Reset clean all resources and restore default inventory
+func (s *Simulator) Reset() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.reset()
+}
+
+// CallCount get api action call count
+func (s *Simulator) CallCount(action string) uint32 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.calls[action]
+}
+
+func (s *Simulator) reset() {
+	s.calls = make(map[string]uint32)
+	s.clusters = make(map[string]*Cluster)
+	s.nodePools = make(map[string]*NodePool)
+	s.instances = make(map[string]*Instance)
+	s.ipIndex = make(map[string]string)
+	s.ipOffset = make(map[string]uint32)
+
+	s.vpcs = map[string]*Vpc{
+		DefaultVpcID: {VpcID: DefaultVpcID, Name: "default", Region: DefaultRegion, CidrBlock: "10.0.0.0/16"},
+	}
+	s.subnets = map[string]*Subnet{
+		"subnet-fake-1": {SubnetID: "subnet-fake-1", Name: "default-1", VpcID: DefaultVpcID,
+			Zone: DefaultRegion + "-1", CidrBlock: "10.0.0.0/18", AvailableIPs: 16381},
+		"subnet-fake-2": {SubnetID: "subnet-fake-2", Name: "default-2", VpcID: DefaultVpcID,
+			Zone: DefaultRegion + "-2", CidrBlock: "10.0.64.0/18", AvailableIPs: 16381},
+	}
+}
+
+// invoke simulate api call latency and failure injection
+func (s *Simulator) invoke(action string) error {
+	s.lock.Lock()
+	s.calls[action]++
+	count := s.calls[action]
+	latency := s.config.Latency
+
+	var err error
+	for _, rule := range s.config.Failures {
+		if rule.Action != action {
+			continue
+		}
+		if count <= rule.Times || (rule.Rate > 0 && s.random.Float64() < rule.Rate) {
+			msg := rule.Message
+			if msg == "" {
+				msg = "injected failure"
+			}
+			err = &InjectedError{Action: action, Message: msg}
+			break
+		}
+	}
+	s.lock.Unlock()
+
+	if latency > 0 {
+		time.Sleep(latency)
+	}
+
+	return err
+}
+
+func (s *Simulator) provisionReadyAt() time.Time {
+	return time.Now().Add(s.config.ProvisionDuration)
+}
+
+func newResourceID(prefix string) string {
+	return fmt.Sprintf("%s-%s", prefix, uuid.New().String()[:8])
+}
+
+// refreshCluster creating cluster become running when provision duration elapsed
+func refreshCluster(cls *Cluster) *Cluster {
+	if cls.Status == StatusCreating && !time.Now().Before(cls.readyAt) {
+		cls.Status = StatusRunning
+	}
+	copied := *cls
+	return &copied
+}
+
+// refreshInstance creating instance become running when provision duration elapsed
+func refreshInstance(ins *Instance) *Instance {
+	if ins.Status == StatusCreating && !time.Now().Before(ins.readyAt) {
+		ins.Status = StatusRunning
+	}
+	copied := *ins
+	return &copied
+}
+
+// CreateCluster create cluster, cluster status is creating until provision duration elapsed
+func (s *Simulator) CreateCluster(input CreateClusterInput) (*Cluster, error) {
+	if err := s.invoke(ActionCreateCluster); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if input.Region == "" {
+		input.Region = DefaultRegion
+	}
+	if input.VpcID == "" {
+		input.VpcID = DefaultVpcID
+	}
+	if _, ok := s.vpcs[input.VpcID]; !ok {
+		return nil, fmt.Errorf("CreateCluster vpc %s: %w", input.VpcID, ErrNotFound)
+	}
+
+	cls := &Cluster{
+		ClusterID:   newResourceID("cls-fake"),
+		ClusterName: input.ClusterName,
+		Region:      input.Region,
+		VpcID:       input.VpcID,
+		Version:     input.Version,
+		Status:      StatusCreating,
+		CreateTime:  time.Now(),
+		readyAt:     s.provisionReadyAt(),
+	}
+	s.clusters[cls.ClusterID] = cls
+
+	return refreshCluster(cls), nil
+}
+
+// DescribeCluster get cluster by clusterID
+func (s *Simulator) DescribeCluster(clusterID string) (*Cluster, error) {
+	if err := s.invoke(ActionDescribeCluster); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	cls, ok := s.clusters[clusterID]
+	if !ok {
+		return nil, fmt.Errorf("DescribeCluster %s: %w", clusterID, ErrNotFound)
+	}
+
+	return refreshCluster(cls), nil
+}
+
+// ListClusters list clusters by region, empty region list all clusters
+func (s *Simulator) ListClusters(region string) ([]*Cluster, error) {
+	if err := s.invoke(ActionDescribeCluster); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	clusters := make([]*Cluster, 0)
+	for _, cls := range s.clusters {
+		if region != "" && cls.Region != region {
+			continue
+		}
+		clusters = append(clusters, refreshCluster(cls))
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].CreateTime.Before(clusters[j].CreateTime)
+	})
+
+	return clusters, nil
+}
+
+// DeleteCluster delete cluster, node pool instances are terminated and other instances are detached
+func (s *Simulator) DeleteCluster(clusterID string) error {
+	if err := s.invoke(ActionDeleteCluster); err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.clusters[clusterID]; !ok {
+		return fmt.Errorf("DeleteCluster %s: %w", clusterID, ErrNotFound)
+	}
+
+	for id, ins := range s.instances {
+		if ins.ClusterID != clusterID {
+			continue
+		}
+		if ins.NodePoolID != "" {
+			s.terminate(id)
+			continue
+		}
+		ins.ClusterID = ""
+	}
+	for id, pool := range s.nodePools {
+		if pool.ClusterID == clusterID {
+			delete(s.nodePools, id)
+		}
+	}
+	delete(s.clusters, clusterID)
+
+	return nil
+}
+
+// AddInstancesToCluster add existed instances to cluster, instances status is creating until provision elapsed
+func (s *Simulator) AddInstancesToCluster(clusterID string, instanceIDs []string) error {
+	if err := s.invoke(ActionAddInstancesToCluster); err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.clusters[clusterID]; !ok {
+		return fmt.Errorf("AddInstancesToCluster cluster %s: %w", clusterID, ErrNotFound)
+	}
+	for _, id := range instanceIDs {
+		ins, ok := s.instances[id]
+		if !ok {
+			return fmt.Errorf("AddInstancesToCluster instance %s: %w", id, ErrNotFound)
+		}
+		if ins.ClusterID != "" && ins.ClusterID != clusterID {
+			return fmt.Errorf("AddInstancesToCluster instance %s already in cluster %s", id, ins.ClusterID)
+		}
+	}
+
+	for _, id := range instanceIDs {
+		ins := s.instances[id]
+		// retry add instances is idempotent
+		if ins.ClusterID == clusterID {
+			continue
+		}
+		ins.ClusterID = clusterID
+		ins.Status = StatusCreating
+		ins.readyAt = s.provisionReadyAt()
+	}
+
+	return nil
+}
+
+// RemoveInstancesFromCluster remove instances from cluster, node pool instances are terminated
+func (s *Simulator) RemoveInstancesFromCluster(clusterID string, instanceIDs []string) error {
+	if err := s.invoke(ActionRemoveInstancesFromCluster); err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, id := range instanceIDs {
+		ins, ok := s.instances[id]
+		if !ok || ins.ClusterID != clusterID {
+			continue
+		}
+		if ins.NodePoolID != "" {
+			s.terminate(id)
+			continue
+		}
+		ins.ClusterID = ""
+	}
+
+	return nil
+}
+
+// CreateNodePool create cluster node pool
+func (s *Simulator) CreateNodePool(input CreateNodePoolInput) (*NodePool, error) {
+	if err := s.invoke(ActionCreateNodePool); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	cls, ok := s.clusters[input.ClusterID]
+	if !ok {
+		return nil, fmt.Errorf("CreateNodePool cluster %s: %w", input.ClusterID, ErrNotFound)
+	}
+
+	subnet, err := s.selectSubnet(cls.VpcID, input.SubnetID, input.Zone)
+	if err != nil {
+		return nil, err
+	}
+	if input.InstanceType == "" {
+		input.InstanceType = DefaultInstanceType
+	}
+
+	pool := &NodePool{
+		NodePoolID:   newResourceID("np-fake"),
+		ClusterID:    input.ClusterID,
+		Name:         input.Name,
+		Zone:         subnet.Zone,
+		SubnetID:     subnet.SubnetID,
+		InstanceType: input.InstanceType,
+		Status:       StatusRunning,
+	}
+	s.nodePools[pool.NodePoolID] = pool
+
+	copied := *pool
+	return &copied, nil
+}
+
+// DescribeNodePool get node pool by nodePoolID
+func (s *Simulator) DescribeNodePool(nodePoolID string) (*NodePool, error) {
+	if err := s.invoke(ActionDescribeNodePool); err != nil {
+		return nil, err
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	pool, ok := s.nodePools[nodePoolID]
+	if !ok {
+		return nil, fmt.Errorf("DescribeNodePool %s: %w", nodePoolID, ErrNotFound)
+	}
+	copied := *pool
+	return &copied, nil
+}
+
+// DeleteNodePool delete node pool and terminate all instances in node pool
+func (s *Simulator) DeleteNodePool(nodePoolID string) error {
+	if err := s.invoke(ActionDeleteNodePool); err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.nodePools[nodePoolID]; !ok {
+		return fmt.Errorf("DeleteNodePool %s: %w", nodePoolID, ErrNotFound)
+	}
+	for id, ins := range s.instances {
+		if ins.NodePoolID == nodePoolID {
+			s.terminate(id)
+		}
+	}
+	delete(s.nodePools, nodePoolID)
+
+	return nil
+}
+
+// ScaleOutNodePool create num instances in node pool, instances are joining cluster
+func (s *Simulator) ScaleOutNodePool(nodePoolID string, num uint32) ([]*Instance, error) {
+	if err := s.invoke(ActionScaleOutNodePool); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	pool, ok := s.nodePools[nodePoolID]
+	if !ok {
+		return nil, fmt.Errorf("ScaleOutNodePool %s: %w", nodePoolID, ErrNotFound)
+	}
+	cls, ok := s.clusters[pool.ClusterID]
+	if !ok {
+		return nil, fmt.Errorf("ScaleOutNodePool cluster %s: %w", pool.ClusterID, ErrNotFound)
+	}
+	subnet := s.subnets[pool.SubnetID]
+	if subnet == nil || uint64(num) > subnet.AvailableIPs {
+		return nil, fmt.Errorf("ScaleOutNodePool subnet %s insufficient ip", pool.SubnetID)
+	}
+
+	instances := make([]*Instance, 0, num)
+	for i := uint32(0); i < num; i++ {
+		ip, err := s.allocateIP(subnet)
+		if err != nil {
+			return nil, err
+		}
+		ins := &Instance{
+			InstanceID:   newResourceID("ins-fake"),
+			InnerIP:      ip,
+			Region:       cls.Region,
+			Zone:         pool.Zone,
+			VpcID:        cls.VpcID,
+			SubnetID:     subnet.SubnetID,
+			InstanceType: pool.InstanceType,
+			CPU:          4,
+			Memory:       8,
+			ClusterID:    pool.ClusterID,
+			NodePoolID:   nodePoolID,
+			Status:       StatusCreating,
+			readyAt:      s.provisionReadyAt(),
+		}
+		ins.InstanceName = ins.InstanceID
+		s.instances[ins.InstanceID] = ins
+		s.ipIndex[ins.InnerIP] = ins.InstanceID
+
+		instances = append(instances, refreshInstance(ins))
+	}
+
+	return instances, nil
+}
+
+// TerminateInstances terminate instances, not existed instances are ignored
+func (s *Simulator) TerminateInstances(instanceIDs []string) error {
+	if err := s.invoke(ActionTerminateInstances); err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, id := range instanceIDs {
+		s.terminate(id)
+	}
+
+	return nil
+}
+
+// DescribeInstances get instances by instanceIDs, not existed instances are ignored
+func (s *Simulator) DescribeInstances(instanceIDs []string) ([]*Instance, error) {
+	if err := s.invoke(ActionDescribeInstances); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	instances := make([]*Instance, 0)
+	for _, id := range instanceIDs {
+		if ins, ok := s.instances[id]; ok {
+			instances = append(instances, refreshInstance(ins))
+		}
+	}
+
+	return instances, nil
+}
+
+// DescribeInstancesByIP get instances by innerIPs, unknown ip is registered as idle running instance
+// in default vpc, which simulates user existed machines
+func (s *Simulator) DescribeInstancesByIP(region string, ips []string) ([]*Instance, error) {
+	if err := s.invoke(ActionDescribeInstances); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if region == "" {
+		region = DefaultRegion
+	}
+
+	instances := make([]*Instance, 0)
+	for _, ip := range ips {
+		if net.ParseIP(ip) == nil {
+			return nil, fmt.Errorf("DescribeInstancesByIP invalid ip %s", ip)
+		}
+
+		id, ok := s.ipIndex[ip]
+		if !ok {
+			ins := &Instance{
+				InstanceID:   newResourceID("ins-fake"),
+				InnerIP:      ip,
+				Region:       region,
+				Zone:         region + "-1",
+				VpcID:        DefaultVpcID,
+				InstanceType: DefaultInstanceType,
+				CPU:          4,
+				Memory:       8,
+				Status:       StatusRunning,
+			}
+			ins.InstanceName = ins.InstanceID
+			s.instances[ins.InstanceID] = ins
+			s.ipIndex[ip] = ins.InstanceID
+			id = ins.InstanceID
+		}
+		instances = append(instances, refreshInstance(s.instances[id]))
+	}
+
+	return instances, nil
+}
+
+// ListInstances list instances by cluster and node pool, empty nodePoolID list all cluster instances
+func (s *Simulator) ListInstances(clusterID, nodePoolID string) ([]*Instance, error) {
+	if err := s.invoke(ActionDescribeInstances); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	instances := make([]*Instance, 0)
+	for _, ins := range s.instances {
+		if ins.ClusterID != clusterID || (nodePoolID != "" && ins.NodePoolID != nodePoolID) {
+			continue
+		}
+		instances = append(instances, refreshInstance(ins))
+	}
+	sort.Slice(instances, func(i, j int) bool {
+		return instances[i].InstanceID < instances[j].InstanceID
+	})
+
+	return instances, nil
+}
+
+// ListVpcs list vpcs by region and vpcID
+func (s *Simulator) ListVpcs(region, vpcID string) ([]*Vpc, error) {
+	if err := s.invoke(ActionDescribeVpcs); err != nil {
+		return nil, err
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	vpcs := make([]*Vpc, 0)
+	for _, vpc := range s.vpcs {
+		if (region != "" && vpc.Region != region) || (vpcID != "" && vpc.VpcID != vpcID) {
+			continue
+		}
+		copied := *vpc
+		vpcs = append(vpcs, &copied)
+	}
+
+	return vpcs, nil
+}
+
+// ListSubnets list subnets by vpc and zone
+func (s *Simulator) ListSubnets(vpcID, zone string) ([]*Subnet, error) {
+	if err := s.invoke(ActionDescribeSubnets); err != nil {
+		return nil, err
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	subnets := make([]*Subnet, 0)
+	for _, subnet := range s.subnets {
+		if subnet.VpcID != vpcID || (zone != "" && subnet.Zone != zone) {
+			continue
+		}
+		copied := *subnet
+		subnets = append(subnets, &copied)
+	}
+	sort.Slice(subnets, func(i, j int) bool {
+		return subnets[i].SubnetID < subnets[j].SubnetID
+	})
+
+	return subnets, nil
+}
+
+// Zones list zones of region
+func (s *Simulator) Zones(region string) []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	zones := make([]string, 0)
+	exist := make(map[string]struct{})
+	for _, subnet := range s.subnets {
+		vpc := s.vpcs[subnet.VpcID]
+		if vpc == nil || (region != "" && vpc.Region != region) {
+			continue
+		}
+		if _, ok := exist[subnet.Zone]; ok {
+			continue
+		}
+		exist[subnet.Zone] = struct{}{}
+		zones = append(zones, subnet.Zone)
+	}
+	sort.Strings(zones)
+
+	return zones
+}
+
+// selectSubnet select subnet by subnetID or zone, default first subnet of vpc
+func (s *Simulator) selectSubnet(vpcID, subnetID, zone string) (*Subnet, error) {
+	if subnetID != "" {
+		subnet, ok := s.subnets[subnetID]
+		if !ok || subnet.VpcID != vpcID {
+			return nil, fmt.Errorf("subnet %s in vpc %s: %w", subnetID, vpcID, ErrNotFound)
+		}
+		return subnet, nil
+	}
+
+	var selected *Subnet
+	for _, subnet := range s.subnets {
+		if subnet.VpcID != vpcID || (zone != "" && subnet.Zone != zone) {
+			continue
+		}
+		if selected == nil || subnet.SubnetID < selected.SubnetID {
+			selected = subnet
+		}
+	}
+	if selected == nil {
+		return nil, fmt.Errorf("subnet of vpc %s zone %s: %w", vpcID, zone, ErrNotFound)
+	}
+
+	return selected, nil
+}
+
+// allocateIP allocate unused ip from subnet
+func (s *Simulator) allocateIP(subnet *Subnet) (string, error) {
+	_, ipNet, err := net.ParseCIDR(subnet.CidrBlock)
+	if err != nil {
+		return "", err
+	}
+	base := binary.BigEndian.Uint32(ipNet.IP.To4())
+	ones, bits := ipNet.Mask.Size()
+	size := uint32(1) << uint32(bits-ones)
+
+	for {
+		offset := s.ipOffset[subnet.SubnetID]
+		if offset < 2 {
+			offset = 2
+		}
+		if offset >= size-1 {
+			return "", fmt.Errorf("subnet %s insufficient ip", subnet.SubnetID)
+		}
+		s.ipOffset[subnet.SubnetID] = offset + 1
+
+		ip := make(net.IP, 4)
+		binary.BigEndian.PutUint32(ip, base+offset)
+		if _, used := s.ipIndex[ip.String()]; used {
+			continue
+		}
+		subnet.AvailableIPs--
+
+		return ip.String(), nil
+	}
+}
+
+// terminate delete instance and release ip, caller must hold lock
+func (s *Simulator) terminate(instanceID string) {
+	ins, ok := s.instances[instanceID]
+	if !ok {
+		return
+	}
+	if subnet, exist := s.subnets[ins.SubnetID]; exist {
+		subnet.AvailableIPs++
+	}
+	delete(s.ipIndex, ins.InnerIP)
+	delete(s.instances, instanceID)
+}
@@ -0,0 +1,131 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSimulatorClusterLifecycle(t *testing.T) {
+	s := NewSimulator(Config{})
+
+	cls, err := s.CreateCluster(CreateClusterInput{ClusterName: "e2e", Version: "1.28.3"})
+	if err != nil {
+		t.Fatalf("CreateCluster failed: %v", err)
+	}
+	if cls.Status != StatusRunning || cls.VpcID != DefaultVpcID {
+		t.Fatalf("unexpected cluster %+v", cls)
+	}
+
+	pool, err := s.CreateNodePool(CreateNodePoolInput{ClusterID: cls.ClusterID, Name: "pool"})
+	if err != nil {
+		t.Fatalf("CreateNodePool failed: %v", err)
+	}
+	instances, err := s.ScaleOutNodePool(pool.NodePoolID, 3)
+	if err != nil || len(instances) != 3 {
+		t.Fatalf("ScaleOutNodePool failed: %v, %d", err, len(instances))
+	}
+
+	existed, err := s.DescribeInstancesByIP("", []string{"192.168.0.10"})
+	if err != nil || len(existed) != 1 {
+		t.Fatalf("DescribeInstancesByIP failed: %v", err)
+	}
+	if err = s.AddInstancesToCluster(cls.ClusterID, []string{existed[0].InstanceID}); err != nil {
+		t.Fatalf("AddInstancesToCluster failed: %v", err)
+	}
+
+	all, _ := s.ListInstances(cls.ClusterID, "")
+	if len(all) != 4 {
+		t.Fatalf("cluster instances = %d, want 4", len(all))
+	}
+
+	if err = s.DeleteCluster(cls.ClusterID); err != nil {
+		t.Fatalf("DeleteCluster failed: %v", err)
+	}
+	if _, err = s.DescribeCluster(cls.ClusterID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("DescribeCluster after delete err = %v", err)
+	}
+	// node pool instances are terminated, existed instance is detached
+	left, _ := s.DescribeInstances([]string{instances[0].InstanceID, existed[0].InstanceID})
+	if len(left) != 1 || left[0].ClusterID != "" {
+		t.Fatalf("unexpected instances after delete cluster %+v", left)
+	}
+}
+
+func TestSimulatorProvision(t *testing.T) {
+	s := NewSimulator(Config{ProvisionDuration: 50 * time.Millisecond})
+
+	cls, err := s.CreateCluster(CreateClusterInput{ClusterName: "e2e"})
+	if err != nil {
+		t.Fatalf("CreateCluster failed: %v", err)
+	}
+	if cls.Status != StatusCreating {
+		t.Fatalf("cluster status = %s, want %s", cls.Status, StatusCreating)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	cls, _ = s.DescribeCluster(cls.ClusterID)
+	if cls.Status != StatusRunning {
+		t.Fatalf("cluster status = %s, want %s", cls.Status, StatusRunning)
+	}
+}
+
+func TestSimulatorFailureInjection(t *testing.T) {
+	s := NewSimulator(Config{Failures: []FailureRule{
+		{Action: ActionCreateCluster, Times: 2, Message: "quota exceeded"},
+		{Action: ActionDeleteCluster, Rate: 1},
+	}})
+
+	for i := 0; i < 2; i++ {
+		_, err := s.CreateCluster(CreateClusterInput{})
+		if !IsInjectedError(err) {
+			t.Fatalf("CreateCluster call %d err = %v, want injected error", i, err)
+		}
+	}
+	cls, err := s.CreateCluster(CreateClusterInput{})
+	if err != nil {
+		t.Fatalf("CreateCluster after injected failures: %v", err)
+	}
+	if s.CallCount(ActionCreateCluster) != 3 {
+		t.Fatalf("CreateCluster call count = %d, want 3", s.CallCount(ActionCreateCluster))
+	}
+
+	if err = s.DeleteCluster(cls.ClusterID); !IsInjectedError(err) {
+		t.Fatalf("DeleteCluster err = %v, want injected error", err)
+	}
+
+	s.SetConfig(Config{})
+	if err = s.DeleteCluster(cls.ClusterID); err != nil {
+		t.Fatalf("DeleteCluster after reset config: %v", err)
+	}
+}
+
+func TestSimulatorAllocateIP(t *testing.T) {
+	s := NewSimulator(Config{})
+
+	// occupy first allocatable ip of default subnet
+	if _, err := s.DescribeInstancesByIP("", []string{"10.0.0.2"}); err != nil {
+		t.Fatalf("DescribeInstancesByIP failed: %v", err)
+	}
+	cls, _ := s.CreateCluster(CreateClusterInput{})
+	pool, _ := s.CreateNodePool(CreateNodePoolInput{ClusterID: cls.ClusterID, SubnetID: "subnet-fake-1"})
+	instances, err := s.ScaleOutNodePool(pool.NodePoolID, 1)
+	if err != nil {
+		t.Fatalf("ScaleOutNodePool failed: %v", err)
+	}
+	if instances[0].InnerIP != "10.0.0.3" {
+		t.Fatalf("allocated ip = %s, want 10.0.0.3", instances[0].InnerIP)
+	}
+}
@@ -0,0 +1,206 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package api xxx
+package api
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/options"
+)
+
+// fake cloud api action name, used by failure injection rules
+const (
+	// ActionCreateCluster create cluster
+	ActionCreateCluster = "CreateCluster"
+	// ActionDescribeCluster describe cluster
+	ActionDescribeCluster = "DescribeCluster"
+	// ActionDeleteCluster delete cluster
+	ActionDeleteCluster = "DeleteCluster"
+	// ActionAddInstancesToCluster add instances to cluster
+	ActionAddInstancesToCluster = "AddInstancesToCluster"
+	// ActionRemoveInstancesFromCluster remove instances from cluster
+	ActionRemoveInstancesFromCluster = "RemoveInstancesFromCluster"
+	// ActionCreateNodePool create node pool
+	ActionCreateNodePool = "CreateNodePool"
+	// ActionDescribeNodePool describe node pool
+	ActionDescribeNodePool = "DescribeNodePool"
+	// ActionDeleteNodePool delete node pool
+	ActionDeleteNodePool = "DeleteNodePool"
+	// ActionScaleOutNodePool scale out node pool instances
+	ActionScaleOutNodePool = "ScaleOutNodePool"
+	// ActionTerminateInstances terminate instances
+	ActionTerminateInstances = "TerminateInstances"
+	// ActionDescribeInstances describe instances
+	ActionDescribeInstances = "DescribeInstances"
+	// ActionDescribeVpcs describe vpcs
+	ActionDescribeVpcs = "DescribeVpcs"
+	// ActionDescribeSubnets describe subnets
+	ActionDescribeSubnets = "DescribeSubnets"
+)
+
+// fake cloud resource status
+const (
+	// StatusCreating resource is creating
+	StatusCreating = "creating"
+	// StatusRunning resource is running
+	StatusRunning = "running"
+)
+
+// fake cloud default inventory
+const (
+	// DefaultRegion default region
+	DefaultRegion = "fake-region"
+	// DefaultVpcID default vpc
+	DefaultVpcID = "vpc-fake-default"
+	// DefaultInstanceType default instance type
+	DefaultInstanceType = "FAKE.M4"
+)
+
+var (
+	// ErrNotFound resource not found in fake cloud
+	ErrNotFound = errors.New("fake cloud resource not found")
+)
+
+// InjectedError error returned by failure injection
+type InjectedError struct {
+	Action  string
+	Message string
+}
+
+// Error implements error
+func (e *InjectedError) Error() string {
+	return fmt.Sprintf("fake cloud %s failed: %s", e.Action, e.Message)
+}
+
+// IsInjectedError check if error is returned by failure injection
+func IsInjectedError(err error) bool {
+	var injected *InjectedError
+	return errors.As(err, &injected)
+}
+
+// Config fake cloud simulator config
+type Config struct {
+	// Latency every api call latency
+	Latency time.Duration
+	// ProvisionDuration duration before creating cluster/instance become running
+	ProvisionDuration time.Duration
+	// Failures api failure injection rules
+	Failures []FailureRule
+}
+
+// FailureRule api failure injection rule, first Times calls fail and then fail by Rate
+type FailureRule struct {
+	Action  string
+	Times   uint32
+	Rate    float64
+	Message string
+}
+
+// NewConfigFromOptions build simulator config from cluster-manager options
+func NewConfigFromOptions(opt options.FakeCloudConfig) Config {
+	cfg := Config{
+		Latency:           time.Duration(opt.Latency) * time.Millisecond,
+		ProvisionDuration: time.Duration(opt.ProvisionSeconds) * time.Second,
+	}
+	for _, rule := range opt.Failures {
+		cfg.Failures = append(cfg.Failures, FailureRule{
+			Action:  rule.Action,
+			Times:   rule.Times,
+			Rate:    rule.Rate,
+			Message: rule.Message,
+		})
+	}
+
+	return cfg
+}
+
+// Cluster fake cloud cluster
+type Cluster struct {
+	ClusterID   string
+	ClusterName string
+	Region      string
+	VpcID       string
+	Version     string
+	Status      string
+	CreateTime  time.Time
+
+	readyAt time.Time
+}
+
+// CreateClusterInput create cluster input
+type CreateClusterInput struct {
+	ClusterName string
+	Region      string
+	VpcID       string
+	Version     string
+}
+
+// NodePool fake cloud node pool
+type NodePool struct {
+	NodePoolID   string
+	ClusterID    string
+	Name         string
+	Zone         string
+	SubnetID     string
+	InstanceType string
+	Status       string
+}
+
+// CreateNodePoolInput create node pool input
+type CreateNodePoolInput struct {
+	ClusterID    string
+	Name         string
+	Zone         string
+	SubnetID     string
+	InstanceType string
+}
+
+// Instance fake cloud instance
+type Instance struct {
+	InstanceID   string
+	InstanceName string
+	InnerIP      string
+	Region       string
+	Zone         string
+	VpcID        string
+	SubnetID     string
+	InstanceType string
+	CPU          uint32
+	Memory       uint32
+	ClusterID    string
+	NodePoolID   string
+	Status       string
+
+	readyAt time.Time
+}
+
+// Vpc fake cloud vpc
+type Vpc struct {
+	VpcID     string
+	Name      string
+	Region    string
+	CidrBlock string
+}
+
+// Subnet fake cloud subnet
+type Subnet struct {
+	SubnetID     string
+	Name         string
+	VpcID        string
+	Zone         string
+	CidrBlock    string
+	AvailableIPs uint64
+}
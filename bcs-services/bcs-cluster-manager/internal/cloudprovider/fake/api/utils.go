@@ -0,0 +1,35 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+)
+
+// InstanceToNode convert fake cloud instance to cluster-manager node
+func InstanceToNode(ins *Instance) *proto.Node {
+	return &proto.Node{
+		NodeID:       ins.InstanceID,
+		InnerIP:      ins.InnerIP,
+		InstanceType: ins.InstanceType,
+		CPU:          ins.CPU,
+		Mem:          ins.Memory,
+		ZoneID:       ins.Zone,
+		ZoneName:     ins.Zone,
+		VPC:          ins.VpcID,
+		Region:       ins.Region,
+		NodeName:     ins.InstanceName,
+		NodeType:     common.CVM.String(),
+	}
+}
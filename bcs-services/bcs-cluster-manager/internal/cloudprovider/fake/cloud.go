@@ -0,0 +1,97 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"fmt"
+	"sync"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+)
+
+var cloudInfoMgr sync.Once
+
+func init() {
+	cloudInfoMgr.Do(func() {
+		// init Cluster
+		cloudprovider.InitCloudInfoManager(cloudName, &CloudInfoManager{})
+	})
+}
+
+// CloudInfoManager fake cloud management cluster info
+type CloudInfoManager struct {
+}
+
+// InitCloudClusterDefaultInfo init cluster default info
+func (c *CloudInfoManager) InitCloudClusterDefaultInfo(cls *proto.Cluster,
+	opt *cloudprovider.InitClusterConfigOption) error {
+	if c == nil || cls == nil {
+		return fmt.Errorf("%s InitCloudClusterDefaultInfo request is empty", cloudName)
+	}
+
+	if opt == nil || opt.Cloud == nil {
+		return fmt.Errorf("%s InitCloudClusterDefaultInfo option is empty", cloudName)
+	}
+
+	clusterCloudDefaultBasicSetting(cls, opt.Cloud, opt.ClusterVersion)
+
+	return nil
+}
+
+// SyncClusterCloudInfo sync cluster cloud info
+func (c *CloudInfoManager) SyncClusterCloudInfo(cls *proto.Cluster,
+	opt *cloudprovider.SyncClusterCloudInfoOption) error {
+	if c == nil || cls == nil {
+		return fmt.Errorf("%s SyncClusterCloudInfo request is empty", cloudName)
+	}
+
+	if opt == nil || opt.Cloud == nil {
+		return fmt.Errorf("%s SyncClusterCloudInfo option is empty", cloudName)
+	}
+
+	clusterCloudDefaultBasicSetting(cls, opt.Cloud, opt.ClusterVersion)
+
+	return nil
+}
+
+// UpdateClusterCloudInfo update cluster info by cloud
+func (c *CloudInfoManager) UpdateClusterCloudInfo(cls *proto.Cluster) error {
+	if c == nil || cls == nil {
+		return fmt.Errorf("%s UpdateClusterCloudInfo request is empty", cloudName)
+	}
+
+	return nil
+}
+
+func clusterCloudDefaultBasicSetting(cls *proto.Cluster, cloud *proto.Cloud, version string) {
+	defaultOSImage := common.DefaultImageName
+	if len(cloud.GetOsManagement().GetAvailableVersion()) > 0 {
+		defaultOSImage = cloud.OsManagement.AvailableVersion[0]
+	}
+	if version == "" && len(cloud.GetClusterManagement().GetAvailableVersion()) > 0 {
+		version = cloud.ClusterManagement.AvailableVersion[0]
+	}
+
+	if cls.ClusterBasicSettings == nil {
+		cls.ClusterBasicSettings = &proto.ClusterBasicSetting{}
+	}
+	if cls.ClusterBasicSettings.OS == "" {
+		cls.ClusterBasicSettings.OS = defaultOSImage
+	}
+	if version != "" {
+		cls.ClusterBasicSettings.Version = version
+		cls.ClusterBasicSettings.VersionName = version
+	}
+}
@@ -0,0 +1,321 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/fake/api"
+)
+
+func init() {
+	cloudprovider.InitClusterManager(cloudName, &Cluster{})
+}
+
+// Cluster fake cloud kubernetes cluster management implementation
+type Cluster struct {
+}
+
+// CreateVirtualCluster create virtual cluster by cloud provider
+func (c *Cluster) CreateVirtualCluster(cls *proto.Cluster,
+	opt *cloudprovider.CreateVirtualClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// DeleteVirtualCluster delete virtual cluster
+func (c *Cluster) DeleteVirtualCluster(cls *proto.Cluster,
+	opt *cloudprovider.DeleteVirtualClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// CreateCluster create kubernetes cluster in fake cloud
+func (c *Cluster) CreateCluster(cls *proto.Cluster, opt *cloudprovider.CreateClusterOption) (*proto.Task, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("fake CreateCluster cluster is empty")
+	}
+
+	if opt == nil || opt.Cloud == nil {
+		return nil, fmt.Errorf("fake CreateCluster cluster opt or cloud is empty")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when CreateCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build create cluster task
+	task, err := mgr.BuildCreateClusterTask(cls, opt)
+	if err != nil {
+		blog.Errorf("build CreateCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// ImportCluster import cluster according cloudprovider
+func (c *Cluster) ImportCluster(cls *proto.Cluster, opt *cloudprovider.ImportClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// DeleteCluster delete kubernetes cluster in fake cloud
+func (c *Cluster) DeleteCluster(cls *proto.Cluster, opt *cloudprovider.DeleteClusterOption) (*proto.Task, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("fake DeleteCluster cluster is empty")
+	}
+
+	if opt == nil || opt.Cloud == nil || opt.Cluster == nil || len(opt.Operator) == 0 {
+		return nil, fmt.Errorf("fake DeleteCluster cluster lost operation")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when DeleteCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build delete cluster task
+	task, err := mgr.BuildDeleteClusterTask(cls, opt)
+	if err != nil {
+		blog.Errorf("build DeleteCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// GetCluster get fake cluster detail information
+func (c *Cluster) GetCluster(cloudID string, opt *cloudprovider.GetClusterOption) (*proto.Cluster, error) {
+	fakeCls, err := api.GetSimulator().DescribeCluster(cloudID)
+	if err != nil {
+		return nil, err
+	}
+
+	cls := opt.Cluster
+	if cls == nil {
+		cls = &proto.Cluster{}
+	}
+	cls.SystemID = fakeCls.ClusterID
+	cls.Region = fakeCls.Region
+	cls.VpcID = fakeCls.VpcID
+	if cls.ClusterName == "" {
+		cls.ClusterName = fakeCls.ClusterName
+	}
+
+	return cls, nil
+}
+
+// ListCluster list fake clusters by region
+func (c *Cluster) ListCluster(opt *cloudprovider.ListClusterOption) ([]*proto.CloudClusterInfo, error) {
+	clusters, err := api.GetSimulator().ListClusters(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*proto.CloudClusterInfo, 0, len(clusters))
+	for _, cls := range clusters {
+		result = append(result, &proto.CloudClusterInfo{
+			ClusterID:      cls.ClusterID,
+			ClusterName:    cls.ClusterName,
+			ClusterVersion: cls.Version,
+			ClusterStatus:  cls.Status,
+			Location:       cls.Region,
+		})
+	}
+
+	return result, nil
+}
+
+// GetNodesInCluster get all nodes belong to fake cluster
+func (c *Cluster) GetNodesInCluster(cls *proto.Cluster, opt *cloudprovider.GetNodesOption) ([]*proto.Node, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("fake GetNodesInCluster cluster is empty")
+	}
+
+	instances, err := api.GetSimulator().ListInstances(cls.SystemID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*proto.Node, 0, len(instances))
+	for _, ins := range instances {
+		node := api.InstanceToNode(ins)
+		node.ClusterID = cls.ClusterID
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
+// AddNodesToCluster add new node to fake cluster
+func (c *Cluster) AddNodesToCluster(cls *proto.Cluster, nodes []*proto.Node,
+	opt *cloudprovider.AddNodesOption) ([]*proto.Task, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("fake AddNodesToCluster cluster is empty")
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("fake AddNodesToCluster nodes is empty")
+	}
+
+	if opt == nil || opt.Operator == "" || opt.Cloud == nil {
+		return nil, fmt.Errorf("fake AddNodesToCluster cluster lost operation|operator|cloud")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when AddNodesToCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build add nodes to cluster task
+	task, err := mgr.BuildAddNodesToClusterTask(cls, nodes, opt)
+	if err != nil {
+		blog.Errorf("build AddNodesToCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return []*proto.Task{task}, nil
+}
+
+// DeleteNodesFromCluster delete specified nodes from fake cluster
+func (c *Cluster) DeleteNodesFromCluster(cls *proto.Cluster, nodes []*proto.Node,
+	opt *cloudprovider.DeleteNodesOption) (*proto.Task, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("fake DeleteNodesFromCluster cluster is empty")
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("fake DeleteNodesFromCluster nodes is empty")
+	}
+
+	if opt == nil || opt.Operator == "" || opt.Cloud == nil {
+		return nil, fmt.Errorf("fake DeleteNodesFromCluster cluster lost operation")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when DeleteNodesFromCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build delete nodes from cluster task
+	task, err := mgr.BuildRemoveNodesFromClusterTask(cls, nodes, opt)
+	if err != nil {
+		blog.Errorf("build DeleteNodesFromCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// CheckClusterCidrAvailable check cluster CIDR nodesNum when add nodes
+func (c *Cluster) CheckClusterCidrAvailable(cls *proto.Cluster,
+	opt *cloudprovider.CheckClusterCIDROption) (bool, error) {
+	return true, nil
+}
+
+// AddSubnetsToCluster add subnets to cluster
+func (c *Cluster) AddSubnetsToCluster(ctx context.Context, subnet *proto.SubnetSource,
+	opt *cloudprovider.AddSubnetsToClusterOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// GetMasterSuggestedMachines get master suggested machines
+func (c *Cluster) GetMasterSuggestedMachines(level, vpcId string,
+	opt *cloudprovider.GetMasterSuggestedMachinesOption) ([]*proto.InstanceTemplateConfig, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// EnableExternalNodeSupport enable cluster support external node
+func (c *Cluster) EnableExternalNodeSupport(cls *proto.Cluster, opt *cloudprovider.EnableExternalNodeOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// ListOsImage list image os
+func (c *Cluster) ListOsImage(provider, clusterID string, opt *cloudprovider.CommonOption) ([]*proto.OsImage, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// ListProjects list cloud projects
+func (c *Cluster) ListProjects(opt *cloudprovider.CommonOption) ([]*proto.CloudProject, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// CheckClusterEndpointStatus check cluster endpoint status
+func (c *Cluster) CheckClusterEndpointStatus(clusterID string, isExtranet bool,
+	opt *cloudprovider.CheckEndpointStatusOption) (bool, error) {
+	return true, nil
+}
+
+// AppendCloudNodeInfo append cloud node detailed info
+func (c *Cluster) AppendCloudNodeInfo(ctx context.Context,
+	nodes []*proto.ClusterNode, opt *cloudprovider.CommonOption) error {
+	return nil
+}
+
+// CheckIfGetNodesFromCluster check cluster if can get nodes from k8s, fake cluster has no apiserver
+func (c *Cluster) CheckIfGetNodesFromCluster(ctx context.Context, cluster *proto.Cluster,
+	nodes []*proto.ClusterNode) bool {
+	return false
+}
+
+// SwitchClusterNetwork switch cluster network mode
+func (c *Cluster) SwitchClusterNetwork(
+	cls *proto.Cluster, subnet *proto.SubnetSource, opt *cloudprovider.SwitchClusterNetworkOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// CheckClusterNetworkStatus get cluster network
+func (c *Cluster) CheckClusterNetworkStatus(cloudID string,
+	opt *cloudprovider.CheckClusterNetworkStatusOption) (bool, error) {
+	return false, cloudprovider.ErrCloudNotImplemented
+}
+
+// UpdateCloudKubeConfig update cloud kube config
+func (c *Cluster) UpdateCloudKubeConfig(kubeConfig string,
+	opt *cloudprovider.UpdateCloudKubeConfigOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// CheckHighAvailabilityMasterNodes check master nodes high availability
+func (c *Cluster) CheckHighAvailabilityMasterNodes(cls *proto.Cluster, nodes []*proto.Node,
+	opt *cloudprovider.CheckHaMasterNodesOption) error {
+	return nil
+}
+
+// UpgradeClusterVersion upgrade cluster kubernetes version
+func (c *Cluster) UpgradeClusterVersion(cls *proto.Cluster, version string,
+	opt *cloudprovider.UpgradeClusterVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
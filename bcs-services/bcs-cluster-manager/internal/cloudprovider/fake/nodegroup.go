@@ -0,0 +1,278 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/fake/api"
+)
+
+func init() {
+	cloudprovider.InitNodeGroupManager(cloudName, &NodeGroup{})
+}
+
+// NodeGroup fake cloud node pool management
+type NodeGroup struct {
+}
+
+// CreateNodeGroup create node pool in fake cloud
+func (ng *NodeGroup) CreateNodeGroup(group *proto.NodeGroup, opt *cloudprovider.CreateNodeGroupOption) (
+	*proto.Task, error) {
+	if group == nil || opt == nil || opt.Cluster == nil {
+		return nil, fmt.Errorf("fake CreateNodeGroup lost group or cluster")
+	}
+	if opt.OnlyData {
+		return nil, nil
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(cloudName)
+	if err != nil {
+		return nil, err
+	}
+	task, err := mgr.BuildCreateNodeGroupTask(group, opt)
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// DeleteNodeGroup delete node pool in fake cloud, all instances in node pool are terminated
+func (ng *NodeGroup) DeleteNodeGroup(group *proto.NodeGroup, nodes []*proto.Node,
+	opt *cloudprovider.DeleteNodeGroupOption) (*proto.Task, error) {
+	if group == nil {
+		return nil, fmt.Errorf("fake DeleteNodeGroup lost group")
+	}
+	if opt == nil || opt.Cloud == nil || opt.Cluster == nil {
+		return nil, fmt.Errorf("fake DeleteNodeGroup lost cluster or cloud information")
+	}
+	if opt.OnlyData {
+		return nil, nil
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(cloudName)
+	if err != nil {
+		return nil, err
+	}
+	task, err := mgr.BuildDeleteNodeGroupTask(group, nodes, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// UpdateNodeGroup update specified nodegroup configuration, fake node pool only keeps data
+func (ng *NodeGroup) UpdateNodeGroup(
+	group *proto.NodeGroup, opt *cloudprovider.UpdateNodeGroupOption) (*proto.Task, error) {
+	if group == nil || opt == nil {
+		return nil, fmt.Errorf("UpdateNodeGroup group or opt is nil")
+	}
+
+	return nil, nil
+}
+
+// RecommendNodeGroupConf recommends nodegroup configs
+func (ng *NodeGroup) RecommendNodeGroupConf(
+	ctx context.Context, opt *cloudprovider.CommonOption) ([]*proto.RecommendNodeGroupConf, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// GetNodesInGroup get all nodes belong to fake node pool
+func (ng *NodeGroup) GetNodesInGroup(group *proto.NodeGroup, opt *cloudprovider.CommonOption) ([]*proto.Node, error) {
+	if group == nil || group.CloudNodeGroupID == "" {
+		return nil, fmt.Errorf("fake GetNodesInGroup lost cloud node pool")
+	}
+
+	pool, err := api.GetSimulator().DescribeNodePool(group.CloudNodeGroupID)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := api.GetSimulator().ListInstances(pool.ClusterID, pool.NodePoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*proto.Node, 0, len(instances))
+	for _, ins := range instances {
+		node := api.InstanceToNode(ins)
+		node.ClusterID = group.ClusterID
+		node.NodeGroupID = group.NodeGroupID
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
+// GetNodesInGroupV2 get all nodes belong to NodeGroup
+func (ng *NodeGroup) GetNodesInGroupV2(group *proto.NodeGroup,
+	opt *cloudprovider.CommonOption) ([]*proto.NodeGroupNode, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// MoveNodesToGroup add cluster nodes to NodeGroup
+func (ng *NodeGroup) MoveNodesToGroup(nodes []*proto.Node, group *proto.NodeGroup,
+	opt *cloudprovider.MoveNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// RemoveNodesFromGroup remove nodes from NodeGroup, nodes are still in cluster
+func (ng *NodeGroup) RemoveNodesFromGroup(nodes []*proto.Node, group *proto.NodeGroup,
+	opt *cloudprovider.RemoveNodesOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// CleanNodesInGroup clean specified nodes in fake node pool
+func (ng *NodeGroup) CleanNodesInGroup(nodes []*proto.Node, group *proto.NodeGroup,
+	opt *cloudprovider.CleanNodesOption) (*proto.Task, error) {
+	// validate request
+	if len(nodes) == 0 || group == nil {
+		return nil, fmt.Errorf("lost clean nodes or group")
+	}
+	if opt == nil || opt.Cluster == nil || opt.Cloud == nil {
+		return nil, fmt.Errorf("lost cluster or cloud information")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(cloudName)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when CleanNodesInGroup %s failed, %s",
+			cloudName, group.Name, err.Error())
+		return nil, err
+	}
+	task, err := mgr.BuildCleanNodesInGroupTask(nodes, group, opt)
+	if err != nil {
+		blog.Errorf("build CleanNodesInGroup task for cluster %s with cloudprovider %s failed, %s",
+			group.ClusterID, cloudName, err.Error())
+		return nil, err
+	}
+	return task, nil
+}
+
+// UpdateDesiredNodes update nodegroup desired node
+func (ng *NodeGroup) UpdateDesiredNodes(desired uint32, group *proto.NodeGroup,
+	opt *cloudprovider.UpdateDesiredNodeOption) (*cloudprovider.ScalingResponse, error) {
+	if group == nil || opt == nil || opt.Cluster == nil || opt.Cloud == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	// scaling nodes with desired, first get all node for status filtering
+	goodNodes, err := cloudprovider.ListNodesInClusterNodePool(opt.Cluster.ClusterID, group.NodeGroupID)
+	if err != nil {
+		blog.Errorf("cloudprovider fake get NodeGroup %s all Nodes failed, %s", group.NodeGroupID, err.Error())
+		return nil, err
+	}
+
+	// check incoming nodes
+	inComingNodes, err := cloudprovider.GetNodesNumWhenApplyInstanceTask(opt.Cluster.ClusterID, group.NodeGroupID,
+		cloudprovider.GetTaskType(cloudName, cloudprovider.UpdateNodeGroupDesiredNode),
+		cloudprovider.TaskStatusRunning, []string{applyInstancesStep.StepMethod})
+	if err != nil {
+		blog.Errorf("UpdateDesiredNodes GetNodesNumWhenApplyInstanceTask failed: %v", err)
+		return nil, err
+	}
+
+	// cluster current node
+	current := len(goodNodes) + inComingNodes
+
+	nodeNames := make([]string, 0)
+	for _, node := range goodNodes {
+		nodeNames = append(nodeNames, node.InnerIP)
+	}
+	blog.Infof("NodeGroup %s has total nodes %d, current capable nodes %d, current incoming nodes %d, "+
+		"desired nodes %d, details %v", group.NodeGroupID, len(goodNodes), current, inComingNodes, desired, nodeNames)
+
+	if current >= int(desired) {
+		blog.Infof("NodeGroup %s current capable nodes %d larger than desired %d nodes, nothing to do",
+			group.NodeGroupID, current, desired)
+		return &cloudprovider.ScalingResponse{
+			ScalingUp:    0,
+			CapableNodes: nodeNames,
+		}, fmt.Errorf("NodeGroup %s UpdateDesiredNodes nodes %d larger than desired %d nodes",
+			group.NodeGroupID, current, desired)
+	}
+
+	return &cloudprovider.ScalingResponse{
+		ScalingUp:    uint32(int(desired) - current),
+		CapableNodes: nodeNames,
+	}, nil
+}
+
+// SwitchNodeGroupAutoScaling switch nodegroup autoscaling
+func (ng *NodeGroup) SwitchNodeGroupAutoScaling(group *proto.NodeGroup, enable bool,
+	opt *cloudprovider.SwitchNodeGroupAutoScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// UpgradeNodeGroupVersion rolling replace nodegroup nodes
+func (ng *NodeGroup) UpgradeNodeGroupVersion(group *proto.NodeGroup,
+	opt *cloudprovider.UpgradeNodeGroupVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// CreateAutoScalingOption create cluster autoscaling option
+func (ng *NodeGroup) CreateAutoScalingOption(scalingOption *proto.ClusterAutoScalingOption,
+	opt *cloudprovider.CreateScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// DeleteAutoScalingOption delete cluster autoscaling
+func (ng *NodeGroup) DeleteAutoScalingOption(scalingOption *proto.ClusterAutoScalingOption,
+	opt *cloudprovider.DeleteScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// UpdateAutoScalingOption update cluster autoscaling option
+func (ng *NodeGroup) UpdateAutoScalingOption(scalingOption *proto.ClusterAutoScalingOption,
+	opt *cloudprovider.UpdateScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// SwitchAutoScalingOptionStatus switch cluster autoscaling option status
+func (ng *NodeGroup) SwitchAutoScalingOptionStatus(scalingOption *proto.ClusterAutoScalingOption, enable bool,
+	opt *cloudprovider.CommonOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// AddExternalNodeToCluster add external to cluster
+func (ng *NodeGroup) AddExternalNodeToCluster(group *proto.NodeGroup, nodes []*proto.Node,
+	opt *cloudprovider.AddExternalNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// DeleteExternalNodeFromCluster remove external node from cluster
+func (ng *NodeGroup) DeleteExternalNodeFromCluster(group *proto.NodeGroup, nodes []*proto.Node,
+	opt *cloudprovider.DeleteExternalNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// GetExternalNodeScript get nodegroup external node script
+func (ng *NodeGroup) GetExternalNodeScript(group *proto.NodeGroup, internal bool) (string, error) {
+	return "", cloudprovider.ErrCloudNotImplemented
+}
+
+// CheckResourcePoolQuota check resource pool quota when revise group limit
+func (ng *NodeGroup) CheckResourcePoolQuota(
+	ctx context.Context, group *proto.NodeGroup, operation string, scaleUpNum uint32) error {
+	return nil
+}
+
+// GetProjectResourceQuota get project resource quota
+func (ng *NodeGroup) GetProjectResourceQuota(groups []*proto.NodeGroup, resourcePoolType string,
+	opt *cloudprovider.CommonOption) ([]*proto.ProjectAutoscalerQuota, error) {
+	return nil, nil
+}
@@ -0,0 +1,166 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/fake/api"
+)
+
+func init() {
+	cloudprovider.InitNodeManager(cloudName, &NodeManager{})
+}
+
+// NodeManager fake cloud instance relative API management
+type NodeManager struct {
+}
+
+// GetNodeByIP get specified Node by innerIP address
+func (nm *NodeManager) GetNodeByIP(ip string, opt *cloudprovider.GetNodeOption) (*proto.Node, error) {
+	nodes, err := nm.ListNodesByIP([]string{ip}, &cloudprovider.ListNodesOption{
+		Common:       opt.Common,
+		ClusterVPCID: opt.ClusterVPCID,
+		ClusterID:    opt.ClusterID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("fake instance %s not found", ip)
+	}
+
+	return nodes[0], nil
+}
+
+// ListNodesByIP list node by IP set, unknown ip is registered as idle instance in simulator
+func (nm *NodeManager) ListNodesByIP(ips []string, opt *cloudprovider.ListNodesOption) ([]*proto.Node, error) {
+	region := ""
+	if opt != nil && opt.Common != nil {
+		region = opt.Common.Region
+	}
+
+	instances, err := api.GetSimulator().DescribeInstancesByIP(region, ips)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*proto.Node, 0, len(instances))
+	for _, ins := range instances {
+		nodes = append(nodes, api.InstanceToNode(ins))
+	}
+
+	return nodes, nil
+}
+
+// GetExternalNodeByIP get specified Node by innerIP address
+func (nm *NodeManager) GetExternalNodeByIP(ip string, opt *cloudprovider.GetNodeOption) (*proto.Node, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// ListExternalNodesByIP list node by IP set
+func (nm *NodeManager) ListExternalNodesByIP(ips []string, opt *cloudprovider.ListNodesOption) ([]*proto.Node, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// GetCVMImageIDByImageName get imageID by imageName
+func (nm *NodeManager) GetCVMImageIDByImageName(imageName string, opt *cloudprovider.CommonOption) (string, error) {
+	return "", cloudprovider.ErrCloudNotImplemented
+}
+
+// GetCloudRegions get cloud regions
+func (nm *NodeManager) GetCloudRegions(opt *cloudprovider.CommonOption) ([]*proto.RegionInfo, error) {
+	return []*proto.RegionInfo{
+		{
+			Region:      api.DefaultRegion,
+			RegionName:  api.DefaultRegion,
+			RegionState: "AVAILABLE",
+		},
+	}, nil
+}
+
+// GetZoneList get zoneList by region
+func (nm *NodeManager) GetZoneList(opt *cloudprovider.GetZoneListOption) ([]*proto.ZoneInfo, error) {
+	zones := api.GetSimulator().Zones(opt.Region)
+
+	zoneInfos := make([]*proto.ZoneInfo, 0, len(zones))
+	for _, zone := range zones {
+		zoneInfos = append(zoneInfos, &proto.ZoneInfo{
+			ZoneID:    zone,
+			Zone:      zone,
+			ZoneName:  zone,
+			ZoneState: "AVAILABLE",
+		})
+	}
+
+	return zoneInfos, nil
+}
+
+// ListNodeInstanceType list node type by zone and node family
+func (nm *NodeManager) ListNodeInstanceType(ctx context.Context, info cloudprovider.InstanceInfo,
+	opt *cloudprovider.CommonOption) ([]*proto.InstanceType, error) {
+	return []*proto.InstanceType{
+		{
+			NodeType:   api.DefaultInstanceType,
+			TypeName:   api.DefaultInstanceType,
+			NodeFamily: "FAKE",
+			Cpu:        4,
+			Memory:     8,
+			Status:     "SELL",
+			Zones:      api.GetSimulator().Zones(info.Region),
+			Provider:   cloudName,
+			Region:     info.Region,
+		},
+	}, nil
+}
+
+// ListDiskTypes get disk type list
+func (nm *NodeManager) ListDiskTypes(instanceTypes []string, zones []string, diskChargeType string, cpu, memory uint64,
+	opt *cloudprovider.CommonOption) ([]*proto.DiskConfigSet, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// ListOsImage list image os
+func (nm *NodeManager) ListOsImage(provider string, opt *cloudprovider.CommonOption) ([]*proto.OsImage, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// ListKeyPairs keyPairs list
+func (nm *NodeManager) ListKeyPairs(opt *cloudprovider.ListNetworksOption) ([]*proto.KeyPair, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// GetResourceGroups resource groups list
+func (nm *NodeManager) GetResourceGroups(opt *cloudprovider.CommonOption) ([]*proto.ResourceGroupInfo, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// ListRuntimeInfo get runtime info list
+func (nm *NodeManager) ListRuntimeInfo(opt *cloudprovider.ListRuntimeInfoOption) (map[string][]string, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// GetServiceRoles service roles list
+func (nm *NodeManager) GetServiceRoles(opt *cloudprovider.CommonOption, roleType string) (
+	[]*proto.ServiceRoleInfo, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// ListNodePublicPrefixs node public prefixs list
+func (nm *NodeManager) ListNodePublicPrefixs(opt *cloudprovider.ListNodePublicPrefixesOption) (
+	[]*proto.NodePublicPrefix, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
@@ -0,0 +1,473 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/fake/tasks"
+)
+
+var taskMgr sync.Once
+
+func init() {
+	taskMgr.Do(func() {
+		cloudprovider.InitTaskManager(cloudName, newtask())
+	})
+}
+
+func newtask() *Task {
+	task := &Task{
+		works: make(map[string]interface{}),
+	}
+
+	// create cluster task
+	task.works[createClusterStep.StepMethod] = tasks.CreateClusterTask
+	task.works[checkClusterStatusStep.StepMethod] = tasks.CheckClusterStatusTask
+	task.works[updateCreateClusterDBInfoStep.StepMethod] = tasks.UpdateCreateClusterDBInfoTask
+
+	// delete cluster task
+	task.works[deleteClusterStep.StepMethod] = tasks.DeleteClusterTask
+	task.works[cleanClusterDBInfoStep.StepMethod] = tasks.CleanClusterDBInfoTask
+
+	// add node to cluster
+	task.works[addNodesToClusterStep.StepMethod] = tasks.AddNodesToClusterTask
+	task.works[checkNodesStatusStep.StepMethod] = tasks.CheckNodesStatusTask
+
+	// remove node from cluster
+	task.works[removeNodesFromClusterStep.StepMethod] = tasks.RemoveNodesFromClusterTask
+
+	// create/delete node pool
+	task.works[createNodePoolStep.StepMethod] = tasks.CreateNodePoolTask
+	task.works[deleteNodePoolStep.StepMethod] = tasks.DeleteNodePoolTask
+
+	// scale out/in node pool
+	task.works[applyInstancesStep.StepMethod] = tasks.ApplyInstancesTask
+	task.works[cleanNodeGroupNodesStep.StepMethod] = tasks.CleanNodeGroupNodesTask
+
+	return task
+}
+
+// Task background task manager
+type Task struct {
+	works map[string]interface{}
+}
+
+// Name get task cloudName
+func (t *Task) Name() string {
+	return cloudName
+}
+
+// GetAllTask register all backgroup task for worker running
+func (t *Task) GetAllTask() map[string]interface{} {
+	return t.works
+}
+
+// initTask init task basic information
+func initTask(taskType, taskName, clusterID, projectID, operator string) *proto.Task {
+	nowStr := time.Now().Format(time.RFC3339)
+	return &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       taskType,
+		TaskName:       taskName,
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      clusterID,
+		ProjectID:      projectID,
+		Creator:        operator,
+		Updater:        operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+	}
+}
+
+// nodesIPsAndIDs get nodes innerIPs and instanceIDs
+func nodesIPsAndIDs(nodes []*proto.Node) ([]string, []string) {
+	nodeIPs, nodeIDs := make([]string, 0, len(nodes)), make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		nodeIPs = append(nodeIPs, node.InnerIP)
+		nodeIDs = append(nodeIDs, node.NodeID)
+	}
+
+	return nodeIPs, nodeIDs
+}
+
+// BuildCreateClusterTask build create cluster task
+func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.CreateClusterOption) (
+	*proto.Task, error) {
+	// create cluster has four steps:
+	// 1. create cluster in fake cloud
+	// 2. wait cluster running
+	// 3. add worker nodes to cluster if exist
+	// 4. update cluster DB info
+	if cls == nil {
+		return nil, fmt.Errorf("BuildCreateClusterTask cluster info empty")
+	}
+	if opt == nil || opt.Cloud == nil || opt.Operator == "" {
+		return nil, fmt.Errorf("BuildCreateClusterTask TaskOptions is lost")
+	}
+
+	task := initTask(cloudprovider.GetTaskType(cloudName, cloudprovider.CreateCluster),
+		cloudprovider.CreateClusterTask.String(), cls.ClusterID, cls.ProjectID, opt.Operator)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = fmt.Sprintf(createClusterTaskTemplate, cls.ClusterID)
+
+	createClusterTask := &CreateClusterTaskOption{Cluster: cls, WorkerNodes: opt.WorkerNodes}
+	createClusterTask.BuildCreateClusterStep(task)
+	createClusterTask.BuildCheckClusterStatusStep(task)
+	createClusterTask.BuildAddNodesToClusterStep(task)
+	createClusterTask.BuildUpdateClusterDbInfoStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildCreateClusterTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.NodeIPList = opt.WorkerNodes
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.CreateClusterJob.String()
+
+	return task, nil
+}
+
+// BuildImportClusterTask build import cluster task
+func (t *Task) BuildImportClusterTask(cls *proto.Cluster, opt *cloudprovider.ImportClusterOption) (
+	*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildCreateVirtualClusterTask build create virtual cluster task
+func (t *Task) BuildCreateVirtualClusterTask(cls *proto.Cluster,
+	opt *cloudprovider.CreateVirtualClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildDeleteVirtualClusterTask build delete virtual cluster task
+func (t *Task) BuildDeleteVirtualClusterTask(cls *proto.Cluster,
+	opt *cloudprovider.DeleteVirtualClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildDeleteClusterTask build delete cluster task
+func (t *Task) BuildDeleteClusterTask(cls *proto.Cluster, opt *cloudprovider.DeleteClusterOption) (
+	*proto.Task, error) {
+	// delete cluster has two steps:
+	// 1. delete cluster in fake cloud
+	// 2. clean cluster DB info
+	if cls == nil {
+		return nil, fmt.Errorf("BuildDeleteClusterTask cluster info empty")
+	}
+	if opt == nil || opt.Operator == "" || opt.Cloud == nil {
+		return nil, fmt.Errorf("BuildDeleteClusterTask TaskOptions is lost")
+	}
+
+	task := initTask(cloudprovider.GetTaskType(cloudName, cloudprovider.DeleteCluster),
+		cloudprovider.DeleteClusterTask.String(), cls.ClusterID, cls.ProjectID, opt.Operator)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = fmt.Sprintf(deleteClusterTaskTemplate, cls.ClusterID)
+
+	deleteClusterTask := &DeleteClusterTaskOption{Cluster: cls}
+	deleteClusterTask.BuildDeleteClusterStep(task)
+	deleteClusterTask.BuildCleanClusterDbInfoStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildDeleteClusterTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.DeleteClusterJob.String()
+
+	return task, nil
+}
+
+// BuildAddNodesToClusterTask build add nodes to cluster task
+func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Node,
+	opt *cloudprovider.AddNodesOption) (*proto.Task, error) {
+	// add nodes has two steps:
+	// 1. add instances to fake cluster
+	// 2. wait nodes running and update node status
+	if cls == nil {
+		return nil, fmt.Errorf("BuildAddNodesToClusterTask cluster info empty")
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("BuildAddNodesToClusterTask nodes info empty")
+	}
+	if opt == nil || opt.Operator == "" || opt.Cloud == nil {
+		return nil, fmt.Errorf("BuildAddNodesToClusterTask TaskOptions is lost")
+	}
+
+	nodeIPs, nodeIDs := nodesIPsAndIDs(nodes)
+
+	task := initTask(cloudprovider.GetTaskType(cloudName, cloudprovider.AddNodesToCluster),
+		cloudprovider.AddNodesToClusterTask.String(), cls.ClusterID, cls.ProjectID, opt.Operator)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = fmt.Sprintf(addClusterNodesTaskTemplate, cls.ClusterID)
+
+	addNodesTask := &AddNodesTaskOption{Cluster: cls, NodeIPs: nodeIPs}
+	addNodesTask.BuildAddNodesToClusterStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildAddNodesToClusterTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.NodeIPList = nodeIPs
+	task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+	task.CommonParams[cloudprovider.NodeIDsKey.String()] = strings.Join(nodeIDs, ",")
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.AddNodeJob.String()
+
+	return task, nil
+}
+
+// BuildRemoveNodesFromClusterTask build remove nodes from cluster task
+func (t *Task) BuildRemoveNodesFromClusterTask(cls *proto.Cluster, nodes []*proto.Node,
+	opt *cloudprovider.DeleteNodesOption) (*proto.Task, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask cluster info empty")
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask nodes info empty")
+	}
+	if opt == nil || opt.Operator == "" || opt.Cloud == nil {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask TaskOptions is lost")
+	}
+
+	nodeIPs, nodeIDs := nodesIPsAndIDs(nodes)
+
+	task := initTask(cloudprovider.GetTaskType(cloudName, cloudprovider.RemoveNodesFromCluster),
+		cloudprovider.RemoveNodesFromClusterTask.String(), cls.ClusterID, cls.ProjectID, opt.Operator)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = fmt.Sprintf(deleteClusterNodesTaskTemplate, cls.ClusterID)
+
+	removeNodesTask := &RemoveNodesTaskOption{Cluster: cls, NodeIPs: nodeIPs, NodeIDs: nodeIDs}
+	removeNodesTask.BuildRemoveNodesFromClusterStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.NodeIPList = nodeIPs
+	task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+	task.CommonParams[cloudprovider.NodeIDsKey.String()] = strings.Join(nodeIDs, ",")
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.DeleteNodeJob.String()
+
+	return task, nil
+}
+
+// BuildCreateNodeGroupTask build create node group task
+func (t *Task) BuildCreateNodeGroupTask(group *proto.NodeGroup, opt *cloudprovider.CreateNodeGroupOption) (
+	*proto.Task, error) {
+	if group == nil {
+		return nil, fmt.Errorf("BuildCreateNodeGroupTask group info empty")
+	}
+	if opt == nil || opt.Cluster == nil {
+		return nil, fmt.Errorf("BuildCreateNodeGroupTask TaskOptions is lost option or cluster")
+	}
+
+	task := initTask(cloudprovider.GetTaskType(cloudName, cloudprovider.CreateNodeGroup),
+		cloudprovider.CreateNodeGroupTask.String(), group.ClusterID, group.ProjectID, group.Creator)
+	task.NodeGroupID = group.NodeGroupID
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = fmt.Sprintf(createNodeGroupTaskTemplate,
+		group.ClusterID, group.Name)
+
+	createNodeGroupTask := &CreateNodeGroupTaskOption{Group: group, Cluster: opt.Cluster}
+	createNodeGroupTask.BuildCreateNodePoolStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildCreateNodeGroupTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.CreateNodeGroupJob.String()
+
+	return task, nil
+}
+
+// BuildDeleteNodeGroupTask build delete node group task, all instances in node pool are terminated
+func (t *Task) BuildDeleteNodeGroupTask(group *proto.NodeGroup, nodes []*proto.Node,
+	opt *cloudprovider.DeleteNodeGroupOption) (*proto.Task, error) {
+	if group == nil {
+		return nil, fmt.Errorf("BuildDeleteNodeGroupTask group info empty")
+	}
+	if opt == nil || len(opt.Operator) == 0 || opt.Cloud == nil || opt.Cluster == nil {
+		return nil, fmt.Errorf("BuildDeleteNodeGroupTask TaskOptions is lost")
+	}
+
+	nodeIPs, nodeIDs := nodesIPsAndIDs(nodes)
+
+	task := initTask(cloudprovider.GetTaskType(cloudName, cloudprovider.DeleteNodeGroup),
+		cloudprovider.DeleteNodeGroupTask.String(), group.ClusterID, group.ProjectID, opt.Operator)
+	task.NodeGroupID = group.NodeGroupID
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = fmt.Sprintf(deleteNodeGroupTaskTemplate,
+		group.ClusterID, group.Name)
+
+	deleteNodeGroupTask := &DeleteNodeGroupTaskOption{Group: group, Cluster: opt.Cluster}
+	deleteNodeGroupTask.BuildDeleteNodePoolStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildDeleteNodeGroupTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.DeleteNodeGroupJob.String()
+	task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+	task.CommonParams[cloudprovider.NodeIDsKey.String()] = strings.Join(nodeIDs, ",")
+
+	return task, nil
+}
+
+// BuildMoveNodesToGroupTask build move nodes to group task
+func (t *Task) BuildMoveNodesToGroupTask(nodes []*proto.Node, group *proto.NodeGroup,
+	opt *cloudprovider.MoveNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildCleanNodesInGroupTask build clean nodes in group task
+func (t *Task) BuildCleanNodesInGroupTask(nodes []*proto.Node, group *proto.NodeGroup,
+	opt *cloudprovider.CleanNodesOption) (*proto.Task, error) {
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("BuildCleanNodesInGroupTask nodes info empty")
+	}
+	if group == nil {
+		return nil, fmt.Errorf("BuildCleanNodesInGroupTask group info empty")
+	}
+	if opt == nil || len(opt.Operator) == 0 || opt.Cluster == nil {
+		return nil, fmt.Errorf("BuildCleanNodesInGroupTask TaskOptions is lost")
+	}
+
+	nodeIPs, nodeIDs := nodesIPsAndIDs(nodes)
+
+	task := initTask(cloudprovider.GetTaskType(cloudName, cloudprovider.CleanNodeGroupNodes),
+		cloudprovider.CleanNodesInGroupTask.String(), group.ClusterID, group.ProjectID, opt.Operator)
+	task.NodeGroupID = group.NodeGroupID
+	task.NodeIPList = nodeIPs
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = fmt.Sprintf(cleanNodeGroupNodesTaskTemplate,
+		group.ClusterID, group.Name)
+
+	cleanNodesTask := &CleanNodesInGroupTaskOption{Group: group, Cluster: opt.Cluster,
+		NodeIPs: nodeIPs, NodeIDs: nodeIDs}
+	cleanNodesTask.BuildCleanNodeGroupNodesStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildCleanNodesInGroupTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+	task.CommonParams[cloudprovider.NodeIDsKey.String()] = strings.Join(nodeIDs, ",")
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.CleanNodeGroupNodesJob.String()
+
+	return task, nil
+}
+
+// BuildUpdateDesiredNodesTask build update desired nodes task
+func (t *Task) BuildUpdateDesiredNodesTask(desired uint32, group *proto.NodeGroup,
+	opt *cloudprovider.UpdateDesiredNodeOption) (*proto.Task, error) {
+	// update desired nodes has two steps:
+	// 1. scale out node pool and save nodes
+	// 2. wait instances running and update node status
+	if desired == 0 {
+		return nil, fmt.Errorf("BuildUpdateDesiredNodesTask desired nodes is zero")
+	}
+	if group == nil {
+		return nil, fmt.Errorf("BuildUpdateDesiredNodesTask group info is empty")
+	}
+	if opt == nil || len(opt.Operator) == 0 || opt.Cluster == nil {
+		return nil, fmt.Errorf("BuildUpdateDesiredNodesTask TaskOptions is lost")
+	}
+
+	task := initTask(cloudprovider.GetTaskType(cloudName, cloudprovider.UpdateNodeGroupDesiredNode),
+		cloudprovider.UpdateDesiredNodesTask.String(), group.ClusterID, group.ProjectID, opt.Operator)
+	task.NodeGroupID = group.NodeGroupID
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = fmt.Sprintf(updateNodeGroupDesiredNodeTemplate,
+		group.ClusterID, group.Name)
+
+	updateDesiredNodesTask := &UpdateDesiredNodesTaskOption{Group: group, Cluster: opt.Cluster,
+		Desired: desired, Operator: opt.Operator}
+	updateDesiredNodesTask.BuildApplyInstancesStep(task)
+	updateDesiredNodesTask.BuildCheckNodesStatusStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildUpdateDesiredNodesTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.ClusterIDKey.String()] = group.ClusterID
+	task.CommonParams[cloudprovider.ScalingNodesNumKey.String()] = strconv.Itoa(int(desired))
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.UpdateNodeGroupDesiredNodeJob.String()
+	task.CommonParams[cloudprovider.ManualKey.String()] = strconv.FormatBool(opt.Manual)
+
+	return task, nil
+}
+
+// BuildSwitchNodeGroupAutoScalingTask build switch node group autoscaling task
+func (t *Task) BuildSwitchNodeGroupAutoScalingTask(group *proto.NodeGroup, enable bool,
+	opt *cloudprovider.SwitchNodeGroupAutoScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildUpdateAutoScalingOptionTask build update autoscaling option task
+func (t *Task) BuildUpdateAutoScalingOptionTask(scalingOption *proto.ClusterAutoScalingOption,
+	opt *cloudprovider.UpdateScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildSwitchAsOptionStatusTask build switch autoscaling option status task
+func (t *Task) BuildSwitchAsOptionStatusTask(scalingOption *proto.ClusterAutoScalingOption, enable bool,
+	opt *cloudprovider.CommonOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildUpdateNodeGroupTask build update node group task
+func (t *Task) BuildUpdateNodeGroupTask(group *proto.NodeGroup, opt *cloudprovider.CommonOption) (
+	*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildUpgradeNodeGroupVersionTask build upgrade node group version task
+func (t *Task) BuildUpgradeNodeGroupVersionTask(group *proto.NodeGroup,
+	opt *cloudprovider.UpgradeNodeGroupVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildSwitchClusterNetworkTask build switch cluster network task
+func (t *Task) BuildSwitchClusterNetworkTask(cls *proto.Cluster, subnet *proto.SubnetSource,
+	opt *cloudprovider.SwitchClusterNetworkOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildUpgradeClusterVersionTask build upgrade cluster version task
+func (t *Task) BuildUpgradeClusterVersionTask(cls *proto.Cluster, version string,
+	opt *cloudprovider.UpgradeClusterVersionOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildAddExternalNodeToCluster build add external nodes task
+func (t *Task) BuildAddExternalNodeToCluster(group *proto.NodeGroup, nodes []*proto.Node,
+	opt *cloudprovider.AddExternalNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildDeleteExternalNodeFromCluster build delete external nodes task
+func (t *Task) BuildDeleteExternalNodeFromCluster(group *proto.NodeGroup, nodes []*proto.Node,
+	opt *cloudprovider.DeleteExternalNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
@@ -0,0 +1,275 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	"google.golang.org/protobuf/proto"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/fake/api"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+// memModel 内存存储, 只实现创建集群任务流程用到的接口
+type memModel struct {
+	store.ClusterManagerModel
+
+	lock     sync.Mutex
+	tasks    map[string]*cmproto.Task
+	clusters map[string]*cmproto.Cluster
+	clouds   map[string]*cmproto.Cloud
+}
+
+func newMemModel() *memModel {
+	return &memModel{
+		tasks:    make(map[string]*cmproto.Task),
+		clusters: make(map[string]*cmproto.Cluster),
+		clouds:   make(map[string]*cmproto.Cloud),
+	}
+}
+
+func (m *memModel) GetTask(ctx context.Context, taskID string) (*cmproto.Task, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	task, ok := m.tasks[taskID]
+	if !ok {
+		return nil, fmt.Errorf("task %s not found", taskID)
+	}
+	return proto.Clone(task).(*cmproto.Task), nil
+}
+
+func (m *memModel) UpdateTask(ctx context.Context, task *cmproto.Task) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.tasks[task.TaskID] = proto.Clone(task).(*cmproto.Task)
+	return nil
+}
+
+func (m *memModel) GetCluster(ctx context.Context, clusterID string) (*cmproto.Cluster, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	cls, ok := m.clusters[clusterID]
+	if !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	return proto.Clone(cls).(*cmproto.Cluster), nil
+}
+
+func (m *memModel) UpdateCluster(ctx context.Context, cluster *cmproto.Cluster) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.clusters[cluster.ClusterID] = proto.Clone(cluster).(*cmproto.Cluster)
+	return nil
+}
+
+func (m *memModel) GetCloud(ctx context.Context, cloudID string) (*cmproto.Cloud, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	cloud, ok := m.clouds[cloudID]
+	if !ok {
+		return nil, fmt.Errorf("cloud %s not found", cloudID)
+	}
+	return proto.Clone(cloud).(*cmproto.Cloud), nil
+}
+
+func (m *memModel) ListNotifyTemplate(ctx context.Context, cond *operator.Condition,
+	opt *storeopt.ListOption) ([]*cmproto.NotifyTemplate, error) {
+	return nil, nil
+}
+
+func (m *memModel) CreateTaskStepLogInfo(ctx context.Context, taskID, stepName, message string) {}
+
+func (m *memModel) CreateTaskStepLogWarn(ctx context.Context, taskID, stepName, message string) {}
+
+func (m *memModel) CreateTaskStepLogError(ctx context.Context, taskID, stepName, message string) {}
+
+// setupCreateClusterTask 初始化存储和模拟云, 构建创建集群任务
+func setupCreateClusterTask(t *testing.T, maxRetry uint32, cfg api.Config) (*memModel, *cmproto.Task) {
+	model := newMemModel()
+	cloudprovider.InitStorageModel(model)
+	api.GetSimulator().Reset()
+	api.GetSimulator().SetConfig(cfg)
+
+	cloud := &cmproto.Cloud{CloudID: "fake-cloud", CloudProvider: cloudName, ConfInfo: &cmproto.CloudConfigInfo{}}
+	cls := &cmproto.Cluster{
+		ClusterID:   "BCS-K8S-00001",
+		ClusterName: "e2e",
+		Provider:    cloud.CloudID,
+		Region:      api.DefaultRegion,
+		ProjectID:   "project",
+		ClusterBasicSettings: &cmproto.ClusterBasicSetting{
+			Version: "1.28.3",
+		},
+	}
+	model.clouds[cloud.CloudID] = cloud
+	model.clusters[cls.ClusterID] = cls
+
+	task, err := newtask().BuildCreateClusterTask(cls, &cloudprovider.CreateClusterOption{
+		Cloud:    cloud,
+		Operator: "admin",
+	})
+	if err != nil {
+		t.Fatalf("BuildCreateClusterTask failed: %v", err)
+	}
+	for _, step := range task.Steps {
+		step.MaxRetry = maxRetry
+	}
+	if err = model.UpdateTask(context.Background(), task); err != nil {
+		t.Fatal(err)
+	}
+	return model, task
+}
+
+// runTask 按步骤顺序执行任务, 步骤失败时按 MaxRetry 重试, 与 taskserver 下发的任务链一致
+func runTask(t *testing.T, task *cmproto.Task) error {
+	works := newtask().GetAllTask()
+	for _, name := range task.StepSequence {
+		work, ok := works[task.Steps[name].TaskMethod].(func(string, string) error)
+		if !ok {
+			t.Fatalf("step %s method not registered", name)
+		}
+		var err error
+		for i := uint32(0); i <= task.Steps[name].MaxRetry; i++ {
+			if err = work(task.TaskID, name); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getTask(t *testing.T, model *memModel, taskID string) *cmproto.Task {
+	task, err := model.GetTask(context.Background(), taskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return task
+}
+
+func TestCreateClusterTaskStepRetry(t *testing.T) {
+	model, task := setupCreateClusterTask(t, 2, api.Config{
+		Failures: []api.FailureRule{{Action: api.ActionCreateCluster, Times: 2}},
+	})
+
+	if err := runTask(t, task); err != nil {
+		t.Fatalf("run task failed: %v", err)
+	}
+
+	task = getTask(t, model, task.TaskID)
+	if task.Status != cloudprovider.TaskStatusSuccess {
+		t.Fatalf("task status = %s, want %s, message: %s", task.Status, cloudprovider.TaskStatusSuccess, task.Message)
+	}
+	if retry := task.Steps[createClusterStep.StepMethod].Retry; retry != 2 {
+		t.Errorf("create cluster step retry = %d, want 2", retry)
+	}
+	if count := api.GetSimulator().CallCount(api.ActionCreateCluster); count != 3 {
+		t.Errorf("CreateCluster call count = %d, want 3", count)
+	}
+	if task.CommonParams[cloudprovider.CloudSystemID.String()] == "" {
+		t.Errorf("cloud system id not set in task common params")
+	}
+}
+
+func TestCreateClusterTaskRetryExhausted(t *testing.T) {
+	model, task := setupCreateClusterTask(t, 1, api.Config{
+		Failures: []api.FailureRule{{Action: api.ActionCreateCluster, Times: 2}},
+	})
+
+	if err := runTask(t, task); err == nil {
+		t.Fatalf("expect task failed")
+	}
+	failed := getTask(t, model, task.TaskID)
+	if failed.Status != cloudprovider.TaskStatusFailure ||
+		failed.CurrentStep != createClusterStep.StepMethod {
+		t.Fatalf("task status = %s, current step = %s", failed.Status, failed.CurrentStep)
+	}
+	if step := failed.Steps[createClusterStep.StepMethod]; step.Status != cloudprovider.TaskStatusFailure ||
+		step.Retry != 1 {
+		t.Fatalf("create cluster step status = %s, retry = %d", step.Status, step.Retry)
+	}
+
+	// 手动重试任务, 与 RetryAction 一致: 任务置为运行中并重置当前步骤的重试次数
+	failed.Status = cloudprovider.TaskStatusRunning
+	failed.Steps[failed.CurrentStep].Retry = 0
+	if err := model.UpdateTask(context.Background(), failed); err != nil {
+		t.Fatal(err)
+	}
+	if err := runTask(t, failed); err != nil {
+		t.Fatalf("retry task failed: %v", err)
+	}
+	if status := getTask(t, model, task.TaskID).Status; status != cloudprovider.TaskStatusSuccess {
+		t.Fatalf("task status = %s after retry, want %s", status, cloudprovider.TaskStatusSuccess)
+	}
+	clusters, err := api.GetSimulator().ListClusters("")
+	if err != nil || len(clusters) != 1 {
+		t.Fatalf("fake cloud clusters = %d, err = %v, want 1", len(clusters), err)
+	}
+}
+
+func TestCreateClusterTaskSkipStep(t *testing.T) {
+	model, task := setupCreateClusterTask(t, 0, api.Config{})
+
+	// 创建集群后模拟云上集群被删除, 检测集群状态步骤失败
+	works := newtask().GetAllTask()
+	createWork := works[createClusterStep.StepMethod].(func(string, string) error)
+	if err := createWork(task.TaskID, createClusterStep.StepMethod); err != nil {
+		t.Fatalf("create cluster step failed: %v", err)
+	}
+	systemID := getTask(t, model, task.TaskID).CommonParams[cloudprovider.CloudSystemID.String()]
+	if err := api.GetSimulator().DeleteCluster(systemID); err != nil {
+		t.Fatal(err)
+	}
+	if err := runTask(t, task); err == nil {
+		t.Fatalf("expect check cluster status step failed")
+	}
+	failed := getTask(t, model, task.TaskID)
+	if failed.Status != cloudprovider.TaskStatusFailure ||
+		failed.CurrentStep != checkClusterStatusStep.StepMethod {
+		t.Fatalf("task status = %s, current step = %s", failed.Status, failed.CurrentStep)
+	}
+	if !failed.Steps[failed.CurrentStep].AllowSkip {
+		t.Fatalf("check cluster status step should allow skip")
+	}
+
+	// 跳过失败步骤, 与 SkipAction 一致: 任务置为运行中并将当前步骤置为跳过
+	failed.Status = cloudprovider.TaskStatusRunning
+	failed.Steps[failed.CurrentStep].Status = cloudprovider.TaskStatusSkip
+	if err := model.UpdateTask(context.Background(), failed); err != nil {
+		t.Fatal(err)
+	}
+	if err := runTask(t, failed); err != nil {
+		t.Fatalf("run task after skip failed: %v", err)
+	}
+
+	task = getTask(t, model, task.TaskID)
+	if task.Status != cloudprovider.TaskStatusSuccess {
+		t.Fatalf("task status = %s after skip, want %s", task.Status, cloudprovider.TaskStatusSuccess)
+	}
+	if status := task.Steps[checkClusterStatusStep.StepMethod].Status; status != cloudprovider.TaskStatusSkip {
+		t.Errorf("check cluster status step status = %s, want %s", status, cloudprovider.TaskStatusSkip)
+	}
+	if status := task.Steps[updateCreateClusterDBInfoStep.StepMethod].Status; status != cloudprovider.TaskStatusSuccess {
+		t.Errorf("update cluster db info step status = %s, want %s", status, cloudprovider.TaskStatusSuccess)
+	}
+}
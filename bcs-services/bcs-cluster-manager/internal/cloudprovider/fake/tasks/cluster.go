@@ -0,0 +1,333 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tasks xxx
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/fake/api"
+	icommon "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/loop"
+)
+
+const (
+	// defaultCheckTimeout wait cloud resource running timeout
+	defaultCheckTimeout = 10 * time.Minute
+	// defaultCheckInterval check cloud resource status interval
+	defaultCheckInterval = time.Second
+)
+
+// CreateClusterTask create cluster in fake cloud
+func CreateClusterTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CreateClusterTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CreateClusterTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("CreateClusterTask[%s]: GetClusterDependBasicInfo for cluster %s failed: %v",
+			taskID, clusterID, err)
+		retErr := fmt.Errorf("get cloud/cluster information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	systemID, err := createFakeCluster(taskID, dependInfo)
+	if err != nil {
+		cloudprovider.GetStorageModel().CreateTaskStepLogError(context.Background(), taskID, stepName,
+			fmt.Sprintf("create cluster failed [%s]", err))
+		blog.Errorf("CreateClusterTask[%s]: create fake cluster %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("create fake cluster failed, %s", err.Error())
+		_ = state.UpdateStepRetryOrFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	err = cloudprovider.UpdateClusterSystemID(clusterID, systemID)
+	if err != nil {
+		blog.Errorf("CreateClusterTask[%s]: update cluster %s systemID %s failed: %v",
+			taskID, clusterID, systemID, err)
+		retErr := fmt.Errorf("update cluster systemID failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		fmt.Sprintf("create cluster %s successful", systemID))
+
+	// update response information to task common params
+	if state.Task.CommonParams == nil {
+		state.Task.CommonParams = make(map[string]string)
+	}
+	state.Task.CommonParams[cloudprovider.CloudSystemID.String()] = systemID
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CreateClusterTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// createFakeCluster create fake cluster, reuse created cluster when retry task
+func createFakeCluster(taskID string, info *cloudprovider.CloudDependBasicInfo) (string, error) {
+	cls := info.Cluster
+	if cls.SystemID != "" {
+		_, err := api.GetSimulator().DescribeCluster(cls.SystemID)
+		if err == nil {
+			blog.Infof("createFakeCluster[%s] cluster %s already created %s", taskID, cls.ClusterID, cls.SystemID)
+			return cls.SystemID, nil
+		}
+		if !errors.Is(err, api.ErrNotFound) {
+			return "", err
+		}
+	}
+
+	fakeCls, err := api.GetSimulator().CreateCluster(api.CreateClusterInput{
+		ClusterName: cls.ClusterName,
+		Region:      cls.Region,
+		VpcID:       cls.VpcID,
+		Version:     cls.GetClusterBasicSettings().GetVersion(),
+	})
+	if err != nil {
+		return "", err
+	}
+	blog.Infof("createFakeCluster[%s] cluster %s created %s", taskID, cls.ClusterID, fakeCls.ClusterID)
+
+	return fakeCls.ClusterID, nil
+}
+
+// CheckClusterStatusTask wait fake cluster running
+func CheckClusterStatusTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CheckClusterStatusTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CheckClusterStatusTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("CheckClusterStatusTask[%s]: get cluster %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("get cluster information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultCheckTimeout)
+	defer cancel()
+
+	err = loop.LoopDoFunc(ctx, func() error {
+		fakeCls, errLocal := api.GetSimulator().DescribeCluster(cluster.SystemID)
+		if errLocal != nil {
+			// cluster not found can't be recovered by polling
+			if errors.Is(errLocal, api.ErrNotFound) {
+				return errLocal
+			}
+			blog.Errorf("CheckClusterStatusTask[%s]: describe cluster %s failed: %v",
+				taskID, cluster.SystemID, errLocal)
+			return nil
+		}
+		blog.Infof("CheckClusterStatusTask[%s]: cluster %s status %s", taskID, cluster.SystemID, fakeCls.Status)
+		if fakeCls.Status == api.StatusRunning {
+			return loop.EndLoop
+		}
+		return nil
+	}, loop.LoopInterval(defaultCheckInterval))
+	if err != nil {
+		blog.Errorf("CheckClusterStatusTask[%s]: check cluster %s status failed: %v", taskID, cluster.SystemID, err)
+		retErr := fmt.Errorf("check cluster status failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		"cluster is running")
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CheckClusterStatusTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateCreateClusterDBInfoTask update cluster nodes DB info
+func UpdateCreateClusterDBInfoTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("UpdateCreateClusterDBInfoTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("UpdateCreateClusterDBInfoTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	nodeIPs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+
+	// update nodes status
+	_ = cloudprovider.UpdateNodeListStatus(true, nodeIPs, icommon.StatusRunning)
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpdateCreateClusterDBInfoTask[%s] task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteClusterTask delete cluster in fake cloud
+func DeleteClusterTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("DeleteClusterTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("DeleteClusterTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("DeleteClusterTask[%s]: get cluster %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("get cluster information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	if cluster.SystemID != "" {
+		err = api.GetSimulator().DeleteCluster(cluster.SystemID)
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			cloudprovider.GetStorageModel().CreateTaskStepLogError(context.Background(), taskID, stepName,
+				fmt.Sprintf("delete cluster failed [%s]", err))
+			blog.Errorf("DeleteClusterTask[%s]: delete fake cluster %s failed: %v", taskID, cluster.SystemID, err)
+			retErr := fmt.Errorf("delete fake cluster failed, %s", err.Error())
+			_ = state.UpdateStepRetryOrFailure(start, stepName, retErr)
+			return retErr
+		}
+	}
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		fmt.Sprintf("delete cluster %s successful", cluster.SystemID))
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("DeleteClusterTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// CleanClusterDBInfoTask clean cluster nodes/nodeGroups/autoscalingOption DB info
+func CleanClusterDBInfoTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CleanClusterDBInfoTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CleanClusterDBInfoTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+
+	err = cloudprovider.GetStorageModel().DeleteAutoScalingOption(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s]: delete cluster %s autoscalingOption failed: %v",
+			taskID, clusterID, err)
+	}
+
+	err = cloudprovider.GetStorageModel().DeleteNodesByClusterID(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s]: delete nodes for %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("delete node for %s failed, %s", clusterID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	err = cloudprovider.GetStorageModel().DeleteNodeGroupByClusterID(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s]: delete nodeGroups for %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("delete nodeGroups for %s failed, %s", clusterID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		"clean cluster db info successful")
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/fake/api"
+	icommon "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/loop"
+)
+
+// AddNodesToClusterTask add instances to fake cluster
+func AddNodesToClusterTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("AddNodesToClusterTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("AddNodesToClusterTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeIPs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("AddNodesToClusterTask[%s]: get cluster %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("get cluster information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	instances, err := api.GetSimulator().DescribeInstancesByIP(cluster.Region, nodeIPs)
+	if err == nil {
+		instanceIDs := make([]string, 0, len(instances))
+		for _, ins := range instances {
+			instanceIDs = append(instanceIDs, ins.InstanceID)
+		}
+		err = api.GetSimulator().AddInstancesToCluster(cluster.SystemID, instanceIDs)
+	}
+	if err != nil {
+		cloudprovider.GetStorageModel().CreateTaskStepLogError(context.Background(), taskID, stepName,
+			fmt.Sprintf("add nodes to cluster failed [%s]", err))
+		blog.Errorf("AddNodesToClusterTask[%s]: add nodes %v to cluster %s failed: %v",
+			taskID, nodeIPs, cluster.SystemID, err)
+		retErr := fmt.Errorf("add nodes to fake cluster failed, %s", err.Error())
+		_ = state.UpdateStepRetryOrFailure(start, stepName, retErr)
+		return retErr
+	}
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		fmt.Sprintf("add nodes %v to cluster successful", nodeIPs))
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("AddNodesToClusterTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// CheckNodesStatusTask wait fake instances running in cluster, nodeIPs are read from step params
+// or task common params when instances are applied by previous step
+func CheckNodesStatusTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CheckNodesStatusTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CheckNodesStatusTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeIPs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+	if len(nodeIPs) == 0 {
+		nodeIPs = cloudprovider.ParseNodeIpOrIdFromCommonMap(state.Task.CommonParams,
+			cloudprovider.NodeIPsKey.String(), ",")
+	}
+
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("CheckNodesStatusTask[%s]: get cluster %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("get cluster information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	err = waitInstancesRunning(taskID, cluster.Region, cluster.SystemID, nodeIPs)
+	if err != nil {
+		cloudprovider.GetStorageModel().CreateTaskStepLogError(context.Background(), taskID, stepName,
+			fmt.Sprintf("check nodes status failed [%s]", err))
+		blog.Errorf("CheckNodesStatusTask[%s]: check nodes %v status failed: %v", taskID, nodeIPs, err)
+		retErr := fmt.Errorf("check nodes status failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	_ = cloudprovider.UpdateNodeListStatus(true, nodeIPs, icommon.StatusRunning)
+
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		fmt.Sprintf("nodes %v are running", nodeIPs))
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CheckNodesStatusTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// waitInstancesRunning wait all instances running in fake cluster
+func waitInstancesRunning(taskID, region, systemID string, nodeIPs []string) error {
+	if len(nodeIPs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultCheckTimeout)
+	defer cancel()
+
+	return loop.LoopDoFunc(ctx, func() error {
+		instances, err := api.GetSimulator().DescribeInstancesByIP(region, nodeIPs)
+		if err != nil {
+			blog.Errorf("waitInstancesRunning[%s]: describe instances failed: %v", taskID, err)
+			return nil
+		}
+
+		running := 0
+		for _, ins := range instances {
+			if ins.ClusterID != systemID {
+				return fmt.Errorf("instance %s not in cluster %s", ins.InnerIP, systemID)
+			}
+			if ins.Status == api.StatusRunning {
+				running++
+			}
+		}
+		blog.Infof("waitInstancesRunning[%s]: running nodes %d/%d", taskID, running, len(nodeIPs))
+		if running == len(nodeIPs) {
+			return loop.EndLoop
+		}
+		return nil
+	}, loop.LoopInterval(defaultCheckInterval))
+}
+
+// RemoveNodesFromClusterTask remove instances from fake cluster
+func RemoveNodesFromClusterTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("RemoveNodesFromClusterTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("RemoveNodesFromClusterTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeIPs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("RemoveNodesFromClusterTask[%s]: get cluster %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("get cluster information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	err = removeInstancesByIP(cluster.Region, cluster.SystemID, nodeIPs)
+	if err != nil {
+		cloudprovider.GetStorageModel().CreateTaskStepLogError(context.Background(), taskID, stepName,
+			fmt.Sprintf("remove nodes from cluster failed [%s]", err))
+		blog.Errorf("RemoveNodesFromClusterTask[%s]: remove nodes %v from cluster %s failed: %v",
+			taskID, nodeIPs, cluster.SystemID, err)
+		retErr := fmt.Errorf("remove nodes from fake cluster failed, %s", err.Error())
+		_ = state.UpdateStepRetryOrFailure(start, stepName, retErr)
+		return retErr
+	}
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		fmt.Sprintf("remove nodes %v from cluster successful", nodeIPs))
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("RemoveNodesFromClusterTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// removeInstancesByIP remove instances from fake cluster, node pool instances are terminated
+func removeInstancesByIP(region, systemID string, nodeIPs []string) error {
+	instances, err := api.GetSimulator().DescribeInstancesByIP(region, nodeIPs)
+	if err != nil {
+		return err
+	}
+
+	instanceIDs := make([]string, 0, len(instances))
+	for _, ins := range instances {
+		instanceIDs = append(instanceIDs, ins.InstanceID)
+	}
+
+	return api.GetSimulator().RemoveInstancesFromCluster(systemID, instanceIDs)
+}
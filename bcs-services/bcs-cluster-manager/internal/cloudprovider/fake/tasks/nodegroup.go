@@ -0,0 +1,298 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/fake/api"
+	icommon "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+)
+
+// CreateNodePoolTask create node pool in fake cluster
+func CreateNodePoolTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CreateNodePoolTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CreateNodePoolTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeGroupID := step.Params[cloudprovider.NodeGroupIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID:   clusterID,
+		CloudID:     cloudID,
+		NodeGroupID: nodeGroupID,
+	})
+	if err != nil {
+		blog.Errorf("CreateNodePoolTask[%s]: GetClusterDependBasicInfo for nodeGroup %s failed: %v",
+			taskID, nodeGroupID, err)
+		retErr := fmt.Errorf("get cloud/cluster/nodeGroup information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	group := dependInfo.NodeGroup
+	// node pool already created when retry task
+	if group.CloudNodeGroupID == "" {
+		pool, errLocal := api.GetSimulator().CreateNodePool(api.CreateNodePoolInput{
+			ClusterID:    dependInfo.Cluster.SystemID,
+			Name:         group.Name,
+			SubnetID:     firstSubnet(group.GetAutoScaling().GetSubnetIDs()),
+			InstanceType: group.GetLaunchTemplate().GetInstanceType(),
+		})
+		if errLocal != nil {
+			cloudprovider.GetStorageModel().CreateTaskStepLogError(context.Background(), taskID, stepName,
+				fmt.Sprintf("create node pool failed [%s]", errLocal))
+			blog.Errorf("CreateNodePoolTask[%s]: create node pool for nodeGroup %s failed: %v",
+				taskID, nodeGroupID, errLocal)
+			retErr := fmt.Errorf("create fake node pool failed, %s", errLocal.Error())
+			_ = state.UpdateStepRetryOrFailure(start, stepName, retErr)
+			return retErr
+		}
+
+		group.CloudNodeGroupID = pool.NodePoolID
+		err = cloudprovider.UpdateNodeGroupCloudNodeGroupID(nodeGroupID, group)
+		if err != nil {
+			blog.Errorf("CreateNodePoolTask[%s]: update nodeGroup %s cloudNodeGroupID failed: %v",
+				taskID, nodeGroupID, err)
+			retErr := fmt.Errorf("update nodeGroup cloudNodeGroupID failed, %s", err.Error())
+			_ = state.UpdateStepFailure(start, stepName, retErr)
+			return retErr
+		}
+	}
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		fmt.Sprintf("create node pool %s successful", group.CloudNodeGroupID))
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CreateNodePoolTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+func firstSubnet(subnets []string) string {
+	if len(subnets) == 0 {
+		return ""
+	}
+	return subnets[0]
+}
+
+// DeleteNodePoolTask delete node pool in fake cluster
+func DeleteNodePoolTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("DeleteNodePoolTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("DeleteNodePoolTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	nodeGroupID := step.Params[cloudprovider.NodeGroupIDKey.String()]
+	group, err := cloudprovider.GetStorageModel().GetNodeGroup(context.Background(), nodeGroupID)
+	if err != nil {
+		blog.Errorf("DeleteNodePoolTask[%s]: get nodeGroup %s failed: %v", taskID, nodeGroupID, err)
+		retErr := fmt.Errorf("get nodeGroup information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	if group.CloudNodeGroupID != "" {
+		err = api.GetSimulator().DeleteNodePool(group.CloudNodeGroupID)
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			cloudprovider.GetStorageModel().CreateTaskStepLogError(context.Background(), taskID, stepName,
+				fmt.Sprintf("delete node pool failed [%s]", err))
+			blog.Errorf("DeleteNodePoolTask[%s]: delete node pool %s failed: %v",
+				taskID, group.CloudNodeGroupID, err)
+			retErr := fmt.Errorf("delete fake node pool failed, %s", err.Error())
+			_ = state.UpdateStepRetryOrFailure(start, stepName, retErr)
+			return retErr
+		}
+	}
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		fmt.Sprintf("delete node pool %s successful", group.CloudNodeGroupID))
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("DeleteNodePoolTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// ApplyInstancesTask scale out fake node pool and save instances to DB
+func ApplyInstancesTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("ApplyInstancesTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("ApplyInstancesTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeGroupID := step.Params[cloudprovider.NodeGroupIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	scalingNum, _ := strconv.Atoi(step.Params[cloudprovider.ScalingNodesNumKey.String()])
+
+	ctx := cloudprovider.WithTaskIDAndStepNameForContext(context.Background(), taskID, stepName)
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID:   clusterID,
+		CloudID:     cloudID,
+		NodeGroupID: nodeGroupID,
+	})
+	if err != nil {
+		blog.Errorf("ApplyInstancesTask[%s]: GetClusterDependBasicInfo for nodeGroup %s failed: %v",
+			taskID, nodeGroupID, err)
+		retErr := fmt.Errorf("get cloud/cluster/nodeGroup information failed, %s", err.Error())
+		_ = cloudprovider.UpdateNodeGroupDesiredSize(nodeGroupID, scalingNum, true)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	instances, err := api.GetSimulator().ScaleOutNodePool(dependInfo.NodeGroup.CloudNodeGroupID, uint32(scalingNum))
+	if err != nil {
+		cloudprovider.GetStorageModel().CreateTaskStepLogError(context.Background(), taskID, stepName,
+			fmt.Sprintf("apply instances failed [%s]", err))
+		blog.Errorf("ApplyInstancesTask[%s]: scale out node pool %s failed: %v",
+			taskID, dependInfo.NodeGroup.CloudNodeGroupID, err)
+		retErr := fmt.Errorf("scale out fake node pool failed, %s", err.Error())
+		_ = cloudprovider.UpdateNodeGroupDesiredSize(nodeGroupID, scalingNum, true)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	nodeIPs, nodeIDs := make([]string, 0), make([]string, 0)
+	for _, ins := range instances {
+		node := api.InstanceToNode(ins)
+		node.ClusterID = clusterID
+		node.NodeGroupID = nodeGroupID
+		node.Status = icommon.StatusInitialization
+		node.TaskID = taskID
+		err = cloudprovider.SaveNodeInfoToDB(ctx, node, true)
+		if err != nil {
+			blog.Errorf("ApplyInstancesTask[%s]: SaveNodeInfoToDB[%s] failed: %v", taskID, node.InnerIP, err)
+		}
+		nodeIPs = append(nodeIPs, ins.InnerIP)
+		nodeIDs = append(nodeIDs, ins.InstanceID)
+	}
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		fmt.Sprintf("apply instances %v successful", nodeIPs))
+
+	// update response information to task common params
+	if state.Task.CommonParams == nil {
+		state.Task.CommonParams = make(map[string]string)
+	}
+	state.Task.NodeIPList = nodeIPs
+	state.Task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+	state.Task.CommonParams[cloudprovider.NodeIDsKey.String()] = strings.Join(nodeIDs, ",")
+	state.Task.CommonParams[cloudprovider.DynamicNodeIPListKey.String()] = strings.Join(nodeIPs, ",")
+	state.Task.CommonParams[cloudprovider.DynamicInstanceIDListKey.String()] = strings.Join(nodeIDs, ",")
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("ApplyInstancesTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// CleanNodeGroupNodesTask remove nodes from fake node pool, instances are terminated
+func CleanNodeGroupNodesTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CleanNodeGroupNodesTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CleanNodeGroupNodesTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeIPs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("CleanNodeGroupNodesTask[%s]: get cluster %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("get cluster information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	err = removeInstancesByIP(cluster.Region, cluster.SystemID, nodeIPs)
+	if err != nil {
+		cloudprovider.GetStorageModel().CreateTaskStepLogError(context.Background(), taskID, stepName,
+			fmt.Sprintf("clean node group nodes failed [%s]", err))
+		blog.Errorf("CleanNodeGroupNodesTask[%s]: remove nodes %v failed: %v", taskID, nodeIPs, err)
+		retErr := fmt.Errorf("clean fake node pool nodes failed, %s", err.Error())
+		_ = state.UpdateStepRetryOrFailure(start, stepName, retErr)
+		return retErr
+	}
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		fmt.Sprintf("clean node group nodes %v successful", nodeIPs))
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CleanNodeGroupNodesTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
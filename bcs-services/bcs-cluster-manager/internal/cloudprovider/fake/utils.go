@@ -0,0 +1,341 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fake xxx
+package fake
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/options"
+)
+
+var (
+	cloudName = "fake"
+)
+
+const (
+	// createClusterTaskTemplate fake cloud create cluster task template
+	createClusterTaskTemplate = "fake-create cluster: %s"
+	// deleteClusterTaskTemplate fake cloud delete cluster task template
+	deleteClusterTaskTemplate = "fake-delete cluster: %s"
+	// addClusterNodesTaskTemplate fake cloud add clusterNodes task template
+	addClusterNodesTaskTemplate = "fake-add nodes: %s"
+	// deleteClusterNodesTaskTemplate fake cloud delete clusterNodes task template
+	deleteClusterNodesTaskTemplate = "fake-remove nodes: %s"
+	// createNodeGroupTaskTemplate fake cloud create node group task template
+	createNodeGroupTaskTemplate = "fake-create node group: %s/%s"
+	// deleteNodeGroupTaskTemplate fake cloud delete node group task template
+	deleteNodeGroupTaskTemplate = "fake-delete node group: %s/%s"
+	// updateNodeGroupDesiredNodeTemplate fake cloud update node group desired node task template
+	updateNodeGroupDesiredNodeTemplate = "fake-update node group desired node: %s/%s"
+	// cleanNodeGroupNodesTaskTemplate fake cloud clean node group nodes task template
+	cleanNodeGroupNodesTaskTemplate = "fake-remove node group nodes: %s/%s"
+)
+
+var (
+	// create cluster task steps
+	createClusterStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CreateClusterTask", cloudName),
+		StepName:   "创建集群",
+	}
+	checkClusterStatusStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CheckClusterStatusTask", cloudName),
+		StepName:   "检测集群状态",
+	}
+	updateCreateClusterDBInfoStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-UpdateCreateClusterDBInfoTask", cloudName),
+		StepName:   "更新集群任务状态",
+	}
+
+	// delete cluster task steps
+	deleteClusterStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-DeleteClusterTask", cloudName),
+		StepName:   "删除集群",
+	}
+	cleanClusterDBInfoStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CleanClusterDBInfoTask", cloudName),
+		StepName:   "清理集群数据",
+	}
+
+	// cluster add nodes task steps
+	addNodesToClusterStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-AddNodesToClusterTask", cloudName),
+		StepName:   "集群上架节点",
+	}
+	checkNodesStatusStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CheckNodesStatusTask", cloudName),
+		StepName:   "检测节点状态",
+	}
+
+	// cluster remove nodes task steps
+	removeNodesFromClusterStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-RemoveNodesFromClusterTask", cloudName),
+		StepName:   "集群下架节点",
+	}
+
+	// create nodeGroup task steps
+	createNodePoolStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CreateNodePoolTask", cloudName),
+		StepName:   "创建节点池",
+	}
+
+	// delete nodeGroup task steps
+	deleteNodePoolStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-DeleteNodePoolTask", cloudName),
+		StepName:   "删除节点池",
+	}
+
+	// update desired nodes task steps
+	applyInstancesStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-%s", cloudName, cloudprovider.ApplyInstanceMachinesTask),
+		StepName:   "申请节点任务",
+	}
+
+	// clean nodes in group task steps
+	cleanNodeGroupNodesStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CleanNodeGroupNodesTask", cloudName),
+		StepName:   "回收节点",
+	}
+)
+
+// stepMaxRetry fake cloud step auto retry times when failed
+func stepMaxRetry() uint32 {
+	if opt := options.GetGlobalCMOptions(); opt != nil {
+		return opt.FakeCloud.StepMaxRetry
+	}
+	return 0
+}
+
+// initTaskStep init fake cloud task step with configured retry times
+func initTaskStep(stepInfo cloudprovider.StepInfo, opts ...cloudprovider.StepOption) *proto.Step {
+	opts = append(opts, cloudprovider.WithStepMaxRetry(stepMaxRetry()))
+	return cloudprovider.InitTaskStep(stepInfo, opts...)
+}
+
+// appendTaskStep append step to task step sequence
+func appendTaskStep(task *proto.Task, stepInfo cloudprovider.StepInfo, step *proto.Step) {
+	task.Steps[stepInfo.StepMethod] = step
+	task.StepSequence = append(task.StepSequence, stepInfo.StepMethod)
+}
+
+// CreateClusterTaskOption for build create cluster step
+type CreateClusterTaskOption struct {
+	Cluster     *proto.Cluster
+	WorkerNodes []string
+}
+
+// BuildCreateClusterStep create cluster in fake cloud
+func (cn *CreateClusterTaskOption) BuildCreateClusterStep(task *proto.Task) {
+	createStep := initTaskStep(createClusterStep)
+
+	createStep.Params[cloudprovider.ClusterIDKey.String()] = cn.Cluster.ClusterID
+	createStep.Params[cloudprovider.CloudIDKey.String()] = cn.Cluster.Provider
+
+	appendTaskStep(task, createClusterStep, createStep)
+}
+
+// BuildCheckClusterStatusStep wait cluster running, allow skip when failed
+func (cn *CreateClusterTaskOption) BuildCheckClusterStatusStep(task *proto.Task) {
+	checkStep := initTaskStep(checkClusterStatusStep, cloudprovider.WithStepAllowSkip(true))
+
+	checkStep.Params[cloudprovider.ClusterIDKey.String()] = cn.Cluster.ClusterID
+	checkStep.Params[cloudprovider.CloudIDKey.String()] = cn.Cluster.Provider
+
+	appendTaskStep(task, checkClusterStatusStep, checkStep)
+}
+
+// BuildAddNodesToClusterStep add worker nodes to cluster
+func (cn *CreateClusterTaskOption) BuildAddNodesToClusterStep(task *proto.Task) {
+	if len(cn.WorkerNodes) == 0 {
+		return
+	}
+	buildAddNodesSteps(task, cn.Cluster, cn.WorkerNodes)
+}
+
+// BuildUpdateClusterDbInfoStep update cluster and nodes DB info
+func (cn *CreateClusterTaskOption) BuildUpdateClusterDbInfoStep(task *proto.Task) {
+	updateStep := initTaskStep(updateCreateClusterDBInfoStep)
+
+	updateStep.Params[cloudprovider.ClusterIDKey.String()] = cn.Cluster.ClusterID
+	updateStep.Params[cloudprovider.CloudIDKey.String()] = cn.Cluster.Provider
+	if len(cn.WorkerNodes) > 0 {
+		updateStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(cn.WorkerNodes, ",")
+	}
+
+	appendTaskStep(task, updateCreateClusterDBInfoStep, updateStep)
+}
+
+// DeleteClusterTaskOption for build delete cluster step
+type DeleteClusterTaskOption struct {
+	Cluster *proto.Cluster
+}
+
+// BuildDeleteClusterStep delete cluster in fake cloud
+func (dn *DeleteClusterTaskOption) BuildDeleteClusterStep(task *proto.Task) {
+	deleteStep := initTaskStep(deleteClusterStep)
+
+	deleteStep.Params[cloudprovider.ClusterIDKey.String()] = dn.Cluster.ClusterID
+	deleteStep.Params[cloudprovider.CloudIDKey.String()] = dn.Cluster.Provider
+
+	appendTaskStep(task, deleteClusterStep, deleteStep)
+}
+
+// BuildCleanClusterDbInfoStep clean cluster DB info
+func (dn *DeleteClusterTaskOption) BuildCleanClusterDbInfoStep(task *proto.Task) {
+	cleanStep := initTaskStep(cleanClusterDBInfoStep)
+
+	cleanStep.Params[cloudprovider.ClusterIDKey.String()] = dn.Cluster.ClusterID
+	cleanStep.Params[cloudprovider.CloudIDKey.String()] = dn.Cluster.Provider
+
+	appendTaskStep(task, cleanClusterDBInfoStep, cleanStep)
+}
+
+// AddNodesTaskOption for build add cluster nodes step
+type AddNodesTaskOption struct {
+	Cluster *proto.Cluster
+	NodeIPs []string
+}
+
+// BuildAddNodesToClusterStep add nodes to cluster and wait nodes running
+func (an *AddNodesTaskOption) BuildAddNodesToClusterStep(task *proto.Task) {
+	buildAddNodesSteps(task, an.Cluster, an.NodeIPs)
+}
+
+// buildAddNodesSteps add nodes to cluster and check nodes status
+func buildAddNodesSteps(task *proto.Task, cls *proto.Cluster, nodeIPs []string) {
+	addStep := initTaskStep(addNodesToClusterStep)
+
+	addStep.Params[cloudprovider.ClusterIDKey.String()] = cls.ClusterID
+	addStep.Params[cloudprovider.CloudIDKey.String()] = cls.Provider
+	addStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+
+	appendTaskStep(task, addNodesToClusterStep, addStep)
+
+	checkStep := initTaskStep(checkNodesStatusStep, cloudprovider.WithStepAllowSkip(true))
+
+	checkStep.Params[cloudprovider.ClusterIDKey.String()] = cls.ClusterID
+	checkStep.Params[cloudprovider.CloudIDKey.String()] = cls.Provider
+	checkStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+
+	appendTaskStep(task, checkNodesStatusStep, checkStep)
+}
+
+// RemoveNodesTaskOption for build remove cluster nodes step
+type RemoveNodesTaskOption struct {
+	Cluster *proto.Cluster
+	NodeIPs []string
+	NodeIDs []string
+}
+
+// BuildRemoveNodesFromClusterStep remove nodes from cluster
+func (rn *RemoveNodesTaskOption) BuildRemoveNodesFromClusterStep(task *proto.Task) {
+	removeStep := initTaskStep(removeNodesFromClusterStep)
+
+	removeStep.Params[cloudprovider.ClusterIDKey.String()] = rn.Cluster.ClusterID
+	removeStep.Params[cloudprovider.CloudIDKey.String()] = rn.Cluster.Provider
+	removeStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(rn.NodeIPs, ",")
+	removeStep.Params[cloudprovider.NodeIDsKey.String()] = strings.Join(rn.NodeIDs, ",")
+
+	appendTaskStep(task, removeNodesFromClusterStep, removeStep)
+}
+
+// CreateNodeGroupTaskOption for build create node group step
+type CreateNodeGroupTaskOption struct {
+	Group   *proto.NodeGroup
+	Cluster *proto.Cluster
+}
+
+// BuildCreateNodePoolStep create node pool in fake cloud
+func (cn *CreateNodeGroupTaskOption) BuildCreateNodePoolStep(task *proto.Task) {
+	createStep := initTaskStep(createNodePoolStep)
+
+	createStep.Params[cloudprovider.ClusterIDKey.String()] = cn.Group.ClusterID
+	createStep.Params[cloudprovider.NodeGroupIDKey.String()] = cn.Group.NodeGroupID
+	createStep.Params[cloudprovider.CloudIDKey.String()] = cn.Cluster.Provider
+
+	appendTaskStep(task, createNodePoolStep, createStep)
+}
+
+// DeleteNodeGroupTaskOption for build delete node group step
+type DeleteNodeGroupTaskOption struct {
+	Group   *proto.NodeGroup
+	Cluster *proto.Cluster
+}
+
+// BuildDeleteNodePoolStep delete node pool in fake cloud
+func (dn *DeleteNodeGroupTaskOption) BuildDeleteNodePoolStep(task *proto.Task) {
+	deleteStep := initTaskStep(deleteNodePoolStep)
+
+	deleteStep.Params[cloudprovider.ClusterIDKey.String()] = dn.Group.ClusterID
+	deleteStep.Params[cloudprovider.NodeGroupIDKey.String()] = dn.Group.NodeGroupID
+	deleteStep.Params[cloudprovider.CloudIDKey.String()] = dn.Cluster.Provider
+
+	appendTaskStep(task, deleteNodePoolStep, deleteStep)
+}
+
+// UpdateDesiredNodesTaskOption for build update desired nodes step
+type UpdateDesiredNodesTaskOption struct {
+	Group    *proto.NodeGroup
+	Cluster  *proto.Cluster
+	Desired  uint32
+	Operator string
+}
+
+// BuildApplyInstancesStep apply instances in node pool
+func (ud *UpdateDesiredNodesTaskOption) BuildApplyInstancesStep(task *proto.Task) {
+	applyStep := initTaskStep(applyInstancesStep)
+
+	applyStep.Params[cloudprovider.ClusterIDKey.String()] = ud.Group.ClusterID
+	applyStep.Params[cloudprovider.NodeGroupIDKey.String()] = ud.Group.NodeGroupID
+	applyStep.Params[cloudprovider.CloudIDKey.String()] = ud.Cluster.Provider
+	applyStep.Params[cloudprovider.ScalingNodesNumKey.String()] = strconv.Itoa(int(ud.Desired))
+	applyStep.Params[cloudprovider.OperatorKey.String()] = ud.Operator
+
+	appendTaskStep(task, applyInstancesStep, applyStep)
+}
+
+// BuildCheckNodesStatusStep wait applied instances running, nodeIPs are read from task common params
+func (ud *UpdateDesiredNodesTaskOption) BuildCheckNodesStatusStep(task *proto.Task) {
+	checkStep := initTaskStep(checkNodesStatusStep, cloudprovider.WithStepAllowSkip(true))
+
+	checkStep.Params[cloudprovider.ClusterIDKey.String()] = ud.Group.ClusterID
+	checkStep.Params[cloudprovider.NodeGroupIDKey.String()] = ud.Group.NodeGroupID
+	checkStep.Params[cloudprovider.CloudIDKey.String()] = ud.Cluster.Provider
+
+	appendTaskStep(task, checkNodesStatusStep, checkStep)
+}
+
+// CleanNodesInGroupTaskOption for build clean nodes in group step
+type CleanNodesInGroupTaskOption struct {
+	Group   *proto.NodeGroup
+	Cluster *proto.Cluster
+	NodeIPs []string
+	NodeIDs []string
+}
+
+// BuildCleanNodeGroupNodesStep remove nodes from node pool and terminate instances
+func (cn *CleanNodesInGroupTaskOption) BuildCleanNodeGroupNodesStep(task *proto.Task) {
+	cleanStep := initTaskStep(cleanNodeGroupNodesStep)
+
+	cleanStep.Params[cloudprovider.ClusterIDKey.String()] = cn.Group.ClusterID
+	cleanStep.Params[cloudprovider.NodeGroupIDKey.String()] = cn.Group.NodeGroupID
+	cleanStep.Params[cloudprovider.CloudIDKey.String()] = cn.Cluster.Provider
+	cleanStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(cn.NodeIPs, ",")
+	cleanStep.Params[cloudprovider.NodeIDsKey.String()] = strings.Join(cn.NodeIDs, ",")
+
+	appendTaskStep(task, cleanNodeGroupNodesStep, cleanStep)
+}
@@ -0,0 +1,115 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"net"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/fake/api"
+)
+
+func init() {
+	cloudprovider.InitVPCManager(cloudName, &VPCManager{})
+}
+
+// VPCManager is the manager for fake cloud VPC
+type VPCManager struct{}
+
+// ListVpcs list vpcs
+func (vm *VPCManager) ListVpcs(vpcID string, opt *cloudprovider.ListNetworksOption) ([]*proto.CloudVpc, error) {
+	vpcs, err := api.GetSimulator().ListVpcs(opt.Region, vpcID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*proto.CloudVpc, 0, len(vpcs))
+	for _, vpc := range vpcs {
+		result = append(result, &proto.CloudVpc{
+			Name:     vpc.Name,
+			VpcId:    vpc.VpcID,
+			Ipv4Cidr: vpc.CidrBlock,
+		})
+	}
+
+	return result, nil
+}
+
+// ListSubnets list vpc subnets
+func (vm *VPCManager) ListSubnets(vpcID string, zone string, opt *cloudprovider.ListNetworksOption) (
+	[]*proto.Subnet, error) {
+	subnets, err := api.GetSimulator().ListSubnets(vpcID, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*proto.Subnet, 0, len(subnets))
+	for _, subnet := range subnets {
+		result = append(result, &proto.Subnet{
+			VpcID:                   subnet.VpcID,
+			SubnetID:                subnet.SubnetID,
+			SubnetName:              subnet.Name,
+			CidrRange:               subnet.CidrBlock,
+			Zone:                    subnet.Zone,
+			ZoneName:                subnet.Zone,
+			AvailableIPAddressCount: subnet.AvailableIPs,
+		})
+	}
+
+	return result, nil
+}
+
+// ListSecurityGroups list security groups, fake cloud has no security group
+func (vm *VPCManager) ListSecurityGroups(opt *cloudprovider.ListNetworksOption) ([]*proto.SecurityGroup, error) {
+	return make([]*proto.SecurityGroup, 0), nil
+}
+
+// GetCloudNetworkAccountType get cloud account type
+func (vm *VPCManager) GetCloudNetworkAccountType(opt *cloudprovider.CommonOption) (*proto.CloudAccountType, error) {
+	return &proto.CloudAccountType{Type: "STANDARD"}, nil
+}
+
+// ListBandwidthPacks list bandWidth packs
+func (vm *VPCManager) ListBandwidthPacks(opt *cloudprovider.CommonOption) ([]*proto.BandwidthPackageInfo, error) {
+	return make([]*proto.BandwidthPackageInfo, 0), nil
+}
+
+// CheckConflictInVpcCidr check cidr if conflict with vpc cidrs
+func (vm *VPCManager) CheckConflictInVpcCidr(vpcID string, cidr string,
+	opt *cloudprovider.CheckConflictInVpcCidrOption) ([]string, error) {
+	return nil, nil
+}
+
+// AllocateOverlayCidr allocate overlay cidr
+func (vm *VPCManager) AllocateOverlayCidr(vpcId string, cluster *proto.Cluster, cidrLens []uint32,
+	reservedBlocks []*net.IPNet, opt *cloudprovider.CommonOption) ([]string, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// AddClusterOverlayCidr add cidr to cluster
+func (vm *VPCManager) AddClusterOverlayCidr(clusterId string, cidrs []string, opt *cloudprovider.CommonOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// GetVpcIpUsage get vpc ipTotal/ipSurplus
+func (vm *VPCManager) GetVpcIpUsage(
+	vpcId string, ipType string, reservedBlocks []*net.IPNet, opt *cloudprovider.CommonOption) (uint32, uint32, error) {
+	return 0, 0, cloudprovider.ErrCloudNotImplemented
+}
+
+// GetClusterIpUsage get cluster ip usage
+func (vm *VPCManager) GetClusterIpUsage(clusterId string, ipType string, opt *cloudprovider.CommonOption) (
+	uint32, uint32, error) {
+	return 0, 0, cloudprovider.ErrCloudNotImplemented
+}
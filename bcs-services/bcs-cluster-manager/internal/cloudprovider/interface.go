@@ -362,6 +362,8 @@ type ClusterManager interface {
 	CheckClusterNetworkStatus(cloudID string, opt *CheckClusterNetworkStatusOption) (bool, error)
 	// CheckHighAvailabilityMasterNodes check master nodes high availability
 	CheckHighAvailabilityMasterNodes(cls *proto.Cluster, nodes []*proto.Node, opt *CheckHaMasterNodesOption) error
+	// UpgradeClusterVersion upgrade cluster kubernetes version, include control plane and nodeGroups
+	UpgradeClusterVersion(cls *proto.Cluster, version string, opt *UpgradeClusterVersionOption) (*proto.Task, error)
 }
 
 // NodeGroupManager cloud interface for nodegroup management
@@ -393,6 +395,8 @@ type NodeGroupManager interface {
 	// SwitchNodeGroupAutoScaling switch nodegroup auto scale
 	SwitchNodeGroupAutoScaling(group *proto.NodeGroup, enable bool,
 		opt *SwitchNodeGroupAutoScalingOption) (*proto.Task, error)
+	// UpgradeNodeGroupVersion rolling replace nodegroup nodes to cluster kubernetes version
+	UpgradeNodeGroupVersion(group *proto.NodeGroup, opt *UpgradeNodeGroupVersionOption) (*proto.Task, error)
 
 	// CreateAutoScalingOption create cluster autoscaling option, cloudprovider will
 	// deploy cluster-autoscaler in backgroup according cloudprovider implementation
@@ -498,6 +502,8 @@ type TaskManager interface {
 		scalingOption *proto.ClusterAutoScalingOption, enable bool, opt *CommonOption) (*proto.Task, error)
 	// BuildUpdateNodeGroupTask update nodeGroup data and update autoScalingOption
 	BuildUpdateNodeGroupTask(group *proto.NodeGroup, opt *CommonOption) (*proto.Task, error)
+	// BuildUpgradeNodeGroupVersionTask rolling replace nodegroup nodes
+	BuildUpgradeNodeGroupVersionTask(group *proto.NodeGroup, opt *UpgradeNodeGroupVersionOption) (*proto.Task, error)
 
 	// ClusterManager taskList
 
@@ -519,6 +525,9 @@ type TaskManager interface {
 	// BuildSwitchClusterNetworkTask switch cluster network mode
 	BuildSwitchClusterNetworkTask(
 		cls *proto.Cluster, subnet *proto.SubnetSource, opt *SwitchClusterNetworkOption) (*proto.Task, error)
+	// BuildUpgradeClusterVersionTask upgrade cluster kubernetes version
+	BuildUpgradeClusterVersionTask(
+		cls *proto.Cluster, version string, opt *UpgradeClusterVersionOption) (*proto.Task, error)
 
 	// BuildAddExternalNodeToCluster add external to cluster
 	BuildAddExternalNodeToCluster(
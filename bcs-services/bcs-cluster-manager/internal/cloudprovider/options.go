@@ -580,6 +580,26 @@ type SwitchClusterNetworkOption struct {
 	ClaimExpiredSeconds uint32
 }
 
+// UpgradeClusterVersionOption upgrade cluster version option
+type UpgradeClusterVersionOption struct {
+	CommonOption
+	Cloud        *proto.Cloud
+	Operator     string
+	SkipPreCheck bool
+	// NodeGroups rolling replace nodes after control plane upgraded
+	NodeGroups []*proto.NodeGroup
+	Strategy   *proto.NodeGroupUpgradeStrategy
+}
+
+// UpgradeNodeGroupVersionOption upgrade nodeGroup version option
+type UpgradeNodeGroupVersionOption struct {
+	CommonOption
+	Cloud    *proto.Cloud
+	Cluster  *proto.Cluster
+	Operator string
+	Strategy *proto.NodeGroupUpgradeStrategy
+}
+
 // CheckClusterNetworkStatusOption check cluster network option
 type CheckClusterNetworkStatusOption struct {
 	CommonOption
@@ -565,6 +565,37 @@ func (cli *TkeClient) CloseVpcCniMode(clusterID string) error {
 	return nil
 }
 
+// UpdateClusterVersion upgrade tke cluster master version
+func (cli *TkeClient) UpdateClusterVersion(clusterID, version string, skipPreCheck bool) error {
+	if cli == nil {
+		return cloudprovider.ErrServerIsNil
+	}
+	if len(clusterID) == 0 || len(version) == 0 {
+		return fmt.Errorf("UpdateClusterVersion failed: clusterID or version is empty")
+	}
+
+	req := tke.NewUpdateClusterVersionRequest()
+	req.ClusterId = common.StringPtr(clusterID)
+	req.DstVersion = common.StringPtr(version)
+	req.SkipPreCheck = common.BoolPtr(skipPreCheck)
+
+	resp, err := cli.tke.UpdateClusterVersion(req)
+	if err != nil {
+		blog.Errorf("UpdateClusterVersion[%s:%s] failed: %v", clusterID, version, err)
+		return err
+	}
+
+	// check response
+	if resp == nil || resp.Response == nil {
+		blog.Errorf("UpdateClusterVersion[%s:%s] but lost response information", clusterID, version)
+		return cloudprovider.ErrCloudLostResponse
+	}
+	blog.Infof("RequestId[%s] tke client UpdateClusterVersion[%s:%s] response successful",
+		*resp.Response.RequestId, clusterID, version)
+
+	return nil
+}
+
 // AddClusterCIDR cluster add cidr
 func (cli *TkeClient) AddClusterCIDR(clusterId string, cidrs []string, ignore bool) error {
 	req := tke.NewAddClusterCIDRRequest()
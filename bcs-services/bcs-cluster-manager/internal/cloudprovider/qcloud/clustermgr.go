@@ -1004,6 +1004,34 @@ func (c *Cluster) SwitchClusterNetwork(
 	return task, nil
 }
 
+// UpgradeClusterVersion upgrade cluster kubernetes version
+func (c *Cluster) UpgradeClusterVersion(cls *proto.Cluster, version string,
+	opt *cloudprovider.UpgradeClusterVersionOption) (*proto.Task, error) {
+	if opt == nil || opt.Account == nil || len(opt.Account.SecretID) == 0 ||
+		len(opt.Account.SecretKey) == 0 || len(opt.Region) == 0 {
+		return nil, fmt.Errorf("qcloud UpgradeClusterVersion lost authoration")
+	}
+
+	// GetTaskManager for cluster manager initialization
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when UpgradeClusterVersion %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build upgrade cluster version task
+	task, err := mgr.BuildUpgradeClusterVersionTask(cls, version, opt)
+	if err != nil {
+		blog.Errorf("build UpgradeClusterVersion task for cluster %s with cloudProvider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+	return task, nil
+}
+
 // CheckClusterNetworkStatus check cluster network status
 func (c *Cluster) CheckClusterNetworkStatus(clusterID string,
 	opt *cloudprovider.CheckClusterNetworkStatusOption) (bool, error) {
@@ -707,6 +707,26 @@ func (ng *NodeGroup) SwitchNodeGroupAutoScaling(group *proto.NodeGroup, enable b
 	return task, nil
 }
 
+// UpgradeNodeGroupVersion rolling replace nodeGroup nodes which are older than cluster version
+func (ng *NodeGroup) UpgradeNodeGroupVersion(group *proto.NodeGroup,
+	opt *cloudprovider.UpgradeNodeGroupVersionOption) (*proto.Task, error) {
+	mgr, err := cloudprovider.GetTaskManager(cloudName)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when UpgradeNodeGroupVersion %s failed, %s",
+			cloudName, group.NodeGroupID, err.Error(),
+		)
+		return nil, err
+	}
+	task, err := mgr.BuildUpgradeNodeGroupVersionTask(group, opt)
+	if err != nil {
+		blog.Errorf("build UpgradeNodeGroupVersion task for nodeGroup %s with cloudprovider %s failed, %s",
+			group.NodeGroupID, cloudName, err.Error(),
+		)
+		return nil, err
+	}
+	return task, nil
+}
+
 // CreateAutoScalingOption create cluster autoscaling option, cloudprovider will
 // deploy cluster-autoscaler in backgroup according cloudprovider implementation
 func (ng *NodeGroup) CreateAutoScalingOption(scalingOption *proto.ClusterAutoScalingOption,
@@ -119,6 +119,11 @@ func newtask() *Task {
 	task.works[openClusterVpcCniStep.StepMethod] = tasks.OpenClusterVpcCniTask
 	task.works[closeClusterVpcCniStep.StepMethod] = tasks.CloseClusterVpcCniTask
 
+	// upgrade cluster/nodeGroup version task
+	task.works[upgradeClusterVersionStep.StepMethod] = tasks.UpgradeClusterVersionTask
+	task.works[checkClusterVersionStatusStep.StepMethod] = tasks.CheckClusterVersionStatusTask
+	task.works[rollingReplaceNodeGroupsStep.StepMethod] = tasks.RollingReplaceNodeGroupsTask
+
 	return task
 }
 
@@ -1952,3 +1957,146 @@ func (t *Task) BuildSwitchClusterNetworkTask(
 
 	return task, nil
 }
+
+// BuildUpgradeClusterVersionTask build upgrade cluster version task
+func (t *Task) BuildUpgradeClusterVersionTask(cls *proto.Cluster, version string,
+	opt *cloudprovider.UpgradeClusterVersionOption) (*proto.Task, error) {
+	// validate request params
+	if cls == nil || len(version) == 0 {
+		return nil, fmt.Errorf("BuildUpgradeClusterVersionTask cluster or version info empty")
+	}
+	if opt == nil || opt.Cloud == nil {
+		return nil, fmt.Errorf("BuildUpgradeClusterVersionTask TaskOptions is lost")
+	}
+
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskName:       cloudprovider.UpgradeClusterVersionTask.String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.UpgradeClusterVersion),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      cls.ClusterID,
+		ProjectID:      cls.ProjectID,
+		Creator:        opt.Operator,
+		Updater:        opt.Operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+	}
+
+	// generate taskName
+	taskName := fmt.Sprintf(upgradeClusterVersionTemplate, cls.ClusterID, version)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+	task.CommonParams[cloudprovider.ClusterIDKey.String()] = cls.ClusterID
+	task.CommonParams[cloudprovider.CloudIDKey.String()] = opt.Cloud.CloudID
+	task.CommonParams[cloudprovider.CloudSystemID.String()] = cls.SystemID
+	task.CommonParams[cloudprovider.ClusterVersionKey.String()] = version
+	task.CommonParams[cloudprovider.SkipPreCheckKey.String()] = strconv.FormatBool(opt.SkipPreCheck)
+
+	groupIDs := make([]string, 0)
+	for _, group := range opt.NodeGroups {
+		groupIDs = append(groupIDs, group.NodeGroupID)
+	}
+
+	// setting step details
+	upgradeTask := &UpgradeVersionTaskOption{
+		Cluster:      cls,
+		Version:      version,
+		NodeGroupIDs: groupIDs,
+		Strategy:     common.MarshalUpgradeStrategy(opt.Strategy),
+	}
+
+	// step0: 升级前置检查(版本跨度/废弃API/组件兼容性)
+	if !opt.SkipPreCheck {
+		common.BuildCheckClusterUpgradeTaskStep(task, cls.ClusterID, version)
+	}
+	// step1: 升级集群控制面
+	upgradeTask.BuildUpgradeClusterVersionStep(task)
+	// step2: 检测集群升级状态
+	upgradeTask.BuildCheckClusterVersionStatusStep(task)
+	// step3: 更新集群版本信息
+	common.BuildUpdateClusterVersionTaskStep(task, cls.ClusterID, version)
+	// step4: 滚动替换节点池节点
+	if len(groupIDs) > 0 {
+		upgradeTask.BuildRollingReplaceNodeGroupsStep(task)
+	}
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildUpgradeClusterVersionTask task StepSequence empty")
+	}
+
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.OperatorKey.String()] = opt.Operator
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.UpgradeClusterVersionJob.String()
+
+	return task, nil
+}
+
+// BuildUpgradeNodeGroupVersionTask build rolling replace nodeGroup nodes task
+func (t *Task) BuildUpgradeNodeGroupVersionTask(group *proto.NodeGroup,
+	opt *cloudprovider.UpgradeNodeGroupVersionOption) (*proto.Task, error) {
+	// validate request params
+	if group == nil {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupVersionTask group info empty")
+	}
+	if opt == nil || opt.Cloud == nil || opt.Cluster == nil {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupVersionTask TaskOptions is lost")
+	}
+	version := opt.Cluster.GetClusterBasicSettings().GetVersion()
+	if len(version) == 0 {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupVersionTask cluster %s version empty", opt.Cluster.ClusterID)
+	}
+
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskName:       cloudprovider.UpgradeNodeGroupVersionTask.String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.UpgradeNodeGroupVersion),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      group.ClusterID,
+		ProjectID:      group.ProjectID,
+		Creator:        opt.Operator,
+		Updater:        opt.Operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+		NodeGroupID:    group.NodeGroupID,
+	}
+
+	// generate taskName
+	taskName := fmt.Sprintf(upgradeNodeGroupVersionTemplate, group.ClusterID, group.NodeGroupID)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+	task.CommonParams[cloudprovider.ClusterIDKey.String()] = group.ClusterID
+	task.CommonParams[cloudprovider.NodeGroupIDKey.String()] = group.NodeGroupID
+	task.CommonParams[cloudprovider.CloudIDKey.String()] = opt.Cloud.CloudID
+	task.CommonParams[cloudprovider.ClusterVersionKey.String()] = version
+
+	// step1: 滚动替换节点池节点
+	upgradeTask := &UpgradeVersionTaskOption{
+		Cluster:      opt.Cluster,
+		Version:      version,
+		NodeGroupIDs: []string{group.NodeGroupID},
+		Strategy:     common.MarshalUpgradeStrategy(opt.Strategy),
+	}
+	upgradeTask.BuildRollingReplaceNodeGroupsStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupVersionTask task StepSequence empty")
+	}
+
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.OperatorKey.String()] = opt.Operator
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.UpgradeNodeGroupVersionJob.String()
+
+	return task, nil
+}
@@ -0,0 +1,441 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	v1 "k8s.io/api/core/v1"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	pcommon "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/qcloud/api"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/qcloud/business"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/clusterops"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/loop"
+)
+
+// UpgradeClusterVersionTask upgrade tke cluster control plane version
+func UpgradeClusterVersionTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("UpgradeClusterVersionTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("UpgradeClusterVersionTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+	skipPreCheck, _ := strconv.ParseBool(state.Task.CommonParams[cloudprovider.SkipPreCheckKey.String()])
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("UpgradeClusterVersionTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cli, err := api.NewTkeClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("UpgradeClusterVersionTask[%s]: get tke client failed: %v", taskID, err)
+		retErr := fmt.Errorf("get cloud tke client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	err = cli.UpdateClusterVersion(dependInfo.Cluster.SystemID, version, skipPreCheck)
+	if err != nil {
+		blog.Errorf("UpgradeClusterVersionTask[%s]: UpdateClusterVersion[%s] failed: %v",
+			taskID, dependInfo.Cluster.SystemID, err)
+		cloudprovider.GetStorageModel().CreateTaskStepLogError(context.Background(), taskID, stepName,
+			fmt.Sprintf("upgrade cluster version to %s failed [%s]", version, err))
+		retErr := fmt.Errorf("UpgradeClusterVersionTask UpdateClusterVersion failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		fmt.Sprintf("submit cluster upgrade to version %s successful", version))
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpgradeClusterVersionTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// CheckClusterVersionStatusTask check tke cluster control plane upgrade status
+func CheckClusterVersionStatusTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CheckClusterVersionStatusTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CheckClusterVersionStatusTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("CheckClusterVersionStatusTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDAndStepNameForContext(context.Background(), taskID, stepName)
+	err = checkClusterVersionStatus(ctx, dependInfo, version)
+	if err != nil {
+		blog.Errorf("CheckClusterVersionStatusTask[%s] checkClusterVersionStatus failed: %v", taskID, err)
+		retErr := fmt.Errorf("CheckClusterVersionStatusTask[%s] failed: %v", clusterID, err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+		fmt.Sprintf("cluster control plane upgrade to version %s successful", version))
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CheckClusterVersionStatusTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// checkClusterVersionStatus wait cluster running with target version
+func checkClusterVersionStatus(ctx context.Context, info *cloudprovider.CloudDependBasicInfo, version string) error {
+	taskID, stepName := cloudprovider.GetTaskIDAndStepNameFromContext(ctx)
+
+	cli, err := api.NewTkeClient(info.CmOption)
+	if err != nil {
+		blog.Errorf("checkClusterVersionStatus[%s] get tke client failed: %s", taskID, err.Error())
+		return fmt.Errorf("get cloud tke client err, %s", err.Error())
+	}
+
+	var (
+		abnormal = false
+	)
+
+	timeout := cloudprovider.GetTaskTimeout(ctx, info.Cluster.ProjectID, info.Cluster.ClusterID, stepName, time.Hour)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// loop cluster status and version
+	err = loop.LoopDoFunc(ctx, func() error {
+		cluster, errGet := cli.GetTKECluster(info.Cluster.SystemID)
+		if errGet != nil {
+			blog.Errorf("checkClusterVersionStatus[%s] GetTKECluster failed: %v", taskID, errGet)
+			return nil
+		}
+		if cluster.ClusterStatus == nil || cluster.ClusterVersion == nil {
+			return nil
+		}
+
+		blog.Infof("checkClusterVersionStatus[%s] cluster[%s] current status[%s] version[%s]", taskID,
+			info.Cluster.ClusterID, *cluster.ClusterStatus, *cluster.ClusterVersion)
+
+		switch *cluster.ClusterStatus {
+		case api.ClusterStatusRunning:
+			// cluster status is running before upgrading started
+			if pcommon.CompareVersion(*cluster.ClusterVersion, version) >= 0 {
+				return loop.EndLoop
+			}
+		case api.ClusterStatusAbnormal:
+			abnormal = true
+			return loop.EndLoop
+		}
+
+		return nil
+	}, loop.LoopInterval(30*time.Second))
+	if err != nil {
+		blog.Errorf("checkClusterVersionStatus[%s] cluster[%s] failed: %v", taskID, info.Cluster.ClusterID, err)
+		return err
+	}
+
+	if abnormal {
+		blog.Errorf("checkClusterVersionStatus[%s] GetTKECluster[%s] failed: abnormal", taskID, info.Cluster.ClusterID)
+		return fmt.Errorf("cluster[%s] status abnormal", info.Cluster.ClusterID)
+	}
+
+	return nil
+}
+
+// RollingReplaceNodeGroupsTask rolling replace nodeGroup nodes whose kubelet version is older than cluster version
+func RollingReplaceNodeGroupsTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("RollingReplaceNodeGroupsTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("RollingReplaceNodeGroupsTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+	groupIDs := strings.Split(step.Params[cloudprovider.UpgradeNodeGroupIDsKey.String()], ",")
+	strategy := pcommon.UnmarshalUpgradeStrategy(step.Params[cloudprovider.UpgradeStrategyKey.String()])
+
+	if len(clusterID) == 0 || len(cloudID) == 0 || len(version) == 0 {
+		blog.Errorf("RollingReplaceNodeGroupsTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("RollingReplaceNodeGroupsTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDAndStepNameForContext(context.Background(), taskID, stepName)
+	for _, groupID := range groupIDs {
+		if groupID == "" {
+			continue
+		}
+
+		dependInfo, errGet := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+			ClusterID:   clusterID,
+			CloudID:     cloudID,
+			NodeGroupID: groupID,
+		})
+		if errGet != nil {
+			blog.Errorf("RollingReplaceNodeGroupsTask[%s]: GetClusterDependBasicInfo failed: %s", taskID, errGet)
+			retErr := fmt.Errorf("RollingReplaceNodeGroupsTask GetClusterDependBasicInfo failed")
+			_ = state.UpdateStepFailure(start, stepName, retErr)
+			return retErr
+		}
+
+		err = rollingReplaceNodeGroup(ctx, dependInfo, version, strategy)
+		if err != nil {
+			blog.Errorf("RollingReplaceNodeGroupsTask[%s]: nodeGroup[%s] failed: %v", taskID, groupID, err)
+			cloudprovider.GetStorageModel().CreateTaskStepLogError(context.Background(), taskID, stepName,
+				fmt.Sprintf("rolling replace nodeGroup %s failed [%s]", groupID, err))
+			retErr := fmt.Errorf("RollingReplaceNodeGroupsTask nodeGroup[%s] failed: %v", groupID, err)
+			_ = state.UpdateStepFailure(start, stepName, retErr)
+			return retErr
+		}
+
+		cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+			fmt.Sprintf("rolling replace nodeGroup %s successful", groupID))
+	}
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("RollingReplaceNodeGroupsTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// outdatedNode nodeGroup node which need to be replaced
+type outdatedNode struct {
+	nodeID   string
+	nodeIP   string
+	nodeName string
+}
+
+// rollingReplaceNodeGroup replace outdated nodes by batch, each batch scale out maxSurge nodes firstly
+// and then cordon/drain/remove the same number of outdated nodes. outdated nodes are recomputed from
+// kubelet version, thus retry task continue with the remained nodes
+func rollingReplaceNodeGroup(ctx context.Context, info *cloudprovider.CloudDependBasicInfo, version string,
+	strategy *proto.NodeGroupUpgradeStrategy) error {
+	taskID, stepName := cloudprovider.GetTaskIDAndStepNameFromContext(ctx)
+
+	nodes, err := listOutdatedNodes(ctx, info, version)
+	if err != nil {
+		return err
+	}
+	if len(nodes) == 0 {
+		blog.Infof("rollingReplaceNodeGroup[%s] nodeGroup[%s] no outdated nodes", taskID, info.NodeGroup.NodeGroupID)
+		return nil
+	}
+
+	asgID, err := getAsgIDByNodePool(ctx, info)
+	if err != nil {
+		return err
+	}
+
+	surge := int(strategy.GetMaxSurge())
+	for i := 0; i < len(nodes); i += surge {
+		end := i + surge
+		if end > len(nodes) {
+			end = len(nodes)
+		}
+		batch := nodes[i:end]
+
+		cloudprovider.GetStorageModel().CreateTaskStepLogInfo(context.Background(), taskID, stepName,
+			fmt.Sprintf("nodeGroup %s replace nodes %d-%d/%d", info.NodeGroup.NodeGroupID, i+1, end, len(nodes)))
+
+		// scale out new nodes
+		newInstanceIDs, errSurge := surgeNodeGroupInstances(ctx, info, len(batch))
+		if errSurge != nil {
+			return errSurge
+		}
+
+		// cordon and drain old nodes
+		names, ids := make([]string, 0), make([]string, 0)
+		for _, n := range batch {
+			names = append(names, n.nodeName)
+			ids = append(ids, n.nodeID)
+		}
+		err = pcommon.CordonAndDrainNodes(ctx, info.Cluster.ClusterID, names, strategy)
+		if err != nil {
+			return err
+		}
+
+		// remove old instances, asg desired capacity is decreased by removed instances
+		err = destroyAsgInstances(ctx, info, asgID, ids)
+		if err != nil {
+			return err
+		}
+		for _, n := range batch {
+			if errDel := cloudprovider.GetStorageModel().DeleteClusterNodeByIP(context.Background(),
+				info.Cluster.ClusterID, n.nodeIP); errDel != nil {
+				blog.Errorf("rollingReplaceNodeGroup[%s] DeleteClusterNodeByIP[%s] failed: %v", taskID, n.nodeIP, errDel)
+			}
+		}
+
+		blog.Infof("rollingReplaceNodeGroup[%s] nodeGroup[%s] replace nodes %v with %v successful",
+			taskID, info.NodeGroup.NodeGroupID, ids, newInstanceIDs)
+	}
+
+	return nil
+}
+
+// surgeNodeGroupInstances scale out nodeGroup instances and wait instances join cluster
+func surgeNodeGroupInstances(ctx context.Context, info *cloudprovider.CloudDependBasicInfo, num int) ([]string, error) {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+
+	activity, err := applyInstanceMachines(ctx, info, uint64(num))
+	if err != nil {
+		return nil, err
+	}
+
+	instanceIDs := make([]string, 0)
+	for _, ins := range activity.ActivityRelatedInstanceSet {
+		if ins.InstanceStatus != nil && *ins.InstanceStatus == api.SuccessfulInstanceAS.String() {
+			instanceIDs = append(instanceIDs, *ins.InstanceId)
+		}
+	}
+	if len(instanceIDs) == 0 {
+		return nil, fmt.Errorf("nodeGroup[%s] scale out instances empty", info.NodeGroup.NodeGroupID)
+	}
+
+	_, err = transInstancesToNode(ctx, instanceIDs, info)
+	if err != nil {
+		blog.Errorf("surgeNodeGroupInstances[%s] transInstancesToNode failed: %v", taskID, err)
+	}
+
+	success, failure, err := business.CheckClusterInstanceStatus(ctx, info, instanceIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(failure) > 0 {
+		blog.Errorf("surgeNodeGroupInstances[%s] instances[%v] join cluster failed", taskID, failure)
+		return nil, fmt.Errorf("instances[%s] join cluster failed", strings.Join(failure, ","))
+	}
+
+	return success, nil
+}
+
+// listOutdatedNodes list nodeGroup nodes whose kubelet version is older than cluster version
+func listOutdatedNodes(ctx context.Context, info *cloudprovider.CloudDependBasicInfo,
+	version string) ([]outdatedNode, error) {
+	nodes, err := cloudprovider.GetClusterOrPoolNodes(info.Cluster.ClusterID, info.NodeGroup.NodeGroupID)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+
+	ips := make([]string, 0)
+	for _, n := range nodes {
+		ips = append(ips, n.InnerIP)
+	}
+	k8sOperator := clusterops.NewK8SOperator(options.GetGlobalCMOptions(), cloudprovider.GetStorageModel())
+	k8sNodes, err := k8sOperator.ListClusterNodesByIPsOrNames(ctx, clusterops.ListNodeOption{
+		ClusterID: info.Cluster.ClusterID,
+		NodeIPs:   ips,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ipToNode := make(map[string]*v1.Node)
+	for _, n := range k8sNodes {
+		for _, addr := range n.Status.Addresses {
+			if addr.Type == v1.NodeInternalIP {
+				ipToNode[addr.Address] = n
+			}
+		}
+	}
+
+	outdated := make([]outdatedNode, 0)
+	for _, n := range nodes {
+		node, ok := ipToNode[n.InnerIP]
+		if !ok || !pcommon.IsNodeVersionOutdated(node.Status.NodeInfo.KubeletVersion, version) {
+			continue
+		}
+		outdated = append(outdated, outdatedNode{
+			nodeID:   n.NodeID,
+			nodeIP:   n.InnerIP,
+			nodeName: node.Name,
+		})
+	}
+
+	return outdated, nil
+}
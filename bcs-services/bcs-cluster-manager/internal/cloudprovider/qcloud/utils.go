@@ -76,6 +76,10 @@ const (
 	switchAutoScalingOptionStatusTemplate = "tke-switch auto scaling option status: %s"
 	// switchClusterNetworkTemplate bk-sops add task template
 	switchClusterNetworkTemplate = "tke-update cluster network: %s"
+	// upgradeClusterVersionTemplate bk-sops add task template
+	upgradeClusterVersionTemplate = "tke-upgrade cluster version: %s/%s"
+	// upgradeNodeGroupVersionTemplate bk-sops add task template
+	upgradeNodeGroupVersionTemplate = "tke-upgrade node group version: %s/%s"
 )
 
 // step task name&method
@@ -288,6 +292,22 @@ var (
 		StepMethod: fmt.Sprintf("%s-CloseClusterVpcCniTask", cloudName),
 		StepName:   "关闭集群VPC-CNI网络模式",
 	}
+
+	// upgradeClusterVersionStep upgrade cluster control plane version
+	upgradeClusterVersionStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-UpgradeClusterVersionTask", cloudName),
+		StepName:   "升级集群控制面版本",
+	}
+	// checkClusterVersionStatusStep check cluster upgrade status
+	checkClusterVersionStatusStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CheckClusterVersionStatusTask", cloudName),
+		StepName:   "检测集群升级状态",
+	}
+	// rollingReplaceNodeGroupsStep rolling replace nodeGroup nodes
+	rollingReplaceNodeGroupsStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-RollingReplaceNodeGroupsTask", cloudName),
+		StepName:   "滚动替换节点池节点",
+	}
 )
 
 // CreateClusterTaskOption 创建集群构建step子任务
@@ -1133,3 +1153,49 @@ func (sn *SwitchClusterNetworkTaskOption) BuildClusterCloseVpcCniStep(task *prot
 	task.Steps[closeClusterVpcCniStep.StepMethod] = closeVpcCniStep
 	task.StepSequence = append(task.StepSequence, closeClusterVpcCniStep.StepMethod)
 }
+
+// UpgradeVersionTaskOption 升级集群/节点池版本
+type UpgradeVersionTaskOption struct {
+	Cluster      *proto.Cluster
+	Version      string
+	NodeGroupIDs []string
+	Strategy     string
+}
+
+// BuildUpgradeClusterVersionStep 升级集群控制面版本
+func (uv *UpgradeVersionTaskOption) BuildUpgradeClusterVersionStep(task *proto.Task) {
+	upgradeStep := cloudprovider.InitTaskStep(upgradeClusterVersionStep)
+
+	upgradeStep.Params[cloudprovider.ClusterIDKey.String()] = uv.Cluster.ClusterID
+	upgradeStep.Params[cloudprovider.CloudIDKey.String()] = uv.Cluster.Provider
+	upgradeStep.Params[cloudprovider.ClusterVersionKey.String()] = uv.Version
+
+	task.Steps[upgradeClusterVersionStep.StepMethod] = upgradeStep
+	task.StepSequence = append(task.StepSequence, upgradeClusterVersionStep.StepMethod)
+}
+
+// BuildCheckClusterVersionStatusStep 检测集群升级状态
+func (uv *UpgradeVersionTaskOption) BuildCheckClusterVersionStatusStep(task *proto.Task) {
+	checkStep := cloudprovider.InitTaskStep(checkClusterVersionStatusStep)
+
+	checkStep.Params[cloudprovider.ClusterIDKey.String()] = uv.Cluster.ClusterID
+	checkStep.Params[cloudprovider.CloudIDKey.String()] = uv.Cluster.Provider
+	checkStep.Params[cloudprovider.ClusterVersionKey.String()] = uv.Version
+
+	task.Steps[checkClusterVersionStatusStep.StepMethod] = checkStep
+	task.StepSequence = append(task.StepSequence, checkClusterVersionStatusStep.StepMethod)
+}
+
+// BuildRollingReplaceNodeGroupsStep 滚动替换节点池节点
+func (uv *UpgradeVersionTaskOption) BuildRollingReplaceNodeGroupsStep(task *proto.Task) {
+	replaceStep := cloudprovider.InitTaskStep(rollingReplaceNodeGroupsStep)
+
+	replaceStep.Params[cloudprovider.ClusterIDKey.String()] = uv.Cluster.ClusterID
+	replaceStep.Params[cloudprovider.CloudIDKey.String()] = uv.Cluster.Provider
+	replaceStep.Params[cloudprovider.ClusterVersionKey.String()] = uv.Version
+	replaceStep.Params[cloudprovider.UpgradeNodeGroupIDsKey.String()] = strings.Join(uv.NodeGroupIDs, ",")
+	replaceStep.Params[cloudprovider.UpgradeStrategyKey.String()] = uv.Strategy
+
+	task.Steps[rollingReplaceNodeGroupsStep.StepMethod] = replaceStep
+	task.StepSequence = append(task.StepSequence, rollingReplaceNodeGroupsStep.StepMethod)
+}
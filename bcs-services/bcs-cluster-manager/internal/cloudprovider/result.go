@@ -56,6 +56,8 @@ var (
 	DeleteExternalNodeJob JobType = "delete-external-node"
 	// SwitchClusterNetworkJob for switchClusterNetwork job
 	SwitchClusterNetworkJob JobType = "switch-cluster-network"
+	// UpgradeClusterVersionJob for upgradeClusterVersion job
+	UpgradeClusterVersionJob JobType = "upgrade-cluster-version"
 
 	// CreateNodeGroupJob for createNodeGroup job
 	CreateNodeGroupJob JobType = "create-nodegroup"
@@ -67,6 +69,8 @@ var (
 	UpdateNodeGroupDesiredNodeJob JobType = "update-nodegroup-desired-node"
 	// CleanNodeGroupNodesJob for cleanNodeGroupNodes job
 	CleanNodeGroupNodesJob JobType = "clean-nodegroup-nodes"
+	// UpgradeNodeGroupVersionJob for upgradeNodeGroupVersion job
+	UpgradeNodeGroupVersionJob JobType = "upgrade-nodegroup-version"
 
 	// MoveNodesToNodeGroupJob for moveNodesToNodeGroup job
 	MoveNodesToNodeGroupJob JobType = "move-nodes-to-nodegroup"
@@ -201,6 +205,12 @@ func (sjr *SyncJobResult) UpdateJobResultStatus(isSuccess bool) error {
 	case SwitchClusterNetworkJob:
 		sjr.Status = generateStatusResult("", common.TaskStatusFailure)
 		return sjr.updateClusterNetworkStatus(isSuccess)
+	case UpgradeClusterVersionJob:
+		sjr.Status = generateStatusResult(common.StatusRunning, common.StatusUpgradeClusterFailed)
+		return sjr.updateClusterResultStatus(isSuccess)
+	case UpgradeNodeGroupVersionJob:
+		sjr.Status = generateStatusResult(common.StatusRunning, common.StatusNodeGroupUpdateFailed)
+		return sjr.updateNodeGroupStatus(isSuccess)
 	}
 
 	return fmt.Errorf(ErrJobType, sjr.JobType)
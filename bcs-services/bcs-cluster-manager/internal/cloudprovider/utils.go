@@ -109,6 +109,10 @@ const (
 	NodeDrainPodAction = "nodeDrainPod"
 	// InstallLogCollectorAddonAction 安装日志采集组件
 	InstallLogCollectorAddonAction = "installLogCollectorAddon" // nolint:gosec
+	// CheckClusterUpgradeAction 集群升级前置检查
+	CheckClusterUpgradeAction = "checkClusterUpgrade"
+	// UpdateClusterVersionAction 更新集群版本信息
+	UpdateClusterVersionAction = "updateClusterVersion"
 )
 
 var (
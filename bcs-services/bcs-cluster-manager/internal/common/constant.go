@@ -74,6 +74,8 @@ var (
 	Task ResourceType = "task"
 	// Account type
 	Account ResourceType = "account"
+	// NodeTemplate type
+	NodeTemplate ResourceType = "nodetemplate"
 	// DiskType disk type
 	DiskType = map[string]string{
 		"CLOUD_BASIC":   "普通云硬盘",
@@ -433,6 +435,10 @@ const (
 	StatusDeleted = "DELETED"
 	// StatusDeleteClusterFailed status delete failed
 	StatusDeleteClusterFailed = "DELETE-FAILURE"
+	// StatusUpgrading status upgrading cluster version
+	StatusUpgrading = "UPGRADING"
+	// StatusUpgradeClusterFailed status upgrade cluster version failed
+	StatusUpgradeClusterFailed = "UPGRADE-FAILURE"
 	// StatusAddNodesFailed status add nodes failed
 	StatusAddNodesFailed = "ADD-FAILURE"
 	// StatusRemoveNodesFailed status remove nodes failed
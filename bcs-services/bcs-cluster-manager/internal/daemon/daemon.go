@@ -37,6 +37,8 @@ type DaemonOptions struct { // nolint
 	EnableAllocateCidrDaemon bool
 	EnableInsTypeUsage       bool
 	EnableAzureTaint         bool
+	EnableAutoRepair         bool
+	AutoRepairLimit          uint32
 }
 
 // DoFunc func() type
@@ -50,6 +52,7 @@ type Daemon struct {
 	model    store.ClusterManagerModel
 	lock     lock.DistributedLock
 	options  DaemonOptions
+	repairer nodeGroupRepairer
 }
 
 // NewDaemon init daemon
@@ -68,6 +71,7 @@ func NewDaemon(interval int, model store.ClusterManagerModel,
 		lock:     lock,
 		interval: interval,
 		options:  options,
+		repairer: &actionRepairer{model: model, lock: lock},
 	}
 }
 
@@ -129,6 +133,12 @@ func (d *Daemon) InitDaemon(ctx context.Context) {
 		}, 20)
 	}
 
+	if d.options.EnableAutoRepair {
+		go d.simpleDaemon(ctx, &wg, func() {
+			d.autoRepairNodeGroupNodes(errChan)
+		}, 60)
+	}
+
 	wg.Wait()
 }
 
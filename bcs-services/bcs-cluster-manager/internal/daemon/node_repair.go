@@ -0,0 +1,428 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	v1 "k8s.io/api/core/v1"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/nodegroup"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/clusterops"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/utils"
+)
+
+const (
+	autoRepairNodeGroupNodes = "autoRepairNodeGroupNodes"
+	// autoRepairOperator operator of auto repair tasks, recorded in task commonParams to filter repair tasks
+	autoRepairOperator = "bcs-auto-repair"
+	// autoRepairReAddKey clean task commonParams key, record re-add task of repaired nodes
+	autoRepairReAddKey = "AutoRepairReAddTask"
+	// autoRepairReAddFailed re-add task failed to create
+	autoRepairReAddFailed = "failed"
+
+	defaultRepairGracePeriod    = 600
+	defaultMaxConcurrentRepairs = 1
+	defaultAutoRepairLimit      = 10
+	// autoRepairTaskWindow repair tasks started before the window are ignored
+	autoRepairTaskWindow = 24 * time.Hour
+)
+
+// nodeGroupRepairer run nodeGroup actions for auto repair
+type nodeGroupRepairer interface {
+	CleanNodes(ctx context.Context, req *cmproto.CleanNodesInGroupRequest, resp *cmproto.CleanNodesInGroupResponse)
+	UpdateDesiredNode(ctx context.Context, req *cmproto.UpdateGroupDesiredNodeRequest,
+		resp *cmproto.UpdateGroupDesiredNodeResponse)
+}
+
+// actionRepairer repair nodeGroup nodes by nodeGroup actions
+type actionRepairer struct {
+	model store.ClusterManagerModel
+	lock  lock.DistributedLock
+}
+
+// CleanNodes clean nodes in nodeGroup
+func (r *actionRepairer) CleanNodes(ctx context.Context, req *cmproto.CleanNodesInGroupRequest,
+	resp *cmproto.CleanNodesInGroupResponse) {
+	nodegroup.NewCleanNodesAction(r.model, r.lock).Handle(ctx, req, resp)
+}
+
+// UpdateDesiredNode update nodeGroup desired node
+func (r *actionRepairer) UpdateDesiredNode(ctx context.Context, req *cmproto.UpdateGroupDesiredNodeRequest,
+	resp *cmproto.UpdateGroupDesiredNodeResponse) {
+	nodegroup.NewUpdateDesiredNodeAction(r.model, r.lock).Handle(ctx, req, resp)
+}
+
+// autoRepairNodeGroupNodes 节点池开启自动修复后, 节点持续异常超过宽限期时, 通过节点池下架节点流程
+// (封锁->驱逐->下架) 移除异常节点, 下架成功后再扩容相同数量的节点补充
+func (d *Daemon) autoRepairNodeGroupNodes(error chan<- error) {
+	d.lock.Lock(autoRepairNodeGroupNodes, []lock.LockOption{lock.LockTTL(time.Second * 50)}...) // nolint
+	defer d.lock.Unlock(autoRepairNodeGroupNodes)                                               // nolint
+
+	defer utils.RecoverPrintStack(autoRepairNodeGroupNodes)
+
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{"status": common.StatusRunning})
+	groupList, err := d.model.ListNodeGroup(d.ctx, cond, &storeopt.ListOption{All: true})
+	if err != nil {
+		blog.Errorf("autoRepairNodeGroupNodes ListNodeGroup failed: %v", err)
+		error <- err
+		return
+	}
+
+	clusterGroups := make(map[string][]*cmproto.NodeGroup)
+	for i := range groupList {
+		if !groupList[i].GetAutoRepair().GetEnable() {
+			continue
+		}
+		clusterGroups[groupList[i].ClusterID] = append(clusterGroups[groupList[i].ClusterID], groupList[i])
+	}
+
+	// limit repair nodes of all clusters in one round
+	budget := int(d.options.AutoRepairLimit)
+	if budget <= 0 {
+		budget = defaultAutoRepairLimit
+	}
+
+	for clusterID, groups := range clusterGroups {
+		repaired, errLocal := d.autoRepairClusterNodes(clusterID, groups, budget)
+		if errLocal != nil {
+			blog.Errorf("autoRepairNodeGroupNodes cluster[%s] failed: %v", clusterID, errLocal)
+			error <- errLocal
+		}
+		budget -= repaired
+	}
+}
+
+// autoRepairClusterNodes repair nodeGroups nodes of cluster, return repairing nodes num
+func (d *Daemon) autoRepairClusterNodes(clusterID string, groups []*cmproto.NodeGroup, budget int) (int, error) {
+	cls, err := d.model.GetCluster(d.ctx, clusterID)
+	if err != nil {
+		return 0, err
+	}
+	if cls.Status != common.StatusRunning {
+		blog.Infof("autoRepairClusterNodes cluster[%s] status %s, skip", clusterID, cls.Status)
+		return 0, nil
+	}
+
+	if !ConnectToCluster(d.model, clusterID) {
+		return 0, fmt.Errorf("autoRepairClusterNodes ConnectToCluster[%s] failed", clusterID)
+	}
+	k8sOperator := clusterops.NewK8SOperator(options.GetGlobalCMOptions(), d.model)
+	k8sNodes, err := k8sOperator.ListClusterNodes(d.ctx, clusterID)
+	if err != nil {
+		return 0, err
+	}
+
+	return d.repairClusterGroups(cls, groups, k8sNodes, budget), nil
+}
+
+// repairClusterGroups run one repair round for nodeGroups of cluster, return repairing nodes num
+func (d *Daemon) repairClusterGroups(cls *cmproto.Cluster, groups []*cmproto.NodeGroup,
+	k8sNodes []*v1.Node, budget int) int {
+	repaired := 0
+	for _, group := range groups {
+		tasks, errLocal := d.listAutoRepairTasks(group.NodeGroupID)
+		if errLocal != nil {
+			blog.Errorf("autoRepairClusterNodes listAutoRepairTasks[%s] failed: %v", group.NodeGroupID, errLocal)
+			continue
+		}
+		// re-add nodes for successfully cleaned nodes
+		d.reAddRepairedNodes(cls, group.NodeGroupID, tasks)
+
+		if budget-repaired <= 0 {
+			continue
+		}
+
+		quota := int(group.GetAutoRepair().GetMaxConcurrentRepairs())
+		if quota == 0 {
+			quota = defaultMaxConcurrentRepairs
+		}
+		quota -= countRepairingNodes(tasks)
+		if quota > budget-repaired {
+			quota = budget - repaired
+		}
+		if quota <= 0 {
+			blog.Infof("autoRepairClusterNodes nodeGroup[%s] reach repair limit, skip", group.NodeGroupID)
+			continue
+		}
+
+		num, errLocal := d.repairNodeGroupNodes(cls, group, k8sNodes, quota)
+		if errLocal != nil {
+			blog.Errorf("autoRepairClusterNodes repairNodeGroupNodes[%s] failed: %v", group.NodeGroupID, errLocal)
+			continue
+		}
+		repaired += num
+	}
+
+	return repaired
+}
+
+// repairNodeGroupNodes clean unhealthy nodes of nodeGroup, at most quota nodes
+func (d *Daemon) repairNodeGroupNodes(cls *cmproto.Cluster, group *cmproto.NodeGroup,
+	k8sNodes []*v1.Node, quota int) (int, error) {
+	condNode := operator.NewLeafCondition(operator.Eq, operator.M{
+		"clusterid":   cls.ClusterID,
+		"nodegroupid": group.NodeGroupID,
+	})
+	nodes, err := d.model.ListNode(d.ctx, condNode, &storeopt.ListOption{})
+	if err != nil {
+		return 0, err
+	}
+	groupNodes := make(map[string]*cmproto.Node)
+	for i := range nodes {
+		if nodes[i].Status == common.StatusRunning {
+			groupNodes[nodes[i].InnerIP] = nodes[i]
+		}
+	}
+
+	var (
+		policy      = group.GetAutoRepair()
+		conditions  = policy.GetConditions()
+		gracePeriod = time.Duration(policy.GetGracePeriod()) * time.Second
+		now         = time.Now()
+		unhealthy   = make(map[string]string)
+	)
+	if len(conditions) == 0 {
+		conditions = []string{string(v1.NodeReady)}
+	}
+	if gracePeriod == 0 {
+		gracePeriod = defaultRepairGracePeriod * time.Second
+	}
+	for _, n := range k8sNodes {
+		ip := nodeInternalIP(n)
+		if _, ok := groupNodes[ip]; !ok {
+			continue
+		}
+		if reason, ok := nodeUnhealthyReason(n, conditions, gracePeriod, now); ok {
+			unhealthy[ip] = reason
+		}
+	}
+	if len(unhealthy) == 0 {
+		return 0, nil
+	}
+
+	// most nodes of nodeGroup unhealthy may be caused by network or cluster problem, repair nodes not helpful
+	if len(groupNodes) > 1 && len(unhealthy)*2 > len(groupNodes) {
+		blog.Warnf("repairNodeGroupNodes nodeGroup[%s] unhealthy nodes %d more than half of %d, skip repair",
+			group.NodeGroupID, len(unhealthy), len(groupNodes))
+		return 0, nil
+	}
+
+	ips := make([]string, 0, len(unhealthy))
+	for ip := range unhealthy {
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+	if len(ips) > quota {
+		ips = ips[:quota]
+	}
+	reasons := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		reasons = append(reasons, fmt.Sprintf("%s(%s)", ip, unhealthy[ip]))
+	}
+
+	req := &cmproto.CleanNodesInGroupRequest{
+		ClusterID:   cls.ClusterID,
+		NodeGroupID: group.NodeGroupID,
+		Nodes:       ips,
+		Operator:    autoRepairOperator,
+	}
+	resp := &cmproto.CleanNodesInGroupResponse{}
+	d.repairer.CleanNodes(d.ctx, req, resp)
+	if !resp.Result {
+		d.recordAutoRepairLog(cls, group, "", fmt.Sprintf("集群%s节点池%s自动修复下架异常节点%v失败: %s",
+			cls.ClusterID, group.NodeGroupID, reasons, resp.Message))
+		return 0, fmt.Errorf("clean nodes %v failed: %s", ips, resp.Message)
+	}
+
+	d.recordAutoRepairLog(cls, group, resp.GetData().GetTaskID(), fmt.Sprintf("集群%s节点池%s自动修复下架异常节点%v",
+		cls.ClusterID, group.NodeGroupID, reasons))
+	blog.Infof("repairNodeGroupNodes nodeGroup[%s] clean unhealthy nodes %v task[%s]",
+		group.NodeGroupID, reasons, resp.GetData().GetTaskID())
+
+	return len(ips), nil
+}
+
+// reAddRepairedNodes scale out nodeGroup to replace successfully cleaned nodes
+func (d *Daemon) reAddRepairedNodes(cls *cmproto.Cluster, groupID string, tasks []*cmproto.Task) {
+	for _, task := range tasks {
+		if task.CommonParams[cloudprovider.JobTypeKey.String()] != cloudprovider.CleanNodeGroupNodesJob.String() ||
+			task.Status != cloudprovider.TaskStatusSuccess || task.CommonParams[autoRepairReAddKey] != "" {
+			continue
+		}
+
+		nodeNum := len(repairTaskNodeIPs(task))
+		// clean nodes job already decrease desired size when task success
+		group, err := d.model.GetNodeGroup(d.ctx, groupID)
+		if err != nil {
+			blog.Errorf("reAddRepairedNodes GetNodeGroup[%s] failed: %v", groupID, err)
+			return
+		}
+		desired := group.GetAutoScaling().GetDesiredSize() + uint32(nodeNum)
+		if desired > group.GetAutoScaling().GetMaxSize() {
+			desired = group.GetAutoScaling().GetMaxSize()
+		}
+
+		req := &cmproto.UpdateGroupDesiredNodeRequest{
+			NodeGroupID: groupID,
+			DesiredNode: desired,
+			Operator:    autoRepairOperator,
+		}
+		resp := &cmproto.UpdateGroupDesiredNodeResponse{}
+		d.repairer.UpdateDesiredNode(d.ctx, req, resp)
+
+		reAddTask := autoRepairReAddFailed
+		if resp.Result {
+			reAddTask = resp.GetData().GetTaskID()
+		} else {
+			d.recordAutoRepairLog(cls, group, task.TaskID, fmt.Sprintf("集群%s节点池%s自动修复补充%d个节点失败: %s",
+				cls.ClusterID, groupID, nodeNum, resp.Message))
+		}
+		blog.Infof("reAddRepairedNodes nodeGroup[%s] repair task[%s] re-add %d nodes, desired %d, result %s",
+			groupID, task.TaskID, nodeNum, desired, reAddTask)
+
+		// only try once to re-add nodes for every repair task
+		err = d.model.PatchTask(d.ctx, task.TaskID, map[string]interface{}{
+			"commonparams." + autoRepairReAddKey: reAddTask,
+		})
+		if err != nil {
+			blog.Errorf("reAddRepairedNodes PatchTask[%s] failed: %v", task.TaskID, err)
+		}
+	}
+}
+
+// listAutoRepairTasks list recent auto repair tasks of nodeGroup, task creator is nodeGroup creator,
+// so filter repair tasks by operator in commonParams
+func (d *Daemon) listAutoRepairTasks(groupID string) ([]*cmproto.Task, error) {
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		"nodegroupid": groupID,
+		"commonparams." + cloudprovider.OperatorKey.String(): autoRepairOperator,
+	})
+	tasks, err := d.model.ListTask(d.ctx, cond, &storeopt.ListOption{All: true})
+	if err != nil {
+		return nil, err
+	}
+
+	recent := make([]*cmproto.Task, 0)
+	for i := range tasks {
+		start, errLocal := time.Parse(time.RFC3339, tasks[i].Start)
+		if errLocal != nil || time.Since(start) > autoRepairTaskWindow {
+			continue
+		}
+		recent = append(recent, tasks[i])
+	}
+
+	return recent, nil
+}
+
+func (d *Daemon) recordAutoRepairLog(cls *cmproto.Cluster, group *cmproto.NodeGroup, taskID, msg string) {
+	err := d.model.CreateOperationLog(d.ctx, &cmproto.OperationLog{
+		ResourceType: common.NodeGroup.String(),
+		ResourceID:   group.NodeGroupID,
+		TaskID:       taskID,
+		Message:      msg,
+		OpUser:       autoRepairOperator,
+		CreateTime:   time.Now().Format(time.RFC3339),
+		ClusterID:    cls.ClusterID,
+		ProjectID:    cls.ProjectID,
+		ResourceName: group.GetName(),
+	})
+	if err != nil {
+		blog.Errorf("autoRepair nodeGroup[%s] CreateOperationLog failed: %v", group.NodeGroupID, err)
+	}
+}
+
+// countRepairingNodes count nodes which repair flow not finished
+func countRepairingNodes(tasks []*cmproto.Task) int {
+	taskStatus := make(map[string]string, len(tasks))
+	for _, task := range tasks {
+		taskStatus[task.TaskID] = task.Status
+	}
+
+	count := 0
+	for _, task := range tasks {
+		if task.CommonParams[cloudprovider.JobTypeKey.String()] != cloudprovider.CleanNodeGroupNodesJob.String() {
+			continue
+		}
+
+		switch task.Status {
+		case cloudprovider.TaskStatusInit, cloudprovider.TaskStatusRunning, cloudprovider.TaskStatusNotStarted:
+			count += len(repairTaskNodeIPs(task))
+		case cloudprovider.TaskStatusSuccess:
+			reAddTask := task.CommonParams[autoRepairReAddKey]
+			status := taskStatus[reAddTask]
+			if reAddTask == "" || status == cloudprovider.TaskStatusInit || status == cloudprovider.TaskStatusRunning {
+				count += len(repairTaskNodeIPs(task))
+			}
+		}
+	}
+
+	return count
+}
+
+func repairTaskNodeIPs(task *cmproto.Task) []string {
+	ips := make([]string, 0)
+	for _, ip := range strings.Split(task.CommonParams[cloudprovider.NodeIPsKey.String()], ",") {
+		if ip != "" {
+			ips = append(ips, ip)
+		}
+	}
+
+	return ips
+}
+
+func nodeInternalIP(node *v1.Node) string {
+	for _, addr := range node.Status.Addresses {
+		if addr.Type == v1.NodeInternalIP {
+			return addr.Address
+		}
+	}
+
+	return ""
+}
+
+// nodeUnhealthyReason check node conditions, Ready condition abnormal when status is not True,
+// other node problem conditions abnormal when status is True
+func nodeUnhealthyReason(node *v1.Node, conditions []string, gracePeriod time.Duration,
+	now time.Time) (string, bool) {
+	for _, condType := range conditions {
+		for _, c := range node.Status.Conditions {
+			if string(c.Type) != condType {
+				continue
+			}
+
+			abnormal := c.Status == v1.ConditionTrue
+			if c.Type == v1.NodeReady {
+				abnormal = c.Status != v1.ConditionTrue
+			}
+			if abnormal && now.Sub(c.LastTransitionTime.Time) >= gracePeriod {
+				return fmt.Sprintf("%s=%s", c.Type, c.Status), true
+			}
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,361 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	v1 "k8s.io/api/core/v1"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+func newNode(conditions ...v1.NodeCondition) *v1.Node {
+	return &v1.Node{Status: v1.NodeStatus{Conditions: conditions}}
+}
+
+func newCondition(condType v1.NodeConditionType, status v1.ConditionStatus, since time.Time) v1.NodeCondition {
+	return v1.NodeCondition{Type: condType, Status: status, LastTransitionTime: metav1.NewTime(since)}
+}
+
+func TestNodeUnhealthyReason(t *testing.T) {
+	now := time.Now()
+	grace := 5 * time.Minute
+	conditions := []string{string(v1.NodeReady), "KernelDeadlock"}
+
+	tests := []struct {
+		name       string
+		node       *v1.Node
+		wantReason string
+		wantBad    bool
+	}{
+		{
+			name: "healthy",
+			node: newNode(newCondition(v1.NodeReady, v1.ConditionTrue, now.Add(-time.Hour)),
+				newCondition("KernelDeadlock", v1.ConditionFalse, now.Add(-time.Hour))),
+		},
+		{
+			name:       "not ready over grace period",
+			node:       newNode(newCondition(v1.NodeReady, v1.ConditionFalse, now.Add(-10*time.Minute))),
+			wantReason: "Ready=False",
+			wantBad:    true,
+		},
+		{
+			name:       "ready unknown over grace period",
+			node:       newNode(newCondition(v1.NodeReady, v1.ConditionUnknown, now.Add(-grace))),
+			wantReason: "Ready=Unknown",
+			wantBad:    true,
+		},
+		{
+			name: "not ready within grace period",
+			node: newNode(newCondition(v1.NodeReady, v1.ConditionFalse, now.Add(-time.Minute))),
+		},
+		{
+			name: "problem condition over grace period",
+			node: newNode(newCondition(v1.NodeReady, v1.ConditionTrue, now.Add(-time.Hour)),
+				newCondition("KernelDeadlock", v1.ConditionTrue, now.Add(-time.Hour))),
+			wantReason: "KernelDeadlock=True",
+			wantBad:    true,
+		},
+		{
+			name: "condition not configured",
+			node: newNode(newCondition(v1.NodeReady, v1.ConditionTrue, now.Add(-time.Hour)),
+				newCondition(v1.NodeDiskPressure, v1.ConditionTrue, now.Add(-time.Hour))),
+		},
+		{
+			name: "no conditions",
+			node: newNode(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, bad := nodeUnhealthyReason(tt.node, conditions, grace, now)
+			if reason != tt.wantReason || bad != tt.wantBad {
+				t.Errorf("nodeUnhealthyReason() = (%q, %v), want (%q, %v)", reason, bad, tt.wantReason, tt.wantBad)
+			}
+		})
+	}
+}
+
+func newRepairTask(taskID, status, nodeIPs string, params map[string]string) *cmproto.Task {
+	commonParams := map[string]string{
+		cloudprovider.JobTypeKey.String(): cloudprovider.CleanNodeGroupNodesJob.String(),
+		cloudprovider.NodeIPsKey.String(): nodeIPs,
+	}
+	for k, v := range params {
+		commonParams[k] = v
+	}
+	return &cmproto.Task{TaskID: taskID, Status: status, CommonParams: commonParams}
+}
+
+func TestCountRepairingNodes(t *testing.T) {
+	reAdd := func(taskID string) map[string]string {
+		return map[string]string{autoRepairReAddKey: taskID}
+	}
+	tests := []struct {
+		name  string
+		tasks []*cmproto.Task
+		want  int
+	}{
+		{
+			name: "empty",
+			want: 0,
+		},
+		{
+			name: "clean task running",
+			tasks: []*cmproto.Task{
+				newRepairTask("clean-1", cloudprovider.TaskStatusRunning, "10.0.0.1,10.0.0.2", nil),
+				newRepairTask("clean-2", cloudprovider.TaskStatusInit, "10.0.0.3", nil),
+			},
+			want: 3,
+		},
+		{
+			name: "clean task failed",
+			tasks: []*cmproto.Task{
+				newRepairTask("clean-1", cloudprovider.TaskStatusFailure, "10.0.0.1", nil),
+			},
+			want: 0,
+		},
+		{
+			name: "clean task success without re-add task",
+			tasks: []*cmproto.Task{
+				newRepairTask("clean-1", cloudprovider.TaskStatusSuccess, "10.0.0.1,", nil),
+			},
+			want: 1,
+		},
+		{
+			name: "re-add task running",
+			tasks: []*cmproto.Task{
+				newRepairTask("clean-1", cloudprovider.TaskStatusSuccess, "10.0.0.1,10.0.0.2", reAdd("add-1")),
+				{TaskID: "add-1", Status: cloudprovider.TaskStatusRunning},
+			},
+			want: 2,
+		},
+		{
+			name: "re-add task finished",
+			tasks: []*cmproto.Task{
+				newRepairTask("clean-1", cloudprovider.TaskStatusSuccess, "10.0.0.1", reAdd("add-1")),
+				{TaskID: "add-1", Status: cloudprovider.TaskStatusSuccess},
+				newRepairTask("clean-2", cloudprovider.TaskStatusSuccess, "10.0.0.2", reAdd("add-2")),
+				{TaskID: "add-2", Status: cloudprovider.TaskStatusFailure},
+			},
+			want: 0,
+		},
+		{
+			name: "other job type",
+			tasks: []*cmproto.Task{
+				{TaskID: "scale-out", Status: cloudprovider.TaskStatusRunning, CommonParams: map[string]string{
+					cloudprovider.JobTypeKey.String(): cloudprovider.UpdateNodeGroupDesiredNodeJob.String(),
+					cloudprovider.NodeIPsKey.String(): "10.0.0.1",
+				}},
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := countRepairingNodes(tt.tasks); got != tt.want {
+				t.Errorf("countRepairingNodes() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeRepairModel in-memory storage used by auto repair round
+type fakeRepairModel struct {
+	store.ClusterManagerModel
+
+	group *cmproto.NodeGroup
+	nodes []*cmproto.Node
+	tasks []*cmproto.Task
+	logs  []*cmproto.OperationLog
+}
+
+func (m *fakeRepairModel) ListNode(ctx context.Context, cond *operator.Condition,
+	opt *storeopt.ListOption) ([]*cmproto.Node, error) {
+	return m.nodes, nil
+}
+
+func (m *fakeRepairModel) GetNodeGroup(ctx context.Context, groupID string) (*cmproto.NodeGroup, error) {
+	return m.group, nil
+}
+
+func (m *fakeRepairModel) CreateOperationLog(ctx context.Context, log *cmproto.OperationLog) error {
+	m.logs = append(m.logs, log)
+	return nil
+}
+
+func (m *fakeRepairModel) ListTask(ctx context.Context, cond *operator.Condition,
+	opt *storeopt.ListOption) ([]*cmproto.Task, error) {
+	tasks := make([]*cmproto.Task, 0)
+	for _, task := range m.tasks {
+		matched := true
+		for key, value := range cond.Value.(operator.M) {
+			if taskField(task, key) != value {
+				matched = false
+			}
+		}
+		// return copies like storage
+		if matched {
+			tasks = append(tasks, proto.Clone(task).(*cmproto.Task))
+		}
+	}
+	return tasks, nil
+}
+
+func (m *fakeRepairModel) PatchTask(ctx context.Context, taskID string, patchs map[string]interface{}) error {
+	for _, task := range m.tasks {
+		if task.TaskID != taskID {
+			continue
+		}
+		for key, value := range patchs {
+			task.CommonParams[strings.TrimPrefix(key, "commonparams.")] = value.(string)
+		}
+		return nil
+	}
+	return fmt.Errorf("task %s not found", taskID)
+}
+
+func taskField(task *cmproto.Task, key string) string {
+	switch {
+	case key == "nodegroupid":
+		return task.NodeGroupID
+	case key == "creator":
+		return task.Creator
+	case strings.HasPrefix(key, "commonparams."):
+		return task.CommonParams[strings.TrimPrefix(key, "commonparams.")]
+	}
+	return ""
+}
+
+// fakeRepairer create tasks like nodeGroup actions, task creator is nodeGroup creator
+type fakeRepairer struct {
+	model   *fakeRepairModel
+	cleaned [][]string
+	desired []uint32
+}
+
+func (r *fakeRepairer) newTask(jobType cloudprovider.JobType, opUser string, nodes []string) *cmproto.Task {
+	task := &cmproto.Task{
+		TaskID:      fmt.Sprintf("task-%d", len(r.model.tasks)),
+		Status:      cloudprovider.TaskStatusRunning,
+		Start:       time.Now().Format(time.RFC3339),
+		NodeGroupID: r.model.group.NodeGroupID,
+		Creator:     r.model.group.Creator,
+		CommonParams: map[string]string{
+			cloudprovider.JobTypeKey.String():  jobType.String(),
+			cloudprovider.NodeIPsKey.String():  strings.Join(nodes, ","),
+			cloudprovider.OperatorKey.String(): opUser,
+		},
+	}
+	r.model.tasks = append(r.model.tasks, task)
+	return task
+}
+
+func (r *fakeRepairer) CleanNodes(ctx context.Context, req *cmproto.CleanNodesInGroupRequest,
+	resp *cmproto.CleanNodesInGroupResponse) {
+	r.cleaned = append(r.cleaned, req.Nodes)
+	resp.Result = true
+	resp.Data = r.newTask(cloudprovider.CleanNodeGroupNodesJob, req.Operator, req.Nodes)
+}
+
+func (r *fakeRepairer) UpdateDesiredNode(ctx context.Context, req *cmproto.UpdateGroupDesiredNodeRequest,
+	resp *cmproto.UpdateGroupDesiredNodeResponse) {
+	r.desired = append(r.desired, req.DesiredNode)
+	resp.Result = true
+	resp.Data = r.newTask(cloudprovider.UpdateNodeGroupDesiredNodeJob, req.Operator, nil)
+}
+
+func newK8sNode(ip string, ready v1.ConditionStatus) *v1.Node {
+	node := newNode(newCondition(v1.NodeReady, ready, time.Now().Add(-time.Hour)))
+	node.Status.Addresses = []v1.NodeAddress{{Type: v1.NodeInternalIP, Address: ip}}
+	return node
+}
+
+func TestRepairClusterGroupsRound(t *testing.T) {
+	cls := &cmproto.Cluster{ClusterID: "BCS-K8S-00001", ProjectID: "project"}
+	group := &cmproto.NodeGroup{
+		NodeGroupID: "BCSNG-00001",
+		ClusterID:   cls.ClusterID,
+		Creator:     "admin",
+		AutoScaling: &cmproto.AutoScalingGroup{DesiredSize: 4, MaxSize: 10},
+		AutoRepair:  &cmproto.NodeGroupAutoRepair{Enable: true, MaxConcurrentRepairs: 1},
+	}
+	model := &fakeRepairModel{group: group}
+	ips := []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"}
+	for _, ip := range ips {
+		model.nodes = append(model.nodes, &cmproto.Node{InnerIP: ip, Status: common.StatusRunning})
+	}
+	k8sNodes := []*v1.Node{
+		newK8sNode(ips[0], v1.ConditionFalse),
+		newK8sNode(ips[1], v1.ConditionUnknown),
+		newK8sNode(ips[2], v1.ConditionTrue),
+		newK8sNode(ips[3], v1.ConditionTrue),
+	}
+	repairer := &fakeRepairer{model: model}
+	d := &Daemon{ctx: context.Background(), model: model, repairer: repairer}
+	groups := []*cmproto.NodeGroup{group}
+
+	// round 1: clean one unhealthy node limited by max concurrent repairs
+	if got := d.repairClusterGroups(cls, groups, k8sNodes, 10); got != 1 {
+		t.Fatalf("round 1 repaired %d nodes, want 1", got)
+	}
+	cleanTask := model.tasks[0]
+
+	// round 2: clean task running, no more repair
+	if got := d.repairClusterGroups(cls, groups, k8sNodes, 10); got != 0 {
+		t.Fatalf("round 2 repaired %d nodes, want 0", got)
+	}
+
+	// clean task success, node removed and desired size decreased
+	cleanTask.Status = cloudprovider.TaskStatusSuccess
+	group.AutoScaling.DesiredSize = 3
+	model.nodes, k8sNodes = model.nodes[1:], k8sNodes[1:]
+
+	// round 3: re-add repaired node, re-add task running blocks next repair
+	if got := d.repairClusterGroups(cls, groups, k8sNodes, 10); got != 0 {
+		t.Fatalf("round 3 repaired %d nodes, want 0", got)
+	}
+	reAddTask := model.tasks[1]
+	if cleanTask.CommonParams[autoRepairReAddKey] != reAddTask.TaskID {
+		t.Fatalf("clean task re-add task %q, want %q", cleanTask.CommonParams[autoRepairReAddKey], reAddTask.TaskID)
+	}
+
+	// round 4: re-add task finished, repair next unhealthy node and never re-add twice
+	reAddTask.Status = cloudprovider.TaskStatusSuccess
+	if got := d.repairClusterGroups(cls, groups, k8sNodes, 10); got != 1 {
+		t.Fatalf("round 4 repaired %d nodes, want 1", got)
+	}
+
+	if want := [][]string{{ips[0]}, {ips[1]}}; !reflect.DeepEqual(repairer.cleaned, want) {
+		t.Errorf("cleaned nodes %v, want %v", repairer.cleaned, want)
+	}
+	if want := []uint32{4}; !reflect.DeepEqual(repairer.desired, want) {
+		t.Errorf("desired nodes %v, want %v", repairer.desired, want)
+	}
+	if len(model.logs) != 2 {
+		t.Errorf("operation logs %d, want 2", len(model.logs))
+	}
+}
@@ -547,6 +547,40 @@ func (cm *ClusterManager) SwitchClusterUnderlayNetwork(ctx context.Context,
 	return nil
 }
 
+// UpgradeClusterVersion implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) UpgradeClusterVersion(ctx context.Context,
+	req *cmproto.UpgradeClusterVersionReq, resp *cmproto.UpgradeClusterVersionResp) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ua := clusterac.NewUpgradeClusterVersionAction(cm.model)
+	ua.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("UpgradeClusterVersion", "grpc",
+		strconv.Itoa(int(resp.Code)), start)
+
+	blog.Infof("reqID: %s, action: UpgradeClusterVersion, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// ApplyClusterSpec implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) ApplyClusterSpec(ctx context.Context,
+	req *cmproto.ApplyClusterSpecRequest, resp *cmproto.ApplyClusterSpecResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	aa := clusterac.NewApplySpecAction(cm.model, cm.locker)
+	aa.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("ApplyClusterSpec", "grpc",
+		strconv.Itoa(int(resp.Code)), start)
+
+	blog.Infof("reqID: %s, action: ApplyClusterSpec, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
 // GetClusterSharedProject implements interface cmproto.ClusterManagerServer
 func (cm *ClusterManager) GetClusterSharedProject(ctx context.Context,
 	req *cmproto.GetClusterSharedProjectRequest, resp *cmproto.GetClusterSharedProjectResponse) error {
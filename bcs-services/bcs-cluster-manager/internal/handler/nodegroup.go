@@ -376,6 +376,21 @@ func (cm *ClusterManager) DisableNodeGroupAutoScale(ctx context.Context,
 	return nil
 }
 
+// UpgradeNodeGroupVersion implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) UpgradeNodeGroupVersion(ctx context.Context,
+	req *cmproto.UpgradeNodeGroupVersionReq, resp *cmproto.UpgradeNodeGroupVersionResp) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ua := nodegroup.NewUpgradeNodeGroupVersionAction(cm.model)
+	ua.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("UpgradeNodeGroupVersion", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: UpgradeNodeGroupVersion, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
 // GetExternalNodeScriptByGroupID implements interface cmproto.ClusterManagerServer
 func (cm *ClusterManager) GetExternalNodeScriptByGroupID(ctx context.Context,
 	req *cmproto.GetExternalNodeScriptRequest, resp *cmproto.GetExternalNodeScriptResponse) error {
@@ -300,6 +300,9 @@ type DaemonConfig struct {
 	EnableInsTypeUsage bool `json:"enableInsTypeUsage"`
 	EnableAllocateCidr bool `json:"enableAllocateCidr"`
 	EnableAzureTaint   bool `json:"enableAzureTaint"`
+	EnableAutoRepair   bool `json:"enableAutoRepair"`
+	// AutoRepairLimit max nodes repaired in one daemon round for all clusters
+	AutoRepairLimit uint32 `json:"autoRepairLimit"`
 }
 
 // CommonConfig for platform common config
@@ -308,6 +311,48 @@ type CommonConfig struct {
 	SystemNameSpaces string `json:"systemNameSpaces"`
 }
 
+// UpgradeConfig for cluster version upgrade
+type UpgradeConfig struct {
+	// AddonRules 集群升级前置检查的组件兼容性规则
+	AddonRules []AddonCompatibility `json:"addonRules"`
+}
+
+// AddonCompatibility addon minimum version required by kubernetes minor version
+type AddonCompatibility struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// Kind workload kind, Deployment or DaemonSet
+	Kind      string `json:"kind"`
+	Container string `json:"container"`
+	// MinVersions key is kubernetes minor version(e.g. 1.28), value is addon minimum image tag
+	MinVersions map[string]string `json:"minVersions"`
+}
+
+// FakeCloudConfig for fake cloud provider, only used by end-to-end testing, and only registered
+// when cluster-manager is built with `-tags fakecloud`
+type FakeCloudConfig struct {
+	// Latency 模拟云接口调用延迟, 单位毫秒
+	Latency uint32 `json:"latency"`
+	// ProvisionSeconds 模拟集群/节点异步创建就绪耗时, 单位秒
+	ProvisionSeconds uint32 `json:"provisionSeconds"`
+	// StepMaxRetry 任务步骤失败后的自动重试次数
+	StepMaxRetry uint32 `json:"stepMaxRetry"`
+	// Failures 云接口故障注入规则
+	Failures []FakeFailureRule `json:"failures"`
+}
+
+// FakeFailureRule fake cloud api failure injection rule
+type FakeFailureRule struct {
+	// Action 云接口名称, 例如 CreateCluster/ScaleOutNodePool
+	Action string `json:"action"`
+	// Times 前 Times 次调用失败, 用于模拟任务重试
+	Times uint32 `json:"times"`
+	// Rate 调用失败概率, 取值范围 [0, 1]
+	Rate float64 `json:"rate"`
+	// Message 失败时返回的错误信息
+	Message string `json:"message"`
+}
+
 // ClusterManagerOptions options of cluster manager
 type ClusterManagerOptions struct {
 	Etcd               EtcdOption            `json:"etcd"`
@@ -343,6 +388,8 @@ type ClusterManagerOptions struct {
 	Encrypt            encryptv2.Config      `json:"encrypt"`
 	Daemon             DaemonConfig          `json:"daemon"`
 	CommonConfig       CommonConfig          `json:"commonConfig"`
+	Upgrade            UpgradeConfig         `json:"upgrade"`
+	FakeCloud          FakeCloudConfig       `json:"fakeCloud"`
 	ServerConfig
 	ClientConfig
 }
@@ -136,6 +136,24 @@ var auditFuncMap = map[string]func(req server.Request, rsp interface{}) (audit.R
 			ProjectCode:  getProjectIDByCluster(res.ProjectID, res.ClusterID),
 		}, audit.Action{ActionID: "cluster_manage", ActivityType: audit.ActivityTypeUpdate}
 	},
+	"ClusterManager.UpgradeClusterVersion": func(req server.Request, rsp interface{}) (audit.Resource, audit.Action) {
+		res := getResourceID(req)
+		// return the resource to be recorded.
+		return audit.Resource{
+			ResourceType: audit.ResourceTypeCluster, ResourceID: res.ClusterID, ResourceName: res.ClusterID,
+			ResourceData: res.toMap(),
+			ProjectCode:  getProjectIDByCluster(res.ProjectID, res.ClusterID),
+		}, audit.Action{ActionID: "cluster_manage", ActivityType: audit.ActivityTypeUpdate}
+	},
+	"ClusterManager.ApplyClusterSpec": func(req server.Request, rsp interface{}) (audit.Resource, audit.Action) {
+		res := getResourceID(req)
+		// return the resource to be recorded.
+		return audit.Resource{
+			ResourceType: audit.ResourceTypeCluster, ResourceID: res.ClusterID, ResourceName: res.ClusterID,
+			ResourceData: res.toMap(),
+			ProjectCode:  getProjectIDByCluster(res.ProjectID, res.ClusterID),
+		}, audit.Action{ActionID: "cluster_manage", ActivityType: audit.ActivityTypeUpdate}
+	},
 	"ClusterManager.DeleteCluster": func(req server.Request, rsp interface{}) (audit.Resource, audit.Action) {
 		res := getResourceID(req)
 		// return the resource to be recorded.
@@ -244,6 +262,15 @@ var auditFuncMap = map[string]func(req server.Request, rsp interface{}) (audit.R
 			ProjectCode:  getProjectIDByNodeGroup(res.NodeGroupID),
 		}, audit.Action{ActionID: "disable_node_group_auto_scale", ActivityType: audit.ActivityTypeUpdate}
 	},
+	"ClusterManager.UpgradeNodeGroupVersion": func(req server.Request, rsp interface{}) (audit.Resource, audit.Action) {
+		res := getResourceID(req)
+		// return the resource to be recorded.
+		return audit.Resource{
+			ResourceType: audit.ResourceTypeCluster, ResourceID: res.NodeGroupID, ResourceName: res.NodeGroupID,
+			ResourceData: res.toMap(),
+			ProjectCode:  getProjectIDByNodeGroup(res.NodeGroupID),
+		}, audit.Action{ActionID: "upgrade_node_group_version", ActivityType: audit.ActivityTypeUpdate}
+	},
 	"ClusterManager.CreateNodeTemplate": func(req server.Request, rsp interface{}) (audit.Resource, audit.Action) {
 		res := getResourceID(req)
 		// return the resource to be recorded.
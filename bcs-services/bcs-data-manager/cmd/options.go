@@ -124,23 +124,24 @@ type DataManagerOptions struct {
 	conf.LogConfig
 	ClientConfig
 	ServerConfig
-	Mongo                  MongoOption        `json:"mongoConf"`
-	BcsMonitorConf         BcsMonitorConfig   `json:"bcsMonitorConf"`
-	QueueConfig            QueueConfig        `json:"queueConfig"`
-	HandleConfig           HandleConfig       `json:"handleConfig"`
-	Etcd                   EtcdOption         `json:"etcd"`
-	BcsAPIConf             BcsAPIConfig       `json:"bcsApiConf"`
-	Debug                  bool               `json:"debug"`
-	FilterRules            ClusterFilterRules `json:"filterRules"`
-	AppCode                string             `json:"appCode"`
-	AppSecret              string             `json:"appSecret"`
-	ProducerConfig         ProducerConfig     `json:"producerConfig"`
-	KafkaConfig            KafkaConfig        `json:"kafkaConfig"`
-	NeedSendKafka          bool               `json:"needSendKafka"`
-	IgnoreBkMonitorCluster bool               `json:"ignoreBkMonitorCluster"`
-	QueryFromBkMonitor     bool               `json:"queryFromBkMonitor"`
-	BkbaseConfigPath       string             `json:"bkbaseConfigPath"`
-	TspiderConfigPath      string             `json:"tspiderConfigPath"`
+	Mongo                  MongoOption           `json:"mongoConf"`
+	BcsMonitorConf         BcsMonitorConfig      `json:"bcsMonitorConf"`
+	QueueConfig            QueueConfig           `json:"queueConfig"`
+	HandleConfig           HandleConfig          `json:"handleConfig"`
+	Etcd                   EtcdOption            `json:"etcd"`
+	BcsAPIConf             BcsAPIConfig          `json:"bcsApiConf"`
+	Debug                  bool                  `json:"debug"`
+	FilterRules            ClusterFilterRules    `json:"filterRules"`
+	AppCode                string                `json:"appCode"`
+	AppSecret              string                `json:"appSecret"`
+	ProducerConfig         ProducerConfig        `json:"producerConfig"`
+	KafkaConfig            KafkaConfig           `json:"kafkaConfig"`
+	NeedSendKafka          bool                  `json:"needSendKafka"`
+	IgnoreBkMonitorCluster bool                  `json:"ignoreBkMonitorCluster"`
+	QueryFromBkMonitor     bool                  `json:"queryFromBkMonitor"`
+	BkbaseConfigPath       string                `json:"bkbaseConfigPath"`
+	TspiderConfigPath      string                `json:"tspiderConfigPath"`
+	CostPrice              types.CostPriceConfig `json:"costPrice"`
 }
 
 // ClusterFilterRules rules for cluster filter
@@ -153,6 +154,8 @@ type ClusterFilterRules struct {
 // ProducerConfig config for producer
 type ProducerConfig struct {
 	Concurrency int `json:"concurrency"`
+	// CostSpec cron spec of cost job, default "0 2 * * *"
+	CostSpec string `json:"costSpec"`
 }
 
 // NewDataManagerOptions new dataManagerOptions
@@ -450,6 +450,7 @@ func (s *Server) initWorker() error {
 	producerCron := cron.New()
 	s.producer = worker.NewProducer(s.ctx, msgQueue, producerCron, cmCli, k8sStorageCli, mesosStorageCli,
 		s.resourceGetter, s.opt.ProducerConfig.Concurrency, s.opt.NeedSendKafka)
+	s.producer.SetCostSpec(s.opt.ProducerConfig.CostSpec)
 	if err = s.producer.InitCronList(); err != nil {
 		blog.Errorf("init producer cron list error: %v", err)
 		return err
@@ -460,7 +461,10 @@ func (s *Server) initWorker() error {
 		}
 	}
 	// init consumer
-	handlerOpts := worker.HandlerOptions{ChanQueueNum: s.opt.HandleConfig.ChanQueueLen}
+	handlerOpts := worker.HandlerOptions{
+		ChanQueueNum: s.opt.HandleConfig.ChanQueueLen,
+		CostPrice:    &s.opt.CostPrice,
+	}
 	handlerClients := worker.HandleClients{
 		Store:            s.mongoStore,
 		BcsMonitorClient: bcsMonitorCli,
@@ -14,8 +14,11 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"fmt"
+	"strconv"
 	"time"
 
 	bcsCommon "github.com/Tencent/bk-bcs/bcs-common/common"
@@ -27,6 +30,11 @@ import (
 	bcsdatamanager "github.com/Tencent/bk-bcs/bcs-services/bcs-data-manager/proto/bcs-data-manager"
 )
 
+const (
+	// defaultCostPageSize default page size of cost list
+	defaultCostPageSize = 10
+)
+
 // BcsDataManager for handler
 type BcsDataManager struct {
 	mongoModel     store.Server
@@ -552,3 +560,114 @@ func (e *BcsDataManager) GetWorkloadOriginRequestResult(
 	prom.ReportAPIRequestMetric("GetWorkloadOriginRequestResult", "grpc", prom.StatusOK, start)
 	return nil
 }
+
+// GetCostList get cost list of project, aggregated by objectType in time range
+// objectType: project/cluster/namespace/workload, default project
+// page: default 0
+// size: default 10
+// startTime: timestamp, default 30 days before endTime
+// endTime: timestamp, default now
+func (e *BcsDataManager) GetCostList(ctx context.Context, req *bcsdatamanager.GetCostListRequest,
+	rsp *bcsdatamanager.GetCostListResponse) error {
+	blog.Infof("Received GetCostList.Call request. projectID:%s, cluster id:%s, namespace:%s, objectType:%s, "+
+		"page:%d, size:%d, startTime=%s, endTime=%s",
+		req.GetProjectID(), req.GetClusterID(), req.GetNamespace(), req.GetObjectType(), req.GetPage(),
+		req.GetSize(), time.Unix(req.GetStartTime(), 0), time.Unix(req.GetEndTime(), 0))
+	start := time.Now()
+	result, err := e.mongoModel.GetCostList(ctx, req)
+	if err != nil {
+		rsp.Message = fmt.Sprintf("get cost list error: %v", err)
+		rsp.Code = bcsCommon.AdditionErrorCode + 500
+		blog.Errorf(rsp.Message)
+		prom.ReportAPIRequestMetric("GetCostList", "grpc", prom.StatusErr, start)
+		return nil
+	}
+	page := int(req.GetPage())
+	size := int(req.GetSize())
+	if size == 0 {
+		size = defaultCostPageSize
+	}
+	startIndex := page * size
+	endIndex := (page + 1) * size
+	if startIndex > len(result) {
+		startIndex = len(result)
+	}
+	if endIndex > len(result) {
+		endIndex = len(result)
+	}
+	rsp.Data = result[startIndex:endIndex]
+	rsp.Total = uint32(len(result))
+	rsp.Message = bcsCommon.BcsSuccessStr
+	rsp.Code = bcsCommon.BcsSuccess
+	prom.ReportAPIRequestMetric("GetCostList", "grpc", prom.StatusOK, start)
+	return nil
+}
+
+// ExportCostList export cost list of project as csv, request params are the same as GetCostList without paging
+func (e *BcsDataManager) ExportCostList(ctx context.Context, req *bcsdatamanager.GetCostListRequest,
+	rsp *bcsdatamanager.ExportCostListResponse) error {
+	blog.Infof("Received ExportCostList.Call request. projectID:%s, cluster id:%s, namespace:%s, objectType:%s, "+
+		"startTime=%s, endTime=%s",
+		req.GetProjectID(), req.GetClusterID(), req.GetNamespace(), req.GetObjectType(),
+		time.Unix(req.GetStartTime(), 0), time.Unix(req.GetEndTime(), 0))
+	start := time.Now()
+	result, err := e.mongoModel.GetCostList(ctx, req)
+	if err != nil {
+		rsp.Message = fmt.Sprintf("export cost list error: %v", err)
+		rsp.Code = bcsCommon.AdditionErrorCode + 500
+		blog.Errorf(rsp.Message)
+		prom.ReportAPIRequestMetric("ExportCostList", "grpc", prom.StatusErr, start)
+		return nil
+	}
+	data, err := generateCostCSV(result)
+	if err != nil {
+		rsp.Message = fmt.Sprintf("generate cost csv error: %v", err)
+		rsp.Code = bcsCommon.AdditionErrorCode + 500
+		blog.Errorf(rsp.Message)
+		prom.ReportAPIRequestMetric("ExportCostList", "grpc", prom.StatusErr, start)
+		return nil
+	}
+	objectType := req.GetObjectType()
+	if objectType == "" {
+		objectType = "project"
+	}
+	rsp.Data = data
+	rsp.FileName = fmt.Sprintf("cost-%s-%s-%s.csv", req.GetProjectID(), objectType, start.Format("20060102150405"))
+	rsp.Message = bcsCommon.BcsSuccessStr
+	rsp.Code = bcsCommon.BcsSuccess
+	prom.ReportAPIRequestMetric("ExportCostList", "grpc", prom.StatusOK, start)
+	return nil
+}
+
+// generateCostCSV generate csv content of cost list
+func generateCostCSV(costs []*bcsdatamanager.Cost) (string, error) {
+	buf := &bytes.Buffer{}
+	writer := csv.NewWriter(buf)
+	header := []string{"projectID", "projectCode", "businessID", "clusterID", "namespace", "workloadType",
+		"workloadName", "startDate", "endDate", "cpuRequestCoreHours", "cpuUsedCoreHours", "memoryRequestGiBHours",
+		"memoryUsedGiBHours", "requestCost", "usedCost", "idleCost", "nodeHours", "nodeCost", "gpuHours", "gpuCost",
+		"currency"}
+	if err := writer.Write(header); err != nil {
+		return "", err
+	}
+	formatFloat := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', 4, 64)
+	}
+	for _, cost := range costs {
+		record := []string{cost.GetProjectID(), cost.GetProjectCode(), cost.GetBusinessID(), cost.GetClusterID(),
+			cost.GetNamespace(), cost.GetWorkloadType(), cost.GetWorkloadName(), cost.GetStartDate(),
+			cost.GetEndDate(), formatFloat(cost.GetCpuRequestCoreHours()), formatFloat(cost.GetCpuUsedCoreHours()),
+			formatFloat(cost.GetMemoryRequestGiBHours()), formatFloat(cost.GetMemoryUsedGiBHours()),
+			formatFloat(cost.GetRequestCost()), formatFloat(cost.GetUsedCost()), formatFloat(cost.GetIdleCost()),
+			formatFloat(cost.GetNodeHours()), formatFloat(cost.GetNodeCost()), formatFloat(cost.GetGpuHours()),
+			formatFloat(cost.GetGpuCost()), cost.GetCurrency()}
+		if err := writer.Write(record); err != nil {
+			return "", err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
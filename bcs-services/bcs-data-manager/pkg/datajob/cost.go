@@ -0,0 +1,191 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package datajob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	cm "github.com/Tencent/bk-bcs/bcs-common/pkg/bcsapi/clustermanager"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-data-manager/pkg/metric"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-data-manager/pkg/prom"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-data-manager/pkg/store"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-data-manager/pkg/types"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-data-manager/pkg/utils"
+)
+
+const (
+	bytesPerGiB = 1024 * 1024 * 1024
+	hoursPerDay = 24
+)
+
+// CostDayPolicy cost day policy, calculate yesterday's cost of one cluster
+type CostDayPolicy struct {
+	MetricGetter metric.Server
+	store        store.Server
+	price        *types.CostPriceConfig
+}
+
+// NewCostDayPolicy init cost day policy
+func NewCostDayPolicy(getter metric.Server, store store.Server, price *types.CostPriceConfig) *CostDayPolicy {
+	return &CostDayPolicy{
+		MetricGetter: getter,
+		store:        store,
+		price:        price,
+	}
+}
+
+// ImplementPolicy cost day implement
+// request and used cost are calculated from hourly workload metrics of yesterday,
+// node and gpu cost are calculated from nodes of cluster, which are considered running the whole day
+func (p *CostDayPolicy) ImplementPolicy(ctx context.Context, opts *types.JobCommonOpts, clients *types.Clients) {
+	if !p.price.Enabled() {
+		blog.Infof("cost price is not configured, skip cost policy of cluster %s", opts.ClusterID)
+		return
+	}
+	bucket, err := utils.GetBucketTime(opts.CurrentTime.AddDate(0, 0, -1), types.DimensionHour)
+	if err != nil {
+		blog.Errorf("do day cost policy error, get bucket err:%v", err)
+		return
+	}
+	hourOpts := &types.JobCommonOpts{
+		ObjectType: types.WorkloadType,
+		ProjectID:  opts.ProjectID,
+		ClusterID:  opts.ClusterID,
+		Dimension:  types.DimensionHour,
+	}
+	workloads, err := p.store.GetRawWorkloadInfo(ctx, hourOpts, bucket)
+	if err != nil {
+		blog.Errorf("do day cost policy error, get workload metrics of cluster %s err:%v", opts.ClusterID, err)
+		return
+	}
+	nodes, err := getClusterNodes(opts, clients)
+	if err != nil {
+		// still record request and used cost when nodes are unavailable
+		blog.Errorf("do day cost policy error, get nodes of cluster %s err:%v", opts.ClusterID, err)
+	}
+	costs := calculateClusterCost(opts, bucket, workloads, nodes, p.price)
+	if err = p.store.InsertCostInfo(ctx, costs); err != nil {
+		blog.Errorf("do day cost policy error, insert cost of cluster %s err:%v", opts.ClusterID, err)
+	}
+}
+
+func getClusterNodes(opts *types.JobCommonOpts, clients *types.Clients) ([]*cm.ClusterNode, error) {
+	if clients == nil || clients.CmCli == nil {
+		return nil, fmt.Errorf("cluster manager client is nil")
+	}
+	start := time.Now()
+	nodes, err := clients.CmCli.Cli.ListNodesInCluster(clients.CmCli.Ctx, &cm.ListNodesInClusterRequest{
+		ClusterID: opts.ClusterID,
+	})
+	prom.ReportLibRequestMetric(prom.BkBcsClusterManager, "ListNodesInCluster", "GET", err, start)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.Data, nil
+}
+
+// calculateClusterCost calculate workload, namespace and cluster cost records of one day
+func calculateClusterCost(opts *types.JobCommonOpts, bucket string, workloads []*types.WorkloadData,
+	nodes []*cm.ClusterNode, price *types.CostPriceConfig) []*types.CostData {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	newCost := func(objectType string) *types.CostData {
+		return &types.CostData{
+			CreateTime:  now,
+			UpdateTime:  now,
+			BucketTime:  bucket,
+			ObjectType:  objectType,
+			ProjectID:   opts.ProjectID,
+			ProjectCode: opts.ProjectCode,
+			BusinessID:  opts.BusinessID,
+			ClusterID:   opts.ClusterID,
+			Currency:    price.Currency,
+		}
+	}
+	result := make([]*types.CostData, 0)
+	clusterCost := newCost(types.ClusterType)
+	namespaceCosts := make(map[string]*types.CostData)
+	namespaceList := make([]string, 0)
+	for _, workload := range distinctWorkloads(workloads) {
+		workloadCost := newCost(types.WorkloadType)
+		workloadCost.Namespace = workload.Namespace
+		workloadCost.WorkloadType = workload.WorkloadType
+		workloadCost.WorkloadName = workload.Name
+		for _, metrics := range workload.Metrics {
+			// every hour metric stands for one hour
+			workloadCost.CPURequestCoreHours += metrics.CPURequest
+			workloadCost.CPUUsedCoreHours += metrics.CPUUsageAmount
+			workloadCost.MemoryRequestGiBHours += float64(metrics.MemoryRequest) / bytesPerGiB
+			workloadCost.MemoryUsedGiBHours += float64(metrics.MemoryUsageAmount) / bytesPerGiB
+		}
+		workloadCost.RequestCost = workloadCost.CPURequestCoreHours*price.CPUCoreHour +
+			workloadCost.MemoryRequestGiBHours*price.MemoryGiBHour
+		workloadCost.UsedCost = workloadCost.CPUUsedCoreHours*price.CPUCoreHour +
+			workloadCost.MemoryUsedGiBHours*price.MemoryGiBHour
+		result = append(result, workloadCost)
+
+		namespaceCost, ok := namespaceCosts[workload.Namespace]
+		if !ok {
+			namespaceCost = newCost(types.NamespaceType)
+			namespaceCost.Namespace = workload.Namespace
+			namespaceCosts[workload.Namespace] = namespaceCost
+			namespaceList = append(namespaceList, workload.Namespace)
+		}
+		addUsageCost(namespaceCost, workloadCost)
+		addUsageCost(clusterCost, workloadCost)
+	}
+	for _, namespace := range namespaceList {
+		result = append(result, namespaceCosts[namespace])
+	}
+	for _, node := range nodes {
+		clusterCost.NodeHours += hoursPerDay
+		clusterCost.NodeCost += price.NodePrice(node.InstanceType) * hoursPerDay
+		clusterCost.GPUHours += float64(node.GPU) * hoursPerDay
+		clusterCost.GPUCost += float64(node.GPU) * hoursPerDay * price.GPUHour
+	}
+	return append(result, clusterCost)
+}
+
+// distinctWorkloads workload may exist in both old and new collection, keep the one with more metrics
+func distinctWorkloads(workloads []*types.WorkloadData) []*types.WorkloadData {
+	index := make(map[string]int)
+	result := make([]*types.WorkloadData, 0, len(workloads))
+	for _, workload := range workloads {
+		if workload == nil {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s/%s", workload.Namespace, workload.WorkloadType, workload.Name)
+		if i, ok := index[key]; ok {
+			if len(workload.Metrics) > len(result[i].Metrics) {
+				result[i] = workload
+			}
+			continue
+		}
+		index[key] = len(result)
+		result = append(result, workload)
+	}
+	return result
+}
+
+func addUsageCost(total, cost *types.CostData) {
+	total.CPURequestCoreHours += cost.CPURequestCoreHours
+	total.CPUUsedCoreHours += cost.CPUUsedCoreHours
+	total.MemoryRequestGiBHours += cost.MemoryRequestGiBHours
+	total.MemoryUsedGiBHours += cost.MemoryUsedGiBHours
+	total.RequestCost += cost.RequestCost
+	total.UsedCost += cost.UsedCost
+}
@@ -0,0 +1,87 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package datajob
+
+import (
+	"testing"
+
+	cm "github.com/Tencent/bk-bcs/bcs-common/pkg/bcsapi/clustermanager"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-data-manager/pkg/types"
+)
+
+func TestCalculateClusterCost(t *testing.T) {
+	opts := &types.JobCommonOpts{
+		ProjectID: "testProject",
+		ClusterID: "testCluster",
+	}
+	price := &types.CostPriceConfig{
+		Currency:        "CNY",
+		CPUCoreHour:     0.1,
+		MemoryGiBHour:   0.01,
+		GPUHour:         1,
+		NodeTypeHour:    map[string]float64{"S5.LARGE8": 0.5},
+		DefaultNodeHour: 0.2,
+	}
+	hourMetric := &types.WorkloadMetrics{
+		CPURequest:        2,
+		CPUUsageAmount:    1,
+		MemoryRequest:     4 * 1024 * 1024 * 1024,
+		MemoryUsageAmount: 2 * 1024 * 1024 * 1024,
+	}
+	workloads := []*types.WorkloadData{
+		{Namespace: "ns1", WorkloadType: types.DeploymentType, Name: "w1",
+			Metrics: []*types.WorkloadMetrics{hourMetric}},
+		// duplicated workload with more metrics should be kept
+		{Namespace: "ns1", WorkloadType: types.DeploymentType, Name: "w1",
+			Metrics: []*types.WorkloadMetrics{hourMetric, hourMetric}},
+		{Namespace: "ns2", WorkloadType: types.StatefulSetType, Name: "w2",
+			Metrics: []*types.WorkloadMetrics{hourMetric}},
+	}
+	nodes := []*cm.ClusterNode{
+		{InstanceType: "S5.LARGE8"},
+		{InstanceType: "GN7.2XLARGE32", GPU: 1},
+	}
+	costs := calculateClusterCost(opts, "2024-01-01", workloads, nodes, price)
+	// 2 workloads, 2 namespaces and 1 cluster
+	assert.Equal(t, 5, len(costs))
+
+	w1 := costs[0]
+	assert.Equal(t, types.WorkloadType, w1.ObjectType)
+	assert.InDelta(t, 4, w1.CPURequestCoreHours, 1e-9)
+	assert.InDelta(t, 8, w1.MemoryRequestGiBHours, 1e-9)
+	assert.InDelta(t, 0.48, w1.RequestCost, 1e-9)
+	assert.InDelta(t, 0.24, w1.UsedCost, 1e-9)
+
+	ns2 := costs[3]
+	assert.Equal(t, types.NamespaceType, ns2.ObjectType)
+	assert.Equal(t, "ns2", ns2.Namespace)
+	assert.InDelta(t, 0.24, ns2.RequestCost, 1e-9)
+
+	cluster := costs[4]
+	assert.Equal(t, types.ClusterType, cluster.ObjectType)
+	assert.Equal(t, "CNY", cluster.Currency)
+	assert.InDelta(t, 0.72, cluster.RequestCost, 1e-9)
+	assert.InDelta(t, 48, cluster.NodeHours, 1e-9)
+	assert.InDelta(t, 0.5*24+0.2*24, cluster.NodeCost, 1e-9)
+	assert.InDelta(t, 24, cluster.GPUHours, 1e-9)
+	assert.InDelta(t, 24, cluster.GPUCost, 1e-9)
+}
+
+func TestCostPriceEnabled(t *testing.T) {
+	var price *types.CostPriceConfig
+	assert.False(t, price.Enabled())
+	assert.False(t, (&types.CostPriceConfig{}).Enabled())
+	assert.True(t, (&types.CostPriceConfig{CPUCoreHour: 0.1}).Enabled())
+}
@@ -33,6 +33,8 @@ var (
 	ProjectMap = map[string]Policy{}
 	// PodAutoscalerMap hpa/gpa map
 	PodAutoscalerMap = map[string]Policy{}
+	// CostMap cost map
+	CostMap = map[string]Policy{}
 )
 
 // PolicyFactoryInterface PolicyMap interface
@@ -42,12 +44,13 @@ type PolicyFactoryInterface interface {
 }
 
 type policyFactory struct {
-	store store.Server
+	store     store.Server
+	costPrice *types.CostPriceConfig
 }
 
 // NewPolicyFactory init policy factory
-func NewPolicyFactory(store store.Server) PolicyFactoryInterface {
-	return &policyFactory{store: store}
+func NewPolicyFactory(store store.Server, costPrice *types.CostPriceConfig) PolicyFactoryInterface {
+	return &policyFactory{store: store, costPrice: costPrice}
 }
 
 // GetPolicy get policy by type and dimension
@@ -63,12 +66,14 @@ func (f *policyFactory) Init() {
 	f.initPublicMap()
 	f.initProjectMap()
 	f.initPodAutoscalerMap()
+	f.initCostMap()
 	PolicyMap[types.ClusterType] = ClusterMap
 	PolicyMap[types.NamespaceType] = NamespaceMap
 	PolicyMap[types.WorkloadType] = WorkloadMap
 	PolicyMap[types.ProjectType] = ProjectMap
 	PolicyMap[types.PublicType] = PublicMap
 	PolicyMap[types.PodAutoscalerType] = PodAutoscalerMap
+	PolicyMap[types.CostType] = CostMap
 }
 func (f *policyFactory) initClusterMap() {
 	ClusterMap[types.DimensionMinute] = NewClusterMinutePolicy(&metric.MetricGetter{}, f.store)
@@ -102,3 +107,7 @@ func (f *policyFactory) initPodAutoscalerMap() {
 	PodAutoscalerMap[types.DimensionHour] = NewPodAutoscalerHourPolicy(&metric.MetricGetter{}, f.store)
 	PodAutoscalerMap[types.DimensionMinute] = NewPodAutoscalerMinutePolicy(&metric.MetricGetter{}, f.store)
 }
+
+func (f *policyFactory) initCostMap() {
+	CostMap[types.DimensionDay] = NewCostDayPolicy(&metric.MetricGetter{}, f.store, f.costPrice)
+}
@@ -21,10 +21,10 @@ import (
 
 func TestNewPolicyFactory(t *testing.T) {
 	storeServer := mock.NewMockStore()
-	factory := NewPolicyFactory(storeServer)
+	factory := NewPolicyFactory(storeServer, nil)
 	factory.Init()
 	assert.NotNil(t, factory.GetPolicy(types.ClusterType, types.DimensionMinute))
-	assert.Equal(t, 5, len(PolicyMap))
+	assert.Equal(t, 7, len(PolicyMap))
 }
 
 func Test_policyFactory_GetPolicy(t *testing.T) {
@@ -46,6 +46,8 @@ func Test_policyFactory_GetPolicy(t *testing.T) {
 	assert.NotNil(t, factory.GetPolicy(types.WorkloadType, types.DimensionMinute))
 	assert.NotNil(t, factory.GetPolicy(types.WorkloadType, types.DimensionHour))
 	assert.NotNil(t, factory.GetPolicy(types.WorkloadType, types.DimensionDay))
+	assert.Nil(t, factory.GetPolicy(types.CostType, types.DimensionHour))
+	assert.NotNil(t, factory.GetPolicy(types.CostType, types.DimensionDay))
 }
 
 func Test_policyFactory_initClusterMap(t *testing.T) {
@@ -100,5 +102,6 @@ func Test_policyFactory_initWorkloadMap(t *testing.T) {
 	assert.NotNil(t, factory.GetPolicy(types.WorkloadType, types.DimensionMinute))
 	assert.NotNil(t, factory.GetPolicy(types.WorkloadType, types.DimensionHour))
 	assert.NotNil(t, factory.GetPolicy(types.WorkloadType, types.DimensionDay))
-	assert.Equal(t, 3, len(PolicyMap[types.WorkloadType]))
+	assert.NotNil(t, factory.GetPolicy(types.WorkloadType, types.GetWorkloadRequestType))
+	assert.Equal(t, 4, len(PolicyMap[types.WorkloadType]))
 }
@@ -57,6 +57,8 @@ func (m *MockCmClient) NewGrpcClientWithHeader(ctx context.Context, conn *grpc.C
 // MockCm mock cm
 type MockCm struct {
 	mock.Mock
+	// methods not mocked below are not used in tests
+	cm.ClusterManagerClient
 }
 
 // NewMockCm new mock cm
@@ -417,7 +419,7 @@ func (m *MockCm) CleanNodesInGroup(ctx context.Context, in *cm.CleanNodesInGroup
 }
 
 // ListNodesInGroup mock cm
-func (m *MockCm) ListNodesInGroup(ctx context.Context, in *cm.GetNodeGroupRequest,
+func (m *MockCm) ListNodesInGroup(ctx context.Context, in *cm.ListNodesInGroupRequest,
 	opts ...grpc.CallOption) (*cm.ListNodesInGroupResponse, error) {
 	return nil, nil
 }
@@ -28,50 +28,60 @@ func NewMockMetric() metric.Server {
 	return &MockMetric{}
 }
 
-func (m *MockMetric) GetWorkloadCPUMetrics(opts *types.JobCommonOpts, clients *types.Clients) (float64, float64, float64, error) {
+func (m *MockMetric) GetWorkloadCPUMetrics(opts *types.JobCommonOpts,
+	clients *types.Clients) (*types.CPUMetrics, error) {
 	testWorkload := opts.WorkloadName
-	m.On("GetWorkloadCPUMetrics", "testWorkload").Return(2.00, 1.0, 1.0, nil)
-	m.On("GetWorkloadCPUMetrics", "testErr").Return(0.0, 0.0, 0.0, fmt.Errorf("test err"))
+	m.On("GetWorkloadCPUMetrics", "testWorkload").Return(&types.CPUMetrics{
+		CPURequest: 2.00, CPUUsed: 1.0, CPUUsage: 1.0}, nil)
+	m.On("GetWorkloadCPUMetrics", "testErr").Return(&types.CPUMetrics{}, fmt.Errorf("test err"))
 	args := m.Called(testWorkload)
-	return args.Get(0).(float64), args.Get(1).(float64), args.Get(2).(float64), args.Error(3)
+	return args.Get(0).(*types.CPUMetrics), args.Error(1)
 }
 
-func (m *MockMetric) GetWorkloadMemoryMetrics(opts *types.JobCommonOpts, clients *types.Clients) (int64, int64, float64, error) {
+func (m *MockMetric) GetWorkloadMemoryMetrics(opts *types.JobCommonOpts,
+	clients *types.Clients) (*types.MemoryMetrics, error) {
 	testWorkload := opts.WorkloadName
-	m.On("GetWorkloadMemoryMetrics", "testWorkload").Return(int64(200), int64(100), 0.5, nil)
-	m.On("GetWorkloadMemoryMetrics", "testErr").Return(int64(0), int64(0), 0.0, fmt.Errorf("test err"))
+	m.On("GetWorkloadMemoryMetrics", "testWorkload").Return(&types.MemoryMetrics{
+		MemoryRequest: 200, MemoryUsed: 100, MemoryUsage: 0.5}, nil)
+	m.On("GetWorkloadMemoryMetrics", "testErr").Return(&types.MemoryMetrics{}, fmt.Errorf("test err"))
 	args := m.Called(testWorkload)
-	return args.Get(0).(int64), args.Get(1).(int64), args.Get(2).(float64), args.Error(3)
+	return args.Get(0).(*types.MemoryMetrics), args.Error(1)
 }
-func (m *MockMetric) GetNamespaceCPUMetrics(opts *types.JobCommonOpts, clients *types.Clients) (float64, float64, float64, error) {
+func (m *MockMetric) GetNamespaceCPUMetrics(opts *types.JobCommonOpts,
+	clients *types.Clients) (*types.CPUMetrics, error) {
 	testNs := opts.Namespace
-	m.On("GetNamespaceCPUMetrics", "testNs").Return(2.00, 1.0, 1.0, nil)
-	m.On("GetNamespaceCPUMetrics", "testErr").Return(0.0, 0.0, 0.0, fmt.Errorf("test err"))
+	m.On("GetNamespaceCPUMetrics", "testNs").Return(&types.CPUMetrics{
+		CPURequest: 2.00, CPUUsed: 1.0, CPUUsage: 1.0}, nil)
+	m.On("GetNamespaceCPUMetrics", "testErr").Return(&types.CPUMetrics{}, fmt.Errorf("test err"))
 	args := m.Called(testNs)
-	return args.Get(0).(float64), args.Get(1).(float64), args.Get(2).(float64), args.Error(3)
+	return args.Get(0).(*types.CPUMetrics), args.Error(1)
 }
-func (m *MockMetric) GetNamespaceMemoryMetrics(opts *types.JobCommonOpts, clients *types.Clients) (int64, int64, float64, error) {
+func (m *MockMetric) GetNamespaceMemoryMetrics(opts *types.JobCommonOpts,
+	clients *types.Clients) (*types.MemoryMetrics, error) {
 	testNs := opts.Namespace
-	m.On("GetNamespaceMemoryMetrics", "testNs").Return(int64(200), int64(100), 0.5, nil)
-	m.On("GetNamespaceMemoryMetrics", "testErr").Return(int64(0), int64(0), 0.0, fmt.Errorf("test err"))
+	m.On("GetNamespaceMemoryMetrics", "testNs").Return(&types.MemoryMetrics{
+		MemoryRequest: 200, MemoryUsed: 100, MemoryUsage: 0.5}, nil)
+	m.On("GetNamespaceMemoryMetrics", "testErr").Return(&types.MemoryMetrics{}, fmt.Errorf("test err"))
 	args := m.Called(testNs)
-	return args.Get(0).(int64), args.Get(1).(int64), args.Get(2).(float64), args.Error(3)
+	return args.Get(0).(*types.MemoryMetrics), args.Error(1)
 }
-func (m *MockMetric) GetClusterCPUMetrics(opts *types.JobCommonOpts, clients *types.Clients) (float64,
-	float64, float64, float64, error) {
+func (m *MockMetric) GetClusterCPUMetrics(opts *types.JobCommonOpts,
+	clients *types.Clients) (*types.CPUMetrics, error) {
 	testCluster := opts.ClusterID
-	m.On("GetClusterCPUMetrics", "testCluster").Return(200.00, 100.00, 10.0, 10.0/200.0, nil)
-	m.On("GetClusterCPUMetrics", "testErr").Return(0.0, 0.0, 0.0, 0.0, fmt.Errorf("test err"))
+	m.On("GetClusterCPUMetrics", "testCluster").Return(&types.CPUMetrics{
+		TotalCPU: 200.00, CPURequest: 100.00, CPUUsed: 10.0, CPUUsage: 10.0 / 200.0}, nil)
+	m.On("GetClusterCPUMetrics", "testErr").Return(&types.CPUMetrics{}, fmt.Errorf("test err"))
 	args := m.Called(testCluster)
-	return args.Get(0).(float64), args.Get(1).(float64), args.Get(2).(float64), args.Get(3).(float64), args.Error(4)
+	return args.Get(0).(*types.CPUMetrics), args.Error(1)
 }
-func (m *MockMetric) GetClusterMemoryMetrics(opts *types.JobCommonOpts, clients *types.Clients) (int64,
-	int64, int64, float64, error) {
+func (m *MockMetric) GetClusterMemoryMetrics(opts *types.JobCommonOpts,
+	clients *types.Clients) (*types.MemoryMetrics, error) {
 	testCluster := opts.ClusterID
-	m.On("GetClusterMemoryMetrics", "testCluster").Return(int64(200), int64(100), int64(10), 10.0/200.0, nil)
-	m.On("GetClusterMemoryMetrics", "testErr").Return(int64(0), int64(0), int64(0), 0.0, fmt.Errorf("test err"))
+	m.On("GetClusterMemoryMetrics", "testCluster").Return(&types.MemoryMetrics{
+		TotalMemory: 200, MemoryRequest: 100, MemoryUsed: 10, MemoryUsage: 10.0 / 200.0}, nil)
+	m.On("GetClusterMemoryMetrics", "testErr").Return(&types.MemoryMetrics{}, fmt.Errorf("test err"))
 	args := m.Called(testCluster)
-	return args.Get(0).(int64), args.Get(1).(int64), args.Get(2).(int64), args.Get(3).(float64), args.Error(4)
+	return args.Get(0).(*types.MemoryMetrics), args.Error(1)
 }
 func (m *MockMetric) GetInstanceCount(opts *types.JobCommonOpts, clients *types.Clients) (int64, error) {
 	testCluster := opts.ClusterID
@@ -49,6 +49,8 @@ func (m *MockPmClient) NewGrpcClientWithHeader(ctx context.Context, conn *grpc.C
 // MockPm mock project manager
 type MockPm struct {
 	mock.Mock
+	// methods not mocked below are not used in tests
+	pm.BCSProjectClient
 }
 
 func NewMockPm() pm.BCSProjectClient {
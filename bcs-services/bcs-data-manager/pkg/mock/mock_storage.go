@@ -24,6 +24,8 @@ import (
 // MockStorage mock storage
 type MockStorage struct {
 	mock.Mock
+	// methods not mocked below are not used in tests
+	bcsapi.Storage
 }
 
 // NewMockStorage new mock storage
@@ -34,6 +34,8 @@ var (
 // MockStore mock store
 type MockStore struct {
 	mock.Mock
+	// methods not mocked below are not used in tests
+	store.Server
 }
 
 func NewMockStore() store.Server {
@@ -296,3 +298,9 @@ func (m *MockStore) GetPodAutoscalerInfo(ctx context.Context, request *datamanag
 func (m *MockStore) GetRawPodAutoscalerInfo(ctx context.Context, opts *types.JobCommonOpts, bucket string) ([]*types.PodAutoscalerData, error) {
 	return nil, nil
 }
+func (m *MockStore) InsertCostInfo(ctx context.Context, costs []*types.CostData) error {
+	return nil
+}
+func (m *MockStore) GetCostList(ctx context.Context, req *datamanager.GetCostListRequest) ([]*datamanager.Cost, error) {
+	return nil, nil
+}
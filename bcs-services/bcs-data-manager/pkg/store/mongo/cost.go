@@ -0,0 +1,238 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-data-manager/pkg/types"
+	datamanager "github.com/Tencent/bk-bcs/bcs-services/bcs-data-manager/proto/bcs-data-manager"
+)
+
+const (
+	// defaultCostDays default days for cost query
+	defaultCostDays = 30
+)
+
+var (
+	modelCostIndexes = []drivers.Index{
+		{
+			Name: types.CostTableName + "_idx",
+			Key: bson.D{
+				bson.E{Key: ObjectTypeKey, Value: 1},
+				bson.E{Key: ProjectIDKey, Value: 1},
+				bson.E{Key: ClusterIDKey, Value: 1},
+				bson.E{Key: NamespaceKey, Value: 1},
+				bson.E{Key: WorkloadTypeKey, Value: 1},
+				bson.E{Key: WorkloadNameKey, Value: 1},
+				bson.E{Key: BucketTimeKey, Value: 1},
+			},
+			Background: true,
+		},
+		{
+			Key: bson.D{
+				bson.E{Key: BucketTimeKey, Value: 1},
+			},
+			Name:       BucketTimeKey + "_1",
+			Background: true,
+		},
+	}
+)
+
+// costAggregation aggregated cost of one object in a time range
+type costAggregation struct {
+	ProjectID             string  `bson:"project_id"`
+	ProjectCode           string  `bson:"project_code"`
+	BusinessID            string  `bson:"business_id"`
+	ClusterID             string  `bson:"cluster_id"`
+	Namespace             string  `bson:"namespace"`
+	WorkloadType          string  `bson:"workload_type"`
+	WorkloadName          string  `bson:"workload_name"`
+	StartDate             string  `bson:"start_date"`
+	EndDate               string  `bson:"end_date"`
+	CPURequestCoreHours   float64 `bson:"cpu_request_core_hours"`
+	CPUUsedCoreHours      float64 `bson:"cpu_used_core_hours"`
+	MemoryRequestGiBHours float64 `bson:"memory_request_gib_hours"`
+	MemoryUsedGiBHours    float64 `bson:"memory_used_gib_hours"`
+	RequestCost           float64 `bson:"request_cost"`
+	UsedCost              float64 `bson:"used_cost"`
+	NodeHours             float64 `bson:"node_hours"`
+	NodeCost              float64 `bson:"node_cost"`
+	GPUHours              float64 `bson:"gpu_hours"`
+	GPUCost               float64 `bson:"gpu_cost"`
+	Currency              string  `bson:"currency"`
+}
+
+// ModelCost cost model
+type ModelCost struct {
+	Public
+}
+
+// NewModelCost new cost model
+func NewModelCost(db drivers.DB) *ModelCost {
+	return &ModelCost{Public: Public{
+		TableName: types.DataTableNamePrefix + types.CostTableName,
+		Indexes:   modelCostIndexes,
+		DB:        db,
+	}}
+}
+
+// InsertCostInfo insert or update cost records, records are unique by object and day
+func (m *ModelCost) InsertCostInfo(ctx context.Context, costs []*types.CostData) error {
+	err := ensureTable(ctx, &m.Public)
+	if err != nil {
+		return err
+	}
+	for _, cost := range costs {
+		cond := operator.NewLeafCondition(operator.Eq, operator.M{
+			ObjectTypeKey:   cost.ObjectType,
+			ProjectIDKey:    cost.ProjectID,
+			ClusterIDKey:    cost.ClusterID,
+			NamespaceKey:    cost.Namespace,
+			WorkloadTypeKey: cost.WorkloadType,
+			WorkloadNameKey: cost.WorkloadName,
+			BucketTimeKey:   cost.BucketTime,
+		})
+		cost.UpdateTime = primitive.NewDateTimeFromTime(time.Now())
+		if err = m.DB.Table(m.TableName).Upsert(ctx, cond, operator.M{"$set": cost}); err != nil {
+			blog.Errorf("upsert %s cost of cluster %s failed, err: %v", cost.ObjectType, cost.ClusterID, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCostList aggregate cost records in time range by object type, sorted by request cost
+// project cost is aggregated from cluster cost records
+func (m *ModelCost) GetCostList(ctx context.Context,
+	req *datamanager.GetCostListRequest) ([]*datamanager.Cost, error) {
+	err := ensureTable(ctx, &m.Public)
+	if err != nil {
+		return nil, err
+	}
+	objectType := req.GetObjectType()
+	if objectType == "" {
+		objectType = types.ProjectType
+	}
+	pipeline := []map[string]interface{}{
+		{"$match": m.generateCond(req, objectType)},
+		{"$group": generateCostGroup(objectType)},
+		{"$sort": map[string]interface{}{"request_cost": DescendingKey}},
+	}
+	aggregations := make([]*costAggregation, 0)
+	if err = m.DB.Table(m.TableName).Aggregation(ctx, pipeline, &aggregations); err != nil {
+		blog.Errorf("aggregate cost of project %s failed, err: %v", req.GetProjectID(), err)
+		return nil, err
+	}
+	result := make([]*datamanager.Cost, 0, len(aggregations))
+	for _, item := range aggregations {
+		result = append(result, &datamanager.Cost{
+			ProjectID:             item.ProjectID,
+			ProjectCode:           item.ProjectCode,
+			BusinessID:            item.BusinessID,
+			ClusterID:             item.ClusterID,
+			Namespace:             item.Namespace,
+			WorkloadType:          item.WorkloadType,
+			WorkloadName:          item.WorkloadName,
+			ObjectType:            objectType,
+			StartDate:             item.StartDate,
+			EndDate:               item.EndDate,
+			CpuRequestCoreHours:   item.CPURequestCoreHours,
+			CpuUsedCoreHours:      item.CPUUsedCoreHours,
+			MemoryRequestGiBHours: item.MemoryRequestGiBHours,
+			MemoryUsedGiBHours:    item.MemoryUsedGiBHours,
+			RequestCost:           item.RequestCost,
+			UsedCost:              item.UsedCost,
+			IdleCost:              item.RequestCost - item.UsedCost,
+			NodeHours:             item.NodeHours,
+			NodeCost:              item.NodeCost,
+			GpuHours:              item.GPUHours,
+			GpuCost:               item.GPUCost,
+			Currency:              item.Currency,
+		})
+	}
+	return result, nil
+}
+
+func (m *ModelCost) generateCond(req *datamanager.GetCostListRequest, objectType string) map[string]interface{} {
+	recordType := objectType
+	if objectType == types.ProjectType {
+		recordType = types.ClusterType
+	}
+	cond := map[string]interface{}{
+		ObjectTypeKey: recordType,
+		ProjectIDKey:  req.GetProjectID(),
+	}
+	if req.GetClusterID() != "" {
+		cond[ClusterIDKey] = req.GetClusterID()
+	}
+	if req.GetNamespace() != "" {
+		cond[NamespaceKey] = req.GetNamespace()
+	}
+	if req.GetWorkloadType() != "" {
+		cond[WorkloadTypeKey] = req.GetWorkloadType()
+	}
+	if req.GetWorkloadName() != "" {
+		cond[WorkloadNameKey] = req.GetWorkloadName()
+	}
+	endTime := time.Now()
+	if req.GetEndTime() != 0 {
+		endTime = time.Unix(req.GetEndTime(), 0)
+	}
+	startTime := endTime.AddDate(0, 0, -defaultCostDays)
+	if req.GetStartTime() != 0 {
+		startTime = time.Unix(req.GetStartTime(), 0)
+	}
+	// bucket time of cost record is day, e.g. 2006-01-02, which is sortable as string
+	cond[BucketTimeKey] = map[string]interface{}{
+		"$gte": startTime.Format(types.DayTimeFormat),
+		"$lte": endTime.Format(types.DayTimeFormat),
+	}
+	return cond
+}
+
+func generateCostGroup(objectType string) map[string]interface{} {
+	groupID := map[string]interface{}{ProjectIDKey: "$" + ProjectIDKey}
+	keys := []string{ProjectIDKey}
+	switch objectType {
+	case types.ClusterType:
+		keys = append(keys, ClusterIDKey)
+	case types.NamespaceType:
+		keys = append(keys, ClusterIDKey, NamespaceKey)
+	case types.WorkloadType:
+		keys = append(keys, ClusterIDKey, NamespaceKey, WorkloadTypeKey, WorkloadNameKey)
+	}
+	group := map[string]interface{}{}
+	for _, key := range keys {
+		groupID[key] = "$" + key
+		group[key] = map[string]interface{}{"$first": "$" + key}
+	}
+	group["_id"] = groupID
+	for _, key := range []string{"project_code", BusinessIDKey, "currency"} {
+		group[key] = map[string]interface{}{"$last": "$" + key}
+	}
+	group["start_date"] = map[string]interface{}{"$min": "$" + BucketTimeKey}
+	group["end_date"] = map[string]interface{}{"$max": "$" + BucketTimeKey}
+	for _, key := range []string{"cpu_request_core_hours", "cpu_used_core_hours", "memory_request_gib_hours",
+		"memory_used_gib_hours", "request_cost", "used_cost", "node_hours", "node_cost", "gpu_hours", "gpu_cost"} {
+		group[key] = map[string]interface{}{"$sum": "$" + key}
+	}
+	return group
+}
@@ -32,6 +32,7 @@ type server struct {
 	*ModelOperationData
 	*ModelWorkloadRequest
 	*ModelWorkloadOriginRequest
+	*ModelCost
 }
 
 // NewServer new db server
@@ -48,5 +49,6 @@ func NewServer(db drivers.DB, bkbaseConf *types.BkbaseConfig) store.Server {
 		ModelOperationData:         NewModelOperationData(db, bkbaseConf),
 		ModelWorkloadRequest:       NewModelWorkloadRequest(db),
 		ModelWorkloadOriginRequest: NewModelWorkloadOriginRequest(db),
+		ModelCost:                  NewModelCost(db),
 	}
 }
@@ -77,4 +77,7 @@ type Server interface {
 	CreateWorkloadOriginRequest(ctx context.Context, result *types.WorkloadOriginRequestResult) error
 	ListWorkloadOriginRequest(ctx context.Context,
 		req *datamanager.GetWorkloadOriginRequestResultReq) ([]*datamanager.WorkloadOriginRequestResult, error)
+
+	InsertCostInfo(ctx context.Context, costs []*types.CostData) error
+	GetCostList(ctx context.Context, req *datamanager.GetCostListRequest) ([]*datamanager.Cost, error)
 }
@@ -188,3 +188,14 @@ func (s *ModelInterface) ListWorkloadOriginRequest(ctx context.Context,
 	req *datamanager.GetWorkloadOriginRequestResultReq) ([]*datamanager.WorkloadOriginRequestResult, error) {
 	return nil, ErrNotImplemented
 }
+
+// InsertCostInfo is not implemented.
+func (s *ModelInterface) InsertCostInfo(ctx context.Context, costs []*types.CostData) error {
+	return ErrNotImplemented
+}
+
+// GetCostList is not implemented.
+func (s *ModelInterface) GetCostList(ctx context.Context,
+	req *datamanager.GetCostListRequest) ([]*datamanager.Cost, error) {
+	return nil, ErrNotImplemented
+}
@@ -0,0 +1,78 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CostPriceConfig unit prices for cost allocation, all prices are per hour
+type CostPriceConfig struct {
+	// Currency currency of prices, e.g. CNY
+	Currency string `json:"currency"`
+	// CPUCoreHour price of one cpu core per hour
+	CPUCoreHour float64 `json:"cpuCoreHour"`
+	// MemoryGiBHour price of 1GiB memory per hour
+	MemoryGiBHour float64 `json:"memoryGiBHour"`
+	// GPUHour price of one gpu card per hour
+	GPUHour float64 `json:"gpuHour"`
+	// NodeTypeHour price of one node per hour, key is node instance type
+	NodeTypeHour map[string]float64 `json:"nodeTypeHour"`
+	// DefaultNodeHour price of node whose instance type is not in NodeTypeHour
+	DefaultNodeHour float64 `json:"defaultNodeHour"`
+}
+
+// Enabled cost job only runs when any price is configured
+func (c *CostPriceConfig) Enabled() bool {
+	if c == nil {
+		return false
+	}
+	return c.CPUCoreHour > 0 || c.MemoryGiBHour > 0 || c.GPUHour > 0 || c.DefaultNodeHour > 0 ||
+		len(c.NodeTypeHour) > 0
+}
+
+// NodePrice get price of node per hour by instance type
+func (c *CostPriceConfig) NodePrice(instanceType string) float64 {
+	if price, ok := c.NodeTypeHour[instanceType]; ok {
+		return price
+	}
+	return c.DefaultNodeHour
+}
+
+// CostData for cost table, one record for one object in one day
+// workload/namespace records only contain request and used cost,
+// node and gpu cost are only recorded in cluster records
+type CostData struct {
+	CreateTime            primitive.DateTime `json:"createTime" bson:"create_time"`
+	UpdateTime            primitive.DateTime `json:"updateTime" bson:"update_time"`
+	BucketTime            string             `json:"bucketTime" bson:"bucket_time"`
+	ObjectType            string             `json:"objectType" bson:"object_type"`
+	ProjectID             string             `json:"projectID" bson:"project_id"`
+	ProjectCode           string             `json:"projectCode" bson:"project_code"`
+	BusinessID            string             `json:"businessID" bson:"business_id"`
+	ClusterID             string             `json:"clusterID" bson:"cluster_id"`
+	Namespace             string             `json:"namespace" bson:"namespace"`
+	WorkloadType          string             `json:"workloadType" bson:"workload_type"`
+	WorkloadName          string             `json:"workloadName" bson:"workload_name"`
+	CPURequestCoreHours   float64            `json:"cpuRequestCoreHours" bson:"cpu_request_core_hours"`
+	CPUUsedCoreHours      float64            `json:"cpuUsedCoreHours" bson:"cpu_used_core_hours"`
+	MemoryRequestGiBHours float64            `json:"memoryRequestGiBHours" bson:"memory_request_gib_hours"`
+	MemoryUsedGiBHours    float64            `json:"memoryUsedGiBHours" bson:"memory_used_gib_hours"`
+	RequestCost           float64            `json:"requestCost" bson:"request_cost"`
+	UsedCost              float64            `json:"usedCost" bson:"used_cost"`
+	NodeHours             float64            `json:"nodeHours" bson:"node_hours"`
+	NodeCost              float64            `json:"nodeCost" bson:"node_cost"`
+	GPUHours              float64            `json:"gpuHours" bson:"gpu_hours"`
+	GPUCost               float64            `json:"gpuCost" bson:"gpu_cost"`
+	Currency              string             `json:"currency" bson:"currency"`
+}
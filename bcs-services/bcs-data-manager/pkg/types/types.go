@@ -40,6 +40,7 @@ const (
 	GPAType                = "GeneralPodAutoscaler"
 	PodAutoscalerType      = "PodAutoscaler"
 	GetWorkloadRequestType = "getWorkloadRequest"
+	CostType               = "cost"
 )
 
 // extract dimension
@@ -65,6 +66,7 @@ const (
 	WorkloadRequestTableName = "request_workload"
 	PredictTableNamePrefix   = "bcs_predict_"
 	WorkloadInfoTableName    = "workload_info"
+	CostTableName            = "cost"
 )
 
 // cluster type
@@ -50,6 +50,7 @@ type DataJobHandler struct {
 type HandlerOptions struct {
 	ChanQueueNum           int64
 	ignoreBkMonitorCluster bool
+	CostPrice              *types.CostPriceConfig
 }
 
 // HandleClients handleClients type
@@ -64,7 +65,7 @@ type HandleClients struct {
 // NewDataJobHandler create dataJob handler object
 func NewDataJobHandler(opts HandlerOptions, client HandleClients, concurrency int64) *DataJobHandler {
 	ctx, cancel := context.WithCancel(context.Background())
-	factory := datajob.NewPolicyFactory(client.Store)
+	factory := datajob.NewPolicyFactory(client.Store, opts.CostPrice)
 	factory.Init()
 	return &DataJobHandler{
 		stopCtx:                ctx,
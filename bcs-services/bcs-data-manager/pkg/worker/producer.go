@@ -50,8 +50,12 @@ type Producer struct {
 	bcsMonitorCli   bcsmonitor.ClientInterface // nolint
 	needSendKafka   bool
 	kafkaConn       kafka.KafkaInterface
+	costSpec        string
 }
 
+// DefaultCostSpec default cron spec of cost job
+const DefaultCostSpec = "0 2 * * *"
+
 // NewProducer new producer
 func NewProducer(rootCtx context.Context, msgQueue msgqueue.MessageQueue, cron *cron.Cron,
 	cmClient cmanager.ClusterManagerClient, k8sStorageCli, mesosStorageCli bcsapi.Storage,
@@ -76,6 +80,11 @@ func (p *Producer) ImportKafkaConn(conn kafka.KafkaInterface) {
 	p.kafkaConn = conn
 }
 
+// SetCostSpec set cron spec of cost job
+func (p *Producer) SetCostSpec(spec string) {
+	p.costSpec = spec
+}
+
 // Stop stop producer
 func (p *Producer) Stop() {
 	p.cron.Stop()
@@ -177,6 +186,17 @@ func (p *Producer) InitCronList() error {
 	}); err != nil {
 		return err
 	}
+
+	// cost job is calculated from hourly workload data of the whole yesterday
+	costSpec := p.costSpec
+	if costSpec == "" {
+		costSpec = DefaultCostSpec
+	}
+	if _, err := p.cron.AddFunc(costSpec, func() {
+		p.CostProducer(types.DimensionDay)
+	}); err != nil {
+		return err
+	}
 	blog.Infof("init cron list")
 	return nil
 }
@@ -281,6 +301,49 @@ func (p *Producer) ClusterProducer(dimension string) {
 		len(clusterList), jobTime, startTime, time.Now(), time.Since(startTime))
 }
 
+// CostProducer is the function to produce cluster cost job and send to message queue
+func (p *Producer) CostProducer(dimension string) {
+	startTime := time.Now()
+	jobTime := utils.FormatTime(time.Now(), dimension)
+	var err error
+	defer func() {
+		prom.ReportProduceJobLatencyMetric(types.CostType, dimension, err, startTime)
+	}()
+	cmConn, err := p.CMClient.GetClusterManagerConn()
+	if err != nil {
+		blog.Errorf("get cm conn error:%v", err)
+		return
+	}
+	defer cmConn.Close() // nolint
+	cliWithHeader := p.CMClient.NewGrpcClientWithHeader(p.ctx, cmConn)
+	clusterList, err := p.resourceGetter.GetClusterIDList(cliWithHeader.Ctx, cliWithHeader.Cli)
+	if err != nil || clusterList == nil {
+		blog.Errorf("get clusterList error: %v", err)
+		return
+	}
+	for _, cluster := range clusterList {
+		opts := types.JobCommonOpts{
+			ProjectID:   cluster.ProjectID,
+			ProjectCode: cluster.ProjectCode,
+			BusinessID:  cluster.BusinessID,
+			ClusterID:   cluster.ClusterID,
+			ClusterType: cluster.ClusterType,
+			CurrentTime: jobTime,
+			Dimension:   dimension,
+			ObjectType:  types.CostType,
+			Label:       cluster.Label,
+			IsBKMonitor: cluster.IsBKMonitor,
+		}
+		err := p.SendJob(opts)
+		if err != nil {
+			blog.Errorf("send cost job to msg queue error, opts: %v, err: %v", opts, err)
+			return
+		}
+	}
+	blog.Infof("[producer] send cost job success, count: %d, jobTime:%v, startTime:%v, currentTime:%v, cost:%v",
+		len(clusterList), jobTime, startTime, time.Now(), time.Since(startTime))
+}
+
 // NamespaceProducer is the function to produce namespace data job and send to message queue
 func (p *Producer) NamespaceProducer(dimension string) {
 	startTime := time.Now()
@@ -177,6 +177,6 @@ func TestProducerInitCronList(t *testing.T) {
 	producer := NewProducer(ctx, queue, newcron, cmCli, storageCli, storageCli, getter, 100)
 	err := producer.InitCronList()
 	assert.Nil(t, err)
-	assert.Equal(t, 11, len(newcron.Entries()))
+	assert.Equal(t, 12, len(newcron.Entries()))
 }
 */
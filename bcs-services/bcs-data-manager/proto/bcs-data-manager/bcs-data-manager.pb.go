@@ -4468,6 +4468,450 @@ func (m *GetWorkloadOriginRequestResultReq) GetProjectID() string {
 	return ""
 }
 
+type GetCostListRequest struct {
+	ProjectID            string   `protobuf:"bytes,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	ClusterID            string   `protobuf:"bytes,2,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	WorkloadType         string   `protobuf:"bytes,4,opt,name=workloadType,proto3" json:"workloadType,omitempty"`
+	WorkloadName         string   `protobuf:"bytes,5,opt,name=workloadName,proto3" json:"workloadName,omitempty"`
+	ObjectType           string   `protobuf:"bytes,6,opt,name=objectType,proto3" json:"objectType,omitempty"`
+	StartTime            int64    `protobuf:"varint,7,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64    `protobuf:"varint,8,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Page                 uint32   `protobuf:"varint,9,opt,name=page,proto3" json:"page,omitempty"`
+	Size                 uint32   `protobuf:"varint,10,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCostListRequest) Reset()         { *m = GetCostListRequest{} }
+func (m *GetCostListRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCostListRequest) ProtoMessage()    {}
+func (*GetCostListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_518799807a60b6f2, []int{49}
+}
+
+func (m *GetCostListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCostListRequest.Unmarshal(m, b)
+}
+func (m *GetCostListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCostListRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCostListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCostListRequest.Merge(m, src)
+}
+func (m *GetCostListRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCostListRequest.Size(m)
+}
+func (m *GetCostListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCostListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCostListRequest proto.InternalMessageInfo
+
+func (m *GetCostListRequest) GetProjectID() string {
+	if m != nil {
+		return m.ProjectID
+	}
+	return ""
+}
+
+func (m *GetCostListRequest) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+func (m *GetCostListRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetCostListRequest) GetWorkloadType() string {
+	if m != nil {
+		return m.WorkloadType
+	}
+	return ""
+}
+
+func (m *GetCostListRequest) GetWorkloadName() string {
+	if m != nil {
+		return m.WorkloadName
+	}
+	return ""
+}
+
+func (m *GetCostListRequest) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *GetCostListRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *GetCostListRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *GetCostListRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *GetCostListRequest) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type GetCostListResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data                 []*Cost  `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Total                uint32   `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCostListResponse) Reset()         { *m = GetCostListResponse{} }
+func (m *GetCostListResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCostListResponse) ProtoMessage()    {}
+func (*GetCostListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_518799807a60b6f2, []int{50}
+}
+
+func (m *GetCostListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCostListResponse.Unmarshal(m, b)
+}
+func (m *GetCostListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCostListResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCostListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCostListResponse.Merge(m, src)
+}
+func (m *GetCostListResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCostListResponse.Size(m)
+}
+func (m *GetCostListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCostListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCostListResponse proto.InternalMessageInfo
+
+func (m *GetCostListResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetCostListResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *GetCostListResponse) GetData() []*Cost {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GetCostListResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type ExportCostListResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data                 string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	FileName             string   `protobuf:"bytes,4,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportCostListResponse) Reset()         { *m = ExportCostListResponse{} }
+func (m *ExportCostListResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportCostListResponse) ProtoMessage()    {}
+func (*ExportCostListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_518799807a60b6f2, []int{51}
+}
+
+func (m *ExportCostListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportCostListResponse.Unmarshal(m, b)
+}
+func (m *ExportCostListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportCostListResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportCostListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportCostListResponse.Merge(m, src)
+}
+func (m *ExportCostListResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportCostListResponse.Size(m)
+}
+func (m *ExportCostListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportCostListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportCostListResponse proto.InternalMessageInfo
+
+func (m *ExportCostListResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ExportCostListResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ExportCostListResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ExportCostListResponse) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
+}
+
+type Cost struct {
+	ProjectID             string   `protobuf:"bytes,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	ProjectCode           string   `protobuf:"bytes,2,opt,name=projectCode,proto3" json:"projectCode,omitempty"`
+	BusinessID            string   `protobuf:"bytes,3,opt,name=businessID,proto3" json:"businessID,omitempty"`
+	ClusterID             string   `protobuf:"bytes,4,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	Namespace             string   `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	WorkloadType          string   `protobuf:"bytes,6,opt,name=workloadType,proto3" json:"workloadType,omitempty"`
+	WorkloadName          string   `protobuf:"bytes,7,opt,name=workloadName,proto3" json:"workloadName,omitempty"`
+	ObjectType            string   `protobuf:"bytes,8,opt,name=objectType,proto3" json:"objectType,omitempty"`
+	StartDate             string   `protobuf:"bytes,9,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate               string   `protobuf:"bytes,10,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	CpuRequestCoreHours   float64  `protobuf:"fixed64,11,opt,name=cpuRequestCoreHours,proto3" json:"cpuRequestCoreHours,omitempty"`
+	CpuUsedCoreHours      float64  `protobuf:"fixed64,12,opt,name=cpuUsedCoreHours,proto3" json:"cpuUsedCoreHours,omitempty"`
+	MemoryRequestGiBHours float64  `protobuf:"fixed64,13,opt,name=memoryRequestGiBHours,proto3" json:"memoryRequestGiBHours,omitempty"`
+	MemoryUsedGiBHours    float64  `protobuf:"fixed64,14,opt,name=memoryUsedGiBHours,proto3" json:"memoryUsedGiBHours,omitempty"`
+	RequestCost           float64  `protobuf:"fixed64,15,opt,name=requestCost,proto3" json:"requestCost,omitempty"`
+	UsedCost              float64  `protobuf:"fixed64,16,opt,name=usedCost,proto3" json:"usedCost,omitempty"`
+	IdleCost              float64  `protobuf:"fixed64,17,opt,name=idleCost,proto3" json:"idleCost,omitempty"`
+	NodeHours             float64  `protobuf:"fixed64,18,opt,name=nodeHours,proto3" json:"nodeHours,omitempty"`
+	NodeCost              float64  `protobuf:"fixed64,19,opt,name=nodeCost,proto3" json:"nodeCost,omitempty"`
+	GpuHours              float64  `protobuf:"fixed64,20,opt,name=gpuHours,proto3" json:"gpuHours,omitempty"`
+	GpuCost               float64  `protobuf:"fixed64,21,opt,name=gpuCost,proto3" json:"gpuCost,omitempty"`
+	Currency              string   `protobuf:"bytes,22,opt,name=currency,proto3" json:"currency,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *Cost) Reset()         { *m = Cost{} }
+func (m *Cost) String() string { return proto.CompactTextString(m) }
+func (*Cost) ProtoMessage()    {}
+func (*Cost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_518799807a60b6f2, []int{52}
+}
+
+func (m *Cost) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Cost.Unmarshal(m, b)
+}
+func (m *Cost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Cost.Marshal(b, m, deterministic)
+}
+func (m *Cost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cost.Merge(m, src)
+}
+func (m *Cost) XXX_Size() int {
+	return xxx_messageInfo_Cost.Size(m)
+}
+func (m *Cost) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cost proto.InternalMessageInfo
+
+func (m *Cost) GetProjectID() string {
+	if m != nil {
+		return m.ProjectID
+	}
+	return ""
+}
+
+func (m *Cost) GetProjectCode() string {
+	if m != nil {
+		return m.ProjectCode
+	}
+	return ""
+}
+
+func (m *Cost) GetBusinessID() string {
+	if m != nil {
+		return m.BusinessID
+	}
+	return ""
+}
+
+func (m *Cost) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+func (m *Cost) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *Cost) GetWorkloadType() string {
+	if m != nil {
+		return m.WorkloadType
+	}
+	return ""
+}
+
+func (m *Cost) GetWorkloadName() string {
+	if m != nil {
+		return m.WorkloadName
+	}
+	return ""
+}
+
+func (m *Cost) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *Cost) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *Cost) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *Cost) GetCpuRequestCoreHours() float64 {
+	if m != nil {
+		return m.CpuRequestCoreHours
+	}
+	return 0
+}
+
+func (m *Cost) GetCpuUsedCoreHours() float64 {
+	if m != nil {
+		return m.CpuUsedCoreHours
+	}
+	return 0
+}
+
+func (m *Cost) GetMemoryRequestGiBHours() float64 {
+	if m != nil {
+		return m.MemoryRequestGiBHours
+	}
+	return 0
+}
+
+func (m *Cost) GetMemoryUsedGiBHours() float64 {
+	if m != nil {
+		return m.MemoryUsedGiBHours
+	}
+	return 0
+}
+
+func (m *Cost) GetRequestCost() float64 {
+	if m != nil {
+		return m.RequestCost
+	}
+	return 0
+}
+
+func (m *Cost) GetUsedCost() float64 {
+	if m != nil {
+		return m.UsedCost
+	}
+	return 0
+}
+
+func (m *Cost) GetIdleCost() float64 {
+	if m != nil {
+		return m.IdleCost
+	}
+	return 0
+}
+
+func (m *Cost) GetNodeHours() float64 {
+	if m != nil {
+		return m.NodeHours
+	}
+	return 0
+}
+
+func (m *Cost) GetNodeCost() float64 {
+	if m != nil {
+		return m.NodeCost
+	}
+	return 0
+}
+
+func (m *Cost) GetGpuHours() float64 {
+	if m != nil {
+		return m.GpuHours
+	}
+	return 0
+}
+
+func (m *Cost) GetGpuCost() float64 {
+	if m != nil {
+		return m.GpuCost
+	}
+	return 0
+}
+
+func (m *Cost) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GetAllProjectListRequest)(nil), "datamanager.GetAllProjectListRequest")
 	proto.RegisterType((*GetAllProjectListResponse)(nil), "datamanager.GetAllProjectListResponse")
@@ -4525,6 +4969,10 @@ func init() {
 	proto.RegisterType((*WorkloadOriginRequestContainer)(nil), "datamanager.WorkloadOriginRequestContainer")
 	proto.RegisterType((*WorkloadOriginRequestResult)(nil), "datamanager.WorkloadOriginRequestResult")
 	proto.RegisterType((*GetWorkloadOriginRequestResultReq)(nil), "datamanager.GetWorkloadOriginRequestResultReq")
+	proto.RegisterType((*GetCostListRequest)(nil), "datamanager.GetCostListRequest")
+	proto.RegisterType((*GetCostListResponse)(nil), "datamanager.GetCostListResponse")
+	proto.RegisterType((*ExportCostListResponse)(nil), "datamanager.ExportCostListResponse")
+	proto.RegisterType((*Cost)(nil), "datamanager.Cost")
 }
 
 func init() {
@@ -4532,467 +4980,564 @@ func init() {
 }
 
 var fileDescriptor_518799807a60b6f2 = []byte{
-	// 7211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x14, 0xc7,
-	0x99, 0x70, 0x56, 0x5a, 0x7d, 0x6c, 0x6b, 0x85, 0x44, 0x0b, 0x8c, 0xb4, 0xe2, 0x63, 0x59, 0x83,
-	0x2d, 0x8f, 0x2d, 0x09, 0x8d, 0x6d, 0x3e, 0x64, 0x3b, 0x61, 0x24, 0x30, 0xc8, 0x46, 0x20, 0x8f,
-	0xc0, 0x71, 0xe2, 0xd7, 0x4e, 0x0d, 0xbb, 0x83, 0xb2, 0xb6, 0xf6, 0x23, 0xbb, 0xb3, 0x04, 0x25,
-	0x95, 0xbc, 0x82, 0x60, 0x83, 0xb1, 0x40, 0x64, 0x02, 0xc1, 0x9f, 0xb1, 0x95, 0xc2, 0x86, 0x24,
-	0x2f, 0x1f, 0x4e, 0x6c, 0x07, 0x84, 0x30, 0xf5, 0xd6, 0x5d, 0xaa, 0x2e, 0x55, 0xb9, 0xba, 0x3b,
-	0x7c, 0x75, 0x55, 0xf7, 0xeb, 0xea, 0x62, 0xcd, 0xae, 0x74, 0x75, 0x15, 0xe5, 0xc7, 0x55, 0xae,
-	0xca, 0x7f, 0xee, 0x6a, 0xba, 0x7b, 0x66, 0xba, 0x67, 0x66, 0x57, 0x2b, 0x21, 0x61, 0x39, 0xc5,
-	0x1f, 0xd0, 0x3e, 0xdd, 0xcf, 0xd3, 0xfd, 0x74, 0x3f, 0xdf, 0xdd, 0x33, 0x03, 0x9a, 0x93, 0xa9,
-	0x84, 0x92, 0x68, 0xdd, 0x1b, 0x4e, 0x37, 0x47, 0x24, 0x45, 0x6a, 0x8e, 0x49, 0x71, 0xa9, 0x4f,
-	0x4e, 0x39, 0x00, 0x2d, 0xa8, 0x1f, 0xac, 0xd2, 0x61, 0x04, 0x14, 0x58, 0xde, 0x97, 0x48, 0xf4,
-	0xf5, 0xcb, 0xad, 0x52, 0x32, 0xda, 0x2a, 0xc5, 0xe3, 0x09, 0x45, 0x52, 0xa2, 0x89, 0x78, 0x1a,
-	0x77, 0x0d, 0x3c, 0x80, 0xfe, 0x0b, 0x37, 0xf7, 0xc9, 0xf1, 0xe6, 0xf4, 0x77, 0xa5, 0x3e, 0x9d,
-	0x6a, 0x22, 0x89, 0x7a, 0xb8, 0xf4, 0x5e, 0xb6, 0x5f, 0xea, 0x8f, 0x46, 0x24, 0x45, 0x6e, 0x35,
-	0xfe, 0x20, 0x0d, 0x0d, 0x64, 0x10, 0xf4, 0x6b, 0x6f, 0x66, 0x5f, 0xab, 0x14, 0x1f, 0x20, 0x4d,
-	0xcb, 0xed, 0x4d, 0x69, 0x25, 0x95, 0x09, 0x2b, 0xb8, 0x35, 0xf4, 0x9a, 0x17, 0xd4, 0x6f, 0x93,
-	0x15, 0xa1, 0xbf, 0xbf, 0x27, 0x95, 0x78, 0x41, 0x0e, 0x2b, 0x3b, 0xa2, 0x69, 0x45, 0x94, 0xbf,
-	0x93, 0x91, 0xd3, 0x0a, 0x1c, 0xf1, 0x00, 0x5f, 0x24, 0x1a, 0x93, 0xe3, 0xe9, 0x68, 0x22, 0x5e,
-	0xef, 0x09, 0x7a, 0x9a, 0x7c, 0x1d, 0x87, 0x3c, 0xaa, 0x20, 0x73, 0x16, 0x98, 0x7f, 0x26, 0x7b,
-	0xe6, 0xe3, 0xc9, 0x33, 0x1f, 0xe6, 0xae, 0x7d, 0xa8, 0x8d, 0xbe, 0x3f, 0x35, 0x36, 0x64, 0xb6,
-	0x8c, 0x5f, 0x19, 0xcd, 0xfd, 0x62, 0x34, 0x7b, 0xe6, 0xe3, 0x89, 0x1b, 0xa7, 0xb4, 0x37, 0x7f,
-	0x96, 0x7d, 0x6b, 0x30, 0xfb, 0xd3, 0xcb, 0xb9, 0x37, 0x0e, 0x8f, 0x5f, 0x19, 0xcc, 0xbe, 0x3d,
-	0x92, 0x3d, 0x7d, 0x39, 0x7b, 0xfc, 0xe2, 0xd4, 0xd8, 0x50, 0x12, 0x8f, 0x9b, 0xfb, 0xb5, 0xaa,
-	0x8d, 0xbe, 0xaf, 0x0d, 0xff, 0x32, 0x7b, 0xea, 0x52, 0x76, 0xe8, 0x60, 0x44, 0x1a, 0xf8, 0xbc,
-	0x63, 0x59, 0x6a, 0xa9, 0x58, 0x1e, 0x8b, 0xc6, 0x33, 0x8a, 0x2c, 0x7a, 0xbf, 0x9d, 0xc8, 0xa4,
-	0xc4, 0xd2, 0x88, 0x34, 0x20, 0x7e, 0x45, 0xb4, 0x86, 0x87, 0x0f, 0x03, 0x6f, 0x52, 0xea, 0x93,
-	0xeb, 0x4b, 0x82, 0x9e, 0xa6, 0xea, 0x8e, 0xd5, 0xaa, 0xb0, 0x8c, 0x43, 0x00, 0xbe, 0x26, 0xfb,
-	0xce, 0x7b, 0x13, 0x97, 0x7e, 0xae, 0x1d, 0x1c, 0xce, 0x9d, 0xbb, 0x36, 0x79, 0x64, 0xf8, 0xf3,
-	0x0e, 0x2f, 0x57, 0xd2, 0xf4, 0x15, 0x11, 0xb5, 0xc2, 0x8d, 0xc0, 0x9b, 0x8e, 0x7e, 0x4f, 0xae,
-	0x2f, 0x45, 0x68, 0x6b, 0x54, 0x21, 0xc0, 0x21, 0x00, 0x0f, 0x31, 0xda, 0xe4, 0xeb, 0x27, 0xb5,
-	0xa3, 0x1f, 0x67, 0x4f, 0x5f, 0x36, 0x30, 0xeb, 0x81, 0x88, 0x3a, 0xc0, 0x67, 0x80, 0x2f, 0xad,
-	0x48, 0x29, 0x65, 0x77, 0x34, 0x26, 0xd7, 0x7b, 0x83, 0x9e, 0xa6, 0xd2, 0x8e, 0x76, 0x55, 0xd8,
-	0xc0, 0x59, 0x50, 0x9e, 0xd3, 0xc6, 0x06, 0xb5, 0x73, 0xaf, 0xe2, 0x85, 0x99, 0x1a, 0x1b, 0xc2,
-	0x7f, 0x64, 0x8f, 0xfe, 0x66, 0x6a, 0x6c, 0x68, 0xfc, 0xca, 0xf1, 0xf1, 0xb1, 0x77, 0xc7, 0xaf,
-	0x8c, 0x4e, 0x5e, 0x7b, 0x6d, 0xe2, 0xe2, 0x59, 0xd1, 0x42, 0x83, 0x22, 0xa8, 0x90, 0xe3, 0x11,
-	0x44, 0xb7, 0x0c, 0xd1, 0xdd, 0xa8, 0x0a, 0x0f, 0x73, 0x06, 0x8c, 0xe7, 0x72, 0xd7, 0x7e, 0x92,
-	0x7d, 0xfb, 0x9d, 0xc2, 0x54, 0x73, 0x27, 0x2e, 0x6b, 0x6f, 0x9d, 0x17, 0x0d, 0xa4, 0xf6, 0x4d,
-	0xaa, 0xb0, 0x1e, 0x3c, 0xc4, 0xe5, 0xdd, 0x63, 0xbe, 0x9e, 0xf0, 0x3b, 0x72, 0x35, 0xf7, 0xe6,
-	0x45, 0xed, 0xe8, 0x99, 0x89, 0x91, 0xf3, 0x13, 0x97, 0x7e, 0x9b, 0xfd, 0xe0, 0x50, 0xe8, 0x77,
-	0x25, 0xa0, 0xc1, 0x05, 0x2d, 0x9d, 0x4c, 0xc4, 0xd3, 0x32, 0x6c, 0x01, 0xde, 0x70, 0x22, 0x22,
-	0x23, 0xa9, 0xa8, 0xee, 0x08, 0xa0, 0x75, 0xd7, 0x01, 0x7c, 0x0d, 0xde, 0xee, 0xc9, 0x53, 0xaf,
-	0x4f, 0x5c, 0xba, 0x94, 0x7b, 0xf7, 0xa0, 0x88, 0xc0, 0xb0, 0x1d, 0x54, 0xc4, 0xe4, 0x74, 0xda,
-	0xd8, 0x2a, 0x5f, 0x47, 0x50, 0x15, 0x56, 0x70, 0x06, 0x8c, 0x87, 0x34, 0xd6, 0xf8, 0x8d, 0x91,
-	0xec, 0xc1, 0x4b, 0xa2, 0xd1, 0x08, 0x05, 0xe0, 0xd5, 0x35, 0xaa, 0xbe, 0x34, 0x58, 0xda, 0x54,
-	0xc5, 0x2f, 0x69, 0xa1, 0xd4, 0xab, 0x85, 0xcc, 0xad, 0x63, 0x99, 0x2a, 0x2c, 0xe1, 0x50, 0x37,
-	0xde, 0x8f, 0x99, 0x21, 0x54, 0x10, 0x0c, 0x36, 0x81, 0x32, 0x25, 0xa1, 0x48, 0xfd, 0x68, 0xc7,
-	0xaa, 0x3b, 0xa0, 0x2a, 0xd4, 0x70, 0x18, 0xc2, 0x97, 0x67, 0x07, 0xaf, 0x65, 0x4f, 0x5f, 0x16,
-	0xf1, 0xcf, 0xf6, 0x5d, 0xaa, 0xb0, 0x03, 0x3c, 0xc1, 0xdd, 0xb5, 0x4d, 0x56, 0x08, 0xed, 0xae,
-	0xf8, 0xbe, 0x84, 0xc1, 0x37, 0xbb, 0x5e, 0x78, 0x08, 0x3c, 0xf5, 0x9b, 0x1e, 0xc4, 0xe8, 0x4d,
-	0x8f, 0x31, 0xed, 0x9b, 0x1e, 0x34, 0x74, 0xe8, 0x58, 0x19, 0x58, 0x6a, 0x27, 0x86, 0xf5, 0x6b,
-	0x3b, 0xa8, 0x20, 0xd2, 0x4f, 0x94, 0xab, 0x45, 0x15, 0xea, 0x38, 0x03, 0xc6, 0x57, 0xe2, 0x41,
-	0xba, 0xb6, 0x7c, 0xde, 0xb1, 0x34, 0x55, 0x57, 0x1f, 0xe1, 0x6b, 0x9e, 0x7f, 0x76, 0x5d, 0xf3,
-	0x26, 0xa9, 0xf9, 0x7b, 0x42, 0xf3, 0x37, 0x9b, 0x9f, 0xbb, 0x7f, 0x8d, 0x68, 0x74, 0xb5, 0x69,
-	0x6a, 0xc9, 0x82, 0xd4, 0xd4, 0x27, 0x41, 0xe5, 0xde, 0x4c, 0x3a, 0x1a, 0x97, 0xd3, 0x69, 0xa4,
-	0x76, 0xbe, 0x8e, 0x56, 0x7d, 0xcf, 0x4c, 0x20, 0x5f, 0x39, 0x7e, 0xe5, 0x0d, 0xed, 0xd8, 0x48,
-	0x01, 0x7e, 0xcd, 0xbe, 0xf0, 0x69, 0x50, 0x45, 0xa6, 0xd3, 0xa9, 0x4b, 0xa1, 0x17, 0xd1, 0x7b,
-	0x48, 0x15, 0x1a, 0x38, 0x1a, 0xce, 0x03, 0xbc, 0x84, 0xfa, 0xc6, 0xe4, 0x25, 0x4a, 0x23, 0xb0,
-	0xda, 0x5d, 0x36, 0x4f, 0xda, 0x5d, 0x3e, 0x57, 0xda, 0xbd, 0x4e, 0x15, 0x9a, 0xc1, 0xfd, 0x9c,
-	0xbb, 0x78, 0x99, 0xa6, 0x0c, 0x2d, 0x01, 0x51, 0xea, 0xd7, 0x4a, 0x40, 0x1e, 0xc9, 0xfe, 0x82,
-	0x34, 0xda, 0x33, 0x4b, 0x8d, 0x9e, 0x7b, 0x3d, 0xfd, 0x3d, 0xd6, 0xd3, 0xce, 0xfe, 0x4c, 0x5a,
-	0x91, 0x53, 0xb4, 0x1f, 0x9c, 0x3b, 0x3d, 0xa5, 0x75, 0xa0, 0xe4, 0x56, 0x75, 0xe0, 0x05, 0x5a,
-	0xe7, 0xb1, 0x46, 0xed, 0x50, 0x85, 0x56, 0x5a, 0xe5, 0x43, 0x36, 0x95, 0xc7, 0x2a, 0x3a, 0x35,
-	0x36, 0xa4, 0xeb, 0xa8, 0x6e, 0x02, 0x66, 0xea, 0x66, 0xbd, 0xb3, 0x73, 0xb3, 0x65, 0x33, 0x76,
-	0xb3, 0x36, 0x05, 0x2f, 0x9f, 0x17, 0x05, 0xaf, 0x98, 0x27, 0x05, 0xaf, 0x9c, 0x2b, 0x05, 0x5f,
-	0xaf, 0x0a, 0x0f, 0x82, 0x36, 0xce, 0x5d, 0x2e, 0x4d, 0x19, 0x7f, 0xf3, 0xe5, 0xdc, 0x27, 0x67,
-	0x19, 0xdf, 0xfd, 0x77, 0x58, 0xcd, 0x19, 0x9c, 0x2f, 0x40, 0xcd, 0x8b, 0xf6, 0xba, 0x70, 0x5b,
-	0x01, 0x17, 0x4f, 0xb8, 0xe8, 0x58, 0x81, 0xc4, 0x07, 0x19, 0x04, 0x48, 0xf3, 0x9c, 0xcf, 0x2c,
-	0xb8, 0x70, 0xef, 0xba, 0x64, 0x85, 0xcd, 0xc2, 0xff, 0x94, 0xd2, 0x66, 0x81, 0x76, 0xdf, 0x4f,
-	0x03, 0x5f, 0x98, 0x40, 0xb7, 0x10, 0xc3, 0xb0, 0x51, 0x15, 0x96, 0x72, 0x16, 0x94, 0xaf, 0xc4,
-	0x03, 0xe9, 0xea, 0xbc, 0x22, 0xd5, 0x58, 0x5b, 0xe2, 0x22, 0x9e, 0xed, 0xde, 0x8e, 0xce, 0xde,
-	0x66, 0xd1, 0x42, 0xfa, 0x52, 0x38, 0x73, 0x46, 0x8d, 0x4a, 0xe7, 0x49, 0x8d, 0xbc, 0x73, 0xa5,
-	0x46, 0x8f, 0xaa, 0xc2, 0x26, 0xb0, 0x81, 0x73, 0xdf, 0x47, 0xd3, 0x16, 0xa1, 0xad, 0xc2, 0x0a,
-	0x74, 0xd3, 0x63, 0xed, 0x47, 0x48, 0x65, 0x94, 0x69, 0x41, 0xfa, 0x4c, 0x43, 0x45, 0x18, 0x9f,
-	0x89, 0xf8, 0x61, 0x94, 0xa3, 0x4b, 0x15, 0x1e, 0x07, 0x5b, 0xb8, 0x3c, 0xdc, 0xd8, 0x16, 0xa2,
-	0xb0, 0x5a, 0xfc, 0x7f, 0x2f, 0x68, 0xdc, 0x26, 0x2b, 0x3b, 0xa5, 0x98, 0x9c, 0x4e, 0x4a, 0x61,
-	0x59, 0x27, 0x44, 0xfb, 0xcc, 0xf9, 0x52, 0x8e, 0x17, 0x9c, 0xba, 0x31, 0x6f, 0x4e, 0xef, 0x01,
-	0xe2, 0xf4, 0x70, 0x92, 0x58, 0xaf, 0x0a, 0x8b, 0x88, 0xd3, 0x2b, 0x9f, 0x1c, 0xf9, 0x28, 0x7b,
-	0xfa, 0x32, 0xeb, 0xeb, 0x78, 0xe2, 0xeb, 0xb0, 0xad, 0x5b, 0x89, 0x76, 0x02, 0xf9, 0x3a, 0xff,
-	0xe4, 0xc8, 0x47, 0x93, 0x6f, 0xff, 0x5c, 0x3b, 0x7b, 0x4e, 0xbb, 0x5c, 0x28, 0x99, 0x5c, 0xf0,
-	0xe1, 0xe6, 0x4e, 0x55, 0x78, 0x12, 0x74, 0x71, 0x85, 0xf6, 0x9d, 0x5f, 0x49, 0xe2, 0x81, 0x1f,
-	0x5f, 0xd7, 0x4e, 0x1e, 0xcf, 0xfd, 0x62, 0x74, 0xf2, 0xcc, 0x87, 0xb4, 0x67, 0x62, 0x14, 0xeb,
-	0x8f, 0x25, 0x60, 0xb9, 0x3b, 0xad, 0x05, 0xed, 0xab, 0x76, 0x31, 0xbe, 0xea, 0x2e, 0x46, 0x11,
-	0x4d, 0x5e, 0x3a, 0xee, 0x56, 0x85, 0x20, 0x51, 0xc5, 0x7a, 0xe7, 0x6a, 0x30, 0x6a, 0xf9, 0xac,
-	0x2a, 0x3c, 0x03, 0x9e, 0xe6, 0x0a, 0xae, 0x45, 0x81, 0x85, 0x2d, 0xac, 0xa8, 0x53, 0x5e, 0xb0,
-	0xcc, 0x4e, 0x78, 0xbe, 0x95, 0xf4, 0x19, 0xe0, 0x8b, 0x1b, 0xe3, 0x91, 0x9d, 0x68, 0x57, 0x85,
-	0x55, 0x9c, 0x05, 0xe5, 0x21, 0xc3, 0xc8, 0xc9, 0xe3, 0xda, 0x85, 0x33, 0x79, 0x03, 0x38, 0x0b,
-	0xcd, 0xe6, 0x1b, 0x4b, 0x17, 0xbe, 0x6f, 0x5c, 0xf0, 0x15, 0xa2, 0x1e, 0x55, 0xe8, 0x06, 0x4f,
-	0x72, 0xf9, 0x64, 0xc4, 0x88, 0x98, 0xe8, 0xed, 0x72, 0xaa, 0xf2, 0x4d, 0x8f, 0xb5, 0x47, 0xa1,
-	0xb3, 0x25, 0xa8, 0xa6, 0x68, 0xa3, 0xf6, 0x05, 0xa8, 0x74, 0x17, 0xe3, 0x31, 0xf3, 0x29, 0x2a,
-	0x1d, 0x56, 0xd2, 0x5c, 0x32, 0x2a, 0xfa, 0x94, 0x2a, 0xec, 0x04, 0x3b, 0xb8, 0xbc, 0x7c, 0xb9,
-	0x2e, 0x53, 0x61, 0xc5, 0xfc, 0x73, 0x19, 0x08, 0x6c, 0x93, 0x95, 0xaf, 0x27, 0x52, 0x2f, 0xf6,
-	0x27, 0xa4, 0xc8, 0xed, 0x72, 0xa0, 0xf3, 0xa7, 0x9b, 0xcf, 0x02, 0xff, 0x77, 0x09, 0x33, 0xbb,
-	0x07, 0x92, 0x32, 0xd1, 0xce, 0x0d, 0xaa, 0xb0, 0x9c, 0x63, 0x1a, 0x78, 0x7f, 0xf6, 0xfc, 0x88,
-	0xf6, 0xf6, 0x99, 0xdc, 0x07, 0xd7, 0xb4, 0x9f, 0xbd, 0x9a, 0x97, 0x32, 0x83, 0xc3, 0xfa, 0x7d,
-	0xef, 0xed, 0xf1, 0xfb, 0x65, 0x33, 0xf2, 0xfb, 0xe5, 0xb3, 0xf5, 0xfb, 0x0b, 0x3e, 0x0b, 0xdd,
-	0xa6, 0x0a, 0x5b, 0x40, 0x07, 0x57, 0x40, 0x5a, 0x0d, 0xf1, 0xc7, 0x1b, 0x9b, 0xd7, 0xe1, 0x7f,
-	0x5a, 0x82, 0x82, 0x46, 0x27, 0x91, 0x05, 0xed, 0xef, 0xb7, 0x33, 0xfe, 0x7e, 0x29, 0x63, 0x46,
-	0x0c, 0x56, 0x18, 0x2b, 0x42, 0xaf, 0x02, 0x63, 0x45, 0x76, 0xab, 0xc2, 0x53, 0x60, 0x17, 0x57,
-	0x68, 0x0d, 0x5c, 0x57, 0xb2, 0xb0, 0x21, 0xb9, 0x51, 0x8e, 0xf2, 0x13, 0x9a, 0xe2, 0x1d, 0x23,
-	0xe2, 0x6a, 0x44, 0x28, 0xe2, 0xba, 0x15, 0x27, 0x76, 0x84, 0x25, 0xae, 0x37, 0x18, 0xc4, 0xf5,
-	0xf9, 0x9f, 0xbb, 0x3c, 0x3d, 0x71, 0x1d, 0xc7, 0x16, 0x9a, 0x94, 0x2d, 0xfc, 0xd0, 0xa4, 0x7c,
-	0x9e, 0xec, 0x4e, 0xc5, 0x5c, 0xd9, 0x9d, 0x3e, 0x55, 0x88, 0x80, 0xbd, 0x5c, 0x1e, 0xe1, 0x36,
-	0xd2, 0xd5, 0x89, 0x8f, 0x0e, 0x67, 0x47, 0x4f, 0x16, 0x8e, 0x49, 0x6e, 0x7a, 0x18, 0x41, 0xa0,
-	0x7e, 0xea, 0x5b, 0x17, 0x7a, 0xb3, 0x04, 0xc5, 0xc8, 0xec, 0x20, 0x5f, 0x80, 0x4d, 0xda, 0xce,
-	0x04, 0x2c, 0xb7, 0x62, 0x69, 0x9e, 0x50, 0x85, 0x6d, 0x60, 0x2b, 0x97, 0x8f, 0x2b, 0x63, 0xed,
-	0x30, 0xfe, 0x34, 0xf6, 0xe5, 0x57, 0xe5, 0xa0, 0x82, 0x94, 0xd8, 0xe1, 0x7a, 0xe0, 0x23, 0x22,
-	0x68, 0x1a, 0x94, 0x7a, 0x64, 0x50, 0x4c, 0xa8, 0x51, 0x0e, 0x8f, 0x46, 0x44, 0x0b, 0x08, 0x37,
-	0x01, 0x60, 0xd4, 0xad, 0xbb, 0xb6, 0x90, 0x85, 0x69, 0x50, 0x85, 0xbb, 0x38, 0x0a, 0x6c, 0x14,
-	0xbf, 0xa3, 0x11, 0x91, 0x82, 0xc2, 0x76, 0x67, 0xc4, 0xbf, 0x5c, 0x15, 0x1a, 0x68, 0xad, 0xf2,
-	0xd3, 0x5a, 0x45, 0xcb, 0x7b, 0xbb, 0x3d, 0x14, 0x37, 0x70, 0x2d, 0x79, 0xf7, 0xd3, 0xf2, 0x4e,
-	0x4b, 0xf4, 0x43, 0x6c, 0xb0, 0xed, 0xc3, 0x7b, 0x6f, 0x4a, 0xb4, 0x9f, 0x96, 0x68, 0x53, 0x66,
-	0xe1, 0x53, 0xfa, 0xf6, 0x2b, 0xa9, 0x68, 0x38, 0x5d, 0x5f, 0x8e, 0xfc, 0x45, 0xa3, 0xdb, 0xe1,
-	0x46, 0x37, 0xee, 0x42, 0x48, 0x12, 0x04, 0xde, 0x8f, 0xd5, 0x1c, 0x6f, 0xa7, 0x68, 0x80, 0xe1,
-	0x73, 0xa0, 0x22, 0x16, 0x8d, 0xef, 0xd4, 0x85, 0xb0, 0x02, 0x09, 0x06, 0x4b, 0x72, 0xeb, 0x01,
-	0x25, 0x25, 0xc7, 0x32, 0x31, 0x51, 0x0e, 0x27, 0x52, 0x91, 0x8e, 0x7b, 0x54, 0xe1, 0x6e, 0xce,
-	0x40, 0xe0, 0xeb, 0xb3, 0x6f, 0x0d, 0x6a, 0x97, 0x87, 0x27, 0x8e, 0x1d, 0xca, 0x1d, 0xba, 0x9a,
-	0x3d, 0x7d, 0x59, 0x1b, 0x3e, 0x66, 0xcc, 0x98, 0x74, 0x41, 0xe4, 0xa5, 0x03, 0x88, 0x7c, 0x65,
-	0xd1, 0xe4, 0x31, 0x02, 0x26, 0x7f, 0xf6, 0x9c, 0x2b, 0x79, 0xdc, 0x05, 0x76, 0x81, 0xb2, 0x7e,
-	0x69, 0xaf, 0xdc, 0x5f, 0xef, 0x43, 0xcb, 0xb1, 0xca, 0x6d, 0x39, 0x5a, 0x76, 0xe8, 0x3d, 0xb6,
-	0xc6, 0x95, 0xd4, 0x00, 0x71, 0xc4, 0x08, 0x85, 0x2f, 0xcf, 0xbe, 0x7b, 0x24, 0x77, 0xe1, 0x13,
-	0x11, 0xff, 0x84, 0x5f, 0x65, 0xcf, 0x04, 0x80, 0xb5, 0x9f, 0xf9, 0xce, 0x04, 0x98, 0xda, 0x7f,
-	0x60, 0x23, 0x00, 0xd6, 0x40, 0xb0, 0x16, 0x94, 0xbe, 0x28, 0x0f, 0x60, 0x21, 0x16, 0xf5, 0x3f,
-	0xe1, 0x12, 0x50, 0xb6, 0x5f, 0xea, 0xcf, 0x10, 0xc5, 0x15, 0xf1, 0x8f, 0xf6, 0x92, 0x8d, 0x9e,
-	0xf6, 0xfb, 0x54, 0xe1, 0x1e, 0xb0, 0x86, 0x33, 0xd4, 0x80, 0x6f, 0x98, 0xb8, 0x78, 0x59, 0xbb,
-	0x7e, 0x9a, 0x10, 0xc7, 0x16, 0x08, 0xeb, 0x5f, 0xe8, 0xd3, 0x4a, 0xb0, 0x88, 0xdd, 0x65, 0x78,
-	0x3f, 0xf0, 0x2a, 0xba, 0x18, 0x61, 0x7d, 0xc1, 0x35, 0x3a, 0x05, 0xc9, 0x10, 0xde, 0x70, 0xb2,
-	0x64, 0x08, 0x06, 0xb7, 0x82, 0x6a, 0x62, 0xc2, 0xd2, 0x9d, 0x89, 0x4c, 0x5c, 0x21, 0xca, 0xb2,
-	0x0a, 0x39, 0x29, 0xd2, 0x82, 0x1a, 0x8c, 0x0a, 0x1f, 0x09, 0x56, 0x58, 0x2c, 0xd8, 0x06, 0x2a,
-	0x51, 0xf4, 0xd2, 0xd9, 0xb3, 0x87, 0x28, 0xcd, 0x52, 0x55, 0x80, 0x9c, 0x09, 0x44, 0x41, 0x4e,
-	0x38, 0x99, 0x11, 0x4d, 0x08, 0x7c, 0x0c, 0x54, 0xa1, 0xbf, 0xbb, 0xe5, 0x58, 0x22, 0x35, 0x40,
-	0xd4, 0xa5, 0x51, 0x15, 0xea, 0x39, 0x1a, 0xce, 0xfb, 0xb2, 0x83, 0xd7, 0xb4, 0x97, 0x5f, 0xd2,
-	0x2e, 0xbc, 0x26, 0xd2, 0x70, 0xd8, 0x09, 0xfc, 0xe8, 0xe7, 0x8e, 0x84, 0x14, 0xd1, 0x47, 0x2d,
-	0xa3, 0xe6, 0x4d, 0x37, 0xf0, 0x7e, 0x3c, 0xf2, 0xc4, 0x87, 0xef, 0x4c, 0x5c, 0xbf, 0x2e, 0x32,
-	0x6d, 0x70, 0x17, 0xa8, 0x31, 0x7f, 0x93, 0x79, 0xe0, 0xa3, 0x9f, 0xb5, 0xaa, 0x10, 0xe2, 0xec,
-	0x6d, 0x7c, 0x8d, 0x39, 0x17, 0x42, 0xcd, 0xde, 0x03, 0x6e, 0x06, 0x40, 0xda, 0xdf, 0x67, 0xcc,
-	0xa9, 0xc2, 0xb2, 0xc8, 0x14, 0x98, 0xaf, 0xd1, 0xae, 0xfe, 0x46, 0x7b, 0xfb, 0x88, 0x35, 0x29,
-	0xaa, 0x11, 0x76, 0x81, 0x6a, 0xf2, 0x8b, 0x4c, 0xa8, 0x12, 0x11, 0x41, 0xf5, 0x1d, 0xb6, 0xc5,
-	0x49, 0x87, 0x6d, 0x87, 0x1b, 0x40, 0x65, 0x67, 0xcf, 0x9e, 0x3d, 0xc8, 0x39, 0xf8, 0xac, 0xe5,
-	0x35, 0x81, 0xbc, 0x3f, 0x9c, 0xcc, 0x8c, 0x5f, 0xbf, 0x91, 0x3b, 0x75, 0x3e, 0x77, 0xe2, 0x88,
-	0x68, 0xc2, 0x61, 0x27, 0xa8, 0xc2, 0x24, 0x30, 0x2e, 0x96, 0xfc, 0xd5, 0xaa, 0xb0, 0x92, 0xa3,
-	0xe1, 0x7c, 0x0d, 0x5e, 0x0b, 0x8b, 0x02, 0xdd, 0xaa, 0x1b, 0xc3, 0x78, 0x22, 0x22, 0x63, 0xa9,
-	0xaa, 0xa2, 0x8c, 0xa1, 0x09, 0xe5, 0xfd, 0x44, 0x93, 0xb1, 0x48, 0x59, 0x0d, 0xf0, 0x19, 0x00,
-	0xa5, 0xfd, 0x52, 0xb4, 0x5f, 0xda, 0xdb, 0x2f, 0xef, 0x34, 0x89, 0xf8, 0x11, 0x91, 0x26, 0x55,
-	0x58, 0xcb, 0xb9, 0x34, 0xf3, 0x35, 0xda, 0xf0, 0xa5, 0xdc, 0xa9, 0xf3, 0xa6, 0x75, 0x10, 0x5d,
-	0x3a, 0xc1, 0xc7, 0x81, 0x9f, 0x58, 0x22, 0x4c, 0xb3, 0x1a, 0xd1, 0x0c, 0xe9, 0xd1, 0x24, 0xd3,
-	0xc0, 0xd7, 0xd8, 0x4c, 0x99, 0xc8, 0x34, 0xc3, 0x2e, 0x50, 0x45, 0x7e, 0x23, 0x93, 0xbd, 0x08,
-	0x91, 0xb9, 0x57, 0x15, 0xd6, 0x70, 0x34, 0x9c, 0x5f, 0x6a, 0xa3, 0x42, 0x74, 0x8f, 0xee, 0x83,
-	0xa6, 0x84, 0xad, 0x17, 0x9e, 0x52, 0x0d, 0x3d, 0x25, 0xaa, 0x01, 0x4f, 0x89, 0x32, 0x7f, 0x22,
-	0xd3, 0x8c, 0xa6, 0x84, 0x7f, 0xa3, 0x29, 0xd5, 0xd2, 0x53, 0xb2, 0xe0, 0x78, 0x4a, 0x14, 0x15,
-	0x73, 0x4a, 0x56, 0x9f, 0xd0, 0xd5, 0x4a, 0x50, 0x41, 0x4a, 0xf6, 0xb3, 0xf6, 0xc1, 0xeb, 0xe9,
-	0x64, 0xa0, 0x84, 0xc2, 0x73, 0x24, 0x03, 0x3a, 0x9e, 0x15, 0xec, 0xcf, 0x99, 0x03, 0x2e, 0x9b,
-	0xb5, 0x03, 0x2e, 0x9f, 0x95, 0x03, 0xf6, 0xba, 0x38, 0x60, 0xb2, 0x88, 0x5f, 0x7a, 0x07, 0x5c,
-	0x04, 0x79, 0xe2, 0x80, 0xa3, 0x48, 0x31, 0xba, 0xe2, 0x69, 0x45, 0x8a, 0x87, 0xb1, 0xdd, 0x99,
-	0x66, 0x08, 0x4e, 0x15, 0xee, 0xe5, 0x68, 0x24, 0x63, 0x18, 0xed, 0xe2, 0xcf, 0xc6, 0x3f, 0x79,
-	0x95, 0x19, 0x86, 0xee, 0x86, 0x86, 0x92, 0x0e, 0x98, 0x43, 0x81, 0xa2, 0x87, 0xb2, 0x90, 0x8c,
-	0x90, 0xc2, 0x75, 0x28, 0xab, 0x9b, 0x2d, 0xa0, 0xac, 0x9a, 0x49, 0x40, 0x69, 0x46, 0x24, 0x7e,
-	0x97, 0x88, 0x84, 0xc8, 0xc7, 0xec, 0x22, 0x92, 0xea, 0xdb, 0x1f, 0x91, 0x10, 0x35, 0x35, 0x22,
-	0x12, 0xf2, 0x93, 0x89, 0x48, 0xae, 0x2e, 0x06, 0x8b, 0x58, 0xb1, 0x9f, 0x59, 0x44, 0xc2, 0xf8,
-	0x8d, 0x92, 0xb9, 0xf0, 0x1b, 0xa5, 0x73, 0xe0, 0x37, 0xf6, 0x59, 0x4a, 0x59, 0x36, 0xbd, 0x9c,
-	0xb5, 0xaa, 0xc2, 0x03, 0x96, 0xd6, 0xac, 0x76, 0xd1, 0x9a, 0x57, 0x06, 0xb5, 0xb7, 0xce, 0x93,
-	0xb4, 0xf4, 0xe2, 0x47, 0x96, 0x76, 0xee, 0xb3, 0xb4, 0xb3, 0xa2, 0xe8, 0x71, 0x48, 0x78, 0xbc,
-	0xda, 0x25, 0x3c, 0x76, 0x8c, 0x43, 0xd4, 0x54, 0x02, 0x7e, 0x7d, 0xd9, 0x9e, 0xca, 0x48, 0x71,
-	0x25, 0xda, 0xaf, 0x9b, 0x02, 0x5d, 0x38, 0x1b, 0xd8, 0xa2, 0x35, 0xd5, 0x81, 0xf8, 0x23, 0x1a,
-	0x87, 0xaf, 0xc1, 0x23, 0x69, 0x47, 0x5f, 0x1e, 0xbf, 0x7e, 0x1c, 0xf9, 0x23, 0xba, 0x19, 0x8a,
-	0xc8, 0xd5, 0xa2, 0x60, 0x00, 0xf1, 0xe3, 0x33, 0x2e, 0x33, 0xdd, 0xcf, 0x31, 0x0d, 0x7c, 0x63,
-	0xf6, 0xad, 0xc1, 0xf1, 0xeb, 0x27, 0x70, 0x10, 0x83, 0x69, 0xe6, 0x5e, 0xff, 0x44, 0x3b, 0xfa,
-	0x72, 0xf6, 0xd2, 0x29, 0x91, 0xe9, 0xca, 0xc4, 0x99, 0x60, 0x56, 0x71, 0x66, 0xd5, 0x2d, 0xc6,
-	0x99, 0xfe, 0x39, 0x8a, 0x33, 0xab, 0xe7, 0x30, 0xce, 0x5c, 0x34, 0x17, 0x71, 0x66, 0xcd, 0x9c,
-	0xc4, 0x99, 0xb5, 0xb7, 0x10, 0x67, 0x2e, 0x9e, 0x55, 0x9c, 0xd9, 0x09, 0xaa, 0x8d, 0xea, 0x0a,
-	0x56, 0x77, 0x88, 0xc8, 0xa0, 0xba, 0x05, 0xdb, 0xc2, 0xfb, 0x70, 0x01, 0x02, 0xe5, 0x2f, 0x4c,
-	0x8b, 0xbe, 0x1a, 0x51, 0x62, 0xeb, 0x31, 0x91, 0x3a, 0x6a, 0x35, 0x98, 0x16, 0xbe, 0x46, 0xbb,
-	0xfe, 0x13, 0xed, 0x95, 0xe3, 0xa6, 0xc7, 0x10, 0xd9, 0x76, 0x98, 0x62, 0x1d, 0xe0, 0x92, 0xe9,
-	0xb5, 0xf8, 0x41, 0x55, 0x58, 0xc7, 0x3a, 0xc0, 0xd5, 0x2e, 0x0e, 0xd0, 0xa6, 0xc9, 0x8c, 0x27,
-	0x4c, 0x81, 0xaa, 0x6e, 0xca, 0x13, 0x2e, 0x2b, 0x76, 0x4c, 0x0a, 0xc9, 0xb0, 0x1e, 0x05, 0xc7,
-	0xa4, 0xfa, 0xc3, 0xc7, 0x00, 0xe8, 0x4c, 0x66, 0x48, 0x75, 0xac, 0xbe, 0xde, 0x5a, 0x74, 0x10,
-	0x36, 0xc1, 0x68, 0xe7, 0x71, 0xad, 0x6c, 0xf2, 0xc8, 0xb0, 0x48, 0x21, 0xe8, 0x2b, 0x8e, 0x77,
-	0xd1, 0xa0, 0xd0, 0x40, 0xad, 0x78, 0x8c, 0x6e, 0x31, 0xf6, 0xdf, 0xa2, 0xc3, 0x62, 0xc2, 0xf5,
-	0xa0, 0xa2, 0x53, 0xc0, 0xdb, 0x16, 0xb0, 0xfc, 0x85, 0x01, 0xe3, 0x17, 0x85, 0xa5, 0x89, 0x73,
-	0xef, 0x6b, 0xc3, 0x3f, 0xce, 0xfe, 0xbf, 0x11, 0x7d, 0xbf, 0x8c, 0x06, 0x24, 0xb7, 0xc9, 0xcc,
-	0x8e, 0x68, 0x2c, 0xaa, 0xd4, 0x37, 0x52, 0x72, 0x1b, 0x26, 0x40, 0x34, 0x7b, 0x7c, 0x67, 0x50,
-	0x1f, 0xd5, 0xec, 0x6c, 0xc9, 0x2d, 0xc6, 0x5d, 0x4e, 0xc9, 0x6d, 0xcc, 0x82, 0x1b, 0xf3, 0xb6,
-	0x28, 0xd0, 0x58, 0xf0, 0x39, 0x50, 0xde, 0x1d, 0x8d, 0xeb, 0xea, 0xbb, 0x62, 0xfa, 0xed, 0x42,
-	0xce, 0x8b, 0xf4, 0x47, 0xf6, 0x52, 0xbb, 0x3c, 0x1c, 0x4e, 0x66, 0x5c, 0xf6, 0x88, 0x74, 0x42,
-	0xe4, 0xa5, 0x03, 0x3a, 0xf9, 0x95, 0x45, 0x93, 0x47, 0xfd, 0x31, 0xf9, 0xb3, 0xe7, 0xf2, 0x91,
-	0x47, 0x9d, 0x60, 0x14, 0xf8, 0xba, 0xa3, 0x71, 0x62, 0x3a, 0x56, 0x4d, 0x3f, 0x02, 0x32, 0xfb,
-	0x16, 0x0a, 0xbf, 0x12, 0xf3, 0x80, 0x97, 0xcb, 0x65, 0x1c, 0xab, 0x2b, 0x1a, 0x4a, 0x3a, 0x40,
-	0x86, 0x0a, 0x16, 0x3d, 0x94, 0x81, 0x82, 0x87, 0x3a, 0x7b, 0xae, 0xc0, 0x50, 0x46, 0xd7, 0x90,
-	0x08, 0xfc, 0xb4, 0xd3, 0x83, 0x2b, 0x01, 0x48, 0xca, 0xa9, 0xb0, 0x1c, 0x57, 0x74, 0xfb, 0x84,
-	0x23, 0x25, 0x0a, 0x02, 0x43, 0xd8, 0x8b, 0x9a, 0xd6, 0x0f, 0xc7, 0x4d, 0x0c, 0x2c, 0xf4, 0x0f,
-	0x35, 0xc0, 0x67, 0x1e, 0xe2, 0xde, 0xf6, 0x6c, 0x6a, 0x33, 0x7d, 0x74, 0x52, 0x6a, 0x65, 0x96,
-	0x85, 0x8f, 0x4e, 0xe8, 0x23, 0x92, 0x76, 0xe7, 0x51, 0x68, 0xd1, 0xf9, 0x58, 0xaf, 0x95, 0x1d,
-	0x95, 0xa1, 0x00, 0x63, 0x85, 0xfb, 0xa9, 0xf8, 0x8c, 0xf2, 0xa3, 0x76, 0xfb, 0xa9, 0xc2, 0xec,
-	0x92, 0xbc, 0x8a, 0xe2, 0x93, 0xbc, 0xc7, 0x00, 0x48, 0x67, 0xfa, 0xfa, 0xe4, 0xb4, 0xa2, 0xeb,
-	0x53, 0x25, 0x65, 0xea, 0x2c, 0x30, 0xef, 0xd7, 0xae, 0x8d, 0x4e, 0x5c, 0xbc, 0xa8, 0xab, 0xcd,
-	0xe0, 0x98, 0x48, 0xb5, 0xe8, 0xa6, 0x8e, 0xfc, 0x22, 0x42, 0xec, 0xa3, 0x4c, 0x1d, 0xd3, 0xc2,
-	0xd7, 0x60, 0x22, 0xd8, 0x70, 0xe8, 0x74, 0xd8, 0x76, 0xb8, 0x17, 0x54, 0xa7, 0xe4, 0x74, 0x22,
-	0x93, 0x0a, 0xcb, 0xd8, 0xf6, 0xe0, 0xa4, 0x27, 0xc0, 0x2c, 0xab, 0x48, 0xf7, 0xc0, 0x61, 0x01,
-	0x8b, 0xc5, 0xfb, 0x71, 0xc4, 0x8e, 0x2d, 0x93, 0xc8, 0x36, 0xc2, 0x03, 0xa0, 0x06, 0x2b, 0x39,
-	0x92, 0x5f, 0xb4, 0x56, 0x55, 0xd3, 0x6b, 0x5d, 0x9b, 0x2a, 0xb4, 0x70, 0x76, 0x44, 0xda, 0x96,
-	0x1c, 0xfd, 0x05, 0xf6, 0xde, 0x56, 0x86, 0x65, 0xef, 0x8d, 0x46, 0x46, 0xd6, 0xcb, 0x1a, 0xd9,
-	0x5f, 0xf4, 0xc8, 0x2c, 0x22, 0x6d, 0x24, 0xdd, 0x46, 0x66, 0x7b, 0xc3, 0xc3, 0x1e, 0x00, 0x4d,
-	0x33, 0x60, 0x8d, 0x5e, 0x3d, 0xfd, 0xe8, 0x1b, 0x54, 0xe1, 0x21, 0xce, 0x05, 0xd7, 0x30, 0x3b,
-	0x64, 0x2b, 0x9d, 0x73, 0x70, 0xc1, 0xc1, 0xd3, 0x30, 0x0c, 0x9f, 0x35, 0x8d, 0x45, 0x45, 0x4f,
-	0xc3, 0x81, 0x6b, 0x18, 0xda, 0x02, 0xd3, 0x70, 0xe0, 0xc0, 0x0c, 0xda, 0x07, 0xc3, 0xd3, 0xa3,
-	0x29, 0xd4, 0x14, 0x69, 0x77, 0xed, 0x88, 0x05, 0x72, 0x79, 0x7b, 0x57, 0x34, 0xac, 0x15, 0x60,
-	0x98, 0x45, 0xac, 0xe2, 0x86, 0x65, 0x11, 0x0b, 0xe4, 0xf5, 0xf6, 0xae, 0xf0, 0x87, 0xa0, 0xb6,
-	0x3b, 0x1a, 0x37, 0xce, 0xad, 0xac, 0x50, 0x74, 0x9a, 0x71, 0x1f, 0x56, 0x05, 0x9e, 0x73, 0x60,
-	0xf2, 0x2b, 0xcd, 0x30, 0x15, 0x33, 0x4e, 0xce, 0xcc, 0xcc, 0xe1, 0x1d, 0x18, 0x68, 0x7c, 0xe9,
-	0x00, 0x3b, 0x3e, 0x2c, 0x7a, 0x7c, 0x1b, 0xa6, 0x6d, 0xfc, 0xb3, 0xe7, 0x9c, 0xe3, 0xdb, 0x30,
-	0x6c, 0xb5, 0x8d, 0xba, 0x99, 0xd4, 0x36, 0x9e, 0x34, 0x6a, 0x1b, 0x4b, 0x90, 0x75, 0x5f, 0xed,
-	0x6e, 0xdd, 0x67, 0x57, 0xdd, 0x58, 0x7a, 0xfb, 0xaa, 0x1b, 0xcd, 0xaa, 0xc0, 0x81, 0x26, 0xda,
-	0x27, 0x36, 0xe2, 0xfa, 0x86, 0x09, 0x60, 0x2a, 0x1c, 0xaf, 0x96, 0x80, 0x6a, 0xc6, 0xc6, 0xc2,
-	0x87, 0x50, 0x06, 0x84, 0x2d, 0x32, 0xe5, 0xd4, 0x4d, 0x20, 0xef, 0x33, 0x23, 0x49, 0xd1, 0x04,
-	0xc2, 0x76, 0x00, 0x3a, 0x7b, 0xf6, 0x18, 0xf1, 0x6f, 0x89, 0xe5, 0x8f, 0x28, 0x30, 0xc2, 0xc4,
-	0x91, 0xaf, 0x48, 0x81, 0xe1, 0x66, 0x36, 0x04, 0xc5, 0x9e, 0x7d, 0xa5, 0x2a, 0x34, 0x72, 0x34,
-	0x9c, 0xf7, 0xd3, 0x21, 0x28, 0x1b, 0x7f, 0x3e, 0x6e, 0x0f, 0xc0, 0xbd, 0x56, 0x16, 0xc9, 0xb6,
-	0x18, 0x54, 0xc8, 0x34, 0xd8, 0xc6, 0xd0, 0xbf, 0xfb, 0x41, 0xad, 0xdd, 0x91, 0xcf, 0xac, 0xe2,
-	0xf3, 0x98, 0xcb, 0x3a, 0x60, 0xf7, 0x4a, 0xad, 0x83, 0x3d, 0x93, 0xb0, 0x96, 0xc2, 0x91, 0x49,
-	0x94, 0xce, 0x3a, 0x93, 0xe8, 0x02, 0x8b, 0x0c, 0xb7, 0x20, 0xc4, 0x50, 0x42, 0xe1, 0xb5, 0x62,
-	0x7b, 0x5b, 0x13, 0x95, 0xd5, 0xea, 0x94, 0x6c, 0xad, 0x70, 0x0f, 0x58, 0x1c, 0xb3, 0xcc, 0x2a,
-	0xa1, 0x56, 0x66, 0xd5, 0xe4, 0x9d, 0xad, 0x6c, 0x9e, 0xab, 0xd3, 0x74, 0xf6, 0x61, 0x72, 0xed,
-	0xf2, 0x5b, 0xc8, 0xb5, 0x2b, 0x66, 0x95, 0x6b, 0x5b, 0x49, 0x45, 0x65, 0xb1, 0x49, 0x45, 0x8c,
-	0x24, 0x15, 0x9d, 0x3d, 0x7b, 0x68, 0xcb, 0x34, 0xf9, 0xab, 0xd7, 0x9c, 0x49, 0x85, 0x95, 0x12,
-	0xf9, 0x8a, 0x26, 0x4f, 0x52, 0x22, 0x1b, 0xf9, 0xf1, 0xeb, 0x27, 0x9c, 0x29, 0x11, 0x93, 0x48,
-	0x80, 0x62, 0x13, 0x89, 0x98, 0x95, 0x48, 0xd8, 0xd6, 0xc6, 0xce, 0x86, 0x45, 0x9d, 0x4d, 0x8f,
-	0xaa, 0x8a, 0x1e, 0xca, 0x4a, 0x8f, 0x9c, 0x43, 0x31, 0x2c, 0x51, 0xe9, 0xd1, 0x02, 0xaf, 0x7f,
-	0x2c, 0x5d, 0xc8, 0xf5, 0x8f, 0xd8, 0x2d, 0xd4, 0x3f, 0x32, 0xa0, 0x36, 0x66, 0x0f, 0x1b, 0xea,
-	0xa7, 0x1f, 0xf8, 0x01, 0x55, 0xb8, 0x8f, 0x73, 0x60, 0xf2, 0x4b, 0xed, 0x5b, 0x8d, 0x76, 0x4a,
-	0x74, 0x74, 0x44, 0xc3, 0xda, 0xa3, 0x85, 0x86, 0xa2, 0x87, 0xb5, 0x47, 0x0b, 0x4b, 0xed, 0xc2,
-	0x6c, 0x0c, 0x6b, 0x0f, 0x12, 0x36, 0x50, 0x1e, 0x2e, 0xc0, 0xda, 0x1d, 0xf7, 0x5a, 0x89, 0xe1,
-	0xe4, 0x6c, 0xb5, 0x92, 0xc6, 0xd9, 0xd4, 0x4a, 0x42, 0x7f, 0xf2, 0x80, 0x45, 0x2c, 0x43, 0xb0,
-	0x1d, 0x78, 0x75, 0xef, 0x4c, 0x3c, 0x0c, 0x3a, 0xa6, 0x42, 0x00, 0xbe, 0x51, 0xbb, 0x74, 0x75,
-	0xe2, 0x83, 0x11, 0x7c, 0x99, 0x6e, 0x6a, 0x6c, 0x48, 0x7b, 0xff, 0x90, 0x79, 0xbd, 0x4e, 0x44,
-	0x5d, 0x60, 0x17, 0x00, 0x38, 0x99, 0x44, 0xd7, 0xf2, 0xb0, 0xc3, 0xb9, 0x4f, 0x15, 0xee, 0xe1,
-	0x28, 0x30, 0x5f, 0x9f, 0x1d, 0x3a, 0x92, 0x7d, 0xf7, 0x08, 0xa6, 0x10, 0x4e, 0x66, 0xa6, 0xc6,
-	0x86, 0x88, 0x33, 0xa1, 0x7a, 0xc1, 0xb5, 0x46, 0x50, 0xa1, 0x3b, 0x1d, 0x4f, 0x47, 0x8d, 0x2a,
-	0xf8, 0x39, 0x0c, 0xe1, 0x4b, 0xf5, 0xfc, 0x0d, 0xff, 0x0d, 0x5b, 0x40, 0x79, 0x52, 0x4e, 0x45,
-	0x13, 0x11, 0xe2, 0x50, 0xee, 0x52, 0x85, 0x3a, 0x8e, 0x80, 0x78, 0x1f, 0x65, 0xaa, 0x30, 0x28,
-	0xf4, 0xa7, 0x6a, 0x50, 0x69, 0x6c, 0xc0, 0x97, 0xb0, 0x66, 0xd0, 0x69, 0xbb, 0x56, 0xe9, 0xa5,
-	0x8a, 0xe6, 0xf9, 0xaf, 0x55, 0xda, 0xae, 0x4f, 0x76, 0xda, 0xae, 0x4f, 0x56, 0xb9, 0x10, 0x71,
-	0x5e, 0x9f, 0xb4, 0x5d, 0x93, 0x64, 0xaa, 0x17, 0xe5, 0x33, 0xab, 0x5e, 0x88, 0xf6, 0xea, 0xc5,
-	0x72, 0xd7, 0x2b, 0x72, 0xb3, 0x2f, 0x5e, 0x54, 0xcc, 0xba, 0x78, 0x51, 0x39, 0xdb, 0xe2, 0x85,
-	0xef, 0x96, 0x8b, 0x17, 0x60, 0xd6, 0xc5, 0x0b, 0x97, 0xc2, 0xc2, 0xe2, 0x3b, 0x85, 0x85, 0x3b,
-	0x85, 0x85, 0xbf, 0xd6, 0xc2, 0x02, 0x9b, 0x58, 0xc3, 0x99, 0x24, 0xd6, 0x4f, 0x18, 0x89, 0x75,
-	0x1d, 0x32, 0x3c, 0x41, 0x57, 0xc3, 0x33, 0xbb, 0xbc, 0x7a, 0xc9, 0x6d, 0xbf, 0x35, 0x50, 0x69,
-	0x58, 0x64, 0xbe, 0x1e, 0xa7, 0xd5, 0xe4, 0x1e, 0x30, 0x9d, 0x53, 0xff, 0x6b, 0x15, 0xa8, 0xb1,
-	0x19, 0xd4, 0xdb, 0x9d, 0x44, 0xc6, 0x66, 0x9d, 0x44, 0xc6, 0xee, 0x24, 0x91, 0x33, 0x48, 0x22,
-	0x0f, 0x7b, 0x40, 0x4d, 0xcc, 0xe6, 0x07, 0x8a, 0x48, 0x27, 0x1f, 0x53, 0x85, 0x76, 0xce, 0x8e,
-	0xc8, 0xdf, 0xeb, 0xcc, 0x2b, 0xb5, 0xc1, 0x31, 0x6d, 0xf8, 0x98, 0x76, 0xe9, 0xaa, 0x36, 0x7a,
-	0xca, 0x8a, 0xac, 0xec, 0x98, 0x78, 0x1a, 0x36, 0xa7, 0xe0, 0x2b, 0x7a, 0x1a, 0x36, 0xa7, 0x70,
-	0xaf, 0x33, 0xff, 0xcc, 0x37, 0x0d, 0x9b, 0x83, 0x38, 0xe6, 0x01, 0x30, 0xe6, 0x74, 0x10, 0xa0,
-	0xc8, 0x99, 0xb8, 0xe0, 0xf2, 0x6b, 0x5c, 0xf3, 0x54, 0x3c, 0x1f, 0x6b, 0x26, 0x2e, 0x98, 0x78,
-	0x32, 0x4e, 0x37, 0x51, 0x55, 0xf4, 0x64, 0x9c, 0x6e, 0x62, 0x8d, 0x6b, 0x26, 0xeb, 0x9c, 0x8c,
-	0xd3, 0x59, 0xcc, 0x61, 0x4e, 0xfa, 0x43, 0xb4, 0xd5, 0x8c, 0x03, 0x58, 0x5a, 0xa4, 0xeb, 0xb3,
-	0x23, 0x16, 0x93, 0x9b, 0xda, 0x71, 0xd0, 0xf8, 0x36, 0x07, 0xb4, 0xac, 0xe8, 0xf1, 0x6d, 0x0e,
-	0xa8, 0x88, 0x3c, 0xd5, 0x8e, 0xc3, 0x64, 0x6f, 0xf5, 0x33, 0xcc, 0xde, 0xa8, 0x2c, 0x8d, 0x9c,
-	0xd1, 0x17, 0x93, 0xbd, 0x51, 0xad, 0xa1, 0xbf, 0x54, 0xa2, 0xe7, 0xf0, 0x7a, 0x12, 0x11, 0x21,
-	0xa3, 0x24, 0xd2, 0x61, 0xa9, 0x9f, 0x7d, 0xe1, 0xd1, 0x9d, 0xc7, 0xc6, 0xee, 0x3c, 0x36, 0xe6,
-	0xf6, 0x40, 0x6c, 0xf9, 0x8c, 0x1e, 0x88, 0xad, 0x98, 0xc1, 0x03, 0xb1, 0xf4, 0x8b, 0xb1, 0x2a,
-	0x6f, 0xf5, 0xc5, 0x58, 0x4f, 0x58, 0xef, 0xeb, 0xc2, 0xd9, 0xd5, 0x3a, 0x5d, 0x78, 0xcd, 0xf7,
-	0x75, 0x01, 0xf2, 0x47, 0xb0, 0x98, 0x37, 0x76, 0x7d, 0x03, 0x2c, 0x4e, 0xd2, 0x6a, 0x82, 0xa4,
-	0x0b, 0x67, 0x5c, 0xf7, 0xab, 0x42, 0x13, 0xe7, 0x6c, 0xe5, 0xeb, 0x18, 0x10, 0xc9, 0xa4, 0x9d,
-	0xfd, 0xd8, 0x87, 0xf1, 0xaa, 0xe6, 0xe9, 0x61, 0x3c, 0xff, 0x5c, 0x3d, 0x8c, 0xd7, 0xa9, 0x0a,
-	0x9b, 0xc1, 0x57, 0xb9, 0x42, 0x76, 0x83, 0x5f, 0x85, 0x9f, 0x2a, 0x63, 0xda, 0x99, 0xf7, 0x52,
-	0x4d, 0xe0, 0x37, 0x7e, 0xb8, 0x10, 0x58, 0xd0, 0x4f, 0x00, 0xef, 0x66, 0x9e, 0x00, 0x66, 0xcf,
-	0xf6, 0x19, 0x5e, 0x3a, 0xd6, 0xa8, 0xc2, 0x6a, 0xf2, 0x70, 0x5e, 0x43, 0xd2, 0xb9, 0x0c, 0xcc,
-	0x33, 0x7a, 0xff, 0x47, 0x15, 0xbe, 0x01, 0xbe, 0xce, 0x15, 0x5c, 0x10, 0x63, 0x49, 0x5d, 0x68,
-	0x4d, 0xf3, 0xd4, 0xde, 0xa9, 0x72, 0xf4, 0x4c, 0x23, 0x43, 0xf9, 0xce, 0x7b, 0x3f, 0xe6, 0xd3,
-	0x4a, 0xba, 0x9a, 0x0a, 0xef, 0x9c, 0x98, 0x0a, 0x3b, 0x69, 0xe4, 0x86, 0xca, 0xf2, 0x91, 0x46,
-	0xbe, 0x88, 0x25, 0x4d, 0x4a, 0x71, 0xce, 0x7e, 0x5f, 0xb2, 0x47, 0x82, 0xbf, 0xa6, 0x0a, 0x8f,
-	0x82, 0x76, 0x2e, 0x9f, 0x64, 0x1b, 0xea, 0x92, 0x7d, 0xe7, 0xe4, 0xf8, 0x95, 0x5f, 0x32, 0x3d,
-	0x88, 0x05, 0xfa, 0x67, 0xfc, 0x72, 0x12, 0x1b, 0xf2, 0x82, 0xb6, 0x3e, 0x3b, 0x99, 0xa7, 0x82,
-	0x0b, 0x59, 0x1f, 0x74, 0xdc, 0x8c, 0xad, 0x0f, 0xbb, 0xfd, 0x8c, 0xdd, 0x11, 0x55, 0x61, 0x17,
-	0xe8, 0xe6, 0xf2, 0x2e, 0x03, 0xdf, 0xe0, 0x62, 0x73, 0xa6, 0xb1, 0x36, 0x7f, 0xa8, 0x04, 0xd5,
-	0x0c, 0xb1, 0x3b, 0x35, 0xf2, 0x08, 0xa5, 0xa4, 0xb7, 0xb1, 0x46, 0x7e, 0xfb, 0xeb, 0xd9, 0x6c,
-	0x55, 0xcd, 0x37, 0x93, 0xaa, 0xda, 0x3c, 0x46, 0x57, 0xae, 0x26, 0xb3, 0x6a, 0x4e, 0x4c, 0xe6,
-	0xf3, 0xd6, 0x31, 0x84, 0xdf, 0xe5, 0x9a, 0x0d, 0xa3, 0x07, 0xc6, 0x59, 0x04, 0x4a, 0xd1, 0xcc,
-	0xb3, 0x08, 0x76, 0x24, 0x7c, 0xae, 0x65, 0x1d, 0x49, 0xec, 0x32, 0x6a, 0x8d, 0xd5, 0x88, 0xfa,
-	0xda, 0xfc, 0xd4, 0x67, 0x57, 0x70, 0x5c, 0x74, 0xfb, 0x0a, 0x8e, 0x0f, 0xab, 0x02, 0x0f, 0xd6,
-	0x71, 0xac, 0x6d, 0xe0, 0x57, 0x91, 0xc7, 0xa7, 0x19, 0x03, 0x43, 0x17, 0x1f, 0xff, 0xde, 0x03,
-	0x96, 0xb8, 0x2d, 0xe3, 0xcc, 0x2a, 0x90, 0x47, 0x3c, 0xe0, 0xae, 0xdd, 0xba, 0x09, 0xed, 0xcd,
-	0x84, 0xc3, 0x72, 0x3a, 0xbd, 0x2f, 0xd3, 0x2f, 0xca, 0x88, 0x1e, 0x31, 0x2c, 0xbb, 0x54, 0x61,
-	0x07, 0x97, 0xa7, 0x0b, 0xcf, 0x4f, 0xdc, 0x38, 0xa2, 0x9d, 0xb8, 0xf6, 0xe0, 0xba, 0x58, 0x34,
-	0xde, 0xda, 0xa6, 0xfb, 0xfc, 0xd6, 0xb6, 0x88, 0x34, 0xa0, 0xbd, 0xfc, 0x12, 0xeb, 0x57, 0xe8,
-	0x9b, 0xec, 0x79, 0x68, 0x85, 0x6e, 0x96, 0xa3, 0x77, 0x3d, 0xf5, 0x24, 0xbe, 0x2b, 0xa7, 0x76,
-	0xa7, 0xa4, 0x48, 0x34, 0xde, 0xb7, 0x45, 0x52, 0x24, 0x23, 0x1e, 0x6b, 0x03, 0x65, 0x8a, 0xb4,
-	0xb7, 0xdf, 0x60, 0x0b, 0x95, 0x02, 0x30, 0xc4, 0x78, 0xbf, 0x6e, 0xee, 0x8d, 0xc3, 0x13, 0x23,
-	0xe7, 0xb5, 0x93, 0xc7, 0x45, 0x0c, 0xd7, 0x43, 0x38, 0x4b, 0xcd, 0x4b, 0x8c, 0x10, 0xee, 0x61,
-	0x5a, 0xcd, 0x9b, 0x6c, 0x6e, 0x7b, 0x60, 0x60, 0x60, 0xa0, 0xb9, 0xbb, 0xbb, 0x39, 0x12, 0x09,
-	0x6e, 0xdf, 0xde, 0x1e, 0x8b, 0xb5, 0xa7, 0xd3, 0xd9, 0x77, 0xc7, 0xb4, 0xb1, 0x61, 0xda, 0x04,
-	0xf4, 0x58, 0x26, 0x00, 0x1b, 0xd1, 0xf5, 0xaa, 0xf0, 0xa0, 0x65, 0x02, 0x9a, 0x6c, 0x4e, 0x3b,
-	0x3f, 0x4d, 0xd3, 0x3c, 0xf4, 0x83, 0xf2, 0xa4, 0x94, 0x92, 0x62, 0xc6, 0xf3, 0x98, 0x0f, 0x32,
-	0xe2, 0x9c, 0x7f, 0x55, 0x5a, 0x7a, 0x10, 0x16, 0x16, 0x6e, 0x64, 0x40, 0x09, 0x1d, 0xe3, 0xe5,
-	0x15, 0xb9, 0x0b, 0x6f, 0x4e, 0x0e, 0xbe, 0x92, 0x7d, 0x7b, 0x64, 0xfc, 0xda, 0xc7, 0x22, 0x69,
-	0x83, 0xff, 0x17, 0x78, 0xd3, 0x89, 0x94, 0x42, 0xce, 0x07, 0xdb, 0x8a, 0x1d, 0xab, 0x37, 0x91,
-	0x52, 0xf0, 0x48, 0x68, 0x15, 0x11, 0x0d, 0xbe, 0x39, 0x7b, 0x42, 0xd5, 0x46, 0x87, 0x73, 0x17,
-	0x7e, 0x9a, 0x3b, 0xfd, 0xde, 0xd4, 0xd8, 0x50, 0xdb, 0xc4, 0xc8, 0xf9, 0xdc, 0xd9, 0x51, 0xed,
-	0xf8, 0x11, 0x6d, 0x74, 0x78, 0x6a, 0x6c, 0xa8, 0x99, 0x00, 0x26, 0x5f, 0x3f, 0xae, 0x8d, 0x0e,
-	0x8b, 0x08, 0x09, 0x3e, 0x07, 0xaa, 0x93, 0x29, 0x79, 0x9f, 0x9c, 0xea, 0x55, 0x12, 0x29, 0xab,
-	0x9e, 0x8b, 0x4a, 0x47, 0x6c, 0x0b, 0x7f, 0xb7, 0x76, 0xe1, 0x35, 0xed, 0xd0, 0x79, 0xed, 0xe0,
-	0xb0, 0xf6, 0xde, 0xf5, 0xa9, 0xb1, 0x21, 0x25, 0x9d, 0x8c, 0x46, 0xe4, 0x54, 0xf6, 0xe8, 0x4f,
-	0x27, 0x06, 0x5f, 0x8a, 0x25, 0xe2, 0x7d, 0x89, 0xc8, 0x5e, 0x91, 0xc5, 0x81, 0x6b, 0x49, 0x2a,
-	0x8e, 0x93, 0xeb, 0xc5, 0x6c, 0x2a, 0x8e, 0x22, 0x13, 0x94, 0x83, 0xb7, 0x91, 0x1c, 0xbc, 0x12,
-	0x75, 0x5b, 0x51, 0xf0, 0xc5, 0xcb, 0x38, 0x05, 0x0f, 0x6c, 0x02, 0x55, 0xd4, 0x8a, 0xcf, 0x44,
-	0xcb, 0x03, 0x1b, 0x80, 0xcf, 0x5c, 0xc0, 0xe9, 0x10, 0x4b, 0x69, 0xf3, 0xf0, 0x88, 0x2a, 0x6c,
-	0x04, 0xeb, 0xb9, 0x02, 0xba, 0x51, 0xe0, 0x25, 0xc7, 0x17, 0x4a, 0x49, 0x15, 0xcb, 0x8e, 0xb8,
-	0xa0, 0xa3, 0xb9, 0x6e, 0xdb, 0x9b, 0x8e, 0xf1, 0xe7, 0x39, 0x5a, 0x8c, 0xcf, 0x73, 0xb4, 0x08,
-	0xf1, 0x01, 0xe6, 0xdd, 0x91, 0x13, 0x37, 0x4e, 0x4e, 0x9c, 0x7c, 0x8f, 0x3e, 0xbb, 0x66, 0x3e,
-	0x6c, 0xb0, 0x96, 0x79, 0x57, 0xd9, 0xb4, 0xf2, 0x50, 0x5e, 0xb4, 0x3c, 0xcc, 0xfd, 0x9b, 0x94,
-	0xff, 0x5c, 0x0e, 0xaa, 0x76, 0x6f, 0xdd, 0x66, 0xde, 0xa2, 0xd8, 0x6c, 0x45, 0x7a, 0x11, 0x62,
-	0xf9, 0x70, 0xc4, 0x66, 0x42, 0x79, 0x48, 0x46, 0x0e, 0x46, 0x23, 0x72, 0x5c, 0x89, 0xee, 0x8b,
-	0xca, 0x29, 0x2b, 0xe6, 0x8b, 0xc0, 0x0d, 0xce, 0x7c, 0x13, 0x05, 0x1e, 0x54, 0xcc, 0x67, 0x3d,
-	0xe9, 0x41, 0x87, 0x7a, 0x8f, 0x5b, 0x57, 0xb4, 0xbe, 0xf5, 0x62, 0x34, 0x1e, 0x21, 0xb6, 0x0e,
-	0x39, 0x69, 0xb6, 0x85, 0xaf, 0x36, 0xe6, 0x1b, 0xd4, 0x7f, 0x5a, 0x81, 0xda, 0x93, 0xd1, 0x78,
-	0x84, 0xa1, 0x13, 0xb7, 0x4a, 0x83, 0x36, 0x3a, 0xe8, 0x16, 0x8c, 0x45, 0x47, 0xff, 0x69, 0x0b,
-	0xf8, 0x1e, 0x01, 0x20, 0x26, 0x45, 0xe3, 0x8a, 0x14, 0x8d, 0xcb, 0x29, 0x12, 0x33, 0x22, 0x2f,
-	0x40, 0x81, 0x79, 0xd0, 0x6d, 0xfe, 0x2d, 0x52, 0x70, 0xf8, 0x24, 0xa8, 0xde, 0x2b, 0xbd, 0x68,
-	0x35, 0xd2, 0x6f, 0xcc, 0x60, 0x5b, 0xf8, 0xc5, 0x1d, 0x52, 0xf8, 0xc5, 0x4c, 0x32, 0x68, 0x61,
-	0x8b, 0x6c, 0x0f, 0xf8, 0x14, 0xa8, 0x36, 0xe2, 0xb3, 0x5e, 0x59, 0xe9, 0x8a, 0x20, 0x43, 0x53,
-	0x86, 0x43, 0x26, 0xb6, 0x85, 0x5f, 0xd6, 0x41, 0x7e, 0x06, 0xd3, 0xb2, 0x42, 0xef, 0x10, 0xdb,
-	0x0f, 0x6e, 0xa1, 0xe2, 0xc3, 0x08, 0xb2, 0x48, 0x65, 0xb8, 0x4e, 0x42, 0x81, 0xf9, 0x3a, 0x93,
-	0x18, 0x45, 0x88, 0xea, 0x00, 0x9f, 0x03, 0xb5, 0xc6, 0xaf, 0xee, 0x44, 0x24, 0xd3, 0x2f, 0x77,
-	0x45, 0x50, 0xac, 0x59, 0x86, 0x6f, 0x05, 0x38, 0x1a, 0xf9, 0x80, 0x49, 0x31, 0x86, 0x40, 0x34,
-	0x61, 0x47, 0x6f, 0xd8, 0x03, 0x6a, 0xd2, 0xe1, 0x6f, 0xcb, 0xfa, 0xaf, 0x54, 0xaf, 0x22, 0x29,
-	0x99, 0x34, 0x8a, 0x43, 0xcb, 0xf0, 0x45, 0x26, 0x7b, 0x1b, 0x5f, 0xdb, 0x6b, 0x00, 0x82, 0x69,
-	0x04, 0x11, 0xed, 0x5d, 0xe0, 0x76, 0x50, 0x9d, 0x96, 0x53, 0xfb, 0xa3, 0x61, 0x99, 0xd0, 0xab,
-	0x42, 0xf4, 0x90, 0x88, 0xb3, 0x2d, 0xfc, 0xa2, 0x5e, 0xfc, 0xd3, 0xa0, 0xc5, 0x36, 0xc3, 0x8d,
-	0xc0, 0xf7, 0xed, 0xa4, 0x44, 0xa8, 0xf8, 0x11, 0x15, 0x64, 0xcd, 0x2c, 0x28, 0x0f, 0xb6, 0xf7,
-	0x08, 0x06, 0xb6, 0x05, 0x0e, 0xfd, 0x8d, 0x17, 0x80, 0xdd, 0x5b, 0xb7, 0x75, 0x13, 0x2b, 0xb5,
-	0x0e, 0x94, 0xe3, 0x3e, 0xc4, 0x26, 0xa2, 0xc4, 0x8a, 0x80, 0xf8, 0x2a, 0x8c, 0x13, 0x44, 0xe1,
-	0x1f, 0x01, 0xea, 0x19, 0x01, 0x6b, 0x13, 0x8d, 0x3b, 0x35, 0xd8, 0x26, 0xfa, 0x09, 0xe5, 0xa0,
-	0x22, 0x1f, 0x50, 0x2c, 0x6b, 0xb8, 0xc1, 0xb0, 0x86, 0xa5, 0xc6, 0xe7, 0x02, 0x56, 0x1a, 0xd6,
-	0x70, 0x29, 0x8a, 0x90, 0x82, 0xf1, 0x4c, 0x6c, 0xaf, 0x9c, 0x0a, 0x26, 0xf6, 0x05, 0xa3, 0x8a,
-	0x1c, 0x4b, 0x1b, 0xc6, 0xb1, 0x13, 0x54, 0xea, 0xe6, 0xaa, 0xd7, 0x7a, 0x8f, 0x32, 0x3a, 0x55,
-	0x35, 0x81, 0x7c, 0xfd, 0x4e, 0x16, 0x31, 0x98, 0x94, 0x53, 0x41, 0xbd, 0x55, 0x34, 0xfb, 0xc0,
-	0x6d, 0xa0, 0x2a, 0x9c, 0x49, 0xa5, 0xe4, 0xb8, 0xd2, 0x63, 0x59, 0x46, 0xa4, 0x0d, 0x34, 0x9c,
-	0xaf, 0xeb, 0xc4, 0x3f, 0x10, 0x3a, 0x99, 0x90, 0x48, 0xf7, 0x80, 0x5b, 0x80, 0x4f, 0x8f, 0x23,
-	0xd3, 0x8a, 0x14, 0x4b, 0x12, 0xa5, 0x42, 0xd2, 0x60, 0x41, 0xf9, 0xbb, 0xf4, 0x10, 0x47, 0x9f,
-	0x8d, 0x6e, 0xe1, 0x82, 0x29, 0x3d, 0x4a, 0x95, 0xf7, 0x4b, 0xfd, 0xa2, 0xd5, 0x05, 0x7e, 0x15,
-	0x54, 0x26, 0xfb, 0x25, 0x65, 0x5f, 0x22, 0x15, 0x23, 0x5e, 0x1b, 0x89, 0x80, 0x09, 0xe4, 0xeb,
-	0x7a, 0xc8, 0x5f, 0xb4, 0x84, 0x9a, 0xcd, 0x70, 0x23, 0x28, 0x93, 0x92, 0xc9, 0x68, 0x84, 0xa4,
-	0x64, 0x08, 0x19, 0x43, 0xf8, 0xbb, 0x84, 0x64, 0xb2, 0x3f, 0x1a, 0x46, 0xdf, 0x88, 0xa2, 0x91,
-	0x71, 0x33, 0x7c, 0x9c, 0xb8, 0x1a, 0xfc, 0xe6, 0xa5, 0x7a, 0x26, 0x16, 0xa2, 0x0c, 0x31, 0x16,
-	0x03, 0xec, 0x6e, 0x2c, 0x2b, 0xa5, 0xff, 0xc4, 0x3e, 0x26, 0xf4, 0x9f, 0x1e, 0xb0, 0x1a, 0x79,
-	0x81, 0x44, 0x26, 0xb2, 0x53, 0x52, 0xa2, 0xfb, 0x65, 0xa3, 0x17, 0x7d, 0x68, 0xb4, 0x81, 0xda,
-	0x3b, 0x2c, 0x5e, 0xf8, 0x48, 0xcb, 0xdc, 0x3b, 0x7f, 0xf6, 0xd2, 0xf0, 0xe4, 0xc8, 0x47, 0xb8,
-	0xe2, 0x46, 0xed, 0xd7, 0x66, 0x76, 0xbf, 0x4a, 0x8c, 0x63, 0x83, 0x46, 0x76, 0xbf, 0xfc, 0xf8,
-	0x48, 0x91, 0xb8, 0x35, 0xba, 0xa9, 0x7d, 0x87, 0x2a, 0x74, 0x81, 0x6d, 0xdc, 0x0a, 0xf7, 0x49,
-	0x1a, 0x91, 0xc4, 0x3d, 0xd8, 0x63, 0x8d, 0x8f, 0xfe, 0x58, 0x3b, 0xf1, 0x4e, 0xee, 0xd4, 0x3b,
-	0x46, 0xbb, 0x36, 0x7c, 0x69, 0xe2, 0xf2, 0x8f, 0xb4, 0xd1, 0xf7, 0x49, 0x5c, 0xf1, 0x8f, 0x1e,
-	0x10, 0x2a, 0xc4, 0xee, 0x2c, 0xc3, 0x8b, 0x27, 0xc8, 0x6e, 0x94, 0xa0, 0x32, 0xce, 0x32, 0xfb,
-	0x6e, 0x10, 0x4d, 0x62, 0x5e, 0xef, 0xe6, 0x12, 0x71, 0x60, 0xaf, 0xdf, 0x02, 0x4a, 0x63, 0xe9,
-	0x3e, 0xfa, 0x65, 0x2c, 0xfa, 0x6f, 0x57, 0x04, 0xbd, 0x21, 0xf4, 0xeb, 0x32, 0xb0, 0x6a, 0x9b,
-	0xac, 0xec, 0x49, 0xcb, 0xa9, 0x5d, 0x49, 0x39, 0x85, 0x04, 0x46, 0x8f, 0x95, 0xe8, 0xfd, 0x7b,
-	0x0a, 0x78, 0x15, 0x3d, 0xfd, 0xc6, 0x9e, 0x18, 0x1d, 0x1f, 0x23, 0x00, 0xcf, 0x93, 0xe0, 0xe3,
-	0x83, 0x43, 0xb9, 0xd1, 0x73, 0x53, 0x63, 0x43, 0xb8, 0x2c, 0x4a, 0xf2, 0xc3, 0xcf, 0x06, 0x0f,
-	0x12, 0x7f, 0xfc, 0xd9, 0xe0, 0x41, 0xd3, 0xc1, 0x8a, 0x08, 0x53, 0xd7, 0x44, 0x3a, 0xef, 0x2c,
-	0xb1, 0xfc, 0x12, 0x93, 0x77, 0xd6, 0x59, 0x79, 0xe7, 0xd4, 0xd8, 0x90, 0x76, 0xe3, 0xa5, 0xc9,
-	0x91, 0x6b, 0xec, 0x57, 0x3b, 0xbe, 0x49, 0x87, 0x0a, 0x98, 0xeb, 0x47, 0x55, 0x61, 0x13, 0x1d,
-	0x2a, 0x3c, 0x40, 0x0a, 0xd6, 0x91, 0xa9, 0xb1, 0x21, 0xfc, 0x67, 0x6e, 0xf4, 0xdc, 0x67, 0x83,
-	0x07, 0xe9, 0x9a, 0x4f, 0x6e, 0xf4, 0x1c, 0xa1, 0x4e, 0x05, 0x11, 0x22, 0x1d, 0x44, 0x98, 0x1f,
-	0x12, 0x6a, 0xa3, 0x83, 0x88, 0x35, 0x34, 0x11, 0x7d, 0x8a, 0xee, 0x34, 0xad, 0xf8, 0xa2, 0xd7,
-	0xf9, 0x5e, 0x77, 0xf4, 0x74, 0x10, 0x95, 0x9d, 0xad, 0xb5, 0x65, 0x67, 0x99, 0x78, 0xf4, 0x00,
-	0x5d, 0x02, 0x35, 0x88, 0x5a, 0xa9, 0x59, 0xb7, 0xfd, 0x95, 0xee, 0xe8, 0xca, 0xb4, 0x99, 0x9a,
-	0xad, 0xb5, 0xa5, 0x66, 0x79, 0x08, 0x9a, 0x79, 0xd9, 0xbc, 0x65, 0x12, 0xed, 0x5b, 0x55, 0xa1,
-	0x03, 0x6c, 0x46, 0x47, 0x45, 0x0e, 0x89, 0x33, 0x94, 0x71, 0x35, 0xc6, 0xee, 0xe8, 0xec, 0xcd,
-	0x9d, 0x3a, 0x9f, 0x3d, 0xfa, 0x5b, 0x3a, 0xcc, 0x25, 0x7a, 0xf8, 0x1f, 0x25, 0x20, 0x98, 0x5f,
-	0x68, 0xbf, 0xc8, 0x20, 0xbf, 0x74, 0xba, 0x20, 0xbf, 0x87, 0xe8, 0x3a, 0xce, 0x78, 0x97, 0x39,
-	0x82, 0xfc, 0x5e, 0xf4, 0x0d, 0xbe, 0x19, 0xc4, 0xf9, 0xed, 0xcf, 0xab, 0xc2, 0xb3, 0xe0, 0x1b,
-	0xe8, 0xb0, 0xc8, 0x65, 0x4d, 0x49, 0x4c, 0x5e, 0x70, 0x51, 0x0b, 0x07, 0xe7, 0xbf, 0xf3, 0x82,
-	0x35, 0xd4, 0xcb, 0x22, 0xc9, 0x36, 0x89, 0x72, 0x38, 0x11, 0x8b, 0xc9, 0xf1, 0x88, 0x28, 0xa7,
-	0x33, 0xfd, 0xba, 0xa9, 0xb8, 0x73, 0x37, 0xe0, 0x36, 0xde, 0x0d, 0x98, 0xf7, 0x17, 0x51, 0x1b,
-	0x49, 0x5e, 0x51, 0x5b, 0xcf, 0x87, 0xb0, 0x78, 0x19, 0x53, 0x0c, 0xa6, 0x70, 0xcf, 0xec, 0x89,
-	0xf3, 0x13, 0xc7, 0x4f, 0x6a, 0x83, 0x63, 0x29, 0xf9, 0x3b, 0xa1, 0x9b, 0x25, 0xc5, 0xc8, 0x51,
-	0x3a, 0x79, 0x5b, 0x15, 0xf7, 0x79, 0x26, 0xe7, 0xbe, 0xdf, 0xf5, 0xee, 0xa6, 0xfb, 0x4c, 0x8b,
-	0x7b, 0xdb, 0x2a, 0x51, 0xce, 0xa2, 0x18, 0x37, 0x4e, 0x57, 0x30, 0x31, 0xb2, 0x84, 0xd3, 0x28,
-	0xe7, 0xa7, 0x5e, 0xb0, 0xb2, 0x30, 0xe1, 0x79, 0x53, 0xcb, 0xcd, 0x4e, 0xb5, 0xbc, 0xc5, 0x63,
-	0x95, 0xd2, 0xb9, 0x38, 0x56, 0xf1, 0xce, 0xe6, 0x58, 0x65, 0x0f, 0x28, 0x0d, 0x27, 0x33, 0xa4,
-	0x34, 0xd8, 0x52, 0x94, 0x14, 0x74, 0x26, 0x48, 0x62, 0xdc, 0x51, 0xa7, 0x0a, 0xb5, 0x9c, 0x8e,
-	0x8f, 0x1e, 0x00, 0xc5, 0x36, 0x55, 0xd4, 0x7f, 0x43, 0x09, 0x94, 0xc7, 0x8c, 0x57, 0x55, 0xce,
-	0x86, 0x32, 0x2a, 0x59, 0x10, 0x12, 0xbc, 0x1f, 0xff, 0x4f, 0xe8, 0x13, 0x28, 0xec, 0x05, 0xb5,
-	0x11, 0x65, 0xeb, 0x7e, 0x39, 0x8e, 0x22, 0x81, 0x5e, 0x94, 0x90, 0xe0, 0x33, 0x55, 0x94, 0x1f,
-	0x39, 0x1a, 0x79, 0x07, 0x44, 0x74, 0x40, 0x42, 0xff, 0xe4, 0x01, 0xab, 0xa7, 0x9d, 0x1d, 0x6c,
-	0x07, 0xbe, 0xb0, 0xf1, 0x83, 0x08, 0x16, 0x3e, 0x4f, 0x32, 0xa1, 0xbc, 0x5f, 0xbb, 0x78, 0x55,
-	0x7b, 0xfd, 0xbc, 0xb1, 0xf5, 0x66, 0x03, 0x6c, 0x01, 0xe5, 0x31, 0xe9, 0xc0, 0xd3, 0x52, 0x3f,
-	0x92, 0x1c, 0x0f, 0x79, 0xc8, 0x06, 0x83, 0x78, 0x1f, 0xb9, 0x36, 0x37, 0x38, 0x26, 0x12, 0x10,
-	0x5c, 0x05, 0x4a, 0x93, 0x9b, 0xd6, 0x91, 0x27, 0x77, 0xaa, 0x55, 0x01, 0x70, 0xfa, 0x6f, 0x5e,
-	0xff, 0x47, 0xd4, 0xff, 0xc1, 0x1d, 0x36, 0xa1, 0xdd, 0xb7, 0x3a, 0x6c, 0xd2, 0x3b, 0x6c, 0xd2,
-	0x3b, 0x6c, 0x0a, 0xfd, 0xbe, 0x04, 0xa5, 0x2a, 0x06, 0x5b, 0xbb, 0x52, 0xd1, 0xbe, 0x68, 0xdc,
-	0x64, 0xee, 0x8b, 0xb0, 0x3d, 0xcf, 0x32, 0xb6, 0xa7, 0xc9, 0x55, 0x36, 0x5c, 0xa6, 0x59, 0x9c,
-	0xe1, 0x21, 0x5f, 0x8e, 0x99, 0x9e, 0xe5, 0xd9, 0x58, 0x9d, 0x8f, 0x3d, 0x96, 0xd5, 0x61, 0xa8,
-	0xce, 0x8d, 0x70, 0xb4, 0x82, 0x8a, 0x14, 0x73, 0x39, 0x1c, 0xbd, 0x38, 0xcc, 0x80, 0xf1, 0xc6,
-	0x1f, 0xa2, 0xf1, 0x07, 0xbc, 0x17, 0x94, 0xf5, 0x53, 0xcf, 0x56, 0xa3, 0x88, 0x15, 0x43, 0x78,
-	0xfc, 0x9f, 0x88, 0xff, 0x0b, 0x5d, 0xf0, 0x82, 0xc6, 0x02, 0xcb, 0x71, 0xc7, 0x56, 0x16, 0x61,
-	0x2b, 0x45, 0xda, 0x56, 0xde, 0x3f, 0xbd, 0xd4, 0x16, 0x63, 0x28, 0x9f, 0xb7, 0x19, 0xca, 0x19,
-	0x91, 0x2d, 0xc2, 0x4a, 0x32, 0x57, 0x0e, 0x2a, 0x8a, 0xbe, 0x72, 0x10, 0xfa, 0xdc, 0x3b, 0xad,
-	0xd1, 0xb8, 0x13, 0xf8, 0xfe, 0x75, 0x05, 0xbe, 0xb3, 0x95, 0x95, 0x39, 0x0f, 0x98, 0xf9, 0x8f,
-	0x1a, 0x41, 0x95, 0x9e, 0xc4, 0x75, 0x63, 0x35, 0x80, 0x37, 0x3c, 0x60, 0xb1, 0xe3, 0xb3, 0xdb,
-	0x70, 0xad, 0xfd, 0x20, 0xd3, 0xf5, 0x6b, 0xde, 0x81, 0x7b, 0xa6, 0xeb, 0x86, 0x13, 0xc5, 0xd0,
-	0xb3, 0xaa, 0xb0, 0x11, 0xd6, 0x91, 0x6b, 0x3e, 0xb8, 0x1d, 0x7b, 0x97, 0x00, 0x49, 0x21, 0xb3,
-	0xaf, 0x0c, 0x66, 0xdf, 0x7a, 0x25, 0xf7, 0xc6, 0x61, 0xf2, 0xf5, 0x60, 0xea, 0x70, 0xfe, 0xd0,
-	0xa7, 0xe3, 0x3f, 0x29, 0x09, 0xc0, 0xfa, 0x56, 0x6a, 0xa8, 0xd6, 0xfd, 0x6d, 0xad, 0x84, 0x4e,
-	0x1a, 0x5e, 0xf1, 0x80, 0x45, 0xec, 0x57, 0x79, 0x61, 0xc8, 0x71, 0x0e, 0xeb, 0xf8, 0x5c, 0x71,
-	0xe0, 0xee, 0x82, 0x7d, 0xc8, 0xc4, 0x9f, 0x53, 0x85, 0x47, 0x60, 0x35, 0x33, 0xf1, 0x00, 0x47,
-	0xa6, 0x3c, 0x74, 0x44, 0xbb, 0xf8, 0x86, 0xb9, 0x53, 0xf9, 0xe7, 0xde, 0x00, 0x97, 0xe5, 0x99,
-	0x3b, 0xbc, 0x66, 0x2e, 0x3e, 0x75, 0xe4, 0xe5, 0x9c, 0xbd, 0xf3, 0x5b, 0xac, 0xce, 0xd9, 0xbb,
-	0x9c, 0x99, 0xe9, 0xb3, 0x6f, 0x87, 0xa4, 0x6e, 0x42, 0x0c, 0x40, 0xb0, 0x3f, 0x9a, 0x56, 0x02,
-	0x6b, 0xe8, 0x55, 0x27, 0xdf, 0x5f, 0xa4, 0xa6, 0x8d, 0xf7, 0x26, 0xdf, 0xc2, 0x1b, 0x2f, 0x7e,
-	0x87, 0xff, 0xe2, 0x41, 0x57, 0x3e, 0xa9, 0x91, 0x3b, 0x06, 0x8c, 0x0f, 0x37, 0xcc, 0x19, 0x0f,
-	0x29, 0x55, 0x78, 0xdc, 0x95, 0x87, 0x75, 0x79, 0xb7, 0xa1, 0x10, 0x3f, 0x77, 0xc3, 0xd5, 0xf9,
-	0x04, 0xc9, 0x62, 0xec, 0x77, 0x58, 0xa2, 0xa8, 0x6f, 0x56, 0xe6, 0xe5, 0xa7, 0xa0, 0x44, 0xb9,
-	0x7c, 0xf4, 0x32, 0xf4, 0x02, 0x25, 0x51, 0xa4, 0x9d, 0x95, 0x28, 0x03, 0xc9, 0x9d, 0x15, 0xc4,
-	0xc4, 0x3d, 0x70, 0x4d, 0xbe, 0x4d, 0x69, 0xfd, 0xbe, 0x69, 0xe9, 0x7f, 0x00, 0xff, 0xe2, 0x01,
-	0x4b, 0xdc, 0x3e, 0xf2, 0x07, 0x9b, 0xec, 0x33, 0xcd, 0xf7, 0x7d, 0xc5, 0xc0, 0x7d, 0x45, 0xf4,
-	0x24, 0x9c, 0x1d, 0xf5, 0xa8, 0xc2, 0x4e, 0xb8, 0x04, 0xf3, 0x62, 0x7a, 0x05, 0xbc, 0x59, 0xeb,
-	0xf3, 0x71, 0xc8, 0x7c, 0xc0, 0x0c, 0xf1, 0x49, 0x87, 0x9d, 0x88, 0xdb, 0x36, 0xd8, 0x5a, 0x0c,
-	0xb7, 0xad, 0xe6, 0x90, 0x69, 0xf8, 0xdf, 0x1e, 0x50, 0x6b, 0x9f, 0x2b, 0x5c, 0x53, 0x90, 0x15,
-	0x83, 0xe1, 0xb5, 0xd3, 0xf4, 0x22, 0xcc, 0x9e, 0xf6, 0xa8, 0xc2, 0x2e, 0x58, 0x63, 0x63, 0x36,
-	0xf0, 0xa8, 0x2b, 0x9f, 0x9a, 0x3a, 0x64, 0x76, 0x31, 0x77, 0xd5, 0xf9, 0xed, 0x37, 0xc4, 0xed,
-	0x63, 0xf0, 0x91, 0x19, 0x72, 0xdb, 0xfa, 0x7d, 0xf3, 0xef, 0x1f, 0xc0, 0x3f, 0x79, 0x40, 0x9d,
-	0xcb, 0xe7, 0x9e, 0xe0, 0xbd, 0x76, 0xb6, 0xf2, 0x7c, 0x59, 0x2b, 0xd0, 0x34, 0x7d, 0x47, 0xb2,
-	0x04, 0x3f, 0xf2, 0xa8, 0xc2, 0xb7, 0x0c, 0xab, 0x6e, 0xfa, 0x1c, 0xb4, 0xdd, 0xdb, 0x5d, 0x97,
-	0xc1, 0x56, 0x1d, 0xff, 0x6c, 0xf0, 0x20, 0x8e, 0x00, 0x72, 0x6f, 0x1c, 0xa6, 0x1f, 0x08, 0x75,
-	0x08, 0x40, 0x23, 0x6c, 0xb0, 0x2f, 0x89, 0x31, 0x5c, 0x1a, 0xfe, 0x9b, 0x07, 0xd4, 0xd8, 0x66,
-	0x09, 0xef, 0x2e, 0xc4, 0x83, 0xc1, 0xe8, 0x9a, 0xc2, 0x9d, 0x08, 0x93, 0x83, 0x1e, 0x55, 0x78,
-	0x0e, 0x2e, 0x62, 0x99, 0x0c, 0x3c, 0x39, 0x23, 0xfe, 0x34, 0x75, 0x08, 0x47, 0x23, 0x36, 0x46,
-	0x0b, 0xfb, 0x37, 0x63, 0x30, 0xf8, 0x07, 0xac, 0xc5, 0x8e, 0x3b, 0xfb, 0x4e, 0x2d, 0xce, 0xf7,
-	0xa0, 0x84, 0x53, 0x8b, 0xf3, 0x3e, 0x00, 0x10, 0xfa, 0x96, 0x2a, 0xac, 0x87, 0x0d, 0x79, 0x9f,
-	0x02, 0x08, 0xe4, 0x6f, 0x42, 0x8c, 0x04, 0xe1, 0x4a, 0x87, 0x7d, 0x4d, 0x44, 0x24, 0xb3, 0x6b,
-	0x5a, 0x0f, 0x38, 0x6a, 0xed, 0x33, 0x70, 0xea, 0xa6, 0xdb, 0x6d, 0x6b, 0xa7, 0x6e, 0xba, 0xde,
-	0x27, 0x0e, 0x3d, 0x4f, 0xb1, 0x80, 0x6f, 0x66, 0x33, 0xb3, 0x0d, 0xe4, 0x6f, 0x42, 0x2c, 0xac,
-	0x82, 0x2b, 0x0a, 0xb2, 0x00, 0xff, 0x16, 0x8b, 0x1c, 0x7d, 0x11, 0xc8, 0xa9, 0x5f, 0x79, 0xee,
-	0x17, 0x05, 0x9a, 0xa6, 0xef, 0x68, 0xb1, 0xf1, 0x10, 0x24, 0xd7, 0x5e, 0x72, 0x17, 0xcf, 0x68,
-	0xc7, 0xde, 0xa4, 0xeb, 0xeb, 0x81, 0xbc, 0x2d, 0x88, 0x89, 0xd5, 0xa1, 0xe5, 0x2c, 0x13, 0x7c,
-	0x6b, 0x52, 0x1f, 0x48, 0xc1, 0x03, 0xb5, 0x7b, 0x38, 0xf8, 0x5f, 0x1e, 0x74, 0x49, 0x30, 0xcf,
-	0xb9, 0x23, 0x6c, 0x71, 0x7a, 0xb3, 0x42, 0xe7, 0xb1, 0x81, 0xd6, 0xa2, 0xfb, 0x13, 0xfe, 0x32,
-	0xaa, 0xb0, 0x05, 0x06, 0xa7, 0x3b, 0x24, 0x0d, 0x4c, 0xdb, 0x03, 0xf1, 0xdb, 0x14, 0xba, 0xdb,
-	0xce, 0x6f, 0x58, 0x1f, 0x3f, 0x8e, 0xc6, 0x37, 0x74, 0x49, 0x67, 0xfb, 0x8f, 0x1e, 0x74, 0x23,
-	0xdf, 0xf5, 0x98, 0x07, 0x3e, 0x60, 0x67, 0xa2, 0xd0, 0x11, 0x66, 0xa0, 0xb9, 0xc8, 0xde, 0x84,
-	0xe1, 0xa8, 0x1e, 0x8e, 0x35, 0x16, 0x38, 0x33, 0x09, 0x14, 0x6a, 0xc4, 0x9e, 0x3f, 0xb4, 0xda,
-	0xce, 0x66, 0x26, 0x2d, 0xa7, 0x12, 0xc6, 0x98, 0xa8, 0x4a, 0xe3, 0xe1, 0xe0, 0x67, 0x98, 0x49,
-	0x47, 0xca, 0x80, 0xea, 0x11, 0x6d, 0xf9, 0x2c, 0x5f, 0xde, 0xcc, 0x22, 0x30, 0x53, 0x94, 0x74,
-	0x32, 0x24, 0xa9, 0xc2, 0xd7, 0x60, 0x88, 0x56, 0x35, 0xf7, 0xbc, 0x84, 0x55, 0x47, 0xa6, 0x29,
-	0x5f, 0xf8, 0x6c, 0x54, 0x68, 0x26, 0x3d, 0x60, 0x65, 0xe1, 0x44, 0xda, 0x29, 0xc5, 0x85, 0xb3,
-	0xee, 0xc0, 0x8c, 0xfa, 0xa7, 0x93, 0xa1, 0x3e, 0x55, 0x78, 0x14, 0x2e, 0x2f, 0xc4, 0x65, 0xa0,
-	0x60, 0x2b, 0x62, 0x31, 0x04, 0x83, 0xf9, 0xac, 0xbf, 0xc1, 0x6b, 0x47, 0x4c, 0x8f, 0x83, 0x57,
-	0x80, 0x25, 0xba, 0x54, 0x05, 0x49, 0xf2, 0x16, 0x14, 0x7a, 0xba, 0x82, 0x5b, 0x12, 0x61, 0xbe,
-	0x6c, 0x5d, 0x4b, 0x5b, 0xcb, 0x3a, 0xce, 0xe3, 0xe1, 0x6b, 0x25, 0xeb, 0x8a, 0x46, 0xeb, 0x0b,
-	0xe9, 0x44, 0xbc, 0xdd, 0x01, 0xf9, 0x66, 0x08, 0x9d, 0x0b, 0xb6, 0xee, 0x0d, 0xa7, 0x9b, 0xf5,
-	0x51, 0x9b, 0xc9, 0xb0, 0x8f, 0x50, 0x53, 0xd8, 0x5b, 0x8e, 0xfa, 0x3c, 0xf8, 0xbf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x3b, 0x26, 0xb1, 0xda, 0x8b, 0x8c, 0x00, 0x00,
+	// 8763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x69, 0x70, 0x14, 0x57,
+	0x9a, 0x60, 0x97, 0x54, 0xba, 0x9e, 0x24, 0x24, 0x1e, 0x57, 0x51, 0x02, 0x51, 0x94, 0xc1, 0xc6,
+	0x69, 0x0b, 0x41, 0xda, 0xcd, 0x21, 0xb7, 0xbb, 0x49, 0x09, 0x0c, 0xb2, 0x2d, 0x90, 0x13, 0xe3,
+	0x76, 0xb7, 0x07, 0x77, 0x24, 0x55, 0x89, 0xba, 0x6c, 0xd5, 0x31, 0x75, 0xd0, 0xd2, 0x4c, 0xcc,
+	0xac, 0x70, 0xdb, 0x16, 0x60, 0x81, 0x70, 0x36, 0x18, 0x1f, 0xf8, 0x60, 0x02, 0x1b, 0xba, 0x67,
+	0x0c, 0xf2, 0xb4, 0xdb, 0x8d, 0x85, 0x68, 0x76, 0x63, 0xb7, 0x23, 0x76, 0x22, 0x66, 0x63, 0x77,
+	0xf1, 0xc6, 0x44, 0xec, 0xaf, 0x8d, 0x19, 0x2b, 0xab, 0xa4, 0x8d, 0x8d, 0x66, 0x7e, 0x6c, 0xcc,
+	0x46, 0xf8, 0xcf, 0x6e, 0xbc, 0x23, 0x33, 0xdf, 0xcb, 0xa3, 0xaa, 0x24, 0x4a, 0x86, 0x9d, 0xe8,
+	0x3f, 0x52, 0xe5, 0xf7, 0xbe, 0xf7, 0xbd, 0x23, 0xbf, 0xfb, 0x1d, 0x09, 0x3a, 0x52, 0xe9, 0x64,
+	0x36, 0xd9, 0x79, 0x28, 0x92, 0xe9, 0x88, 0x2a, 0x59, 0xa5, 0x23, 0xae, 0x24, 0x94, 0x01, 0x35,
+	0xed, 0x00, 0x6c, 0xc4, 0x78, 0xb0, 0x11, 0xc1, 0x28, 0x28, 0xb8, 0x6a, 0x20, 0x99, 0x1c, 0x18,
+	0x54, 0x3b, 0x95, 0x54, 0xac, 0x53, 0x49, 0x24, 0x92, 0x59, 0x25, 0x1b, 0x4b, 0x26, 0x32, 0x04,
+	0x35, 0xf8, 0x30, 0xfe, 0x17, 0xe9, 0x18, 0x50, 0x13, 0x1d, 0x99, 0x9f, 0x29, 0x03, 0x88, 0x6a,
+	0x32, 0x85, 0x31, 0x5c, 0xb0, 0x57, 0x1c, 0x51, 0x06, 0x63, 0x51, 0x25, 0xab, 0x76, 0x1a, 0x3f,
+	0x68, 0xc1, 0x4a, 0xda, 0x08, 0x7e, 0x3a, 0x94, 0x3b, 0xdc, 0xa9, 0x24, 0x86, 0x69, 0xd1, 0x2a,
+	0x7b, 0x51, 0x26, 0x9b, 0xce, 0x45, 0xb2, 0xa4, 0x34, 0xfc, 0xb6, 0x1f, 0x04, 0x76, 0xab, 0x59,
+	0x69, 0x70, 0xb0, 0x3f, 0x9d, 0x7c, 0x49, 0x8d, 0x64, 0x9f, 0x8e, 0x65, 0xb2, 0xb2, 0xfa, 0xa7,
+	0x39, 0x35, 0x93, 0x85, 0x17, 0x7c, 0xa0, 0x21, 0x1a, 0x8b, 0xab, 0x89, 0x4c, 0x2c, 0x99, 0x08,
+	0xf8, 0x42, 0xbe, 0x0d, 0x0d, 0xdd, 0xaf, 0xf8, 0x34, 0x49, 0x15, 0x2c, 0xb0, 0xf8, 0x7c, 0xfe,
+	0xdc, 0x17, 0xb3, 0xe7, 0x3e, 0x2f, 0x5c, 0xff, 0x5c, 0x9f, 0xfc, 0xe4, 0xf6, 0xd4, 0x98, 0x59,
+	0x32, 0x7d, 0x6d, 0xb2, 0xf0, 0xcb, 0xc9, 0xfc, 0xb9, 0x2f, 0x66, 0x6e, 0x9e, 0xd1, 0xdf, 0xfb,
+	0x20, 0x7f, 0x7e, 0x24, 0xff, 0xd6, 0x44, 0xe1, 0xdd, 0x57, 0xa7, 0xaf, 0x8d, 0xe4, 0xdf, 0xbf,
+	0x90, 0x3f, 0x3b, 0x91, 0x3f, 0x79, 0xe5, 0xf6, 0xd4, 0x58, 0x8a, 0xb4, 0x5b, 0xf8, 0x5b, 0x4d,
+	0x9f, 0xfc, 0x44, 0x1f, 0xff, 0x55, 0xfe, 0xcc, 0xd5, 0xfc, 0xd8, 0xd1, 0xa8, 0x32, 0xfc, 0x4d,
+	0xf7, 0x8a, 0xf4, 0x32, 0xb9, 0x36, 0x1e, 0x4b, 0xe4, 0xb2, 0xaa, 0xec, 0xff, 0x69, 0x32, 0x97,
+	0x96, 0xab, 0xa3, 0xca, 0xb0, 0xfc, 0x1d, 0xd9, 0x6a, 0x1e, 0x7e, 0x17, 0xf8, 0x53, 0xca, 0x80,
+	0x1a, 0xa8, 0x0a, 0xf9, 0x36, 0x34, 0x77, 0xaf, 0xd5, 0xa4, 0x15, 0x62, 0x4b, 0xfe, 0xc3, 0x8f,
+	0x67, 0xae, 0xfe, 0x95, 0x7e, 0x74, 0xbc, 0x70, 0xe9, 0xfa, 0xec, 0xe8, 0xb8, 0x80, 0x31, 0xbe,
+	0xe9, 0xf6, 0x0b, 0x55, 0x1b, 0xbe, 0x23, 0xe3, 0x07, 0xb8, 0x0d, 0xf8, 0x33, 0xb1, 0x3f, 0x53,
+	0x03, 0xd5, 0xb8, 0xda, 0x3a, 0x4d, 0x0a, 0x0a, 0x18, 0x20, 0x42, 0x52, 0x79, 0xf6, 0x9d, 0xd3,
+	0xfa, 0xb1, 0x2f, 0xf2, 0x67, 0x27, 0x66, 0x47, 0xc7, 0x71, 0xcd, 0x00, 0x90, 0x31, 0x02, 0x7c,
+	0x1e, 0x34, 0x64, 0xb2, 0x4a, 0x3a, 0xfb, 0x6c, 0x2c, 0xae, 0x06, 0xfc, 0x21, 0xdf, 0x86, 0xea,
+	0xee, 0x2e, 0x4d, 0xda, 0x2a, 0x58, 0x50, 0x51, 0xd0, 0xa7, 0x46, 0xf4, 0x4b, 0x6f, 0x90, 0x89,
+	0xb9, 0x3d, 0x35, 0x46, 0x7e, 0xe4, 0x8f, 0xfd, 0xfa, 0xf6, 0xd4, 0xd8, 0xf4, 0xb5, 0x93, 0xd3,
+	0x53, 0x1f, 0x4d, 0x5f, 0x9b, 0x9c, 0xbd, 0xfe, 0xf6, 0xcc, 0x95, 0x8b, 0xb2, 0x55, 0x0d, 0xca,
+	0xa0, 0x4e, 0x4d, 0x44, 0x31, 0xdd, 0x1a, 0x4c, 0x77, 0x9b, 0x26, 0x7d, 0x57, 0x30, 0x60, 0xa2,
+	0x50, 0xb8, 0xfe, 0x8b, 0xfc, 0xfb, 0x1f, 0x16, 0xa7, 0x5a, 0x38, 0x35, 0xa1, 0x9f, 0xbf, 0x2c,
+	0x1b, 0x95, 0xba, 0xb6, 0x6b, 0xd2, 0x16, 0xf0, 0xa8, 0xe0, 0xf9, 0x8e, 0xc5, 0x00, 0x1d, 0xef,
+	0x85, 0x2f, 0x0b, 0xef, 0x5d, 0xd1, 0x8f, 0x9d, 0x9b, 0xb9, 0x70, 0x79, 0xe6, 0xea, 0x6f, 0xf3,
+	0x9f, 0xbd, 0x12, 0xfe, 0x7d, 0x15, 0x58, 0xe9, 0x52, 0x2d, 0x93, 0x4a, 0x26, 0x32, 0x2a, 0xdc,
+	0x08, 0xfc, 0x91, 0x64, 0x54, 0xc5, 0x5c, 0xd1, 0xdc, 0x1d, 0xd4, 0xa4, 0x15, 0x02, 0x06, 0x88,
+	0x2d, 0xe4, 0x75, 0xcf, 0x9e, 0x79, 0x67, 0xe6, 0xea, 0xd5, 0xc2, 0x47, 0x47, 0x65, 0x0c, 0x86,
+	0x5d, 0xa0, 0x2e, 0xae, 0x66, 0x32, 0xc6, 0xab, 0x6a, 0xe8, 0x0e, 0x69, 0xd2, 0x6a, 0xc1, 0x80,
+	0x89, 0x90, 0xad, 0x35, 0x7d, 0xf3, 0x42, 0xfe, 0xe8, 0x55, 0xd9, 0x28, 0x84, 0x12, 0xf0, 0x23,
+	0x89, 0x0a, 0x54, 0x87, 0xaa, 0x37, 0x34, 0x8a, 0x4b, 0x37, 0x32, 0xe2, 0xb5, 0x91, 0xf6, 0xad,
+	0x7b, 0x85, 0x26, 0x2d, 0x15, 0x9b, 0xc8, 0x30, 0x48, 0x7d, 0x01, 0x57, 0x92, 0xf1, 0x5f, 0xb8,
+	0x01, 0xd4, 0x64, 0x93, 0x59, 0x65, 0x10, 0xbf, 0xb1, 0xe6, 0x6e, 0xa8, 0x49, 0x2d, 0x02, 0x81,
+	0x88, 0xb5, 0xf9, 0x91, 0xeb, 0xf9, 0xb3, 0x13, 0x32, 0x79, 0xec, 0xda, 0xa7, 0x49, 0x4f, 0x83,
+	0x27, 0x85, 0xe5, 0xbb, 0xd5, 0x2c, 0xa5, 0xdd, 0x9b, 0x38, 0x9c, 0x34, 0xc6, 0xcd, 0xcf, 0x17,
+	0x69, 0x88, 0x74, 0xfd, 0x96, 0x0f, 0x0f, 0xf4, 0x96, 0xcf, 0xe8, 0xf6, 0x2d, 0x1f, 0x6e, 0x3a,
+	0x7c, 0xa2, 0x06, 0x2c, 0xb3, 0x13, 0x23, 0xf2, 0xb5, 0x07, 0xd4, 0x51, 0xee, 0xa7, 0xc2, 0xb5,
+	0x51, 0x93, 0x96, 0x08, 0x06, 0x4c, 0xac, 0x27, 0x8d, 0xf4, 0xee, 0xfc, 0xa6, 0x7b, 0x59, 0x7a,
+	0x49, 0x20, 0x2a, 0xb6, 0xbc, 0xf8, 0xc2, 0xa6, 0x8e, 0xed, 0x4a, 0xc7, 0x9f, 0x49, 0x1d, 0x3f,
+	0xee, 0x38, 0xf8, 0xd0, 0x3a, 0xd9, 0x40, 0xb5, 0x49, 0x6a, 0xd5, 0x3d, 0x29, 0xa9, 0x4f, 0x81,
+	0xfa, 0x43, 0xb9, 0x4c, 0x2c, 0xa1, 0x66, 0x32, 0x58, 0xec, 0x1a, 0xba, 0x3b, 0xd1, 0x3b, 0xab,
+	0x9f, 0xbe, 0xf6, 0xae, 0x7e, 0xe2, 0x42, 0xef, 0x4e, 0xc1, 0x2c, 0xf6, 0x1c, 0xaf, 0x89, 0x01,
+	0x9f, 0x03, 0x8d, 0xb4, 0x3b, 0x3d, 0x88, 0x0b, 0xfd, 0x98, 0xde, 0xa3, 0x9a, 0xb4, 0x52, 0x04,
+	0x64, 0xd6, 0xd0, 0xbb, 0x10, 0x58, 0x1c, 0x4f, 0xa2, 0x2c, 0x12, 0x2f, 0xdd, 0x35, 0x0b, 0x24,
+	0xdd, 0xb5, 0x95, 0x92, 0xee, 0x4d, 0x9a, 0xd4, 0x01, 0x1e, 0x12, 0xdc, 0xd9, 0xcb, 0x54, 0x65,
+	0x78, 0x3e, 0xa8, 0x50, 0xbf, 0x5d, 0x05, 0x3c, 0x38, 0xfb, 0x2e, 0x49, 0xb4, 0x6f, 0x9e, 0x12,
+	0x5d, 0x79, 0x39, 0xfd, 0x3b, 0x22, 0xa7, 0x3d, 0x83, 0xb9, 0x4c, 0x56, 0x4d, 0xb3, 0x76, 0xb0,
+	0x72, 0x72, 0xca, 0xca, 0x40, 0x95, 0x29, 0x03, 0x16, 0xe7, 0x5b, 0xd2, 0x50, 0x86, 0x0c, 0xbc,
+	0xc4, 0xca, 0x3c, 0x91, 0xa8, 0xa7, 0x35, 0xa9, 0x93, 0x15, 0xf9, 0xb0, 0x4d, 0xe4, 0x89, 0x88,
+	0xde, 0x9e, 0x1a, 0x43, 0x32, 0x8a, 0x54, 0xc0, 0x5c, 0xcd, 0xac, 0xdf, 0x34, 0xb3, 0xc4, 0xaa,
+	0x3a, 0x8c, 0xad, 0xbb, 0x99, 0xad, 0x99, 0xb3, 0x99, 0xb5, 0x09, 0x78, 0xad, 0x29, 0xe0, 0x9c,
+	0x50, 0xb3, 0xd2, 0x3e, 0x0f, 0x01, 0xaf, 0x5b, 0x20, 0x01, 0xaf, 0xaf, 0x94, 0x80, 0x6f, 0xd1,
+	0xa4, 0x47, 0xc0, 0x66, 0xc1, 0x9d, 0x2f, 0x4d, 0x1e, 0x7f, 0xef, 0xf5, 0xc2, 0xef, 0x2e, 0x72,
+	0xb6, 0xfb, 0x3f, 0x12, 0x31, 0xe7, 0xea, 0x78, 0x8a, 0xb9, 0x43, 0xc0, 0x89, 0xdc, 0x57, 0x40,
+	0xcc, 0xcb, 0xb6, 0xba, 0x70, 0x77, 0x11, 0x13, 0x4f, 0x47, 0xd1, 0xbd, 0x1a, 0xb3, 0x0f, 0x2a,
+	0x11, 0x21, 0x3b, 0x66, 0xda, 0x2a, 0xab, 0x16, 0x5c, 0xa7, 0xa6, 0xa8, 0xf8, 0x0b, 0x1e, 0xf3,
+	0x15, 0xfe, 0xbf, 0xd5, 0xac, 0x5a, 0x60, 0xcd, 0xf7, 0x73, 0xa0, 0x21, 0x42, 0xa1, 0x3b, 0xa9,
+	0x62, 0xd8, 0xa6, 0x49, 0xcb, 0x04, 0x0b, 0x2a, 0xd6, 0x93, 0x0e, 0x20, 0x71, 0x5e, 0x9d, 0x6e,
+	0x73, 0xb0, 0x66, 0x97, 0xbf, 0xbb, 0x67, 0x7f, 0x47, 0x6b, 0x55, 0x20, 0x2a, 0x5b, 0x95, 0xfe,
+	0xbf, 0x30, 0xe6, 0x9c, 0x18, 0x55, 0x9b, 0x62, 0x34, 0x17, 0xd9, 0x61, 0x44, 0xce, 0x43, 0x8c,
+	0xfc, 0x95, 0x12, 0xa3, 0xef, 0x69, 0xd2, 0x76, 0xb0, 0xf5, 0x96, 0xcf, 0x9a, 0x64, 0xc1, 0xfd,
+	0x9d, 0x9a, 0x7a, 0x09, 0xbf, 0x36, 0x2a, 0x4c, 0x1a, 0x27, 0x4c, 0xf7, 0xa4, 0xcd, 0x34, 0x44,
+	0x84, 0xda, 0x4c, 0xdc, 0x7b, 0x17, 0x9b, 0xd9, 0xab, 0x49, 0x4f, 0x80, 0x9d, 0x82, 0xc7, 0x68,
+	0x6c, 0x83, 0x2f, 0x6e, 0x2d, 0xff, 0x9d, 0x1f, 0xb4, 0xed, 0x56, 0xb3, 0x7b, 0x95, 0xb8, 0x9a,
+	0x49, 0x29, 0x11, 0x15, 0x11, 0x62, 0x6d, 0xa6, 0x87, 0x70, 0x58, 0x12, 0xc1, 0x88, 0xc9, 0x5c,
+	0x84, 0xe3, 0x25, 0xa7, 0x6c, 0x2c, 0x98, 0xd1, 0x7b, 0x98, 0x1a, 0x3d, 0x12, 0x24, 0x06, 0x34,
+	0x69, 0x91, 0x58, 0x3b, 0x7b, 0xe1, 0x37, 0xf9, 0xb3, 0x13, 0x6e, 0x21, 0xa5, 0x48, 0x6d, 0x1d,
+	0xd1, 0x75, 0xed, 0xd4, 0x7b, 0xf9, 0xcd, 0xec, 0xfb, 0x7f, 0xa5, 0x5f, 0xbc, 0xa4, 0x4f, 0x8c,
+	0x13, 0xcb, 0x57, 0x24, 0x98, 0xbc, 0xe7, 0xdd, 0xcd, 0xbd, 0x9a, 0xf4, 0x14, 0xe8, 0xe5, 0xc4,
+	0xa8, 0x18, 0x0f, 0x88, 0xed, 0xd4, 0x37, 0x78, 0xf3, 0x86, 0x7e, 0xfa, 0x64, 0xe1, 0x97, 0x93,
+	0xb3, 0xe7, 0x3e, 0xe7, 0xac, 0xd4, 0x3f, 0x55, 0x81, 0x55, 0xee, 0xf5, 0xef, 0x69, 0x5b, 0xb5,
+	0x8f, 0xb3, 0x55, 0xcb, 0x39, 0x41, 0x34, 0xc7, 0xd2, 0x7d, 0x9f, 0x26, 0x85, 0xc4, 0x80, 0x73,
+	0xec, 0x2e, 0x62, 0xf9, 0x82, 0x26, 0x3d, 0x0f, 0x9e, 0xf3, 0xb4, 0x4c, 0x45, 0xe7, 0xa8, 0xc8,
+	0x24, 0xe3, 0xc1, 0x86, 0x6f, 0xfb, 0xc1, 0x0a, 0x3b, 0x81, 0x79, 0x5b, 0x30, 0x24, 0x8d, 0x1e,
+	0x82, 0xca, 0x0a, 0xe9, 0xf3, 0xa0, 0x21, 0x61, 0xb4, 0x47, 0xdf, 0x44, 0x97, 0x26, 0xad, 0x11,
+	0x2c, 0xa8, 0x08, 0xb9, 0x0e, 0x9f, 0x3e, 0xa9, 0x7f, 0x7a, 0xce, 0xd3, 0x81, 0xb3, 0xaa, 0xd9,
+	0x6c, 0x63, 0xf5, 0xbd, 0x6f, 0x1b, 0xef, 0xf9, 0x0c, 0x51, 0xbf, 0x26, 0xf5, 0x81, 0xa7, 0x04,
+	0x2f, 0x1e, 0x31, 0x3c, 0x29, 0xf6, 0x75, 0x11, 0xf1, 0x65, 0xf5, 0xc0, 0x2d, 0x9f, 0xf5, 0x8e,
+	0xc2, 0x17, 0xab, 0x40, 0xc0, 0x49, 0xad, 0x92, 0x16, 0xd3, 0x4d, 0x92, 0x4d, 0x31, 0xb7, 0x44,
+	0xba, 0x97, 0xb3, 0x98, 0x5e, 0x82, 0x8a, 0xdc, 0x4a, 0x9e, 0x1d, 0x5d, 0x44, 0xf4, 0x19, 0x4d,
+	0xda, 0x0b, 0x9e, 0x16, 0x3c, 0xc7, 0xe5, 0x3a, 0x4d, 0xc5, 0x2d, 0xe8, 0x3f, 0xd7, 0x80, 0xe0,
+	0x6e, 0x35, 0xfb, 0xc3, 0x64, 0xfa, 0xe5, 0xc1, 0xa4, 0x12, 0x2d, 0xd3, 0x80, 0xde, 0xb1, 0x77,
+	0xb9, 0x70, 0xb2, 0xf9, 0x02, 0x68, 0xfa, 0x19, 0x1d, 0xcc, 0xb3, 0xc3, 0x29, 0x95, 0x4a, 0xe7,
+	0x56, 0x4d, 0x5a, 0x25, 0x70, 0x05, 0x62, 0x53, 0xfe, 0xf2, 0x05, 0xfd, 0xfd, 0x73, 0x85, 0xcf,
+	0xae, 0xeb, 0x1f, 0xbc, 0xe1, 0x49, 0x99, 0xab, 0xc3, 0xdb, 0x7d, 0xff, 0xb7, 0x63, 0xf7, 0x6b,
+	0x4c, 0xbb, 0x4f, 0x83, 0x5d, 0x6a, 0xfd, 0xdd, 0xed, 0x7e, 0xad, 0x69, 0xf7, 0x69, 0x8c, 0xcb,
+	0x59, 0xff, 0x22, 0x76, 0xbf, 0x6e, 0x81, 0xdc, 0x67, 0x2b, 0x0a, 0x9d, 0x8b, 0x66, 0x30, 0xd5,
+	0x89, 0xa5, 0x22, 0x76, 0x6b, 0xd2, 0x4e, 0xd0, 0x2d, 0x14, 0xe1, 0x56, 0x83, 0xfd, 0xc9, 0x8b,
+	0x65, 0x8d, 0x3c, 0xab, 0x25, 0xc2, 0x5f, 0x55, 0x81, 0x36, 0x57, 0x22, 0x77, 0xc1, 0x9d, 0x2e,
+	0xdf, 0xde, 0xef, 0xe1, 0xec, 0xfd, 0x32, 0x4e, 0x8d, 0x18, 0x43, 0xa1, 0x5a, 0x84, 0x1d, 0xbf,
+	0x8b, 0x16, 0x79, 0x56, 0x93, 0x9e, 0x01, 0xfb, 0x84, 0x62, 0x73, 0xe0, 0x3a, 0x93, 0xc5, 0x15,
+	0xc9, 0xcd, 0x5a, 0xb0, 0xdc, 0x46, 0xf1, 0x2e, 0x19, 0x78, 0x37, 0xcd, 0xc1, 0x28, 0x96, 0xbb,
+	0xae, 0x44, 0x18, 0xe2, 0x48, 0x8b, 0x07, 0xfc, 0x2e, 0xc4, 0x51, 0x81, 0x41, 0x1c, 0x0d, 0xe5,
+	0xd2, 0x44, 0x69, 0xe2, 0xa8, 0x8e, 0xcd, 0x35, 0xa9, 0xb9, 0xf7, 0x5d, 0x93, 0xda, 0x05, 0x72,
+	0x4d, 0xea, 0x2a, 0xe5, 0x9a, 0x0c, 0x68, 0x52, 0x14, 0x1c, 0x32, 0xc2, 0xd2, 0x99, 0xdf, 0xbc,
+	0x9a, 0x9f, 0x3c, 0x5d, 0xdc, 0xf7, 0xb8, 0xe5, 0xe3, 0x5e, 0xf8, 0x2d, 0x1f, 0xf7, 0x8a, 0x04,
+	0x0f, 0x31, 0x09, 0xbf, 0x57, 0x05, 0x56, 0x38, 0x8a, 0xee, 0x82, 0x4e, 0xda, 0xc3, 0x39, 0x2c,
+	0x45, 0x34, 0x8d, 0x91, 0x06, 0x73, 0xea, 0x1b, 0xaa, 0x69, 0x9e, 0xd4, 0xa4, 0xdd, 0x60, 0x97,
+	0x67, 0x48, 0xe1, 0x35, 0x5a, 0x63, 0xae, 0x09, 0x5d, 0x1a, 0x41, 0xfc, 0x75, 0x2d, 0xa8, 0xa3,
+	0x29, 0x76, 0xb8, 0x05, 0x34, 0x50, 0x16, 0x34, 0x15, 0x4a, 0x00, 0x2b, 0x14, 0x13, 0x6a, 0xa4,
+	0xc3, 0x63, 0x51, 0xd9, 0x02, 0xc2, 0xed, 0x00, 0x18, 0x79, 0xeb, 0xde, 0x9d, 0x74, 0x62, 0x56,
+	0x6a, 0xd2, 0x72, 0x81, 0x01, 0x1b, 0xc9, 0xef, 0x58, 0x54, 0x66, 0xa0, 0xb0, 0xcb, 0xe9, 0xf1,
+	0xaf, 0x42, 0x79, 0x60, 0x0b, 0x2a, 0x36, 0xb1, 0x52, 0xc5, 0xf2, 0x7b, 0x97, 0xdd, 0x15, 0x37,
+	0xea, 0x5a, 0xfc, 0xde, 0xc4, 0xf2, 0x3b, 0xcb, 0xd1, 0x8f, 0xf2, 0xce, 0x76, 0x03, 0x79, 0xf7,
+	0x26, 0x47, 0x37, 0xb1, 0x1c, 0x6d, 0xf2, 0x2c, 0x7c, 0x06, 0xbd, 0xfe, 0x6c, 0x3a, 0x16, 0xc9,
+	0x04, 0x6a, 0xb1, 0xbd, 0x68, 0x73, 0x5b, 0xdc, 0xe8, 0x23, 0x28, 0x94, 0x24, 0xad, 0x20, 0x36,
+	0x11, 0x31, 0x27, 0xaf, 0x53, 0x36, 0xc0, 0xf0, 0x20, 0xa8, 0x8b, 0xc7, 0x12, 0x7b, 0x93, 0x51,
+	0x22, 0x5a, 0x76, 0x92, 0xbb, 0x86, 0xb2, 0x69, 0x35, 0x9e, 0x8b, 0xcb, 0x6a, 0x24, 0x99, 0x8e,
+	0x76, 0xdf, 0xaf, 0x49, 0xf7, 0x09, 0x46, 0x05, 0x31, 0x90, 0x3f, 0x3f, 0xa2, 0x4f, 0x8c, 0xcf,
+	0x9c, 0x78, 0xa5, 0xf0, 0xca, 0x97, 0xf9, 0xb3, 0x13, 0xfa, 0xf8, 0x09, 0xa3, 0xc7, 0x14, 0x05,
+	0x93, 0x57, 0x86, 0x30, 0xf9, 0xfa, 0xf2, 0xc8, 0x13, 0xaa, 0x17, 0x2f, 0x39, 0xa9, 0x0a, 0x06,
+	0x29, 0xd9, 0xf8, 0x01, 0x7b, 0x41, 0xcd, 0xa0, 0x72, 0x48, 0x1d, 0x0c, 0x34, 0xe0, 0xe9, 0x58,
+	0xe3, 0x36, 0x1d, 0x1b, 0x9f, 0x46, 0x18, 0xbb, 0x12, 0xd9, 0xf4, 0x30, 0x36, 0xc4, 0x62, 0x6d,
+	0xfe, 0xa3, 0xd1, 0xc2, 0xa7, 0xbf, 0x13, 0x48, 0x55, 0x99, 0xfc, 0x83, 0xdf, 0xe7, 0xd7, 0x04,
+	0x80, 0xf5, 0x3e, 0xbd, 0xd6, 0x04, 0xb8, 0xdc, 0x7f, 0x70, 0x1b, 0x00, 0x56, 0x43, 0xb0, 0x15,
+	0x54, 0xbf, 0xac, 0x0e, 0x13, 0x26, 0x96, 0xd1, 0x4f, 0xb8, 0x14, 0xd4, 0x1c, 0x51, 0x06, 0x73,
+	0x54, 0x70, 0x65, 0xf2, 0xd0, 0x55, 0xb5, 0xcd, 0xd7, 0xf5, 0xa0, 0x26, 0xdd, 0x0f, 0xd6, 0x09,
+	0x86, 0x18, 0x88, 0x2b, 0x67, 0xae, 0x4c, 0xe8, 0x37, 0xce, 0x52, 0xe2, 0x44, 0x33, 0x11, 0xf9,
+	0x0b, 0x7f, 0x55, 0x0f, 0x16, 0xf1, 0x6f, 0x19, 0x3e, 0x04, 0xfc, 0x59, 0xc4, 0x46, 0x44, 0x5e,
+	0x48, 0x8e, 0x8e, 0xbc, 0x6a, 0x3a, 0x6b, 0xb8, 0x58, 0xc6, 0x7f, 0xe1, 0x2e, 0xd0, 0x4c, 0x55,
+	0x5b, 0xa6, 0x27, 0x99, 0x4b, 0x64, 0xa9, 0xb0, 0xac, 0xc1, 0x46, 0x8a, 0x96, 0xe0, 0x02, 0x23,
+	0xcf, 0x47, 0x9d, 0x15, 0xbe, 0x16, 0xdc, 0x0c, 0xea, 0xb1, 0xf7, 0xd2, 0xd3, 0x7f, 0x80, 0x0a,
+	0xcd, 0x32, 0x4d, 0x82, 0x82, 0x09, 0xc4, 0x4e, 0x4e, 0x24, 0x95, 0x93, 0x4d, 0x08, 0x7c, 0x1c,
+	0x34, 0xe2, 0xdf, 0x7d, 0x6a, 0x3c, 0x99, 0x1e, 0xa6, 0xe2, 0xd2, 0xa6, 0x49, 0x01, 0x81, 0x85,
+	0x8b, 0x0d, 0xf9, 0x91, 0xeb, 0xfa, 0xeb, 0xaf, 0xe9, 0x9f, 0xbe, 0x2d, 0xb3, 0x70, 0xd8, 0x03,
+	0x9a, 0xf0, 0xe3, 0xd3, 0x49, 0x25, 0x8a, 0x5a, 0xad, 0x61, 0xfa, 0xcd, 0x16, 0x88, 0x4d, 0xa4,
+	0xe5, 0x99, 0xcf, 0x3f, 0x9c, 0xb9, 0x71, 0x43, 0xe6, 0xca, 0xe0, 0x3e, 0xd0, 0x62, 0x3e, 0xd3,
+	0x7e, 0x90, 0xa5, 0x9f, 0xf5, 0x9a, 0x14, 0x16, 0xec, 0x65, 0x62, 0x8b, 0xd9, 0x17, 0x4a, 0xcd,
+	0x8e, 0x01, 0x77, 0x00, 0xa0, 0x1c, 0x19, 0x30, 0xfa, 0x54, 0x67, 0x69, 0x64, 0x06, 0x2c, 0xb6,
+	0xe8, 0x5f, 0xfe, 0x5a, 0x7f, 0x7f, 0xd4, 0xea, 0x14, 0x53, 0x08, 0x7b, 0x41, 0x33, 0x7d, 0xa2,
+	0x1d, 0xaa, 0xc7, 0x44, 0x70, 0x7e, 0xc7, 0x5e, 0x51, 0xe0, 0x51, 0x65, 0xfe, 0x11, 0x6e, 0x05,
+	0xf5, 0x3d, 0xfd, 0x07, 0x0e, 0x60, 0xe3, 0xd0, 0x60, 0x4d, 0xaf, 0x09, 0x14, 0x9b, 0x22, 0xa9,
+	0xdc, 0xf4, 0x8d, 0x9b, 0x85, 0x33, 0x97, 0x0b, 0xa7, 0x46, 0x65, 0x13, 0x0e, 0x7b, 0x40, 0x23,
+	0x21, 0x41, 0xea, 0x12, 0xce, 0x5f, 0xab, 0x49, 0xed, 0x02, 0x0b, 0x17, 0x5b, 0xc8, 0x5c, 0x58,
+	0x14, 0xd8, 0x52, 0xa4, 0x0c, 0x13, 0xc9, 0xa8, 0x4a, 0xb8, 0xaa, 0xd1, 0x14, 0x1e, 0xb1, 0x89,
+	0xca, 0x31, 0xe6, 0x23, 0xc1, 0xc2, 0x91, 0xad, 0x9f, 0xf0, 0x79, 0x00, 0x95, 0x23, 0x4a, 0x6c,
+	0x50, 0x39, 0x34, 0xa8, 0xee, 0x35, 0x89, 0x34, 0x61, 0x22, 0x1b, 0x34, 0x69, 0xbd, 0xd8, 0xa2,
+	0x8f, 0x5f, 0x2d, 0x9c, 0xb9, 0x6c, 0xaa, 0x04, 0xc1, 0x05, 0x5f, 0x76, 0x81, 0xc1, 0x27, 0x40,
+	0x13, 0xd5, 0x44, 0x84, 0x66, 0x33, 0xa6, 0x19, 0x46, 0xde, 0x64, 0x8b, 0x4d, 0x79, 0x09, 0x1c,
+	0xa6, 0xcc, 0x3d, 0xc1, 0x5e, 0xd0, 0x48, 0x9f, 0xb1, 0xca, 0x5e, 0x84, 0xc9, 0x3c, 0xa0, 0x49,
+	0xeb, 0x04, 0x16, 0x2e, 0x2e, 0xb3, 0xd1, 0xa4, 0xda, 0x90, 0xc5, 0xc1, 0x5d, 0x52, 0x86, 0x4c,
+	0xd2, 0x81, 0x16, 0xb3, 0x4b, 0x02, 0x57, 0x20, 0xb6, 0xd8, 0xf4, 0xa0, 0xcc, 0x15, 0xe3, 0x2e,
+	0x29, 0x43, 0x06, 0xd9, 0x40, 0x2b, 0xdb, 0x25, 0x65, 0x88, 0xef, 0xd2, 0xc5, 0x4b, 0xce, 0x2e,
+	0x59, 0x38, 0xe1, 0x2f, 0xeb, 0x41, 0x1d, 0x4d, 0xd9, 0xcf, 0xdb, 0x06, 0x6f, 0x61, 0x83, 0x81,
+	0x2a, 0xa6, 0x9e, 0x23, 0x18, 0x88, 0x71, 0x19, 0x83, 0x8a, 0x19, 0xe0, 0x9a, 0x79, 0x1b, 0xe0,
+	0xda, 0x79, 0x19, 0x60, 0xbf, 0x8b, 0x01, 0xa6, 0x93, 0xc8, 0x1a, 0x60, 0xde, 0xee, 0x9a, 0xe6,
+	0xf8, 0x1e, 0x36, 0xc0, 0xe5, 0x91, 0xa7, 0x06, 0x38, 0x86, 0x05, 0xa3, 0x37, 0x91, 0xc9, 0x2a,
+	0x89, 0x08, 0xd1, 0x3b, 0x25, 0x9a, 0x10, 0x34, 0xe9, 0x01, 0x81, 0xad, 0x64, 0x34, 0xa3, 0x5f,
+	0xf9, 0x60, 0xfa, 0x77, 0x6f, 0x70, 0xcd, 0xb0, 0x68, 0xb8, 0x29, 0x65, 0xc8, 0x6c, 0x0a, 0x94,
+	0xdd, 0x94, 0x32, 0xc4, 0x37, 0x75, 0xf1, 0x92, 0x6b, 0x53, 0x16, 0x9a, 0xcd, 0xa1, 0x6c, 0x34,
+	0x1d, 0x4a, 0xcb, 0x89, 0x64, 0x5d, 0x4b, 0xce, 0xa1, 0x34, 0x3d, 0x92, 0x26, 0x17, 0x8f, 0x84,
+	0xf2, 0xc7, 0xfc, 0x3c, 0x92, 0xe6, 0x6f, 0xdb, 0x23, 0x31, 0x1c, 0x11, 0x2a, 0xac, 0xac, 0x23,
+	0x22, 0xd4, 0x51, 0x60, 0xf8, 0xcb, 0xc5, 0x60, 0x11, 0xcf, 0xf6, 0x2e, 0x1e, 0x09, 0xf1, 0x41,
+	0x78, 0xbf, 0x84, 0x7a, 0x24, 0x9c, 0xdd, 0xa8, 0x62, 0x64, 0xd8, 0x84, 0xf2, 0x26, 0xa4, 0xb4,
+	0xdd, 0xa8, 0x36, 0xed, 0x86, 0x9b, 0x99, 0x70, 0xd8, 0x12, 0x57, 0xbb, 0x71, 0xd8, 0x12, 0xca,
+	0x9a, 0xd2, 0x7c, 0xd6, 0xa9, 0x49, 0x0f, 0x5b, 0x52, 0xb3, 0xd6, 0x45, 0x6a, 0x8e, 0x8f, 0xe8,
+	0xe7, 0x2f, 0xd3, 0xb0, 0xf4, 0xca, 0x6f, 0x2c, 0xe9, 0x3c, 0x6c, 0x49, 0x67, 0x5d, 0x79, 0xed,
+	0x88, 0x6b, 0x6d, 0x0a, 0xdd, 0x49, 0xde, 0xc5, 0x4f, 0x56, 0x40, 0x13, 0x9a, 0xb6, 0x67, 0x72,
+	0x4a, 0x22, 0x1b, 0x1b, 0x44, 0xaa, 0x00, 0x31, 0xe7, 0x4a, 0x3e, 0x69, 0xcd, 0x20, 0x50, 0x13,
+	0x49, 0x1a, 0xd1, 0x8f, 0xbd, 0x3e, 0x7d, 0xe3, 0x24, 0x36, 0x91, 0x2c, 0x11, 0x99, 0x7b, 0x82,
+	0x32, 0x36, 0xb5, 0xd8, 0x19, 0xc0, 0xe3, 0x69, 0x30, 0x36, 0x33, 0x3d, 0x24, 0x70, 0x05, 0x62,
+	0x5b, 0xfe, 0xfc, 0xc8, 0xf4, 0x8d, 0x53, 0xc4, 0xa7, 0x21, 0x2d, 0x14, 0xde, 0xf9, 0x9d, 0x7e,
+	0xec, 0xf5, 0xfc, 0xd5, 0x33, 0x32, 0x87, 0xca, 0xf9, 0x99, 0xc0, 0xf4, 0x33, 0x0d, 0xef, 0xd2,
+	0xf2, 0x37, 0xbd, 0xfd, 0xcc, 0xc6, 0x3b, 0xf4, 0x33, 0x9b, 0x4c, 0x3f, 0x93, 0xf7, 0x2c, 0x79,
+	0xaf, 0xb3, 0xb4, 0x9f, 0xd9, 0x5c, 0x41, 0x3f, 0x73, 0x51, 0x25, 0xfc, 0xcc, 0x16, 0xd3, 0xcf,
+	0xb4, 0xb9, 0x95, 0x4e, 0x3a, 0x45, 0xfc, 0xcc, 0xd6, 0x3b, 0xf0, 0x33, 0x17, 0xcf, 0xcb, 0xcf,
+	0xec, 0x01, 0xcd, 0x46, 0xd6, 0x85, 0x88, 0x3b, 0xc4, 0x64, 0x70, 0xde, 0x82, 0x2f, 0x11, 0x1b,
+	0x48, 0xa2, 0x01, 0xc7, 0x2f, 0x5c, 0x09, 0x9a, 0x8d, 0x18, 0xd5, 0xf5, 0x84, 0xc8, 0x12, 0x66,
+	0x36, 0xb8, 0x12, 0xb1, 0x45, 0xbf, 0xf1, 0x0b, 0xfd, 0xf8, 0x49, 0xd3, 0x62, 0xc8, 0x7c, 0x39,
+	0x4c, 0xf3, 0x06, 0x70, 0x69, 0x69, 0x29, 0x7e, 0x44, 0x93, 0x36, 0xf1, 0x06, 0x70, 0xad, 0x8b,
+	0x01, 0xb4, 0x69, 0x0c, 0xce, 0x12, 0xa6, 0x41, 0x63, 0x1f, 0x63, 0x09, 0x57, 0x94, 0xdb, 0x26,
+	0x53, 0xc9, 0x50, 0x23, 0x45, 0xdb, 0x64, 0xf0, 0xe1, 0xe3, 0x00, 0xf4, 0xa4, 0x72, 0x34, 0xa7,
+	0x15, 0x08, 0x58, 0x93, 0x0e, 0x22, 0x26, 0x18, 0xbf, 0x79, 0x92, 0x43, 0x9b, 0x1d, 0x1d, 0x97,
+	0x99, 0x0a, 0x68, 0xc6, 0x69, 0xd4, 0x42, 0x29, 0xac, 0x64, 0xe3, 0x1c, 0xc2, 0xfc, 0x46, 0x45,
+	0xa1, 0x39, 0xce, 0xa2, 0xca, 0x7c, 0x4d, 0xb8, 0x05, 0xd4, 0xf5, 0x48, 0xe4, 0xb5, 0x05, 0xad,
+	0x38, 0x63, 0x51, 0x44, 0x99, 0xb9, 0xf4, 0x89, 0x3e, 0xfe, 0x66, 0xfe, 0x6f, 0x50, 0x42, 0x53,
+	0x30, 0x70, 0x64, 0xe3, 0x07, 0xe6, 0xdb, 0x54, 0xee, 0xe9, 0x58, 0x3c, 0x96, 0x0d, 0xb4, 0x31,
+	0x7c, 0x1b, 0xa1, 0x40, 0xdc, 0x7b, 0xb2, 0x67, 0x10, 0xf5, 0xde, 0x44, 0xb6, 0xf8, 0x96, 0xd4,
+	0x5d, 0x65, 0xf2, 0xad, 0xd1, 0x73, 0xb3, 0x92, 0xd0, 0x18, 0xb7, 0x10, 0x65, 0xb6, 0x16, 0x3c,
+	0x08, 0x6a, 0xfb, 0x62, 0x09, 0x24, 0xbe, 0xab, 0x4b, 0xbf, 0x2e, 0x6c, 0xbc, 0x28, 0x3e, 0xd6,
+	0x97, 0xfa, 0xc4, 0x78, 0x24, 0x95, 0x73, 0x79, 0x47, 0x14, 0x09, 0x93, 0x57, 0x86, 0x10, 0xf9,
+	0xf6, 0xf2, 0xc8, 0x13, 0xb2, 0x17, 0x2f, 0xb9, 0x92, 0x15, 0x28, 0x31, 0x99, 0xfe, 0x87, 0x31,
+	0xd0, 0xd0, 0x17, 0x4b, 0x50, 0xd5, 0xb1, 0xa6, 0x74, 0x0b, 0x48, 0xed, 0x8b, 0xed, 0xa4, 0xe3,
+	0x64, 0x4a, 0x5c, 0x1a, 0xb1, 0x48, 0xca, 0xd6, 0x4f, 0xdc, 0x94, 0x32, 0x44, 0x9b, 0x0a, 0x95,
+	0xd7, 0x94, 0x60, 0x55, 0x21, 0xad, 0x5e, 0xbc, 0xe4, 0xd5, 0xaa, 0x6c, 0xa1, 0x86, 0x65, 0xd0,
+	0xc4, 0x1a, 0x3d, 0xd8, 0x0e, 0x40, 0x4a, 0x4d, 0x47, 0xd4, 0x44, 0x56, 0x19, 0xa0, 0xee, 0x8b,
+	0xcc, 0x40, 0x60, 0x98, 0x58, 0x51, 0x53, 0xfb, 0x11, 0xbf, 0x89, 0x83, 0x85, 0xff, 0x73, 0x0b,
+	0x68, 0x30, 0x17, 0x71, 0xbf, 0xf5, 0x68, 0x6a, 0x07, 0xbb, 0x74, 0x52, 0x6d, 0x05, 0xbb, 0xc5,
+	0x97, 0x4e, 0xd8, 0x25, 0x92, 0x2e, 0xe7, 0x52, 0x68, 0xd9, 0xf1, 0xd8, 0x7e, 0x2b, 0x3a, 0xaa,
+	0xc1, 0x0e, 0xc6, 0x6a, 0xf7, 0x55, 0xf1, 0x39, 0x25, 0x28, 0xbb, 0xec, 0xab, 0x0a, 0xf3, 0x0b,
+	0xf2, 0xea, 0xca, 0x0f, 0xf2, 0x1e, 0x07, 0x20, 0x93, 0x1b, 0x18, 0x50, 0x33, 0x59, 0x24, 0x4f,
+	0xf5, 0x8c, 0xaa, 0xb3, 0xc0, 0x62, 0x93, 0x7e, 0x7d, 0x72, 0xe6, 0xca, 0x15, 0x24, 0x3e, 0x23,
+	0x53, 0x32, 0x53, 0x82, 0x54, 0x1d, 0x7d, 0xa2, 0x4c, 0xdc, 0xc0, 0xaa, 0x3a, 0x5c, 0x8b, 0xa8,
+	0x0d, 0x7d, 0x64, 0x4a, 0xe0, 0x51, 0x65, 0xfe, 0x11, 0x1e, 0x02, 0xcd, 0x69, 0x35, 0x93, 0xcc,
+	0xa5, 0x23, 0x2a, 0xd1, 0x3d, 0x24, 0xe8, 0x09, 0x72, 0xd3, 0x2a, 0xb3, 0x18, 0xc4, 0x2d, 0xe0,
+	0x6b, 0x89, 0x4d, 0xc4, 0x75, 0x27, 0x6a, 0x4a, 0xe6, 0x0b, 0xe1, 0x10, 0x68, 0x21, 0x42, 0x8e,
+	0xf9, 0x17, 0xcf, 0x55, 0x63, 0x69, 0xa9, 0xdb, 0xac, 0x49, 0x1b, 0x05, 0x7b, 0x45, 0x56, 0xa7,
+	0x1c, 0xfb, 0x25, 0xb1, 0xde, 0x56, 0x84, 0x65, 0xc7, 0xc6, 0x2d, 0xc7, 0x12, 0x2c, 0x28, 0xd0,
+	0x54, 0x76, 0xcb, 0xb1, 0x84, 0xb3, 0xe5, 0x89, 0x71, 0xaf, 0x96, 0x79, 0x6c, 0xf8, 0xaa, 0x0f,
+	0x40, 0x53, 0x0d, 0x58, 0xad, 0x37, 0x97, 0x6e, 0x7d, 0xab, 0x26, 0x3d, 0x6a, 0xa8, 0x18, 0xfa,
+	0x16, 0x1d, 0xed, 0x09, 0x2e, 0xb4, 0x65, 0x17, 0x18, 0xe9, 0x46, 0x2c, 0x61, 0x03, 0x07, 0x16,
+	0xcd, 0xa5, 0x1b, 0x13, 0xe3, 0x45, 0xba, 0xe1, 0xa0, 0x2d, 0xbb, 0xc0, 0x60, 0x0e, 0xbf, 0x07,
+	0xc3, 0xd2, 0xe3, 0x2e, 0xb4, 0x94, 0xa9, 0xe2, 0x3d, 0x43, 0x77, 0xc1, 0x4e, 0x52, 0xb6, 0x03,
+	0x70, 0xb3, 0xca, 0x10, 0x0b, 0x0a, 0xb4, 0xce, 0xa5, 0x59, 0xb7, 0x30, 0x5e, 0xb0, 0x93, 0x94,
+	0xed, 0x00, 0xf8, 0x97, 0xa0, 0xb5, 0x2f, 0x96, 0x30, 0xd6, 0xa7, 0x2c, 0x57, 0xb4, 0x44, 0xbb,
+	0xdf, 0xd5, 0x24, 0x51, 0x70, 0xd4, 0x14, 0xdb, 0x4d, 0x37, 0x95, 0xcc, 0x04, 0x5d, 0x33, 0x33,
+	0x59, 0xcf, 0x51, 0x03, 0xb7, 0xaf, 0x0c, 0xf1, 0xed, 0xc3, 0xf2, 0xda, 0xb7, 0xb5, 0x76, 0xf1,
+	0x92, 0xad, 0x35, 0xc1, 0x41, 0x59, 0x76, 0x40, 0x6c, 0xb9, 0x8d, 0x25, 0x73, 0x59, 0x2c, 0x7b,
+	0xca, 0xc8, 0x6d, 0x2c, 0xc5, 0xda, 0x7d, 0xad, 0xbb, 0x76, 0x9f, 0x5f, 0x76, 0x63, 0xd9, 0xb7,
+	0x97, 0xdd, 0xe8, 0xd0, 0x24, 0x01, 0x6c, 0x60, 0xf7, 0x24, 0xb5, 0x91, 0x44, 0x87, 0x09, 0xe0,
+	0xd6, 0x5c, 0xde, 0xa8, 0x02, 0xcd, 0x9c, 0x8e, 0x85, 0x8f, 0xe2, 0x08, 0x08, 0xff, 0x66, 0x8d,
+	0xba, 0x09, 0x14, 0x1b, 0x4c, 0x4f, 0x52, 0x36, 0x81, 0xb0, 0x0b, 0x00, 0xe4, 0x52, 0x51, 0xff,
+	0xb7, 0xca, 0xb4, 0x47, 0x18, 0x9b, 0x38, 0xbf, 0x02, 0x83, 0x21, 0x33, 0xbf, 0xe1, 0x0e, 0xde,
+	0x05, 0x25, 0x96, 0xbd, 0x5d, 0x93, 0xda, 0x04, 0x16, 0x2e, 0x36, 0xb1, 0xfe, 0x28, 0xef, 0x7f,
+	0x3e, 0x61, 0x77, 0xc0, 0xfd, 0x56, 0x14, 0xc9, 0x97, 0x18, 0x54, 0x48, 0x97, 0x6c, 0xde, 0x77,
+	0xf8, 0x1f, 0x9b, 0x40, 0xab, 0xdd, 0x90, 0xcf, 0x6d, 0x0d, 0xea, 0x71, 0x97, 0x79, 0xc0, 0x1b,
+	0x5c, 0xb8, 0xe8, 0xc1, 0x73, 0x2a, 0x1c, 0x91, 0x44, 0x35, 0x13, 0xbb, 0x71, 0x81, 0x83, 0x23,
+	0xb0, 0xb0, 0x47, 0x12, 0xbd, 0x60, 0x91, 0x61, 0x16, 0xa4, 0x38, 0x0e, 0x28, 0xfc, 0x56, 0x4c,
+	0x6a, 0x2b, 0x62, 0xa2, 0x5a, 0x44, 0xc9, 0x56, 0x0a, 0x0f, 0x80, 0xc5, 0x71, 0x4b, 0xad, 0x52,
+	0x6a, 0x35, 0x66, 0x4e, 0x9e, 0x0f, 0x6a, 0xd1, 0xf0, 0x9c, 0xe8, 0xb2, 0x13, 0xc4, 0xc5, 0xda,
+	0xb5, 0x77, 0x10, 0x6b, 0xd7, 0x39, 0x62, 0x16, 0xb3, 0x12, 0x17, 0x7c, 0xf3, 0xb1, 0xb6, 0x15,
+	0x54, 0xd4, 0x97, 0x1b, 0xb3, 0xc4, 0x31, 0xbe, 0xd8, 0xd6, 0xd3, 0x7f, 0x80, 0xd5, 0x55, 0xb3,
+	0x7f, 0xfd, 0x36, 0x1b, 0xb3, 0x60, 0x24, 0x26, 0x24, 0x6a, 0x28, 0x9b, 0x7c, 0x2c, 0xe1, 0x46,
+	0x7e, 0xfa, 0xc6, 0x29, 0x67, 0x48, 0xc4, 0x05, 0x12, 0xa0, 0xdc, 0x40, 0x22, 0x6e, 0x05, 0x12,
+	0xb6, 0x89, 0xb2, 0x0f, 0xc3, 0xa2, 0xce, 0x87, 0x47, 0x8d, 0x65, 0x37, 0x15, 0x4b, 0x78, 0x37,
+	0xc5, 0x0d, 0xc9, 0xa2, 0x5e, 0x2c, 0xff, 0xc1, 0x24, 0x3c, 0x6c, 0xa9, 0x90, 0x39, 0xe4, 0x3f,
+	0x1c, 0x09, 0x0f, 0x5b, 0x42, 0xa4, 0x44, 0xfe, 0x63, 0x59, 0x79, 0xb9, 0x88, 0x32, 0x52, 0x1e,
+	0x5c, 0x8a, 0xa4, 0x32, 0xf9, 0x8f, 0xf8, 0x1d, 0xe4, 0x3f, 0x72, 0xa0, 0x35, 0x6e, 0x77, 0x1b,
+	0x02, 0xa5, 0x1b, 0x7e, 0x58, 0x93, 0x1e, 0x14, 0x97, 0xd9, 0x5f, 0x2c, 0x7e, 0x4d, 0x82, 0x83,
+	0xa0, 0xec, 0x80, 0xe0, 0x66, 0xed, 0xde, 0xc2, 0xca, 0xf2, 0x9a, 0x15, 0x1c, 0x35, 0xc5, 0x65,
+	0x76, 0x66, 0xc6, 0x1d, 0x91, 0x1d, 0x88, 0x54, 0xef, 0x10, 0x63, 0x13, 0xe4, 0xf5, 0x8e, 0x7b,
+	0xae, 0x84, 0xc2, 0xed, 0xb9, 0x92, 0xb6, 0xf9, 0xe4, 0x4a, 0xc2, 0x7f, 0xf0, 0x81, 0x45, 0xfc,
+	0x80, 0x60, 0x17, 0xf0, 0x27, 0x14, 0xd3, 0xc2, 0xe0, 0x65, 0x2a, 0x0c, 0x10, 0xdb, 0xf4, 0xab,
+	0x5f, 0xce, 0x7c, 0x76, 0x81, 0x6c, 0xa6, 0xbb, 0x3d, 0x35, 0xa6, 0x7f, 0xf2, 0x8a, 0xb9, 0xbd,
+	0x4e, 0xc6, 0x28, 0xb0, 0x17, 0x00, 0x12, 0x4c, 0xe2, 0x6d, 0x79, 0xc4, 0xe0, 0xa0, 0x15, 0x0e,
+	0x81, 0x01, 0x8b, 0x81, 0xfc, 0xd8, 0x68, 0xfe, 0xa3, 0x51, 0x42, 0x21, 0x92, 0xca, 0xa1, 0x2d,
+	0xbe, 0xc4, 0x58, 0x30, 0x58, 0x70, 0xbd, 0xe1, 0x54, 0x20, 0xa3, 0xe3, 0xeb, 0x6e, 0xd1, 0xa4,
+	0x26, 0xb1, 0x1a, 0xc5, 0x71, 0x04, 0x4c, 0xbd, 0x0c, 0xb8, 0x11, 0xd4, 0xa6, 0xd4, 0x74, 0x2c,
+	0x19, 0xa5, 0x06, 0x65, 0xb9, 0x26, 0x2d, 0x11, 0x1b, 0x98, 0xf4, 0x0a, 0x29, 0x95, 0xe9, 0xff,
+	0xf0, 0x1f, 0x9a, 0x41, 0xbd, 0xf1, 0x02, 0xee, 0x95, 0x9c, 0x41, 0x89, 0x3d, 0xdb, 0x6c, 0xce,
+	0xa0, 0xc7, 0xb6, 0xad, 0xd2, 0xcf, 0x26, 0xcd, 0x99, 0x8d, 0x94, 0xfc, 0x26, 0x4b, 0xdb, 0xf6,
+	0xc9, 0x1e, 0xdb, 0xf6, 0xc9, 0x46, 0x07, 0x11, 0xf2, 0x8e, 0xf9, 0xcd, 0x94, 0xb6, 0x6d, 0x92,
+	0x5c, 0xf6, 0xa2, 0x76, 0x6e, 0xd9, 0x0b, 0xd9, 0x9e, 0xbd, 0x58, 0xe5, 0xba, 0x45, 0x6e, 0x4e,
+	0x8b, 0xbb, 0x5d, 0xf6, 0xad, 0xd8, 0xf3, 0x4b, 0x5e, 0xd4, 0xcf, 0x37, 0x79, 0xd1, 0x70, 0xc7,
+	0xc9, 0x0b, 0xc0, 0x78, 0x57, 0x5c, 0x89, 0x23, 0x97, 0x61, 0x4f, 0x5e, 0xb8, 0x24, 0x16, 0x16,
+	0xff, 0x31, 0xb1, 0x50, 0x2c, 0xa2, 0x77, 0x4b, 0x1c, 0x94, 0x4a, 0x36, 0x2c, 0x40, 0x62, 0xc1,
+	0x2d, 0x71, 0x50, 0x2a, 0xd9, 0x50, 0xe1, 0xc4, 0x82, 0x23, 0x7d, 0x50, 0x64, 0x93, 0x40, 0x05,
+	0x13, 0x0b, 0x8e, 0xf4, 0x41, 0x91, 0x0d, 0x03, 0x76, 0x54, 0x5b, 0x60, 0x0d, 0xe7, 0x12, 0x58,
+	0x3f, 0x69, 0x04, 0xd6, 0x4b, 0xb0, 0xe2, 0x09, 0xb9, 0x2a, 0x9e, 0xf9, 0xc5, 0xd5, 0x4b, 0xad,
+	0x25, 0x12, 0xaf, 0xcb, 0x4b, 0x2a, 0xbc, 0x8f, 0xb1, 0xde, 0xd0, 0xc8, 0x62, 0x80, 0x84, 0xd5,
+	0x74, 0xbf, 0x2f, 0x1b, 0x53, 0xff, 0xf7, 0x46, 0xd0, 0x62, 0x53, 0xa8, 0xdf, 0x76, 0x10, 0x19,
+	0x9f, 0x77, 0x10, 0x19, 0xbf, 0x4b, 0x41, 0xa4, 0x4b, 0xcc, 0xe8, 0x88, 0x2b, 0xcb, 0x0f, 0x22,
+	0xf9, 0xd0, 0xd1, 0x0a, 0x29, 0xcb, 0x09, 0x22, 0xe7, 0xb2, 0x60, 0xfb, 0xaa, 0x0f, 0xb4, 0xc4,
+	0x6d, 0x76, 0xa0, 0x8c, 0x70, 0xf2, 0x71, 0x4d, 0xea, 0x12, 0xec, 0x15, 0xc5, 0x07, 0x9c, 0x71,
+	0xa5, 0x3e, 0x32, 0xa5, 0x8f, 0x9f, 0xd0, 0xaf, 0x7e, 0xa9, 0x4f, 0x9e, 0xb1, 0x7c, 0x77, 0x7b,
+	0x4d, 0xd2, 0x0d, 0x9b, 0x51, 0x68, 0x28, 0xbb, 0x1b, 0xb1, 0x44, 0xd1, 0x6e, 0x4c, 0xdf, 0x38,
+	0xe5, 0xd5, 0x0d, 0x9b, 0x81, 0x38, 0xe1, 0x03, 0x30, 0xee, 0x34, 0x10, 0xa0, 0xcc, 0x9e, 0xb8,
+	0xd4, 0x15, 0xd7, 0xb9, 0xc6, 0xa9, 0xa4, 0x3f, 0x56, 0x4f, 0x5c, 0x6a, 0x92, 0xce, 0x38, 0xcd,
+	0x44, 0x63, 0x79, 0x9d, 0x71, 0x6b, 0xd8, 0x9c, 0x08, 0xcb, 0xd7, 0x75, 0x69, 0x41, 0x76, 0x81,
+	0x55, 0x72, 0x4d, 0xfe, 0x2f, 0xf1, 0xab, 0xe6, 0x0c, 0xc0, 0xb2, 0x32, 0x4d, 0x9f, 0xbd, 0x62,
+	0x39, 0x6b, 0xf3, 0xf6, 0x3a, 0xb8, 0x7d, 0x9b, 0x01, 0x5a, 0x51, 0x76, 0xfb, 0xca, 0x90, 0xb3,
+	0xfd, 0xe2, 0x71, 0xaa, 0xbd, 0x0e, 0x17, 0xbd, 0x05, 0xe6, 0x18, 0xbd, 0x31, 0x41, 0x59, 0x60,
+	0x25, 0x23, 0xf0, 0x0c, 0xdc, 0x11, 0xca, 0xc9, 0x6c, 0x69, 0xf8, 0x5f, 0xea, 0xf1, 0x39, 0xbc,
+	0xfe, 0x64, 0x54, 0xca, 0x65, 0x93, 0x99, 0x88, 0x32, 0xc8, 0x5f, 0x78, 0x34, 0xe7, 0x63, 0x63,
+	0xd6, 0x51, 0x53, 0x97, 0xc3, 0x5d, 0x15, 0x39, 0x7b, 0xea, 0xa0, 0x8b, 0x4e, 0xb5, 0x96, 0x73,
+	0x6c, 0xac, 0x58, 0x7c, 0xe3, 0x49, 0xb9, 0xdc, 0x63, 0x63, 0xc5, 0xe2, 0x9e, 0x7f, 0x45, 0xc7,
+	0xc6, 0x8c, 0x03, 0xb1, 0xb5, 0x73, 0x3a, 0x10, 0x5b, 0x37, 0x87, 0x8b, 0x30, 0xd8, 0x8b, 0xb1,
+	0xea, 0x4b, 0x5f, 0x8c, 0xe5, 0xf6, 0xce, 0x4c, 0x5c, 0xf8, 0xa4, 0x75, 0x5f, 0x17, 0x89, 0xae,
+	0x36, 0xa1, 0x9b, 0x47, 0x00, 0x85, 0x85, 0x7a, 0x77, 0x9a, 0x77, 0x77, 0x95, 0xbe, 0xb1, 0xeb,
+	0x47, 0x60, 0x71, 0x8a, 0x15, 0x13, 0xcc, 0x5d, 0x24, 0xe2, 0x7a, 0x48, 0x93, 0x36, 0x08, 0xce,
+	0x52, 0x71, 0x09, 0x07, 0x22, 0x7c, 0x27, 0x3b, 0xf1, 0xf8, 0xc3, 0x78, 0x8d, 0x0b, 0x74, 0x08,
+	0xb8, 0xa9, 0x52, 0x87, 0xf1, 0x7a, 0x34, 0x69, 0x07, 0xf8, 0xbe, 0x50, 0x4c, 0x6f, 0x88, 0x6b,
+	0xc8, 0xe9, 0x31, 0xae, 0x9c, 0xbb, 0xf1, 0x63, 0x86, 0xdc, 0xf8, 0xe1, 0x42, 0xe0, 0x9e, 0xbe,
+	0xf1, 0xe3, 0x59, 0xee, 0x04, 0x30, 0xbf, 0xb6, 0xcf, 0x8d, 0x05, 0x5d, 0x71, 0xb6, 0x96, 0x1e,
+	0xce, 0x5b, 0x99, 0x72, 0x4e, 0x03, 0x77, 0x46, 0xef, 0x4f, 0x34, 0xe9, 0x47, 0xe0, 0x87, 0x42,
+	0xd1, 0x09, 0x31, 0xa6, 0xd4, 0x85, 0x56, 0x89, 0x53, 0xc1, 0x67, 0x6a, 0xc1, 0x0a, 0x3b, 0xe5,
+	0x79, 0x5f, 0xce, 0xe3, 0x79, 0x28, 0xb8, 0xb5, 0xea, 0x8f, 0xf7, 0x7e, 0x18, 0x5a, 0xd2, 0x55,
+	0x55, 0xf8, 0x2b, 0xa2, 0x2a, 0xec, 0xa4, 0xb1, 0x19, 0xaa, 0xf1, 0x22, 0x8d, 0x4a, 0x6d, 0xa4,
+	0x89, 0x81, 0x92, 0x9d, 0x78, 0x5e, 0x47, 0x82, 0x2b, 0xab, 0x85, 0x2a, 0x76, 0x24, 0xf8, 0x07,
+	0x9a, 0xf4, 0x3d, 0xd0, 0x25, 0x78, 0x71, 0xb6, 0x21, 0x2e, 0xf9, 0x0f, 0x4f, 0x4f, 0x5f, 0xfb,
+	0x15, 0x87, 0x41, 0x35, 0xd0, 0x7f, 0x23, 0x97, 0x93, 0xd8, 0x2a, 0xdf, 0x85, 0xcb, 0x49, 0x5c,
+	0xb4, 0x8f, 0xa1, 0x76, 0xa8, 0x16, 0x32, 0xb4, 0xcf, 0x5e, 0xee, 0x54, 0x70, 0x31, 0xed, 0x83,
+	0x97, 0x9b, 0x89, 0xf6, 0xe1, 0x5f, 0x3f, 0xa7, 0x77, 0x64, 0x4d, 0xda, 0x07, 0xfa, 0xc4, 0x95,
+	0x2e, 0x9a, 0xa5, 0xc4, 0x1d, 0x79, 0x9e, 0x33, 0x17, 0xfe, 0xfb, 0x7a, 0xd0, 0xcc, 0x95, 0x54,
+	0x3a, 0x47, 0x6e, 0x25, 0xc6, 0x19, 0xdd, 0xf4, 0x6d, 0xe5, 0xc8, 0x8b, 0x5d, 0x3d, 0x50, 0x22,
+	0x47, 0x5e, 0xe3, 0x42, 0xc4, 0x79, 0xc5, 0x40, 0x05, 0x73, 0xe4, 0x15, 0xcf, 0x67, 0xf3, 0x69,
+	0x6c, 0xe7, 0xf5, 0x20, 0xb6, 0xac, 0x5a, 0xc3, 0x5c, 0xb2, 0x6a, 0x0b, 0xe8, 0x5d, 0xb9, 0xaa,
+	0xcc, 0xc6, 0x8a, 0xa8, 0xcc, 0x17, 0xad, 0x65, 0x88, 0x26, 0x97, 0x6d, 0x36, 0x9c, 0x1c, 0x18,
+	0x6b, 0x11, 0x38, 0x44, 0x33, 0xaa, 0xd9, 0x5a, 0x22, 0xeb, 0x5a, 0xd6, 0x92, 0xc4, 0x3e, 0x23,
+	0xd7, 0xd8, 0x8c, 0xa9, 0xaf, 0xf7, 0xa6, 0x6e, 0x4f, 0x38, 0xd2, 0x44, 0xa3, 0x91, 0x77, 0xf4,
+	0x48, 0x38, 0x2e, 0xfa, 0xf6, 0x36, 0xf2, 0xa0, 0x3d, 0x51, 0x60, 0x93, 0xc0, 0xeb, 0x06, 0x71,
+	0x0d, 0x3d, 0x3e, 0xcd, 0xa9, 0x1e, 0x36, 0xf9, 0xf8, 0x9f, 0x7c, 0x60, 0xa9, 0xdb, 0x34, 0xce,
+	0xed, 0xe0, 0xd2, 0xa8, 0x0f, 0x2c, 0x7f, 0x16, 0xa9, 0xd0, 0xfd, 0xb9, 0x48, 0x44, 0xcd, 0x64,
+	0x0e, 0xe7, 0x06, 0x65, 0x15, 0xd3, 0xa3, 0x8a, 0x05, 0x5d, 0x16, 0x2a, 0x78, 0xa0, 0x88, 0xe2,
+	0xcc, 0xcd, 0x51, 0xfd, 0xd4, 0xf5, 0x47, 0x36, 0xc5, 0x63, 0x89, 0xce, 0xcd, 0xc8, 0xe6, 0x77,
+	0x6e, 0x8e, 0x2a, 0xc3, 0xfa, 0xeb, 0xaf, 0xf1, 0x76, 0x85, 0xd9, 0xd2, 0x2e, 0x7b, 0xd0, 0x0a,
+	0xdf, 0xaa, 0xc5, 0x77, 0x3d, 0xf5, 0x27, 0x7f, 0xa6, 0xa6, 0x9f, 0x4d, 0x2b, 0xd1, 0x58, 0x62,
+	0x60, 0xa7, 0x92, 0x55, 0x0c, 0x7f, 0x6c, 0x33, 0xa8, 0xc9, 0xa2, 0xd3, 0x4d, 0x01, 0x9f, 0x95,
+	0x0a, 0x20, 0x10, 0xe3, 0x7e, 0xdd, 0xc2, 0xbb, 0xaf, 0xce, 0x5c, 0xb8, 0x8c, 0x16, 0x4c, 0x09,
+	0x1c, 0xb9, 0x70, 0x96, 0x98, 0x57, 0x19, 0x2e, 0xdc, 0x77, 0xc5, 0x0d, 0x36, 0xb3, 0x3d, 0x3c,
+	0x3c, 0x3c, 0xdc, 0xd1, 0xd7, 0xd7, 0x11, 0x8d, 0x86, 0xf6, 0xec, 0xe9, 0x8a, 0xc7, 0xbb, 0x32,
+	0x99, 0xfc, 0x47, 0x53, 0xfa, 0xd4, 0xb8, 0x87, 0xd1, 0xee, 0xb7, 0x54, 0x00, 0x51, 0xa2, 0xe8,
+	0x0a, 0x5a, 0xcb, 0x68, 0x6f, 0xb0, 0x19, 0x6d, 0x4f, 0xf2, 0x96, 0x7a, 0x18, 0x04, 0xb5, 0x29,
+	0x25, 0xad, 0xc4, 0x8d, 0xf3, 0x98, 0x8f, 0x70, 0xec, 0xec, 0x3d, 0x2b, 0x1b, 0xfb, 0x71, 0x2d,
+	0xc2, 0xdc, 0x58, 0x81, 0x52, 0x3a, 0xc6, 0x25, 0x15, 0x85, 0x4f, 0xdf, 0x9b, 0x1d, 0x39, 0x9e,
+	0x7f, 0xff, 0xc2, 0xf4, 0xf5, 0x2f, 0x64, 0x5a, 0x06, 0xff, 0x0d, 0xf0, 0x67, 0x92, 0xe9, 0x2c,
+	0x5d, 0x1f, 0xdc, 0x5c, 0x6e, 0x5b, 0xfb, 0x93, 0xe9, 0x2c, 0x69, 0x09, 0x3b, 0x29, 0x98, 0x86,
+	0xd8, 0x91, 0x3f, 0xa5, 0xe9, 0x93, 0xe3, 0x85, 0x4f, 0xdf, 0x2a, 0x9c, 0xfd, 0xf8, 0xf6, 0xd4,
+	0xd8, 0xe6, 0x99, 0x0b, 0x97, 0x0b, 0x17, 0x27, 0xf5, 0x93, 0xa3, 0xfa, 0xe4, 0xf8, 0xed, 0xa9,
+	0xb1, 0x0e, 0x0a, 0x98, 0x7d, 0xe7, 0xa4, 0x3e, 0x39, 0x2e, 0xe3, 0x4a, 0xf0, 0x20, 0x68, 0x4e,
+	0xa5, 0xd5, 0xc3, 0x6a, 0x7a, 0x7f, 0x36, 0x99, 0xb6, 0xf2, 0xb9, 0x38, 0x75, 0xc4, 0x97, 0x88,
+	0xf7, 0xa1, 0x75, 0x9a, 0x57, 0x2e, 0xeb, 0x47, 0xc7, 0xf5, 0x8f, 0x6f, 0xdc, 0x9e, 0x1a, 0xcb,
+	0x66, 0x52, 0xb1, 0xa8, 0x9a, 0xce, 0x1f, 0x7b, 0x6b, 0x66, 0xe4, 0xb5, 0x78, 0x32, 0x31, 0x90,
+	0x8c, 0x1e, 0x92, 0xf9, 0x3a, 0x70, 0x3d, 0x0d, 0xc5, 0x49, 0x70, 0xbd, 0x98, 0x0f, 0xc5, 0xb1,
+	0x67, 0x82, 0x9e, 0xe0, 0x66, 0x1a, 0x83, 0xd7, 0x63, 0xb4, 0xd5, 0x45, 0x2f, 0x5e, 0x26, 0x21,
+	0x78, 0x70, 0x3b, 0x68, 0x64, 0x66, 0x7c, 0x2e, 0x52, 0x1e, 0xdc, 0x0a, 0x1a, 0xcc, 0x09, 0x2c,
+	0x55, 0xb1, 0x9a, 0x55, 0x0f, 0x8f, 0x69, 0xd2, 0x36, 0xb0, 0x45, 0x28, 0x22, 0x1b, 0x45, 0x2e,
+	0x39, 0xfe, 0xb4, 0x1a, 0xb4, 0xb9, 0x56, 0xbc, 0x37, 0x6e, 0x93, 0xf2, 0xf0, 0xe6, 0xfa, 0x6c,
+	0x37, 0x1d, 0x93, 0xcf, 0x73, 0x6c, 0x34, 0x3e, 0xcf, 0xb1, 0x51, 0x4a, 0x0c, 0x93, 0x8c, 0x2a,
+	0x46, 0x13, 0x03, 0x33, 0x37, 0x4f, 0xcf, 0x9c, 0xfe, 0x98, 0x5d, 0xc4, 0x66, 0x9d, 0x39, 0x93,
+	0x1f, 0x6a, 0x4c, 0x7e, 0x30, 0x38, 0x81, 0xf0, 0x85, 0x8d, 0x1f, 0x6a, 0x4d, 0x7e, 0x70, 0xe3,
+	0x04, 0xc2, 0x23, 0x84, 0x1f, 0xd8, 0x0b, 0xd6, 0x5d, 0xee, 0x45, 0x9e, 0xfb, 0x0d, 0xcb, 0xe1,
+	0x7f, 0xae, 0x05, 0x8d, 0xcf, 0xee, 0xda, 0x6d, 0xee, 0xa2, 0xd8, 0x61, 0x79, 0x7a, 0xd1, 0x80,
+	0xcf, 0xf4, 0xd8, 0x44, 0x48, 0x9b, 0x0b, 0xc5, 0xa2, 0x6a, 0x22, 0x1b, 0x3b, 0x1c, 0x53, 0xd3,
+	0x96, 0xcf, 0xc7, 0xec, 0x8b, 0x88, 0xc2, 0xad, 0xce, 0x78, 0x13, 0x3b, 0x1e, 0x16, 0x54, 0xb4,
+	0x4e, 0x7a, 0xb0, 0xae, 0xde, 0x13, 0xd6, 0x16, 0xad, 0x9f, 0xbc, 0x1c, 0x4b, 0x44, 0xa9, 0xae,
+	0xc3, 0x46, 0x9a, 0x2f, 0x11, 0x9b, 0x8d, 0xfe, 0x86, 0xd0, 0xa3, 0xe5, 0xa8, 0x3d, 0x15, 0x4b,
+	0x44, 0x39, 0x3a, 0x09, 0x2b, 0x35, 0x88, 0xe8, 0x30, 0x15, 0x51, 0x89, 0xc0, 0x23, 0xda, 0x1c,
+	0xbe, 0xc7, 0x00, 0x88, 0x2b, 0xb1, 0x44, 0x56, 0x89, 0x25, 0xd4, 0x74, 0xa0, 0xc6, 0xb4, 0x02,
+	0x22, 0xe8, 0x33, 0xc1, 0x02, 0x83, 0x22, 0x33, 0xbf, 0xe1, 0x53, 0xa0, 0xf9, 0x90, 0xf2, 0xb2,
+	0x85, 0xc8, 0xde, 0x98, 0xc1, 0x97, 0x88, 0x8b, 0xbb, 0x95, 0xc8, 0xcb, 0xb9, 0x54, 0x88, 0xa1,
+	0xc4, 0x63, 0xc0, 0x67, 0x40, 0xb3, 0xe1, 0x9f, 0xed, 0x57, 0xb3, 0xbd, 0x51, 0xac, 0x68, 0x6a,
+	0xb0, 0xcb, 0x24, 0xae, 0xe8, 0xa6, 0x25, 0xa1, 0x8c, 0x9a, 0x65, 0xdf, 0x0e, 0x5f, 0x45, 0xe6,
+	0x1f, 0xe1, 0x4e, 0xc6, 0x3f, 0x8c, 0x62, 0x8d, 0x54, 0x83, 0xf3, 0x24, 0xe2, 0x12, 0x93, 0x1e,
+	0x43, 0x8b, 0xc1, 0x65, 0x5c, 0xc5, 0x28, 0x3c, 0x08, 0x5a, 0x8d, 0xa7, 0xbe, 0x64, 0x34, 0x37,
+	0xa8, 0xf6, 0x46, 0xb1, 0xaf, 0x59, 0x43, 0x76, 0x05, 0x38, 0x0a, 0xc5, 0xa0, 0x49, 0x3d, 0x8e,
+	0x41, 0x4c, 0x23, 0xb2, 0x03, 0x1b, 0xf6, 0x83, 0x96, 0x4c, 0xe4, 0xa7, 0x2a, 0x7a, 0x4a, 0xef,
+	0xcf, 0x2a, 0xd9, 0x5c, 0x06, 0xfb, 0xa1, 0x35, 0x64, 0x23, 0x93, 0xbd, 0x4c, 0x6c, 0xdd, 0x6f,
+	0x00, 0x42, 0x19, 0x0c, 0x91, 0xed, 0x28, 0x70, 0x0f, 0x68, 0xce, 0xa8, 0xe9, 0x23, 0xb1, 0x88,
+	0x4a, 0xe9, 0x35, 0x62, 0x7a, 0x98, 0xc5, 0x17, 0xed, 0x27, 0x25, 0xb4, 0xb2, 0xc0, 0x63, 0xca,
+	0xfc, 0x23, 0xdc, 0x06, 0x1a, 0x7e, 0x9a, 0x52, 0x28, 0x95, 0x26, 0x4c, 0x05, 0x6b, 0x33, 0x0b,
+	0x2a, 0x82, 0x3d, 0xfd, 0x92, 0xd1, 0x13, 0x0b, 0x1c, 0xfe, 0xf7, 0x7e, 0x00, 0x9e, 0xdd, 0xb5,
+	0xbb, 0x8f, 0x6a, 0xa9, 0x4d, 0xa0, 0x96, 0xe0, 0x50, 0x9d, 0x88, 0x03, 0x32, 0x0a, 0x12, 0x1b,
+	0x49, 0x9d, 0x10, 0x76, 0xff, 0x28, 0x10, 0x45, 0x04, 0xbc, 0x4e, 0x34, 0x0e, 0x04, 0x65, 0xc8,
+	0xde, 0x56, 0x4a, 0x39, 0x94, 0x55, 0x87, 0xb2, 0x96, 0x36, 0xdc, 0x6a, 0x68, 0xc3, 0x6a, 0xe3,
+	0x73, 0x01, 0xed, 0xe2, 0x32, 0xec, 0x1a, 0x85, 0x12, 0xb9, 0xf8, 0x21, 0x35, 0x1d, 0x4a, 0x1e,
+	0x0e, 0xc5, 0xb2, 0x6a, 0x3c, 0x63, 0x53, 0x8e, 0x3d, 0xa0, 0x1e, 0xa9, 0xab, 0xfd, 0xd6, 0x3d,
+	0xca, 0x78, 0x55, 0xd5, 0x04, 0x8a, 0x81, 0xbd, 0x7c, 0xfd, 0x50, 0x4a, 0x4d, 0x87, 0x52, 0x78,
+	0x4d, 0xd4, 0xc0, 0x81, 0xbb, 0x41, 0x63, 0x24, 0x97, 0x4e, 0xab, 0x89, 0x6c, 0xbf, 0xa5, 0x19,
+	0xb1, 0x34, 0xb0, 0x70, 0x71, 0x49, 0x0f, 0x79, 0xc0, 0xd5, 0x69, 0xbf, 0x64, 0x16, 0x03, 0xee,
+	0x04, 0x0d, 0xc8, 0x8f, 0xcc, 0x64, 0x95, 0x78, 0x8a, 0x0a, 0x15, 0xbe, 0xfe, 0x68, 0x39, 0xf2,
+	0x6b, 0x50, 0x17, 0x90, 0x5a, 0x0b, 0xa5, 0x91, 0x6b, 0xaa, 0x1e, 0x51, 0x06, 0x05, 0x0b, 0x5b,
+	0xb6, 0x7e, 0xc2, 0xef, 0x83, 0xfa, 0xd4, 0xa0, 0x92, 0x3d, 0x9c, 0x4c, 0xc7, 0xa9, 0xd5, 0xc6,
+	0x2c, 0xb0, 0xa4, 0x9f, 0x02, 0x59, 0xe6, 0x37, 0x31, 0x65, 0xf3, 0x17, 0xdc, 0x06, 0x6a, 0x94,
+	0x54, 0x2a, 0x16, 0xa5, 0x21, 0x19, 0xaa, 0x2c, 0x10, 0x88, 0xb8, 0x5c, 0x4a, 0xa5, 0x06, 0x63,
+	0x11, 0xfc, 0x8d, 0x28, 0x96, 0xbd, 0x49, 0x31, 0x7c, 0x82, 0x9a, 0x1a, 0x72, 0xf3, 0x52, 0x80,
+	0xf3, 0x85, 0x18, 0x45, 0x4c, 0xd8, 0x80, 0x98, 0x1b, 0x4b, 0x69, 0x59, 0xd7, 0x16, 0x86, 0xff,
+	0x97, 0x0f, 0xac, 0xc5, 0x56, 0x20, 0x99, 0x8b, 0xee, 0x55, 0xb2, 0xb1, 0x23, 0xaa, 0x81, 0xc5,
+	0x2e, 0x1a, 0x6d, 0x65, 0xde, 0x1d, 0x61, 0x2f, 0xb2, 0x86, 0x9d, 0xbf, 0x3a, 0x8e, 0x56, 0x09,
+	0x70, 0x9e, 0xcd, 0x7a, 0x93, 0xcc, 0xfb, 0xda, 0xc1, 0xbf, 0xaf, 0x2a, 0x63, 0xd9, 0xa0, 0x4d,
+	0x6c, 0x22, 0xab, 0x88, 0xd4, 0x9e, 0xb1, 0x58, 0xdc, 0x8b, 0xea, 0x7a, 0x5a, 0x93, 0x7a, 0xc1,
+	0x6e, 0xf1, 0x7e, 0x62, 0x92, 0xa6, 0x27, 0xdf, 0xd4, 0x4f, 0x7d, 0x58, 0x38, 0xf3, 0xa1, 0xd1,
+	0x4b, 0x7d, 0xfc, 0xea, 0xcc, 0xc4, 0xcf, 0xf5, 0xc9, 0x4f, 0xe8, 0x16, 0xfe, 0xd5, 0xee, 0x83,
+	0x31, 0xf6, 0xcf, 0xff, 0x17, 0x1f, 0x08, 0x17, 0x1b, 0xee, 0x3c, 0xdd, 0x8b, 0x27, 0xe9, 0xdb,
+	0xa8, 0xc2, 0x69, 0x9c, 0x15, 0xf6, 0xb7, 0x41, 0x25, 0x89, 0xbb, 0xde, 0xcd, 0xc5, 0xe3, 0xc0,
+	0x25, 0x70, 0x23, 0xa8, 0x8e, 0x67, 0x06, 0x98, 0xcb, 0x58, 0xdc, 0x70, 0x05, 0x84, 0x23, 0xa3,
+	0x3f, 0xe1, 0xbf, 0xad, 0x01, 0x6b, 0x76, 0xab, 0xd9, 0x03, 0x19, 0x35, 0xbd, 0x2f, 0xa5, 0xa6,
+	0x31, 0xc3, 0x20, 0x5f, 0x89, 0x7d, 0x7f, 0xcf, 0x00, 0x7f, 0x76, 0x38, 0x45, 0xc6, 0xd3, 0x40,
+	0xd6, 0xb2, 0x31, 0x40, 0x14, 0xa9, 0xf3, 0xf1, 0xd9, 0x2b, 0x85, 0xc9, 0x4b, 0x28, 0x51, 0x87,
+	0xd3, 0xa2, 0x34, 0x3e, 0xfc, 0x7a, 0xe4, 0x28, 0xb5, 0xc7, 0x5f, 0x8f, 0x1c, 0xb5, 0x8e, 0x2b,
+	0xe2, 0x9a, 0x48, 0x12, 0xd9, 0xb8, 0xb3, 0xca, 0xb2, 0x4b, 0x2c, 0x5c, 0x5c, 0x62, 0xc5, 0x9d,
+	0x68, 0x73, 0xe7, 0xcd, 0xd7, 0x66, 0x2f, 0x5c, 0xe7, 0xbf, 0xda, 0xf1, 0x63, 0xd6, 0x55, 0x20,
+	0xa3, 0x46, 0x77, 0xfa, 0x8b, 0x0f, 0xd3, 0x84, 0x75, 0xf4, 0xf6, 0xd4, 0x18, 0xf9, 0x59, 0x98,
+	0xbc, 0xf4, 0xf5, 0xc8, 0x51, 0x36, 0xd1, 0x53, 0x98, 0xbc, 0x44, 0x48, 0x7a, 0x38, 0x11, 0x32,
+	0xeb, 0x44, 0x98, 0x1f, 0x12, 0xda, 0x2c, 0xae, 0x63, 0x89, 0xa0, 0x7e, 0xb9, 0xd3, 0x74, 0x3d,
+	0xa2, 0xb9, 0xdf, 0x79, 0xaf, 0x3b, 0x3e, 0x1d, 0xb4, 0xde, 0x16, 0x9d, 0xe5, 0x12, 0xb1, 0x21,
+	0x36, 0xef, 0x69, 0x10, 0x75, 0x0d, 0xcd, 0xfa, 0xec, 0x57, 0xba, 0xe3, 0x2d, 0xd3, 0x06, 0x4c,
+	0x5c, 0x6f, 0x0b, 0xcd, 0xdc, 0x69, 0x5b, 0x71, 0xd9, 0x82, 0x45, 0x12, 0x5d, 0xbb, 0x34, 0xa9,
+	0x1b, 0xec, 0x10, 0xda, 0xdc, 0x38, 0xce, 0x70, 0xeb, 0xd7, 0x92, 0xda, 0xdd, 0x3d, 0xfb, 0x0b,
+	0x67, 0x2e, 0xe7, 0x8f, 0xfd, 0x96, 0x75, 0x73, 0xa9, 0x7f, 0xff, 0x3f, 0xab, 0x40, 0xc8, 0x9b,
+	0x69, 0xef, 0xa6, 0x93, 0x5f, 0x5d, 0x6a, 0xc1, 0xa8, 0x9f, 0xca, 0x3a, 0x89, 0x78, 0x57, 0x38,
+	0x9c, 0xfc, 0xfd, 0xf8, 0x1b, 0x7c, 0xf4, 0x8e, 0x78, 0x2f, 0x0f, 0x9f, 0xbb, 0x3a, 0xf6, 0x45,
+	0x4d, 0x7a, 0x01, 0xfc, 0x48, 0x58, 0xe5, 0x36, 0x21, 0xa6, 0x4f, 0x5e, 0x74, 0x52, 0x8b, 0x3b,
+	0xe7, 0xbf, 0xf7, 0x83, 0x75, 0xcc, 0xa5, 0x90, 0xc6, 0x8e, 0x2c, 0x35, 0x92, 0x8c, 0xc7, 0xd5,
+	0x44, 0x54, 0x56, 0x33, 0xb9, 0x41, 0xa4, 0x2a, 0xee, 0xc5, 0x3b, 0xe3, 0xe7, 0xbd, 0x37, 0x60,
+	0x41, 0xaf, 0x94, 0xad, 0xc0, 0xde, 0x80, 0x05, 0xbf, 0x88, 0xda, 0x08, 0xf2, 0xca, 0x7a, 0xf5,
+	0x62, 0x98, 0xb0, 0x97, 0xd1, 0xc5, 0x50, 0x9a, 0x60, 0xe6, 0x4f, 0x5d, 0x9e, 0x39, 0x79, 0x5a,
+	0x1f, 0x99, 0x4a, 0xab, 0x7f, 0x1a, 0xbe, 0x55, 0x55, 0x0e, 0x1f, 0x65, 0x52, 0xdf, 0xea, 0x5a,
+	0xcb, 0x8b, 0x5c, 0xcc, 0xfd, 0x90, 0xeb, 0xde, 0x4d, 0xf7, 0x9e, 0x96, 0x77, 0xdb, 0x2a, 0x15,
+	0xce, 0xb2, 0x06, 0x6e, 0xac, 0xbb, 0x10, 0x62, 0x74, 0x0a, 0x4b, 0x08, 0xe7, 0x57, 0x7e, 0xd0,
+	0x5e, 0x9c, 0xf0, 0xdc, 0x97, 0x74, 0xcb, 0x14, 0xcb, 0x1d, 0x4e, 0xb1, 0xbc, 0xc3, 0x65, 0x95,
+	0xea, 0x4a, 0x2c, 0xab, 0xf8, 0xe7, 0xb3, 0xac, 0x72, 0x00, 0x54, 0x47, 0x52, 0x39, 0x9a, 0x1a,
+	0xdc, 0x58, 0x16, 0x17, 0xf4, 0x24, 0x69, 0x60, 0xdc, 0xbd, 0x44, 0x93, 0x5a, 0xf1, 0x39, 0x50,
+	0xa2, 0x4e, 0x05, 0x44, 0x4a, 0x46, 0x7f, 0xa0, 0x02, 0x6a, 0xe3, 0xc6, 0x55, 0x95, 0xf3, 0xa1,
+	0x8c, 0xaf, 0x2d, 0x6b, 0x22, 0x24, 0x28, 0x71, 0x4a, 0x50, 0xa6, 0xff, 0xe1, 0x7e, 0xd0, 0x1a,
+	0xcd, 0xee, 0x3a, 0xa2, 0x26, 0xb0, 0x27, 0xb0, 0x1f, 0x07, 0x24, 0x64, 0x4d, 0x15, 0xc7, 0x47,
+	0x8e, 0x42, 0xd1, 0x01, 0x91, 0x1d, 0x90, 0xf0, 0x7f, 0xf5, 0x81, 0xb5, 0x25, 0x7b, 0x87, 0xd6,
+	0x93, 0x22, 0xc6, 0x43, 0xc0, 0x67, 0x3a, 0x9c, 0x82, 0x05, 0x15, 0x9b, 0xf4, 0x2b, 0x5f, 0xea,
+	0xef, 0x5c, 0x36, 0x5e, 0xbd, 0x59, 0x80, 0x0e, 0xd9, 0xc4, 0x95, 0xa1, 0xe7, 0x94, 0x41, 0xcc,
+	0x39, 0x3e, 0x7c, 0xc8, 0x46, 0xa0, 0x20, 0xb1, 0x81, 0x6e, 0x9b, 0x1b, 0x99, 0x92, 0x29, 0x08,
+	0xae, 0x01, 0xd5, 0xa9, 0xed, 0x9b, 0xe8, 0xc9, 0x9d, 0x66, 0x4d, 0x02, 0x02, 0x7a, 0x16, 0xd1,
+	0x1f, 0x19, 0xfd, 0x21, 0x08, 0xdb, 0x03, 0x7e, 0x0e, 0x61, 0x3b, 0x42, 0xd8, 0x8e, 0x10, 0xb6,
+	0x87, 0xff, 0xae, 0x0a, 0x87, 0x2a, 0xc6, 0xb0, 0xf6, 0xa5, 0x63, 0x03, 0xb1, 0x84, 0x39, 0xb8,
+	0x8a, 0xea, 0x9e, 0x32, 0x9d, 0x86, 0x17, 0x38, 0xdd, 0xb3, 0xc1, 0x95, 0x37, 0x5c, 0xba, 0x59,
+	0xde, 0x85, 0xf2, 0xe4, 0xcb, 0x31, 0x42, 0xe9, 0x21, 0xcf, 0x47, 0xeb, 0x7c, 0xe1, 0x03, 0xed,
+	0xae, 0x54, 0x4b, 0x32, 0x07, 0xcf, 0x11, 0x0c, 0xab, 0xb0, 0xcc, 0xd1, 0x09, 0xea, 0xd2, 0xdc,
+	0xe6, 0x70, 0x7c, 0x71, 0x98, 0x01, 0x13, 0x8c, 0x1f, 0xb2, 0xf1, 0x03, 0x3e, 0x00, 0x6a, 0x06,
+	0x99, 0xb3, 0xd5, 0x38, 0xd7, 0x49, 0x20, 0x02, 0xf9, 0x27, 0x93, 0x7f, 0xe1, 0x4f, 0xfd, 0xa0,
+	0xad, 0xc8, 0x74, 0x2c, 0x98, 0x0b, 0x73, 0xcf, 0xeb, 0xca, 0x39, 0x1c, 0xd3, 0x92, 0x59, 0x5d,
+	0xf9, 0x50, 0x69, 0xae, 0xe5, 0x15, 0x25, 0xd6, 0x8e, 0x8c, 0xb6, 0x24, 0x8a, 0xf2, 0x45, 0x9b,
+	0xa2, 0x9c, 0x13, 0xd9, 0x32, 0xb4, 0x24, 0xb7, 0xe5, 0xa0, 0xae, 0xec, 0x2d, 0x07, 0xe1, 0x6f,
+	0xfc, 0x25, 0x95, 0xc6, 0x7c, 0x1c, 0xdf, 0xbb, 0xbe, 0x69, 0xea, 0x0e, 0x1c, 0xdf, 0x3b, 0xd9,
+	0x14, 0x5b, 0x81, 0x6f, 0x29, 0x2c, 0xfc, 0x17, 0x58, 0x3c, 0x78, 0xc5, 0x62, 0x10, 0x86, 0x6b,
+	0x18, 0x5e, 0x31, 0xbe, 0x2f, 0x59, 0x86, 0x2b, 0x5c, 0x9e, 0x53, 0x1d, 0x1e, 0xad, 0x07, 0x10,
+	0x65, 0x9b, 0x92, 0x19, 0xee, 0xdb, 0xeb, 0x3f, 0x70, 0x6e, 0x9f, 0x59, 0xeb, 0xce, 0xcb, 0x88,
+	0xdb, 0x6a, 0xd3, 0xfe, 0x40, 0xb4, 0xd5, 0xc7, 0x74, 0x14, 0x26, 0x9d, 0xfb, 0x68, 0x9e, 0xd1,
+	0xa4, 0x2d, 0x2c, 0xbb, 0x3e, 0x68, 0xb0, 0x2b, 0xb7, 0xa5, 0x0b, 0x13, 0x9d, 0xbe, 0xf6, 0x86,
+	0xfe, 0xda, 0xe5, 0xd9, 0x9f, 0x5f, 0x26, 0x28, 0x6e, 0x3c, 0x21, 0xac, 0x2b, 0xf2, 0x61, 0xa1,
+	0xea, 0x3b, 0xe3, 0x63, 0xa1, 0x28, 0x1f, 0xfb, 0xe7, 0xcd, 0xc7, 0x42, 0x69, 0x3e, 0xae, 0xb9,
+	0x03, 0x3e, 0x16, 0xec, 0x7c, 0x3c, 0xe1, 0x03, 0x20, 0x79, 0x08, 0xbd, 0x11, 0xdc, 0x71, 0x92,
+	0x43, 0x1e, 0xf7, 0x69, 0xd2, 0x8b, 0x62, 0x7f, 0xfe, 0xd8, 0xe9, 0xfc, 0xf9, 0xbf, 0x41, 0x97,
+	0x6a, 0xbe, 0x79, 0xc2, 0xdc, 0xb2, 0x48, 0xdf, 0xdf, 0xed, 0xa9, 0x31, 0x3a, 0xb1, 0xb7, 0xa7,
+	0xc6, 0xcc, 0x89, 0xb8, 0x3d, 0x35, 0x66, 0x50, 0x67, 0xdf, 0x18, 0xad, 0x23, 0x30, 0x2d, 0x7d,
+	0xd3, 0xfd, 0x50, 0xfa, 0x41, 0x73, 0x13, 0xb3, 0x6c, 0x5c, 0x05, 0xcb, 0x4c, 0xaa, 0x6c, 0x9e,
+	0x01, 0x93, 0xbf, 0x23, 0x33, 0x35, 0xe1, 0x41, 0xe7, 0xa7, 0x89, 0xd0, 0xa6, 0x3b, 0x76, 0xff,
+	0x50, 0x67, 0x39, 0x5b, 0x03, 0xf3, 0xe7, 0x47, 0x66, 0x6e, 0xbe, 0xf9, 0xc8, 0x26, 0xfd, 0xe2,
+	0x2f, 0x17, 0xf8, 0x2b, 0xb9, 0xf0, 0xfb, 0x54, 0x5b, 0x34, 0x60, 0xf9, 0x17, 0x70, 0x52, 0x85,
+	0xe8, 0x09, 0x94, 0xf6, 0xba, 0x3a, 0xa5, 0x8f, 0xa2, 0xbd, 0x9f, 0xfa, 0xcd, 0x1b, 0x85, 0xb3,
+	0x1f, 0xbb, 0x7d, 0xb9, 0x71, 0x27, 0xd5, 0x1f, 0x00, 0xd7, 0xdf, 0x84, 0xce, 0x9d, 0x63, 0x80,
+	0xd8, 0xce, 0xea, 0x0f, 0x27, 0x2d, 0xa2, 0x51, 0xa2, 0x34, 0x94, 0xa6, 0x5b, 0x13, 0x5d, 0x84,
+	0xd9, 0x58, 0x2b, 0x25, 0x5b, 0x5c, 0xc8, 0x7b, 0x37, 0xbf, 0x63, 0x62, 0x99, 0xa1, 0x9b, 0x55,
+	0x60, 0x09, 0x57, 0xf9, 0x2e, 0xa4, 0xb8, 0x76, 0x72, 0xde, 0xea, 0x62, 0xfe, 0x6a, 0xe4, 0x64,
+	0x86, 0x8f, 0x87, 0xd9, 0xf1, 0xd0, 0x3b, 0xe1, 0x3c, 0xbf, 0xb6, 0xef, 0xbe, 0x1a, 0x4e, 0x33,
+	0xf7, 0x82, 0xdb, 0xb8, 0x5d, 0x67, 0xad, 0xb8, 0xc7, 0xfa, 0x0f, 0x55, 0x60, 0xf9, 0xae, 0xa1,
+	0x54, 0x32, 0x5d, 0xce, 0x24, 0x2e, 0xdc, 0xc6, 0xf2, 0xc7, 0x99, 0x0d, 0x9b, 0xe4, 0x1e, 0x03,
+	0xb1, 0xbd, 0x67, 0xff, 0x73, 0x64, 0x63, 0x0c, 0xda, 0xc9, 0xc3, 0x8c, 0x28, 0x7f, 0xe2, 0x63,
+	0x74, 0x2e, 0x9d, 0x71, 0xea, 0x61, 0x17, 0xa8, 0x3f, 0x1c, 0x1b, 0x54, 0x19, 0xab, 0x8a, 0x17,
+	0x43, 0x5a, 0x08, 0x6a, 0xfe, 0xad, 0xd1, 0xe9, 0xeb, 0x5f, 0xe8, 0xa7, 0x4f, 0x0a, 0x26, 0x96,
+	0x6c, 0xfe, 0xea, 0x3a, 0xa0, 0x49, 0x32, 0xe8, 0xf7, 0xfe, 0xc8, 0xb1, 0xfb, 0xec, 0x88, 0xed,
+	0x84, 0xb3, 0x9d, 0x1d, 0xa3, 0x9f, 0x80, 0xd1, 0x9a, 0x81, 0x1f, 0x55, 0xf2, 0xda, 0xd5, 0x69,
+	0xd9, 0x22, 0x77, 0xb3, 0x89, 0x8e, 0x2d, 0x39, 0x57, 0x02, 0xc8, 0xa5, 0x13, 0xa4, 0xe6, 0xcc,
+	0x1b, 0x9f, 0xe5, 0xdf, 0x1a, 0x45, 0xc3, 0xf2, 0x3c, 0xf7, 0x6a, 0xdb, 0x68, 0x58, 0x5d, 0x74,
+	0xa3, 0xa1, 0xed, 0xce, 0x6f, 0x6e, 0x57, 0xa9, 0xdf, 0xb1, 0xab, 0x94, 0x4b, 0x8f, 0x78, 0xba,
+	0xf4, 0x35, 0x95, 0x70, 0xe9, 0x6b, 0x2b, 0xe1, 0xd2, 0xd7, 0xcd, 0x27, 0xfd, 0xd1, 0xc3, 0xd9,
+	0x22, 0xe6, 0x2b, 0x16, 0xd0, 0x69, 0x8a, 0x58, 0x63, 0xc2, 0x99, 0x87, 0x1d, 0xd4, 0x3c, 0xec,
+	0x54, 0xb2, 0xc6, 0x05, 0xd2, 0x64, 0xd3, 0x46, 0xe1, 0xfa, 0x87, 0x33, 0x57, 0x2e, 0x18, 0x96,
+	0xe1, 0xe3, 0xfc, 0xf9, 0x0f, 0x05, 0x0b, 0x53, 0xb6, 0x7e, 0x22, 0xc9, 0x52, 0x13, 0x51, 0x5c,
+	0x1f, 0x30, 0x92, 0x45, 0x61, 0x06, 0x21, 0xc3, 0x0e, 0x20, 0x42, 0xb2, 0x51, 0x08, 0x0f, 0x82,
+	0x25, 0xd6, 0x5d, 0xb6, 0x3d, 0xc9, 0xb4, 0xba, 0x27, 0x99, 0x4b, 0x93, 0x95, 0x75, 0x1f, 0xd9,
+	0xd6, 0xe9, 0x56, 0x2e, 0x2e, 0xee, 0xe9, 0x3f, 0x60, 0xba, 0x68, 0x1f, 0x5d, 0xcb, 0x9f, 0xfb,
+	0x42, 0x76, 0xc3, 0x83, 0x32, 0x68, 0x8d, 0xa4, 0x72, 0x07, 0x32, 0x6a, 0xd4, 0xa2, 0xdd, 0x84,
+	0x69, 0xe3, 0x5d, 0x00, 0x8e, 0x42, 0xb1, 0xc5, 0x3c, 0x65, 0x4a, 0xc9, 0x3a, 0x50, 0xe0, 0x61,
+	0xb0, 0x8c, 0x3b, 0x8d, 0xbc, 0x3b, 0xd6, 0x4d, 0x08, 0x37, 0x63, 0xc2, 0x9b, 0x34, 0xa9, 0x43,
+	0x70, 0xc7, 0x10, 0x97, 0x92, 0x43, 0x7c, 0xb4, 0xe3, 0xa1, 0xdd, 0xb1, 0x6e, 0xd4, 0x84, 0x3b,
+	0x32, 0x7c, 0x01, 0x40, 0xe3, 0x5c, 0xb1, 0x1a, 0x35, 0x1b, 0x59, 0x64, 0xcd, 0x8c, 0x4b, 0xb1,
+	0x08, 0xd9, 0xf3, 0xa1, 0x94, 0xbe, 0x0b, 0x1e, 0xec, 0x03, 0x8d, 0x69, 0x63, 0xb2, 0x32, 0xe4,
+	0x83, 0x18, 0x84, 0xaa, 0xb8, 0x32, 0x3f, 0x76, 0x9c, 0x16, 0xa1, 0xb7, 0x76, 0xe5, 0x5c, 0xe1,
+	0xdd, 0x57, 0x09, 0x3b, 0x09, 0x6c, 0x15, 0x99, 0x7d, 0x80, 0xbd, 0xa0, 0x3e, 0x87, 0x27, 0x29,
+	0x93, 0xc5, 0xe7, 0xfe, 0x7d, 0xdd, 0xe8, 0xc2, 0x37, 0xc1, 0x04, 0x8a, 0xed, 0xf9, 0xb1, 0xe3,
+	0xfa, 0x95, 0x0f, 0x66, 0xdf, 0x79, 0x8d, 0x74, 0xcd, 0x46, 0x5a, 0x36, 0x31, 0xe1, 0x9f, 0x80,
+	0xfa, 0x58, 0x74, 0x50, 0xc5, 0xa4, 0x16, 0x63, 0x52, 0x3b, 0x34, 0xe9, 0x71, 0xc1, 0x04, 0x8a,
+	0x9b, 0x0b, 0x67, 0x7e, 0x8d, 0x8c, 0xed, 0xf9, 0x5f, 0x11, 0x52, 0x26, 0x91, 0xdb, 0x53, 0x63,
+	0x4c, 0x9f, 0x42, 0x1d, 0x21, 0x83, 0xa6, 0x6c, 0x56, 0x86, 0x3f, 0x24, 0x57, 0xdf, 0x93, 0xb9,
+	0x84, 0x98, 0xfc, 0x76, 0x4d, 0xda, 0x22, 0x3e, 0x48, 0xef, 0x76, 0x3f, 0x8f, 0x9c, 0x01, 0xe4,
+	0x9a, 0x5c, 0x7f, 0x8d, 0x2a, 0x2a, 0x5d, 0x33, 0x3c, 0x37, 0x2a, 0x48, 0x16, 0x01, 0xd9, 0xfa,
+	0x09, 0x0f, 0x83, 0x7a, 0x72, 0x49, 0x7e, 0x86, 0x9c, 0xa2, 0xf5, 0x75, 0xa3, 0x2f, 0x76, 0x09,
+	0x26, 0x50, 0x7c, 0x2c, 0x3f, 0x76, 0xdc, 0x6c, 0x44, 0xff, 0xe0, 0x0d, 0xdb, 0x0c, 0x14, 0x6d,
+	0x53, 0x36, 0xc9, 0xc0, 0x67, 0x40, 0xfd, 0x40, 0x2a, 0x47, 0xfa, 0xbf, 0x14, 0xb7, 0x83, 0x6f,
+	0x49, 0x34, 0x81, 0xe2, 0xfd, 0xbb, 0xfb, 0x0f, 0xe8, 0x27, 0x2f, 0x94, 0x1a, 0x86, 0x6c, 0xd6,
+	0x80, 0x7b, 0x41, 0xdd, 0x40, 0x2a, 0x87, 0x7b, 0xbe, 0x0c, 0x53, 0x44, 0xab, 0xa5, 0x82, 0x01,
+	0xc3, 0x04, 0xcb, 0xe9, 0xa3, 0x51, 0x01, 0xed, 0x1b, 0x20, 0x6b, 0xf9, 0x91, 0xe1, 0xc0, 0x72,
+	0xf6, 0xd2, 0x67, 0x0a, 0x14, 0x9b, 0x66, 0x3e, 0xbf, 0xa4, 0x5f, 0x3b, 0xaa, 0x9f, 0x3c, 0x3b,
+	0x7d, 0xe3, 0xa4, 0x6c, 0xc1, 0xff, 0xb1, 0x1d, 0x34, 0xa2, 0xe5, 0xaf, 0x3e, 0xe2, 0x9f, 0xc0,
+	0x9b, 0x3e, 0xb0, 0x78, 0xb7, 0x9a, 0x95, 0x06, 0x07, 0xe9, 0xf7, 0x97, 0x90, 0x89, 0x83, 0xeb,
+	0xed, 0x5b, 0x40, 0xf9, 0x72, 0x2a, 0x46, 0xc1, 0xfb, 0x4b, 0xa1, 0xd1, 0x83, 0x0e, 0x2f, 0x68,
+	0xd2, 0xb6, 0x20, 0x5d, 0x67, 0xcb, 0x1f, 0x1f, 0xc9, 0x9f, 0x3f, 0x5e, 0x78, 0xf7, 0x55, 0x6a,
+	0xc2, 0x98, 0x1d, 0xcc, 0x70, 0x09, 0x41, 0x31, 0x46, 0x8f, 0xbd, 0xa3, 0x57, 0xbe, 0x9a, 0xfe,
+	0x45, 0x55, 0x10, 0x06, 0x3a, 0x99, 0xa6, 0x3a, 0x8f, 0x6c, 0xee, 0xa4, 0x48, 0x19, 0x78, 0xcd,
+	0x07, 0x16, 0xa1, 0x1d, 0x8d, 0xd4, 0x6c, 0x26, 0x0e, 0x27, 0x61, 0xd8, 0xb1, 0x83, 0xd5, 0x2a,
+	0x34, 0xfa, 0x7e, 0x5f, 0x51, 0x1c, 0xda, 0xf1, 0x83, 0x9a, 0xf4, 0x58, 0x50, 0xa0, 0x1d, 0x1f,
+	0x1b, 0xd5, 0xaf, 0xbc, 0x6b, 0x5a, 0x67, 0xf7, 0x11, 0x34, 0x73, 0x23, 0xc0, 0x7d, 0x5f, 0x09,
+	0x57, 0x78, 0xf4, 0x1d, 0x5e, 0x37, 0x27, 0x9f, 0xd9, 0x2c, 0xe8, 0xec, 0x3d, 0xb7, 0x93, 0xd0,
+	0xa3, 0xf7, 0x2e, 0xbb, 0x0d, 0x51, 0xef, 0xbb, 0x82, 0xeb, 0xd8, 0x69, 0xa7, 0x5f, 0xa9, 0x67,
+	0x7a, 0x4c, 0x26, 0x19, 0xd2, 0x75, 0x69, 0xca, 0x6d, 0xa1, 0xc1, 0x58, 0x26, 0xeb, 0x35, 0xf1,
+	0x14, 0x27, 0x03, 0xff, 0xc1, 0x07, 0x56, 0xf0, 0x2d, 0x77, 0x0f, 0xd3, 0x49, 0xac, 0xdc, 0x18,
+	0xd2, 0x9a, 0xf4, 0x44, 0x70, 0x93, 0xe7, 0x1b, 0x98, 0xeb, 0x78, 0xee, 0x83, 0x6b, 0xbd, 0x18,
+	0xc9, 0x1a, 0xd8, 0xef, 0x09, 0x47, 0x31, 0x5f, 0xfb, 0xf7, 0x1c, 0x4f, 0x51, 0x8e, 0xe2, 0x70,
+	0xe8, 0x78, 0x5e, 0x72, 0x70, 0x94, 0x81, 0xe5, 0x3e, 0x1e, 0x83, 0xa3, 0x28, 0x16, 0x1e, 0xc4,
+	0xfd, 0x70, 0x9d, 0xd7, 0x4b, 0xe9, 0xfc, 0x73, 0xd3, 0x0d, 0xfb, 0x0b, 0xf8, 0x2f, 0x3e, 0xb0,
+	0xd4, 0xed, 0x33, 0xe8, 0x70, 0x83, 0xbd, 0xa7, 0x5e, 0x5f, 0xa3, 0x0f, 0x3e, 0x58, 0x06, 0x26,
+	0x1d, 0xd9, 0x31, 0x9f, 0x26, 0xed, 0x85, 0x4b, 0x49, 0x77, 0x4d, 0x57, 0x0e, 0x4f, 0x7d, 0x70,
+	0x8b, 0xd7, 0x80, 0xb9, 0x4f, 0x3f, 0xe3, 0x61, 0xb3, 0x69, 0x7b, 0x3c, 0xda, 0xcd, 0xb0, 0xb3,
+	0x9c, 0xd1, 0x76, 0x9a, 0x4d, 0x66, 0xe0, 0xff, 0xf1, 0x81, 0x56, 0x7b, 0x5f, 0xe1, 0xba, 0xa2,
+	0x43, 0x31, 0x06, 0xbc, 0xbe, 0x04, 0x16, 0x1d, 0xec, 0x59, 0x9f, 0x26, 0xed, 0x0b, 0x7e, 0xcf,
+	0x75, 0x58, 0xba, 0x66, 0x65, 0x1f, 0xcc, 0x77, 0xea, 0xfc, 0x54, 0x36, 0x6c, 0xb1, 0x4d, 0x15,
+	0x1e, 0xed, 0xe3, 0xf0, 0xb1, 0x39, 0x8e, 0xb6, 0xf3, 0xcf, 0xcd, 0xdf, 0x7f, 0x01, 0xff, 0xe0,
+	0x03, 0x4b, 0x98, 0x0c, 0x9a, 0xf9, 0xc6, 0x1f, 0xb0, 0x0f, 0xcb, 0xe3, 0x9b, 0xc4, 0xc1, 0x0d,
+	0xa5, 0x11, 0xe9, 0x14, 0xfc, 0xdc, 0xa7, 0x49, 0x3f, 0x09, 0xee, 0x71, 0x9d, 0x02, 0xdb, 0x6e,
+	0xa2, 0xaf, 0x47, 0x8e, 0x12, 0x0f, 0x1d, 0x99, 0x62, 0xe6, 0xd6, 0x1c, 0xf6, 0x5d, 0x1b, 0xca,
+	0xdf, 0xcc, 0x0e, 0x9a, 0x32, 0xdb, 0x06, 0x57, 0xda, 0xa7, 0xc4, 0x40, 0xca, 0xc0, 0xff, 0xe1,
+	0x03, 0x2d, 0xb6, 0x5e, 0xc2, 0xfb, 0x8a, 0x8d, 0xc1, 0x18, 0xe8, 0xba, 0xe2, 0x48, 0x74, 0x90,
+	0x23, 0x3e, 0x4d, 0x3a, 0x18, 0x7c, 0x6a, 0x4e, 0x83, 0xd4, 0xb5, 0x31, 0x12, 0x4a, 0xd8, 0x46,
+	0x4b, 0xc7, 0xb9, 0x88, 0x1f, 0xa7, 0x97, 0x9a, 0x35, 0xca, 0xe1, 0xdf, 0x13, 0x29, 0x76, 0x9c,
+	0x76, 0x76, 0x4a, 0xb1, 0xd7, 0x11, 0xf3, 0xe0, 0x83, 0x65, 0x60, 0xd2, 0x01, 0xff, 0x44, 0x93,
+	0xb6, 0x04, 0x57, 0x7a, 0x9e, 0x9f, 0x86, 0xde, 0x45, 0x78, 0x20, 0x21, 0xd8, 0xee, 0xd0, 0xaf,
+	0xc9, 0xa8, 0x62, 0xa2, 0x66, 0xe0, 0x4d, 0x22, 0x9b, 0x5c, 0x0f, 0x9c, 0xb2, 0xe9, 0x76, 0x4e,
+	0x35, 0xb8, 0xbe, 0x04, 0x16, 0x1d, 0xc2, 0x8b, 0x9a, 0xb4, 0x05, 0xae, 0x64, 0xcf, 0xb4, 0x72,
+	0xbd, 0x0d, 0x7a, 0x17, 0xe1, 0x21, 0xac, 0x81, 0xab, 0x8b, 0x0e, 0x01, 0xfe, 0x07, 0xc2, 0x72,
+	0xec, 0x11, 0x0a, 0xa7, 0x7c, 0x79, 0x9c, 0xcc, 0x08, 0x6e, 0x28, 0x8d, 0x68, 0x0d, 0xe3, 0xd1,
+	0x20, 0x4d, 0xe7, 0x14, 0xae, 0x9c, 0xd3, 0x4f, 0xbc, 0xc7, 0xee, 0x4c, 0x82, 0x9e, 0x25, 0x78,
+	0x10, 0x6b, 0xc3, 0xab, 0xf8, 0x41, 0x88, 0x9d, 0x29, 0xd4, 0x50, 0x96, 0x34, 0xd4, 0xe5, 0x13,
+	0xe0, 0xff, 0xf6, 0x81, 0xa0, 0xf7, 0x8e, 0x4d, 0xb8, 0xd1, 0x69, 0xcd, 0x8a, 0xed, 0x64, 0x0d,
+	0x76, 0x96, 0x8d, 0x4f, 0xc7, 0x97, 0xd3, 0xa4, 0x9d, 0xc1, 0x50, 0xa9, 0xdd, 0xa7, 0xb0, 0x24,
+	0x06, 0x1e, 0xef, 0x86, 0xf0, 0x7d, 0xf6, 0xf1, 0x46, 0x50, 0xfb, 0x09, 0xdc, 0xbe, 0x21, 0x4b,
+	0x68, 0xd8, 0xff, 0xe4, 0x03, 0x01, 0xb7, 0xfd, 0x60, 0x78, 0xd0, 0x0f, 0xdb, 0x07, 0x51, 0x6c,
+	0xf3, 0x67, 0xb0, 0xa3, 0x4c, 0x6c, 0x3a, 0xe0, 0x18, 0x72, 0xc7, 0xda, 0x8a, 0xec, 0x36, 0x83,
+	0xc5, 0x0a, 0x89, 0xe5, 0x0f, 0xaf, 0xb5, 0x0f, 0x33, 0x97, 0x51, 0xd3, 0x49, 0xa3, 0x4d, 0x54,
+	0x86, 0x06, 0xf9, 0x35, 0x19, 0xa4, 0x63, 0x21, 0x05, 0xaf, 0xe4, 0x6e, 0xf6, 0xd2, 0x7c, 0x9e,
+	0xeb, 0x2d, 0xc1, 0xb9, 0x56, 0xc9, 0xa4, 0xc2, 0x8a, 0x26, 0xfd, 0x80, 0x17, 0x35, 0x6e, 0xb9,
+	0x07, 0x86, 0xd9, 0x22, 0xf7, 0x25, 0x21, 0x2f, 0xf7, 0x99, 0x22, 0xc1, 0x59, 0x1f, 0x68, 0x2f,
+	0xbe, 0x04, 0xe9, 0xe4, 0xe2, 0xe2, 0xeb, 0x95, 0xc1, 0x39, 0xe1, 0x67, 0x52, 0xe1, 0x01, 0x4d,
+	0xfa, 0x5e, 0x70, 0x55, 0xb1, 0xa1, 0xc0, 0xa2, 0xa5, 0x78, 0x88, 0x61, 0x18, 0xf2, 0xd2, 0xfe,
+	0xe6, 0x58, 0xff, 0xad, 0x0f, 0x34, 0x32, 0xf9, 0x5e, 0xb8, 0xc6, 0x21, 0x6e, 0x7c, 0xfa, 0x3c,
+	0x18, 0xf2, 0x46, 0xa0, 0xfc, 0x98, 0x40, 0x2e, 0xcc, 0xf6, 0xfc, 0xd8, 0x71, 0x12, 0xc7, 0xd8,
+	0xcc, 0x59, 0xfe, 0xd8, 0x5b, 0xfa, 0x6f, 0x3f, 0x9e, 0xbe, 0x71, 0x9e, 0x7c, 0x2d, 0xcb, 0x99,
+	0x56, 0x86, 0xd0, 0x09, 0xc3, 0xc3, 0x59, 0x0e, 0x97, 0x3a, 0x5c, 0x18, 0x14, 0xb3, 0xe6, 0xf1,
+	0xf5, 0xb7, 0x6c, 0x2a, 0xb5, 0xf4, 0x28, 0xee, 0xb3, 0xdd, 0x2c, 0xe5, 0x96, 0x88, 0x0d, 0x9f,
+	0xf4, 0x69, 0xd2, 0x00, 0x0c, 0x78, 0xa5, 0x63, 0x83, 0x4f, 0x95, 0x39, 0x46, 0x42, 0xa0, 0x78,
+	0xd2, 0x19, 0x8f, 0x70, 0x35, 0x6c, 0x73, 0x1b, 0x61, 0xa7, 0x8a, 0xfb, 0xd7, 0x1d, 0xd7, 0xa4,
+	0x27, 0xe0, 0x6a, 0xb0, 0x14, 0x69, 0x80, 0x10, 0x0d, 0xb4, 0x43, 0x52, 0x7f, 0x6f, 0x68, 0x67,
+	0x32, 0x22, 0xd6, 0x6c, 0xda, 0xb8, 0x79, 0xe3, 0x26, 0xc1, 0xe7, 0x13, 0x5b, 0x15, 0xeb, 0x20,
+	0x42, 0xe7, 0x4b, 0x99, 0x64, 0xa2, 0xcb, 0x01, 0xf9, 0x71, 0x18, 0xef, 0x7e, 0xed, 0x3c, 0x14,
+	0xc9, 0x74, 0xa0, 0x06, 0x3b, 0x68, 0x8b, 0x8f, 0x31, 0xad, 0x1f, 0xaa, 0xc5, 0x38, 0x8f, 0xfc,
+	0xbf, 0x01, 0x00, 0x59, 0xb8, 0x02, 0x2d, 0x71, 0x9b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
-var _ grpc.ClientConn
+var _ grpc.ClientConnInterface
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+const _ = grpc.SupportPackageIsVersion6
 
 // DataManagerClient is the client API for DataManager service.
 //
@@ -5014,13 +5559,15 @@ type DataManagerClient interface {
 	GetUserOperationDataList(ctx context.Context, in *GetUserOperationDataListRequest, opts ...grpc.CallOption) (*GetUserOperationDataListResponse, error)
 	GetWorkloadRequestResult(ctx context.Context, in *GetWorkloadRequestRecommendResultReq, opts ...grpc.CallOption) (*GetWorkloadRequestRecommendResultRsp, error)
 	GetWorkloadOriginRequestResult(ctx context.Context, in *GetWorkloadOriginRequestResultReq, opts ...grpc.CallOption) (*GetWorkloadOriginRequestResultRsp, error)
+	GetCostList(ctx context.Context, in *GetCostListRequest, opts ...grpc.CallOption) (*GetCostListResponse, error)
+	ExportCostList(ctx context.Context, in *GetCostListRequest, opts ...grpc.CallOption) (*ExportCostListResponse, error)
 }
 
 type dataManagerClient struct {
-	cc *grpc.ClientConn
+	cc grpc.ClientConnInterface
 }
 
-func NewDataManagerClient(cc *grpc.ClientConn) DataManagerClient {
+func NewDataManagerClient(cc grpc.ClientConnInterface) DataManagerClient {
 	return &dataManagerClient{cc}
 }
 
@@ -5168,6 +5715,24 @@ func (c *dataManagerClient) GetWorkloadOriginRequestResult(ctx context.Context,
 	return out, nil
 }
 
+func (c *dataManagerClient) GetCostList(ctx context.Context, in *GetCostListRequest, opts ...grpc.CallOption) (*GetCostListResponse, error) {
+	out := new(GetCostListResponse)
+	err := c.cc.Invoke(ctx, "/datamanager.DataManager/GetCostList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataManagerClient) ExportCostList(ctx context.Context, in *GetCostListRequest, opts ...grpc.CallOption) (*ExportCostListResponse, error) {
+	out := new(ExportCostListResponse)
+	err := c.cc.Invoke(ctx, "/datamanager.DataManager/ExportCostList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataManagerServer is the server API for DataManager service.
 type DataManagerServer interface {
 	GetAllProjectList(context.Context, *GetAllProjectListRequest) (*GetAllProjectListResponse, error)
@@ -5186,6 +5751,8 @@ type DataManagerServer interface {
 	GetUserOperationDataList(context.Context, *GetUserOperationDataListRequest) (*GetUserOperationDataListResponse, error)
 	GetWorkloadRequestResult(context.Context, *GetWorkloadRequestRecommendResultReq) (*GetWorkloadRequestRecommendResultRsp, error)
 	GetWorkloadOriginRequestResult(context.Context, *GetWorkloadOriginRequestResultReq) (*GetWorkloadOriginRequestResultRsp, error)
+	GetCostList(context.Context, *GetCostListRequest) (*GetCostListResponse, error)
+	ExportCostList(context.Context, *GetCostListRequest) (*ExportCostListResponse, error)
 }
 
 // UnimplementedDataManagerServer can be embedded to have forward compatible implementations.
@@ -5240,6 +5807,12 @@ func (*UnimplementedDataManagerServer) GetWorkloadRequestResult(ctx context.Cont
 func (*UnimplementedDataManagerServer) GetWorkloadOriginRequestResult(ctx context.Context, req *GetWorkloadOriginRequestResultReq) (*GetWorkloadOriginRequestResultRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkloadOriginRequestResult not implemented")
 }
+func (*UnimplementedDataManagerServer) GetCostList(ctx context.Context, req *GetCostListRequest) (*GetCostListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCostList not implemented")
+}
+func (*UnimplementedDataManagerServer) ExportCostList(ctx context.Context, req *GetCostListRequest) (*ExportCostListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCostList not implemented")
+}
 
 func RegisterDataManagerServer(s *grpc.Server, srv DataManagerServer) {
 	s.RegisterService(&_DataManager_serviceDesc, srv)
@@ -5533,6 +6106,42 @@ func _DataManager_GetWorkloadOriginRequestResult_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataManager_GetCostList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCostListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataManagerServer).GetCostList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datamanager.DataManager/GetCostList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataManagerServer).GetCostList(ctx, req.(*GetCostListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataManager_ExportCostList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCostListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataManagerServer).ExportCostList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datamanager.DataManager/ExportCostList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataManagerServer).ExportCostList(ctx, req.(*GetCostListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "datamanager.DataManager",
 	HandlerType: (*DataManagerServer)(nil),
@@ -5601,6 +6210,14 @@ var _DataManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkloadOriginRequestResult",
 			Handler:    _DataManager_GetWorkloadOriginRequestResult_Handler,
 		},
+		{
+			MethodName: "GetCostList",
+			Handler:    _DataManager_GetCostList_Handler,
+		},
+		{
+			MethodName: "ExportCostList",
+			Handler:    _DataManager_ExportCostList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/bcs-data-manager/bcs-data-manager.proto",
@@ -132,6 +132,18 @@ func NewDataManagerEndpoints() []*api.Endpoint {
 			Method:  []string{"GET"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "DataManager.GetCostList",
+			Path:    []string{"/datamanager/v1/cost"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "DataManager.ExportCostList",
+			Path:    []string{"/datamanager/v1/cost/export"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -154,6 +166,8 @@ type DataManagerService interface {
 	GetUserOperationDataList(ctx context.Context, in *GetUserOperationDataListRequest, opts ...client.CallOption) (*GetUserOperationDataListResponse, error)
 	GetWorkloadRequestResult(ctx context.Context, in *GetWorkloadRequestRecommendResultReq, opts ...client.CallOption) (*GetWorkloadRequestRecommendResultRsp, error)
 	GetWorkloadOriginRequestResult(ctx context.Context, in *GetWorkloadOriginRequestResultReq, opts ...client.CallOption) (*GetWorkloadOriginRequestResultRsp, error)
+	GetCostList(ctx context.Context, in *GetCostListRequest, opts ...client.CallOption) (*GetCostListResponse, error)
+	ExportCostList(ctx context.Context, in *GetCostListRequest, opts ...client.CallOption) (*ExportCostListResponse, error)
 }
 
 type dataManagerService struct {
@@ -328,6 +342,26 @@ func (c *dataManagerService) GetWorkloadOriginRequestResult(ctx context.Context,
 	return out, nil
 }
 
+func (c *dataManagerService) GetCostList(ctx context.Context, in *GetCostListRequest, opts ...client.CallOption) (*GetCostListResponse, error) {
+	req := c.c.NewRequest(c.name, "DataManager.GetCostList", in)
+	out := new(GetCostListResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataManagerService) ExportCostList(ctx context.Context, in *GetCostListRequest, opts ...client.CallOption) (*ExportCostListResponse, error) {
+	req := c.c.NewRequest(c.name, "DataManager.ExportCostList", in)
+	out := new(ExportCostListResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for DataManager service
 
 type DataManagerHandler interface {
@@ -347,6 +381,8 @@ type DataManagerHandler interface {
 	GetUserOperationDataList(context.Context, *GetUserOperationDataListRequest, *GetUserOperationDataListResponse) error
 	GetWorkloadRequestResult(context.Context, *GetWorkloadRequestRecommendResultReq, *GetWorkloadRequestRecommendResultRsp) error
 	GetWorkloadOriginRequestResult(context.Context, *GetWorkloadOriginRequestResultReq, *GetWorkloadOriginRequestResultRsp) error
+	GetCostList(context.Context, *GetCostListRequest, *GetCostListResponse) error
+	ExportCostList(context.Context, *GetCostListRequest, *ExportCostListResponse) error
 }
 
 func RegisterDataManagerHandler(s server.Server, hdlr DataManagerHandler, opts ...server.HandlerOption) error {
@@ -367,6 +403,8 @@ func RegisterDataManagerHandler(s server.Server, hdlr DataManagerHandler, opts .
 		GetUserOperationDataList(ctx context.Context, in *GetUserOperationDataListRequest, out *GetUserOperationDataListResponse) error
 		GetWorkloadRequestResult(ctx context.Context, in *GetWorkloadRequestRecommendResultReq, out *GetWorkloadRequestRecommendResultRsp) error
 		GetWorkloadOriginRequestResult(ctx context.Context, in *GetWorkloadOriginRequestResultReq, out *GetWorkloadOriginRequestResultRsp) error
+		GetCostList(ctx context.Context, in *GetCostListRequest, out *GetCostListResponse) error
+		ExportCostList(ctx context.Context, in *GetCostListRequest, out *ExportCostListResponse) error
 	}
 	type DataManager struct {
 		dataManager
@@ -468,6 +506,18 @@ func RegisterDataManagerHandler(s server.Server, hdlr DataManagerHandler, opts .
 		Method:  []string{"GET"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "DataManager.GetCostList",
+		Path:    []string{"/datamanager/v1/cost"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "DataManager.ExportCostList",
+		Path:    []string{"/datamanager/v1/cost/export"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&DataManager{h}, opts...))
 }
 
@@ -538,3 +588,11 @@ func (h *dataManagerHandler) GetWorkloadRequestResult(ctx context.Context, in *G
 func (h *dataManagerHandler) GetWorkloadOriginRequestResult(ctx context.Context, in *GetWorkloadOriginRequestResultReq, out *GetWorkloadOriginRequestResultRsp) error {
 	return h.DataManagerHandler.GetWorkloadOriginRequestResult(ctx, in, out)
 }
+
+func (h *dataManagerHandler) GetCostList(ctx context.Context, in *GetCostListRequest, out *GetCostListResponse) error {
+	return h.DataManagerHandler.GetCostList(ctx, in, out)
+}
+
+func (h *dataManagerHandler) ExportCostList(ctx context.Context, in *GetCostListRequest, out *ExportCostListResponse) error {
+	return h.DataManagerHandler.ExportCostList(ctx, in, out)
+}
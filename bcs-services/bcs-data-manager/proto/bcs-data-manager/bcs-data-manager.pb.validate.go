@@ -8850,3 +8850,647 @@ var _GetWorkloadOriginRequestResultReq_Namespace_Pattern = regexp.MustCompile("^
 var _GetWorkloadOriginRequestResultReq_WorkloadType_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
 
 var _GetWorkloadOriginRequestResultReq_WorkloadName_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on GetCostListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCostListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCostListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCostListRequestMultiError, or nil if none found.
+func (m *GetCostListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCostListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetProjectID()); l < 1 || l > 100 {
+		err := GetCostListRequestValidationError{
+			field:  "ProjectID",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetClusterID()) > 100 {
+		err := GetCostListRequestValidationError{
+			field:  "ClusterID",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetCostListRequest_ClusterID_Pattern.MatchString(m.GetClusterID()) {
+		err := GetCostListRequestValidationError{
+			field:  "ClusterID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 100 {
+		err := GetCostListRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetCostListRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := GetCostListRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetWorkloadType()) > 100 {
+		err := GetCostListRequestValidationError{
+			field:  "WorkloadType",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetCostListRequest_WorkloadType_Pattern.MatchString(m.GetWorkloadType()) {
+		err := GetCostListRequestValidationError{
+			field:  "WorkloadType",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetWorkloadName()) > 100 {
+		err := GetCostListRequestValidationError{
+			field:  "WorkloadName",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetCostListRequest_WorkloadName_Pattern.MatchString(m.GetWorkloadName()) {
+		err := GetCostListRequestValidationError{
+			field:  "WorkloadName",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetCostListRequest_ObjectType_InLookup[m.GetObjectType()]; !ok {
+		err := GetCostListRequestValidationError{
+			field:  "ObjectType",
+			reason: "value must be in list [project cluster namespace workload ]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	if m.GetPage() < 0 {
+		err := GetCostListRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() > 100 {
+		err := GetCostListRequestValidationError{
+			field:  "Size",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCostListRequestMultiError(errors)
+	}
+	return nil
+}
+
+// GetCostListRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCostListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCostListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCostListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCostListRequestMultiError) AllErrors() []error { return m }
+
+// GetCostListRequestValidationError is the validation error returned by
+// GetCostListRequest.Validate if the designated constraints aren't met.
+type GetCostListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCostListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCostListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCostListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCostListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCostListRequestValidationError) ErrorName() string {
+	return "GetCostListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCostListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCostListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCostListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCostListRequestValidationError{}
+
+var _GetCostListRequest_ClusterID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+
+var _GetCostListRequest_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+
+var _GetCostListRequest_WorkloadType_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+
+var _GetCostListRequest_WorkloadName_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+
+var _GetCostListRequest_ObjectType_InLookup = map[string]struct{}{
+	"project":   {},
+	"cluster":   {},
+	"namespace": {},
+	"workload":  {},
+	"":          {},
+}
+
+// Validate checks the field values on GetCostListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCostListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCostListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCostListResponseMultiError, or nil if none found.
+func (m *GetCostListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCostListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCostListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCostListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCostListResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return GetCostListResponseMultiError(errors)
+	}
+	return nil
+}
+
+// GetCostListResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCostListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCostListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCostListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCostListResponseMultiError) AllErrors() []error { return m }
+
+// GetCostListResponseValidationError is the validation error returned by
+// GetCostListResponse.Validate if the designated constraints aren't met.
+type GetCostListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCostListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCostListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCostListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCostListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCostListResponseValidationError) ErrorName() string {
+	return "GetCostListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCostListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCostListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCostListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCostListResponseValidationError{}
+
+// Validate checks the field values on ExportCostListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportCostListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportCostListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportCostListResponseMultiError, or nil if none found.
+func (m *ExportCostListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportCostListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Data
+
+	// no validation rules for FileName
+
+	if len(errors) > 0 {
+		return ExportCostListResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ExportCostListResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportCostListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportCostListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportCostListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportCostListResponseMultiError) AllErrors() []error { return m }
+
+// ExportCostListResponseValidationError is the validation error returned by
+// ExportCostListResponse.Validate if the designated constraints aren't met.
+type ExportCostListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportCostListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportCostListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportCostListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportCostListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportCostListResponseValidationError) ErrorName() string {
+	return "ExportCostListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportCostListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportCostListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportCostListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportCostListResponseValidationError{}
+
+// Validate checks the field values on Cost with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Cost) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Cost with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CostMultiError, or nil if none found.
+func (m *Cost) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Cost) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectID
+
+	// no validation rules for ProjectCode
+
+	// no validation rules for BusinessID
+
+	// no validation rules for ClusterID
+
+	// no validation rules for Namespace
+
+	// no validation rules for WorkloadType
+
+	// no validation rules for WorkloadName
+
+	// no validation rules for ObjectType
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	// no validation rules for CpuRequestCoreHours
+
+	// no validation rules for CpuUsedCoreHours
+
+	// no validation rules for MemoryRequestGiBHours
+
+	// no validation rules for MemoryUsedGiBHours
+
+	// no validation rules for RequestCost
+
+	// no validation rules for UsedCost
+
+	// no validation rules for IdleCost
+
+	// no validation rules for NodeHours
+
+	// no validation rules for NodeCost
+
+	// no validation rules for GpuHours
+
+	// no validation rules for GpuCost
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return CostMultiError(errors)
+	}
+	return nil
+}
+
+// CostMultiError is an error wrapping multiple validation errors returned by
+// Cost.ValidateAll() if the designated constraints aren't met.
+type CostMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CostMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CostMultiError) AllErrors() []error { return m }
+
+// CostValidationError is the validation error returned by Cost.Validate if the
+// designated constraints aren't met.
+type CostValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CostValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CostValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CostValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CostValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CostValidationError) ErrorName() string { return "CostValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CostValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCost.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CostValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CostValidationError{}
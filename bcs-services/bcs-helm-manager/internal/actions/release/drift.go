@@ -0,0 +1,136 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package release
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	helmrelease "helm.sh/helm/v3/pkg/release"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/release"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store/entity"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store/utils"
+)
+
+const (
+	// driftScanTimeout 单个 release 漂移检测的超时时间
+	driftScanTimeout = time.Minute
+)
+
+// DetectReleaseDrift 检测 release 漂移, 并将结果同步到数据库中的 release 状态
+func DetectReleaseDrift(ctx context.Context, model store.HelmManagerModel, releaseHandler release.Handler,
+	clusterID, namespace, name string) (*release.DriftResult, error) {
+	result, err := releaseHandler.Cluster(clusterID).Drift(ctx, release.DriftOption{
+		Namespace: namespace,
+		Name:      name,
+	})
+	if err != nil {
+		blog.Errorf("detect release drift failed, %s, clusterID: %s, namespace: %s, name: %s",
+			err.Error(), clusterID, namespace, name)
+		return nil, err
+	}
+
+	if err = syncDriftStatus(ctx, model, clusterID, result); err != nil {
+		blog.Warnf("sync release drift status failed, %s, clusterID: %s, namespace: %s, name: %s",
+			err.Error(), clusterID, namespace, name)
+	}
+	return result, nil
+}
+
+// syncDriftStatus 只在 deployed 和 drifted 之间切换状态, 操作中或失败的 release 保持原状态
+func syncDriftStatus(ctx context.Context, model store.HelmManagerModel, clusterID string,
+	result *release.DriftResult) error {
+	rl, err := model.GetRelease(ctx, clusterID, result.Namespace, result.Name)
+	if err != nil {
+		if errors.Is(err, drivers.ErrTableRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	var status, message string
+	switch {
+	case result.Drifted && (rl.Status == helmrelease.StatusDeployed.String() ||
+		rl.Status == common.ReleaseStatusDrifted.String()):
+		status, message = common.ReleaseStatusDrifted.String(), result.Summary()
+	case !result.Drifted && rl.Status == common.ReleaseStatusDrifted.String():
+		status, message = helmrelease.StatusDeployed.String(), ""
+	default:
+		return nil
+	}
+	if status == rl.Status && message == rl.Message {
+		return nil
+	}
+
+	blog.Infof("release drift status changed to %s, clusterID: %s, namespace: %s, name: %s, message: %s",
+		status, clusterID, result.Namespace, result.Name, message)
+	return model.UpdateRelease(ctx, clusterID, result.Namespace, result.Name, entity.M{
+		entity.FieldKeyStatus:  status,
+		entity.FieldKeyMessage: message,
+		// 漂移检测不改变 release 的更新时间
+		entity.FieldKeyUpdateTime: rl.UpdateTime,
+	})
+}
+
+// RunDriftScan 定时检测数据库中已部署的 release 是否发生漂移, 直到 ctx 结束
+func RunDriftScan(ctx context.Context, model store.HelmManagerModel, releaseHandler release.Handler,
+	interval time.Duration) error {
+	blog.Infof("release drift scan is enabled, interval %s", interval.String())
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			scanReleaseDrift(ctx, model, releaseHandler)
+		}
+	}
+}
+
+func scanReleaseDrift(ctx context.Context, model store.HelmManagerModel, releaseHandler release.Handler) {
+	cond := operator.NewBranchCondition(operator.And,
+		operator.NewLeafCondition(operator.In, operator.M{entity.FieldKeyStatus: []string{
+			helmrelease.StatusDeployed.String(), common.ReleaseStatusDrifted.String()}}),
+		operator.NewLeafCondition(operator.Ne, operator.M{entity.FieldKeyChartName: ""}),
+	)
+	_, rls, err := model.ListRelease(ctx, cond, &utils.ListOption{})
+	if err != nil {
+		blog.Errorf("scan release drift list release from db failed, %s", err.Error())
+		return
+	}
+
+	start := time.Now()
+	drifted := 0
+	for _, rl := range rls {
+		if ctx.Err() != nil {
+			return
+		}
+		scanCtx, cancel := context.WithTimeout(ctx, driftScanTimeout)
+		result, err := DetectReleaseDrift(scanCtx, model, releaseHandler, rl.ClusterID, rl.Namespace, rl.Name)
+		cancel()
+		if err == nil && result.Drifted {
+			drifted++
+		}
+	}
+	blog.Infof("scan release drift finished, %d releases scanned, %d drifted, took %s",
+		len(rls), drifted, time.Since(start).String())
+}
@@ -58,6 +58,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"gopkg.in/yaml.v2"
 
+	actionRelease "github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/actions/release"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/auth"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/common"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/component"
@@ -71,6 +72,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/release/bcs"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/repo"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/repo/bkrepo"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/repo/generic"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/utils/envx"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/utils/runtimex"
@@ -81,6 +83,8 @@ import (
 var (
 	// maxMsgSize define maximum message size that grpc server can send or receive. Default value is 50MB.
 	maxMsgSize = 1024 * 1024 * 50
+	// defaultDriftScanInterval default interval of release drift scan
+	defaultDriftScanInterval = 30 * time.Minute
 )
 
 // HelmManager describe the helm-service manager instance
@@ -100,6 +104,7 @@ type HelmManager struct {
 	// tls config for helm manager service and client side
 	tlsConfig       *tls.Config
 	clientTLSConfig *tls.Config
+	etcdTLSConfig   *tls.Config
 
 	// mongo
 	mongoOptions   *mongo.Options
@@ -173,6 +178,11 @@ func (hm *HelmManager) Run() error {
 	eg.Go(func() error {
 		return hm.addonsWatch()
 	})
+	if hm.opt.Drift.Enable {
+		eg.Go(func() error {
+			return hm.driftScan()
+		})
+	}
 	eg.Go(func() error {
 		// run the service
 		return hm.microSvc.Run()
@@ -284,15 +294,23 @@ func (hm *HelmManager) initPlatform() error {
 		password = string(realPwd)
 	}
 
-	hm.platform = bkrepo.New(repo.Config{
+	config := repo.Config{
 		URL:      hm.opt.Repo.URL,
 		OciURL:   hm.opt.Repo.OciURL,
 		AuthType: "Platform",
 		UID:      hm.opt.Repo.UID,
 		Username: hm.opt.Repo.Username,
 		Password: password,
-	})
-	blog.Infof("init repo platform successfully to %s", hm.opt.Repo.URL)
+	}
+	switch hm.opt.Repo.Platform {
+	case "", options.RepoPlatformBkRepo:
+		hm.platform = bkrepo.New(config)
+	case options.RepoPlatformGeneric:
+		hm.platform = generic.New(config)
+	default:
+		return fmt.Errorf("unknown repo platform %s", hm.opt.Repo.Platform)
+	}
+	blog.Infof("init repo platform %s successfully to %s", hm.opt.Repo.Platform, hm.opt.Repo.URL)
 	return nil
 }
 
@@ -330,6 +348,7 @@ func (hm *HelmManager) initRegistry() error {
 	}
 
 	blog.Infof("get etcd endpoints for registry: %v, with secure %t", etcdEndpoints, etcdSecure)
+	hm.etcdTLSConfig = etcdTLS
 
 	hm.microRgt = microEtcd.NewRegistry(
 		microRgt.Addrs(etcdEndpoints...),
@@ -737,6 +756,17 @@ func (hm *HelmManager) watch() error {
 	return nil
 }
 
+// driftScan 定时检测 release 漂移, 多副本部署时只在 leader 上执行
+func (hm *HelmManager) driftScan() error {
+	interval := time.Duration(hm.opt.Drift.Interval) * time.Minute
+	if interval <= 0 {
+		interval = defaultDriftScanInterval
+	}
+	return hm.runAsLeader(driftScanLeaderKey, func(ctx context.Context) error {
+		return actionRelease.RunDriftScan(ctx, hm.model, hm.releaseHandler, interval)
+	})
+}
+
 // addonsWatch 监听addons配置文件
 func (hm *HelmManager) addonsWatch() error {
 	var eg errgroup.Group
@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package app
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/common"
+)
+
+const (
+	// driftScanLeaderKey leader election key of release drift scan
+	driftScanLeaderKey = "/" + common.ServiceDomain + "/leader/drift-scan"
+	// leaderSessionTTL leader 租约时间, 单位秒, leader 异常退出后其他副本在租约过期后接管
+	leaderSessionTTL = 15
+	// leaderRetryInterval 选举失败后的重试间隔
+	leaderRetryInterval = 5 * time.Second
+)
+
+// runAsLeader 通过 etcd 选举 leader, 只在 leader 上执行 run, 失去 leader 后取消 run 的 ctx 并重新参与选举,
+// 直到 helm manager 退出
+func (hm *HelmManager) runAsLeader(key string, run func(ctx context.Context) error) error {
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:   common.SplitAddrString(hm.opt.Etcd.EtcdEndpoints),
+		TLS:         hm.etcdTLSConfig,
+		DialTimeout: 5 * time.Second,
+		Context:     hm.ctx,
+	})
+	if err != nil {
+		blog.Errorf("create etcd client for leader election %s failed, %s", key, err.Error())
+		return err
+	}
+	defer cli.Close()
+
+	identity, _ := os.Hostname()
+	for {
+		if hm.ctx.Err() != nil {
+			return nil
+		}
+		if err = hm.campaignAndRun(cli, key, identity, run); err != nil {
+			blog.Errorf("leader election %s failed, %s, retry after %s", key, err.Error(),
+				leaderRetryInterval.String())
+			select {
+			case <-hm.ctx.Done():
+				return nil
+			case <-time.After(leaderRetryInterval):
+			}
+		}
+	}
+}
+
+// campaignAndRun 阻塞直到成为 leader, 然后执行 run 直到 run 退出或者失去 leader
+func (hm *HelmManager) campaignAndRun(cli *clientv3.Client, key, identity string,
+	run func(ctx context.Context) error) error {
+	session, err := concurrency.NewSession(cli, concurrency.WithTTL(leaderSessionTTL),
+		concurrency.WithContext(hm.ctx))
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	election := concurrency.NewElection(session, key)
+	if err = election.Campaign(hm.ctx, identity); err != nil {
+		return err
+	}
+	blog.Infof("%s become leader of %s", identity, key)
+
+	ctx, cancel := context.WithCancel(hm.ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-session.Done():
+			blog.Warnf("%s lost leader of %s", identity, key)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if err = run(ctx); err != nil {
+		blog.Errorf("leader task %s exit with error, %s", key, err.Error())
+	}
+	resignCtx, resignCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer resignCancel()
+	if err = election.Resign(resignCtx); err != nil {
+		blog.Warnf("%s resign leader of %s failed, %s", identity, key, err.Error())
+	}
+	return nil
+}
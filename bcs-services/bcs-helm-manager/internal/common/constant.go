@@ -45,6 +45,8 @@ const (
 	ReleaseStatusRollbackFailed release.Status = "failed-rollback"
 	// ReleaseStatusUninstallFailed xxx
 	ReleaseStatusUninstallFailed release.Status = "failed-uninstall"
+	// ReleaseStatusDrifted 集群中的资源与 release manifest 不一致
+	ReleaseStatusDrifted release.Status = "drifted"
 )
 
 const (
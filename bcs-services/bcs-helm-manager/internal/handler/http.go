@@ -13,6 +13,7 @@
 package handler
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"path"
@@ -20,9 +21,13 @@ import (
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
 	"github.com/gorilla/mux"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	actionRelease "github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/actions/release"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/auth"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/component"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/component/clustermanager"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/options"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/repo"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/utils/contextx"
@@ -55,6 +60,9 @@ func NewAPIRouter(hm *HelmManager) *mux.Router {
 	// chart upload
 	r.Methods("POST").Path("/helmmanager/api/v1/projects/{projectCode}/repos/{repoName}/charts/upload").
 		HandlerFunc(UploadChartHandler(hm))
+	// release drift
+	r.Methods("GET").Path("/helmmanager/api/v1/projects/{projectCode}/clusters/{clusterID}/namespaces/{namespace}" +
+		"/releases/{name}/drift").HandlerFunc(ReleaseDriftHandler(hm))
 	return r
 }
 
@@ -112,3 +120,56 @@ func UploadChartHandler(hm *HelmManager) func(w http.ResponseWriter, r *http.Req
 		httpx.ResponseOK(w, r, nil)
 	}
 }
+
+// ReleaseDriftHandler release drift handler, 对比 release manifest 与集群中的资源, 返回每个资源的差异
+func ReleaseDriftHandler(hm *HelmManager) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		ctx := r.Context()
+		clusterID := vars["clusterID"]
+
+		// check cluster belongs to project
+		cls, err := clustermanager.GetCluster(ctx, clusterID)
+		if err != nil {
+			httpx.ResponseSystemError(w, r, err)
+			return
+		}
+		if !cls.IsShared && cls.ProjectID != contextx.GetProjectIDFromCtx(ctx) {
+			httpx.ResponseAuthError(w, r, fmt.Errorf("cluster %s does not belong to this project", clusterID))
+			return
+		}
+		// 共享集群中的命名空间需要属于该项目
+		if cls.IsShared {
+			if err = checkNamespaceInProject(ctx, clusterID, vars["namespace"],
+				contextx.GetProjectCodeFromCtx(ctx)); err != nil {
+				httpx.ResponseAuthError(w, r, err)
+				return
+			}
+		}
+
+		result, err := actionRelease.DetectReleaseDrift(ctx, hm.model, hm.releaseHandler,
+			clusterID, vars["namespace"], vars["name"])
+		if err != nil {
+			httpx.ResponseSystemError(w, r, err)
+			return
+		}
+
+		httpx.ResponseOK(w, r, result)
+	}
+}
+
+// checkNamespaceInProject 检测共享集群中的命名空间是否属于项目
+func checkNamespaceInProject(ctx context.Context, clusterID, namespace, projectCode string) error {
+	client, err := component.GetK8SClientByClusterID(clusterID)
+	if err != nil {
+		return err
+	}
+	ns, err := client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if ns.Annotations[options.GlobalOptions.SharedCluster.AnnotationKeyProjCode] != projectCode {
+		return fmt.Errorf("namespace %s does not belong to this project in shared cluster %s", namespace, clusterID)
+	}
+	return nil
+}
@@ -70,9 +70,18 @@ type MongoConfig struct {
 	Encrypted      bool   `json:"encrypted" yaml:"encrypted"`
 }
 
+const (
+	// RepoPlatformBkRepo 蓝鲸制品库
+	RepoPlatformBkRepo = "bkrepo"
+	// RepoPlatformGeneric 标准仓库, HELM 仓库对接 ChartMuseum, OCI 仓库对接 OCI registry(如 Harbor, distribution)
+	RepoPlatformGeneric = "generic"
+)
+
 // RepoConfig option for repo platform
 type RepoConfig struct {
-	// bkrepo api url
+	// repo platform type, bkrepo or generic, default bkrepo
+	Platform string `json:"platform" yaml:"platform"`
+	// bkrepo api url, or chartmuseum url for generic platform
 	URL string `json:"url" yaml:"url"`
 	// repo base url
 	BaseURL           string `json:"baseurl" yaml:"baseurl"`
@@ -90,6 +99,9 @@ func (r *RepoConfig) GetRepoBaseURL() string {
 	if r.BaseURL != "" {
 		return r.BaseURL
 	}
+	if r.Platform == RepoPlatformGeneric {
+		return r.URL
+	}
 	return fmt.Sprintf("%s/helm", r.URL)
 }
 
@@ -161,6 +173,14 @@ type EncryptSecret struct {
 	Secret string `json:"secret" yaml:"secret"`
 }
 
+// DriftConfig options of release drift detection
+type DriftConfig struct {
+	// 是否开启定时漂移检测
+	Enable bool `json:"enable" yaml:"enable"`
+	// 检测间隔, 单位分钟
+	Interval uint `json:"interval" yaml:"interval"`
+}
+
 // SharedClusterConfig options of shared cluster config
 type SharedClusterConfig struct {
 	AnnotationKeyProjCode string `json:"annotationKeyProjCode" yaml:"annotationKeyProjCode"`
@@ -182,6 +202,7 @@ type HelmManagerOptions struct {
 	TLS           TLS                 `json:"tls" yaml:"tls"`
 	TracingConfig conf.TracingConfig  `json:"tracingConfig" yaml:"tracingConfig"`
 	SharedCluster SharedClusterConfig `json:"sharedCluster" yaml:"sharedCluster"`
+	Drift         DriftConfig         `json:"drift" yaml:"drift"`
 	ServerConfig
 }
 
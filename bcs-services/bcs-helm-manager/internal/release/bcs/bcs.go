@@ -106,3 +106,8 @@ func (c *cluster) Rollback(ctx context.Context, conf release.HelmRollbackConfig)
 func (c *cluster) History(ctx context.Context, option release.HelmHistoryOption) ([]*release.Release, error) {
 	return c.history(ctx, option)
 }
+
+// Drift detect release drift between manifest and cluster resources
+func (c *cluster) Drift(ctx context.Context, option release.DriftOption) (*release.DriftResult, error) {
+	return c.drift(ctx, option)
+}
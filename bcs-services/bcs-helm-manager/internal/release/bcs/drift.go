@@ -0,0 +1,269 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bcs
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/resource"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/release"
+)
+
+// drift 对比 release 最新版本的 manifest 与集群中的资源, manifest 中声明的字段在集群中被修改或资源被删除均视为漂移,
+// 集群中额外的字段(默认值, status 等)不参与对比
+func (c *cluster) drift(ctx context.Context, op release.DriftOption) (*release.DriftResult, error) {
+	rl, err := c.ensureSdkClient().Get(ctx, op.Namespace, op.Name, 0)
+	if err != nil {
+		blog.Errorf("get helm release from cluster failed, %s, cluster: %s, namespace: %s, name: %s",
+			err.Error(), c.clusterID, op.Namespace, op.Name)
+		return nil, err
+	}
+
+	infos, err := resource.NewBuilder(c.sdkClientGroup.Config(c.clusterID)).
+		Unstructured().
+		ContinueOnError().
+		Stream(strings.NewReader(rl.Manifest), "").
+		Flatten().
+		Do().Infos()
+	if err != nil {
+		blog.Errorf("parse release manifest failed, %s, cluster: %s, namespace: %s, name: %s",
+			err.Error(), c.clusterID, op.Namespace, op.Name)
+		return nil, err
+	}
+
+	drifts := make([]*release.ResourceDrift, len(infos))
+	errs := make([]error, len(infos))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(infos))
+	for i, v := range infos {
+		if len(v.Namespace) == 0 && v.Namespaced() {
+			v.Namespace = op.Namespace
+		}
+		go func(i int) {
+			defer wg.Done()
+			drifts[i], errs[i] = detectResourceDrift(infos[i])
+		}(i)
+	}
+	wg.Wait()
+
+	result := &release.DriftResult{
+		Name:      rl.Name,
+		Namespace: rl.Namespace,
+		Revision:  rl.Version,
+		Resources: make([]*release.ResourceDrift, 0),
+	}
+	for i := range infos {
+		if errs[i] != nil {
+			blog.Errorf("get k8s resource for %s in %s err: %s", infos[i].Name, infos[i].Namespace, errs[i].Error())
+			return nil, errs[i]
+		}
+		if drifts[i] != nil {
+			result.Resources = append(result.Resources, drifts[i])
+		}
+	}
+	result.Drifted = len(result.Resources) > 0
+	return result, nil
+}
+
+// detectResourceDrift 获取集群中的资源并与 manifest 对比, 无漂移时返回 nil
+func detectResourceDrift(info *resource.Info) (*release.ResourceDrift, error) {
+	obj, ok := info.Object.(runtime.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", info.Object)
+	}
+	desired := runtime.DeepCopyJSON(obj.UnstructuredContent())
+	gvk := info.Object.GetObjectKind().GroupVersionKind()
+
+	drift := &release.ResourceDrift{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  info.Namespace,
+		Name:       info.Name,
+	}
+	if err := info.Get(); err != nil {
+		if apierrors.IsNotFound(err) {
+			drift.Type = release.DriftTypeDeleted
+			return drift, nil
+		}
+		return nil, err
+	}
+
+	live, ok := info.Object.(runtime.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", info.Object)
+	}
+	drift.Diffs = diffObject(desired, live.UnstructuredContent())
+	if len(drift.Diffs) == 0 {
+		return nil, nil
+	}
+	drift.Type = release.DriftTypeModified
+	return drift, nil
+}
+
+// secretMaskValue Secret 数据在差异中的展示值
+const secretMaskValue = `"******"`
+
+// diffObject 对比 manifest 中声明的字段, metadata 中只对比 labels 和 annotations,
+// Secret 的 stringData 转换为 data 后对比, 差异中不展示 data 的内容
+func diffObject(desired, live map[string]interface{}) []*release.FieldDiff {
+	secret := isSecret(desired)
+	if secret {
+		desired = normalizeSecretData(desired)
+	}
+	diffs := make([]*release.FieldDiff, 0)
+	for k, v := range desired {
+		switch k {
+		case "apiVersion", "kind", "status":
+			continue
+		case "metadata":
+			desiredMeta, _ := v.(map[string]interface{})
+			liveMeta, _ := live[k].(map[string]interface{})
+			for _, field := range []string{"labels", "annotations"} {
+				diffs = diffValue(k+"."+field, desiredMeta[field], liveMeta[field], diffs)
+			}
+		default:
+			diffs = diffValue(k, v, live[k], diffs)
+		}
+	}
+
+	if secret {
+		maskSecretData(diffs)
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+	return diffs
+}
+
+func isSecret(obj map[string]interface{}) bool {
+	return obj["apiVersion"] == "v1" && obj["kind"] == "Secret"
+}
+
+// normalizeSecretData 集群中的 Secret 只有 data, 将 manifest 中的 stringData 编码后合并到 data 中,
+// 与 apiserver 一致, stringData 覆盖 data 中的同名字段
+func normalizeSecretData(desired map[string]interface{}) map[string]interface{} {
+	stringData, ok := desired["stringData"].(map[string]interface{})
+	if !ok {
+		return desired
+	}
+	normalized := make(map[string]interface{}, len(desired))
+	for k, v := range desired {
+		normalized[k] = v
+	}
+	delete(normalized, "stringData")
+
+	data := make(map[string]interface{})
+	if origin, ok := desired["data"].(map[string]interface{}); ok {
+		for k, v := range origin {
+			data[k] = v
+		}
+	}
+	for k, v := range stringData {
+		data[k] = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(v)))
+	}
+	normalized["data"] = data
+	return normalized
+}
+
+// maskSecretData 隐藏 Secret data 的内容, 只展示字段是否存在
+func maskSecretData(diffs []*release.FieldDiff) {
+	for _, d := range diffs {
+		if d.Path != "data" && !strings.HasPrefix(d.Path, "data.") {
+			continue
+		}
+		if d.Expected != "null" {
+			d.Expected = secretMaskValue
+		}
+		if d.Actual != "null" {
+			d.Actual = secretMaskValue
+		}
+	}
+}
+
+// diffValue 递归对比字段, manifest 中为空的字段不参与对比
+func diffValue(path string, expected, actual interface{}, diffs []*release.FieldDiff) []*release.FieldDiff {
+	switch e := expected.(type) {
+	case nil:
+		return diffs
+	case map[string]interface{}:
+		if len(e) == 0 {
+			return diffs
+		}
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return append(diffs, newFieldDiff(path, expected, actual))
+		}
+		for k, v := range e {
+			diffs = diffValue(path+"."+k, v, a[k], diffs)
+		}
+		return diffs
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if len(e) == 0 && len(a) == 0 {
+			return diffs
+		}
+		if !ok || len(a) != len(e) {
+			return append(diffs, newFieldDiff(path, expected, actual))
+		}
+		for i := range e {
+			diffs = diffValue(fmt.Sprintf("%s[%d]", path, i), e[i], a[i], diffs)
+		}
+		return diffs
+	default:
+		if !scalarEqual(expected, actual) {
+			return append(diffs, newFieldDiff(path, expected, actual))
+		}
+		return diffs
+	}
+}
+
+// scalarEqual 对比基础类型的值, 兼容数字类型差异和资源数量的不同写法, 如 cpu 0.5 与 500m
+func scalarEqual(expected, actual interface{}) bool {
+	if actual == nil {
+		return false
+	}
+	e, a := fmt.Sprint(expected), fmt.Sprint(actual)
+	if e == a {
+		return true
+	}
+	eq, err := apiresource.ParseQuantity(e)
+	if err != nil {
+		return false
+	}
+	aq, err := apiresource.ParseQuantity(a)
+	if err != nil {
+		return false
+	}
+	return eq.Cmp(aq) == 0
+}
+
+func newFieldDiff(path string, expected, actual interface{}) *release.FieldDiff {
+	e, _ := json.Marshal(expected)
+	a, _ := json.Marshal(actual)
+	return &release.FieldDiff{
+		Path:     path,
+		Expected: string(e),
+		Actual:   string(a),
+	}
+}
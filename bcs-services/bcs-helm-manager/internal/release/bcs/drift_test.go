@@ -0,0 +1,160 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bcs
+
+import (
+	"fmt"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+)
+
+const desiredDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: nginx
+  labels:
+    app: nginx
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: nginx
+        image: nginx:1.21
+        resources:
+          limits:
+            cpu: 0.5
+            memory: 512Mi
+`
+
+const liveDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: nginx
+  namespace: default
+  resourceVersion: "123"
+  labels:
+    app: nginx
+    app.kubernetes.io/managed-by: Helm
+  annotations:
+    meta.helm.sh/release-name: nginx
+spec:
+  replicas: %s
+  progressDeadlineSeconds: 600
+  template:
+    spec:
+      containers:
+      - name: nginx
+        image: nginx:1.21
+        imagePullPolicy: IfNotPresent
+        resources:
+          limits:
+            cpu: 500m
+            memory: 512Mi
+status:
+  replicas: 2
+`
+
+func unmarshalObject(t *testing.T, data string) map[string]interface{} {
+	obj := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(data), &obj); err != nil {
+		t.Fatal(err)
+	}
+	return obj
+}
+
+func TestDiffObject(t *testing.T) {
+	desired := unmarshalObject(t, desiredDeployment)
+
+	// 集群中的默认值和 status 不视为漂移
+	live := unmarshalObject(t, fmt.Sprintf(liveDeployment, "2"))
+	if diffs := diffObject(desired, live); len(diffs) != 0 {
+		t.Errorf("expect no drift, get %+v", diffs[0])
+	}
+
+	// 手动修改副本数
+	live = unmarshalObject(t, fmt.Sprintf(liveDeployment, "5"))
+	diffs := diffObject(desired, live)
+	if len(diffs) != 1 {
+		t.Fatalf("expect 1 drift, get %d", len(diffs))
+	}
+	if diffs[0].Path != "spec.replicas" || diffs[0].Expected != "2" || diffs[0].Actual != "5" {
+		t.Errorf("unexpected drift %+v", diffs[0])
+	}
+
+	// 删除容器
+	podSpec := live["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})
+	podSpec["containers"] = []interface{}{}
+	diffs = diffObject(desired, live)
+	if len(diffs) != 2 || diffs[0].Path != "spec.replicas" || diffs[1].Path != "spec.template.spec.containers" {
+		t.Errorf("unexpected drifts %v", diffs)
+	}
+}
+
+const desiredSecret = `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: db
+type: Opaque
+data:
+  user: YWRtaW4=
+stringData:
+  password: %s
+`
+
+const liveSecret = `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: db
+  namespace: default
+type: Opaque
+data:
+  user: YWRtaW4=
+  password: cGFzc3dvcmQ=
+`
+
+func TestDiffSecret(t *testing.T) {
+	live := unmarshalObject(t, liveSecret)
+
+	// stringData 与集群中的 data 一致时不视为漂移
+	desired := unmarshalObject(t, fmt.Sprintf(desiredSecret, "password"))
+	if diffs := diffObject(desired, live); len(diffs) != 0 {
+		t.Errorf("expect no drift, get %+v", diffs[0])
+	}
+	if _, ok := desired["stringData"]; !ok {
+		t.Errorf("expect desired object not modified")
+	}
+
+	// 差异中不展示 Secret 的内容
+	desired = unmarshalObject(t, fmt.Sprintf(desiredSecret, "changed"))
+	diffs := diffObject(desired, live)
+	if len(diffs) != 1 {
+		t.Fatalf("expect 1 drift, get %d", len(diffs))
+	}
+	if diffs[0].Path != "data.password" || diffs[0].Expected != secretMaskValue ||
+		diffs[0].Actual != secretMaskValue {
+		t.Errorf("unexpected drift %+v", diffs[0])
+	}
+
+	// 集群中被删除的字段展示为 null
+	delete(live["data"].(map[string]interface{}), "password")
+	diffs = diffObject(desired, live)
+	if len(diffs) != 1 || diffs[0].Expected != secretMaskValue || diffs[0].Actual != "null" {
+		t.Errorf("unexpected drifts %v", diffs)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package release
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DriftType 资源漂移的类型
+type DriftType string
+
+const (
+	// DriftTypeModified 集群中的资源被修改
+	DriftTypeModified DriftType = "modified"
+	// DriftTypeDeleted 集群中的资源被删除
+	DriftTypeDeleted DriftType = "deleted"
+
+	// maxDriftSummaryResources 漂移摘要中最多展示的资源数
+	maxDriftSummaryResources = 5
+)
+
+// DriftOption 定义了 Cluster.Drift 的查询参数
+type DriftOption struct {
+	Namespace string
+	Name      string
+}
+
+// DriftResult 定义了 release 的漂移检测结果, 对比 release 存储的 manifest 与集群中的资源
+type DriftResult struct {
+	Name      string           `json:"name"`
+	Namespace string           `json:"namespace"`
+	Revision  int              `json:"revision"`
+	Drifted   bool             `json:"drifted"`
+	Resources []*ResourceDrift `json:"resources"`
+}
+
+// ResourceDrift 定义了单个资源的漂移信息
+type ResourceDrift struct {
+	APIVersion string       `json:"apiVersion"`
+	Kind       string       `json:"kind"`
+	Namespace  string       `json:"namespace"`
+	Name       string       `json:"name"`
+	Type       DriftType    `json:"type"`
+	Diffs      []*FieldDiff `json:"diffs,omitempty"`
+}
+
+// FieldDiff 定义了资源字段的差异, Expected 为 manifest 中的值, Actual 为集群中的值, 均为 json 格式
+type FieldDiff struct {
+	Path     string `json:"path"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+// Summary 返回漂移摘要, 用于展示在 release 状态信息中
+func (r *DriftResult) Summary() string {
+	if r == nil || !r.Drifted {
+		return ""
+	}
+
+	items := make([]string, 0, maxDriftSummaryResources)
+	for i, res := range r.Resources {
+		if i >= maxDriftSummaryResources {
+			items = append(items, "...")
+			break
+		}
+		item := fmt.Sprintf("%s/%s %s", res.Kind, res.Name, res.Type)
+		if len(res.Diffs) > 0 {
+			paths := make([]string, 0, len(res.Diffs))
+			for _, d := range res.Diffs {
+				paths = append(paths, d.Path)
+			}
+			item += "(" + strings.Join(paths, ", ") + ")"
+		}
+		items = append(items, item)
+	}
+	return fmt.Sprintf("%d resources drifted from revision %d: %s",
+		len(r.Resources), r.Revision, strings.Join(items, "; "))
+}
@@ -50,6 +50,7 @@ type Cluster interface {
 	Upgrade(ctx context.Context, conf HelmUpgradeConfig) (*HelmUpgradeResult, error)
 	Rollback(ctx context.Context, conf HelmRollbackConfig) (*HelmRollbackResult, error)
 	History(ctx context.Context, option HelmHistoryOption) ([]*Release, error)
+	Drift(ctx context.Context, option DriftOption) (*DriftResult, error)
 }
 
 // Release 定义了集群中的helm release信息, 一般在命令行通过 helm list 获取
@@ -0,0 +1,230 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/repo"
+)
+
+const (
+	// requestTimeout 请求仓库的超时时间
+	requestTimeout = 30 * time.Second
+
+	// upload chart timeout
+	timeout = 10
+
+	// defaultPageSize 默认分页大小
+	defaultPageSize = 10
+)
+
+var (
+	errNotExist = fmt.Errorf("not exist")
+)
+
+// response 仓库的http返回
+type response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// credential 返回访问仓库使用的账号, 优先使用仓库自身的账号, 否则使用平台账号
+func (h *handler) credential() (string, string) {
+	if h.user.Password != "" {
+		return h.user.Name, h.user.Password
+	}
+	return h.config.Username, h.config.Password
+}
+
+// request 向仓库发起请求, 默认使用 Basic 认证,
+// 当仓库返回 401 并要求 Bearer 认证时(如 Harbor, distribution 的 token 认证), 先换取 token 再重试
+func (h *handler) request(ctx context.Context, method, uri string, header http.Header, data []byte) (
+	*response, error) {
+
+	username, password := h.credential()
+	resp, err := h.do(ctx, method, uri, header, data, func(req *http.Request) {
+		if username != "" || password != "" {
+			req.SetBasicAuth(username, password)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		return resp, nil
+	}
+
+	challenge := resp.Header.Get("WWW-Authenticate")
+	if !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+		return resp, nil
+	}
+	token, err := h.fetchToken(ctx, challenge)
+	if err != nil {
+		blog.Errorf("request to repo [%s] %s fetch token failed, %s", method, uri, err.Error())
+		return nil, err
+	}
+	return h.do(ctx, method, uri, header, data, func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+token)
+	})
+}
+
+func (h *handler) do(ctx context.Context, method, uri string, header http.Header, data []byte,
+	setAuth func(*http.Request)) (*response, error) {
+
+	var body io.Reader
+	if data != nil {
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, uri, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	setAuth(req)
+	blog.V(5).Infof("request to repo [%s] %s, header(%v)", method, uri, header)
+
+	beforeReq := time.Now().Local()
+	r, err := h.client.Do(req)
+	blog.V(5).Infof("request to repo [%s] %s spent time %s",
+		method, uri, time.Now().Local().Sub(beforeReq).String())
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	reply, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	blog.V(5).Infof("request to repo [%s] %s, code: %d", method, uri, r.StatusCode)
+
+	return &response{StatusCode: r.StatusCode, Header: r.Header, Body: reply}, nil
+}
+
+// fetchToken 根据 WWW-Authenticate 中的 realm, service, scope 换取 Bearer token
+func (h *handler) fetchToken(ctx context.Context, challenge string) (string, error) {
+	params := parseChallenge(challenge[len("bearer "):])
+	realm := params["realm"]
+	if realm == "" {
+		return "", fmt.Errorf("invalid auth challenge %s", challenge)
+	}
+
+	u, err := url.Parse(realm)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	if params["service"] != "" {
+		q.Set("service", params["service"])
+	}
+	if params["scope"] != "" {
+		q.Set("scope", params["scope"])
+	}
+	u.RawQuery = q.Encode()
+
+	username, password := h.credential()
+	resp, err := h.do(ctx, http.MethodGet, u.String(), nil, nil, func(req *http.Request) {
+		if username != "" || password != "" {
+			req.SetBasicAuth(username, password)
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetch token failed, http(%d): %s", resp.StatusCode, u.String())
+	}
+
+	var r tokenResp
+	if err = json.Unmarshal(resp.Body, &r); err != nil {
+		return "", err
+	}
+	if r.Token != "" {
+		return r.Token, nil
+	}
+	if r.AccessToken != "" {
+		return r.AccessToken, nil
+	}
+	return "", fmt.Errorf("fetch token get empty token from %s", u.String())
+}
+
+type tokenResp struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
+
+// parseChallenge 解析形如 realm="xxx",service="xxx",scope="xxx" 的认证参数
+func parseChallenge(s string) map[string]string {
+	params := make(map[string]string)
+	for len(s) > 0 {
+		s = strings.TrimLeft(s, ", ")
+		idx := strings.Index(s, "=")
+		if idx < 0 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(s[:idx]))
+		s = s[idx+1:]
+
+		var value string
+		if strings.HasPrefix(s, "\"") {
+			end := strings.Index(s[1:], "\"")
+			if end < 0 {
+				value, s = s[1:], ""
+			} else {
+				value, s = s[1:end+1], s[end+2:]
+			}
+		} else {
+			end := strings.Index(s, ",")
+			if end < 0 {
+				value, s = s, ""
+			} else {
+				value, s = s[:end], s[end:]
+			}
+		}
+		params[key] = value
+	}
+	return params
+}
+
+// paginate 返回分页后的起止下标, page 从 1 开始, 同时补全默认的分页参数
+func paginate(total int, option *repo.ListOption) (int, int) {
+	if option.Size <= 0 {
+		option.Size = defaultPageSize
+	}
+	if option.Page <= 0 {
+		option.Page = 1
+	}
+	start := int((option.Page - 1) * option.Size)
+	if start > total {
+		start = total
+	}
+	end := start + int(option.Size)
+	if end > total {
+		end = total
+	}
+	return start, end
+}
@@ -0,0 +1,183 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package generic 基于标准协议的 repo.Platform 实现, HELM 仓库对接 ChartMuseum 风格的 index.yaml 仓库,
+// OCI 仓库对接标准的 OCI registry(如 Harbor, distribution)
+package generic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/repo"
+)
+
+// New 返回一个 genericRepo, 标准的repo.Platform对象, 其背后是基于 ChartMuseum 和 OCI registry 的实现
+// config.URL 为 ChartMuseum 地址, 仓库地址为 {URL}/{projectID}/{repository}, 需开启 ChartMuseum 多租户(depth=2)
+// config.OciURL 为 OCI registry 地址, chart 地址为 {OciURL}/{projectID}/{repository}/{chartName}
+func New(c repo.Config) repo.Platform {
+	return &genericRepo{
+		config: &c,
+		client: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// genericRepo 基于标准的 chart 仓库协议操作仓库, 项目和仓库本身由外部的仓库服务管理
+type genericRepo struct {
+	config *repo.Config
+
+	client *http.Client
+}
+
+// User 针对给定用户权限实例化一个handler, 共享genericRepo的client
+func (gr *genericRepo) User(user repo.User) repo.Handler {
+	return &handler{
+		genericRepo: gr,
+		user:        user,
+	}
+}
+
+type handler struct {
+	*genericRepo
+
+	user repo.User
+}
+
+// Project 针对给定的projectID, 返回一个 repo.ProjectHandler 实例, 用于项目层级的所有操作
+func (h *handler) Project(projectID string) repo.ProjectHandler {
+	return &projectHandler{
+		handler:   h,
+		projectID: projectID,
+	}
+}
+
+type projectHandler struct {
+	*handler
+
+	projectID string
+}
+
+// Ensure 标准仓库没有统一的项目管理接口, 项目需在仓库服务中预先创建, 这里不做处理
+func (ph *projectHandler) Ensure(_ context.Context) error {
+	blog.Infof("generic repo platform skip ensure project %s", ph.projectID)
+	return nil
+}
+
+// Repository 针对给定的repository type和repository name, 返回一个 repo.RepositoryHandler 实例, 用于仓库层级的所有操作
+func (ph *projectHandler) Repository(repoType repo.RepositoryType, repository string) repo.RepositoryHandler {
+	return &repositoryHandler{
+		projectHandler: ph,
+		projectID:      ph.projectID,
+		repository:     repository,
+		repoType:       repoType,
+	}
+}
+
+type repositoryHandler struct {
+	*projectHandler
+
+	projectID  string
+	repository string
+	repoType   repo.RepositoryType
+}
+
+// Get 获取指定的repository信息
+func (rh *repositoryHandler) Get(ctx context.Context) (*repo.Repository, error) {
+	return rh.getRepository(ctx)
+}
+
+// Create 创建一个repository, ChartMuseum 多租户仓库和 OCI 仓库均在首次上传时自动创建, 这里只返回仓库地址
+func (rh *repositoryHandler) Create(ctx context.Context, repository *repo.Repository) (string, error) {
+	if repository == nil {
+		return "", fmt.Errorf("repository can not be empty")
+	}
+
+	repository.ProjectID = rh.projectID
+	repository.Name = rh.repository
+	repository.Type = rh.repoType
+	return rh.createRepository(ctx, repository)
+}
+
+// ListChart 针对给定的分页信息, 返回chart维度的list数据, 同一个chart的多个版本会被合并, 只展示最新的版本信息
+func (rh *repositoryHandler) ListChart(ctx context.Context, option repo.ListOption) (*repo.ListChartData, error) {
+	return rh.listChart(ctx, option)
+}
+
+// SearchChart 针对给定的chart名称关键字模糊查询, 返回chart维度的list数据
+func (rh *repositoryHandler) SearchChart(ctx context.Context, option repo.ListOption) (*repo.ListChartData, error) {
+	return rh.searchChart(ctx, option)
+}
+
+// GetChartDetail get chart detail
+func (rh *repositoryHandler) GetChartDetail(ctx context.Context, name string) (*repo.Chart, error) {
+	return rh.getChartDetail(ctx, name)
+}
+
+// Chart 针对给定的chart名称, 返回一个 repo.ChartHandler 实例, 用于chart层级的所有操作
+func (rh *repositoryHandler) Chart(chartName string) repo.ChartHandler {
+	return &chartHandler{
+		repositoryHandler: rh,
+		projectID:         rh.projectID,
+		repository:        rh.repository,
+		repoType:          rh.repoType,
+		chartName:         chartName,
+	}
+}
+
+// UploadChart 上传自定义版本chart
+func (rh *repositoryHandler) UploadChart(ctx context.Context, option repo.UploadOption) error {
+	return rh.uploadChart(ctx, option)
+}
+
+// CreateUser 标准仓库没有统一的账号管理接口, 返回平台配置的账号信息
+func (rh *repositoryHandler) CreateUser(_ context.Context) (string, string, error) {
+	blog.Infof("generic repo platform use platform account for repository %s in project %s",
+		rh.repository, rh.projectID)
+	return rh.config.Username, rh.config.Password, nil
+}
+
+type chartHandler struct {
+	*repositoryHandler
+
+	projectID  string
+	repository string
+	repoType   repo.RepositoryType
+	chartName  string
+}
+
+// ListVersion 返回该chart的版本信息列表
+func (ch *chartHandler) ListVersion(ctx context.Context, option repo.ListOption) (*repo.ListChartVersionData, error) {
+	return ch.listChartVersion(ctx, option)
+}
+
+// Detail 返回该chart指定version的详细信息
+func (ch *chartHandler) Detail(ctx context.Context, version string) (*repo.ChartDetail, error) {
+	return ch.getChartVersionDetail(ctx, version)
+}
+
+// Download 返回该chart指定version的源文件信息
+func (ch *chartHandler) Download(ctx context.Context, version string) ([]byte, error) {
+	return ch.downloadChartVersion(ctx, version)
+}
+
+// Delete delete chart
+func (ch *chartHandler) Delete(ctx context.Context) error {
+	return ch.deleteChart(ctx)
+}
+
+// DeleteVersion delete chart version
+func (ch *chartHandler) DeleteVersion(ctx context.Context, version string) error {
+	return ch.deleteChartVersion(ctx, version)
+}
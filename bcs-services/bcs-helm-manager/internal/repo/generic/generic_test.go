@@ -0,0 +1,178 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generic
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/repo"
+)
+
+const testIndex = `apiVersion: v1
+entries:
+  nginx:
+  - name: nginx
+    version: 1.0.0
+    appVersion: "1.20"
+    description: nginx chart
+    created: "2024-01-01T00:00:00Z"
+    urls:
+    - charts/nginx-1.0.0.tgz
+  - name: nginx
+    version: 1.1.0
+    appVersion: "1.21"
+    description: nginx chart v1.1
+    created: "2024-02-01T00:00:00Z"
+    urls:
+    - charts/nginx-1.1.0.tgz
+  redis:
+  - name: redis
+    version: 0.1.0
+    created: "2024-01-01T00:00:00Z"
+    urls:
+    - charts/redis-0.1.0.tgz
+`
+
+func newTestChartTgz(t *testing.T) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	files := map[string]string{
+		"nginx/Chart.yaml":  "apiVersion: v2\nname: nginx\nversion: 1.1.0\n",
+		"nginx/values.yaml": "replicas: 1\n",
+	}
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{
+			Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func newTestChartMuseum(t *testing.T) (*httptest.Server, *[]string) {
+	tgz := newTestChartTgz(t)
+	var mtx sync.Mutex
+	deleted := make([]string, 0)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/proj/repo/index.yaml", func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "admin" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(testIndex))
+	})
+	mux.HandleFunc("/proj/repo/charts/nginx-1.1.0.tgz", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(tgz)
+	})
+	mux.HandleFunc("/api/proj/repo/charts/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		mtx.Lock()
+		deleted = append(deleted, r.URL.Path)
+		mtx.Unlock()
+		_, _ = w.Write([]byte(`{"deleted":true}`))
+	})
+	return httptest.NewServer(mux), &deleted
+}
+
+func TestHelmRepository(t *testing.T) {
+	server, deleted := newTestChartMuseum(t)
+	defer server.Close()
+
+	ctx := context.Background()
+	platform := New(repo.Config{URL: server.URL, Username: "admin", Password: "secret"})
+	rh := platform.User(repo.User{Name: "user"}).Project("proj").Repository(repo.RepositoryTypeHelm, "repo")
+
+	if _, err := rh.Get(ctx); err != nil {
+		t.Fatalf("get repository failed, %s", err)
+	}
+	repoURL, err := rh.Create(ctx, &repo.Repository{})
+	if err != nil || repoURL != server.URL+"/proj/repo" {
+		t.Fatalf("create repository get url %s, err %v", repoURL, err)
+	}
+
+	charts, err := rh.ListChart(ctx, repo.ListOption{Page: 1, Size: 1})
+	if err != nil {
+		t.Fatalf("list chart failed, %s", err)
+	}
+	if charts.Total != 2 || len(charts.Charts) != 1 || charts.Charts[0].Name != "nginx" {
+		t.Fatalf("list chart get unexpected result %+v", charts)
+	}
+	if charts.Charts[0].Version != "1.1.0" || charts.Charts[0].AppVersion != "1.21" {
+		t.Errorf("list chart should return the latest version, get %+v", charts.Charts[0])
+	}
+
+	charts, err = rh.SearchChart(ctx, repo.ListOption{PackageName: "red"})
+	if err != nil || charts.Total != 1 || charts.Charts[0].Name != "redis" {
+		t.Fatalf("search chart get unexpected result %+v, err %v", charts, err)
+	}
+
+	versions, err := rh.Chart("nginx").ListVersion(ctx, repo.ListOption{})
+	if err != nil || versions.Total != 2 || versions.Versions[0].Version != "1.1.0" {
+		t.Fatalf("list chart version get unexpected result %+v, err %v", versions, err)
+	}
+
+	detail, err := rh.Chart("nginx").Detail(ctx, "1.1.0")
+	if err != nil {
+		t.Fatalf("get chart detail failed, %s", err)
+	}
+	if _, ok := detail.Contents["nginx/values.yaml"]; !ok {
+		t.Errorf("chart detail should contain values.yaml, get %v", detail.Contents)
+	}
+
+	if _, err = rh.Chart("nginx").Download(ctx, "2.0.0"); err == nil {
+		t.Errorf("download not exist version should failed")
+	}
+
+	if err = rh.Chart("nginx").Delete(ctx); err != nil {
+		t.Fatalf("delete chart failed, %s", err)
+	}
+	if len(*deleted) != 2 || (*deleted)[0] != "/api/proj/repo/charts/nginx/1.1.0" {
+		t.Errorf("delete chart should delete all versions, get %v", *deleted)
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	params := parseChallenge(`realm="https://harbor.example.com/service/token",` +
+		`service="harbor-registry",scope="repository:proj/repo/nginx:pull"`)
+	if params["realm"] != "https://harbor.example.com/service/token" ||
+		params["service"] != "harbor-registry" ||
+		params["scope"] != "repository:proj/repo/nginx:pull" {
+		t.Errorf("parse challenge get unexpected result %v", params)
+	}
+
+	if next := getNextLink(`</v2/_catalog?last=proj%2Frepo%2Fnginx&n=2>; rel="next"`); next !=
+		"/v2/_catalog?last=proj%2Frepo%2Fnginx&n=2" {
+		t.Errorf("get next link get unexpected result %s", next)
+	}
+}
@@ -0,0 +1,338 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	cm "github.com/chartmuseum/helm-push/pkg/chartmuseum"
+	helmrepo "helm.sh/helm/v3/pkg/repo"
+	"sigs.k8s.io/yaml"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/repo"
+)
+
+const (
+	// helmIndexFile chart 仓库的索引文件
+	helmIndexFile = "index.yaml"
+)
+
+// getHelmRepoURL 返回 HELM 仓库地址, {URL}/{projectID}/{repository}
+func (rh *repositoryHandler) getHelmRepoURL() string {
+	return fmt.Sprintf("%s/%s/%s", strings.TrimRight(rh.config.URL, "/"), rh.projectID, rh.repository)
+}
+
+// getHelmAPIURL 返回 ChartMuseum 的 chart 管理接口地址, {URL}/api/{projectID}/{repository}/charts
+func (rh *repositoryHandler) getHelmAPIURL() (string, error) {
+	u, err := url.Parse(rh.config.URL)
+	if err != nil {
+		return "", err
+	}
+	u.Path = path.Join(u.Path, "api", rh.projectID, rh.repository, "charts")
+	return u.String(), nil
+}
+
+// getHelmRepository 通过仓库的 index.yaml 确认仓库存在
+func (rh *repositoryHandler) getHelmRepository(ctx context.Context) (*repo.Repository, error) {
+	if _, err := rh.loadHelmIndex(ctx); err != nil {
+		return nil, err
+	}
+
+	return &repo.Repository{
+		ProjectID: rh.projectID,
+		Name:      rh.repository,
+		Type:      repo.RepositoryTypeHelm,
+	}, nil
+}
+
+// loadHelmIndex 获取并解析仓库的 index.yaml, 每个chart的版本按从新到旧排序
+func (rh *repositoryHandler) loadHelmIndex(ctx context.Context) (*helmrepo.IndexFile, error) {
+	resp, err := rh.request(ctx, http.MethodGet, rh.getHelmRepoURL()+"/"+helmIndexFile, nil, nil)
+	if err != nil {
+		blog.Errorf("load helm index from repo failed, %s, with projectID %s, repoName %s",
+			err.Error(), rh.projectID, rh.repository)
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errNotExist
+	}
+	if resp.StatusCode != http.StatusOK {
+		blog.Errorf("load helm index from repo get http code %d, with projectID %s, repoName %s",
+			resp.StatusCode, rh.projectID, rh.repository)
+		return nil, fmt.Errorf("load helm index failed, http code %d", resp.StatusCode)
+	}
+
+	index := &helmrepo.IndexFile{}
+	if err = yaml.Unmarshal(resp.Body, index); err != nil {
+		blog.Errorf("load helm index from repo decode failed, %s, with projectID %s, repoName %s",
+			err.Error(), rh.projectID, rh.repository)
+		return nil, err
+	}
+	index.SortEntries()
+	return index, nil
+}
+
+// listHelmChart 按chart名称排序分页, 名称中包含 PackageName 的chart才会返回
+func (rh *repositoryHandler) listHelmChart(ctx context.Context, option repo.ListOption) (*repo.ListChartData, error) {
+	index, err := rh.loadHelmIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(index.Entries))
+	for name, versions := range index.Entries {
+		if len(versions) == 0 || !strings.Contains(name, option.PackageName) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	start, end := paginate(len(names), &option)
+	data := make([]*repo.Chart, 0, end-start)
+	for _, name := range names[start:end] {
+		data = append(data, convertHelmChart(name, index.Entries[name]))
+	}
+	return &repo.ListChartData{
+		Total:  int64(len(names)),
+		Page:   option.Page,
+		Size:   option.Size,
+		Charts: data,
+	}, nil
+}
+
+// getHelmChart 从 index.yaml 中获取chart的最新版本信息
+func (rh *repositoryHandler) getHelmChart(ctx context.Context, name string) (*repo.Chart, error) {
+	index, err := rh.loadHelmIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, ok := index.Entries[name]
+	if !ok || len(versions) == 0 {
+		return nil, fmt.Errorf("chart %s %s", name, errNotExist)
+	}
+	return convertHelmChart(name, versions), nil
+}
+
+// uploadHelmChart 通过 ChartMuseum 的接口上传chart, ChartMuseum 多租户仓库的接口为 /api/{projectID}/{repository}/charts
+func (rh *repositoryHandler) uploadHelmChart(_ context.Context, option repo.UploadOption) error {
+	chartPackagePath, clean, err := createChartPackage(option)
+	if err != nil {
+		return err
+	}
+	defer clean()
+
+	u, err := url.Parse(rh.config.URL)
+	if err != nil {
+		return err
+	}
+	username, password := rh.credential()
+	cmClient, err := cm.NewClient(
+		cm.URL(rh.getHelmRepoURL()),
+		cm.ContextPath(strings.TrimRight(u.Path, "/")),
+		cm.Username(username),
+		cm.Password(password),
+		cm.Timeout(timeout),
+	)
+	if err != nil {
+		return fmt.Errorf("creates client fail, %s", err)
+	}
+
+	// 上传chart
+	chartPackage, err := cmClient.UploadChartPackage(chartPackagePath, option.Force)
+	if err != nil {
+		return fmt.Errorf("uploads a chart package fail, %s", err)
+	}
+	defer chartPackage.Body.Close()
+	if chartPackage.StatusCode != http.StatusCreated {
+		return fmt.Errorf("uploads a chart package response error, %s", chartPackage.Status)
+	}
+	return nil
+}
+
+// listHelmChartVersion 从 index.yaml 中获取chart的版本列表, 按版本从新到旧排序
+func (ch *chartHandler) listHelmChartVersion(ctx context.Context, option repo.ListOption) (
+	*repo.ListChartVersionData, error) {
+
+	versions, err := ch.getHelmChartVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := paginate(len(versions), &option)
+	data := make([]*repo.ChartVersion, 0, end-start)
+	for _, v := range versions[start:end] {
+		data = append(data, convertHelmChartVersion(ch.chartName, v))
+	}
+	return &repo.ListChartVersionData{
+		Total:    int64(len(versions)),
+		Page:     option.Page,
+		Size:     option.Size,
+		Versions: data,
+	}, nil
+}
+
+func (ch *chartHandler) getHelmChartVersions(ctx context.Context) (helmrepo.ChartVersions, error) {
+	index, err := ch.loadHelmIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, ok := index.Entries[ch.chartName]
+	if !ok || len(versions) == 0 {
+		return nil, fmt.Errorf("chart %s %s", ch.chartName, errNotExist)
+	}
+	return versions, nil
+}
+
+// downloadHelmChartVersionOrigin 根据 index.yaml 中记录的地址下载chart包
+func (ch *chartHandler) downloadHelmChartVersionOrigin(ctx context.Context, version string) ([]byte, error) {
+	chartURL, err := ch.getDownloadHelmChartVersionURL(ctx, version)
+	if err != nil {
+		blog.Errorf("download helm chart version origin from repo failed, %s, "+
+			"with projectID %s, repoName %s, chartName %s, version %s",
+			err.Error(), ch.projectID, ch.repository, ch.chartName, version)
+		return nil, err
+	}
+
+	resp, err := ch.request(ctx, http.MethodGet, chartURL, nil, nil)
+	if err != nil {
+		blog.Errorf("download helm chart version origin from repo get failed, %s, "+
+			"with projectID %s, repoName %s, chartName %s, version %s",
+			err.Error(), ch.projectID, ch.repository, ch.chartName, version)
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download helm chart version error, http code %d", resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+// getDownloadHelmChartVersionURL index.yaml 中的地址可能为相对地址, 相对于仓库地址解析
+func (ch *chartHandler) getDownloadHelmChartVersionURL(ctx context.Context, version string) (string, error) {
+	versions, err := ch.getHelmChartVersions(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range versions {
+		if v.Version != version {
+			continue
+		}
+		if len(v.URLs) == 0 {
+			return "", fmt.Errorf("chart %s version %s has no download url", ch.chartName, version)
+		}
+		base, err := url.Parse(ch.getHelmRepoURL() + "/")
+		if err != nil {
+			return "", err
+		}
+		ref, err := url.Parse(v.URLs[0])
+		if err != nil {
+			return "", err
+		}
+		return base.ResolveReference(ref).String(), nil
+	}
+	return "", fmt.Errorf("chart %s version %s %s", ch.chartName, version, errNotExist)
+}
+
+// deleteHelmChart 逐个删除chart的所有版本, ChartMuseum 在最后一个版本删除后移除该chart
+func (ch *chartHandler) deleteHelmChart(ctx context.Context) error {
+	versions, err := ch.getHelmChartVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range versions {
+		if err = ch.deleteHelmChartVersion(ctx, v.Version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteHelmChartVersion 通过 ChartMuseum 的接口删除chart版本, DELETE /api/{projectID}/{repository}/charts/{name}/{version}
+func (ch *chartHandler) deleteHelmChartVersion(ctx context.Context, version string) error {
+	apiURL, err := ch.getHelmAPIURL()
+	if err != nil {
+		return err
+	}
+
+	resp, err := ch.request(ctx, http.MethodDelete,
+		fmt.Sprintf("%s/%s/%s", apiURL, url.PathEscape(ch.chartName), url.PathEscape(version)), nil, nil)
+	if err != nil {
+		blog.Errorf(
+			"delete chart version from repo failed, %s, with projectID %s, repoName %s, chartName %s, version %s",
+			err.Error(), ch.projectID, ch.repository, ch.chartName, version)
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		blog.Errorf("delete chart version from repo get http code %d, resp %s", resp.StatusCode, resp.Body)
+		return fmt.Errorf("delete chart version err with http code %d, %s", resp.StatusCode, resp.Body)
+	}
+	return nil
+}
+
+// convertHelmChart 将 index.yaml 中的chart信息转换为chart信息, versions 需按从新到旧排序
+func convertHelmChart(name string, versions helmrepo.ChartVersions) *repo.Chart {
+	latest := versions[0]
+	chart := &repo.Chart{
+		Key:  "helm://" + name,
+		Name: name,
+		Type: repo.RepositoryTypeHelm.String(),
+	}
+	if latest.Metadata != nil {
+		chart.Version = latest.Version
+		chart.AppVersion = latest.AppVersion
+		chart.Description = latest.Description
+	}
+
+	// 以最早的版本创建时间作为chart的创建时间, 最新的版本创建时间作为chart的更新时间
+	for _, v := range versions {
+		if v.Created.IsZero() {
+			continue
+		}
+		created := v.Created.Local().Format(common.TimeFormat)
+		if chart.CreateTime == "" || created < chart.CreateTime {
+			chart.CreateTime = created
+		}
+		if created > chart.UpdateTime {
+			chart.UpdateTime = created
+		}
+	}
+	return chart
+}
+
+// convertHelmChartVersion 将 index.yaml 中的版本信息, 转换为chart version信息
+func convertHelmChartVersion(name string, v *helmrepo.ChartVersion) *repo.ChartVersion {
+	version := &repo.ChartVersion{Name: name}
+	if v.Metadata != nil {
+		version.Version = v.Version
+		version.AppVersion = v.AppVersion
+		version.Description = v.Description
+	}
+	if !v.Created.IsZero() {
+		version.CreateTime = v.Created.Local().Format(common.TimeFormat)
+		version.UpdateTime = version.CreateTime
+	}
+	return version
+}
@@ -0,0 +1,428 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/registry"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/repo"
+)
+
+const (
+	// registryCatalogURI 获取 registry 下所有的 repository, 需要账号具有相应的权限
+	registryCatalogURI = "/v2/_catalog"
+	// registryManifestURI 获取和删除 manifest
+	registryManifestURI = "/v2/%s/manifests/%s"
+
+	// catalogPageSize 分页获取 catalog 的大小
+	catalogPageSize = 1000
+)
+
+// getOCIRegistry 返回 registry 的 scheme 和 host, OciURL 未指定 scheme 时默认为 https
+func (h *handler) getOCIRegistry() (string, string, error) {
+	ociURL := h.config.OciURL
+	if !strings.Contains(ociURL, "://") {
+		ociURL = "https://" + ociURL
+	}
+	u, err := url.Parse(ociURL)
+	if err != nil {
+		return "", "", err
+	}
+	if u.Host == "" {
+		return "", "", fmt.Errorf("invalid oci url %s", h.config.OciURL)
+	}
+	return u.Scheme, u.Host, nil
+}
+
+// getOCIRepoPrefix 返回仓库在 registry 中的路径前缀, {projectID}/{repository}
+func (rh *repositoryHandler) getOCIRepoPrefix() string {
+	return rh.projectID + "/" + rh.repository
+}
+
+// getOCIRepoURL 返回 OCI 仓库地址, oci://{host}/{projectID}/{repository}
+func (rh *repositoryHandler) getOCIRepoURL() (string, error) {
+	_, host, err := rh.getOCIRegistry()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s://%s/%s", registry.OCIScheme, host, rh.getOCIRepoPrefix()), nil
+}
+
+// getOCIChartRef 返回chart的引用地址, {host}/{projectID}/{repository}/{chartName}
+func (rh *repositoryHandler) getOCIChartRef(chartName string) (string, error) {
+	_, host, err := rh.getOCIRegistry()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s/%s", host, rh.getOCIRepoPrefix(), chartName), nil
+}
+
+// newRegistryClient 返回已登录 registry 的 helm registry client
+func (h *handler) newRegistryClient() (*registry.Client, error) {
+	scheme, host, err := h.getOCIRegistry()
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []registry.ClientOption
+	if scheme == "http" {
+		opts = append(opts, registry.ClientOptPlainHTTP())
+	}
+	cli, err := registry.NewClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	username, password := h.credential()
+	if username == "" && password == "" {
+		return cli, nil
+	}
+	if err = cli.Login(host,
+		registry.LoginOptBasicAuth(username, password),
+		registry.LoginOptInsecure(scheme == "http")); err != nil {
+		return nil, err
+	}
+	return cli, nil
+}
+
+// registryRequest 请求 registry 的 HTTP API V2
+func (h *handler) registryRequest(ctx context.Context, method, uri string, header http.Header) (*response, error) {
+	scheme, host, err := h.getOCIRegistry()
+	if err != nil {
+		return nil, err
+	}
+	return h.request(ctx, method, fmt.Sprintf("%s://%s%s", scheme, host, uri), header, nil)
+}
+
+// getOCIRepository 确认 registry 可以访问, registry 中的仓库在首次推送时自动创建
+func (rh *repositoryHandler) getOCIRepository(ctx context.Context) (*repo.Repository, error) {
+	resp, err := rh.registryRequest(ctx, http.MethodGet, "/v2/", nil)
+	if err != nil {
+		blog.Errorf("get repository from registry failed, %s, projectID: %s, name: %s",
+			err.Error(), rh.projectID, rh.repository)
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request registry error with http code %d", resp.StatusCode)
+	}
+
+	return &repo.Repository{
+		ProjectID: rh.projectID,
+		Name:      rh.repository,
+		Type:      repo.RepositoryTypeOCI,
+	}, nil
+}
+
+// listOCIChartNames 通过 catalog 接口获取仓库下所有chart名称
+func (rh *repositoryHandler) listOCIChartNames(ctx context.Context) ([]string, error) {
+	prefix := rh.getOCIRepoPrefix() + "/"
+	names := make([]string, 0)
+	uri := fmt.Sprintf("%s?n=%d", registryCatalogURI, catalogPageSize)
+	for uri != "" {
+		resp, err := rh.registryRequest(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			blog.Errorf("list oci chart from registry failed, %s, with projectID %s, repoName %s",
+				err.Error(), rh.projectID, rh.repository)
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			blog.Errorf("list oci chart from registry get http code %d, resp %s", resp.StatusCode, resp.Body)
+			return nil, fmt.Errorf("list oci chart error with http code %d", resp.StatusCode)
+		}
+
+		var r catalogResp
+		if err = json.Unmarshal(resp.Body, &r); err != nil {
+			blog.Errorf("list oci chart from registry decode failed, %s, with resp %s", err.Error(), resp.Body)
+			return nil, err
+		}
+		for _, item := range r.Repositories {
+			// 只返回仓库下一级的 repository
+			name := strings.TrimPrefix(item, prefix)
+			if name == item || name == "" || strings.Contains(name, "/") {
+				continue
+			}
+			names = append(names, name)
+		}
+
+		uri = getNextLink(resp.Header.Get("Link"))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+type catalogResp struct {
+	Repositories []string `json:"repositories"`
+}
+
+// getNextLink 解析分页返回中的 Link 头, 形如 </v2/_catalog?last=xxx&n=100>; rel="next"
+func getNextLink(link string) string {
+	if link == "" || !strings.Contains(link, `rel="next"`) {
+		return ""
+	}
+	start, end := strings.Index(link, "<"), strings.Index(link, ">")
+	if start < 0 || end <= start {
+		return ""
+	}
+	next := link[start+1 : end]
+	// 部分 registry 返回完整地址
+	if u, err := url.Parse(next); err == nil && u.IsAbs() {
+		return u.RequestURI()
+	}
+	return next
+}
+
+// listOCIChart 按chart名称排序分页, 名称中包含 PackageName 的chart才会返回, 只返回最新版本号
+func (rh *repositoryHandler) listOCIChart(ctx context.Context, option repo.ListOption) (*repo.ListChartData, error) {
+	names, err := rh.listOCIChartNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]string, 0, len(names))
+	for _, name := range names {
+		if strings.Contains(name, option.PackageName) {
+			filtered = append(filtered, name)
+		}
+	}
+
+	cli, err := rh.newRegistryClient()
+	if err != nil {
+		return nil, err
+	}
+	start, end := paginate(len(filtered), &option)
+	data := make([]*repo.Chart, 0, end-start)
+	for _, name := range filtered[start:end] {
+		chart := &repo.Chart{
+			Key:  "oci://" + name,
+			Name: name,
+			Type: repo.RepositoryTypeOCI.String(),
+		}
+		if tags, err := rh.listOCIChartTags(cli, name); err == nil && len(tags) > 0 {
+			chart.Version = tags[0]
+		}
+		data = append(data, chart)
+	}
+	return &repo.ListChartData{
+		Total:  int64(len(filtered)),
+		Page:   option.Page,
+		Size:   option.Size,
+		Charts: data,
+	}, nil
+}
+
+// listOCIChartTags 返回chart的版本列表, 按版本从新到旧排序
+func (rh *repositoryHandler) listOCIChartTags(cli *registry.Client, chartName string) ([]string, error) {
+	ref, err := rh.getOCIChartRef(chartName)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := cli.Tags(ref)
+	if err != nil {
+		blog.Errorf("list oci chart tags from registry failed, %s, with projectID %s, repoName %s, chartName %s",
+			err.Error(), rh.projectID, rh.repository, chartName)
+		return nil, err
+	}
+	return tags, nil
+}
+
+// getOCIChart 拉取chart的最新版本, 从 Chart.yaml 中获取chart信息
+func (rh *repositoryHandler) getOCIChart(_ context.Context, name string) (*repo.Chart, error) {
+	cli, err := rh.newRegistryClient()
+	if err != nil {
+		return nil, err
+	}
+	tags, err := rh.listOCIChartTags(cli, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("chart %s %s", name, errNotExist)
+	}
+
+	data, err := rh.pullOCIChart(cli, name, tags[0])
+	if err != nil {
+		return nil, err
+	}
+	c, err := loader.LoadArchive(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	return &repo.Chart{
+		Key:         "oci://" + name,
+		Name:        name,
+		Type:        repo.RepositoryTypeOCI.String(),
+		Version:     c.Metadata.Version,
+		AppVersion:  c.Metadata.AppVersion,
+		Description: c.Metadata.Description,
+	}, nil
+}
+
+// pullOCIChart 拉取chart包
+func (rh *repositoryHandler) pullOCIChart(cli *registry.Client, chartName, version string) ([]byte, error) {
+	ref, err := rh.getOCIChartRef(chartName)
+	if err != nil {
+		return nil, err
+	}
+	r, err := cli.Pull(ref + ":" + version)
+	if err != nil {
+		blog.Errorf("pull oci chart from registry failed, %s, "+
+			"with projectID %s, repoName %s, chartName %s, version %s",
+			err.Error(), rh.projectID, rh.repository, chartName, version)
+		return nil, err
+	}
+	return r.Chart.Data, nil
+}
+
+// uploadOCIChart 推送chart到 registry, 非强制上传时若版本已存在则返回错误
+func (rh *repositoryHandler) uploadOCIChart(_ context.Context, option repo.UploadOption) error {
+	chartPackagePath, clean, err := createChartPackage(option)
+	if err != nil {
+		return err
+	}
+	defer clean()
+
+	data, err := os.ReadFile(chartPackagePath)
+	if err != nil {
+		return err
+	}
+	c, err := loader.LoadArchive(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed load chart, %s", err)
+	}
+
+	cli, err := rh.newRegistryClient()
+	if err != nil {
+		return fmt.Errorf("creates client fail, %s", err)
+	}
+	if !option.Force {
+		tags, _ := rh.listOCIChartTags(cli, c.Name())
+		for _, tag := range tags {
+			if tag == c.Metadata.Version {
+				return fmt.Errorf("chart %s version %s already exists", c.Name(), tag)
+			}
+		}
+	}
+
+	ref, err := rh.getOCIChartRef(c.Name())
+	if err != nil {
+		return err
+	}
+	if _, err = cli.Push(data, ref+":"+c.Metadata.Version); err != nil {
+		return fmt.Errorf("push chart to registry fail, %s", err)
+	}
+	return nil
+}
+
+// listOCIChartVersion 返回chart的版本列表, 按版本从新到旧排序
+func (ch *chartHandler) listOCIChartVersion(_ context.Context, option repo.ListOption) (
+	*repo.ListChartVersionData, error) {
+
+	cli, err := ch.newRegistryClient()
+	if err != nil {
+		return nil, err
+	}
+	tags, err := ch.listOCIChartTags(cli, ch.chartName)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := paginate(len(tags), &option)
+	data := make([]*repo.ChartVersion, 0, end-start)
+	for _, tag := range tags[start:end] {
+		data = append(data, &repo.ChartVersion{Name: ch.chartName, Version: tag})
+	}
+	return &repo.ListChartVersionData{
+		Total:    int64(len(tags)),
+		Page:     option.Page,
+		Size:     option.Size,
+		Versions: data,
+	}, nil
+}
+
+// downloadOCIChartVersionOrigin
+func (ch *chartHandler) downloadOCIChartVersionOrigin(_ context.Context, version string) ([]byte, error) {
+	cli, err := ch.newRegistryClient()
+	if err != nil {
+		blog.Errorf("download oci chart version origin new registry client failed, %s, "+
+			"with projectID %s, repoName %s, chartName %s, version %s",
+			err.Error(), ch.projectID, ch.repository, ch.chartName, version)
+		return nil, err
+	}
+	return ch.pullOCIChart(cli, ch.chartName, version)
+}
+
+// deleteOCIChart 逐个删除chart的所有版本
+func (ch *chartHandler) deleteOCIChart(ctx context.Context) error {
+	cli, err := ch.newRegistryClient()
+	if err != nil {
+		return err
+	}
+	tags, err := ch.listOCIChartTags(cli, ch.chartName)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		if err = ch.deleteOCIChartVersion(ctx, tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteOCIChartVersion registry 只支持按 digest 删除 manifest, 先查询 tag 对应的 digest 再删除
+func (ch *chartHandler) deleteOCIChartVersion(ctx context.Context, version string) error {
+	name := ch.getOCIRepoPrefix() + "/" + ch.chartName
+	header := http.Header{}
+	header.Set("Accept", "application/vnd.oci.image.manifest.v1+json")
+	resp, err := ch.registryRequest(ctx, http.MethodHead, fmt.Sprintf(registryManifestURI, name, version), header)
+	if err != nil {
+		blog.Errorf(
+			"delete chart version from registry failed, %s, with projectID %s, repoName %s, chartName %s, version %s",
+			err.Error(), ch.projectID, ch.repository, ch.chartName, version)
+		return err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("chart %s version %s %s", ch.chartName, version, errNotExist)
+	}
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if resp.StatusCode != http.StatusOK || digest == "" {
+		return fmt.Errorf("get chart version digest err with http code %d", resp.StatusCode)
+	}
+
+	resp, err = ch.registryRequest(ctx, http.MethodDelete, fmt.Sprintf(registryManifestURI, name, digest), nil)
+	if err != nil {
+		blog.Errorf(
+			"delete chart version from registry failed, %s, with projectID %s, repoName %s, chartName %s, version %s",
+			err.Error(), ch.projectID, ch.repository, ch.chartName, version)
+		return err
+	}
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		blog.Errorf("delete chart version from registry get http code %d, resp %s", resp.StatusCode, resp.Body)
+		return fmt.Errorf("delete chart version err with http code %d, %s", resp.StatusCode, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generic
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/chartmuseum/helm-push/pkg/helm"
+	"k8s.io/helm/pkg/chartutil"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/repo"
+)
+
+func (rh *repositoryHandler) getRepository(ctx context.Context) (*repo.Repository, error) {
+	blog.Infof("get repository from generic repo projectID: %s, type: %s, name: %s",
+		rh.projectID, rh.repoType, rh.repository)
+
+	switch rh.repoType {
+	case repo.RepositoryTypeHelm:
+		return rh.getHelmRepository(ctx)
+	case repo.RepositoryTypeOCI:
+		return rh.getOCIRepository(ctx)
+	default:
+		return nil, fmt.Errorf("unknown repo type %d", rh.repoType)
+	}
+}
+
+func (rh *repositoryHandler) createRepository(_ context.Context, rp *repo.Repository) (string, error) {
+	blog.Infof("create repository to generic repo with data %v", rp)
+
+	if rp.Remote {
+		return "", fmt.Errorf("generic repo platform does not support remote repository")
+	}
+
+	switch rh.repoType {
+	case repo.RepositoryTypeHelm:
+		return rh.getHelmRepoURL(), nil
+	case repo.RepositoryTypeOCI:
+		return rh.getOCIRepoURL()
+	default:
+		return "", fmt.Errorf("unknown repo type %d", rh.repoType)
+	}
+}
+
+// list chart
+func (rh *repositoryHandler) listChart(ctx context.Context, option repo.ListOption) (*repo.ListChartData, error) {
+	switch rh.repoType {
+	case repo.RepositoryTypeHelm:
+		return rh.listHelmChart(ctx, option)
+	case repo.RepositoryTypeOCI:
+		return rh.listOCIChart(ctx, option)
+	default:
+		return nil, fmt.Errorf("unknown repo type %d", rh.repoType)
+	}
+}
+
+// search chart, 标准仓库没有搜索接口, 与 list chart 一致按名称模糊匹配
+func (rh *repositoryHandler) searchChart(ctx context.Context, option repo.ListOption) (*repo.ListChartData, error) {
+	return rh.listChart(ctx, option)
+}
+
+// get chart detail
+func (rh *repositoryHandler) getChartDetail(ctx context.Context, name string) (*repo.Chart, error) {
+	switch rh.repoType {
+	case repo.RepositoryTypeHelm:
+		return rh.getHelmChart(ctx, name)
+	case repo.RepositoryTypeOCI:
+		return rh.getOCIChart(ctx, name)
+	default:
+		return nil, fmt.Errorf("unknown repo type %d", rh.repoType)
+	}
+}
+
+// uploadChart
+func (rh *repositoryHandler) uploadChart(ctx context.Context, option repo.UploadOption) error {
+	switch rh.repoType {
+	case repo.RepositoryTypeHelm:
+		return rh.uploadHelmChart(ctx, option)
+	case repo.RepositoryTypeOCI:
+		return rh.uploadOCIChart(ctx, option)
+	default:
+		return fmt.Errorf("unknown repo type %d", rh.repoType)
+	}
+}
+
+// createChartPackage 将上传的chart按自定义版本重新打包到临时目录, 返回chart包路径和清理临时目录的方法
+func createChartPackage(option repo.UploadOption) (string, func(), error) {
+	chart, err := chartutil.LoadArchive(option.Content)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed load chart, %s", err)
+	}
+	helmChart := &helm.Chart{Chart: chart}
+	// 设置自定义版本
+	if option.Version != "" {
+		helmChart.SetVersion(option.Version)
+	}
+
+	// 创建临时目录
+	tmp, err := os.MkdirTemp("", "helm-push-")
+	if err != nil {
+		return "", nil, fmt.Errorf("error creates a new temporary directory in the directory dir, %s", err)
+	}
+	clean := func() {
+		if err := os.RemoveAll(tmp); err != nil {
+			blog.Errorf("failed to remove temporary directory, %s: %s", tmp, err.Error())
+		}
+	}
+	chartPackagePath, err := helm.CreateChartPackage(helmChart, tmp)
+	if err != nil {
+		clean()
+		return "", nil, fmt.Errorf("creates chart package in directory error, %s", err)
+	}
+	return chartPackagePath, clean, nil
+}
+
+func (ch *chartHandler) listChartVersion(ctx context.Context, option repo.ListOption) (
+	*repo.ListChartVersionData, error) {
+
+	switch ch.repoType {
+	case repo.RepositoryTypeHelm:
+		return ch.listHelmChartVersion(ctx, option)
+	case repo.RepositoryTypeOCI:
+		return ch.listOCIChartVersion(ctx, option)
+	default:
+		return nil, fmt.Errorf("unknown repo type %d", ch.repoType)
+	}
+}
+
+// getChartVersionDetail
+func (ch *chartHandler) getChartVersionDetail(ctx context.Context, version string) (*repo.ChartDetail, error) {
+	contents, err := ch.downloadChartVersion(ctx, version)
+	if err != nil {
+		blog.Errorf("get chart version detail get origin contents failed, %s, "+
+			"with projectID %s, repoName %s, chartName %s, version %s",
+			err.Error(), ch.projectID, ch.repository, ch.chartName, version)
+		return nil, err
+	}
+
+	detail := &repo.ChartDetail{
+		Name:    ch.chartName,
+		Version: version,
+	}
+	if err = detail.LoadContentFromTgz(contents); err != nil {
+		blog.Errorf("get chart version detail load from gzip file failed, %s, "+
+			"with projectID %s, repoName %s, chartName %s, version %s",
+			err.Error(), ch.projectID, ch.repository, ch.chartName, version)
+		return nil, err
+	}
+
+	return detail, nil
+}
+
+// downloadChartVersion
+func (ch *chartHandler) downloadChartVersion(ctx context.Context, version string) ([]byte, error) {
+	switch ch.repoType {
+	case repo.RepositoryTypeHelm:
+		return ch.downloadHelmChartVersionOrigin(ctx, version)
+	case repo.RepositoryTypeOCI:
+		return ch.downloadOCIChartVersionOrigin(ctx, version)
+	default:
+		return nil, fmt.Errorf("unknown repo type %d", ch.repoType)
+	}
+}
+
+// deleteChart
+func (ch *chartHandler) deleteChart(ctx context.Context) error {
+	switch ch.repoType {
+	case repo.RepositoryTypeHelm:
+		return ch.deleteHelmChart(ctx)
+	case repo.RepositoryTypeOCI:
+		return ch.deleteOCIChart(ctx)
+	default:
+		return fmt.Errorf("unknown repo type %d", ch.repoType)
+	}
+}
+
+// deleteChartVersion
+func (ch *chartHandler) deleteChartVersion(ctx context.Context, version string) error {
+	switch ch.repoType {
+	case repo.RepositoryTypeHelm:
+		return ch.deleteHelmChartVersion(ctx, version)
+	case repo.RepositoryTypeOCI:
+		return ch.deleteOCIChartVersion(ctx, version)
+	default:
+		return fmt.Errorf("unknown repo type %d", ch.repoType)
+	}
+}
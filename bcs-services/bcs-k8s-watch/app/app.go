@@ -32,6 +32,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/k8s/resources"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/options"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/output"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/output/sink"
 )
 
 var globalStopChan = make(chan struct{})
@@ -195,6 +196,15 @@ func RunAsLeader(stopChan <-chan struct{}, config *options.WatchConfig, clusterI
 	}
 	glog.Info("create writer success")
 
+	// create sinks, resource change events would be sent to kafka or cloudevents endpoints.
+	sinks, err := sink.NewManager(clusterID, config.Sinks, func(mc options.MaskerConfig) sink.Masker {
+		return &k8s.Masker{Namespace: mc.Namespace, Path: mc.Path}
+	})
+	if err != nil {
+		panic(err)
+	}
+	writer.SetSinks(sinks)
+
 	glog.Info("starting writer now...")
 	// NOCC:vetshadow/shadow(设计如此:这里err可以被覆盖)
 	if err = writer.Run(stopChan); err != nil {
@@ -73,6 +73,7 @@ const (
 // and write to storage by synchronizer with series actions.
 type Watcher struct {
 	resourceType       string
+	groupVersion       string
 	resourceNamespaced bool
 	// queue              *queue.Queue
 	eventQueue       workqueue.RateLimitingInterface
@@ -141,6 +142,7 @@ func NewWatcher(wo *WatcherOptions) (*Watcher, error) {
 	}
 	watcher := &Watcher{
 		resourceType:       wo.ResourceType,
+		groupVersion:       wo.GroupVersion,
 		writer:             wo.Writer,
 		sharedWatchers:     wo.SharedWatchers,
 		resourceNamespaced: wo.IsNameSpaced,
@@ -301,6 +303,9 @@ func (w *Watcher) distributeDataToHandler(data *action.SyncData) {
 	} else {
 		glog.Errorf("can't distribute the normal metadata, unknown DataType[%+v]", data.Kind)
 	}
+
+	// 实时变更事件同时下发到外部 sink, 与 bcs-storage 的对账同步数据不下发
+	w.writer.DispatchToSinks(data)
 }
 
 // AddEvent is event handler for add resource event.
@@ -579,13 +584,14 @@ func (w *Watcher) genSyncData(nsedName types.NamespacedName, obj interface{},
 	ownerUID := ""
 	glog.Infof("Ready to sync: %s %s: %s/%s", eventAction, w.resourceType, namespace, name)
 	syncData := &action.SyncData{
-		Kind:      w.resourceType,
-		Namespace: namespace,
-		Name:      name,
-		Action:    eventAction,
-		Data:      dMeta,
-		OwnerUID:  ownerUID,
-		RequeueQ:  w.GetTriggerQueue(),
+		Kind:         w.resourceType,
+		GroupVersion: w.groupVersion,
+		Namespace:    namespace,
+		Name:         name,
+		Action:       eventAction,
+		Data:         dMeta,
+		OwnerUID:     ownerUID,
+		RequeueQ:     w.GetTriggerQueue(),
 	}
 
 	return syncData, false
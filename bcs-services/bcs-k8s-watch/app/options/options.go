@@ -91,6 +91,7 @@ type WatchConfig struct {
 	K8s              K8sConfig     `json:"k8s"`
 	FilterConfigPath string        `json:"filterConfigPath"`
 	WatchResource    WatchResource `json:"watch_resource"`
+	Sinks            []SinkConfig  `json:"sinks"`
 	conf.FileConfig
 	conf.ProcessConfig
 	conf.LogConfig
@@ -151,3 +152,52 @@ type MaskerConfig struct {
 	Namespace string   `json:"namespace"`
 	Path      []string `json:"path"`
 }
+
+const (
+	// SinkTypeKafka 推送到 kafka
+	SinkTypeKafka = "kafka"
+	// SinkTypeCloudEvents 以 CloudEvents 批量模式推送到 HTTP 端点
+	SinkTypeCloudEvents = "cloudevents"
+)
+
+// SinkConfig 资源变更事件下发配置, 数据平台可直接订阅集群资源变更流
+type SinkConfig struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+	// QueueLen 缓冲队列长度, 队列满时丢弃事件, 不阻塞 watcher
+	QueueLen int `json:"queueLen"`
+	// BatchSize 单批最大事件数
+	BatchSize int `json:"batchSize"`
+	// BatchInterval 凑批最长等待时间, 单位毫秒
+	BatchInterval int `json:"batchInterval"`
+	// MaxRetry 单批发送失败的重试次数
+	MaxRetry int `json:"maxRetry"`
+	// Filter 复用过滤配置, 仅 apiResourceSpecification, apiResourceException,
+	// resourceNamespaceFilters, resourceNameFilters, resourceMaskers 生效
+	Filter      *FilterConfig          `json:"filter"`
+	Kafka       *KafkaSinkConfig       `json:"kafka"`
+	CloudEvents *CloudEventsSinkConfig `json:"cloudevents"`
+}
+
+// KafkaSinkConfig kafka 下发配置
+type KafkaSinkConfig struct {
+	Brokers  []string `json:"brokers"`
+	Topic    string   `json:"topic"`
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+	// Mechanism sasl 认证方式, 支持 plain/scram-sha256/scram-sha512, 为空时不认证
+	Mechanism string `json:"mechanism"`
+	TLS       *TLS   `json:"tls"`
+}
+
+// CloudEventsSinkConfig CloudEvents HTTP 下发配置
+type CloudEventsSinkConfig struct {
+	Endpoint string            `json:"endpoint"`
+	Headers  map[string]string `json:"headers"`
+	// Source CloudEvents source 属性, 默认为 /bcs-k8s-watch/{clusterID}
+	Source string `json:"source"`
+	// Timeout 请求超时时间, 单位秒
+	Timeout int  `json:"timeout"`
+	TLS     *TLS `json:"tls"`
+}
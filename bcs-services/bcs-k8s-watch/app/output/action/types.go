@@ -31,6 +31,8 @@ const (
 type SyncData struct {
 	// Kind is resource kind.
 	Kind string
+	// GroupVersion is resource group version, used by sink filter.
+	GroupVersion string
 	// Namespace is k8s resource namespace.
 	Namespace string
 	// Name is resource name.
@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/ssl"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/options"
+)
+
+const (
+	// cloudEventsBatchContentType CloudEvents HTTP 批量模式
+	cloudEventsBatchContentType = "application/cloudevents-batch+json"
+
+	defaultCloudEventsTimeout = 10 * time.Second
+)
+
+// CloudEventsSink 以 CloudEvents 批量模式 POST 到 HTTP 端点
+type CloudEventsSink struct {
+	endpoint string
+	headers  map[string]string
+	client   *http.Client
+}
+
+// NewCloudEventsSink create cloudevents http sink
+func NewCloudEventsSink(config *options.CloudEventsSinkConfig) (*CloudEventsSink, error) {
+	if config.Endpoint == "" {
+		return nil, errors.New("cloudevents endpoint is required")
+	}
+
+	timeout := defaultCloudEventsTimeout
+	if config.Timeout > 0 {
+		timeout = time.Duration(config.Timeout) * time.Second
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.TLS != nil {
+		tlsConfig, err := ssl.ClientTslConfVerity(config.TLS.CAFile, config.TLS.CertFile, config.TLS.KeyFile,
+			config.TLS.Password)
+		if err != nil {
+			return nil, fmt.Errorf("init cloudevents tls failed: %s", err.Error())
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return &CloudEventsSink{
+		endpoint: config.Endpoint,
+		headers:  config.Headers,
+		client:   &http.Client{Transport: transport, Timeout: timeout},
+	}, nil
+}
+
+// Send post events in batch mode, non 2xx response is treated as failure
+func (s *CloudEventsSink) Send(ctx context.Context, events []*CloudEvent) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", cloudEventsBatchContentType)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("cloudevents endpoint response %d: %s", resp.StatusCode, string(msg))
+	}
+	// 读完 body 以复用连接
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// Close close idle connections
+func (s *CloudEventsSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,262 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	glog "github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/k8s/resources"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/output/action"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/pkg/metrics"
+)
+
+const (
+	defaultSinkQueueLen      = 10 * 1024
+	defaultSinkBatchSize     = 100
+	defaultSinkBatchInterval = 1000 * time.Millisecond
+	defaultSinkMaxRetry      = 3
+	defaultSinkRetryInterval = 1 * time.Second
+
+	// 丢弃原因
+	discardReasonQueueFull  = "queue_full"
+	discardReasonSendFailed = "send_failed"
+)
+
+// Dispatcher 单个 Sink 的过滤, 脱敏, 缓冲和凑批发送
+// 队列满时直接丢弃并上报指标, 不反压 watcher, 避免影响 bcs-storage 同步
+type Dispatcher struct {
+	clusterID     string
+	name          string
+	source        string
+	sink          Sink
+	queue         chan *CloudEvent
+	batchSize     int
+	batchInterval time.Duration
+	maxRetry      int
+
+	filter           *resources.ResourceFilter
+	namespaceFilters map[string]struct{}
+	nameFilters      map[string]struct{}
+	// maskers 按资源类型分组
+	maskers map[string][]Masker
+	// bannedCache 缓存 groupVersion/kind 的过滤结果, IsBanned 每次命中都会打印日志
+	bannedCache sync.Map
+}
+
+// NewDispatcher create dispatcher, newMasker 用于根据配置创建脱敏器
+func NewDispatcher(clusterID string, config options.SinkConfig,
+	newMasker func(options.MaskerConfig) Masker) (*Dispatcher, error) {
+	if config.Name == "" {
+		return nil, errors.New("sink name is required")
+	}
+	setSinkDefaults(&config)
+	s, err := NewSink(&config)
+	if err != nil {
+		return nil, err
+	}
+	return newDispatcher(clusterID, config, s, newMasker), nil
+}
+
+func setSinkDefaults(config *options.SinkConfig) {
+	if config.QueueLen <= 0 {
+		config.QueueLen = defaultSinkQueueLen
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultSinkBatchSize
+	}
+	if config.BatchInterval <= 0 {
+		config.BatchInterval = int(defaultSinkBatchInterval / time.Millisecond)
+	}
+	if config.MaxRetry < 0 {
+		config.MaxRetry = 0
+	} else if config.MaxRetry == 0 {
+		config.MaxRetry = defaultSinkMaxRetry
+	}
+}
+
+func newDispatcher(clusterID string, config options.SinkConfig, s Sink,
+	newMasker func(options.MaskerConfig) Masker) *Dispatcher {
+	source := fmt.Sprintf("/bcs-k8s-watch/%s", clusterID)
+	if config.CloudEvents != nil && config.CloudEvents.Source != "" {
+		source = config.CloudEvents.Source
+	}
+
+	d := &Dispatcher{
+		clusterID:        clusterID,
+		name:             config.Name,
+		source:           source,
+		sink:             s,
+		queue:            make(chan *CloudEvent, config.QueueLen),
+		batchSize:        config.BatchSize,
+		batchInterval:    time.Duration(config.BatchInterval) * time.Millisecond,
+		maxRetry:         config.MaxRetry,
+		filter:           resources.NewResourceFilter(config.Filter),
+		namespaceFilters: map[string]struct{}{},
+		nameFilters:      map[string]struct{}{},
+		maskers:          map[string][]Masker{},
+	}
+	if config.Filter != nil {
+		for _, ns := range config.Filter.NamespaceFilters {
+			d.namespaceFilters[ns] = struct{}{}
+		}
+		for _, name := range config.Filter.NameFilters {
+			d.nameFilters[name] = struct{}{}
+		}
+		if newMasker != nil {
+			for _, mc := range config.Filter.DataMaskConfigList {
+				d.maskers[mc.Kind] = append(d.maskers[mc.Kind], newMasker(mc))
+			}
+		}
+	}
+	return d
+}
+
+// Dispatch 过滤并放入队列, 不阻塞
+func (d *Dispatcher) Dispatch(data *action.SyncData) {
+	if d.isFiltered(data) {
+		return
+	}
+
+	event := NewCloudEvent(d.clusterID, d.source, d.maskData(data))
+	select {
+	case d.queue <- event:
+	default:
+		metrics.ReportK8sWatchSinkDiscardEvents(d.clusterID, d.name, discardReasonQueueFull, 1)
+		glog.Warnf("sink %s queue is full(%d), discard %s", d.name, cap(d.queue), event.Subject)
+	}
+}
+
+// isFiltered 复用 ResourceFilter 的 groupVersion/kind 黑白名单, 以及命名空间和名称过滤
+func (d *Dispatcher) isFiltered(data *action.SyncData) bool {
+	if _, ok := d.namespaceFilters[data.Namespace]; ok {
+		return true
+	}
+	if _, ok := d.nameFilters[data.Name]; ok {
+		return true
+	}
+
+	key := data.GroupVersion + "/" + data.Kind
+	if banned, ok := d.bannedCache.Load(key); ok {
+		return banned.(bool)
+	}
+	banned := d.filter.IsBanned(data.GroupVersion, options.APIResource{Kind: data.Kind})
+	d.bannedCache.Store(key, banned)
+	return banned
+}
+
+// maskData 存在该类型的脱敏配置时, 拷贝后脱敏, 不影响写入 bcs-storage 的数据
+func (d *Dispatcher) maskData(data *action.SyncData) *action.SyncData {
+	maskers := d.maskers[data.Kind]
+	if len(maskers) == 0 {
+		return data
+	}
+	obj, ok := data.Data.(*unstructured.Unstructured)
+	if !ok || obj == nil {
+		return data
+	}
+
+	obj = obj.DeepCopy()
+	for _, m := range maskers {
+		m.MaskData(obj)
+	}
+	masked := *data
+	masked.Data = obj
+	return &masked
+}
+
+// Run 凑批发送, 达到 batchSize 或 batchInterval 时发送, 停止时发送剩余事件并关闭 Sink
+func (d *Dispatcher) Run(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(d.batchInterval)
+	defer ticker.Stop()
+
+	batch := make([]*CloudEvent, 0, d.batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		d.send(batch, stopCh)
+		batch = make([]*CloudEvent, 0, d.batchSize)
+	}
+
+	for {
+		select {
+		case event := <-d.queue:
+			batch = append(batch, event)
+			if len(batch) >= d.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+			metrics.ReportK8sWatchSinkQueueLength(d.clusterID, d.name, float64(len(d.queue)))
+		case <-stopCh:
+			d.drain(batch)
+			if err := d.sink.Close(); err != nil {
+				glog.Errorf("close sink %s failed: %s", d.name, err.Error())
+			}
+			glog.Infof("sink %s stopped", d.name)
+			return
+		}
+	}
+}
+
+// drain 停止时尽力发送队列中剩余的事件, 不再重试
+func (d *Dispatcher) drain(batch []*CloudEvent) {
+	for {
+		select {
+		case event := <-d.queue:
+			batch = append(batch, event)
+			if len(batch) >= d.batchSize {
+				d.send(batch, nil)
+				batch = make([]*CloudEvent, 0, d.batchSize)
+			}
+		default:
+			if len(batch) > 0 {
+				d.send(batch, nil)
+			}
+			return
+		}
+	}
+}
+
+// send 发送失败时按间隔递增重试, stopCh 为 nil 时不重试, 超过重试次数后丢弃
+func (d *Dispatcher) send(batch []*CloudEvent, stopCh <-chan struct{}) {
+	for attempt := 0; ; attempt++ {
+		started := time.Now()
+		err := d.sink.Send(context.Background(), batch)
+		if err == nil {
+			metrics.ReportK8sWatchSinkSendMetrics(d.clusterID, d.name, metrics.SucStatus, len(batch), started)
+			return
+		}
+		metrics.ReportK8sWatchSinkSendMetrics(d.clusterID, d.name, metrics.ErrStatus, len(batch), started)
+		glog.Errorf("sink %s send %d events failed, attempt %d: %s", d.name, len(batch), attempt+1, err.Error())
+
+		if stopCh == nil || attempt >= d.maxRetry {
+			break
+		}
+		select {
+		case <-stopCh:
+			// 停止时不再等待, 最后尝试一次
+			stopCh = nil
+		case <-time.After(defaultSinkRetryInterval * time.Duration(attempt+1)):
+		}
+	}
+	metrics.ReportK8sWatchSinkDiscardEvents(d.clusterID, d.name, discardReasonSendFailed, len(batch))
+}
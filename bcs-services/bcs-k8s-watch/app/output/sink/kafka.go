@@ -0,0 +1,120 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/ssl"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/options"
+)
+
+const (
+	// cloudEventsContentType CloudEvents kafka 结构化模式
+	cloudEventsContentType = "application/cloudevents+json"
+
+	kafkaMechanismPlain       = "plain"
+	kafkaMechanismScramSHA256 = "scram-sha256"
+	kafkaMechanismScramSHA512 = "scram-sha512"
+
+	// kafka-go 同步写入时未凑满批次会等待 BatchTimeout, 由 Dispatcher 负责凑批, 这里尽快发送
+	defaultKafkaBatchTimeout = 10 * time.Millisecond
+)
+
+// KafkaSink 以 CloudEvents 结构化模式写入 kafka, 按资源做分区键
+type KafkaSink struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaSink create kafka sink, batchSize 与 Dispatcher 凑批大小一致
+func NewKafkaSink(config *options.KafkaSinkConfig, batchSize int) (*KafkaSink, error) {
+	if len(config.Brokers) == 0 || config.Topic == "" {
+		return nil, errors.New("kafka brokers and topic are required")
+	}
+
+	transport := &kafka.Transport{}
+	mechanism, err := kafkaMechanism(config)
+	if err != nil {
+		return nil, err
+	}
+	transport.SASL = mechanism
+	if config.TLS != nil {
+		tlsConfig, err := ssl.ClientTslConfVerity(config.TLS.CAFile, config.TLS.CertFile, config.TLS.KeyFile,
+			config.TLS.Password)
+		if err != nil {
+			return nil, fmt.Errorf("init kafka tls failed: %s", err.Error())
+		}
+		transport.TLS = tlsConfig
+	}
+
+	return &KafkaSink{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(config.Brokers...),
+			Topic:        config.Topic,
+			Balancer:     &kafka.Hash{},
+			MaxAttempts:  3,
+			BatchSize:    batchSize,
+			BatchTimeout: defaultKafkaBatchTimeout,
+			RequiredAcks: kafka.RequireAll,
+			Transport:    transport,
+		},
+	}, nil
+}
+
+// kafkaMechanism sasl mechanism, return nil when username is empty
+func kafkaMechanism(config *options.KafkaSinkConfig) (sasl.Mechanism, error) {
+	if config.Mechanism == "" || config.Username == "" {
+		return nil, nil
+	}
+	switch config.Mechanism {
+	case kafkaMechanismPlain:
+		return plain.Mechanism{Username: config.Username, Password: config.Password}, nil
+	case kafkaMechanismScramSHA256:
+		return scram.Mechanism(scram.SHA256, config.Username, config.Password)
+	case kafkaMechanismScramSHA512:
+		return scram.Mechanism(scram.SHA512, config.Username, config.Password)
+	default:
+		return nil, fmt.Errorf("kafka sasl mechanism %s not supported", config.Mechanism)
+	}
+}
+
+// Send write events to kafka
+func (s *KafkaSink) Send(ctx context.Context, events []*CloudEvent) error {
+	msgs := make([]kafka.Message, 0, len(events))
+	for _, event := range events {
+		value, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, kafka.Message{
+			Key:     []byte(event.Key()),
+			Value:   value,
+			Headers: []kafka.Header{{Key: "content-type", Value: []byte(cloudEventsContentType)}},
+		})
+	}
+	return s.writer.WriteMessages(ctx, msgs...)
+}
+
+// Close flush and close kafka writer
+func (s *KafkaSink) Close() error {
+	return s.writer.Close()
+}
@@ -0,0 +1,63 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sink
+
+import (
+	glog "github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/output/action"
+)
+
+// Manager 管理所有启用的 Sink, 将资源变更事件扇出到各个 Dispatcher
+type Manager struct {
+	dispatchers []*Dispatcher
+}
+
+// NewManager create sink manager, 未启用的 sink 会被忽略
+func NewManager(clusterID string, configs []options.SinkConfig,
+	newMasker func(options.MaskerConfig) Masker) (*Manager, error) {
+	m := &Manager{}
+	for _, config := range configs {
+		if !config.Enabled {
+			continue
+		}
+		d, err := NewDispatcher(clusterID, config, newMasker)
+		if err != nil {
+			return nil, err
+		}
+		glog.Infof("sink %s(%s) created", config.Name, config.Type)
+		m.dispatchers = append(m.dispatchers, d)
+	}
+	return m, nil
+}
+
+// Dispatch fan out data to all sinks
+func (m *Manager) Dispatch(data *action.SyncData) {
+	if m == nil || data == nil {
+		return
+	}
+	for _, d := range m.dispatchers {
+		d.Dispatch(data)
+	}
+}
+
+// Run run all dispatchers until stopCh closed
+func (m *Manager) Run(stopCh <-chan struct{}) {
+	if m == nil {
+		return
+	}
+	for _, d := range m.dispatchers {
+		go d.Run(stopCh)
+	}
+}
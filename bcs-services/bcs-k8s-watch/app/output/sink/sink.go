@@ -0,0 +1,114 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package sink 将资源变更事件下发到 kafka, CloudEvents HTTP 等外部系统
+package sink
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/output/action"
+)
+
+const (
+	// CloudEventsSpecVersion CloudEvents 规范版本
+	CloudEventsSpecVersion = "1.0"
+	// CloudEventsTypePrefix 事件类型前缀, 完整类型如 com.tencent.bkbcs.k8swatch.update
+	CloudEventsTypePrefix = "com.tencent.bkbcs.k8swatch."
+
+	contentTypeJSON = "application/json"
+)
+
+// Sink 事件下发目标, Send 需要保证整批发送成功或返回错误
+type Sink interface {
+	Send(ctx context.Context, events []*CloudEvent) error
+	Close() error
+}
+
+// Masker 数据脱敏, 由 k8s.Masker 实现
+type Masker interface {
+	MaskData(dMeta *unstructured.Unstructured)
+}
+
+// CloudEvent CloudEvents 1.0 结构化格式, kafka 与 HTTP 下发使用同一种格式
+type CloudEvent struct {
+	SpecVersion     string `json:"specversion"`
+	ID              string `json:"id"`
+	Source          string `json:"source"`
+	Type            string `json:"type"`
+	Subject         string `json:"subject"`
+	Time            string `json:"time"`
+	DataContentType string `json:"datacontenttype"`
+	// 扩展属性, 方便消费端不解析 data 即可路由
+	ClusterID string      `json:"clusterid"`
+	Kind      string      `json:"kind"`
+	Namespace string      `json:"namespace,omitempty"`
+	Name      string      `json:"name"`
+	Action    string      `json:"action"`
+	Data      interface{} `json:"data,omitempty"`
+}
+
+// Key 事件分区键, 同一资源的事件落在同一分区以保证顺序
+func (e *CloudEvent) Key() string {
+	return e.ClusterID + "/" + e.Subject
+}
+
+// NewCloudEvent 由 SyncData 生成事件, 删除事件没有 data
+func NewCloudEvent(clusterID, source string, data *action.SyncData) *CloudEvent {
+	subject := data.Kind + "/" + data.Name
+	if data.Namespace != "" {
+		subject = data.Kind + "/" + data.Namespace + "/" + data.Name
+	}
+	event := &CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              uuid.New().String(),
+		Source:          source,
+		Type:            CloudEventsTypePrefix + strings.ToLower(data.Action),
+		Subject:         subject,
+		Time:            time.Now().UTC().Format(time.RFC3339Nano),
+		DataContentType: contentTypeJSON,
+		ClusterID:       clusterID,
+		Kind:            data.Kind,
+		Namespace:       data.Namespace,
+		Name:            data.Name,
+		Action:          data.Action,
+	}
+	if obj, ok := data.Data.(*unstructured.Unstructured); ok && obj != nil {
+		event.Data = obj.Object
+	}
+	return event
+}
+
+// NewSink 根据配置类型创建 Sink
+func NewSink(config *options.SinkConfig) (Sink, error) {
+	switch config.Type {
+	case options.SinkTypeKafka:
+		if config.Kafka == nil {
+			return nil, fmt.Errorf("sink %s kafka config is required", config.Name)
+		}
+		return NewKafkaSink(config.Kafka, config.BatchSize)
+	case options.SinkTypeCloudEvents:
+		if config.CloudEvents == nil {
+			return nil, fmt.Errorf("sink %s cloudevents config is required", config.Name)
+		}
+		return NewCloudEventsSink(config.CloudEvents)
+	default:
+		return nil, fmt.Errorf("sink %s type %s not supported", config.Name, config.Type)
+	}
+}
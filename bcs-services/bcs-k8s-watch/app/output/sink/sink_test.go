@@ -0,0 +1,240 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/output/action"
+)
+
+type fakeSink struct {
+	mtx     sync.Mutex
+	batches [][]*CloudEvent
+	failed  int
+	closed  bool
+}
+
+func (s *fakeSink) Send(_ context.Context, events []*CloudEvent) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.failed > 0 {
+		s.failed--
+		return errors.New("send failed")
+	}
+	s.batches = append(s.batches, events)
+	return nil
+}
+
+func (s *fakeSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+type fakeMasker struct {
+	field string
+}
+
+func (m *fakeMasker) MaskData(dMeta *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(dMeta.Object, "data", m.field)
+}
+
+func newSyncData(gv, kind, namespace, name string) *action.SyncData {
+	return &action.SyncData{
+		Kind:         kind,
+		GroupVersion: gv,
+		Namespace:    namespace,
+		Name:         name,
+		Action:       action.SyncDataActionUpdate,
+		Data: &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": gv,
+			"kind":       kind,
+			"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+			"data":       map[string]interface{}{"password": "xxx", "user": "admin"},
+		}},
+	}
+}
+
+func TestDispatcherFilter(t *testing.T) {
+	config := options.SinkConfig{
+		Name:      "test",
+		QueueLen:  10,
+		BatchSize: 10,
+		Filter: &options.FilterConfig{
+			APIResourceSpecification: []options.APIResourceFilter{
+				{GroupVersion: "v1", ResourceKinds: []string{"Pod", "Secret"}},
+				{GroupVersion: "apps/v1", ResourceKinds: []string{}},
+			},
+			NamespaceFilters: []string{"kube-system"},
+			NameFilters:      []string{"ignored"},
+		},
+	}
+	d := newDispatcher("BCS-K8S-00000", config, &fakeSink{}, nil)
+
+	tests := []struct {
+		data     *action.SyncData
+		filtered bool
+	}{
+		{newSyncData("v1", "Pod", "default", "nginx"), false},
+		{newSyncData("v1", "Pod", "default", "nginx-2"), false},
+		{newSyncData("v1", "ConfigMap", "default", "cm"), true},
+		{newSyncData("apps/v1", "Deployment", "default", "nginx"), false},
+		{newSyncData("v1", "Pod", "kube-system", "coredns"), true},
+		{newSyncData("v1", "Pod", "default", "ignored"), true},
+		{newSyncData("batch/v1", "Job", "default", "job"), true},
+	}
+	for _, tt := range tests {
+		if got := d.isFiltered(tt.data); got != tt.filtered {
+			t.Errorf("isFiltered(%s/%s %s/%s) = %v, want %v",
+				tt.data.GroupVersion, tt.data.Kind, tt.data.Namespace, tt.data.Name, got, tt.filtered)
+		}
+	}
+}
+
+func TestDispatcherMaskData(t *testing.T) {
+	config := options.SinkConfig{
+		Name:      "test",
+		QueueLen:  10,
+		BatchSize: 10,
+		Filter: &options.FilterConfig{
+			DataMaskConfigList: []options.MaskerConfig{{Kind: "Secret", Path: []string{"data", "password"}}},
+		},
+	}
+	d := newDispatcher("BCS-K8S-00000", config, &fakeSink{}, func(mc options.MaskerConfig) Masker {
+		return &fakeMasker{field: mc.Path[1]}
+	})
+
+	data := newSyncData("v1", "Secret", "default", "secret")
+	d.Dispatch(data)
+	event := <-d.queue
+
+	masked := event.Data.(map[string]interface{})["data"].(map[string]interface{})
+	if _, ok := masked["password"]; ok {
+		t.Errorf("password should be masked, got %v", masked)
+	}
+	origin := data.Data.(*unstructured.Unstructured).Object["data"].(map[string]interface{})
+	if _, ok := origin["password"]; !ok {
+		t.Errorf("origin data should not be modified, got %v", origin)
+	}
+}
+
+func TestDispatcherBatch(t *testing.T) {
+	s := &fakeSink{failed: 1}
+	config := options.SinkConfig{Name: "test", QueueLen: 2, BatchSize: 2, BatchInterval: 60 * 1000, MaxRetry: 1}
+	d := newDispatcher("BCS-K8S-00000", config, s, nil)
+
+	// queue is full, the third event is discarded
+	for _, name := range []string{"a", "b", "c"} {
+		d.Dispatch(newSyncData("v1", "Pod", "default", name))
+	}
+	if len(d.queue) != 2 {
+		t.Fatalf("queue length = %d, want 2", len(d.queue))
+	}
+
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		d.Run(stopCh)
+		close(done)
+	}()
+
+	// wait for batch sent after one retry
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s.mtx.Lock()
+		n := len(s.batches)
+		s.mtx.Unlock()
+		if n == 1 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// the remaining event is flushed on stop
+	d.Dispatch(newSyncData("v1", "Pod", "default", "d"))
+	close(stopCh)
+	<-done
+
+	if len(s.batches) != 2 || len(s.batches[0]) != 2 || len(s.batches[1]) != 1 {
+		t.Fatalf("unexpected batches: %v", s.batches)
+	}
+	if s.batches[1][0].Subject != "Pod/default/d" {
+		t.Errorf("subject = %s, want Pod/default/d", s.batches[1][0].Subject)
+	}
+	if !s.closed {
+		t.Error("sink should be closed after stop")
+	}
+}
+
+func TestCloudEventsSink(t *testing.T) {
+	var (
+		contentType string
+		auth        string
+		received    []*CloudEvent
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		auth = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if len(received) > 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	s, err := NewCloudEventsSink(&options.CloudEventsSinkConfig{
+		Endpoint: server.URL,
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	data := newSyncData("v1", "Pod", "default", "nginx")
+	data.Action = action.SyncDataActionDelete
+	data.Data = nil
+	event := NewCloudEvent("BCS-K8S-00000", "/bcs-k8s-watch/BCS-K8S-00000", data)
+	if err = s.Send(context.Background(), []*CloudEvent{event}); err != nil {
+		t.Fatal(err)
+	}
+	if contentType != cloudEventsBatchContentType || auth != "Bearer token" {
+		t.Errorf("unexpected headers, content-type: %s, authorization: %s", contentType, auth)
+	}
+	if len(received) != 1 || received[0].Type != "com.tencent.bkbcs.k8swatch.delete" ||
+		received[0].SpecVersion != CloudEventsSpecVersion || received[0].Data != nil {
+		t.Errorf("unexpected events: %+v", received[0])
+	}
+	if received[0].Key() != "BCS-K8S-00000/Pod/default/nginx" {
+		t.Errorf("key = %s", received[0].Key())
+	}
+
+	if err = s.Send(context.Background(), []*CloudEvent{event, event}); err == nil {
+		t.Error("non 2xx response should return error")
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/k8s/resources"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/options"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/output/action"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/app/output/sink"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-k8s-watch/pkg/metrics"
 )
 
@@ -107,6 +108,9 @@ type Writer struct {
 	// settled handlers.
 	Handlers map[string]*Handler
 
+	// sinks fan out resource change events to external systems, nil means no sink.
+	sinks *sink.Manager
+
 	// getResourceName get resourceName by data
 	getResourceName func(data *action.SyncData) string
 	// resourceQueueNum for resource queueNum
@@ -170,6 +174,16 @@ func (w *Writer) init(clusterID string, storageService *bcs.InnerService) error
 	return nil
 }
 
+// SetSinks sets the sink manager, must be called before Run.
+func (w *Writer) SetSinks(sinks *sink.Manager) {
+	w.sinks = sinks
+}
+
+// DispatchToSinks dispatches resource change event to all sinks without blocking.
+func (w *Writer) DispatchToSinks(data *action.SyncData) {
+	w.sinks.Dispatch(data)
+}
+
 // Sync syncs normal metadata by sending into queue.
 func (w *Writer) Sync(data *action.SyncData) {
 	if data == nil {
@@ -279,6 +293,9 @@ func (w *Writer) Run(stopCh <-chan struct{}) error {
 		handler.Run(stopCh)
 	}
 
+	// start all sinks.
+	w.sinks.Run(w.stopCh)
+
 	// keep consuming metadata from queues.
 	glog.Info("Writer keeps consuming/distributing metadata now")
 	go wait.NonSlidingUntil(w.distributeNormal, defaultDistributeInterval, w.stopCh)
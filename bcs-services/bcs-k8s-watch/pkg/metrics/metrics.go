@@ -83,6 +83,38 @@ var (
 		Help:      "request latency time for queue parse data",
 		Buckets:   []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0, 2.0, 3.0},
 	}, []string{"cluster_id", "handler", "name", "status"})
+
+	// sinkQueueLength 事件下发缓冲队列长度
+	sinkQueueLength = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: BkBcsK8sWatch,
+		Name:      "sink_queue_num",
+		Help:      "The number of events waiting in sink queue",
+	}, []string{"cluster_id", "sink"})
+
+	// sinkDiscardEvents 事件下发丢弃数, reason 为 queue_full 或 send_failed
+	sinkDiscardEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: BkBcsK8sWatch,
+		Name:      "sink_discard_events",
+		Help:      "The number of discard events in sink.",
+	}, []string{"cluster_id", "sink", "reason"})
+
+	sinkSendTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: BkBcsK8sWatch,
+		Name:      "sink_send_total_num",
+		Help:      "The total num of batches sent to sink",
+	}, []string{"cluster_id", "sink", "status"})
+	sinkSendLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: BkBcsK8sWatch,
+		Name:      "sink_send_latency_time",
+		Help:      "batch send latency statistic for sink",
+		Buckets:   []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0, 2.0, 3.0},
+	}, []string{"cluster_id", "sink", "status"})
+	sinkBatchSize = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: BkBcsK8sWatch,
+		Name:      "sink_batch_size",
+		Help:      "The number of events in each batch sent to sink",
+		Buckets:   []float64{1, 5, 10, 20, 50, 100, 200, 500, 1000},
+	}, []string{"cluster_id", "sink"})
 )
 
 func init() {
@@ -98,6 +130,13 @@ func init() {
 
 	// handler discard events
 	prometheus.MustRegister(handlerDiscardEvents)
+
+	// sink events
+	prometheus.MustRegister(sinkQueueLength)
+	prometheus.MustRegister(sinkDiscardEvents)
+	prometheus.MustRegister(sinkSendTotal)
+	prometheus.MustRegister(sinkSendLatency)
+	prometheus.MustRegister(sinkBatchSize)
 }
 
 // ReportK8sWatchAPIMetrics report all api action metrics
@@ -151,3 +190,20 @@ func ReportK8sWatchHandlerDiscardEvents(clusterID, handler string) {
 func ReportK8sWatchHandlerFuncLatency(clusterID, handler, name, status string, started time.Time) {
 	requestLatencyHandler.WithLabelValues(clusterID, handler, name, status).Observe(time.Since(started).Seconds())
 }
+
+// ReportK8sWatchSinkQueueLength report sink queue length
+func ReportK8sWatchSinkQueueLength(clusterID, sink string, queueLen float64) {
+	sinkQueueLength.WithLabelValues(clusterID, sink).Set(queueLen)
+}
+
+// ReportK8sWatchSinkDiscardEvents report sink discard events num
+func ReportK8sWatchSinkDiscardEvents(clusterID, sink, reason string, num int) {
+	sinkDiscardEvents.WithLabelValues(clusterID, sink, reason).Add(float64(num))
+}
+
+// ReportK8sWatchSinkSendMetrics report sink batch send metrics
+func ReportK8sWatchSinkSendMetrics(clusterID, sink, status string, batchSize int, started time.Time) {
+	sinkSendTotal.WithLabelValues(clusterID, sink, status).Inc()
+	sinkSendLatency.WithLabelValues(clusterID, sink, status).Observe(time.Since(started).Seconds())
+	sinkBatchSize.WithLabelValues(clusterID, sink).Observe(float64(batchSize))
+}
@@ -0,0 +1,189 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package alert 告警规则计算, 兼容 prometheus 规则文件格式, 通过 webhook 发送告警
+package alert
+
+import (
+	"context"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/model/exemplar"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/rulefmt"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/rules"
+	"github.com/prometheus/prometheus/storage"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/config"
+	bcsstorage "github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/storage"
+)
+
+const (
+	outageTolerance = time.Hour        // 重启后恢复 for 状态的最大容忍时间
+	forGracePeriod  = time.Minute * 10 // 恢复 for 状态后的最小等待时间
+)
+
+// Manager 告警规则管理, pending/firing 状态由 prometheus rules 维护
+type Manager struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	conf    *config.AlertConf
+	manager *rules.Manager
+	elector *Elector
+}
+
+// NewManager 加载规则文件, engine 和 queryable 使用 query 模块的查询引擎
+func NewManager(ctx context.Context, reg prometheus.Registerer, logger log.Logger, conf *config.AlertConf,
+	engine *promql.Engine, queryable storage.Queryable, externalURL string) (*Manager, error) {
+	files, err := loadRuleFiles(conf.RuleFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(externalURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse external url")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	notifier := NewNotifier(conf.Webhook, conf.ExternalLabels, externalURL)
+
+	m := &Manager{
+		ctx:    ctx,
+		cancel: cancel,
+		conf:   conf,
+	}
+
+	// 多副本部署时只有 leader 发送告警, for 状态保存在 redis 中, 重启或切换 leader 后恢复
+	notifyFunc := notifier.Notify
+	var (
+		appendable   storage.Appendable = nopAppendable{}
+		forStateable storage.Queryable  = queryable
+	)
+	if conf.LeaderElection {
+		client := bcsstorage.GetDefaultRedisSession().Client
+		m.elector = NewElector(client)
+		notifyFunc = func(ctx context.Context, expr string, alerts ...*rules.Alert) {
+			if m.elector.IsLeader() {
+				notifier.Notify(ctx, expr, alerts...)
+			}
+		}
+		forState := newForStateStore(client, m.elector.IsLeader)
+		appendable, forStateable = forState, forState
+	}
+
+	m.manager = rules.NewManager(&rules.ManagerOptions{
+		ExternalURL:     u,
+		QueryFunc:       rules.EngineQueryFunc(engine, queryable),
+		NotifyFunc:      notifyFunc,
+		Context:         ctx,
+		Appendable:      appendable,
+		Queryable:       forStateable,
+		Logger:          log.With(logger, "component", "alert"),
+		Registerer:      reg,
+		OutageTolerance: outageTolerance,
+		ForGracePeriod:  forGracePeriod,
+		ResendDelay:     time.Duration(conf.ResendDelay),
+	})
+
+	interval := time.Duration(conf.EvaluationInterval)
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	if err := m.manager.Update(interval, files, labels.FromMap(conf.ExternalLabels), externalURL); err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "load alert rules")
+	}
+
+	return m, nil
+}
+
+// Run 启动规则计算
+func (m *Manager) Run() error {
+	if m.elector != nil {
+		go m.elector.Run(m.ctx)
+	}
+	m.manager.Run()
+	<-m.ctx.Done()
+	return nil
+}
+
+// Close 停止规则计算
+func (m *Manager) Close(err error) {
+	m.cancel()
+	m.manager.Stop()
+}
+
+// loadRuleFiles 展开通配符并校验规则文件, 只支持告警规则
+// recording rules 请使用 storegw RECORDING_RULE 数据源
+func loadRuleFiles(patterns []string) ([]string, error) {
+	files := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, errors.Wrapf(err, "glob rule files %s", pattern)
+		}
+
+		for _, file := range matches {
+			groups, errs := rulefmt.ParseFile(file)
+			if len(errs) > 0 {
+				return nil, errors.Wrapf(errs[0], "parse rule file %s", file)
+			}
+
+			for _, g := range groups.Groups {
+				for _, r := range g.Rules {
+					if r.Record.Value != "" {
+						return nil, errors.Errorf("recording rule %s in %s is not supported", r.Record.Value, file)
+					}
+				}
+			}
+			files = append(files, file)
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, errors.Errorf("no rule files found in %v", patterns)
+	}
+	return files, nil
+}
+
+// nopAppendable 未开启选主时 ALERTS 等 series 不持久化, 重启后 for 状态重新计算
+type nopAppendable struct{}
+
+// Appender :
+func (nopAppendable) Appender(_ context.Context) storage.Appender {
+	return nopAppender{}
+}
+
+type nopAppender struct{}
+
+// Append :
+func (nopAppender) Append(storage.SeriesRef, labels.Labels, int64, float64) (storage.SeriesRef, error) {
+	return 0, nil
+}
+
+// AppendExemplar :
+func (nopAppender) AppendExemplar(storage.SeriesRef, labels.Labels, exemplar.Exemplar) (storage.SeriesRef, error) {
+	return 0, nil
+}
+
+// Commit :
+func (nopAppender) Commit() error { return nil }
+
+// Rollback :
+func (nopAppender) Rollback() error { return nil }
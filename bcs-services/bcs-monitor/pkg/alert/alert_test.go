@@ -0,0 +1,82 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package alert
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/rules"
+	"github.com/thanos-io/thanos/pkg/testutil"
+)
+
+func TestNewMessage(t *testing.T) {
+	n := NewNotifier(nil, map[string]string{"env": "prod", "cluster_id": "default"}, "http://127.0.0.1/query")
+	now := time.Now()
+
+	alerts := []*rules.Alert{
+		{
+			Labels:      labels.FromStrings("alertname", "NodeDown", "cluster_id", "BCS-K8S-00000"),
+			Annotations: labels.FromStrings("summary", "node down"),
+			FiredAt:     now,
+			ValidUntil:  now.Add(time.Minute * 4),
+		},
+	}
+	msg := n.newMessage("up == 0", alerts)
+	testutil.Equals(t, StatusFiring, msg.Status)
+	testutil.Assert(t, len(msg.Alerts) == 1)
+	testutil.Equals(t, "BCS-K8S-00000", msg.Alerts[0].Labels["cluster_id"])
+	testutil.Equals(t, "prod", msg.Alerts[0].Labels["env"])
+	testutil.Equals(t, "node down", msg.Alerts[0].Annotations["summary"])
+	testutil.Equals(t, now.Add(time.Minute*4), msg.Alerts[0].EndsAt)
+
+	alerts[0].ResolvedAt = now.Add(time.Minute)
+	msg = n.newMessage("up == 0", alerts)
+	testutil.Equals(t, StatusResolved, msg.Status)
+	testutil.Equals(t, StatusResolved, msg.Alerts[0].Status)
+	testutil.Equals(t, now.Add(time.Minute), msg.Alerts[0].EndsAt)
+}
+
+func TestLoadRuleFiles(t *testing.T) {
+	dir := t.TempDir()
+	alertRules := `
+groups:
+  - name: node
+    rules:
+      - alert: NodeDown
+        expr: up{cluster_id="BCS-K8S-00000"} == 0
+        for: 5m
+`
+	testutil.Ok(t, os.WriteFile(filepath.Join(dir, "alert.yml"), []byte(alertRules), 0o600))
+
+	files, err := loadRuleFiles([]string{filepath.Join(dir, "*.yml")})
+	testutil.Ok(t, err)
+	testutil.Assert(t, len(files) == 1)
+
+	_, err = loadRuleFiles([]string{filepath.Join(dir, "*.yaml")})
+	testutil.NotOk(t, err)
+
+	recordRules := `
+groups:
+  - name: node
+    rules:
+      - record: bcs:node:up
+        expr: up
+`
+	testutil.Ok(t, os.WriteFile(filepath.Join(dir, "record.yml"), []byte(recordRules), 0o600))
+	_, err = loadRuleFiles([]string{filepath.Join(dir, "*.yml")})
+	testutil.NotOk(t, err)
+}
@@ -0,0 +1,119 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package alert
+
+import (
+	"context"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	redis "github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+const (
+	leaderKey = "bcs_monitor:alert:leader" // 选主使用的 redis key
+	leaderTTL = time.Second * 15           // leader 过期时间, 每 1/3 过期时间续期一次
+)
+
+// renewScript 只有持有者可以续期
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+// releaseScript 只有持有者可以释放
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// Elector 多副本时通过 redis 选主, 所有副本都计算告警规则以保持 pending/firing 状态, 只有 leader 发送告警
+type Elector struct {
+	client   redis.Cmdable
+	id       string
+	isLeader atomic.Bool
+}
+
+// NewElector :
+func NewElector(client redis.Cmdable) *Elector {
+	hostname, _ := os.Hostname()
+	return &Elector{
+		client: client,
+		id:     hostname + "-" + uuid.New().String(),
+	}
+}
+
+// IsLeader 是否 leader
+func (e *Elector) IsLeader() bool {
+	return e.isLeader.Load()
+}
+
+// Run 定时续期或抢占 leader, ctx 结束后释放
+func (e *Elector) Run(ctx context.Context) {
+	ticker := time.NewTicker(leaderTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		e.campaign(ctx)
+
+		select {
+		case <-ctx.Done():
+			e.release()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// campaign 续期或抢占 leader, redis 异常时放弃 leader, 避免多个副本同时发送告警
+func (e *Elector) campaign(ctx context.Context) {
+	var (
+		ok  bool
+		err error
+	)
+	if e.IsLeader() {
+		var n int64
+		n, err = renewScript.Run(ctx, e.client, []string{leaderKey}, e.id, leaderTTL.Milliseconds()).Int64()
+		ok = n == 1
+	} else {
+		ok, err = e.client.SetNX(ctx, leaderKey, e.id, leaderTTL).Result()
+	}
+	if err != nil {
+		blog.Errorf("alert leader election error, %s", err)
+		ok = false
+	}
+
+	if ok != e.IsLeader() {
+		blog.Infof("alert leader changed, id: %s, is leader: %t", e.id, ok)
+	}
+	e.isLeader.Store(ok)
+}
+
+// release 释放 leader, 其他副本可以立即接管
+func (e *Elector) release() {
+	if !e.IsLeader() {
+		return
+	}
+	e.isLeader.Store(false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+	if err := releaseScript.Run(ctx, e.client, []string{leaderKey}, e.id).Err(); err != nil {
+		blog.Errorf("release alert leader error, %s", err)
+	}
+}
@@ -0,0 +1,150 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package alert
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/rules"
+	"github.com/prometheus/prometheus/util/strutil"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/component"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/config"
+)
+
+const (
+	webhookVersion = "4" // 兼容 alertmanager webhook 版本
+	receiverName   = "bcs-monitor"
+
+	// StatusFiring 告警中
+	StatusFiring = "firing"
+	// StatusResolved 已恢复
+	StatusResolved = "resolved"
+)
+
+// Message webhook 请求内容, 兼容 alertmanager webhook 格式
+type Message struct {
+	Version     string   `json:"version"`
+	Status      string   `json:"status"`
+	Receiver    string   `json:"receiver"`
+	ExternalURL string   `json:"externalURL"`
+	Alerts      []*Alert `json:"alerts"`
+}
+
+// Alert 单条告警
+type Alert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// Notifier 告警发送
+type Notifier struct {
+	conf           *config.WebhookConf
+	externalLabels map[string]string
+	externalURL    string
+}
+
+// NewNotifier :
+func NewNotifier(conf *config.WebhookConf, externalLabels map[string]string, externalURL string) *Notifier {
+	return &Notifier{conf: conf, externalLabels: externalLabels, externalURL: externalURL}
+}
+
+// Notify 实现 rules.NotifyFunc, 发送 firing 和 resolved 告警
+func (n *Notifier) Notify(ctx context.Context, expr string, alerts ...*rules.Alert) {
+	if len(alerts) == 0 {
+		return
+	}
+
+	msg := n.newMessage(expr, alerts)
+	if n.conf == nil || n.conf.URL == "" {
+		blog.Warnf("alert webhook not configured, drop %d alerts of %s", len(msg.Alerts), expr)
+		return
+	}
+
+	if err := n.send(ctx, msg); err != nil {
+		blog.Errorf("send %d alerts of %s to webhook error, %s", len(msg.Alerts), expr, err)
+	}
+}
+
+// newMessage prometheus 告警转换为 webhook 格式
+func (n *Notifier) newMessage(expr string, alerts []*rules.Alert) *Message {
+	msg := &Message{
+		Version:     webhookVersion,
+		Status:      StatusResolved,
+		Receiver:    receiverName,
+		ExternalURL: n.externalURL,
+		Alerts:      make([]*Alert, 0, len(alerts)),
+	}
+
+	for _, a := range alerts {
+		lset := labels.NewBuilder(a.Labels)
+		for k, v := range n.externalLabels {
+			if a.Labels.Get(k) == "" {
+				lset.Set(k, v)
+			}
+		}
+		lbs := lset.Labels()
+
+		alert := &Alert{
+			Status:       StatusFiring,
+			Labels:       lbs.Map(),
+			Annotations:  a.Annotations.Map(),
+			StartsAt:     a.FiredAt,
+			EndsAt:       a.ValidUntil,
+			GeneratorURL: n.externalURL + strutil.TableLinkForExpression(expr),
+			Fingerprint:  strconv.FormatUint(lbs.Hash(), 16),
+		}
+		if !a.ResolvedAt.IsZero() {
+			alert.Status = StatusResolved
+			alert.EndsAt = a.ResolvedAt
+		} else {
+			msg.Status = StatusFiring
+		}
+		msg.Alerts = append(msg.Alerts, alert)
+	}
+
+	return msg
+}
+
+// send 发送到 webhook
+func (n *Notifier) send(ctx context.Context, msg *Message) error {
+	if n.conf.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(n.conf.Timeout))
+		defer cancel()
+	}
+
+	resp, err := component.GetClient().R().
+		SetContext(ctx).
+		SetHeaders(n.conf.Headers).
+		SetBody(msg).
+		Post(n.conf.URL)
+	if err != nil {
+		return err
+	}
+
+	if !resp.IsSuccess() {
+		return errors.Errorf("http code %d != 200", resp.StatusCode())
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package alert
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/prometheus/prometheus/rules"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/thanos-io/thanos/pkg/rules/rulespb"
+	"github.com/thanos-io/thanos/pkg/store/labelpb"
+)
+
+// Rules 实现 thanos rules client, 提供 /api/v1/rules, /api/v1/alerts 接口查询规则和告警状态
+func (m *Manager) Rules(_ context.Context, req *rulespb.RulesRequest) (*rulespb.RuleGroups, storage.Warnings,
+	error) {
+	groups := &rulespb.RuleGroups{}
+	// 只有告警规则
+	if req.Type == rulespb.RulesRequest_RECORD {
+		return groups, nil, nil
+	}
+
+	for _, g := range m.manager.RuleGroups() {
+		group := &rulespb.RuleGroup{
+			Name:                      g.Name(),
+			File:                      g.File(),
+			Interval:                  g.Interval().Seconds(),
+			EvaluationDurationSeconds: g.GetEvaluationTime().Seconds(),
+			LastEvaluation:            g.GetLastEvaluation(),
+		}
+
+		for _, r := range g.Rules() {
+			rule, ok := r.(*rules.AlertingRule)
+			if !ok {
+				continue
+			}
+			group.Rules = append(group.Rules, rulespb.NewAlertingRule(alertingRuleToProto(rule)))
+		}
+		groups.Groups = append(groups.Groups, group)
+	}
+
+	return groups, nil, nil
+}
+
+// alertingRuleToProto 告警规则及 pending/firing 告警转换为 thanos 格式
+func alertingRuleToProto(rule *rules.AlertingRule) *rulespb.Alert {
+	lastError := ""
+	if rule.LastError() != nil {
+		lastError = rule.LastError().Error()
+	}
+
+	activeAlerts := rule.ActiveAlerts()
+	alerts := make([]*rulespb.AlertInstance, 0, len(activeAlerts))
+	for _, a := range activeAlerts {
+		activeAt := a.ActiveAt
+		alerts = append(alerts, &rulespb.AlertInstance{
+			Labels:      labelpb.ZLabelSet{Labels: labelpb.ZLabelsFromPromLabels(a.Labels)},
+			Annotations: labelpb.ZLabelSet{Labels: labelpb.ZLabelsFromPromLabels(a.Annotations)},
+			State:       rulespb.AlertState(a.State),
+			ActiveAt:    &activeAt,
+			Value:       strconv.FormatFloat(a.Value, 'e', -1, 64),
+		})
+	}
+
+	return &rulespb.Alert{
+		State:                     rulespb.AlertState(rule.State()),
+		Name:                      rule.Name(),
+		Query:                     rule.Query().String(),
+		DurationSeconds:           rule.HoldDuration().Seconds(),
+		Labels:                    labelpb.ZLabelSet{Labels: labelpb.ZLabelsFromPromLabels(rule.Labels())},
+		Annotations:               labelpb.ZLabelSet{Labels: labelpb.ZLabelsFromPromLabels(rule.Annotations())},
+		Alerts:                    alerts,
+		Health:                    string(rule.Health()),
+		LastError:                 lastError,
+		EvaluationDurationSeconds: rule.GetEvaluationDuration().Seconds(),
+		LastEvaluation:            rule.GetEvaluationTimestamp(),
+	}
+}
@@ -0,0 +1,252 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	redis "github.com/go-redis/redis/v8"
+	"github.com/prometheus/prometheus/model/exemplar"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+	"github.com/prometheus/prometheus/tsdb/tsdbutil"
+)
+
+const (
+	forStateKey    = "bcs_monitor:alert:for_state" // 保存 ALERTS_FOR_STATE 的 redis hash
+	forStateMetric = "ALERTS_FOR_STATE"
+)
+
+// forStateSample ALERTS_FOR_STATE 最新的点, value 为告警 activeAt 的秒级时间戳
+type forStateSample struct {
+	Labels    map[string]string `json:"labels"`
+	Timestamp int64             `json:"t"`
+	Value     float64           `json:"v"`
+}
+
+// forStateStore 将告警的 for 状态保存到 redis, 重启或切换 leader 后 rules manager 通过 Queryable 恢复 for 状态
+// 只保存 ALERTS_FOR_STATE, 只有 leader 写入
+type forStateStore struct {
+	client   redis.Cmdable
+	isLeader func() bool
+}
+
+// newForStateStore :
+func newForStateStore(client redis.Cmdable, isLeader func() bool) *forStateStore {
+	return &forStateStore{client: client, isLeader: isLeader}
+}
+
+// Appender :
+func (s *forStateStore) Appender(ctx context.Context) storage.Appender {
+	return &forStateAppender{ctx: ctx, store: s}
+}
+
+// Querier :
+func (s *forStateStore) Querier(ctx context.Context, mint, maxt int64) (storage.Querier, error) {
+	return &forStateQuerier{ctx: ctx, store: s, mint: mint, maxt: maxt}, nil
+}
+
+type forStateAppender struct {
+	ctx     context.Context
+	store   *forStateStore
+	samples []forStateSample
+}
+
+// Append :
+func (a *forStateAppender) Append(_ storage.SeriesRef, l labels.Labels, t int64, v float64) (storage.SeriesRef,
+	error) {
+	if l.Get(labels.MetricName) != forStateMetric {
+		return 0, nil
+	}
+	a.samples = append(a.samples, forStateSample{Labels: l.Map(), Timestamp: t, Value: v})
+	return 0, nil
+}
+
+// AppendExemplar :
+func (a *forStateAppender) AppendExemplar(storage.SeriesRef, labels.Labels, exemplar.Exemplar) (storage.SeriesRef,
+	error) {
+	return 0, nil
+}
+
+// Commit 已恢复的告警写入 stale 标记, 从 redis 中删除
+func (a *forStateAppender) Commit() error {
+	defer a.Rollback() // nolint
+	if len(a.samples) == 0 || !a.store.isLeader() {
+		return nil
+	}
+
+	values := make(map[string]interface{}, len(a.samples))
+	var deleted []string
+	for _, s := range a.samples {
+		field := labels.FromMap(s.Labels).String()
+		if value.IsStaleNaN(s.Value) {
+			deleted = append(deleted, field)
+			continue
+		}
+		data, err := json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		values[field] = data
+	}
+
+	if len(deleted) > 0 {
+		if err := a.store.client.HDel(a.ctx, forStateKey, deleted...).Err(); err != nil {
+			blog.Errorf("delete alert for state error, %s", err)
+			return err
+		}
+	}
+	if len(values) > 0 {
+		if err := a.store.client.HSet(a.ctx, forStateKey, values).Err(); err != nil {
+			blog.Errorf("save alert for state error, %s", err)
+			return err
+		}
+	}
+	// 超过 outageTolerance 的 for 状态不会恢复, 长时间没有告警时整体过期
+	return a.store.client.Expire(a.ctx, forStateKey, outageTolerance).Err()
+}
+
+// Rollback :
+func (a *forStateAppender) Rollback() error {
+	a.samples = nil
+	return nil
+}
+
+type forStateQuerier struct {
+	ctx   context.Context
+	store *forStateStore
+	mint  int64
+	maxt  int64
+}
+
+// Select 返回时间范围内匹配的 for 状态
+func (q *forStateQuerier) Select(_ bool, _ *storage.SelectHints, matchers ...*labels.Matcher) storage.SeriesSet {
+	result, err := q.store.client.HGetAll(q.ctx, forStateKey).Result()
+	if err != nil {
+		return storage.ErrSeriesSet(err)
+	}
+
+	series := make([]storage.Series, 0)
+	for _, data := range result {
+		var s forStateSample
+		if err := json.Unmarshal([]byte(data), &s); err != nil {
+			blog.Warnf("unmarshal alert for state %s error, %s", data, err)
+			continue
+		}
+		if s.Timestamp < q.mint || s.Timestamp > q.maxt {
+			continue
+		}
+		lset := labels.FromMap(s.Labels)
+		if !matchLabels(lset, matchers) {
+			continue
+		}
+		series = append(series, &forStateSeries{labels: lset, sample: s})
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return labels.Compare(series[i].Labels(), series[j].Labels()) < 0
+	})
+	return &forStateSeriesSet{series: series, index: -1}
+}
+
+// LabelValues 恢复 for 状态不需要
+func (q *forStateQuerier) LabelValues(string, ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	return nil, nil, nil
+}
+
+// LabelNames 恢复 for 状态不需要
+func (q *forStateQuerier) LabelNames(...*labels.Matcher) ([]string, storage.Warnings, error) {
+	return nil, nil, nil
+}
+
+// Close :
+func (q *forStateQuerier) Close() error {
+	return nil
+}
+
+func matchLabels(lset labels.Labels, matchers []*labels.Matcher) bool {
+	for _, m := range matchers {
+		if !m.Matches(lset.Get(m.Name)) {
+			return false
+		}
+	}
+	return true
+}
+
+type forStateSeriesSet struct {
+	series []storage.Series
+	index  int
+}
+
+// Next :
+func (s *forStateSeriesSet) Next() bool {
+	s.index++
+	return s.index < len(s.series)
+}
+
+// At :
+func (s *forStateSeriesSet) At() storage.Series {
+	return s.series[s.index]
+}
+
+// Err :
+func (s *forStateSeriesSet) Err() error {
+	return nil
+}
+
+// Warnings :
+func (s *forStateSeriesSet) Warnings() storage.Warnings {
+	return nil
+}
+
+type forStateSeries struct {
+	labels labels.Labels
+	sample forStateSample
+}
+
+// Labels :
+func (s *forStateSeries) Labels() labels.Labels {
+	return s.labels
+}
+
+// Iterator 只有最新的一个点
+func (s *forStateSeries) Iterator() chunkenc.Iterator {
+	return storage.NewListSeriesIterator(forStateSamples{s.sample})
+}
+
+// forStateSamples 实现 storage.Samples
+type forStateSamples []forStateSample
+
+// Get :
+func (s forStateSamples) Get(i int) tsdbutil.Sample {
+	return s[i]
+}
+
+// Len :
+func (s forStateSamples) Len() int {
+	return len(s)
+}
+
+// T :
+func (s forStateSample) T() int64 {
+	return s.Timestamp
+}
+
+// V :
+func (s forStateSample) V() float64 {
+	return s.Value
+}
@@ -0,0 +1,113 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package alert
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/thanos-io/thanos/pkg/testutil"
+)
+
+// fakeRedis 内存实现的 redis hash
+type fakeRedis struct {
+	redis.Cmdable
+	hash map[string]string
+}
+
+func (r *fakeRedis) HSet(ctx context.Context, key string, values ...interface{}) *redis.IntCmd {
+	for k, v := range values[0].(map[string]interface{}) {
+		r.hash[k] = string(v.([]byte))
+	}
+	return redis.NewIntCmd(ctx)
+}
+
+func (r *fakeRedis) HDel(ctx context.Context, key string, fields ...string) *redis.IntCmd {
+	for _, f := range fields {
+		delete(r.hash, f)
+	}
+	return redis.NewIntCmd(ctx)
+}
+
+func (r *fakeRedis) HGetAll(ctx context.Context, key string) *redis.StringStringMapCmd {
+	cmd := redis.NewStringStringMapCmd(ctx)
+	cmd.SetVal(r.hash)
+	return cmd
+}
+
+func (r *fakeRedis) Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd {
+	return redis.NewBoolCmd(ctx)
+}
+
+func TestForStateStore(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeRedis{hash: map[string]string{}}
+	isLeader := false
+	s := newForStateStore(client, func() bool { return isLeader })
+
+	forState := labels.FromStrings(labels.MetricName, forStateMetric, labels.AlertName, "NodeDown", "node", "n1")
+	alerts := labels.FromStrings(labels.MetricName, "ALERTS", labels.AlertName, "NodeDown", "node", "n1")
+	activeAt := float64(time.Now().Add(-time.Minute * 10).Unix())
+	now := time.Now().UnixMilli()
+
+	// 非 leader 不写入
+	app := s.Appender(ctx)
+	_, err := app.Append(0, forState, now, activeAt)
+	testutil.Ok(t, err)
+	testutil.Ok(t, app.Commit())
+	testutil.Equals(t, 0, len(client.hash))
+
+	// leader 只保存 ALERTS_FOR_STATE
+	isLeader = true
+	app = s.Appender(ctx)
+	_, err = app.Append(0, forState, now, activeAt)
+	testutil.Ok(t, err)
+	_, err = app.Append(0, alerts, now, 1)
+	testutil.Ok(t, err)
+	testutil.Ok(t, app.Commit())
+	testutil.Equals(t, 1, len(client.hash))
+
+	// 按 labels 和时间范围查询
+	q, err := s.Querier(ctx, now-time.Hour.Milliseconds(), now)
+	testutil.Ok(t, err)
+	sset := q.Select(false, nil, labels.MustNewMatcher(labels.MatchEqual, labels.MetricName, forStateMetric),
+		labels.MustNewMatcher(labels.MatchEqual, "node", "n1"))
+	testutil.Assert(t, sset.Next())
+	testutil.Equals(t, forState, sset.At().Labels())
+	it := sset.At().Iterator()
+	testutil.Assert(t, it.Next())
+	ts, v := it.At()
+	testutil.Equals(t, now, ts)
+	testutil.Equals(t, activeAt, v)
+	testutil.Assert(t, !sset.Next())
+
+	sset = q.Select(false, nil, labels.MustNewMatcher(labels.MatchEqual, "node", "n2"))
+	testutil.Assert(t, !sset.Next())
+
+	q, err = s.Querier(ctx, now+1, now+time.Hour.Milliseconds())
+	testutil.Ok(t, err)
+	sset = q.Select(false, nil, labels.MustNewMatcher(labels.MatchEqual, "node", "n1"))
+	testutil.Assert(t, !sset.Next())
+
+	// 告警恢复后删除
+	app = s.Appender(ctx)
+	_, err = app.Append(0, forState, now, math.Float64frombits(value.StaleNaN))
+	testutil.Ok(t, err)
+	testutil.Ok(t, app.Commit())
+	testutil.Equals(t, 0, len(client.hash))
+}
@@ -0,0 +1,55 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"time"
+
+	"github.com/prometheus/common/model"
+)
+
+// AlertConf 告警规则配置, 未配置规则文件时不启动告警计算
+type AlertConf struct {
+	RuleFiles          []string          `yaml:"rule_files"`          // prometheus 格式的规则文件, 支持通配符
+	EvaluationInterval model.Duration    `yaml:"evaluation_interval"` // 默认计算周期, 规则组可单独配置 interval
+	ExternalLabels     map[string]string `yaml:"external_labels"`     // 告警附加的 labels
+	ResendDelay        model.Duration    `yaml:"resend_delay"`        // firing 告警重复发送间隔
+	Webhook            *WebhookConf      `yaml:"webhook"`
+	// 多副本部署时通过 redis 选主, 只有 leader 发送告警, 并在 redis 中保存 for 状态, redis 不可用时不发送告警;
+	// 默认关闭, 关闭时每个副本都会发送告警
+	LeaderElection bool `yaml:"leader_election"`
+}
+
+// WebhookConf 告警接收 webhook 配置, 请求格式兼容 alertmanager webhook
+type WebhookConf struct {
+	URL     string            `yaml:"url"`
+	Timeout model.Duration    `yaml:"timeout"`
+	Headers map[string]string `yaml:"headers"`
+}
+
+// Enabled 是否开启告警计算
+func (c *AlertConf) Enabled() bool {
+	return c != nil && len(c.RuleFiles) > 0
+}
+
+// defaultAlertConf 默认配置
+func defaultAlertConf() *AlertConf {
+	c := &AlertConf{
+		EvaluationInterval: model.Duration(time.Minute),
+		ResendDelay:        model.Duration(time.Minute),
+		Webhook: &WebhookConf{
+			Timeout: model.Duration(time.Second * 10),
+		},
+	}
+	return c
+}
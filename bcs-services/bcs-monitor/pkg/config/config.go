@@ -47,6 +47,7 @@ type Configuration struct {
 	Web         *WebConf                 `yaml:"web"`
 	QueryStore  *QueryStoreConf          `yaml:"query_store_conf"`
 	TracingConf *TracingConf             `yaml:"tracing_conf"`
+	Alert       *AlertConf               `yaml:"alert_conf"`
 }
 
 // init 初始化
@@ -99,6 +100,7 @@ func newConfiguration() (*Configuration, error) {
 	c.BKLog = &BKLogConf{}
 	c.BKBase = &BKBaseConf{}
 	c.TracingConf = &TracingConf{}
+	c.Alert = defaultAlertConf()
 
 	return c, nil
 }
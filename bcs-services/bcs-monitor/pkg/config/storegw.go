@@ -32,4 +32,6 @@ const (
 	SUANLI_CPU StoreProvider = "SUANLI_CPU"
 	// SUANLI_GPU_NATIVE 算力 gpu 数据源
 	SUANLI_GPU_NATIVE StoreProvider = "SUANLI_GPU_NATIVE"
+	// RECORDING_RULE 预计算并降采样的 recording rules 数据源
+	RECORDING_RULE StoreProvider = "RECORDING_RULE"
 )
@@ -25,7 +25,7 @@ const (
 	queryTimeout                      = time.Second * 20 // 查询超时时间
 	lookbackDelta                     = time.Minute * 5  // 最大回溯时间，当步长太短，回溯去找上一个点的最大时间
 	dynamicLookbackDelta              = true             // 允许具有解析的查询，具有更大的回溯时间
-	enableAutodownsampling            = false            // 自动降采样，（如果max_source_resolution没配置的话）
+	enableAutodownsampling            = true             // 自动降采样，（如果max_source_resolution没配置的话）, 只对 recording store 生效
 	enableQueryPartialResponse        = true             // query模块的 部分响应参数
 	instantDefaultMaxSourceResolution = 0                // metadata的默认检索时间范围。0代表从头到尾全部
 	defaultMetadataTimeRange          = 0                // 即使查询的最大分辨率
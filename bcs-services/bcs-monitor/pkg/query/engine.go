@@ -13,6 +13,7 @@
 package query
 
 import (
+	"context"
 	"math"
 	"strconv"
 	"time"
@@ -25,13 +26,17 @@ import (
 	"github.com/thanos-io/thanos/pkg/extprom"
 	"github.com/thanos-io/thanos/pkg/query"
 	"github.com/thanos-io/thanos/pkg/store"
+	"github.com/thanos-io/thanos/pkg/store/storepb"
+	"google.golang.org/grpc"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/config"
 )
 
 // NewQueryableCreator xxx
 func NewQueryableCreator(reg *prometheus.Registry, logKit blog.GlogKit,
 	discoveryClient *DiscoveryClient) query.QueryableCreator {
-	proxy := store.NewProxyStore(logKit, reg, discoveryClient.Endpoints().GetStoreClients, component.Query, nil,
-		storeResponseTimeout)
+	proxy := store.NewProxyStore(logKit, reg, downsampleStoreClients(discoveryClient.Endpoints().GetStoreClients),
+		component.Query, nil, storeResponseTimeout)
 
 	queryableCreator := query.NewQueryableCreator(
 		logKit,
@@ -43,6 +48,48 @@ func NewQueryableCreator(reg *prometheus.Registry, logKit blog.GlogKit,
 	return queryableCreator
 }
 
+// downsampleStoreClients 降采样分辨率只下发给 recording store, 其他数据源始终查询原始数据
+func downsampleStoreClients(getClients func() []store.Client) func() []store.Client {
+	return func() []store.Client {
+		clients := getClients()
+		result := make([]store.Client, 0, len(clients))
+		for _, c := range clients {
+			if isRecordingStore(c) {
+				result = append(result, c)
+				continue
+			}
+			result = append(result, &rawStoreClient{Client: c})
+		}
+		return result
+	}
+}
+
+// isRecordingStore 通过 provider label 判断是否 recording store
+func isRecordingStore(c store.Client) bool {
+	for _, lset := range c.LabelSets() {
+		if lset.Get("provider") == string(config.RECORDING_RULE) {
+			return true
+		}
+	}
+	return false
+}
+
+// rawStoreClient 查询时忽略 MaxResolutionWindow
+type rawStoreClient struct {
+	store.Client
+}
+
+// Series 清空降采样分辨率后查询
+func (c *rawStoreClient) Series(ctx context.Context, in *storepb.SeriesRequest,
+	opts ...grpc.CallOption) (storepb.Store_SeriesClient, error) {
+	if in.MaxResolutionWindow != 0 {
+		req := *in
+		req.MaxResolutionWindow = 0
+		in = &req
+	}
+	return c.Client.Series(ctx, in, opts...)
+}
+
 // NewQueryEngine xxx
 func NewQueryEngine(reg *prometheus.Registry, logKit blog.GlogKit) func(int64) *promql.Engine {
 	engineOpts := promql.EngineOpts{
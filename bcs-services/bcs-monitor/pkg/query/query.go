@@ -16,6 +16,7 @@ package query
 import (
 	"context"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
@@ -32,9 +33,11 @@ import (
 	"github.com/thanos-io/thanos/pkg/logging"
 	"github.com/thanos-io/thanos/pkg/prober"
 	"github.com/thanos-io/thanos/pkg/query"
+	"github.com/thanos-io/thanos/pkg/rules"
 	httpserver "github.com/thanos-io/thanos/pkg/server/http"
 	"github.com/thanos-io/thanos/pkg/ui"
 
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/alert"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/config"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/utils"
 )
@@ -103,18 +106,32 @@ func NewQueryAPI(ctx context.Context, reg *prometheus.Registry, tracer opentraci
 	}
 	ui.NewQueryUI(logKit, discoveryClient.Endpoints(), prefix, "", "").Register(router, ins)
 
+	// 配置了告警规则时, 使用查询引擎计算告警, 同时提供 rules/alerts 接口
+	var ruleClient rules.UnaryClient = NewEmptyRuleClient()
+	if config.G.Alert.Enabled() {
+		queryable := queryableCreator(true, queryReplicaLabels, nil, 0, enableQueryPartialResponse, false, false)
+		externalURL := strings.TrimSuffix(config.G.Web.BaseURL.String(), "/") + config.QueryServicePrefix
+		alertManager, err := alert.NewManager(ctx, reg, logKit, config.G.Alert, queryEngine(0), queryable,
+			externalURL)
+		if err != nil {
+			return nil, err
+		}
+		ruleClient = alertManager
+		g.Add(alertManager.Run, alertManager.Close)
+	}
+
 	api := v1.NewQueryAPI(
 		logKit,
 		discoveryClient.Endpoints().GetEndpointStatus,
 		queryEngine,
 		queryableCreator,
-		NewEmptyRuleClient(),
+		ruleClient,
 		NewEmptyTargetClient(),
 		NewEmptyMetaDataClient(),
 		NewEmptyExemplarClient(),
 		enableAutodownsampling,
 		enableQueryPartialResponse,
-		true, // 用不到rule接口
+		true, // enableRulePartialResponse
 		true, // 用不到target接口
 		true, // 用不到 metadata接口
 		true, // enableExemplarPartialResponse
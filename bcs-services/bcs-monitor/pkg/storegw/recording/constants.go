@@ -0,0 +1,33 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recording
+
+import (
+	"time"
+
+	"github.com/prometheus/common/model"
+)
+
+const (
+	provider          = "RECORDING_RULE" // 数据源名称
+	defaultEvalStep   = time.Minute      // 计算规则时原始数据的步长
+	defaultEvalDelay  = time.Minute      // 计算延迟, 等待原始数据上报完成
+	defaultBackfill   = time.Hour * 24   // 启动时最多回填的时间范围
+	maxPointsPerQuery = 1000             // 单次 query_range 最多返回的点数, prometheus 限制 11000
+)
+
+// defaultResolutions 默认降采样分辨率, 5m 保留 30 天, 1h 保留 1 年
+var defaultResolutions = []ResolutionConfig{
+	{Resolution: model.Duration(time.Minute * 5), Retention: model.Duration(time.Hour * 24 * 30)},
+	{Resolution: model.Duration(time.Hour), Retention: model.Duration(time.Hour * 24 * 365)},
+}
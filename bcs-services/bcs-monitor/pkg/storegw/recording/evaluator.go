@@ -0,0 +1,141 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recording
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/storage"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/component/promclient"
+)
+
+// runEvaluation 按分辨率对齐周期计算所有规则, 结果写入本地 tsdb
+func (s *Store) runEvaluation(ctx context.Context, rdb *resolutionDB) {
+	evalDelay := time.Duration(s.config.EvalDelay)
+	last := s.lastEvalTime(rdb)
+
+	for {
+		end := time.Now().Add(-evalDelay).Truncate(rdb.resolution)
+		start := last.Add(rdb.resolution)
+		// 最多回填 backfill 时间范围
+		if minStart := end.Add(-time.Duration(s.config.Backfill)); start.Before(minStart) {
+			start = minStart
+		}
+
+		if !start.After(end) {
+			if err := s.evalRange(ctx, rdb, start, end); err != nil {
+				// 不更新 last, 下个周期重试
+				blog.Errorf("eval recording rules with resolution %s error, %s", rdb.resolution, err)
+			} else {
+				last = end
+			}
+		}
+
+		next := end.Add(rdb.resolution).Add(evalDelay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(next)):
+		}
+	}
+}
+
+// lastEvalTime 最近一次计算的时间, 从 tsdb 中恢复, 重启后继续计算
+func (s *Store) lastEvalTime(rdb *resolutionDB) time.Time {
+	maxTime := rdb.db.Head().MaxTime()
+	if blocks := rdb.db.Blocks(); len(blocks) > 0 {
+		// block 的 MaxTime 是开区间
+		if blockMaxTime := blocks[len(blocks)-1].Meta().MaxTime - 1; blockMaxTime > maxTime {
+			maxTime = blockMaxTime
+		}
+	}
+
+	if maxTime == math.MinInt64 {
+		return time.Time{}
+	}
+	return time.UnixMilli(maxTime).Truncate(rdb.resolution)
+}
+
+// evalRange 计算 [start, end] 内所有对齐的时间点, 按 maxPointsPerQuery 分批查询
+func (s *Store) evalRange(ctx context.Context, rdb *resolutionDB, start, end time.Time) error {
+	batch := rdb.resolution * maxPointsPerQuery
+	for batchStart := start; !batchStart.After(end); batchStart = batchStart.Add(batch) {
+		batchEnd := batchStart.Add(batch - rdb.resolution)
+		if batchEnd.After(end) {
+			batchEnd = end
+		}
+
+		var lastErr error
+		for _, rule := range s.config.Rules {
+			if err := s.evalRule(ctx, rdb, rule, batchStart, batchEnd); err != nil {
+				blog.Errorf("eval recording rule %s with resolution %s error, %s", rule.Record, rdb.resolution, err)
+				lastErr = err
+			}
+		}
+		if lastErr != nil {
+			return lastErr
+		}
+	}
+	return nil
+}
+
+// evalRule 计算单条规则, 每个点为 (t-resolution, t] 窗口内原始数据的平均值
+func (s *Store) evalRule(ctx context.Context, rdb *resolutionDB, rule RuleConfig, start, end time.Time) error {
+	promql := downsampleExpr(rule.Expr, rdb.resolution, time.Duration(s.config.EvalStep))
+	matrix, _, err := promclient.QueryRangeMatrix(ctx, s.config.QueryURL, nil, promql, start, end, rdb.resolution)
+	if err != nil {
+		return err
+	}
+
+	app := rdb.db.Appender(ctx)
+	for _, stream := range matrix {
+		lset := recordLabels(stream.Metric, rule)
+		for _, p := range stream.Values {
+			if _, err := app.Append(0, lset, int64(p.Timestamp), float64(p.Value)); err != nil {
+				// 重试时已写入的点忽略
+				if errors.Is(err, storage.ErrOutOfOrderSample) || errors.Is(err, storage.ErrDuplicateSampleForTimestamp) {
+					continue
+				}
+				_ = app.Rollback()
+				return errors.Wrapf(err, "append sample of %s", lset)
+			}
+		}
+	}
+	return app.Commit()
+}
+
+// downsampleExpr 生成降采样 promql, 如 avg_over_time((expr)[5m:1m])
+func downsampleExpr(expr string, resolution, step time.Duration) string {
+	return fmt.Sprintf("avg_over_time((%s)[%s:%s])", expr, model.Duration(resolution), model.Duration(step))
+}
+
+// recordLabels 预计算结果的 labels, __name__ 替换为 record 名称, 并追加规则 labels
+func recordLabels(metric model.Metric, rule RuleConfig) labels.Labels {
+	builder := labels.NewBuilder(nil)
+	for k, v := range metric {
+		builder.Set(string(k), string(v))
+	}
+	for k, v := range rule.Labels {
+		builder.Set(k, v)
+	}
+	builder.Set(labels.MetricName, rule.Record)
+	return builder.Labels()
+}
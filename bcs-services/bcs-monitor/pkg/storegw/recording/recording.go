@@ -0,0 +1,400 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package recording 预计算 recording rules 并降采样到本地 tsdb, 通过 StoreAPI 提供长时间范围查询
+package recording
+
+import (
+	"context"
+	"math"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/go-kit/log"
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/tsdb"
+	"github.com/thanos-io/thanos/pkg/component"
+	"github.com/thanos-io/thanos/pkg/store"
+	"github.com/thanos-io/thanos/pkg/store/storepb"
+	"gopkg.in/yaml.v2"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/storegw/clientutil"
+)
+
+// Config 配置
+type Config struct {
+	QueryURL       string             `yaml:"query_url"`            // bcs-monitor query api 地址, 用于计算规则
+	DataDir        string             `yaml:"data_dir"`             // 本地 tsdb 存储目录
+	EvalStep       model.Duration     `yaml:"eval_step,omitempty"`  // 计算时原始数据的步长, 默认 1m
+	EvalDelay      model.Duration     `yaml:"eval_delay,omitempty"` // 计算延迟, 默认 1m
+	Backfill       model.Duration     `yaml:"backfill,omitempty"`   // 启动时最多回填的时间范围, 默认 24h
+	Resolutions    []ResolutionConfig `yaml:"resolutions,omitempty"`
+	Rules          []RuleConfig       `yaml:"rules"`
+	ExternalLabels map[string]string  `yaml:"external_labels,omitempty"`
+}
+
+// ResolutionConfig 降采样分辨率配置
+type ResolutionConfig struct {
+	Resolution model.Duration `yaml:"resolution"` // 分辨率, 如 5m, 1h
+	Retention  model.Duration `yaml:"retention"`  // 数据保留时间
+}
+
+// RuleConfig recording rule 配置
+type RuleConfig struct {
+	Record string            `yaml:"record"` // 预计算后的 metric 名称
+	Expr   string            `yaml:"expr"`   // 原始 promql
+	Labels map[string]string `yaml:"labels,omitempty"`
+}
+
+// resolutionDB 单个分辨率对应的本地 tsdb
+type resolutionDB struct {
+	resolution time.Duration
+	db         *tsdb.DB
+}
+
+// Store 本地 recording rules 存储, 实现 StoreAPI
+type Store struct {
+	config         *Config
+	logger         log.Logger
+	externalLabels labels.Labels
+	metricNames    []string
+	dbs            []*resolutionDB // 按分辨率从小到大排序
+}
+
+// NewRecordingStore 初始化本地 tsdb 并启动规则计算
+func NewRecordingStore(logger log.Logger, conf []byte) (*Store, error) {
+	var config Config
+	if err := yaml.UnmarshalStrict(conf, &config); err != nil {
+		return nil, errors.Wrap(err, "parsing recording store config")
+	}
+
+	if err := config.init(); err != nil {
+		return nil, err
+	}
+
+	lbs := map[string]string{"provider": provider}
+	for k, v := range config.ExternalLabels {
+		lbs[k] = v
+	}
+
+	s := &Store{
+		config:         &config,
+		logger:         logger,
+		externalLabels: labels.FromMap(lbs),
+		metricNames:    config.metricNames(),
+	}
+
+	for _, res := range config.Resolutions {
+		db, err := openDB(logger, config.DataDir, res)
+		if err != nil {
+			s.close()
+			return nil, err
+		}
+		s.dbs = append(s.dbs, &resolutionDB{resolution: time.Duration(res.Resolution), db: db})
+	}
+
+	for _, rdb := range s.dbs {
+		go s.runEvaluation(context.Background(), rdb)
+	}
+
+	return s, nil
+}
+
+// init 默认值与合法性校验
+func (c *Config) init() error {
+	if c.QueryURL == "" {
+		return errors.New("query_url is required")
+	}
+	if c.DataDir == "" {
+		return errors.New("data_dir is required")
+	}
+	if len(c.Rules) == 0 {
+		return errors.New("rules is required")
+	}
+
+	if c.EvalStep <= 0 {
+		c.EvalStep = model.Duration(defaultEvalStep)
+	}
+	if c.EvalDelay <= 0 {
+		c.EvalDelay = model.Duration(defaultEvalDelay)
+	}
+	if c.Backfill <= 0 {
+		c.Backfill = model.Duration(defaultBackfill)
+	}
+	if len(c.Resolutions) == 0 {
+		c.Resolutions = append([]ResolutionConfig{}, defaultResolutions...)
+	}
+
+	sort.Slice(c.Resolutions, func(i, j int) bool {
+		return c.Resolutions[i].Resolution < c.Resolutions[j].Resolution
+	})
+
+	for _, res := range c.Resolutions {
+		if time.Duration(res.Resolution) < time.Duration(c.EvalStep) {
+			return errors.Errorf("resolution %s is smaller than eval_step %s", res.Resolution, c.EvalStep)
+		}
+		if res.Retention < res.Resolution {
+			return errors.Errorf("retention %s of resolution %s is too short", res.Retention, res.Resolution)
+		}
+	}
+
+	for _, rule := range c.Rules {
+		if !model.IsValidMetricName(model.LabelValue(rule.Record)) {
+			return errors.Errorf("invalid record name %q", rule.Record)
+		}
+		if _, err := parser.ParseExpr(rule.Expr); err != nil {
+			return errors.Wrapf(err, "parse expr of rule %s", rule.Record)
+		}
+	}
+
+	return nil
+}
+
+// metricNames 预计算的 metric 名称列表
+func (c *Config) metricNames() []string {
+	names := make([]string, 0, len(c.Rules))
+	for _, rule := range c.Rules {
+		names = append(names, rule.Record)
+	}
+	return clientutil.CleanStrList(names)
+}
+
+// openDB 打开分辨率对应的 tsdb, 每个分辨率独立目录, 便于按分辨率配置保留时间
+func openDB(logger log.Logger, dataDir string, res ResolutionConfig) (*tsdb.DB, error) {
+	opts := tsdb.DefaultOptions()
+	opts.RetentionDuration = time.Duration(res.Retention).Milliseconds()
+	// 每个 block 至少包含 24 个点
+	opts.MinBlockDuration = time.Duration(res.Resolution).Milliseconds() * 24
+	if opts.MinBlockDuration < tsdb.DefaultBlockDuration {
+		opts.MinBlockDuration = tsdb.DefaultBlockDuration
+	}
+	opts.MaxBlockDuration = opts.RetentionDuration / 10
+	if opts.MaxBlockDuration < opts.MinBlockDuration {
+		opts.MaxBlockDuration = opts.MinBlockDuration
+	}
+
+	dir := filepath.Join(dataDir, res.Resolution.String())
+	db, err := tsdb.Open(dir, log.With(logger, "resolution", res.Resolution.String()), nil, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open tsdb %s", dir)
+	}
+	return db, nil
+}
+
+// close 关闭已打开的 tsdb
+func (s *Store) close() {
+	for _, rdb := range s.dbs {
+		if err := rdb.db.Close(); err != nil {
+			blog.Errorf("close recording tsdb %s error, %s", rdb.resolution, err)
+		}
+	}
+}
+
+// Info 返回元数据信息
+func (s *Store) Info(ctx context.Context, r *storepb.InfoRequest) (*storepb.InfoResponse, error) {
+	res := &storepb.InfoResponse{
+		StoreType: component.Store.ToProto(),
+		MinTime:   math.MinInt64,
+		MaxTime:   math.MaxInt64,
+		LabelSets: clientutil.ExtendLabelSetByNames(s.externalLabels, s.metricNames),
+	}
+	return res, nil
+}
+
+// LabelNames 返回 labels 列表
+func (s *Store) LabelNames(ctx context.Context, r *storepb.LabelNamesRequest) (*storepb.LabelNamesResponse,
+	error) {
+	matchers, err := toPromMatchers(r.Matchers)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := s.queryLabels(ctx, r.Start, r.End, func(q storage.Querier) ([]string, storage.Warnings, error) {
+		return q.LabelNames(matchers...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &storepb.LabelNamesResponse{Names: names}, nil
+}
+
+// LabelValues 返回 label values 列表
+func (s *Store) LabelValues(ctx context.Context, r *storepb.LabelValuesRequest) (*storepb.LabelValuesResponse,
+	error) {
+	matchers, err := toPromMatchers(r.Matchers)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := s.queryLabels(ctx, r.Start, r.End, func(q storage.Querier) ([]string, storage.Warnings, error) {
+		return q.LabelValues(r.Label, matchers...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &storepb.LabelValuesResponse{Values: values}, nil
+}
+
+// queryLabels 合并所有分辨率的查询结果
+func (s *Store) queryLabels(ctx context.Context, start, end int64,
+	query func(q storage.Querier) ([]string, storage.Warnings, error)) ([]string, error) {
+	result := make([]string, 0)
+	for _, rdb := range s.dbs {
+		q, err := rdb.db.Querier(ctx, start, end)
+		if err != nil {
+			return nil, err
+		}
+
+		values, _, err := query(q)
+		_ = q.Close()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, values...)
+	}
+
+	result = clientutil.CleanStrList(result)
+	sort.Strings(result)
+	return result, nil
+}
+
+// Series 返回时序数据, 按 MaxResolutionWindow 自动选择分辨率
+func (s *Store) Series(r *storepb.SeriesRequest, srv storepb.Store_SeriesServer) error {
+	ctx := srv.Context()
+	blog.Infow(clientutil.DumpPromQL(r), "request_id", store.RequestIDValue(ctx), "minTime", r.MinTime, "maxTime",
+		r.MaxTime, "maxResolutionWindow", r.MaxResolutionWindow)
+
+	// 非预计算 metrics 直接忽略
+	if m := clientutil.GetLabelMatch(labels.MetricName, r.Matchers); m != nil && m.Type == storepb.LabelMatcher_EQ &&
+		!s.hasMetric(m.Value) {
+		return nil
+	}
+
+	matchers, err := toPromMatchers(r.Matchers)
+	if err != nil {
+		return err
+	}
+
+	rdb := s.selectDB(r.MinTime, r.MaxResolutionWindow)
+	q, err := rdb.db.Querier(ctx, r.MinTime, r.MaxTime)
+	if err != nil {
+		return err
+	}
+	defer q.Close() // nolint
+
+	seriesSet := q.Select(false, nil, matchers...)
+	for seriesSet.Next() {
+		series, err := toTimeSeries(seriesSet.At(), r.SkipChunks)
+		if err != nil {
+			return err
+		}
+		if !r.SkipChunks && len(series.Samples) == 0 {
+			continue
+		}
+
+		thanosSeries, err := series.ToThanosSeries(r.SkipChunks)
+		if err != nil {
+			return err
+		}
+		if err := srv.Send(storepb.NewSeriesResponse(thanosSeries)); err != nil {
+			return err
+		}
+	}
+
+	return seriesSet.Err()
+}
+
+// hasMetric 是否是预计算的 metric
+func (s *Store) hasMetric(name string) bool {
+	for _, n := range s.metricNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// selectDB 选择查询使用的分辨率
+func (s *Store) selectDB(minTime, maxResolutionWindow int64) *resolutionDB {
+	resolutions := make([]time.Duration, 0, len(s.dbs))
+	startTimes := make([]int64, 0, len(s.dbs))
+	for _, rdb := range s.dbs {
+		startTime, err := rdb.db.StartTime()
+		if err != nil {
+			startTime = math.MaxInt64
+		}
+		resolutions = append(resolutions, rdb.resolution)
+		startTimes = append(startTimes, startTime)
+	}
+	return s.dbs[selectResolution(resolutions, startTimes, minTime, maxResolutionWindow)]
+}
+
+// selectResolution 选择不超过 maxResolutionWindow 的最大分辨率, 默认使用最小分辨率
+// 如果该分辨率的数据没有覆盖查询起始时间(如刚开始计算), 回退到数据更早的小分辨率
+func selectResolution(resolutions []time.Duration, startTimes []int64, minTime, maxResolutionWindow int64) int {
+	selected := 0
+	for i, res := range resolutions {
+		if res.Milliseconds() <= maxResolutionWindow {
+			selected = i
+		}
+	}
+
+	for i := selected; i > 0; i-- {
+		if startTimes[i] <= minTime || startTimes[i] <= startTimes[i-1] {
+			return i
+		}
+	}
+	return 0
+}
+
+// toPromMatchers 转换为 prometheus matchers, 忽略 provider 等外部 label
+func toPromMatchers(matchers []storepb.LabelMatcher) ([]*labels.Matcher, error) {
+	newMatchers := make([]storepb.LabelMatcher, 0, len(matchers))
+	for _, m := range matchers {
+		if m.Name == "provider" {
+			continue
+		}
+		newMatchers = append(newMatchers, m)
+	}
+	return storepb.MatchersToPromMatchers(newMatchers...)
+}
+
+// toTimeSeries tsdb series 转换为 prompb 格式
+func toTimeSeries(series storage.Series, skipChunks bool) (*clientutil.TimeSeries, error) {
+	lset := series.Labels()
+	ts := &prompb.TimeSeries{Labels: make([]prompb.Label, 0, len(lset))}
+	for _, l := range lset {
+		ts.Labels = append(ts.Labels, prompb.Label{Name: l.Name, Value: l.Value})
+	}
+
+	if skipChunks {
+		return &clientutil.TimeSeries{TimeSeries: ts}, nil
+	}
+
+	it := series.Iterator()
+	for it.Next() {
+		t, v := it.At()
+		ts.Samples = append(ts.Samples, prompb.Sample{Timestamp: t, Value: v})
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return &clientutil.TimeSeries{TimeSeries: ts}, nil
+}
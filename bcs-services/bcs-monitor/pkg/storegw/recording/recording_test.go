@@ -0,0 +1,67 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recording
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/thanos-io/thanos/pkg/testutil"
+)
+
+func TestSelectResolution(t *testing.T) {
+	resolutions := []time.Duration{time.Minute * 5, time.Hour}
+	startTimes := []int64{1000, 1000}
+
+	// 未开启降采样, 使用最小分辨率
+	testutil.Assert(t, selectResolution(resolutions, startTimes, 2000, 0) == 0)
+	testutil.Assert(t, selectResolution(resolutions, startTimes, 2000, (time.Minute*10).Milliseconds()) == 0)
+	testutil.Assert(t, selectResolution(resolutions, startTimes, 2000, time.Hour.Milliseconds()) == 1)
+	testutil.Assert(t, selectResolution(resolutions, startTimes, 2000, (time.Hour*2).Milliseconds()) == 1)
+
+	// 1h 数据还没覆盖查询起始时间, 回退到 5m
+	startTimes = []int64{1000, 5000}
+	testutil.Assert(t, selectResolution(resolutions, startTimes, 2000, time.Hour.Milliseconds()) == 0)
+	testutil.Assert(t, selectResolution(resolutions, startTimes, 6000, time.Hour.Milliseconds()) == 1)
+
+	// 5m 数据已过期, 1h 数据更早
+	startTimes = []int64{8000, 5000}
+	testutil.Assert(t, selectResolution(resolutions, startTimes, 2000, time.Hour.Milliseconds()) == 1)
+}
+
+func TestDownsampleExpr(t *testing.T) {
+	expr := downsampleExpr(`sum(rate(container_cpu_usage_seconds_total[2m])) by (cluster_id)`, time.Hour, time.Minute)
+	testutil.Equals(t, `avg_over_time((sum(rate(container_cpu_usage_seconds_total[2m])) by (cluster_id))[1h:1m])`, expr)
+}
+
+func TestRecordLabels(t *testing.T) {
+	rule := RuleConfig{Record: "bcs:cluster:cpu:usage:avg", Labels: map[string]string{"source": "recording"}}
+	lset := recordLabels(model.Metric{"cluster_id": "BCS-K8S-00000", "__name__": "raw"}, rule)
+	testutil.Equals(t, `{__name__="bcs:cluster:cpu:usage:avg", cluster_id="BCS-K8S-00000", source="recording"}`,
+		lset.String())
+}
+
+func TestConfigInit(t *testing.T) {
+	c := &Config{QueryURL: "http://127.0.0.1:10902", DataDir: "./data"}
+	testutil.NotOk(t, c.init())
+
+	c.Rules = []RuleConfig{{Record: "bcs:cluster:cpu:usage:avg", Expr: "sum(up"}}
+	testutil.NotOk(t, c.init())
+
+	c.Rules = []RuleConfig{{Record: "bcs:cluster:cpu:usage:avg", Expr: "sum(up)"}}
+	testutil.Ok(t, c.init())
+	testutil.Equals(t, model.Duration(defaultEvalStep), c.EvalStep)
+	testutil.Assert(t, len(c.Resolutions) == 2)
+	testutil.Assert(t, c.Resolutions[0].Resolution < c.Resolutions[1].Resolution)
+}
@@ -32,6 +32,7 @@ import (
 	bcssystem "github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/storegw/bcs_system"
 	bkmonitor "github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/storegw/bk_monitor"
 	prom "github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/storegw/prometheus"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/storegw/recording"
 	suanlicpu "github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/storegw/suanli_cpu"
 	suanligpunative "github.com/Tencent/bk-bcs/bcs-services/bcs-monitor/pkg/storegw/suanli_gpu_native"
 )
@@ -66,6 +67,8 @@ func GetStoreSvr(logger log.Logger, reg *prometheus.Registry, conf *config.Store
 		return suanlicpu.NewSuanLiCPUStore(reg, c)
 	case string(config.SUANLI_GPU_NATIVE):
 		return suanligpunative.NewSuanLiGPUNativeStore(reg, c)
+	case string(config.RECORDING_RULE):
+		return recording.NewRecordingStore(logger, c)
 	default:
 		return nil, errors.Errorf("store with type %s is not supported", conf.Type)
 	}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/Tencent/bk-bcs/bcs-common/common"
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/mitchellh/mapstructure"
 
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-nodegroup-manager/pkg/metric"
@@ -336,6 +337,9 @@ func transferToHandlerStrategy(original *storage.NodeGroupMgrStrategy) *nodegrou
 				blog.Errorf("Error during decoding nodegroupBuffer to pbNodegroupBuffer:%s", err.Error())
 			}
 		}
+		if original.Strategy.Predictive != nil {
+			strategy.Predictive = transferToHandlerPredictive(original.Strategy.Predictive)
+		}
 	}
 	return &nodegroupmgr.NodeGroupStrategy{
 		Kind:              "NodeGroupStrategy",
@@ -399,6 +403,9 @@ func transferToStorageStrategy(original *nodegroupmgr.NodeGroupStrategy) *storag
 				blog.Errorf("Error during decoding nodegroupBuffer to storage nodegroupBuffer:%s", err.Error())
 			}
 		}
+		if original.Strategy.Predictive != nil {
+			strategy.Predictive = transferToStoragePredictive(original.Strategy.Predictive)
+		}
 	}
 	status := &storage.State{
 		Status:      storage.InitState,
@@ -417,6 +424,31 @@ func transferToStorageStrategy(original *nodegroupmgr.NodeGroupStrategy) *storag
 }
 
 // transferToStorageNodegroup transfer proto nodegroup struct to storage local struct
+// transferToHandlerPredictive weeklyWeight为空时保持为空，以区分未设置和设置为0
+func transferToHandlerPredictive(original *storage.PredictiveStrategy) *nodegroupmgr.Predictive {
+	predictive := &nodegroupmgr.Predictive{
+		LookAheadMinutes: int32(original.LookAheadMinutes),
+		HistoryDays:      int32(original.HistoryDays),
+	}
+	if original.WeeklyWeight != nil {
+		predictive.WeeklyWeight = &wrappers.Int32Value{Value: int32(*original.WeeklyWeight)}
+	}
+	return predictive
+}
+
+// transferToStoragePredictive weeklyWeight为空时保持为空，以区分未设置和设置为0
+func transferToStoragePredictive(original *nodegroupmgr.Predictive) *storage.PredictiveStrategy {
+	predictive := &storage.PredictiveStrategy{
+		LookAheadMinutes: int(original.LookAheadMinutes),
+		HistoryDays:      int(original.HistoryDays),
+	}
+	if original.WeeklyWeight != nil {
+		weeklyWeight := int(original.WeeklyWeight.GetValue())
+		predictive.WeeklyWeight = &weeklyWeight
+	}
+	return predictive
+}
+
 func transferToStorageNodegroup(origin *nodegroupmgr.NodeGroup,
 	storageNodegroup *storage.NodeGroup) (*storage.NodeGroup, bool) {
 	retNodegroup := &storage.NodeGroup{}
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
@@ -749,3 +750,21 @@ func Test_checkNodeGroupEqual(t *testing.T) {
 	storageNodegroup.NodeIPs = []string{"2.2.2.2", "3.3.3.3"}
 	assert.Equal(t, false, checkNodeGroupEqual(origin, storageNodegroup))
 }
+
+func Test_transferPredictive(t *testing.T) {
+	// weeklyWeight not set
+	origin := &nodegroupmanager.Predictive{LookAheadMinutes: 60, HistoryDays: 14}
+	storagePredictive := transferToStoragePredictive(origin)
+	assert.Equal(t, 60, storagePredictive.LookAheadMinutes)
+	assert.Equal(t, 14, storagePredictive.HistoryDays)
+	assert.Nil(t, storagePredictive.WeeklyWeight)
+	assert.Nil(t, transferToHandlerPredictive(storagePredictive).WeeklyWeight)
+	// weeklyWeight set to 0
+	origin.WeeklyWeight = &wrappers.Int32Value{Value: 0}
+	storagePredictive = transferToStoragePredictive(origin)
+	assert.NotNil(t, storagePredictive.WeeklyWeight)
+	assert.Equal(t, 0, *storagePredictive.WeeklyWeight)
+	handlerPredictive := transferToHandlerPredictive(storagePredictive)
+	assert.NotNil(t, handlerPredictive.WeeklyWeight)
+	assert.Equal(t, int32(0), handlerPredictive.WeeklyWeight.GetValue())
+}
@@ -127,6 +127,17 @@ func (c *control) controllerLoops() {
 		len(hierarchicalStrategies))
 	strategies = append(strategies, hierarchicalStrategies...)
 	metric.ReportStrategyNumMetric(storage.HierarchicalStrategyType, len(hierarchicalStrategies))
+	predictiveStrategies, err := c.opt.Storage.ListNodeGroupStrategiesByType(storage.PredictiveStrategyType,
+		&storage.ListOptions{})
+	if err != nil {
+		blog.Errorf("[nodegroupController] controller check all predictive nodegroup manage strategies failed, %s",
+			err.Error())
+		return
+	}
+	blog.Infof("[nodegroupController] controller got %d predictive type NodeGroupMgrStrategy",
+		len(predictiveStrategies))
+	strategies = append(strategies, predictiveStrategies...)
+	metric.ReportStrategyNumMetric(storage.PredictiveStrategyType, len(predictiveStrategies))
 	for _, strategy := range strategies {
 		c.handleStrategy(strategy)
 		blog.Infof("[nodegroupController] strategy %s for ResourcePool %s has been processed completely",
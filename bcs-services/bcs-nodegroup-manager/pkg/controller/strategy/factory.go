@@ -24,6 +24,7 @@ import (
 var (
 	bufferExecutor               = &BufferStrategyExecutor{}
 	hierarchicalStrategyExecutor = &HierarchicalStrategyExecutor{}
+	predictiveStrategyExecutor   = &PredictiveStrategyExecutor{}
 )
 
 // Factory strategy factory
@@ -59,6 +60,7 @@ func NewFactory(opt *Options) Factory {
 func (f *strategyFactory) Init() {
 	bufferExecutor = NewBufferStrategyExecutor(f.opt)
 	hierarchicalStrategyExecutor = NewHierarchicalStrategyExecutor(f.opt)
+	predictiveStrategyExecutor = NewPredictiveStrategyExecutor(f.opt)
 }
 
 // GetStrategyExecutor get strategy executor by kind
@@ -68,6 +70,8 @@ func (f *strategyFactory) GetStrategyExecutor(strategy *storage.Strategy) (Execu
 		return bufferExecutor, nil
 	case storage.HierarchicalStrategyType:
 		return hierarchicalStrategyExecutor, nil
+	case storage.PredictiveStrategyType:
+		return predictiveStrategyExecutor, nil
 	default:
 		return nil, fmt.Errorf("unknown strategy type:%s", strategy.Type)
 	}
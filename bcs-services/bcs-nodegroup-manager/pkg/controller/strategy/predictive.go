@@ -0,0 +1,322 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strategy
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-nodegroup-manager/pkg/storage"
+)
+
+const (
+	// defaultLookAheadMinutes default prediction window
+	defaultLookAheadMinutes = 60
+	// maxLookAheadMinutes prediction window can not exceed one day, otherwise daily sample is in future
+	maxLookAheadMinutes = 24 * 60
+	// defaultHistoryDays default history days for prediction
+	defaultHistoryDays = 14
+	// defaultWeeklyWeight default weight of weekly seasonality
+	defaultWeeklyWeight = 50
+	// maxDailySamples max days used for daily seasonality
+	maxDailySamples = 7
+	// predictStep sample step in prediction window
+	predictStep = 10 * time.Minute
+	day         = 24 * time.Hour
+	week        = 7 * day
+)
+
+// PredictiveStrategyExecutor predictive type strategy executor.
+// it forecasts resource pool demand by daily/weekly seasonality of elastic nodegroups history,
+// and scales down elastic nodegroups ahead of a predicted peak.
+type PredictiveStrategyExecutor struct {
+	opt *Options
+}
+
+// NewPredictiveStrategyExecutor return PredictiveStrategyExecutor
+func NewPredictiveStrategyExecutor(opt *Options) *PredictiveStrategyExecutor {
+	return &PredictiveStrategyExecutor{
+		opt: opt,
+	}
+}
+
+// IsAbleToScaleDown check if is able to scale down, reserved buffer is raised by predicted demand
+func (e *PredictiveStrategyExecutor) IsAbleToScaleDown(strategy *storage.NodeGroupMgrStrategy) (int,
+	bool, error) {
+	blog.Infof("controller handle predictive strategy %s for ResourcePool %s", strategy.Name, strategy.ResourcePool)
+	pool, err := e.getDeviceGroup(strategy)
+	if err != nil {
+		return 0, false, err
+	}
+	availableTotal := float64(pool.IdleNum + pool.ConsumedNum)
+	reservedNum := getReservedNum(strategy, availableTotal)
+	predictNum := e.predictDemand(strategy, time.Now())
+	expectedNum := reservedNum + predictNum
+	blog.Infof("strategy %s, consumerID:%s: idleNum:%d, reservedNum:%d, predictNum:%d, availableTotal:%d",
+		strategy.Name, pool.ConsumerID, pool.IdleNum, reservedNum, predictNum, int(availableTotal))
+	if pool.IdleNum >= expectedNum {
+		blog.Infof("device group of consumer %s resource is idle %d >= expected %d, elasticNodeGroup don't scaleDown",
+			pool.ConsumerID, pool.IdleNum, expectedNum)
+		return 0, false, nil
+	}
+	scaleDownNum := expectedNum - pool.IdleNum
+	blog.Infof("strategy:%s, scaleDownNum:%d", strategy.Name, scaleDownNum)
+	return scaleDownNum, true, nil
+}
+
+// IsAbleToScaleUp check if is able to scale up, resources predicted to be needed soon are kept in pool
+func (e *PredictiveStrategyExecutor) IsAbleToScaleUp(strategy *storage.NodeGroupMgrStrategy) (int,
+	bool, int, error) {
+	blog.Infof("controller handle predictive strategy %s for ResourcePool %s", strategy.Name, strategy.ResourcePool)
+	isExecutingTask, err := checkIfTaskExecuting(strategy.Name, e.opt.Storage)
+	if err != nil {
+		return 0, false, 0, fmt.Errorf("check if task executing failed: %s", err.Error())
+	}
+	if isExecutingTask {
+		blog.Infof("strategy %s is executing scale down task, skip scale up", strategy.Name)
+		return 0, false, 0, nil
+	}
+	pool, err := e.getDeviceGroup(strategy)
+	if err != nil {
+		return 0, false, 0, err
+	}
+	total := pool.InitNum + pool.IdleNum + pool.ConsumedNum + pool.ReturnedNum
+	availableTotal := float64(pool.IdleNum + pool.ConsumedNum)
+	reservedNum := getReservedNum(strategy, availableTotal)
+	predictNum := e.predictDemand(strategy, time.Now())
+	expectedNum := reservedNum + predictNum
+	blog.Infof("strategy %s, consumerID:%s: total:%d, idleNum:%d, reservedNum:%d, predictNum:%d, "+
+		"availableTotal:%d", strategy.Name, pool.ConsumerID, total, pool.IdleNum, reservedNum, predictNum,
+		int(availableTotal))
+	if pool.IdleNum <= expectedNum {
+		blog.Infof("device group of consumer %s idle resource %d <= expected %d, elasticNodeGroup don't scaleUp",
+			pool.ConsumerID, pool.IdleNum, expectedNum)
+		return 0, false, 0, nil
+	}
+	// check resource pool is idle and stable
+	diff := time.Since(pool.UpdatedTime)
+	if diff.Seconds() < float64(strategy.Strategy.MaxIdleDelay*60) {
+		blog.Infof("device group of consumer %s is not stable enough for elasticNodeGroup scaleUp, now: %.f, target: %d",
+			pool.ConsumerID, diff.Seconds(), strategy.Strategy.MaxIdleDelay*60)
+		return 0, false, 0, nil
+	}
+	scaleUpNum := pool.IdleNum - expectedNum
+	if scaleUpNum < strategy.Strategy.MinScaleUpSize {
+		blog.Infof("device group of consumer %s idle resource %d is less than MinScaleUpSize %d",
+			pool.ConsumerID, scaleUpNum, strategy.Strategy.MinScaleUpSize)
+		return 0, false, 0, nil
+	}
+	blog.Infof("strategy %s scaleUpNum:%d", strategy.Name, scaleUpNum)
+	return scaleUpNum, true, total, nil
+}
+
+// HandleNodeMetadata handle node metadata
+func (e *PredictiveStrategyExecutor) HandleNodeMetadata() {
+	blog.Infof("[PredictiveStrategyExecutor] do not need to update node")
+}
+
+// CreateNodeUpdateAction create update action
+func (e *PredictiveStrategyExecutor) CreateNodeUpdateAction(strategy *storage.NodeGroupMgrStrategy,
+	action *storage.NodeGroupAction) error {
+	blog.Infof("[PredictiveStrategyExecutor] do not need to update node")
+	return nil
+}
+
+// getDeviceGroup query relative device group information from resource-manager
+func (e *PredictiveStrategyExecutor) getDeviceGroup(strategy *storage.NodeGroupMgrStrategy) (*storage.DeviceGroup,
+	error) {
+	var consumerID string
+	if strategy.ReservedNodeGroup != nil && strategy.ReservedNodeGroup.ConsumerID != "" {
+		consumerID = strategy.ReservedNodeGroup.ConsumerID
+	} else {
+		for _, elasticGroup := range strategy.ElasticNodeGroups {
+			if elasticGroup.ConsumerID != "" {
+				consumerID = elasticGroup.ConsumerID
+				break
+			}
+		}
+	}
+	if consumerID == "" {
+		return nil, fmt.Errorf("strategy %s consumer id is empty", strategy.Name)
+	}
+	pool, err := e.opt.ResourceManager.GetDeviceListByConsumer(consumerID, nil)
+	if err != nil {
+		blog.Errorf("controller got ResourcePool %s from resource-manager failed, %s",
+			strategy.ResourcePool, err.Error())
+		return nil, fmt.Errorf("get dependent resourcepool %s failed", strategy.ResourcePool)
+	}
+	return pool, nil
+}
+
+// predictDemand predict how many devices will be required by elastic nodegroups in look ahead window.
+// return 0 when history is not enough for prediction
+func (e *PredictiveStrategyExecutor) predictDemand(strategy *storage.NodeGroupMgrStrategy, now time.Time) int {
+	lookAhead, historyDays, weeklyWeight := getPredictiveParams(strategy.Strategy.Predictive)
+	history := &sizeHistory{}
+	since := now.Add(-time.Duration(historyDays) * day)
+	for _, group := range strategy.ElasticNodeGroups {
+		events, err := e.opt.Storage.ListNodeGroupEvent(group.NodeGroupID, &storage.ListOptions{Since: since})
+		if err != nil {
+			blog.Errorf("strategy %s list nodegroup %s events failed: %s, skip it in prediction",
+				strategy.Name, group.NodeGroupID, err.Error())
+			continue
+		}
+		history.add(events, since)
+	}
+	demand := forecastDemand(history, now, lookAhead, historyDays, weeklyWeight)
+	blog.Infof("strategy %s predict demand %d in next %s", strategy.Name, demand, lookAhead.String())
+	return demand
+}
+
+// getReservedNum reserved number calculated by buffer high water level
+func getReservedNum(strategy *storage.NodeGroupMgrStrategy, availableTotal float64) int {
+	if strategy.Strategy.Buffer == nil {
+		return 0
+	}
+	return int(math.Ceil(availableTotal * float64(strategy.Strategy.Buffer.High) / 100))
+}
+
+// getPredictiveParams return prediction params with default value
+func getPredictiveParams(predictive *storage.PredictiveStrategy) (time.Duration, int, int) {
+	lookAheadMinutes, historyDays, weeklyWeight := defaultLookAheadMinutes, defaultHistoryDays, defaultWeeklyWeight
+	if predictive != nil {
+		if predictive.LookAheadMinutes > 0 {
+			lookAheadMinutes = predictive.LookAheadMinutes
+		}
+		if predictive.HistoryDays > 0 {
+			historyDays = predictive.HistoryDays
+		}
+		if predictive.WeeklyWeight != nil && *predictive.WeeklyWeight >= 0 && *predictive.WeeklyWeight <= 100 {
+			weeklyWeight = *predictive.WeeklyWeight
+		}
+	}
+	if lookAheadMinutes > maxLookAheadMinutes {
+		lookAheadMinutes = maxLookAheadMinutes
+	}
+	return time.Duration(lookAheadMinutes) * time.Minute, historyDays, weeklyWeight
+}
+
+// forecastDemand forecast size of elastic nodegroups in (now, now+lookAhead], demand is the biggest
+// predicted shrink compared with forecast of now, which means devices will be returned to resource pool
+func forecastDemand(history *sizeHistory, now time.Time, lookAhead time.Duration, historyDays,
+	weeklyWeight int) int {
+	base, ok := history.forecast(now, historyDays, weeklyWeight)
+	if !ok {
+		return 0
+	}
+	lowest := base
+	for offset := predictStep; ; offset += predictStep {
+		if offset > lookAhead {
+			offset = lookAhead
+		}
+		value, found := history.forecast(now.Add(offset), historyDays, weeklyWeight)
+		if found && value < lowest {
+			lowest = value
+		}
+		if offset == lookAhead {
+			break
+		}
+	}
+	return int(math.Ceil(base - lowest))
+}
+
+type sizePoint struct {
+	time time.Time
+	size int
+}
+
+// sizeHistory desired size history of elastic nodegroups, rebuilt from nodegroup events
+type sizeHistory struct {
+	groups [][]sizePoint
+}
+
+// add nodegroup events into history, the latest event before since is kept as baseline
+func (h *sizeHistory) add(events []*storage.NodeGroupEvent, since time.Time) {
+	points := make([]sizePoint, 0, len(events))
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		points = append(points, sizePoint{time: event.EventTime, size: event.DesiredNum})
+	}
+	if len(points) == 0 {
+		return
+	}
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].time.Before(points[j].time)
+	})
+	start := sort.Search(len(points), func(i int) bool {
+		return !points[i].time.Before(since)
+	})
+	if start > 0 {
+		start--
+	}
+	h.groups = append(h.groups, points[start:])
+}
+
+// sizeAt return total size of nodegroups at time t, false if no nodegroup has history at t
+func (h *sizeHistory) sizeAt(t time.Time) (int, bool) {
+	total, found := 0, false
+	for _, points := range h.groups {
+		idx := sort.Search(len(points), func(i int) bool {
+			return points[i].time.After(t)
+		})
+		if idx == 0 {
+			continue
+		}
+		total += points[idx-1].size
+		found = true
+	}
+	return total, found
+}
+
+// forecast predict total size at time t by blending daily and weekly seasonality
+func (h *sizeHistory) forecast(t time.Time, historyDays, weeklyWeight int) (float64, bool) {
+	dailyDays := historyDays
+	if dailyDays > maxDailySamples {
+		dailyDays = maxDailySamples
+	}
+	daily, dailyOK := h.average(t, day, dailyDays)
+	weekly, weeklyOK := h.average(t, week, historyDays/7)
+	switch {
+	case dailyOK && weeklyOK:
+		return (weekly*float64(weeklyWeight) + daily*float64(100-weeklyWeight)) / 100, true
+	case weeklyOK:
+		return weekly, true
+	case dailyOK:
+		return daily, true
+	default:
+		return 0, false
+	}
+}
+
+// average return average size at t-period, t-2*period ... t-count*period
+func (h *sizeHistory) average(t time.Time, period time.Duration, count int) (float64, bool) {
+	sum, samples := 0, 0
+	for i := 1; i <= count; i++ {
+		size, ok := h.sizeAt(t.Add(-time.Duration(i) * period))
+		if !ok {
+			continue
+		}
+		sum += size
+		samples++
+	}
+	if samples == 0 {
+		return 0, false
+	}
+	return float64(sum) / float64(samples), true
+}
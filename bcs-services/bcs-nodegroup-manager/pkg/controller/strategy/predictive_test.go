@@ -0,0 +1,267 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package strategy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-nodegroup-manager/pkg/cluster/mocks"
+	resourcemock "github.com/Tencent/bk-bcs/bcs-services/bcs-nodegroup-manager/pkg/resourcemgr/mocks"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-nodegroup-manager/pkg/storage"
+	storagemock "github.com/Tencent/bk-bcs/bcs-services/bcs-nodegroup-manager/pkg/storage/mocks"
+)
+
+func TestForecastDemand(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		events     []*storage.NodeGroupEvent
+		lookAhead  time.Duration
+		historyDay int
+		want       int
+	}{
+		{
+			name:       "no history",
+			events:     nil,
+			lookAhead:  time.Hour,
+			historyDay: 14,
+			want:       0,
+		},
+		{
+			name:       "daily shrink in window",
+			events:     getTestPeriodicEvents(now, 14),
+			lookAhead:  time.Hour,
+			historyDay: 14,
+			want:       6,
+		},
+		{
+			name:       "daily shrink out of window",
+			events:     getTestPeriodicEvents(now, 14),
+			lookAhead:  20 * time.Minute,
+			historyDay: 14,
+			want:       0,
+		},
+		{
+			name:       "only daily history",
+			events:     getTestPeriodicEvents(now, 3),
+			lookAhead:  time.Hour,
+			historyDay: 3,
+			want:       6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := &sizeHistory{}
+			history.add(tt.events, now.Add(-time.Duration(tt.historyDay)*day))
+			assert.Equal(t, tt.want, forecastDemand(history, now, tt.lookAhead, tt.historyDay, defaultWeeklyWeight))
+		})
+	}
+}
+
+func TestGetPredictiveParams(t *testing.T) {
+	lookAhead, historyDays, weeklyWeight := getPredictiveParams(nil)
+	assert.Equal(t, time.Duration(defaultLookAheadMinutes)*time.Minute, lookAhead)
+	assert.Equal(t, defaultHistoryDays, historyDays)
+	assert.Equal(t, defaultWeeklyWeight, weeklyWeight)
+	// weeklyWeight not set
+	lookAhead, historyDays, weeklyWeight = getPredictiveParams(&storage.PredictiveStrategy{
+		LookAheadMinutes: 3000,
+		HistoryDays:      28,
+	})
+	assert.Equal(t, time.Duration(maxLookAheadMinutes)*time.Minute, lookAhead)
+	assert.Equal(t, 28, historyDays)
+	assert.Equal(t, defaultWeeklyWeight, weeklyWeight)
+	// weeklyWeight set to 0, only daily seasonality
+	zero := 0
+	_, _, weeklyWeight = getPredictiveParams(&storage.PredictiveStrategy{WeeklyWeight: &zero})
+	assert.Equal(t, 0, weeklyWeight)
+	// invalid weeklyWeight
+	invalid := 101
+	_, _, weeklyWeight = getPredictiveParams(&storage.PredictiveStrategy{WeeklyWeight: &invalid})
+	assert.Equal(t, defaultWeeklyWeight, weeklyWeight)
+}
+
+func TestPredictive_IsAbleToScaleDown(t *testing.T) {
+	tests := []struct {
+		name       string
+		strategy   *storage.NodeGroupMgrStrategy
+		wantNum    int
+		wantResult bool
+		wantErr    bool
+		on         func(f *MockFields)
+	}{
+		{
+			name:       "scale down ahead of predicted demand",
+			strategy:   getTestPredictiveStrategy(),
+			wantNum:    6,
+			wantResult: true,
+			wantErr:    false,
+			on: func(f *MockFields) {
+				// only history in prediction window is listed
+				withinHistory := mock.MatchedBy(func(opt *storage.ListOptions) bool {
+					return !opt.Since.IsZero() && opt.Since.Before(time.Now().Add(-13*day))
+				})
+				f.storage.On("ListNodeGroupEvent", "NodeGroup1", withinHistory).
+					Return(getTestPeriodicEvents(time.Now(), 14), nil)
+				f.storage.On("ListNodeGroupEvent", "NodeGroup2", withinHistory).
+					Return(nil, nil)
+				f.resourceCli.On("GetDeviceListByConsumer", "consumer1", mock.Anything).
+					Return(&storage.DeviceGroup{
+						UpdatedTime: time.Now(),
+						IdleNum:     2,
+						ConsumedNum: 18,
+					}, nil)
+			},
+		},
+		{
+			name:       "no history, buffer is enough",
+			strategy:   getTestPredictiveStrategy(),
+			wantNum:    0,
+			wantResult: false,
+			wantErr:    false,
+			on: func(f *MockFields) {
+				f.storage.On("ListNodeGroupEvent", mock.Anything, mock.Anything).
+					Return(nil, nil)
+				f.resourceCli.On("GetDeviceListByConsumer", "consumer1", mock.Anything).
+					Return(&storage.DeviceGroup{
+						UpdatedTime: time.Now(),
+						IdleNum:     2,
+						ConsumedNum: 18,
+					}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockFields := &MockFields{
+				resourceCli:   resourcemock.NewClient(t),
+				storage:       storagemock.NewStorage(t),
+				clusterClient: mocks.NewClient(t),
+			}
+			tt.on(mockFields)
+			opts := &Options{
+				ResourceManager: mockFields.resourceCli,
+				Storage:         mockFields.storage,
+			}
+			executor := NewPredictiveStrategyExecutor(opts)
+			num, result, err := executor.IsAbleToScaleDown(tt.strategy)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantNum, num)
+			assert.Equal(t, tt.wantResult, result)
+		})
+	}
+}
+
+func TestPredictive_IsAbleToScaleUp(t *testing.T) {
+	tests := []struct {
+		name       string
+		strategy   *storage.NodeGroupMgrStrategy
+		wantNum    int
+		wantResult bool
+		wantErr    bool
+		on         func(f *MockFields)
+	}{
+		{
+			name:       "keep predicted demand in pool",
+			strategy:   getTestPredictiveStrategy(),
+			wantNum:    12,
+			wantResult: true,
+			wantErr:    false,
+			on: func(f *MockFields) {
+				f.storage.On("ListTasksByStrategy", "test-strategy", mock.Anything).
+					Return([]*storage.ScaleDownTask{}, nil)
+				f.storage.On("ListNodeGroupEvent", "NodeGroup1", mock.Anything).
+					Return(getTestPeriodicEvents(time.Now(), 14), nil)
+				f.storage.On("ListNodeGroupEvent", "NodeGroup2", mock.Anything).
+					Return(nil, nil)
+				f.resourceCli.On("GetDeviceListByConsumer", "consumer1", mock.Anything).
+					Return(&storage.DeviceGroup{
+						UpdatedTime: time.Now(),
+						IdleNum:     20,
+						ConsumedNum: 0,
+					}, nil)
+			},
+		},
+		{
+			name:       "predicted demand exceeds idle resource",
+			strategy:   getTestPredictiveStrategy(),
+			wantNum:    0,
+			wantResult: false,
+			wantErr:    false,
+			on: func(f *MockFields) {
+				f.storage.On("ListTasksByStrategy", "test-strategy", mock.Anything).
+					Return([]*storage.ScaleDownTask{}, nil)
+				f.storage.On("ListNodeGroupEvent", "NodeGroup1", mock.Anything).
+					Return(getTestPeriodicEvents(time.Now(), 14), nil)
+				f.storage.On("ListNodeGroupEvent", "NodeGroup2", mock.Anything).
+					Return(nil, nil)
+				f.resourceCli.On("GetDeviceListByConsumer", "consumer1", mock.Anything).
+					Return(&storage.DeviceGroup{
+						UpdatedTime: time.Now(),
+						IdleNum:     7,
+						ConsumedNum: 13,
+					}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockFields := &MockFields{
+				resourceCli:   resourcemock.NewClient(t),
+				storage:       storagemock.NewStorage(t),
+				clusterClient: mocks.NewClient(t),
+			}
+			tt.on(mockFields)
+			opts := &Options{
+				ResourceManager: mockFields.resourceCli,
+				Storage:         mockFields.storage,
+			}
+			executor := NewPredictiveStrategyExecutor(opts)
+			num, result, _, err := executor.IsAbleToScaleUp(tt.strategy)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantNum, num)
+			assert.Equal(t, tt.wantResult, result)
+		})
+	}
+}
+
+func getTestPredictiveStrategy() *storage.NodeGroupMgrStrategy {
+	strategy := getTestStrategy()
+	strategy.ReservedNodeGroup.ConsumerID = "consumer1"
+	strategy.Strategy.Type = storage.PredictiveStrategyType
+	strategy.Strategy.Buffer.High = 10
+	strategy.Strategy.Predictive = &storage.PredictiveStrategy{
+		LookAheadMinutes: 60,
+		HistoryDays:      14,
+	}
+	return strategy
+}
+
+// getTestPeriodicEvents nodegroup shrinks from 10 to 4 at 30 minutes later of every day, and recovers 3 hours later
+func getTestPeriodicEvents(now time.Time, days int) []*storage.NodeGroupEvent {
+	events := []*storage.NodeGroupEvent{
+		{NodeGroupID: "NodeGroup1", EventTime: now.Add(-time.Duration(days+1) * day), DesiredNum: 10},
+	}
+	for i := days; i >= 1; i-- {
+		dayStart := now.Add(-time.Duration(i) * day)
+		events = append(events,
+			&storage.NodeGroupEvent{NodeGroupID: "NodeGroup1", EventTime: dayStart.Add(30 * time.Minute), DesiredNum: 4},
+			&storage.NodeGroupEvent{NodeGroupID: "NodeGroup1", EventTime: dayStart.Add(3 * time.Hour), DesiredNum: 10},
+		)
+	}
+	return events
+}
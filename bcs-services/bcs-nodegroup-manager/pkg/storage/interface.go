@@ -13,12 +13,16 @@
 // Package storage xxx
 package storage
 
+import "time"
+
 // ListOptions for list operation
 type ListOptions struct {
 	Limit                  int
 	Page                   int
 	ReturnSoftDeletedItems bool
 	DoPagination           bool
+	// Since only list events happened at or after the time when it is not zero
+	Since time.Time
 }
 
 // CreateOptions for create strategy
@@ -95,6 +95,11 @@ func (m *ModelEvent) ListNodeGroupEvent(nodeGroupID string, opt *storage.ListOpt
 	cond = append(cond, operator.NewLeafCondition(operator.Eq, operator.M{
 		isDeletedKey: opt.ReturnSoftDeletedItems,
 	}))
+	if !opt.Since.IsZero() {
+		cond = append(cond, operator.NewLeafCondition(operator.Gte, operator.M{
+			eventTimeKey: opt.Since,
+		}))
+	}
 	if !opt.DoPagination && opt.Limit == 0 {
 		// nolint
 		count, err := m.DB.Table(m.TableName).Find(operator.NewBranchCondition(operator.And, cond...)).Count(ctx)
@@ -18,6 +18,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
@@ -136,6 +137,33 @@ func TestModelEvent_ListNodeGroupEvent(t *testing.T) {
 					})
 			},
 		},
+		{
+			name: "since",
+			opt: &storage.ListOptions{
+				Limit: 1,
+				Since: time.Now().Add(-time.Hour),
+			},
+			nodegroupId: "testNodeGroup",
+			wantErr:     false,
+			want:        []*storage.NodeGroupEvent{event},
+			on: func(mockFields *MockFields) {
+				mockFields.db.On("HasTable", context.Background(), tableNamePrefix+eventTableName).Return(true, nil)
+				mockFields.db.On("Table", tableNamePrefix+eventTableName).Return(mockFields.table)
+				mockFields.table.On("HasIndex", context.Background(), mock.Anything).Return(true, nil)
+				// nodegroup, soft deleted and event time conditions
+				mockFields.table.On("Find", mock.MatchedBy(func(cond *operator.Condition) bool {
+					return len(cond.Children) == 3 && cond.Children[2].Op == operator.Gte
+				})).Return(mockFields.find)
+				mockFields.find.On("WithSort", mock.Anything).Return(mockFields.find)
+				mockFields.find.On("WithStart", mock.Anything).Return(mockFields.find)
+				mockFields.find.On("WithLimit", mock.Anything).Return(mockFields.find)
+				mockFields.find.On("All",
+					context.Background(), mock.Anything).
+					Return(func(ctx context.Context, result interface{}) error {
+						return reflectInterface(result, []*storage.NodeGroupEvent{event})
+					})
+			},
+		},
 		{
 			name:        "err",
 			nodegroupId: "testStrategy",
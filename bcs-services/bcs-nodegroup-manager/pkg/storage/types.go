@@ -65,6 +65,8 @@ const (
 	BufferStrategyType = "buffer"
 	// HierarchicalStrategyType hierarchicalBuffer
 	HierarchicalStrategyType = "hierarchicalBuffer"
+	// PredictiveStrategyType predictive, forecast elasticNodeGroup usage by daily and weekly seasonality
+	PredictiveStrategyType = "predictive"
 )
 
 const (
@@ -130,6 +132,8 @@ type Strategy struct {
 	TimeMode *BufferTimeMode `json:"timeMode" bson:"time_mode"`
 	// 多个nodegroup分别设置buffer
 	NodegroupBuffer map[string]*NodegroupBuffer `json:"nodegroupBuffer" bson:"nodegroup_buffer"`
+	// Predictive 预测模式参数
+	Predictive *PredictiveStrategy `json:"predictive" bson:"predictive"`
 }
 
 // BufferStrategy 空闲资源水位策略
@@ -149,6 +153,18 @@ type BufferTimeMode struct {
 	ReservedHours        int           `json:"reservedHours" bson:"reserved_hours"`
 }
 
+// PredictiveStrategy 预测模式配置
+// elasticNodeGroup历史规模存在明显的日、周周期，根据历史同期规模预测未来窗口内资源池的需求，
+// 在需求高峰到来之前提前缩容elasticNodeGroup归还资源
+type PredictiveStrategy struct {
+	// LookAheadMinutes 预测窗口，单位分钟，需覆盖elasticNodeGroup缩容耗时
+	LookAheadMinutes int `json:"lookAheadMinutes" bson:"look_ahead_minutes"`
+	// HistoryDays 参与预测的历史天数
+	HistoryDays int `json:"historyDays" bson:"history_days"`
+	// WeeklyWeight 周周期权重百分比，其余为日周期权重，为空时使用默认值，0表示仅使用日周期
+	WeeklyWeight *int `json:"weeklyWeight,omitempty" bson:"weekly_weight,omitempty"`
+}
+
 // NodegroupBuffer 单nodegroup buffer设置
 type NodegroupBuffer struct {
 	Percent int32 `json:"percent" bson:"percent"`
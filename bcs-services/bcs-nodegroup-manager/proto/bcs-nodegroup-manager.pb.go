@@ -8,6 +8,7 @@ package nodegroupmanager
 
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -1434,6 +1435,7 @@ type Strategy struct {
 	ScaleDownBeforeDDL int32                   `protobuf:"varint,9,opt,name=scaleDownBeforeDDL,proto3" json:"scaleDownBeforeDDL,omitempty"`
 	NodegroupBuffer    map[string]*BufferParam `protobuf:"bytes,10,rep,name=nodegroupBuffer,proto3" json:"nodegroupBuffer,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	TimeMode           *TimeMode               `protobuf:"bytes,11,opt,name=timeMode,proto3" json:"timeMode,omitempty"`
+	Predictive         *Predictive             `protobuf:"bytes,12,opt,name=predictive,proto3" json:"predictive,omitempty"`
 }
 
 func (x *Strategy) Reset() {
@@ -1545,6 +1547,13 @@ func (x *Strategy) GetTimeMode() *TimeMode {
 	return nil
 }
 
+func (x *Strategy) GetPredictive() *Predictive {
+	if x != nil {
+		return x.Predictive
+	}
+	return nil
+}
+
 type Buffer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1663,6 +1672,69 @@ func (x *TimeMode) GetReservedHours() int32 {
 	return 0
 }
 
+type Predictive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LookAheadMinutes int32                `protobuf:"varint,1,opt,name=lookAheadMinutes,proto3" json:"lookAheadMinutes,omitempty"`
+	HistoryDays      int32                `protobuf:"varint,2,opt,name=historyDays,proto3" json:"historyDays,omitempty"`
+	WeeklyWeight     *wrappers.Int32Value `protobuf:"bytes,3,opt,name=weeklyWeight,proto3" json:"weeklyWeight,omitempty"`
+}
+
+func (x *Predictive) Reset() {
+	*x = Predictive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Predictive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Predictive) ProtoMessage() {}
+
+func (x *Predictive) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Predictive.ProtoReflect.Descriptor instead.
+func (*Predictive) Descriptor() ([]byte, []int) {
+	return file_proto_bcs_nodegroup_manager_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Predictive) GetLookAheadMinutes() int32 {
+	if x != nil {
+		return x.LookAheadMinutes
+	}
+	return 0
+}
+
+func (x *Predictive) GetHistoryDays() int32 {
+	if x != nil {
+		return x.HistoryDays
+	}
+	return 0
+}
+
+func (x *Predictive) GetWeeklyWeight() *wrappers.Int32Value {
+	if x != nil {
+		return x.WeeklyWeight
+	}
+	return nil
+}
+
 type TimePeriod struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1677,7 +1749,7 @@ type TimePeriod struct {
 func (x *TimePeriod) Reset() {
 	*x = TimePeriod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[24]
+		mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1690,7 +1762,7 @@ func (x *TimePeriod) String() string {
 func (*TimePeriod) ProtoMessage() {}
 
 func (x *TimePeriod) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[24]
+	mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1703,7 +1775,7 @@ func (x *TimePeriod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimePeriod.ProtoReflect.Descriptor instead.
 func (*TimePeriod) Descriptor() ([]byte, []int) {
-	return file_proto_bcs_nodegroup_manager_proto_rawDescGZIP(), []int{24}
+	return file_proto_bcs_nodegroup_manager_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *TimePeriod) GetScaleOutCron() string {
@@ -1746,7 +1818,7 @@ type BufferParam struct {
 func (x *BufferParam) Reset() {
 	*x = BufferParam{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[25]
+		mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1759,7 +1831,7 @@ func (x *BufferParam) String() string {
 func (*BufferParam) ProtoMessage() {}
 
 func (x *BufferParam) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[25]
+	mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1772,7 +1844,7 @@ func (x *BufferParam) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BufferParam.ProtoReflect.Descriptor instead.
 func (*BufferParam) Descriptor() ([]byte, []int) {
-	return file_proto_bcs_nodegroup_manager_proto_rawDescGZIP(), []int{25}
+	return file_proto_bcs_nodegroup_manager_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *BufferParam) GetPercent() int32 {
@@ -1801,7 +1873,7 @@ type CreateOptions struct {
 func (x *CreateOptions) Reset() {
 	*x = CreateOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[26]
+		mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1814,7 +1886,7 @@ func (x *CreateOptions) String() string {
 func (*CreateOptions) ProtoMessage() {}
 
 func (x *CreateOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[26]
+	mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1827,7 +1899,7 @@ func (x *CreateOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOptions.ProtoReflect.Descriptor instead.
 func (*CreateOptions) Descriptor() ([]byte, []int) {
-	return file_proto_bcs_nodegroup_manager_proto_rawDescGZIP(), []int{26}
+	return file_proto_bcs_nodegroup_manager_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CreateOptions) GetOverWriteIfExist() bool {
@@ -1857,7 +1929,7 @@ type UpdateOptions struct {
 func (x *UpdateOptions) Reset() {
 	*x = UpdateOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[27]
+		mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1870,7 +1942,7 @@ func (x *UpdateOptions) String() string {
 func (*UpdateOptions) ProtoMessage() {}
 
 func (x *UpdateOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[27]
+	mi := &file_proto_bcs_nodegroup_manager_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1883,7 +1955,7 @@ func (x *UpdateOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOptions.ProtoReflect.Descriptor instead.
 func (*UpdateOptions) Descriptor() ([]byte, []int) {
-	return file_proto_bcs_nodegroup_manager_proto_rawDescGZIP(), []int{27}
+	return file_proto_bcs_nodegroup_manager_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateOptions) GetCreateIfNotExist() bool {
@@ -1919,7 +1991,9 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x73, 0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
 	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfc, 0x01, 0x0a, 0x17, 0x43,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfc, 0x01, 0x0a, 0x17, 0x43,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72,
 	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x66, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72,
@@ -1993,10 +2067,10 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x20, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0xe5, 0x93, 0x8d, 0xe5, 0xba, 0x94, 0x22, 0xbf, 0x09,
 	0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x5b, 0x0a, 0x0b, 0x6e,
 	0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x39, 0x92, 0x41, 0x36, 0x2a, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x44, 0x32, 0x27, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x20,
-	0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x49, 0x44, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
-	0x20, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x6e, 0x6f, 0x64,
+	0x42, 0x39, 0x92, 0x41, 0x36, 0x32, 0x27, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x49, 0x44, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2a, 0x0b,
+	0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x52, 0x0b, 0x6e, 0x6f, 0x64,
 	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x54, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x53,
 	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x3a, 0x92, 0x41, 0x37, 0x2a, 0x07,
 	0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x32, 0x2c, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65,
@@ -2022,16 +2096,16 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x65, 0x72, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x65, 0x6e, 0x74,
 	0x65, 0x73, 0x2e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65,
 	0x12, 0xb6, 0x01, 0x0a, 0x0c, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x91, 0x01, 0x92, 0x41, 0x8d, 0x01, 0x2a, 0x0c,
-	0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x32, 0x7d, 0x55, 0x70,
-	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68,
-	0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x69, 0x6e,
-	0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x20, 0x68, 0x6f, 0x77, 0x20, 0x6d, 0x61, 0x6e, 0x79,
-	0x20, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x4b, 0x75,
-	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x68, 0x61, 0x76, 0x65,
-	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20,
-	0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x2e, 0x52, 0x0c, 0x75, 0x70, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x91, 0x01, 0x92, 0x41, 0x8d, 0x01, 0x32, 0x7d,
+	0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x20, 0x69, 0x73, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20,
+	0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x20, 0x68, 0x6f, 0x77, 0x20, 0x6d, 0x61,
+	0x6e, 0x79, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20,
+	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x68, 0x61,
+	0x76, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x2e, 0x2a, 0x0c, 0x75,
+	0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x0c, 0x75, 0x70, 0x63,
 	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x99, 0x01, 0x0a, 0x0c, 0x6e, 0x6f,
 	0x64, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61,
@@ -2055,18 +2129,18 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72,
 	0x6f, 0x75, 0x70, 0x2e, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x90,
 	0x01, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x05, 0x42, 0x6c, 0x92, 0x41, 0x69, 0x2a, 0x0c, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x32, 0x59, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69,
-	0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x69, 0x6e,
-	0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20,
-	0x74, 0x68, 0x61, 0x74, 0x20, 0x61, 0x72, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a,
-	0x65, 0x3a, 0x3c, 0x92, 0x41, 0x39, 0x0a, 0x37, 0x2a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x32, 0x2a, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x69,
-	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x42, 0x6c, 0x92, 0x41, 0x69, 0x32, 0x59, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x20,
+	0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x61, 0x72, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x69, 0x7a, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a,
+	0x65, 0x3a, 0x3c, 0x92, 0x41, 0x39, 0x0a, 0x37, 0x32, 0x2a, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22,
 	0xb6, 0x04, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
 	0x12, 0x4e, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x3c, 0x92,
 	0x41, 0x39, 0x2a, 0x03, 0x63, 0x70, 0x75, 0x32, 0x32, 0x43, 0x50, 0x55, 0x20, 0x69, 0x73, 0x20,
@@ -2074,10 +2148,10 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x20, 0x6f, 0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x75, 0x6e,
 	0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x03, 0x63, 0x70, 0x75,
 	0x12, 0x4f, 0x0a, 0x03, 0x6d, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x3d, 0x92,
-	0x41, 0x3a, 0x2a, 0x03, 0x6d, 0x65, 0x6d, 0x32, 0x33, 0x4d, 0x65, 0x6d, 0x20, 0x69, 0x73, 0x20,
-	0x74, 0x68, 0x65, 0x20, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x20, 0x54, 0x68, 0x65,
-	0x20, 0x75, 0x6e, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x47, 0x69, 0x2e, 0x52, 0x03, 0x6d, 0x65,
+	0x41, 0x3a, 0x32, 0x33, 0x4d, 0x65, 0x6d, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x6f,
+	0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x75, 0x6e, 0x69, 0x74,
+	0x20, 0x69, 0x73, 0x20, 0x47, 0x69, 0x2e, 0x2a, 0x03, 0x6d, 0x65, 0x6d, 0x52, 0x03, 0x6d, 0x65,
 	0x6d, 0x12, 0x3c, 0x0a, 0x03, 0x67, 0x70, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x2a,
 	0x92, 0x41, 0x27, 0x2a, 0x03, 0x67, 0x70, 0x75, 0x32, 0x20, 0x47, 0x50, 0x55, 0x20, 0x69, 0x73,
 	0x20, 0x74, 0x68, 0x65, 0x20, 0x47, 0x50, 0x55, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
@@ -2099,26 +2173,26 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
 	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x3c, 0x92, 0x41, 0x39, 0x0a,
-	0x37, 0x2a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x32,
-	0x27, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x20, 0x69, 0x73,
-	0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x20, 0x6f, 0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xd0, 0x03, 0x0a, 0x09, 0x4e, 0x6f, 0x64,
+	0x37, 0x32, 0x27, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x20,
+	0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x2a, 0x0c, 0x4e, 0x6f, 0x64, 0x65,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0xd0, 0x03, 0x0a, 0x09, 0x4e, 0x6f, 0x64,
 	0x65, 0x54, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x30, 0x92, 0x41, 0x2d, 0x2a, 0x03, 0x6b, 0x65, 0x79, 0x32, 0x26, 0x54,
-	0x68, 0x65, 0x20, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x74, 0x6f, 0x20,
-	0x62, 0x65, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20,
-	0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x51, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x2a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0x2f, 0x54, 0x68, 0x65, 0x20, 0x74, 0x61, 0x69, 0x6e, 0x74,
-	0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x61, 0x69, 0x6e,
-	0x74, 0x20, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x65, 0x0a,
+	0x01, 0x28, 0x09, 0x42, 0x30, 0x92, 0x41, 0x2d, 0x32, 0x26, 0x54, 0x68, 0x65, 0x20, 0x74, 0x61,
+	0x69, 0x6e, 0x74, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
+	0x2a, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x51, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32, 0x2f,
+	0x54, 0x68, 0x65, 0x20, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x20, 0x6b, 0x65, 0x79, 0x2e, 0x2a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x65, 0x0a,
 	0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d, 0x92,
-	0x41, 0x4a, 0x2a, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x32, 0x40, 0x20, 0x54, 0x68, 0x65,
-	0x20, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74,
-	0x61, 0x69, 0x6e, 0x74, 0x20, 0x6f, 0x6e, 0x20, 0x70, 0x6f, 0x64, 0x73, 0x20, 0x74, 0x68, 0x61,
-	0x74, 0x20, 0x64, 0x6f, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x06, 0x65, 0x66,
+	0x41, 0x4a, 0x32, 0x40, 0x20, 0x54, 0x68, 0x65, 0x20, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x20, 0x6f, 0x6e, 0x20,
+	0x70, 0x6f, 0x64, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x64, 0x6f, 0x20, 0x6e, 0x6f, 0x74,
+	0x20, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x61,
+	0x69, 0x6e, 0x74, 0x2e, 0x2a, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x06, 0x65, 0x66,
 	0x66, 0x65, 0x63, 0x74, 0x12, 0x91, 0x01, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x41, 0x64, 0x64,
 	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x73, 0x92, 0x41, 0x70, 0x2a, 0x09, 0x74,
 	0x69, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x65, 0x64, 0x32, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x41, 0x64,
@@ -2153,10 +2227,10 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x83, 0x04, 0x0a, 0x13, 0x4e, 0x6f,
 	0x64, 0x65, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
 	0x79, 0x12, 0x57, 0x0a, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0x92, 0x41, 0x32, 0x2a, 0x0b, 0x6e, 0x6f, 0x64,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x32, 0x23, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x49, 0x44, 0x20,
-	0x6f, 0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0x92, 0x41, 0x32, 0x32, 0x23, 0x4e, 0x6f, 0x64,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x49, 0x44, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2a, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x52, 0x0b, 0x6e,
 	0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x6f, 0x0a, 0x04, 0x74, 0x79,
 	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5b, 0x92, 0x41, 0x58, 0x2a, 0x04, 0x74,
 	0x79, 0x70, 0x65, 0x32, 0x50, 0x54, 0x79, 0x70, 0x65, 0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
@@ -2166,36 +2240,36 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x73, 0x3a, 0x20, 0x5b, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x2c, 0x20, 0x4e, 0x6f, 0x64,
 	0x65, 0x49, 0x50, 0x73, 0x5d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x5d, 0x0a, 0x07, 0x6e,
 	0x6f, 0x64, 0x65, 0x49, 0x50, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x43, 0x92, 0x41,
-	0x40, 0x2a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x50, 0x73, 0x32, 0x35, 0x4e, 0x6f, 0x64, 0x65,
-	0x49, 0x50, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x70, 0x20, 0x6f,
-	0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x73, 0x68, 0x6f,
-	0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x20, 0x64, 0x6f, 0x77,
-	0x6e, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x50, 0x73, 0x12, 0x61, 0x0a, 0x07, 0x6e, 0x6f,
+	0x40, 0x32, 0x35, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x50, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x69, 0x70, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x74,
+	0x68, 0x61, 0x74, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x20, 0x64, 0x6f, 0x77, 0x6e, 0x2a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x50,
+	0x73, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x50, 0x73, 0x12, 0x61, 0x0a, 0x07, 0x6e, 0x6f,
 	0x64, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x47, 0x92, 0x41, 0x44,
 	0x2a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x32, 0x39, 0x4e, 0x6f, 0x64, 0x65, 0x4e,
 	0x75, 0x6d, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
 	0x20, 0x6f, 0x66, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x73,
 	0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x20,
 	0x64, 0x6f, 0x77, 0x6e, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x3a, 0x60, 0x92,
-	0x41, 0x5d, 0x0a, 0x5b, 0x2a, 0x13, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x44,
-	0x6f, 0x77, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x32, 0x44, 0x4e, 0x6f, 0x64, 0x65, 0x53,
-	0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x20, 0x64,
-	0x65, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x64, 0x6f,
-	0x77, 0x6e, 0x20, 0x61, 0x20, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22,
+	0x41, 0x5d, 0x0a, 0x5b, 0x32, 0x44, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x44,
+	0x6f, 0x77, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x64, 0x6f, 0x77, 0x6e, 0x20, 0x61, 0x20,
+	0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2a, 0x13, 0x4e, 0x6f, 0x64, 0x65,
+	0x53, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
 	0xa2, 0x02, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f,
 	0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71,
 	0x12, 0x54, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61,
 	0x67, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x2a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x0e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06,
+	0x73, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x32, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
 	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
 	0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67,
 	0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
 	0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x15, 0x92,
-	0x41, 0x12, 0x2a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x32, 0x06, 0xe7, 0xad,
-	0x96, 0xe7, 0x95, 0xa5, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x3a, 0x54,
+	0x41, 0x12, 0x32, 0x06, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0x2a, 0x08, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x3a, 0x54,
 	0x92, 0x41, 0x51, 0x0a, 0x4f, 0x2a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64,
 	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
 	0x52, 0x65, 0x71, 0x32, 0x1b, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0x8a, 0x82, 0xe7, 0x82,
@@ -2214,12 +2288,12 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
 	0x67, 0x79, 0x42, 0x15, 0x92, 0x41, 0x12, 0x2a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
 	0x79, 0x32, 0x06, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x3a, 0x54, 0x92, 0x41, 0x51, 0x0a, 0x4f, 0x2a, 0x1c, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x32, 0x1b, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0,
-	0xe8, 0x8a, 0x82, 0xe7, 0x82, 0xb9, 0xe6, 0xb1, 0xa0, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe8,
-	0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0xd2, 0x01,
-	0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0x96, 0x02, 0x0a, 0x1c, 0x43, 0x72,
+	0x65, 0x67, 0x79, 0x3a, 0x54, 0x92, 0x41, 0x51, 0x0a, 0x4f, 0x32, 0x1b, 0xe6, 0x9b, 0xb4, 0xe6,
+	0x96, 0xb0, 0xe8, 0x8a, 0x82, 0xe7, 0x82, 0xb9, 0xe6, 0xb1, 0xa0, 0xe7, 0xad, 0x96, 0xe7, 0x95,
+	0xa5, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0xd2, 0x01, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2a, 0x1c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x22, 0x96, 0x02, 0x0a, 0x1c, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53,
 	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f,
 	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63,
@@ -2232,20 +2306,20 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x06, 0x72, 0x65, 0x73,
 	0x75, 0x6c, 0x74, 0x32, 0x12, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
 	0xa6, 0xe6, 0x88, 0x90, 0xe5, 0x8a, 0x9f, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x3a,
-	0x51, 0x92, 0x41, 0x4e, 0x0a, 0x4c, 0x2a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x52, 0x73, 0x70, 0x32, 0x1b, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0x8a, 0x82, 0xe7,
-	0x82, 0xb9, 0xe6, 0xb1, 0xa0, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe5, 0x93, 0x8d, 0xe5, 0xba,
-	0x94, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f,
+	0x51, 0x92, 0x41, 0x4e, 0x0a, 0x4c, 0x32, 0x1b, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0x8a,
+	0x82, 0xe7, 0x82, 0xb9, 0xe6, 0xb1, 0xa0, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe5, 0x93, 0x8d,
+	0xe5, 0xba, 0x94, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
+	0x73, 0x70, 0x22, 0x86, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f,
 	0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71,
 	0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0x92, 0x41, 0x15, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x3b,
-	0x92, 0x41, 0x38, 0x0a, 0x36, 0x2a, 0x19, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f,
-	0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71,
-	0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe8, 0xaf,
-	0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x19,
+	0x92, 0x41, 0x15, 0x32, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x20, 0x6e, 0x61,
+	0x6d, 0x65, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x3b,
+	0x92, 0x41, 0x38, 0x0a, 0x36, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x19, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7,
+	0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x22, 0xa2, 0x02, 0x0a, 0x19,
 	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74,
 	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f,
@@ -2270,8 +2344,8 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x92, 0x41, 0x15, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x0c, 0xe9, 0xa1, 0xb5, 0xe9,
 	0x9d, 0xa2, 0xe5, 0xa4, 0xa7, 0xe5, 0xb0, 0x8f, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x00, 0x52,
 	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x42, 0x18, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x32,
-	0x06, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x00, 0x52, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x18, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe6, 0x95,
+	0xb0, 0x2a, 0x04, 0x70, 0x61, 0x67, 0x65, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x00, 0x52, 0x04,
 	0x70, 0x61, 0x67, 0x65, 0x3a, 0x3b, 0x92, 0x41, 0x38, 0x0a, 0x36, 0x2a, 0x1a, 0x4c, 0x69, 0x73,
 	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61,
 	0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x32, 0x18, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7,
@@ -2282,12 +2356,12 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
 	0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
 	0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32,
-	0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1,
-	0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94,
+	0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x05,
 	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17,
-	0x2a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x32, 0x0e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x52,
+	0x32, 0x0e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0,
+	0x2a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x52,
 	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e,
 	0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e,
 	0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
@@ -2323,11 +2397,11 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x08, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
 	0x74, 0x32, 0x12, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe6,
 	0x88, 0x90, 0xe5, 0x8a, 0x9f, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x3a, 0x51, 0x92,
-	0x41, 0x4e, 0x0a, 0x4c, 0x2a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65,
-	0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
-	0x73, 0x70, 0x32, 0x1b, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0x8a, 0x82, 0xe7, 0x82, 0xb9,
-	0xe6, 0xb1, 0xa0, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe5, 0x93, 0x8d, 0xe5, 0xba, 0x94, 0xd2,
-	0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x41, 0x4e, 0x0a, 0x4c, 0x32, 0x1b, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0x8a, 0x82, 0xe7,
+	0x82, 0xb9, 0xe6, 0xb1, 0xa0, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe5, 0x93, 0x8d, 0xe5, 0xba,
+	0x94, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f,
+	0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x73, 0x70,
 	0x22, 0xb3, 0x05, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74,
 	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x25, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x32,
@@ -2390,8 +2464,8 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x08, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x69, 0x64,
 	0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e,
 	0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c,
-	0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x32, 0x0c,
-	0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x69, 0x64, 0x52, 0x09, 0x6e, 0x6f,
+	0x92, 0x41, 0x19, 0x32, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x69,
+	0x64, 0x2a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x09, 0x6e, 0x6f,
 	0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x75,
 	0x6d, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19,
 	0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x32, 0x0b, 0x63, 0x6f,
@@ -2412,9 +2486,9 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0xbc, 0x80, 0xe5, 0x85, 0xb3, 0x52, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70,
 	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x5b, 0x0a, 0x11, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f,
 	0x75, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x2d, 0x92, 0x41, 0x2a, 0x2a, 0x11, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4e, 0x75, 0x6d, 0x32, 0x15, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52,
+	0x42, 0x2d, 0x92, 0x41, 0x2a, 0x32, 0x15, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x2a, 0x11, 0x6e, 0x6f,
+	0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4e, 0x75, 0x6d, 0x52,
 	0x11, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4e,
 	0x75, 0x6d, 0x12, 0x4b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x6d,
 	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x27, 0x92, 0x41, 0x24, 0x2a, 0x0c, 0x63,
@@ -2426,277 +2500,313 @@ var file_proto_bcs_nodegroup_manager_proto_rawDesc = []byte{
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4e, 0x75, 0x6d, 0x32, 0x13,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0,
 	0xe9, 0x87, 0x8f, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x4e, 0x75, 0x6d, 0x22, 0x80, 0x0c, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x12, 0x52, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x3e, 0x92, 0x41, 0x3b, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x33, 0xe7, 0xb1, 0xbb, 0xe5,
-	0x9e, 0x8b, 0xef, 0xbc, 0x8c, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0xe6, 0x88, 0x96, 0xe8, 0x80,
-	0x85, 0xe5, 0x90, 0x8e, 0xe7, 0xbb, 0xad, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe7, 0x9a, 0x84,
-	0xe5, 0x85, 0xb6, 0xe4, 0xbb, 0x96, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xef, 0xbc, 0x8c, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x73, 0x0a, 0x0f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x55, 0x70,
-	0x43, 0x6f, 0x6f, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x49,
-	0x92, 0x41, 0x46, 0x2a, 0x0f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x55, 0x70, 0x43, 0x6f, 0x6f, 0x6c,
-	0x44, 0x6f, 0x77, 0x6e, 0x32, 0x33, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x4e, 0x6f, 0x64,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0xe6, 0x89, 0xa9, 0xe5, 0xae, 0xb9, 0xe7, 0x9a, 0x84, 0xe5,
-	0x86, 0xb7, 0xe5, 0x8d, 0xb4, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe5, 0x8d,
-	0x95, 0xe4, 0xbd, 0x8d, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x52, 0x0f, 0x73, 0x63, 0x61, 0x6c, 0x65,
-	0x55, 0x70, 0x43, 0x6f, 0x6f, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x6e, 0x0a, 0x0c, 0x73, 0x63,
-	0x61, 0x6c, 0x65, 0x55, 0x70, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x4a, 0x92, 0x41, 0x47, 0x2a, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x55, 0x70, 0x44, 0x65,
-	0x6c, 0x61, 0x79, 0x32, 0x37, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x4e, 0x6f, 0x64, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0xe6, 0x89, 0xa9, 0xe5, 0xae, 0xb9, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a,
-	0xa1, 0xe5, 0xbb, 0xb6, 0xe6, 0x97, 0xb6, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c,
-	0xe5, 0x8d, 0x95, 0xe4, 0xbd, 0x8d, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f, 0x52, 0x0c, 0x73, 0x63,
-	0x61, 0x6c, 0x65, 0x55, 0x70, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x86, 0x01, 0x0a, 0x0e, 0x6d,
-	0x69, 0x6e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x55, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x5e, 0x92, 0x41, 0x5b, 0x2a, 0x0e, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x61,
-	0x6c, 0x65, 0x55, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x32, 0x49, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69,
-	0x63, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0xe6, 0x9c, 0x80, 0xe5, 0xb0, 0x8f,
-	0xe6, 0x89, 0xa9, 0xe5, 0xae, 0xb9, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xef, 0xbc, 0x8c, 0xe4,
-	0xb8, 0xba, 0xe4, 0xbf, 0x9d, 0xe9, 0x9a, 0x9c, 0xe7, 0xa8, 0xb3, 0xe5, 0xae, 0x9a, 0xe5, 0xb9,
-	0xb6, 0xe4, 0xb8, 0x8d, 0xe6, 0x98, 0xaf, 0xe9, 0x9a, 0x8f, 0xe6, 0x84, 0x8f, 0xe6, 0x89, 0xa9,
-	0xe5, 0xae, 0xb9, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x55, 0x70, 0x53,
-	0x69, 0x7a, 0x65, 0x12, 0xa7, 0x01, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77,
-	0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x7f, 0x92, 0x41,
-	0x7c, 0x2a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x44, 0x65, 0x6c, 0x61,
-	0x79, 0x32, 0x6a, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0xe7, 0xbc, 0xa9, 0xe5, 0xae, 0xb9, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe6,
-	0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0xe5, 0xbb, 0xb6, 0xe8, 0xbf, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
-	0xb4, 0xef, 0xbc, 0x8c, 0xe5, 0x8d, 0x95, 0xe4, 0xbd, 0x8d, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f,
-	0xef, 0xbc, 0x8c, 0xe5, 0xa6, 0x82, 0xe6, 0x9e, 0x9c, 0xe8, 0xb6, 0x85, 0xe6, 0x9c, 0x9f, 0xe6,
-	0x9c, 0xaa, 0xe5, 0xae, 0x8c, 0xe6, 0x88, 0x90, 0xe5, 0x88, 0x99, 0xe5, 0x8f, 0xaf, 0xe4, 0xbb,
-	0xa5, 0xe9, 0x87, 0x8d, 0xe6, 0x96, 0xb0, 0xe5, 0x8f, 0x91, 0xe8, 0xb5, 0xb7, 0x52, 0x0e, 0x73,
-	0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0xac, 0x01,
-	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x87, 0x01, 0x92, 0x41, 0x83, 0x01, 0x2a, 0x0c, 0x6d, 0x61, 0x78,
-	0x49, 0x64, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x32, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0xe7, 0xa9, 0xba,
-	0xe9, 0x97, 0xb2, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe5, 0xaf, 0xb9, 0xe4,
-	0xba, 0x8e, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0xe8, 0x80, 0x8c, 0xe8, 0xa8, 0x80, 0xe5, 0xb0, 0xb1, 0xe6, 0x98, 0xaf, 0xe4, 0xb8,
-	0x8b, 0xe9, 0x99, 0x8d, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xe5, 0x9c, 0xa8, 0xe9, 0xa2, 0x84,
-	0xe6, 0x9c, 0x9f, 0xe5, 0x86, 0x85, 0xe7, 0x9a, 0x84, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef,
-	0xbc, 0x8c, 0xe5, 0x8d, 0x95, 0xe4, 0xbd, 0x8d, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f, 0x52, 0x0c,
-	0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0xa0, 0x01, 0x0a,
-	0x11, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x72, 0x92, 0x41, 0x6f, 0x2a, 0x11, 0x72,
+	0x74, 0x4e, 0x75, 0x6d, 0x22, 0x81, 0x0d, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x12, 0x53, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3f, 0x92, 0x41, 0x3c, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x34, 0xe7, 0xb1, 0xbb, 0xe5,
+	0x9e, 0x8b, 0xef, 0xbc, 0x8c, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0xe3, 0x80, 0x81, 0x68, 0x69,
+	0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0xe6, 0x88, 0x96, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x76, 0x65, 0xef, 0xbc, 0x8c,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x73, 0x0a, 0x0f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x55,
+	0x70, 0x43, 0x6f, 0x6f, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x49, 0x92, 0x41, 0x46, 0x2a, 0x0f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x55, 0x70, 0x43, 0x6f, 0x6f,
+	0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x32, 0x33, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x4e, 0x6f,
+	0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0xe6, 0x89, 0xa9, 0xe5, 0xae, 0xb9, 0xe7, 0x9a, 0x84,
+	0xe5, 0x86, 0xb7, 0xe5, 0x8d, 0xb4, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe5,
+	0x8d, 0x95, 0xe4, 0xbd, 0x8d, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x52, 0x0f, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x55, 0x70, 0x43, 0x6f, 0x6f, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x6e, 0x0a, 0x0c, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x55, 0x70, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x4a, 0x92, 0x41, 0x47, 0x2a, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x55, 0x70, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x32, 0x37, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x4e, 0x6f, 0x64,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0xe6, 0x89, 0xa9, 0xe5, 0xae, 0xb9, 0xe4, 0xbb, 0xbb, 0xe5,
+	0x8a, 0xa1, 0xe5, 0xbb, 0xb6, 0xe6, 0x97, 0xb6, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc,
+	0x8c, 0xe5, 0x8d, 0x95, 0xe4, 0xbd, 0x8d, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f, 0x52, 0x0c, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x55, 0x70, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x86, 0x01, 0x0a, 0x0e,
+	0x6d, 0x69, 0x6e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x55, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x5e, 0x92, 0x41, 0x5b, 0x2a, 0x0e, 0x6d, 0x69, 0x6e, 0x53, 0x63,
+	0x61, 0x6c, 0x65, 0x55, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x32, 0x49, 0x65, 0x6c, 0x61, 0x73, 0x74,
+	0x69, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0xe6, 0x9c, 0x80, 0xe5, 0xb0,
+	0x8f, 0xe6, 0x89, 0xa9, 0xe5, 0xae, 0xb9, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xef, 0xbc, 0x8c,
+	0xe4, 0xb8, 0xba, 0xe4, 0xbf, 0x9d, 0xe9, 0x9a, 0x9c, 0xe7, 0xa8, 0xb3, 0xe5, 0xae, 0x9a, 0xe5,
+	0xb9, 0xb6, 0xe4, 0xb8, 0x8d, 0xe6, 0x98, 0xaf, 0xe9, 0x9a, 0x8f, 0xe6, 0x84, 0x8f, 0xe6, 0x89,
+	0xa9, 0xe5, 0xae, 0xb9, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x55, 0x70,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0xa7, 0x01, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f,
+	0x77, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x7f, 0x92,
+	0x41, 0x7c, 0x2a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x32, 0x6a, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0xe7, 0xbc, 0xa9, 0xe5, 0xae, 0xb9, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1,
+	0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0xe5, 0xbb, 0xb6, 0xe8, 0xbf, 0x9f, 0xe6, 0x97, 0xb6, 0xe9,
+	0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe5, 0x8d, 0x95, 0xe4, 0xbd, 0x8d, 0xe5, 0x88, 0x86, 0xe9, 0x92,
+	0x9f, 0xef, 0xbc, 0x8c, 0xe5, 0xa6, 0x82, 0xe6, 0x9e, 0x9c, 0xe8, 0xb6, 0x85, 0xe6, 0x9c, 0x9f,
+	0xe6, 0x9c, 0xaa, 0xe5, 0xae, 0x8c, 0xe6, 0x88, 0x90, 0xe5, 0x88, 0x99, 0xe5, 0x8f, 0xaf, 0xe4,
+	0xbb, 0xa5, 0xe9, 0x87, 0x8d, 0xe6, 0x96, 0xb0, 0xe5, 0x8f, 0x91, 0xe8, 0xb5, 0xb7, 0x52, 0x0e,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0xac,
+	0x01, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x87, 0x01, 0x92, 0x41, 0x83, 0x01, 0x32, 0x73, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7,
+	0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb2, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe5,
+	0xaf, 0xb9, 0xe4, 0xba, 0x8e, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x4e, 0x6f, 0x64, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0xe8, 0x80, 0x8c, 0xe8, 0xa8, 0x80, 0xe5, 0xb0, 0xb1, 0xe6, 0x98,
+	0xaf, 0xe4, 0xb8, 0x8b, 0xe9, 0x99, 0x8d, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xe5, 0x9c, 0xa8,
+	0xe9, 0xa2, 0x84, 0xe6, 0x9c, 0x9f, 0xe5, 0x86, 0x85, 0xe7, 0x9a, 0x84, 0xe6, 0x97, 0xb6, 0xe9,
+	0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe5, 0x8d, 0x95, 0xe4, 0xbd, 0x8d, 0xe5, 0x88, 0x86, 0xe9, 0x92,
+	0x9f, 0x2a, 0x0c, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x52,
+	0x0c, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0xa0, 0x01,
+	0x0a, 0x11, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x72, 0x92, 0x41, 0x6f, 0x2a, 0x11,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x32, 0x5a, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6,
+	0xae, 0xb5, 0xef, 0xbc, 0x8c, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe4, 0xbf, 0x9d, 0xe6, 0x8a,
+	0xa4, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0xe7, 0xa8,
+	0xb3, 0xe5, 0xae, 0x9a, 0xef, 0xbc, 0x8c, 0xe5, 0x87, 0x86, 0xe5, 0xa4, 0x87, 0xe7, 0xbb, 0x99,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0xe9, 0xa2, 0x84, 0xe7, 0x95, 0x99, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x52, 0x11, 0x72,
 	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x32, 0x5a, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0xae,
-	0xb5, 0xef, 0xbc, 0x8c, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe4, 0xbf, 0x9d, 0xe6, 0x8a, 0xa4,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0xe7, 0xa8, 0xb3,
-	0xe5, 0xae, 0x9a, 0xef, 0xbc, 0x8c, 0xe5, 0x87, 0x86, 0xe5, 0xa4, 0x87, 0xe7, 0xbb, 0x99, 0x72,
-	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0xe9, 0xa2, 0x84, 0xe7, 0x95, 0x99, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x52, 0x11, 0x72, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x57, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x42, 0x25, 0x92, 0x41, 0x22, 0x2a, 0x06,
-	0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x32, 0x18, 0xe9, 0xa2, 0x84, 0xe7, 0x95, 0x99, 0xe6, 0xb0,
-	0xb4, 0xe4, 0xbd, 0x8d, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89,
-	0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x85, 0x01, 0x0a, 0x12, 0x73, 0x63, 0x61,
-	0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x44, 0x44, 0x4c, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x05, 0x42, 0x55, 0x92, 0x41, 0x52, 0x2a, 0x12, 0x73, 0x63, 0x61, 0x6c,
-	0x65, 0x44, 0x6f, 0x77, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x44, 0x44, 0x4c, 0x32, 0x3c,
-	0xe5, 0x9c, 0xa8, 0x64, 0x64, 0x6c, 0xe5, 0xa4, 0x9a, 0xe5, 0xb0, 0x91, 0xe5, 0x88, 0x86, 0xe9,
-	0x92, 0x9f, 0xe4, 0xb9, 0x8b, 0xe5, 0x89, 0x8d, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe7, 0xad,
-	0x96, 0xe7, 0x95, 0xa5, 0xef, 0xbc, 0x8c, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe5, 0x88, 0x86,
-	0xe7, 0xba, 0xa7, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe6, 0xb1, 0xa0, 0x52, 0x12, 0x73, 0x63,
+	0x12, 0x57, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x42, 0x25, 0x92, 0x41, 0x22, 0x2a,
+	0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x32, 0x18, 0xe9, 0xa2, 0x84, 0xe7, 0x95, 0x99, 0xe6,
+	0xb0, 0xb4, 0xe4, 0xbd, 0x8d, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe5, 0xae, 0x9a, 0xe4, 0xb9,
+	0x89, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x85, 0x01, 0x0a, 0x12, 0x73, 0x63,
 	0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x44, 0x44, 0x4c,
-	0x12, 0x8a, 0x01, 0x0a, 0x0f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x75,
-	0x66, 0x66, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6e, 0x6f, 0x64,
-	0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x2f, 0x92, 0x41, 0x2c,
-	0x2a, 0x0f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x75, 0x66, 0x66, 0x65,
-	0x72, 0x32, 0x19, 0xe5, 0x90, 0x84, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20,
-	0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0x52, 0x0f, 0x6e, 0x6f,
-	0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x65, 0x0a,
-	0x08, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x2d, 0x92, 0x41, 0x2a,
-	0x2a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x32, 0x1e, 0xe6, 0x97, 0xb6, 0xe9,
-	0x97, 0xb4, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xef, 0xbc, 0x8c, 0xe6, 0x89, 0xa9, 0xe7, 0xbc,
-	0xa9, 0xe5, 0xae, 0xb9, 0xe5, 0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
-	0x4d, 0x6f, 0x64, 0x65, 0x1a, 0x61, 0x0a, 0x14, 0x4e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xcd, 0x01, 0x0a, 0x06, 0x42, 0x75, 0x66, 0x66,
-	0x65, 0x72, 0x12, 0x71, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x5f, 0x92, 0x41, 0x5c, 0x2a, 0x03, 0x6c, 0x6f, 0x77, 0x32, 0x55, 0xe5, 0xae, 0x9a, 0xe4, 0xb9,
-	0x89, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0xe4, 0xb8,
-	0xad, 0xe5, 0x8f, 0xaf, 0xe5, 0xae, 0xb9, 0xe5, 0xbf, 0x8d, 0xe7, 0x9a, 0x84, 0xe6, 0x9c, 0x80,
-	0xe4, 0xbd, 0x8e, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb2, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe6,
-	0xb0, 0xb4, 0xe4, 0xbd, 0x8d, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xbb, 0xe8, 0xa6, 0x81, 0xe7, 0x94,
-	0xa8, 0xe4, 0xba, 0x8e, 0xe9, 0xa2, 0x84, 0xe8, 0xad, 0xa6, 0xe7, 0x94, 0xa8, 0xe9, 0x80, 0x94,
-	0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x50, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x2a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x32, 0x31,
-	0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x70,
-	0x6f, 0x6f, 0x6c, 0xe4, 0xb8, 0xad, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb2, 0xe8, 0xb5, 0x84, 0xe6,
-	0xba, 0x90, 0xe7, 0x9a, 0x84, 0xe6, 0x9c, 0x80, 0xe9, 0xab, 0x98, 0xe6, 0xb0, 0xb4, 0xe4, 0xbd,
-	0x8d, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x22, 0x98, 0x02, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65,
-	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77,
-	0x6e, 0x57, 0x68, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x2d, 0x92, 0x41, 0x2a, 0x2a, 0x14, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f,
-	0x77, 0x6e, 0x57, 0x68, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x32, 0x12, 0xe5,
-	0x88, 0xb0, 0xe6, 0x9c, 0x9f, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0xbc, 0xa9, 0xe5, 0xae,
-	0xb9, 0x52, 0x14, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x57, 0x68, 0x65, 0x6e,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x61, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x50,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e,
-	0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x2a,
-	0x0b, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x32, 0x0f, 0xe6, 0x89,
-	0xa9, 0xe7, 0xbc, 0xa9, 0xe5, 0xae, 0xb9, 0xe5, 0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0x52, 0x0b, 0x74,
-	0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x0d, 0x72, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
-	0x48, 0x6f, 0x75, 0x72, 0x73, 0x32, 0x0c, 0xe4, 0xbf, 0x9d, 0xe7, 0x95, 0x99, 0xe6, 0x97, 0xb6,
-	0xe9, 0x95, 0xbf, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x48, 0x6f, 0x75,
-	0x72, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x12, 0x43, 0x0a, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x43, 0x72, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x73, 0x63,
-	0x61, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x43, 0x72, 0x6f, 0x6e, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5,
-	0xae, 0xb9, 0xe5, 0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0x52, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x4f,
-	0x75, 0x74, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x49,
-	0x6e, 0x43, 0x72, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x1b,
-	0x2a, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x49, 0x6e, 0x43, 0x72, 0x6f, 0x6e, 0x32, 0x0c, 0xe7,
-	0xbc, 0xa9, 0xe5, 0xae, 0xb9, 0xe5, 0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0x52, 0x0b, 0x73, 0x63, 0x61,
-	0x6c, 0x65, 0x49, 0x6e, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0c, 0x73, 0x63, 0x61, 0x6c,
-	0x65, 0x4f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f,
-	0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xae, 0xb9, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52,
-	0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a,
-	0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x2a, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x49, 0x6e,
-	0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe7, 0xbc, 0xa9, 0xe5, 0xae, 0xb9, 0xe6, 0x97, 0xb6, 0xe9,
-	0x97, 0xb4, 0x52, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22,
-	0x6a, 0x0a, 0x0b, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x31,
-	0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x17, 0x92, 0x41, 0x14, 0x2a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x32, 0x09, 0xe7,
-	0x99, 0xbe, 0xe5, 0x88, 0x86, 0xe6, 0xaf, 0x94, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x12, 0x28, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x12, 0x92, 0x41, 0x0f, 0x2a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x06, 0xe6, 0x95,
-	0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x0d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4c, 0x0a,
-	0x10, 0x6f, 0x76, 0x65, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x66, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x10, 0x6f, 0x76,
-	0x65, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x32, 0x09,
-	0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8, 0xbf, 0x94, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x49, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92,
-	0x41, 0x15, 0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x32, 0x09, 0xe6, 0x93,
-	0x8d, 0xe4, 0xbd, 0x9c, 0xe4, 0xba, 0xba, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x22, 0xa0, 0x02, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x5e, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x66, 0x4e,
-	0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x32, 0x92,
-	0x41, 0x2f, 0x2a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x32, 0x1b, 0xe5, 0xa6, 0x82, 0xe6, 0x9e, 0x9c, 0xe4, 0xb8, 0x8d, 0xe5,
-	0xad, 0x98, 0xe5, 0x9c, 0xa8, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x88, 0x9b, 0xe5, 0xbb,
-	0xba, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x12, 0x79, 0x0a, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x5a, 0x65, 0x72, 0x6f, 0x4f, 0x72, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x74, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x3f, 0x92, 0x41, 0x3c, 0x2a, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x77,
-	0x72, 0x69, 0x74, 0x65, 0x5a, 0x65, 0x72, 0x6f, 0x4f, 0x72, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53,
-	0x74, 0x72, 0x32, 0x21, 0xe9, 0x9b, 0xb6, 0xe5, 0x80, 0xbc, 0xe6, 0x88, 0x96, 0xe7, 0xa9, 0xba,
-	0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0xe4, 0xb8, 0xb2, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8,
-	0xa6, 0x86, 0xe7, 0x9b, 0x96, 0x52, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x5a, 0x65, 0x72, 0x6f, 0x4f, 0x72, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x74, 0x72, 0x12, 0x34,
-	0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x32,
-	0x09, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe4, 0xba, 0xba, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x32, 0x8b, 0x0b, 0x0a, 0x10, 0x4e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0xea, 0x01, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c,
-	0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x29, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x1a, 0x29, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75,
-	0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x76,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x26, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74,
-	0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01,
-	0x2a, 0x92, 0x41, 0x42, 0x12, 0x1f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x20, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x20, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0x1f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x20, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x20,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0xe7, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x12, 0x2e, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x52, 0x73, 0x70, 0x22, 0x6a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x26, 0x2f, 0x6e,
-	0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f,
-	0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x36, 0x12, 0x19, 0xe5, 0x88, 0x9b, 0xe5,
-	0xbb, 0xba, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x1a, 0x19, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0x6e, 0x6f, 0x64,
-	0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x12, 0xe7, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50,
-	0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2e,
-	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c,
-	0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x2e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x42, 0x55, 0x92, 0x41, 0x52, 0x2a, 0x12, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x44, 0x44, 0x4c, 0x32,
+	0x3c, 0xe5, 0x9c, 0xa8, 0x64, 0x64, 0x6c, 0xe5, 0xa4, 0x9a, 0xe5, 0xb0, 0x91, 0xe5, 0x88, 0x86,
+	0xe9, 0x92, 0x9f, 0xe4, 0xb9, 0x8b, 0xe5, 0x89, 0x8d, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe7,
+	0xad, 0x96, 0xe7, 0x95, 0xa5, 0xef, 0xbc, 0x8c, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe5, 0x88,
+	0x86, 0xe7, 0xba, 0xa7, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe6, 0xb1, 0xa0, 0x52, 0x12, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x44, 0x44,
+	0x4c, 0x12, 0x8a, 0x01, 0x0a, 0x0f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6e, 0x6f,
+	0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x2f, 0x92, 0x41,
+	0x2c, 0x32, 0x19, 0xe5, 0x90, 0x84, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20,
+	0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0x2a, 0x0f, 0x6e, 0x6f,
+	0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x0f, 0x6e,
+	0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x65,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x2d, 0x92, 0x41,
+	0x2a, 0x2a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x32, 0x1e, 0xe6, 0x97, 0xb6,
+	0xe9, 0x97, 0xb4, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xef, 0xbc, 0x8c, 0xe6, 0x89, 0xa9, 0xe7,
+	0xbc, 0xa9, 0xe5, 0xae, 0xb9, 0xe5, 0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x7e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x65,
+	0x64, 0x69, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x40, 0x92, 0x41, 0x3d, 0x2a, 0x0a, 0x70, 0x72,
+	0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x76, 0x65, 0x32, 0x2f, 0xe9, 0xa2, 0x84, 0xe6, 0xb5, 0x8b,
+	0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0xef, 0xbc, 0x8c, 0x74,
+	0x79, 0x70, 0x65, 0xe4, 0xb8, 0xba, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0xe6, 0x97, 0xb6, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x1a, 0x61, 0x0a, 0x14, 0x4e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
 	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c,
-	0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x73, 0x70, 0x22, 0x6a,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x1a, 0x26, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x64,
-	0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x3a, 0x01,
-	0x2a, 0x92, 0x41, 0x36, 0x12, 0x19, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0x6e, 0x6f, 0x64, 0x65,
-	0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x1a,
-	0x19, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x20, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0xdb, 0x01, 0x0a, 0x16, 0x47,
-	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2b, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x72, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xcd, 0x01, 0x0a, 0x06, 0x42, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x12, 0x71, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x5f, 0x92, 0x41, 0x5c, 0x32, 0x55, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0xe4, 0xb8, 0xad, 0xe5, 0x8f, 0xaf,
+	0xe5, 0xae, 0xb9, 0xe5, 0xbf, 0x8d, 0xe7, 0x9a, 0x84, 0xe6, 0x9c, 0x80, 0xe4, 0xbd, 0x8e, 0xe7,
+	0xa9, 0xba, 0xe9, 0x97, 0xb2, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe6, 0xb0, 0xb4, 0xe4, 0xbd,
+	0x8d, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xbb, 0xe8, 0xa6, 0x81, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e,
+	0xe9, 0xa2, 0x84, 0xe8, 0xad, 0xa6, 0xe7, 0x94, 0xa8, 0xe9, 0x80, 0x94, 0x2a, 0x03, 0x6c, 0x6f,
+	0x77, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x50, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x2a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x32,
+	0x31, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20,
+	0x70, 0x6f, 0x6f, 0x6c, 0xe4, 0xb8, 0xad, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb2, 0xe8, 0xb5, 0x84,
+	0xe6, 0xba, 0x90, 0xe7, 0x9a, 0x84, 0xe6, 0x9c, 0x80, 0xe9, 0xab, 0x98, 0xe6, 0xb0, 0xb4, 0xe4,
+	0xbd, 0x8d, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x22, 0x98, 0x02, 0x0a, 0x08, 0x54, 0x69, 0x6d,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f,
+	0x77, 0x6e, 0x57, 0x68, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x2d, 0x92, 0x41, 0x2a, 0x2a, 0x14, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x44,
+	0x6f, 0x77, 0x6e, 0x57, 0x68, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x32, 0x12,
+	0xe5, 0x88, 0xb0, 0xe6, 0x9c, 0x9f, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0xbc, 0xa9, 0xe5,
+	0xae, 0xb9, 0x52, 0x14, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x57, 0x68, 0x65,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x61, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x21, 0x92, 0x41, 0x1e,
+	0x2a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x32, 0x0f, 0xe6,
+	0x89, 0xa9, 0xe7, 0xbc, 0xa9, 0xe5, 0xae, 0xb9, 0xe5, 0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0x52, 0x0b,
+	0x74, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x0d, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x0c, 0xe4, 0xbf, 0x9d, 0xe7, 0x95, 0x99, 0xe6,
+	0x97, 0xb6, 0xe9, 0x95, 0xbf, 0x2a, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x48, 0x6f,
+	0x75, 0x72, 0x73, 0x22, 0xba, 0x03, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x10, 0x6c, 0x6f, 0x6f, 0x6b, 0x41, 0x68, 0x65, 0x61, 0x64,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x65, 0x92,
+	0x41, 0x62, 0x32, 0x4e, 0xe9, 0xa2, 0x84, 0xe6, 0xb5, 0x8b, 0xe7, 0xaa, 0x97, 0xe5, 0x8f, 0xa3,
+	0xef, 0xbc, 0x8c, 0xe5, 0x8d, 0x95, 0xe4, 0xbd, 0x8d, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f, 0xef,
+	0xbc, 0x8c, 0xe9, 0x9c, 0x80, 0xe8, 0xa6, 0x86, 0xe7, 0x9b, 0x96, 0x65, 0x6c, 0x61, 0x73, 0x74,
+	0x69, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0xe7, 0xbc, 0xa9, 0xe5, 0xae,
+	0xb9, 0xe8, 0x80, 0x97, 0xe6, 0x97, 0xb6, 0xef, 0xbc, 0x8c, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4,
+	0x36, 0x30, 0x2a, 0x10, 0x6c, 0x6f, 0x6f, 0x6b, 0x41, 0x68, 0x65, 0x61, 0x64, 0x4d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x52, 0x10, 0x6c, 0x6f, 0x6f, 0x6b, 0x41, 0x68, 0x65, 0x61, 0x64, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x0b, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x44, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x38, 0x92, 0x41, 0x35,
+	0x32, 0x26, 0xe5, 0x8f, 0x82, 0xe4, 0xb8, 0x8e, 0xe9, 0xa2, 0x84, 0xe6, 0xb5, 0x8b, 0xe7, 0x9a,
+	0x84, 0xe5, 0x8e, 0x86, 0xe5, 0x8f, 0xb2, 0xe5, 0xa4, 0xa9, 0xe6, 0x95, 0xb0, 0xef, 0xbc, 0x8c,
+	0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x31, 0x34, 0x2a, 0x0b, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x44, 0x61, 0x79, 0x73, 0x52, 0x0b, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61,
+	0x79, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x0c, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x7a, 0x92, 0x41, 0x77, 0x32, 0x67, 0xe5, 0x91, 0xa8,
+	0xe5, 0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0xe5, 0x9c, 0xa8, 0xe9, 0xa2, 0x84, 0xe6, 0xb5, 0x8b, 0xe4,
+	0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe6, 0x9d, 0x83, 0xe9, 0x87, 0x8d, 0xe7, 0x99, 0xbe, 0xe5, 0x88,
+	0x86, 0xe6, 0xaf, 0x94, 0xef, 0xbc, 0x8c, 0xe5, 0x8f, 0x96, 0xe5, 0x80, 0xbc, 0x30, 0x2d, 0x31,
+	0x30, 0x30, 0xef, 0xbc, 0x8c, 0xe5, 0x85, 0xb6, 0xe4, 0xbd, 0x99, 0xe4, 0xb8, 0xba, 0xe6, 0x97,
+	0xa5, 0xe5, 0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0xe6, 0x9d, 0x83, 0xe9, 0x87, 0x8d, 0xef, 0xbc, 0x8c,
+	0xe4, 0xb8, 0x8d, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe6, 0x97, 0xb6, 0xe9, 0xbb, 0x98, 0xe8,
+	0xae, 0xa4, 0x35, 0x30, 0x2a, 0x0c, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x52, 0x0c, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x9a, 0x02, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x43, 0x0a, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x43, 0x72, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x4f, 0x75, 0x74, 0x43, 0x72, 0x6f, 0x6e, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xae, 0xb9,
+	0xe5, 0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0x52, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x4f, 0x75, 0x74,
+	0x43, 0x72, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x49, 0x6e, 0x43,
+	0x72, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x2a, 0x0b,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x49, 0x6e, 0x43, 0x72, 0x6f, 0x6e, 0x32, 0x0c, 0xe7, 0xbc, 0xa9,
+	0xe5, 0xae, 0xb9, 0xe5, 0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0x52, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x49, 0x6e, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x4f,
+	0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41,
+	0x1c, 0x2a, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x32,
+	0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xae, 0xb9, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0c, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1e, 0x92, 0x41, 0x1b, 0x2a, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x49, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x32, 0x0c, 0xe7, 0xbc, 0xa9, 0xe5, 0xae, 0xb9, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0x52, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x6a, 0x0a,
+	0x0b, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x31, 0x0a, 0x07,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x17, 0x92,
+	0x41, 0x14, 0x2a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x32, 0x09, 0xe7, 0x99, 0xbe,
+	0xe5, 0x88, 0x86, 0xe6, 0xaf, 0x94, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x28, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x12,
+	0x92, 0x41, 0x0f, 0x2a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe9,
+	0x87, 0x8f, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x0d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4c, 0x0a, 0x10, 0x6f,
+	0x76, 0x65, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x10, 0x6f, 0x76, 0x65, 0x72,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x32, 0x09, 0xe6, 0x98,
+	0xaf, 0xe5, 0x90, 0xa6, 0xe8, 0xbf, 0x94, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x49, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15,
+	0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x32, 0x09, 0xe6, 0x93, 0x8d, 0xe4,
+	0xbd, 0x9c, 0xe4, 0xba, 0xba, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22,
+	0xa0, 0x02, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x5e, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x66, 0x4e, 0x6f, 0x74,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x32, 0x92, 0x41, 0x2f,
+	0x32, 0x1b, 0xe5, 0xa6, 0x82, 0xe6, 0x9e, 0x9c, 0xe4, 0xb8, 0x8d, 0xe5, 0xad, 0x98, 0xe5, 0x9c,
+	0xa8, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0x2a, 0x10, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52,
+	0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x12, 0x79, 0x0a, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5a, 0x65,
+	0x72, 0x6f, 0x4f, 0x72, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x3f, 0x92, 0x41, 0x3c, 0x2a, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x5a, 0x65, 0x72, 0x6f, 0x4f, 0x72, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x74, 0x72,
+	0x32, 0x21, 0xe9, 0x9b, 0xb6, 0xe5, 0x80, 0xbc, 0xe6, 0x88, 0x96, 0xe7, 0xa9, 0xba, 0xe5, 0xad,
+	0x97, 0xe7, 0xac, 0xa6, 0xe4, 0xb8, 0xb2, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8, 0xa6, 0x86,
+	0xe7, 0x9b, 0x96, 0x52, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5a, 0x65,
+	0x72, 0x6f, 0x4f, 0x72, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x74, 0x72, 0x12, 0x34, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0x92, 0x41, 0x15, 0x32, 0x09, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe4, 0xba, 0xba, 0x2a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x32, 0x8b, 0x0b, 0x0a, 0x10, 0x4e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0xea, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x29, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x1a, 0x29, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x6f,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x76, 0x92, 0x41,
+	0x42, 0x1a, 0x1f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x20, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x20, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x1f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x20, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x20, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x26, 0x2f, 0x6e, 0x6f, 0x64, 0x65,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x3a, 0x01, 0x2a, 0x12, 0xe7, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x12, 0x2e, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
+	0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65,
 	0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
-	0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f,
-	0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x73, 0x70, 0x22,
-	0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f,
-	0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x92,
+	0x73, 0x70, 0x22, 0x6a, 0x92, 0x41, 0x36, 0x12, 0x19, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0x6e,
+	0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x1a, 0x19, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x20, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2b, 0x22, 0x26, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0xe7,
+	0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f,
+	0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2e, 0x2e, 0x6e,
+	0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67,
+	0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6e,
+	0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67,
+	0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x73, 0x70, 0x22, 0x6a, 0x92, 0x41,
+	0x36, 0x1a, 0x19, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x20, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x19, 0xe6, 0x9b,
+	0xb4, 0xe6, 0x96, 0xb0, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a,
+	0x1a, 0x26, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0xdb, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x2b, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f,
+	0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71,
+	0x1a, 0x2b, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d,
+	0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x73, 0x70, 0x22, 0x67, 0x92,
 	0x41, 0x36, 0x12, 0x19, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67,
 	0x72, 0x6f, 0x75, 0x70, 0x20, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x1a, 0x19, 0xe6,
 	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0xf0, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f,
-	0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26,
+	0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0xf0, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70,
 	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65,
 	0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
-	0x73, 0x70, 0x22, 0x77, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6e, 0x6f, 0x64,
-	0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31,
-	0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x67, 0x69, 0x65, 0x73, 0x92, 0x41, 0x44, 0x12, 0x20, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6e,
+	0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f,
+	0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x73, 0x70,
+	0x22, 0x77, 0x92, 0x41, 0x44, 0x1a, 0x20, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6e, 0x6f, 0x64,
+	0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x20, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x12, 0x20, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6e,
 	0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x67, 0x79, 0x20, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x1a, 0x20, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x20, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x12, 0xe4, 0x01, 0x0a, 0x19,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67,
-	0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2e, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x73, 0x70, 0x22, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x28, 0x2a, 0x26, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x92, 0x41, 0x36, 0x12, 0x19, 0xe5, 0x88,
+	0x67, 0x79, 0x20, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12,
+	0x28, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x12, 0xe4, 0x01, 0x0a, 0x19, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2e, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x67, 0x72, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x52, 0x73, 0x70, 0x22, 0x67, 0x92, 0x41, 0x36, 0x12, 0x19, 0xe5, 0x88,
 	0xa0, 0xe9, 0x99, 0xa4, 0x6e, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x73,
 	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x1a, 0x19, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0x6e,
 	0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x67, 0x79, 0x42, 0x1a, 0x5a, 0x18, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x6e, 0x6f,
-	0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x2a, 0x26, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6e,
+	0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x42, 0x1a, 0x5a, 0x18, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x6e, 0x6f, 0x64, 0x65,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2711,7 +2821,7 @@ func file_proto_bcs_nodegroup_manager_proto_rawDescGZIP() []byte {
 	return file_proto_bcs_nodegroup_manager_proto_rawDescData
 }
 
-var file_proto_bcs_nodegroup_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_proto_bcs_nodegroup_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_proto_bcs_nodegroup_manager_proto_goTypes = []interface{}{
 	(*ClusterAutoscalerReview)(nil),      // 0: nodegroupmanager.ClusterAutoscalerReview
 	(*AutoscalerReviewRequest)(nil),      // 1: nodegroupmanager.AutoscalerReviewRequest
@@ -2737,58 +2847,62 @@ var file_proto_bcs_nodegroup_manager_proto_goTypes = []interface{}{
 	(*Strategy)(nil),                     // 21: nodegroupmanager.Strategy
 	(*Buffer)(nil),                       // 22: nodegroupmanager.Buffer
 	(*TimeMode)(nil),                     // 23: nodegroupmanager.TimeMode
-	(*TimePeriod)(nil),                   // 24: nodegroupmanager.TimePeriod
-	(*BufferParam)(nil),                  // 25: nodegroupmanager.BufferParam
-	(*CreateOptions)(nil),                // 26: nodegroupmanager.CreateOptions
-	(*UpdateOptions)(nil),                // 27: nodegroupmanager.UpdateOptions
-	nil,                                  // 28: nodegroupmanager.AutoscalerReviewRequest.NodeGroupsEntry
-	nil,                                  // 29: nodegroupmanager.NodeTemplate.LabelsEntry
-	nil,                                  // 30: nodegroupmanager.NodeGroupStrategy.LabelsEntry
-	nil,                                  // 31: nodegroupmanager.Strategy.NodegroupBufferEntry
+	(*Predictive)(nil),                   // 24: nodegroupmanager.Predictive
+	(*TimePeriod)(nil),                   // 25: nodegroupmanager.TimePeriod
+	(*BufferParam)(nil),                  // 26: nodegroupmanager.BufferParam
+	(*CreateOptions)(nil),                // 27: nodegroupmanager.CreateOptions
+	(*UpdateOptions)(nil),                // 28: nodegroupmanager.UpdateOptions
+	nil,                                  // 29: nodegroupmanager.AutoscalerReviewRequest.NodeGroupsEntry
+	nil,                                  // 30: nodegroupmanager.NodeTemplate.LabelsEntry
+	nil,                                  // 31: nodegroupmanager.NodeGroupStrategy.LabelsEntry
+	nil,                                  // 32: nodegroupmanager.Strategy.NodegroupBufferEntry
+	(*wrappers.Int32Value)(nil),          // 33: google.protobuf.Int32Value
 }
 var file_proto_bcs_nodegroup_manager_proto_depIdxs = []int32{
 	1,  // 0: nodegroupmanager.ClusterAutoscalerReview.request:type_name -> nodegroupmanager.AutoscalerReviewRequest
 	2,  // 1: nodegroupmanager.ClusterAutoscalerReview.response:type_name -> nodegroupmanager.AutoscalerReviewResponse
-	28, // 2: nodegroupmanager.AutoscalerReviewRequest.nodeGroups:type_name -> nodegroupmanager.AutoscalerReviewRequest.NodeGroupsEntry
+	29, // 2: nodegroupmanager.AutoscalerReviewRequest.nodeGroups:type_name -> nodegroupmanager.AutoscalerReviewRequest.NodeGroupsEntry
 	6,  // 3: nodegroupmanager.AutoscalerReviewResponse.scaleUps:type_name -> nodegroupmanager.NodeScaleUpPolicy
 	7,  // 4: nodegroupmanager.AutoscalerReviewResponse.scaleDowns:type_name -> nodegroupmanager.NodeScaleDownPolicy
 	4,  // 5: nodegroupmanager.NodeGroup.nodeTemplate:type_name -> nodegroupmanager.NodeTemplate
-	29, // 6: nodegroupmanager.NodeTemplate.labels:type_name -> nodegroupmanager.NodeTemplate.LabelsEntry
+	30, // 6: nodegroupmanager.NodeTemplate.labels:type_name -> nodegroupmanager.NodeTemplate.LabelsEntry
 	5,  // 7: nodegroupmanager.NodeTemplate.taints:type_name -> nodegroupmanager.NodeTaint
-	26, // 8: nodegroupmanager.CreateNodePoolMgrStrategyReq.option:type_name -> nodegroupmanager.CreateOptions
+	27, // 8: nodegroupmanager.CreateNodePoolMgrStrategyReq.option:type_name -> nodegroupmanager.CreateOptions
 	17, // 9: nodegroupmanager.CreateNodePoolMgrStrategyReq.strategy:type_name -> nodegroupmanager.NodeGroupStrategy
-	27, // 10: nodegroupmanager.UpdateNodePoolMgrStrategyReq.option:type_name -> nodegroupmanager.UpdateOptions
+	28, // 10: nodegroupmanager.UpdateNodePoolMgrStrategyReq.option:type_name -> nodegroupmanager.UpdateOptions
 	17, // 11: nodegroupmanager.UpdateNodePoolMgrStrategyReq.strategy:type_name -> nodegroupmanager.NodeGroupStrategy
 	17, // 12: nodegroupmanager.GetNodePoolMgrStrategyRsp.data:type_name -> nodegroupmanager.NodeGroupStrategy
 	17, // 13: nodegroupmanager.ListNodePoolMgrStrategyRsp.data:type_name -> nodegroupmanager.NodeGroupStrategy
-	30, // 14: nodegroupmanager.NodeGroupStrategy.labels:type_name -> nodegroupmanager.NodeGroupStrategy.LabelsEntry
+	31, // 14: nodegroupmanager.NodeGroupStrategy.labels:type_name -> nodegroupmanager.NodeGroupStrategy.LabelsEntry
 	18, // 15: nodegroupmanager.NodeGroupStrategy.reservedNodeGroup:type_name -> nodegroupmanager.ReservedNodeGroup
 	19, // 16: nodegroupmanager.NodeGroupStrategy.elasticNodeGroups:type_name -> nodegroupmanager.ElasticNodeGroup
 	21, // 17: nodegroupmanager.NodeGroupStrategy.strategy:type_name -> nodegroupmanager.Strategy
 	20, // 18: nodegroupmanager.ElasticNodeGroup.limit:type_name -> nodegroupmanager.NodegroupLimit
 	22, // 19: nodegroupmanager.Strategy.buffer:type_name -> nodegroupmanager.Buffer
-	31, // 20: nodegroupmanager.Strategy.nodegroupBuffer:type_name -> nodegroupmanager.Strategy.NodegroupBufferEntry
+	32, // 20: nodegroupmanager.Strategy.nodegroupBuffer:type_name -> nodegroupmanager.Strategy.NodegroupBufferEntry
 	23, // 21: nodegroupmanager.Strategy.timeMode:type_name -> nodegroupmanager.TimeMode
-	24, // 22: nodegroupmanager.TimeMode.timePeriods:type_name -> nodegroupmanager.TimePeriod
-	3,  // 23: nodegroupmanager.AutoscalerReviewRequest.NodeGroupsEntry.value:type_name -> nodegroupmanager.NodeGroup
-	25, // 24: nodegroupmanager.Strategy.NodegroupBufferEntry.value:type_name -> nodegroupmanager.BufferParam
-	0,  // 25: nodegroupmanager.NodegroupManager.GetClusterAutoscalerReview:input_type -> nodegroupmanager.ClusterAutoscalerReview
-	8,  // 26: nodegroupmanager.NodegroupManager.CreateNodePoolMgrStrategy:input_type -> nodegroupmanager.CreateNodePoolMgrStrategyReq
-	9,  // 27: nodegroupmanager.NodegroupManager.UpdateNodePoolMgrStrategy:input_type -> nodegroupmanager.UpdateNodePoolMgrStrategyReq
-	11, // 28: nodegroupmanager.NodegroupManager.GetNodePoolMgrStrategy:input_type -> nodegroupmanager.GetNodePoolMgrStrategyReq
-	13, // 29: nodegroupmanager.NodegroupManager.ListNodePoolMgrStrategies:input_type -> nodegroupmanager.ListNodePoolMgrStrategyReq
-	15, // 30: nodegroupmanager.NodegroupManager.DeleteNodePoolMgrStrategy:input_type -> nodegroupmanager.DeleteNodePoolMgrStrategyReq
-	0,  // 31: nodegroupmanager.NodegroupManager.GetClusterAutoscalerReview:output_type -> nodegroupmanager.ClusterAutoscalerReview
-	10, // 32: nodegroupmanager.NodegroupManager.CreateNodePoolMgrStrategy:output_type -> nodegroupmanager.CreateNodePoolMgrStrategyRsp
-	10, // 33: nodegroupmanager.NodegroupManager.UpdateNodePoolMgrStrategy:output_type -> nodegroupmanager.CreateNodePoolMgrStrategyRsp
-	12, // 34: nodegroupmanager.NodegroupManager.GetNodePoolMgrStrategy:output_type -> nodegroupmanager.GetNodePoolMgrStrategyRsp
-	14, // 35: nodegroupmanager.NodegroupManager.ListNodePoolMgrStrategies:output_type -> nodegroupmanager.ListNodePoolMgrStrategyRsp
-	16, // 36: nodegroupmanager.NodegroupManager.DeleteNodePoolMgrStrategy:output_type -> nodegroupmanager.DeleteNodePoolMgrStrategyRsp
-	31, // [31:37] is the sub-list for method output_type
-	25, // [25:31] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	24, // 22: nodegroupmanager.Strategy.predictive:type_name -> nodegroupmanager.Predictive
+	25, // 23: nodegroupmanager.TimeMode.timePeriods:type_name -> nodegroupmanager.TimePeriod
+	33, // 24: nodegroupmanager.Predictive.weeklyWeight:type_name -> google.protobuf.Int32Value
+	3,  // 25: nodegroupmanager.AutoscalerReviewRequest.NodeGroupsEntry.value:type_name -> nodegroupmanager.NodeGroup
+	26, // 26: nodegroupmanager.Strategy.NodegroupBufferEntry.value:type_name -> nodegroupmanager.BufferParam
+	0,  // 27: nodegroupmanager.NodegroupManager.GetClusterAutoscalerReview:input_type -> nodegroupmanager.ClusterAutoscalerReview
+	8,  // 28: nodegroupmanager.NodegroupManager.CreateNodePoolMgrStrategy:input_type -> nodegroupmanager.CreateNodePoolMgrStrategyReq
+	9,  // 29: nodegroupmanager.NodegroupManager.UpdateNodePoolMgrStrategy:input_type -> nodegroupmanager.UpdateNodePoolMgrStrategyReq
+	11, // 30: nodegroupmanager.NodegroupManager.GetNodePoolMgrStrategy:input_type -> nodegroupmanager.GetNodePoolMgrStrategyReq
+	13, // 31: nodegroupmanager.NodegroupManager.ListNodePoolMgrStrategies:input_type -> nodegroupmanager.ListNodePoolMgrStrategyReq
+	15, // 32: nodegroupmanager.NodegroupManager.DeleteNodePoolMgrStrategy:input_type -> nodegroupmanager.DeleteNodePoolMgrStrategyReq
+	0,  // 33: nodegroupmanager.NodegroupManager.GetClusterAutoscalerReview:output_type -> nodegroupmanager.ClusterAutoscalerReview
+	10, // 34: nodegroupmanager.NodegroupManager.CreateNodePoolMgrStrategy:output_type -> nodegroupmanager.CreateNodePoolMgrStrategyRsp
+	10, // 35: nodegroupmanager.NodegroupManager.UpdateNodePoolMgrStrategy:output_type -> nodegroupmanager.CreateNodePoolMgrStrategyRsp
+	12, // 36: nodegroupmanager.NodegroupManager.GetNodePoolMgrStrategy:output_type -> nodegroupmanager.GetNodePoolMgrStrategyRsp
+	14, // 37: nodegroupmanager.NodegroupManager.ListNodePoolMgrStrategies:output_type -> nodegroupmanager.ListNodePoolMgrStrategyRsp
+	16, // 38: nodegroupmanager.NodegroupManager.DeleteNodePoolMgrStrategy:output_type -> nodegroupmanager.DeleteNodePoolMgrStrategyRsp
+	33, // [33:39] is the sub-list for method output_type
+	27, // [27:33] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_proto_bcs_nodegroup_manager_proto_init() }
@@ -3086,7 +3200,7 @@ func file_proto_bcs_nodegroup_manager_proto_init() {
 			}
 		}
 		file_proto_bcs_nodegroup_manager_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimePeriod); i {
+			switch v := v.(*Predictive); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3098,7 +3212,7 @@ func file_proto_bcs_nodegroup_manager_proto_init() {
 			}
 		}
 		file_proto_bcs_nodegroup_manager_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BufferParam); i {
+			switch v := v.(*TimePeriod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3110,7 +3224,7 @@ func file_proto_bcs_nodegroup_manager_proto_init() {
 			}
 		}
 		file_proto_bcs_nodegroup_manager_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOptions); i {
+			switch v := v.(*BufferParam); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3122,6 +3236,18 @@ func file_proto_bcs_nodegroup_manager_proto_init() {
 			}
 		}
 		file_proto_bcs_nodegroup_manager_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bcs_nodegroup_manager_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateOptions); i {
 			case 0:
 				return &v.state
@@ -3140,7 +3266,7 @@ func file_proto_bcs_nodegroup_manager_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_bcs_nodegroup_manager_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
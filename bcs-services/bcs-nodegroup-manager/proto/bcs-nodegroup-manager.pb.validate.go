@@ -2949,6 +2949,35 @@ func (m *Strategy) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetPredictive()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StrategyValidationError{
+					field:  "Predictive",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StrategyValidationError{
+					field:  "Predictive",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPredictive()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StrategyValidationError{
+				field:  "Predictive",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return StrategyMultiError(errors)
 	}
@@ -3262,6 +3291,137 @@ var _ interface {
 	ErrorName() string
 } = TimeModeValidationError{}
 
+// Validate checks the field values on Predictive with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Predictive) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Predictive with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PredictiveMultiError, or
+// nil if none found.
+func (m *Predictive) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Predictive) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LookAheadMinutes
+
+	// no validation rules for HistoryDays
+
+	if all {
+		switch v := interface{}(m.GetWeeklyWeight()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PredictiveValidationError{
+					field:  "WeeklyWeight",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PredictiveValidationError{
+					field:  "WeeklyWeight",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWeeklyWeight()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PredictiveValidationError{
+				field:  "WeeklyWeight",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PredictiveMultiError(errors)
+	}
+	return nil
+}
+
+// PredictiveMultiError is an error wrapping multiple validation errors
+// returned by Predictive.ValidateAll() if the designated constraints aren't met.
+type PredictiveMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PredictiveMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PredictiveMultiError) AllErrors() []error { return m }
+
+// PredictiveValidationError is the validation error returned by
+// Predictive.Validate if the designated constraints aren't met.
+type PredictiveValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PredictiveValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PredictiveValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PredictiveValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PredictiveValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PredictiveValidationError) ErrorName() string { return "PredictiveValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PredictiveValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPredictive.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PredictiveValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PredictiveValidationError{}
+
 // Validate checks the field values on TimePeriod with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
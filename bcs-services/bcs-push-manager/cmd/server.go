@@ -44,6 +44,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/action"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/channel"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/constant"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/handler"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/mq/rabbitmq"
@@ -62,6 +63,7 @@ type Server struct {
 	httpServer          *http.Server
 	mqClient            *rabbitmq.RabbitMQ
 	mongoServer         *mongostore.Server
+	channelManager      *channel.Manager
 	tlsConfig           *tls.Config
 	clientTLSConfig     *tls.Config
 
@@ -88,6 +90,7 @@ func (s *Server) Init() error {
 		s.initRegistry,
 		s.initStore,
 		s.initMQ,
+		s.initChannels,
 		s.initMicro,
 		s.initThirdpartyDiscovery,
 		s.initHTTPService,
@@ -198,6 +201,17 @@ func (s *Server) initStore() error {
 	return nil
 }
 
+// initChannels initializes the configured notification channels.
+func (s *Server) initChannels() error {
+	manager, err := channel.NewManager(s.opt.Channels)
+	if err != nil {
+		return fmt.Errorf("failed to init notification channels: %v", err)
+	}
+	s.channelManager = manager
+	blog.Infof("init %d notification channels successfully", len(s.opt.Channels))
+	return nil
+}
+
 // initMQ initializes the RabbitMQ client.
 func (s *Server) initMQ() error {
 	mqClient := rabbitmq.NewRabbitMQ(s.opt.RabbitMQ)
@@ -405,8 +419,10 @@ func (s *Server) startRabbitMQConsumer(ctx context.Context) error {
 	// Create notification action with dependencies
 	notificationAction := &action.NotificationAction{
 		ThirdpartyClient: thirdparty.GetThirdpartyClient(),
+		Channels:         s.channelManager,
 		WhitelistStore:   s.mongoServer.GetPushWhitelistModel(),
 		EventStore:       s.mongoServer.GetPushEventModel(),
+		TemplateStore:    s.mongoServer.GetPushTemplateModel(),
 		MaxRetry:         3,
 		RetryInterval:    5 * time.Second,
 	}
@@ -14,24 +14,30 @@
 package action
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"text/template"
 	"time"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
 	amqp "github.com/rabbitmq/amqp091-go"
 
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/channel"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/constant"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/mq"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/store/mongo"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/store/types"
 	third "github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/thirdparty"
 )
 
 // NotificationAction defines the action for handling notification messages.
 type NotificationAction struct {
 	ThirdpartyClient third.Client
+	Channels         *channel.Manager
 	WhitelistStore   *mongo.ModelPushWhitelist
 	EventStore       *mongo.ModelPushEvent
+	TemplateStore    *mongo.ModelPushTemplate
 	MaxRetry         int
 	RetryInterval    time.Duration
 	Chn              *amqp.Channel
@@ -81,28 +87,25 @@ func (n *NotificationAction) HandleMsg(messages <-chan amqp.Delivery, done <-cha
 
 			allSuccess := true
 			for _, pushType := range pushMsg.Type {
-				var sendErr error
-				for i := 0; i < n.MaxRetry; i++ {
-					blog.Infof("try send notification, eventID: %s, type: %s, try: %d", event.EventID, pushType, i+1)
-					sendErr = n.sendNotification(pushType, pushMsg)
-					if sendErr == nil {
-						err := n.EventStore.AppendNotificationResult(ctx, event.EventID, pushType, constant.NotificationResultSuccess)
-						if err != nil {
-							blog.Errorf("failed to append notification result, eventID: %s, type: %s, err: %v", event.EventID, pushType, err)
-						}
-						blog.Infof("send notification success, eventID: %s, type: %s", event.EventID, pushType)
-						break
-					}
-					blog.Infof("failed to send %s: %v, retrying %d time(s)", pushType, sendErr, i+1)
-					time.Sleep(n.RetryInterval)
-				}
-				if sendErr != nil {
+				pushType := pushType
+				if !n.deliver(ctx, event.EventID, pushType, func() error {
+					return n.sendNotification(pushType, pushMsg)
+				}) {
 					allSuccess = false
-					err := n.EventStore.AppendNotificationResult(ctx, event.EventID, pushType, constant.NotificationResultFailed)
-					if err != nil {
-						blog.Errorf("failed to append notification result, eventID: %s, type: %s, err: %v", event.EventID, pushType, err)
+				}
+			}
+			channels := n.Channels.Match(event.Domain, pushMsg.TemplateID, pushMsg.Channels)
+			if len(channels) > 0 {
+				channelMsg := n.buildChannelMessage(ctx, event, pushMsg)
+				for _, ch := range channels {
+					ch := ch
+					if !n.deliver(ctx, event.EventID, ch.Name(), func() error {
+						sendCtx, cancel := context.WithTimeout(ctx, time.Minute)
+						defer cancel()
+						return ch.Send(sendCtx, channelMsg)
+					}) {
+						allSuccess = false
 					}
-					blog.Infof("send notification failed, eventID: %s, type: %s, err: %v", event.EventID, pushType, sendErr)
 				}
 			}
 			if allSuccess {
@@ -125,6 +128,96 @@ func (n *NotificationAction) HandleMsg(messages <-chan amqp.Delivery, done <-cha
 	}
 }
 
+// deliver sends the notification with retry and records the result by key, returns whether it succeeded.
+func (n *NotificationAction) deliver(ctx context.Context, eventID, key string, send func() error) bool {
+	var sendErr error
+	for i := 0; i < n.MaxRetry; i++ {
+		blog.Infof("try send notification, eventID: %s, type: %s, try: %d", eventID, key, i+1)
+		sendErr = send()
+		if sendErr == nil {
+			err := n.EventStore.AppendNotificationResult(ctx, eventID, key, constant.NotificationResultSuccess)
+			if err != nil {
+				blog.Errorf("failed to append notification result, eventID: %s, type: %s, err: %v", eventID, key, err)
+			}
+			blog.Infof("send notification success, eventID: %s, type: %s", eventID, key)
+			return true
+		}
+		blog.Infof("failed to send %s: %v, retrying %d time(s)", key, sendErr, i+1)
+		time.Sleep(n.RetryInterval)
+	}
+	err := n.EventStore.AppendNotificationResult(ctx, eventID, key, constant.NotificationResultFailed)
+	if err != nil {
+		blog.Errorf("failed to append notification result, eventID: %s, type: %s, err: %v", eventID, key, err)
+	}
+	blog.Infof("send notification failed, eventID: %s, type: %s, err: %v", eventID, key, sendErr)
+	return false
+}
+
+// buildChannelMessage builds the message of notification channels. If the push template of the message exists,
+// title and body are rendered by the template with event detail and dimension fields, such as {{.cluster_id}}.
+func (n *NotificationAction) buildChannelMessage(ctx context.Context, event *types.PushEvent,
+	pushMsg *mq.PushEventMessage) *channel.Message {
+	msg := &channel.Message{
+		EventID:   event.EventID,
+		Domain:    event.Domain,
+		Level:     event.PushLevel,
+		Title:     firstNonEmpty(pushMsg.RTXTitle, pushMsg.MailTitle, event.EventID),
+		Content:   firstNonEmpty(pushMsg.MsgContent, pushMsg.RTXContent, pushMsg.MailContent),
+		Dimension: event.Dimension.Fields,
+		Timestamp: time.Now().Unix(),
+	}
+	if pushMsg.TemplateID == "" || n.TemplateStore == nil {
+		return msg
+	}
+
+	tpl, err := n.TemplateStore.GetPushTemplate(ctx, pushMsg.TemplateID)
+	if err != nil || tpl.Domain != event.Domain {
+		blog.Warnf("push template %s not available for domain %s, use raw content, err: %v",
+			pushMsg.TemplateID, event.Domain, err)
+		return msg
+	}
+	data := make(map[string]string, len(event.Dimension.Fields)+len(event.EventDetail.Fields))
+	for k, v := range event.Dimension.Fields {
+		data[k] = v
+	}
+	for k, v := range event.EventDetail.Fields {
+		data[k] = v
+	}
+	if title, err := renderTemplate(tpl.Content.Title, data); err == nil {
+		msg.Title = title
+	} else {
+		blog.Warnf("render push template %s title failed, err: %v", pushMsg.TemplateID, err)
+	}
+	if body, err := renderTemplate(tpl.Content.Body, data); err == nil {
+		msg.Content = body
+	} else {
+		blog.Warnf("render push template %s body failed, err: %v", pushMsg.TemplateID, err)
+	}
+	return msg
+}
+
+// renderTemplate renders the text template with data, missing variables are rendered as empty string.
+func renderTemplate(text string, data map[string]string) (string, error) {
+	t, err := template.New("push").Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
 // sendNotification sends a notification based on the push type.
 func (n *NotificationAction) sendNotification(pushType string, pushMsg *mq.PushEventMessage) error {
 	switch pushType {
@@ -457,7 +457,10 @@ func (a *PushEventAction) validateEventDetail(detail *types.EventDetail, rsp *pb
 	pushTypes := strings.Split(fields[constant.EventDetailKeyTypes], ",")
 
 	for _, pushType := range pushTypes {
-		switch pushType {
+		switch strings.TrimSpace(pushType) {
+		case "":
+			// events delivered only by notification channels may have no push type
+			continue
 		case constant.PushTypeRtx:
 			requiredRTXFields := []string{
 				constant.EventDetailKeyRTXReceivers,
@@ -0,0 +1,146 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package channel provides pluggable notification channels, such as signed webhook and IM group robots.
+package channel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	resty "github.com/go-resty/resty/v2"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/constant"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/options"
+)
+
+// Message defines the notification content delivered by channels.
+type Message struct {
+	EventID   string            `json:"event_id"`
+	Domain    string            `json:"domain"`
+	Level     string            `json:"level"`
+	Title     string            `json:"title"`
+	Content   string            `json:"content"`
+	Dimension map[string]string `json:"dimension,omitempty"`
+	Timestamp int64             `json:"timestamp"`
+}
+
+// Channel is the interface of a notification channel.
+type Channel interface {
+	// Name returns the unique name of the channel.
+	Name() string
+	// Type returns the type of the channel.
+	Type() string
+	// Send delivers the message to the channel.
+	Send(ctx context.Context, msg *Message) error
+}
+
+// New creates a channel by the channel option.
+func New(opt *options.ChannelOption) (Channel, error) {
+	if opt == nil {
+		return nil, fmt.Errorf("channel option is nil")
+	}
+	if opt.Name == "" || strings.ContainsAny(opt.Name, ".$") {
+		return nil, fmt.Errorf("invalid channel name %q, must be non-empty and not contain '.' or '$'", opt.Name)
+	}
+	// rtx and mail results are stored in notification results too, channel names must not collide with them.
+	if opt.Name == constant.PushTypeRtx || opt.Name == constant.PushTypeMail {
+		return nil, fmt.Errorf("invalid channel name %q, %s and %s are reserved",
+			opt.Name, constant.PushTypeRtx, constant.PushTypeMail)
+	}
+	if opt.URL == "" {
+		return nil, fmt.Errorf("channel %s url is required", opt.Name)
+	}
+	timeout := opt.Timeout
+	if timeout <= 0 {
+		timeout = constant.ChannelDefaultTimeout
+	}
+	base := baseChannel{
+		opt:     opt,
+		httpCli: resty.New().SetTimeout(time.Duration(timeout) * time.Second),
+	}
+
+	switch opt.Type {
+	case constant.ChannelTypeWebhook:
+		return &webhookChannel{baseChannel: base}, nil
+	case constant.ChannelTypeWecom:
+		return &wecomChannel{baseChannel: base}, nil
+	case constant.ChannelTypeFeishu:
+		return &feishuChannel{baseChannel: base}, nil
+	case constant.ChannelTypeDingtalk:
+		return &dingtalkChannel{baseChannel: base}, nil
+	case constant.ChannelTypeSlack:
+		return &slackChannel{baseChannel: base}, nil
+	default:
+		return nil, fmt.Errorf("channel %s type %s is not supported", opt.Name, opt.Type)
+	}
+}
+
+// Manager manages the configured channels and selects channels for events.
+type Manager struct {
+	channels []Channel
+	options  map[string]*options.ChannelOption
+}
+
+// NewManager creates channels by options, returns error if any channel is invalid.
+func NewManager(opts []*options.ChannelOption) (*Manager, error) {
+	m := &Manager{
+		channels: make([]Channel, 0, len(opts)),
+		options:  make(map[string]*options.ChannelOption, len(opts)),
+	}
+	for _, opt := range opts {
+		ch, err := New(opt)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := m.options[opt.Name]; ok {
+			return nil, fmt.Errorf("duplicate channel name %s", opt.Name)
+		}
+		m.channels = append(m.channels, ch)
+		m.options[opt.Name] = opt
+	}
+	return m, nil
+}
+
+// Match returns the channels configured for the domain and push template.
+// If names is not empty, only channels with these names are returned.
+func (m *Manager) Match(domain, templateID string, names []string) []Channel {
+	if m == nil {
+		return nil
+	}
+	result := make([]Channel, 0)
+	for _, ch := range m.channels {
+		opt := m.options[ch.Name()]
+		if len(names) > 0 && !contains(names, opt.Name) {
+			continue
+		}
+		if len(opt.Domains) > 0 && !contains(opt.Domains, domain) {
+			continue
+		}
+		if len(opt.Templates) > 0 && !contains(opt.Templates, templateID) {
+			continue
+		}
+		result = append(result, ch)
+	}
+	return result
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/constant"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/options"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		opt     *options.ChannelOption
+		wantErr bool
+	}{
+		{name: "nil option", opt: nil, wantErr: true},
+		{name: "empty name", opt: &options.ChannelOption{Type: constant.ChannelTypeWebhook, URL: "http://x"}, wantErr: true},
+		{name: "name with dot", opt: &options.ChannelOption{Name: "a.b", Type: constant.ChannelTypeWebhook,
+			URL: "http://x"}, wantErr: true},
+		{name: "reserved rtx", opt: &options.ChannelOption{Name: constant.PushTypeRtx,
+			Type: constant.ChannelTypeWecom, URL: "http://x"}, wantErr: true},
+		{name: "reserved mail", opt: &options.ChannelOption{Name: constant.PushTypeMail,
+			Type: constant.ChannelTypeWebhook, URL: "http://x"}, wantErr: true},
+		{name: "empty url", opt: &options.ChannelOption{Name: "ops", Type: constant.ChannelTypeWebhook}, wantErr: true},
+		{name: "unknown type", opt: &options.ChannelOption{Name: "ops", Type: "sms", URL: "http://x"}, wantErr: true},
+		{name: "valid", opt: &options.ChannelOption{Name: "ops", Type: constant.ChannelTypeSlack, URL: "http://x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.opt)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestManagerMatch(t *testing.T) {
+	m, err := NewManager([]*options.ChannelOption{
+		{Name: "all", Type: constant.ChannelTypeWebhook, URL: "http://x"},
+		{Name: "bcs", Type: constant.ChannelTypeWecom, URL: "http://x", Domains: []string{"bcs"}},
+		{Name: "node", Type: constant.ChannelTypeFeishu, URL: "http://x", Templates: []string{"node-alert"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := func(chs []Channel) []string {
+		result := make([]string, 0, len(chs))
+		for _, ch := range chs {
+			result = append(result, ch.Name())
+		}
+		return result
+	}
+	if got := names(m.Match("bcs", "node-alert", nil)); len(got) != 3 {
+		t.Errorf("expect all channels, get %v", got)
+	}
+	if got := names(m.Match("other", "", nil)); len(got) != 1 || got[0] != "all" {
+		t.Errorf("expect channel all, get %v", got)
+	}
+	if got := names(m.Match("bcs", "", []string{"bcs"})); len(got) != 1 || got[0] != "bcs" {
+		t.Errorf("expect channel bcs, get %v", got)
+	}
+
+	if _, err = NewManager([]*options.ChannelOption{
+		{Name: "dup", Type: constant.ChannelTypeWebhook, URL: "http://x"},
+		{Name: "dup", Type: constant.ChannelTypeWecom, URL: "http://x"},
+	}); err == nil {
+		t.Errorf("expect error for duplicate channel name")
+	}
+}
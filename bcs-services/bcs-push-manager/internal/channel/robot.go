@@ -0,0 +1,171 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// robotResponse is the common response of WeCom, DingTalk and Feishu robots.
+type robotResponse struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+	Code    int    `json:"code"`
+	Msg     string `json:"msg"`
+}
+
+// checkRobotResponse returns error if the robot response contains a non-zero code.
+func checkRobotResponse(name string, body []byte) error {
+	if len(body) == 0 {
+		return nil
+	}
+	rsp := &robotResponse{}
+	if err := json.Unmarshal(body, rsp); err != nil {
+		return fmt.Errorf("channel %s unmarshal response failed: %v", name, err)
+	}
+	if rsp.ErrCode != 0 {
+		return fmt.Errorf("channel %s send failed, code: %d, message: %s", name, rsp.ErrCode, rsp.ErrMsg)
+	}
+	if rsp.Code != 0 {
+		return fmt.Errorf("channel %s send failed, code: %d, message: %s", name, rsp.Code, rsp.Msg)
+	}
+	return nil
+}
+
+// markdown returns the markdown text of the message.
+func markdown(msg *Message) string {
+	return fmt.Sprintf("**%s**\n\n%s", msg.Title, msg.Content)
+}
+
+// wecomChannel sends markdown message to WeCom group robot.
+type wecomChannel struct {
+	baseChannel
+}
+
+// Send delivers the message to WeCom group robot.
+func (w *wecomChannel) Send(ctx context.Context, msg *Message) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"msgtype":  "markdown",
+		"markdown": map[string]string{"content": markdown(msg)},
+	})
+	if err != nil {
+		return err
+	}
+	rsp, err := w.post(ctx, w.opt.URL, nil, body)
+	if err != nil {
+		return err
+	}
+	return checkRobotResponse(w.opt.Name, rsp)
+}
+
+// dingtalkChannel sends markdown message to DingTalk group robot, signs the url if secret is set.
+type dingtalkChannel struct {
+	baseChannel
+}
+
+// Send delivers the message to DingTalk group robot.
+func (d *dingtalkChannel) Send(ctx context.Context, msg *Message) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"msgtype":  "markdown",
+		"markdown": map[string]string{"title": msg.Title, "text": markdown(msg)},
+	})
+	if err != nil {
+		return err
+	}
+
+	reqURL := d.opt.URL
+	if d.opt.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+		u, err := url.Parse(reqURL)
+		if err != nil {
+			return fmt.Errorf("channel %s parse url failed: %v", d.opt.Name, err)
+		}
+		query := u.Query()
+		query.Set("timestamp", timestamp)
+		query.Set("sign", signRobot(d.opt.Secret, timestamp+"\n"+d.opt.Secret))
+		u.RawQuery = query.Encode()
+		reqURL = u.String()
+	}
+
+	rsp, err := d.post(ctx, reqURL, nil, body)
+	if err != nil {
+		return err
+	}
+	return checkRobotResponse(d.opt.Name, rsp)
+}
+
+// feishuChannel sends interactive card message to Feishu group robot, signs the body if secret is set.
+type feishuChannel struct {
+	baseChannel
+}
+
+// Send delivers the message to Feishu group robot.
+func (f *feishuChannel) Send(ctx context.Context, msg *Message) error {
+	payload := map[string]interface{}{
+		"msg_type": "interactive",
+		"card": map[string]interface{}{
+			"header": map[string]interface{}{
+				"title": map[string]string{"tag": "plain_text", "content": msg.Title},
+			},
+			"elements": []interface{}{
+				map[string]string{"tag": "markdown", "content": msg.Content},
+			},
+		},
+	}
+	if f.opt.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		payload["timestamp"] = timestamp
+		payload["sign"] = signRobot(timestamp+"\n"+f.opt.Secret, "")
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	rsp, err := f.post(ctx, f.opt.URL, nil, body)
+	if err != nil {
+		return err
+	}
+	return checkRobotResponse(f.opt.Name, rsp)
+}
+
+// signRobot returns the base64 encoded hmac-sha256 signature used by DingTalk and Feishu robots.
+func signRobot(key, data string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(data))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// slackChannel sends message to Slack-compatible incoming webhook, such as Mattermost and Rocket.Chat.
+type slackChannel struct {
+	baseChannel
+}
+
+// Send delivers the message to Slack-compatible incoming webhook.
+func (s *slackChannel) Send(ctx context.Context, msg *Message) error {
+	body, err := json.Marshal(map[string]string{
+		"text": fmt.Sprintf("*%s*\n%s", msg.Title, msg.Content),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.post(ctx, s.opt.URL, nil, body)
+	return err
+}
@@ -0,0 +1,150 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/constant"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/options"
+)
+
+// robotRequest records the last request received by the fake robot server.
+type robotRequest struct {
+	query url.Values
+	body  []byte
+}
+
+func newRobotServer(t *testing.T, response string) (*httptest.Server, *robotRequest) {
+	req := &robotRequest{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("read request body failed: %v", err)
+		}
+		req.query = r.URL.Query()
+		req.body = body
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, req
+}
+
+func hmacBase64(key, data string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(data))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestDingtalkSign(t *testing.T) {
+	srv, req := newRobotServer(t, `{"errcode":0,"errmsg":"ok"}`)
+	ch, err := New(&options.ChannelOption{
+		Name:   "dingtalk",
+		Type:   constant.ChannelTypeDingtalk,
+		URL:    srv.URL + "/robot/send?access_token=token",
+		Secret: "SEC000",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ch.Send(context.Background(), &Message{Title: "title", Content: "content"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if req.query.Get("access_token") != "token" {
+		t.Errorf("access_token lost, query: %v", req.query)
+	}
+	timestamp := req.query.Get("timestamp")
+	if timestamp == "" {
+		t.Fatalf("timestamp not set, query: %v", req.query)
+	}
+	if expect := hmacBase64("SEC000", timestamp+"\nSEC000"); req.query.Get("sign") != expect {
+		t.Errorf("expect sign %s, get %s", expect, req.query.Get("sign"))
+	}
+}
+
+func TestDingtalkWithoutSecret(t *testing.T) {
+	srv, req := newRobotServer(t, `{"errcode":0,"errmsg":"ok"}`)
+	ch, err := New(&options.ChannelOption{Name: "dingtalk", Type: constant.ChannelTypeDingtalk, URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ch.Send(context.Background(), &Message{Title: "title", Content: "content"}); err != nil {
+		t.Fatal(err)
+	}
+	if req.query.Has("timestamp") || req.query.Has("sign") {
+		t.Errorf("expect no sign, query: %v", req.query)
+	}
+}
+
+func TestFeishuSign(t *testing.T) {
+	srv, req := newRobotServer(t, `{"code":0,"msg":"success"}`)
+	ch, err := New(&options.ChannelOption{
+		Name:   "feishu",
+		Type:   constant.ChannelTypeFeishu,
+		URL:    srv.URL,
+		Secret: "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ch.Send(context.Background(), &Message{Title: "title", Content: "content"}); err != nil {
+		t.Fatal(err)
+	}
+
+	payload := map[string]interface{}{}
+	if err = json.Unmarshal(req.body, &payload); err != nil {
+		t.Fatal(err)
+	}
+	timestamp, _ := payload["timestamp"].(string)
+	if timestamp == "" {
+		t.Fatalf("timestamp not set, body: %s", req.body)
+	}
+	// feishu signs an empty string with timestamp + "\n" + secret as the key
+	if expect := hmacBase64(timestamp+"\nsecret", ""); payload["sign"] != expect {
+		t.Errorf("expect sign %s, get %v", expect, payload["sign"])
+	}
+}
+
+func TestRobotResponseError(t *testing.T) {
+	tests := []struct {
+		name     string
+		chType   string
+		response string
+	}{
+		{name: "dingtalk", chType: constant.ChannelTypeDingtalk, response: `{"errcode":310000,"errmsg":"sign not match"}`},
+		{name: "feishu", chType: constant.ChannelTypeFeishu, response: `{"code":19021,"msg":"sign match fail"}`},
+		{name: "wecom", chType: constant.ChannelTypeWecom, response: `{"errcode":93000,"errmsg":"invalid webhook url"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _ := newRobotServer(t, tt.response)
+			ch, err := New(&options.ChannelOption{Name: tt.name, Type: tt.chType, URL: srv.URL, Secret: "secret"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = ch.Send(context.Background(), &Message{Title: "title"}); err == nil {
+				t.Errorf("expect error for response %s", tt.response)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	resty "github.com/go-resty/resty/v2"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/constant"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/options"
+)
+
+// baseChannel holds the option and http client shared by all channels.
+type baseChannel struct {
+	opt     *options.ChannelOption
+	httpCli *resty.Client
+}
+
+// Name returns the channel name.
+func (b *baseChannel) Name() string {
+	return b.opt.Name
+}
+
+// Type returns the channel type.
+func (b *baseChannel) Type() string {
+	return b.opt.Type
+}
+
+// post sends the json body to url, returns the response body if the status code is 2xx.
+func (b *baseChannel) post(ctx context.Context, url string, header map[string]string, body []byte) ([]byte, error) {
+	rsp, err := b.httpCli.R().SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeaders(header).
+		SetBody(body).
+		Post(url)
+	if err != nil {
+		return nil, fmt.Errorf("channel %s post request failed: %v", b.opt.Name, err)
+	}
+	if !rsp.IsSuccess() {
+		return nil, fmt.Errorf("channel %s post request failed, status: %d, body: %s",
+			b.opt.Name, rsp.StatusCode(), rsp.String())
+	}
+	return rsp.Body(), nil
+}
+
+// webhookChannel posts the message as json to a generic http webhook.
+// If secret is set, the request is signed by hmac-sha256 of "{timestamp}.{body}".
+type webhookChannel struct {
+	baseChannel
+}
+
+// Send delivers the message to the webhook.
+func (w *webhookChannel) Send(ctx context.Context, msg *Message) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	header := map[string]string{}
+	if w.opt.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		header[constant.WebhookHeaderTimestamp] = timestamp
+		header[constant.WebhookHeaderSignature] = signWebhook(w.opt.Secret, timestamp, body)
+	}
+	_, err = w.post(ctx, w.opt.URL, header, body)
+	return err
+}
+
+// signWebhook returns the hex encoded hmac-sha256 signature of the webhook request.
+func signWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,107 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/constant"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-push-manager/internal/options"
+)
+
+func TestWebhookSign(t *testing.T) {
+	var (
+		header http.Header
+		body   []byte
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	ch, err := New(&options.ChannelOption{
+		Name:   "webhook",
+		Type:   constant.ChannelTypeWebhook,
+		URL:    srv.URL,
+		Secret: "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &Message{EventID: "event-1", Domain: "bcs", Title: "title", Content: "content"}
+	if err = ch.Send(context.Background(), msg); err != nil {
+		t.Fatal(err)
+	}
+
+	received := &Message{}
+	if err = json.Unmarshal(body, received); err != nil {
+		t.Fatal(err)
+	}
+	if received.EventID != msg.EventID || received.Title != msg.Title {
+		t.Errorf("unexpected message %+v", received)
+	}
+
+	timestamp := header.Get(constant.WebhookHeaderTimestamp)
+	if timestamp == "" {
+		t.Fatalf("header %s not set", constant.WebhookHeaderTimestamp)
+	}
+	// the receiver verifies the signature of "{timestamp}.{body}"
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(timestamp + "." + string(body)))
+	if expect := hex.EncodeToString(mac.Sum(nil)); header.Get(constant.WebhookHeaderSignature) != expect {
+		t.Errorf("expect signature %s, get %s", expect, header.Get(constant.WebhookHeaderSignature))
+	}
+}
+
+func TestWebhookWithoutSecret(t *testing.T) {
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+	}))
+	defer srv.Close()
+
+	ch, err := New(&options.ChannelOption{Name: "webhook", Type: constant.ChannelTypeWebhook, URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ch.Send(context.Background(), &Message{Title: "title"}); err != nil {
+		t.Fatal(err)
+	}
+	if header.Get(constant.WebhookHeaderTimestamp) != "" || header.Get(constant.WebhookHeaderSignature) != "" {
+		t.Errorf("expect no signature header, get %v", header)
+	}
+}
+
+func TestWebhookStatusError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	ch, err := New(&options.ChannelOption{Name: "webhook", Type: constant.ChannelTypeWebhook, URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ch.Send(context.Background(), &Message{Title: "title"}); err == nil {
+		t.Errorf("expect error for status %d", http.StatusUnauthorized)
+	}
+}
@@ -160,12 +160,36 @@ const (
 	EventDetailKeyMailContent = "mail_content"
 	// EventDetailKeyMailTitle is the key for mail title in event details.
 	EventDetailKeyMailTitle = "mail_title"
+	// EventDetailKeyTemplateID is the key for push template id in event details.
+	EventDetailKeyTemplateID = "template_id"
+	// EventDetailKeyChannels is the key for notification channel names in event details, separated by comma.
+	EventDetailKeyChannels = "channels"
 	// MQRoutingKeyFormat is the format string for creating MQ routing keys. Example: "*.push.%s"
 	MQRoutingKeyFormat = "*.push.%s"
 	// MQRoutingKeyBindPattern is the pattern used for queue binding in MQ. Example: "*.push.#"
 	MQRoutingKeyBindPattern = "*.push.#"
 )
 
+// Notification channel constants.
+const (
+	// ChannelTypeWebhook represents the generic signed http webhook channel.
+	ChannelTypeWebhook = "webhook"
+	// ChannelTypeWecom represents the WeCom group robot channel.
+	ChannelTypeWecom = "wecom"
+	// ChannelTypeFeishu represents the Feishu group robot channel.
+	ChannelTypeFeishu = "feishu"
+	// ChannelTypeDingtalk represents the DingTalk group robot channel.
+	ChannelTypeDingtalk = "dingtalk"
+	// ChannelTypeSlack represents the Slack-compatible incoming webhook channel.
+	ChannelTypeSlack = "slack"
+	// ChannelDefaultTimeout is the default request timeout of notification channels in seconds.
+	ChannelDefaultTimeout = 10
+	// WebhookHeaderTimestamp is the header of request timestamp for the generic webhook.
+	WebhookHeaderTimestamp = "X-Bcs-Timestamp"
+	// WebhookHeaderSignature is the header of hmac-sha256 signature for the generic webhook.
+	WebhookHeaderSignature = "X-Bcs-Signature"
+)
+
 const (
 	// MicroMetaKeyHTTPPort http port in micro service meta
 	MicroMetaKeyHTTPPort = "httpport"
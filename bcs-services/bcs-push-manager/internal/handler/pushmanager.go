@@ -265,12 +265,13 @@ func (p *PushManagerService) sendEventToMQ(req *pb.CreatePushEventRequest, rsp *
 		return fmt.Errorf("rsp is nil")
 	}
 
-	pushChannelsStr := event.EventDetail.Fields[constant.EventDetailKeyTypes]
-	pushChannels := strings.Split(pushChannelsStr, ",")
+	pushChannels := splitFields(event.EventDetail.Fields[constant.EventDetailKeyTypes])
 	message := &mq.PushEventMessage{
-		EventID: rsp.EventId,
-		Type:    pushChannels,
-		Extra:   event.EventDetail.Fields,
+		EventID:    rsp.EventId,
+		Type:       pushChannels,
+		TemplateID: event.EventDetail.Fields[constant.EventDetailKeyTemplateID],
+		Channels:   splitFields(event.EventDetail.Fields[constant.EventDetailKeyChannels]),
+		Extra:      event.EventDetail.Fields,
 	}
 	for _, channel := range pushChannels {
 		switch channel {
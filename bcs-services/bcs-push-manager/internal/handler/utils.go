@@ -15,6 +15,7 @@ package handler
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
 )
@@ -60,3 +61,14 @@ func NewSuccessResponse() *StandardErrorResponse {
 		Message: "success",
 	}
 }
+
+// splitFields splits the comma separated event detail field, empty items are ignored.
+func splitFields(s string) []string {
+	fields := make([]string, 0)
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
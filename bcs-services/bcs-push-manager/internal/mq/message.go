@@ -43,6 +43,11 @@ type PushEventMessage struct {
 	MsgReceivers []string `json:"msg_receivers"`
 	MsgContent   string   `json:"msg_content"`
 
+	// TemplateID is the push template used to render messages of notification channels, optional.
+	TemplateID string `json:"template_id"`
+	// Channels limits the configured notification channels by name, empty means all matched channels.
+	Channels []string `json:"channels"`
+
 	// Extra contains additional data for the notification.
 	Extra map[string]string `json:"extra"`
 }
@@ -24,9 +24,10 @@ type ServiceOptions struct {
 	conf.LogConfig `json:"log_config"`
 	ServerConfig   `json:"server_config"`
 	ClientConfig   `json:"client_config"`
-	Mongo          *MongoOption    `json:"mongodb"`
-	Etcd           *EtcdOption     `json:"etcd"`
-	RabbitMQ       *RabbitMQOption `json:"rabbitmq"`
+	Mongo          *MongoOption     `json:"mongodb"`
+	Etcd           *EtcdOption      `json:"etcd"`
+	RabbitMQ       *RabbitMQOption  `json:"rabbitmq"`
+	Channels       []*ChannelOption `json:"notification_channels"`
 }
 
 // ServerConfig defines the config for the server.
@@ -72,6 +73,24 @@ type RabbitMQOption struct {
 	SourceExchange string `json:"source_exchange"`
 }
 
+// ChannelOption defines the options for a notification channel, such as webhook or IM robot.
+type ChannelOption struct {
+	// Name is the unique name of the channel, used as the key of notification results, rtx and mail are reserved.
+	Name string `json:"name"`
+	// Type is the channel type, one of webhook, wecom, feishu, dingtalk and slack.
+	Type string `json:"type"`
+	// URL is the webhook address of the channel.
+	URL string `json:"url"`
+	// Secret is used to sign the request, optional.
+	Secret string `json:"secret"`
+	// Domains limits the channel to events of these domains, empty means all domains.
+	Domains []string `json:"domains"`
+	// Templates limits the channel to messages of these push templates, empty means all templates.
+	Templates []string `json:"templates"`
+	// Timeout is the request timeout in seconds.
+	Timeout int `json:"timeout"`
+}
+
 // ClientConfig config for client
 type ClientConfig struct {
 	ClientCert string `json:"clientcert"`
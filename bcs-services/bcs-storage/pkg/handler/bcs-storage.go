@@ -38,6 +38,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-storage/pkg/util"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-storage/storage/actions/lib"
 	dynamic2 "github.com/Tencent/bk-bcs/bcs-services/bcs-storage/storage/actions/v1http/dynamic"
+	storageErr "github.com/Tencent/bk-bcs/bcs-services/bcs-storage/storage/errors"
 )
 
 // Storage storage struct, which include operations of alarm,
@@ -2028,6 +2029,15 @@ func (s *Storage) WatchDynamic(ctx context.Context, req *storage.WatchDynamicReq
 
 	event, err := dynamicwatch.HandlerWatchDynamic(ctx, req)
 	if err != nil {
+		// resourceVersion is too old, notify client to re-list
+		if errors.Is(err, storageErr.ResourceVersionExpired) {
+			v := &storage.WatchDynamicResponse{}
+			if err = util.StructToStruct(lib.EventWatchExpired, v, "WatchDynamic"); err == nil {
+				_ = stream.SendMsg(v)
+			}
+			blog.Infof("stop watch by expired resourceVersion. clusterId: '%s'", req.ClusterId)
+			return nil
+		}
 		_ = stream.SendMsg(
 			&storage.WatchDynamicResponse{
 				Type: -1,
@@ -2084,6 +2094,15 @@ func (s *Storage) WatchContainer(ctx context.Context, req *storage.WatchContaine
 
 	event, err := dynamicwatch.HandlerWatchContainer(ctx, req)
 	if err != nil {
+		// resourceVersion is too old, notify client to re-list
+		if errors.Is(err, storageErr.ResourceVersionExpired) {
+			v := &storage.WatchContainerResponse{}
+			if err = util.StructToStruct(lib.EventWatchExpired, v, "WatchContainer"); err == nil {
+				_ = stream.SendMsg(v)
+			}
+			blog.Infof("stop watch by expired resourceVersion. clusterId: '%s'", req.ClusterId)
+			return nil
+		}
 		_ = stream.SendMsg(
 			&storage.WatchContainerResponse{
 				Type: -1,
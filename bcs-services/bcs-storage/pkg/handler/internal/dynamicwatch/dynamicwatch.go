@@ -27,11 +27,13 @@ func HandlerWatchDynamic(ctx context.Context, req *storage.WatchDynamicRequest)
 	cond := req.Option.Cond.AsMap()
 	cond[constants.ClusterIDTag] = req.ClusterId
 	watchOption := &lib.StoreWatchOption{
-		Cond:      cond,
-		SelfOnly:  req.Option.SelfOnly,
-		MaxEvents: uint(req.Option.MaxEvents),
-		Timeout:   req.Option.Timeout.AsDuration(),
-		MustDiff:  req.Option.MustDiff,
+		Cond:            cond,
+		SelfOnly:        req.Option.SelfOnly,
+		MaxEvents:       uint(req.Option.MaxEvents),
+		Timeout:         req.Option.Timeout.AsDuration(),
+		MustDiff:        req.Option.MustDiff,
+		ResourceVersion: req.Option.ResourceVersion,
+		AllowBookmarks:  req.Option.AllowBookmarks,
 	}
 	return dynamicwatch.GetStore().Watch(ctx, req.ResourceType, watchOption)
 }
@@ -41,11 +43,13 @@ func HandlerWatchContainer(ctx context.Context, req *storage.WatchContainerReque
 	cond := req.Option.Cond.AsMap()
 	cond[constants.ClusterIDTag] = req.ClusterId
 	watchOption := &lib.StoreWatchOption{
-		Cond:      cond,
-		SelfOnly:  req.Option.SelfOnly,
-		MaxEvents: uint(req.Option.MaxEvents),
-		Timeout:   req.Option.Timeout.AsDuration(),
-		MustDiff:  req.Option.MustDiff,
+		Cond:            cond,
+		SelfOnly:        req.Option.SelfOnly,
+		MaxEvents:       uint(req.Option.MaxEvents),
+		Timeout:         req.Option.Timeout.AsDuration(),
+		MustDiff:        req.Option.MustDiff,
+		ResourceVersion: req.Option.ResourceVersion,
+		AllowBookmarks:  req.Option.AllowBookmarks,
 	}
 	return dynamicwatch.GetStore().Watch(ctx, constants.ContainerInfo, watchOption)
 }
@@ -8401,8 +8401,9 @@ type WatchDynamicResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  int32           `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	Value *_struct.Struct `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Type            int32           `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value           *_struct.Struct `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	ResourceVersion string          `protobuf:"bytes,5,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
 }
 
 func (x *WatchDynamicResponse) Reset() {
@@ -8451,6 +8452,13 @@ func (x *WatchDynamicResponse) GetValue() *_struct.Struct {
 	return nil
 }
 
+func (x *WatchDynamicResponse) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
 type WatchContainerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -8511,8 +8519,9 @@ type WatchContainerResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  int32           `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	Value *_struct.Struct `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Type            int32           `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value           *_struct.Struct `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	ResourceVersion string          `protobuf:"bytes,5,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
 }
 
 func (x *WatchContainerResponse) Reset() {
@@ -8561,6 +8570,13 @@ func (x *WatchContainerResponse) GetValue() *_struct.Struct {
 	return nil
 }
 
+func (x *WatchContainerResponse) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
 type EventExtraInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -9224,11 +9240,13 @@ type StoreWatchOption struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cond      *_struct.Struct    `protobuf:"bytes,1,opt,name=cond,proto3" json:"cond,omitempty"`
-	SelfOnly  bool               `protobuf:"varint,2,opt,name=selfOnly,proto3" json:"selfOnly,omitempty"`
-	MaxEvents uint64             `protobuf:"varint,3,opt,name=maxEvents,proto3" json:"maxEvents,omitempty"`
-	Timeout   *duration.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	MustDiff  string             `protobuf:"bytes,5,opt,name=mustDiff,proto3" json:"mustDiff,omitempty"`
+	Cond            *_struct.Struct    `protobuf:"bytes,1,opt,name=cond,proto3" json:"cond,omitempty"`
+	SelfOnly        bool               `protobuf:"varint,2,opt,name=selfOnly,proto3" json:"selfOnly,omitempty"`
+	MaxEvents       uint64             `protobuf:"varint,3,opt,name=maxEvents,proto3" json:"maxEvents,omitempty"`
+	Timeout         *duration.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	MustDiff        string             `protobuf:"bytes,5,opt,name=mustDiff,proto3" json:"mustDiff,omitempty"`
+	ResourceVersion string             `protobuf:"bytes,6,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	AllowBookmarks  bool               `protobuf:"varint,7,opt,name=allowBookmarks,proto3" json:"allowBookmarks,omitempty"`
 }
 
 func (x *StoreWatchOption) Reset() {
@@ -9298,6 +9316,20 @@ func (x *StoreWatchOption) GetMustDiff() string {
 	return ""
 }
 
+func (x *StoreWatchOption) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+func (x *StoreWatchOption) GetAllowBookmarks() bool {
+	if x != nil {
+		return x.AllowBookmarks
+	}
+	return false
+}
+
 type WatchEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -11908,17 +11940,17 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x04, 0x0a, 0x05, 0x41, 0x6c,
 	0x61, 0x72, 0x6d, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83,
-	0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef,
-	0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b,
-	0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18,
-	0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d,
-	0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53,
+	0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7,
+	0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba,
+	0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x32, 0x0f, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04,
+	0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
 	0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9,
-	0xba, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x32, 0x0c, 0xe5, 0x91, 0xbd,
+	0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
 	0x34, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x0c,
 	0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65,
@@ -11926,9 +11958,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90,
 	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0xa8, 0xa1,
-	0xe5, 0x9d, 0x97, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x74,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x0c, 0xe5,
+	0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0xa8, 0xa1, 0xe5, 0x9d, 0x97, 0x2a, 0x06, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x74,
 	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x2a, 0x04,
 	0x74, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe7, 0xb1, 0xbb, 0xe5,
 	0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65,
@@ -11949,8 +11981,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe,
 	0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42,
 	0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72,
-	0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c,
+	0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30,
+	0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x09, 0x63, 0x6c,
 	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
 	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a,
 	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5,
@@ -11977,20 +12009,20 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42,
 	0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x0f, 0xe4, 0xb8, 0x8a, 0xe6,
 	0x8a, 0xa5, 0xe7, 0x9a, 0x84, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x3a, 0x62, 0x92, 0x41, 0x5f, 0x0a, 0x5d, 0x2a, 0x10, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c,
-	0x61, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x31, 0xe6, 0x8e, 0xa5, 0xe6,
-	0x94, 0xb6, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xe8, 0xaf,
-	0xb7, 0xe6, 0xb1, 0x82, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe4, 0xbf, 0x9d, 0xe5, 0xad, 0x98,
-	0xe5, 0x88, 0xb0, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0xe4, 0xb8, 0xad, 0xd2, 0x01, 0x09,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xbd, 0x02, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c,
+	0x61, 0x3a, 0x62, 0x92, 0x41, 0x5f, 0x0a, 0x5d, 0x32, 0x31, 0xe6, 0x8e, 0xa5, 0xe6, 0x94, 0xb6,
+	0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xe8, 0xaf, 0xb7, 0xe6,
+	0xb1, 0x82, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe4, 0xbf, 0x9d, 0xe5, 0xad, 0x98, 0xe5, 0x88,
+	0xb0, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0xe4, 0xb8, 0xad, 0xd2, 0x01, 0x09, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2a, 0x10, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbd, 0x02, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c,
 	0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63,
 	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04,
 	0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8,
 	0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41,
-	0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5,
-	0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07,
+	0x1d, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4,
+	0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
 	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
 	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65,
 	0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6,
@@ -11999,27 +12031,27 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
 	0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95,
 	0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x49, 0x92, 0x41, 0x46, 0x0a,
-	0x44, 0x2a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x17, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x61, 0x6c, 0x61, 0x72,
-	0x6d, 0xe4, 0xbf, 0x9d, 0xe5, 0xad, 0x98, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd2, 0x05, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x44, 0x32, 0x17, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0xe4, 0xbf,
+	0x9d, 0xe5, 0xad, 0x98, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x2a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd2, 0x05, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
 	0x61, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c,
 	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92,
-	0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42,
-	0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b,
-	0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4,
-	0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa,
-	0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61,
-	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52,
+	0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf,
+	0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0,
+	0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58,
+	0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa,
+	0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x52,
 	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e, 0x61,
 	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92,
 	0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5,
 	0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xfa, 0x42, 0x04, 0x72, 0x02,
 	0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x31, 0x0a,
 	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92,
-	0x41, 0x16, 0x2a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0x8a, 0xe8,
-	0xad, 0xa6, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x41, 0x16, 0x32, 0x0c, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90,
+	0x2a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x12, 0x31, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x32, 0x0c, 0xe5,
 	0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0xa8, 0xa1, 0xe5, 0x9d, 0x97, 0x52, 0x06, 0x6d, 0x6f, 0x64,
@@ -12027,8 +12059,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe5, 0x91,
 	0x8a, 0xe8, 0xad, 0xa6, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
 	0x12, 0x31, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
-	0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x32, 0x0c, 0xe9,
-	0xa2, 0x9d, 0xe5, 0xa4, 0x96, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x0c, 0xe9, 0xa2, 0x9d, 0xe5, 0xa4, 0x96, 0xe5, 0xad, 0x97,
+	0xe6, 0xae, 0xb5, 0x2a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65,
 	0x6c, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e,
 	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x74, 0x69, 0x6d,
 	0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x32, 0x0c, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe6, 0x97,
@@ -12041,9 +12073,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x65, 0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb,
 	0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
 	0x65, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87,
-	0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a,
+	0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99,
+	0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a,
 	0x49, 0x92, 0x41, 0x46, 0x0a, 0x44, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x61, 0x72,
 	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x18, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
 	0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xe8, 0xaf, 0xb7, 0xe6,
@@ -12066,9 +12098,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
 	0x47, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae,
-	0xb5, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x3a, 0x50, 0x92, 0x41, 0x4d, 0x0a, 0x4b, 0x2a,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x0c, 0xe6, 0x89,
+	0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x2a, 0x05, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x3a, 0x50, 0x92, 0x41, 0x4d, 0x0a, 0x4b, 0x2a,
 	0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x32, 0x1e, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6,
 	0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6,
@@ -12076,16 +12108,16 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe3, 0x01, 0x0a, 0x0d, 0x43,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x7e, 0x0a, 0x09,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32,
-	0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8,
-	0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc,
-	0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58,
-	0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d,
+	0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4,
+	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c,
+	0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53,
+	0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d,
 	0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53,
 	0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x07,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92,
-	0x41, 0x11, 0x2a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x06, 0xe6, 0x9c, 0x8d,
-	0xe5, 0x8a, 0xa1, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x22, 0x92, 0x41,
+	0x41, 0x11, 0x32, 0x06, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x2a, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x22, 0x92, 0x41,
 	0x1f, 0x0a, 0x1d, 0x2a, 0x0d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x32, 0x0c, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae,
 	0x22, 0x8f, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43,
@@ -12095,12 +12127,12 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8,
 	0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc,
 	0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58,
-	0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d,
-	0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53,
-	0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x07,
+	0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10,
+	0x02, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x07,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92,
-	0x41, 0x11, 0x2a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x06, 0xe6, 0x9c, 0x8d,
-	0xe5, 0x8a, 0xa1, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x44, 0x92, 0x41,
+	0x41, 0x11, 0x32, 0x06, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x2a, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x44, 0x92, 0x41,
 	0x41, 0x0a, 0x3f, 0x2a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x18, 0xe6, 0x9f,
 	0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae,
@@ -12114,17 +12146,17 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x0f, 0x92,
 	0x41, 0x0c, 0x2a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x32, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x52, 0x04,
-	0x63, 0x6f, 0x6e, 0x66, 0x3a, 0x1e, 0x92, 0x41, 0x1b, 0x0a, 0x19, 0x2a, 0x09, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x32, 0x0c, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0x9b,
-	0x86, 0xe5, 0x90, 0x88, 0x22, 0x89, 0x02, 0x0a, 0x0a, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x63, 0x6f, 0x6e, 0x66, 0x3a, 0x1e, 0x92, 0x41, 0x1b, 0x0a, 0x19, 0x32, 0x0c, 0xe9, 0x85, 0x8d,
+	0xe7, 0xbd, 0xae, 0xe9, 0x9b, 0x86, 0xe5, 0x90, 0x88, 0x2a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x53, 0x65, 0x74, 0x22, 0x89, 0x02, 0x0a, 0x0a, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
 	0x53, 0x65, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83,
 	0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef,
 	0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b,
-	0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18,
-	0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d,
-	0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x32, 0x0f, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04,
+	0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
 	0x72, 0x49, 0x64, 0x12, 0x5a, 0x0a, 0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x6f,
 	0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x42, 0x20,
@@ -12135,13 +12167,13 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x65, 0x74, 0x32, 0x0c, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe9, 0x9b, 0x86, 0xe5, 0x90, 0x88,
 	0x22, 0xcf, 0x02, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x32, 0x06, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x06, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x2a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
 	0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x42, 0x20, 0x92, 0x41,
-	0x1d, 0x2a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x32, 0x0c, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x0d,
+	0x1d, 0x32, 0x0c, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x2a,
+	0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x48, 0x0a,
 	0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
@@ -12157,8 +12189,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xbd, 0xae, 0x22, 0xd0, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
 	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92,
-	0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
-	0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x41, 0x17, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf,
+	0xe7, 0xa0, 0x81, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
 	0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12,
 	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6,
@@ -12168,8 +12200,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3c,
 	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
 	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06,
-	0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x57, 0x92, 0x41,
+	0x66, 0x69, 0x67, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae,
+	0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x57, 0x92, 0x41,
 	0x54, 0x0a, 0x52, 0x2a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1e, 0xe8,
 	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe,
@@ -12183,15 +12215,15 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x6b, 0x49, 0x50, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x16, 0x92, 0x41, 0x13, 0x2a, 0x04,
 	0x7a, 0x6b, 0x49, 0x50, 0x32, 0x0b, 0x7a, 0x6b, 0x20, 0x49, 0x50, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d,
 	0x80, 0x52, 0x04, 0x7a, 0x6b, 0x49, 0x50, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x50, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x2a, 0x08,
-	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x50, 0x32, 0x0f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x20, 0x49, 0x50, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x50, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x32, 0x0f,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x20, 0x49, 0x50, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x2a,
+	0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x50, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65,
 	0x72, 0x49, 0x50, 0x12, 0x2e, 0x0a, 0x05, 0x64, 0x6e, 0x73, 0x49, 0x50, 0x18, 0x04, 0x20, 0x03,
 	0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x64, 0x6e, 0x73, 0x49, 0x50, 0x32, 0x0c,
 	0x64, 0x6e, 0x73, 0x20, 0x49, 0x50, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x52, 0x05, 0x64, 0x6e,
 	0x73, 0x49, 0x50, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x32, 0x06, 0xe5, 0x9f,
-	0x8e, 0xe5, 0xb8, 0x82, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x08, 0x6a, 0x66,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe5, 0x9f, 0x8e, 0xe5, 0xb8, 0x82, 0x2a, 0x04,
+	0x63, 0x69, 0x74, 0x79, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x08, 0x6a, 0x66,
 	0x72, 0x6f, 0x67, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41,
 	0x0a, 0x2a, 0x08, 0x6a, 0x66, 0x72, 0x6f, 0x67, 0x55, 0x72, 0x6c, 0x52, 0x08, 0x6a, 0x66, 0x72,
 	0x6f, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x26, 0x0a, 0x07, 0x6e, 0x65, 0x65, 0x64, 0x4e, 0x61, 0x74,
@@ -12230,12 +12262,12 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x72, 0x6c, 0x12, 0x26, 0x0a, 0x07, 0x6e, 0x65, 0x65, 0x64, 0x4e, 0x61, 0x74, 0x18, 0x09, 0x20,
 	0x01, 0x28, 0x08, 0x42, 0x0c, 0x92, 0x41, 0x09, 0x2a, 0x07, 0x6e, 0x65, 0x65, 0x64, 0x4e, 0x61,
 	0x74, 0x52, 0x07, 0x6e, 0x65, 0x65, 0x64, 0x4e, 0x61, 0x74, 0x3a, 0x57, 0x92, 0x41, 0x54, 0x0a,
-	0x52, 0x2a, 0x17, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x21, 0xe5, 0x88, 0x9b, 0xe5,
-	0xbb, 0xba, 0xe6, 0x88, 0x96, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe,
-	0xa4, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x07, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x22, 0xd9, 0x02, 0x0a, 0x18, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x52, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2a, 0x17, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x32, 0x21, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x88, 0x96, 0xe4, 0xbf, 0xae, 0xe6, 0x94,
+	0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe8, 0xaf, 0xb7,
+	0xe6, 0xb1, 0x82, 0x22, 0xd9, 0x02, 0x0a, 0x18, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
 	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a,
 	0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
@@ -12245,8 +12277,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1,
 	0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06,
 	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41,
-	0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
-	0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x16, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x2a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
 	0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
 	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
@@ -12273,15 +12305,15 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
 	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x76, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
 	0x49, 0x64, 0x4e, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x52, 0x92, 0x41, 0x4f,
-	0x2a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x4e, 0x6f, 0x74, 0x32, 0x3f,
-	0xe4, 0xb8, 0x8e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xe4, 0xba, 0x92, 0xe6,
-	0x96, 0xa5, 0xef, 0xbc, 0x8c, 0xe8, 0x8b, 0xa5, 0xef, 0xbc, 0x8c, 0xe4, 0xbd, 0xbf, 0xe7, 0x94,
-	0xa8, 0xe6, 0x9c, 0xac, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0xe8, 0xaf, 0xb7, 0xe5, 0x8b, 0xbf,
-	0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x52,
+	0x32, 0x3f, 0xe4, 0xb8, 0x8e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xe4, 0xba,
+	0x92, 0xe6, 0x96, 0xa5, 0xef, 0xbc, 0x8c, 0xe8, 0x8b, 0xa5, 0xef, 0xbc, 0x8c, 0xe4, 0xbd, 0xbf,
+	0xe7, 0x94, 0xa8, 0xe6, 0x9c, 0xac, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0xe8, 0xaf, 0xb7, 0xe5,
+	0x8b, 0xbf, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x2a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x4e, 0x6f, 0x74, 0x52,
 	0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x4e, 0x6f, 0x74, 0x12, 0x2e, 0x0a,
 	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
-	0x92, 0x41, 0x11, 0x2a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x06, 0xe6, 0x9c,
-	0x8d, 0xe5, 0x8a, 0xa1, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x42, 0x92,
+	0x92, 0x41, 0x11, 0x32, 0x06, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x2a, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x42, 0x92,
 	0x41, 0x3f, 0x0a, 0x3d, 0x2a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x18, 0xe6,
 	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe9, 0x85, 0x8d, 0xe7, 0xbd,
@@ -12289,8 +12321,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x65, 0x22, 0xd0, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
 	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41,
-	0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9,
-	0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a,
+	0x17, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7,
+	0xa0, 0x81, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a,
 	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8,
 	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
@@ -12300,24 +12332,24 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3c, 0x0a,
 	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74,
 	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6,
-	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x57, 0x92, 0x41, 0x54,
-	0x0a, 0x52, 0x2a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1e, 0xe8, 0xbf,
-	0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae,
-	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x9d, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62,
+	0x69, 0x67, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x57, 0x92, 0x41, 0x54,
+	0x0a, 0x52, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x18, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1e, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x9c, 0x8d, 0xe5,
+	0x8a, 0xa1, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb,
+	0x93, 0xe6, 0x9e, 0x9c, 0x22, 0x9d, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62,
 	0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x3b, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x21, 0x92, 0x41, 0x17, 0x2a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32,
 	0x0c, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xfa, 0x42, 0x04,
 	0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x45, 0x92,
-	0x41, 0x42, 0x0a, 0x40, 0x2a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1b, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xa8, 0xb3, 0xe5, 0xae, 0x9a, 0xe7, 0x89, 0x88, 0xe6, 0x9c,
-	0xac, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe5, 0xa4, 0xb4, 0xd2, 0x01, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x22, 0xb9, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62,
+	0x41, 0x42, 0x0a, 0x40, 0xd2, 0x01, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2a, 0x17,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1b, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7,
+	0xa8, 0xb3, 0xe5, 0xae, 0x9a, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1,
+	0x82, 0xe5, 0xa4, 0xb4, 0x22, 0xb9, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62,
 	0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
 	0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5,
@@ -12331,12 +12363,12 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
 	0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
 	0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d,
-	0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x57, 0x92, 0x41, 0x54, 0x0a, 0x52, 0x2a, 0x18,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1e, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
-	0xe7, 0xa8, 0xb3, 0xe5, 0xae, 0x9a, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe6, 0x9f, 0xa5, 0xe8,
-	0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2,
-	0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x57, 0x92, 0x41, 0x54, 0x0a, 0x52, 0x32, 0x1e,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xa8, 0xb3, 0xe5, 0xae, 0x9a, 0xe7, 0x89, 0x88, 0xe6,
+	0x9c, 0xac, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0xd2,
+	0x01, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x22, 0xd8, 0x01, 0x0a, 0x17, 0x50, 0x75, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65,
 	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92,
@@ -12356,9 +12388,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64,
 	0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7,
 	0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9,
-	0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x12,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6,
+	0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
 	0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52,
@@ -12376,9 +12408,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85,
 	0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49,
 	0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53,
-	0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10,
-	0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
-	0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18,
+	0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d,
+	0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
 	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
 	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e,
 	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d,
@@ -12389,28 +12421,28 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41,
-	0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x32,
-	0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x0c, 0x72,
+	0x1c, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x2a,
+	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0c, 0x72,
 	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x18, 0x92, 0x41, 0x15,
 	0x0a, 0x13, 0x2a, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x32, 0x06, 0xe8,
 	0xb5, 0x84, 0xe6, 0xba, 0x90, 0x22, 0xf0, 0x06, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d,
 	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86,
-	0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4,
-	0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43,
-	0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b,
-	0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
-	0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30,
+	0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9,
+	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f,
+	0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58,
+	0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b,
+	0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b,
+	0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
 	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x09,
 	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90,
 	0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09,
 	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0x26, 0x92, 0x41, 0x1c, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5,
+	0x9e, 0x8b, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
 	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c,
@@ -12418,8 +12450,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xfa, 0x42, 0x04, 0x72,
 	0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d,
 	0x65, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x32, 0x0c, 0xe6, 0x89,
-	0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72,
+	0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97,
+	0xe6, 0xae, 0xb5, 0x2a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72,
 	0x61, 0x12, 0x43, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
 	0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0d, 0x6c,
 	0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x09, 0xe9, 0x80,
@@ -12441,22 +12473,22 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x66, 0x69,
 	0x65, 0x6c, 0x64, 0x73, 0x32, 0x0c, 0xe9, 0xa2, 0x9d, 0xe5, 0xa4, 0x96, 0xe5, 0xad, 0x97, 0xe6,
 	0xae, 0xb5, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x3a, 0x7c, 0x92, 0x41, 0x79, 0x0a,
-	0x77, 0x2a, 0x1c, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32,
-	0x21, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba,
-	0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe5,
-	0xa4, 0xb4, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01,
-	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xe2, 0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74,
+	0x77, 0x32, 0x21, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7,
+	0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1,
+	0x82, 0xe5, 0xa4, 0xb4, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x0c, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x2a, 0x1c, 0x47, 0x65, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe2, 0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74,
 	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f,
 	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63,
 	0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf,
 	0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d,
-	0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
-	0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d,
+	0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf,
+	0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73,
 	0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
@@ -12465,12 +12497,12 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
 	0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0,
 	0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x62, 0x92, 0x41, 0x5f, 0x0a, 0x5d,
-	0x2a, 0x1d, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
-	0x24, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba,
-	0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7,
-	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf9, 0x04,
+	0x32, 0x24, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9,
+	0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
+	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x1d,
+	0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf9, 0x04,
 	0x0a, 0x1c, 0x50, 0x75, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e,
 	0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
@@ -12486,9 +12518,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xfa,
 	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
 	0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6,
-	0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x32, 0x0c, 0xe8, 0xb5,
+	0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
 	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a,
 	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
@@ -12500,22 +12532,22 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xae, 0xb5, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x44, 0x0a, 0x04, 0x64, 0x61, 0x74,
 	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x42, 0x17, 0x92, 0x41, 0x14, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x0c, 0xe5, 0x8a, 0xa8,
-	0xe6, 0x80, 0x81, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a,
-	0x87, 0x01, 0x92, 0x41, 0x83, 0x01, 0x0a, 0x80, 0x01, 0x2a, 0x1c, 0x50, 0x75, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x2a, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6,
-	0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9,
-	0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82,
-	0xe5, 0xa4, 0xb4, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2,
-	0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xeb, 0x02, 0x0a, 0x1d, 0x50, 0x75,
+	0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x0c, 0xe5, 0x8a, 0xa8, 0xe6, 0x80, 0x81, 0xe6, 0x95, 0xb0,
+	0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a,
+	0x87, 0x01, 0x92, 0x41, 0x83, 0x01, 0x0a, 0x80, 0x01, 0x32, 0x2a, 0xe4, 0xbf, 0xae, 0xe6, 0x94,
+	0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d,
+	0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6,
+	0xb1, 0x82, 0xe5, 0xa4, 0xb4, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x0c,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x2a, 0x1c, 0x50, 0x75, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xeb, 0x02, 0x0a, 0x1d, 0x50, 0x75,
 	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8,
-	0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x0f,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x2a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41,
 	0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5,
 	0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07,
@@ -12525,15 +12557,15 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61,
 	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95,
-	0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x6b, 0x92, 0x41, 0x68, 0x0a,
-	0x66, 0x2a, 0x1d, 0x50, 0x75, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0x2d, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9,
-	0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9,
-	0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2,
-	0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xfd, 0x03, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x6b, 0x92, 0x41, 0x68, 0x0a,
+	0x66, 0x32, 0x2d, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7,
+	0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe4, 0xbf, 0xae, 0xe6, 0x94,
+	0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+	0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x1d, 0x50, 0x75, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfd, 0x03, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65,
 	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60,
@@ -12541,8 +12573,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9,
 	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f,
 	0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58,
-	0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d,
+	0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02,
 	0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e,
 	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23,
 	0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c,
@@ -12575,45 +12607,45 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94,
 	0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52,
 	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93,
-	0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64,
+	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x0c, 0xe8,
+	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x2a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64,
 	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6,
-	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x65, 0x92, 0x41, 0x62,
-	0x0a, 0x60, 0x2a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x24, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0x91, 0xbd, 0xe5,
-	0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x88,
-	0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x9a, 0x06, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x65, 0x92, 0x41, 0x62,
+	0x0a, 0x60, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x20, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x24, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4,
+	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xbb, 0x93, 0xe6,
+	0x9e, 0x9c, 0x22, 0x9a, 0x06, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
 	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c,
 	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9,
 	0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44,
 	0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d,
-	0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02,
-	0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d,
-	0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64,
+	0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b,
+	0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
 	0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61,
 	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7,
 	0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61,
 	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92,
-	0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xfa, 0x42,
+	0x41, 0x1c, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xfa, 0x42,
 	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
 	0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x32, 0x0c,
 	0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x65, 0x78,
 	0x74, 0x72, 0x61, 0x12, 0x43, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a,
-	0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x09,
-	0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32,
+	0x09, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0x2a, 0x0d, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c,
 	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x55, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01,
 	0x28, 0x03, 0x42, 0x29, 0x92, 0x41, 0x26, 0x2a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
@@ -12644,9 +12676,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94,
 	0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f,
 	0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4,
-	0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31,
+	0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
+	0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31,
 	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19,
 	0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94,
 	0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
@@ -12669,17 +12701,17 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4,
 	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c,
 	0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53,
-	0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32,
-	0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24,
-	0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53,
+	0x2d, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
 	0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9,
-	0x97, 0xb4, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x19, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90,
+	0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
 	0x70, 0x61, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a,
-	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8,
-	0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x32,
+	0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x2a, 0x0c, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xfa, 0x42, 0x04, 0x72, 0x02,
 	0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
 	0x12, 0x52, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x65,
 	0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x28, 0x92, 0x41, 0x25, 0x2a, 0x0f,
@@ -12688,17 +12720,17 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xe9, 0x97, 0xb4, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42,
 	0x65, 0x67, 0x69, 0x6e, 0x12, 0x4c, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69,
 	0x6d, 0x65, 0x45, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x26, 0x92, 0x41, 0x23,
-	0x2a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x32,
-	0x12, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6,
-	0xe9, 0x97, 0xb4, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45,
-	0x6e, 0x64, 0x3a, 0x77, 0x92, 0x41, 0x74, 0x0a, 0x72, 0x2a, 0x23, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x24,
-	0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0x91, 0xbd, 0xe5,
-	0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf,
-	0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x0c, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xf6, 0x02, 0x0a, 0x24,
+	0x32, 0x12, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97,
+	0xb6, 0xe9, 0x97, 0xb4, 0x2a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x45, 0x6e, 0x64, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45,
+	0x6e, 0x64, 0x3a, 0x77, 0x92, 0x41, 0x74, 0x0a, 0x72, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x2a, 0x23, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x24, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0,
+	0xe9, 0x99, 0xa4, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8,
+	0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x22, 0xf6, 0x02, 0x0a, 0x24,
 	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73,
 	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
@@ -12715,27 +12747,27 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a,
 	0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x3a, 0x6f, 0x92, 0x41, 0x6c, 0x0a, 0x6a, 0x2a, 0x24, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0x2a, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9,
-	0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f,
-	0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x94, 0x06, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x61, 0x74, 0x61, 0x3a, 0x6f, 0x92, 0x41, 0x6c, 0x0a, 0x6a, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x2a, 0x24, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x2a, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
+	0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6,
+	0xba, 0x90, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xbb,
+	0x93, 0xe6, 0x9e, 0x9c, 0x22, 0x94, 0x06, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
 	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83,
 	0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef,
 	0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b,
-	0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18,
-	0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d,
-	0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64, 0x32,
+	0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24,
+	0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
 	0x72, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5,
-	0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x32, 0x0c,
+	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x2a, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
 	0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
 	0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f,
@@ -12759,24 +12791,24 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x66, 0x73, 0x65, 0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7,
 	0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66,
 	0x66, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32,
-	0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0,
-	0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
+	0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x2a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
 	0x74, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
 	0x09, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x32, 0x0c,
 	0xe9, 0xa2, 0x9d, 0xe5, 0xa4, 0x96, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x06, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x3a, 0x65, 0x92, 0x41, 0x62, 0x0a, 0x60, 0x2a, 0x1a, 0x47, 0x65, 0x74,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x18, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9,
-	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1,
-	0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x0c,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd8, 0x02, 0x0a, 0x1b,
+	0x65, 0x6c, 0x64, 0x73, 0x3a, 0x65, 0x92, 0x41, 0x62, 0x0a, 0x60, 0xd2, 0x01, 0x09, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x2a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x32, 0x18, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8,
+	0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x22, 0xd8, 0x02, 0x0a, 0x1b,
 	0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8,
-	0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x0f,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x2a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41,
 	0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5,
 	0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07,
@@ -12800,9 +12832,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85,
 	0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49,
 	0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53,
-	0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10,
-	0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
-	0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x32,
+	0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24,
+	0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
 	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c,
 	0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c,
@@ -12820,14 +12852,14 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
 	0x72, 0x75, 0x63, 0x74, 0x42, 0x17, 0x92, 0x41, 0x14, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
 	0x0c, 0xe5, 0x8a, 0xa8, 0xe6, 0x80, 0x81, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x3a, 0x6e, 0x92, 0x41, 0x6b, 0x0a, 0x69, 0x2a, 0x1a, 0x50, 0x75, 0x74, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x21, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x88,
-	0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84,
-	0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0xe1, 0x02, 0x0a, 0x1b, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x61, 0x74, 0x61, 0x3a, 0x6e, 0x92, 0x41, 0x6b, 0x0a, 0x69, 0x32, 0x21, 0xe4, 0xbf, 0xae, 0xe6,
+	0x94, 0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe9, 0x9b, 0x86, 0xe7, 0xbe,
+	0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x2a, 0x1a, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xe1, 0x02, 0x0a, 0x1b, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
 	0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf,
@@ -12843,22 +12875,22 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04,
 	0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x3a, 0x63, 0x92, 0x41, 0x60, 0x0a, 0x5e, 0x2a, 0x1b, 0x50, 0x75, 0x74, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x27, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x9b,
-	0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9,
-	0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2,
-	0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa1, 0x03, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x61, 0x3a, 0x63, 0x92, 0x41, 0x60, 0x0a, 0x5e, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x2a, 0x1b, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x27,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6,
+	0xba, 0x90, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2,
+	0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x22, 0xa1, 0x03, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65,
 	0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41,
-	0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43,
-	0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86,
-	0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8,
-	0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42,
-	0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d,
-	0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09,
+	0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7,
+	0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc,
+	0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58,
+	0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42,
+	0x1d, 0x72, 0x1b, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x52, 0x09,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
@@ -12866,8 +12898,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c,
-	0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0x0c,
-	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xfa, 0x42, 0x04, 0x72,
+	0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x2a, 0x0c,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0xfa, 0x42, 0x04, 0x72,
 	0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d,
 	0x65, 0x3a, 0x68, 0x92, 0x41, 0x65, 0x0a, 0x63, 0x2a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
@@ -12885,19 +12917,19 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8,
 	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
 	0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7,
-	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32,
+	0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x2a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a,
 	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
-	0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x5d, 0x92,
-	0x41, 0x5a, 0x0a, 0x58, 0x2a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1e, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x9b, 0x86, 0xe7,
-	0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xbb,
-	0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc1, 0x05, 0x0a,
+	0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d,
+	0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x5d, 0x92,
+	0x41, 0x5a, 0x0a, 0x58, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x1e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1e, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
+	0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x22, 0xc1, 0x05, 0x0a,
 	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
@@ -12905,9 +12937,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8,
 	0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc,
 	0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58,
-	0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d,
-	0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53,
-	0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0c,
+	0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64,
+	0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b,
+	0x24, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0c,
 	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb,
@@ -12926,22 +12958,22 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe4, 0xb9, 0x8b, 0xe5,
 	0x89, 0x8d, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x65,
 	0x66, 0x6f, 0x72, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9,
-	0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x23, 0x92, 0x41, 0x19, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
 	0x74, 0x12, 0x3b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
 	0x42, 0x25, 0x92, 0x41, 0x1b, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6, 0x9f,
 	0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
 	0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x31,
 	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x19,
-	0x92, 0x41, 0x16, 0x2a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x32, 0x0c, 0xe9, 0xa2, 0x9d,
-	0xe5, 0xa4, 0x96, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x3a, 0x5d, 0x92, 0x41, 0x5a, 0x0a, 0x58, 0x2a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1e, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5,
-	0xe8, 0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8,
-	0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x92, 0x41, 0x16, 0x32, 0x0c, 0xe9, 0xa2, 0x9d, 0xe5, 0xa4, 0x96, 0xe5, 0xad, 0x97, 0xe6, 0xae,
+	0xb5, 0x2a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x3a, 0x5d, 0x92, 0x41, 0x5a, 0x0a, 0x58, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x2a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x32, 0x1e, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b,
+	0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82,
 	0x22, 0xe0, 0x02, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
 	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
@@ -12952,8 +12984,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf,
 	0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a,
 	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92,
-	0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5,
-	0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x41, 0x16, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+	0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
 	0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61,
@@ -12968,10 +13000,10 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41,
-	0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43,
-	0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86,
-	0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8,
-	0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42,
+	0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7,
+	0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc,
+	0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58,
+	0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42,
 	0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d,
 	0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73,
@@ -13006,19 +13038,19 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf,
 	0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
 	0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf,
-	0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x19, 0x92, 0x41, 0x16, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6,
+	0x9e, 0x9c, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
 	0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x3a, 0x67, 0x92, 0x41, 0x64, 0x0a, 0x62, 0x2a, 0x22, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x24, 0xe8,
-	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba,
-	0x90, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xbb, 0x93,
-	0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xaf, 0x06, 0x0a, 0x19,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06,
+	0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x3a, 0x67, 0x92, 0x41, 0x64, 0x0a, 0x62, 0x32, 0x24, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe6, 0x89, 0xb9,
+	0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2,
+	0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x22, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaf, 0x06, 0x0a, 0x19,
 	0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41,
@@ -13026,12 +13058,12 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86,
 	0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8,
 	0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42,
-	0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d,
-	0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09,
+	0x1d, 0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x09,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
 	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41,
-	0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91,
-	0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x19, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x2a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
 	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c,
 	0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c,
@@ -13042,8 +13074,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6,
 	0xba, 0x90, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5,
+	0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x2a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x52,
 	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x43, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53,
 	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92,
 	0x41, 0x1a, 0x2a, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
@@ -13076,12 +13108,12 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99,
 	0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07,
 	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92,
-	0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94,
-	0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52,
+	0x41, 0x1d, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf,
+	0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
 	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93,
-	0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64,
+	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x0c, 0xe8,
+	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x2a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64,
 	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
 	0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6,
@@ -13105,20 +13137,20 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb,
 	0xe5, 0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x4f, 0x92, 0x41, 0x4c, 0x0a, 0x4a, 0x2a,
-	0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1b, 0xe5,
-	0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5,
-	0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xdf, 0x02, 0x0a, 0x1d, 0x44, 0x65,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x4f, 0x92, 0x41, 0x4c, 0x0a, 0x4a, 0xd2,
+	0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2a, 0x1c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1b, 0xe5, 0x88,
+	0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84,
+	0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x22, 0xdf, 0x02, 0x0a, 0x1d, 0x44, 0x65,
 	0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63,
 	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04,
 	0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8,
 	0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41,
-	0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5,
-	0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07,
+	0x1d, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4,
+	0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
 	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
 	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65,
 	0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6,
@@ -13136,19 +13168,19 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x50, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0x26, 0x92, 0x41, 0x1c, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5,
+	0x9e, 0x8b, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
 	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41,
 	0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x55, 0x92, 0x41, 0x52, 0x0a, 0x50, 0x2a, 0x19, 0x50, 0x75,
-	0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x24, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe6,
-	0x88, 0x96, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9,
-	0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x0c,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xe2, 0x02, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x55, 0x92, 0x41, 0x52, 0x0a, 0x50, 0x32, 0x24, 0xe6, 0x96,
+	0xb0, 0xe5, 0xa2, 0x9e, 0xe6, 0x88, 0x96, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe8, 0x87, 0xaa,
+	0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6,
+	0xb1, 0x82, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x2a, 0x19, 0x50, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe2, 0x02, 0x0a,
 	0x1a, 0x50, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63,
 	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04,
@@ -13185,37 +13217,37 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x65, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92,
-	0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x5a, 0x92, 0x41, 0x57, 0x0a, 0x55, 0x2a, 0x21, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x32, 0x21, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9,
-	0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb4, 0xa2, 0xe5, 0xbc, 0x95, 0xe8, 0xaf, 0xb7,
-	0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x22, 0xef, 0x02, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
+	0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x5a, 0x92, 0x41, 0x57, 0x0a, 0x55, 0x32, 0x21, 0xe5,
+	0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5,
+	0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb4, 0xa2, 0xe5, 0xbc, 0x95, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82,
+	0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2a,
+	0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xef, 0x02, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
 	0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65,
 	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf,
-	0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x0f, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x2a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a,
 	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
 	0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5,
+	0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
 	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42,
 	0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6,
-	0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x6a, 0x92, 0x41, 0x67, 0x0a, 0x65, 0x2a,
-	0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x32, 0x27, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe8, 0x87, 0xaa, 0xe5, 0xae,
-	0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb4, 0xa2, 0xe5, 0xbc, 0x95,
-	0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x8e, 0x02, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x6a, 0x92, 0x41, 0x67, 0x0a, 0x65, 0x32,
+	0x27, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89,
+	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb4, 0xa2, 0xe5, 0xbc, 0x95, 0xe5, 0x88, 0x9b, 0xe5,
+	0xbb, 0xba, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2,
+	0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x2a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x02, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
 	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49, 0x6e,
 	0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65,
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
@@ -13235,9 +13267,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xef, 0x02, 0x0a, 0x22, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49,
 	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99,
-	0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32,
+	0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81,
+	0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07,
 	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92,
 	0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94,
 	0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52,
@@ -13247,8 +13279,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64,
 	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6,
-	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x6a, 0x92, 0x41, 0x67,
+	0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x6a, 0x92, 0x41, 0x67,
 	0x0a, 0x65, 0x2a, 0x22, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
 	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x27, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe8, 0x87,
@@ -13262,8 +13294,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7,
 	0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba,
 	0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d,
-	0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a,
-	0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63,
+	0x72, 0x1b, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x52, 0x09, 0x63,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d,
 	0x92, 0x41, 0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32,
@@ -13283,8 +13315,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x97, 0xb4, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e,
 	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6,
+	0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25,
 	0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11,
 	0x2a, 0x03, 0x5f, 0x69, 0x64, 0x32, 0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x20, 0x69,
 	0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x2f, 0x92, 0x41, 0x2c, 0x0a, 0x2a, 0x2a, 0x0c, 0x49, 0x50,
@@ -13313,33 +13345,33 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b,
 	0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5,
-	0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x0c,
+	0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x2a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04,
+	0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04,
 	0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x07, 0x20,
 	0x03, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x32,
 	0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x66,
 	0x69, 0x65, 0x6c, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9,
-	0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x23, 0x92, 0x41, 0x19, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
 	0x74, 0x12, 0x3b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
 	0x42, 0x25, 0x92, 0x41, 0x1b, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6, 0x9f,
 	0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
 	0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x3f,
-	0x92, 0x41, 0x3c, 0x0a, 0x3a, 0x2a, 0x13, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61,
-	0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x17, 0xe6, 0x9f, 0xa5, 0xe8,
-	0xaf, 0xa2, 0xe9, 0x9d, 0x99, 0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0xe8, 0xaf, 0xb7,
-	0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x92, 0x41, 0x3c, 0x0a, 0x3a, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x2a, 0x13, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x17, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9d,
+	0x99, 0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x22,
 	0xcb, 0x02, 0x0a, 0x14, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7,
-	0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x0f, 0xe8, 0xbf, 0x94,
+	0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x2a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07,
 	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9,
 	0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73,
@@ -13350,29 +13382,29 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11,
 	0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d,
-	0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x54, 0x92, 0x41, 0x51, 0x0a, 0x4f, 0x2a, 0x14,
-	0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1d, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x9d, 0x99, 0xe6,
-	0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93,
-	0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa8, 0x04,
+	0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x54, 0x92, 0x41, 0x51, 0x0a, 0x4f, 0xd2, 0x01,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x14, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1d,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x9d, 0x99, 0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1,
+	0xa0, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x22, 0xa8, 0x04,
 	0x0a, 0x12, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65,
 	0x74, 0x61, 0x69, 0x6c, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c,
 	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9,
 	0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44,
 	0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d,
-	0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02,
-	0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d,
-	0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64,
+	0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b,
+	0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
 	0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b,
-	0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32,
+	0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x2a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92,
-	0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x0c,
+	0x41, 0x1c, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
 	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0a,
 	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
@@ -13393,11 +13425,11 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd8, 0x05, 0x0a, 0x19, 0x49, 0x50, 0x50,
 	0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86,
-	0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4,
-	0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43,
-	0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30,
+	0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9,
+	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f,
+	0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58,
+	0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b,
 	0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
 	0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
@@ -13406,8 +13438,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0c, 0x72, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0x1f, 0x92, 0x41, 0x1c, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5,
+	0x9e, 0x8b, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
 	0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d,
 	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
@@ -13431,12 +13463,12 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x2a, 0x05, 0x6c,
 	0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5,
 	0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x4b, 0x92, 0x41, 0x48, 0x0a, 0x46, 0x2a, 0x19, 0x49,
-	0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1d, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe9, 0x9d, 0x99, 0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1,
-	0xa0, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0xdd, 0x02, 0x0a, 0x1a, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x4b, 0x92, 0x41, 0x48, 0x0a, 0x46, 0x32, 0x1d, 0xe6,
+	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe9, 0x9d, 0x99, 0xe6, 0x80,
+	0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x2a, 0x19, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xdd, 0x02, 0x0a, 0x1a, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74,
 	0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
 	0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94,
@@ -13452,13 +13484,13 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64,
 	0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x3a, 0x60, 0x92, 0x41, 0x5d, 0x0a, 0x5b, 0x2a, 0x1a, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x23, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe8, 0xaf, 0xa6, 0xe7,
-	0xbb, 0x86, 0xe9, 0x9d, 0x99, 0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0xe6, 0x9f, 0xa5,
-	0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0xcb, 0x04, 0x0a, 0x03, 0x50, 0x6f, 0x64, 0x12, 0x7e, 0x0a, 0x09, 0x63,
+	0x61, 0x3a, 0x60, 0x92, 0x41, 0x5d, 0x0a, 0x5b, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2,
+	0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2a, 0x1a, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x23,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe9, 0x9d, 0x99, 0xe6,
+	0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93,
+	0xe6, 0x9e, 0x9c, 0x22, 0xcb, 0x04, 0x0a, 0x03, 0x50, 0x6f, 0x64, 0x12, 0x7e, 0x0a, 0x09, 0x63,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60,
 	0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30,
 	0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9,
@@ -13476,9 +13508,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0c, 0x72, 0x65,
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65,
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e,
-	0x8b, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb,
+	0xe5, 0x9e, 0x8b, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
 	0x3d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9,
@@ -13489,10 +13521,10 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xb4, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a,
 	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
-	0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a,
-	0x03, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a,
-	0x03, 0x5f, 0x69, 0x64, 0x32, 0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x20, 0x69, 0x64,
+	0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d,
+	0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a,
+	0x03, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32,
+	0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x20, 0x69, 0x64, 0x2a, 0x03, 0x5f, 0x69, 0x64,
 	0x52, 0x02, 0x49, 0x64, 0x3a, 0x1b, 0x92, 0x41, 0x18, 0x0a, 0x16, 0x2a, 0x03, 0x50, 0x6f, 0x64,
 	0x32, 0x03, 0x70, 0x6f, 0x64, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
 	0x64, 0x22, 0xeb, 0x05, 0x0a, 0x0a, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
@@ -13501,12 +13533,12 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd,
 	0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6,
 	0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d,
-	0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f,
-	0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a,
-	0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b,
+	0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42,
+	0x43, 0x53, 0x2d, 0x10, 0x02, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
 	0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
-	0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7,
-	0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x92, 0x41, 0x0e, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x2a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
 	0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a,
 	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5,
 	0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
@@ -13514,11 +13546,11 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x42, 0x15, 0x92, 0x41, 0x12, 0x2a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x50,
 	0x32, 0x08, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0x49, 0x50, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74,
 	0x49, 0x50, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x6f, 0x64, 0x49, 0x50, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x12, 0x92, 0x41, 0x0f, 0x2a, 0x05, 0x70, 0x6f, 0x64, 0x49, 0x50, 0x32, 0x06, 0x50,
-	0x6f, 0x64, 0x20, 0x49, 0x50, 0x52, 0x05, 0x70, 0x6f, 0x64, 0x49, 0x50, 0x12, 0x2b, 0x0a, 0x06,
+	0x09, 0x42, 0x12, 0x92, 0x41, 0x0f, 0x32, 0x06, 0x50, 0x6f, 0x64, 0x20, 0x49, 0x50, 0x2a, 0x05,
+	0x70, 0x6f, 0x64, 0x49, 0x50, 0x52, 0x05, 0x70, 0x6f, 0x64, 0x49, 0x50, 0x12, 0x2b, 0x0a, 0x06,
 	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41,
-	0x10, 0x2a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x06, 0xe7, 0x8a, 0xb6, 0xe6, 0x80,
-	0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x73, 0x74, 0x61,
+	0x10, 0x32, 0x06, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x2a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x73, 0x74, 0x61,
 	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x27, 0x92, 0x41, 0x24, 0x2a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
 	0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x32, 0x12, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe5, 0x90,
@@ -13536,40 +13568,40 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x65, 0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb,
 	0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
 	0x65, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87,
-	0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a,
-	0x2e, 0x92, 0x41, 0x2b, 0x0a, 0x29, 0x2a, 0x0a, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x70, 0x6f, 0x64, 0xe8, 0xaf, 0xb7,
-	0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99,
+	0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a,
+	0x2e, 0x92, 0x41, 0x2b, 0x0a, 0x29, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x2a, 0x0a, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x0f,
+	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x70, 0x6f, 0x64, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x22,
 	0xb1, 0x02, 0x0a, 0x0b, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92,
 	0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
 	0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
 	0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12,
-	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6,
-	0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72,
+	0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99,
+	0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72,
 	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16,
 	0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
 	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e,
 	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x43,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6,
+	0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x43,
 	0x92, 0x41, 0x40, 0x0a, 0x3e, 0x2a, 0x0b, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x32, 0x15, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x70, 0x6f, 0x64, 0xe6, 0x9f, 0xa5,
 	0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65,
 	0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x22, 0xe0, 0x04, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53,
 	0x65, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8,
-	0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc,
-	0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38,
-	0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64,
-	0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b,
-	0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5,
+	0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe,
+	0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42,
+	0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64, 0x32, 0x0f,
+	0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a,
+	0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
 	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
 	0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba,
@@ -13593,7 +13625,7 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04,
+	0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04,
 	0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x03, 0x5f, 0x69, 0x64, 0x32, 0x0a, 0x6d, 0x6f, 0x6e,
 	0x67, 0x6f, 0x64, 0x62, 0x20, 0x69, 0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x29, 0x92, 0x41, 0x26,
@@ -13606,18 +13638,18 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8,
 	0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc,
 	0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58,
-	0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d,
-	0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53,
-	0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e,
+	0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64,
+	0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b,
+	0x24, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d,
 	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9,
 	0xba, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
 	0x34, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
-	0x32, 0x09, 0xe5, 0xae, 0x9e, 0xe4, 0xbe, 0x8b, 0xe6, 0x95, 0xb0, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x09, 0xe5, 0xae, 0x9e, 0xe4, 0xbe, 0x8b, 0xe6, 0x95,
+	0xb0, 0x2a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x52, 0x08, 0x72, 0x65, 0x70,
 	0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x55, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
 	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x27, 0x92, 0x41, 0x24, 0x2a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
@@ -13630,34 +13662,34 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe5, 0xae, 0x9e, 0xe4, 0xbe, 0x8b, 0xe6, 0x95, 0xb0, 0x52, 0x0d,
 	0x72, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x52, 0x0a,
 	0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0x92, 0x41, 0x25, 0x2a, 0x0f, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x32, 0x12, 0xe5, 0xbc,
-	0x80, 0xe5, 0xa7, 0x8b, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x12, 0xe5, 0xbc, 0x80,
+	0xe5, 0xa7, 0x8b, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x2a,
+	0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e,
 	0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69,
 	0x6e, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45,
-	0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x23, 0x2a, 0x0d, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x32, 0x12, 0xe7, 0xbb,
-	0x93, 0xe6, 0x9d, 0x9f, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x12, 0xe7,
+	0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
+	0xb4, 0x2a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64,
 	0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12,
 	0x2e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18,
 	0x92, 0x41, 0x15, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5,
 	0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
 	0x3b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x23, 0x92, 0x41, 0x19, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x32, 0x0f, 0xe6, 0x9f,
-	0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04,
+	0x23, 0x92, 0x41, 0x19, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7,
+	0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0xfa, 0x42, 0x04,
 	0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x05,
 	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41, 0x1b,
 	0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9,
 	0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02,
 	0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x3c, 0x92, 0x41, 0x39, 0x0a, 0x37,
-	0x2a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x32, 0x16, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x52, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x53, 0x65, 0x74, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc6, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c,
+	0x32, 0x16, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53,
+	0x65, 0x74, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x2a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc6, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c,
 	0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
 	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41,
-	0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9,
-	0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a,
+	0x17, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7,
+	0xa0, 0x81, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a,
 	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8,
 	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
@@ -13669,20 +13701,20 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
 	0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
 	0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x51, 0x92,
-	0x41, 0x4e, 0x0a, 0x4c, 0x2a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x41, 0x4e, 0x0a, 0x4c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x12,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0x1c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x53, 0x65, 0x74, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
 	0x22, 0xe9, 0x04, 0x0a, 0x0d, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b,
 	0x38, 0x53, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73,
 	0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8,
 	0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc,
 	0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38,
-	0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64,
-	0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b,
-	0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x32, 0x0f, 0x5e, 0x5b,
+	0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42,
+	0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
 	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
 	0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba,
@@ -13696,9 +13728,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6,
 	0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b,
-	0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32,
+	0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x2a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
 	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a,
 	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5,
@@ -13706,7 +13738,7 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04,
+	0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04,
 	0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x03, 0x5f, 0x69, 0x64, 0x32, 0x0a, 0x6d, 0x6f, 0x6e,
 	0x67, 0x6f, 0x64, 0x62, 0x20, 0x69, 0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x2f, 0x92, 0x41, 0x2c,
@@ -13715,13 +13747,13 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xfc, 0x08, 0x0a,
 	0x14, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x38, 0x53, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85,
-	0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49,
-	0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53,
-	0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10,
-	0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
-	0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42,
+	0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b,
+	0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4,
+	0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a,
+	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x3a,
+	0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
 	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06,
 	0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x09,
@@ -13729,9 +13761,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32,
 	0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x6e,
 	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x2a,
-	0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbe,
-	0x8b, 0xe4, 0xb8, 0xaa, 0xe6, 0x95, 0xb0, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x32,
+	0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbe, 0x8b, 0xe4, 0xb8, 0xaa, 0xe6, 0x95, 0xb0, 0x2a, 0x08, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
 	0x73, 0x12, 0x58, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
 	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0x92, 0x41,
 	0x27, 0x2a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c,
@@ -13762,10 +13794,10 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
 	0xb4, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67,
 	0x69, 0x6e, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x45, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x23, 0x2a, 0x0d,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x32, 0x12, 0xe7,
-	0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
-	0xb4, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64,
+	0x45, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x12,
+	0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9,
+	0x97, 0xb4, 0x2a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e,
+	0x64, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64,
 	0x12, 0x3e, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61,
@@ -13774,12 +13806,12 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x32, 0x0c, 0xe6, 0x89, 0xa9,
 	0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
 	0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x32, 0x0f, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0xfa, 0x42,
+	0x42, 0x23, 0x92, 0x41, 0x19, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f,
+	0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0xfa, 0x42,
 	0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a,
 	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41,
-	0x1b, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32,
+	0x1b, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6,
+	0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0xfa, 0x42, 0x04, 0x32,
 	0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x3f, 0x92, 0x41, 0x3c, 0x0a,
 	0x3a, 0x2a, 0x14, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x38, 0x53,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x16, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64,
@@ -13808,16 +13840,16 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xdc, 0x04, 0x0a, 0x0a, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41,
-	0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43,
-	0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86,
-	0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8,
-	0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42,
+	0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7,
+	0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc,
+	0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58,
+	0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42,
 	0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d,
 	0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
 	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41,
-	0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91,
-	0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x19, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x2a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
 	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a,
 	0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88,
@@ -13841,16 +13873,16 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x03, 0x5f,
 	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x03, 0x5f,
 	0x69, 0x64, 0x32, 0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x20, 0x69, 0x64, 0x52, 0x02,
-	0x49, 0x64, 0x3a, 0x25, 0x92, 0x41, 0x22, 0x0a, 0x20, 0x2a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4b, 0x38, 0x53, 0x32, 0x06, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xd2, 0x01, 0x09,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xdc, 0x05, 0x0a, 0x11, 0x53, 0x65,
+	0x49, 0x64, 0x3a, 0x25, 0x92, 0x41, 0x22, 0x0a, 0x20, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x2a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x38,
+	0x53, 0x32, 0x06, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x22, 0xdc, 0x05, 0x0a, 0x11, 0x53, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf,
-	0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0,
-	0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58,
-	0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e,
+	0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9,
+	0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44,
+	0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d,
+	0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e,
 	0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04,
 	0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
 	0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
@@ -13866,18 +13898,18 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x74,
 	0x79, 0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70,
 	0x65, 0x12, 0x52, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42,
-	0x65, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0x92, 0x41, 0x25, 0x2a,
-	0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e,
-	0x32, 0x12, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97,
-	0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x65, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32,
+	0x12, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6,
+	0xe9, 0x97, 0xb4, 0x2a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42,
+	0x65, 0x67, 0x69, 0x6e, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
 	0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
 	0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41,
 	0x23, 0x2a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64,
 	0x32, 0x12, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97,
 	0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
 	0x45, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x03,
-	0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x32, 0x0c,
-	0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x66, 0x69,
+	0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5,
+	0xad, 0x97, 0xe6, 0xae, 0xb5, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69,
 	0x65, 0x6c, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20,
 	0x01, 0x28, 0x04, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
 	0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9, 0x87,
@@ -13886,39 +13918,39 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x25, 0x92, 0x41, 0x1b, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5,
 	0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xfa,
 	0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x39, 0x92,
-	0x41, 0x36, 0x0a, 0x34, 0x2a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x13, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc3, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x72,
+	0x41, 0x36, 0x0a, 0x34, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x2a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x32, 0x13, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x22, 0xc3, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92,
-	0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
-	0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x41, 0x17, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf,
+	0xe7, 0xa0, 0x81, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
 	0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12,
 	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6,
 	0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72,
 	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16,
-	0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
-	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e,
+	0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x2a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e,
 	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x4e,
-	0x92, 0x41, 0x4b, 0x0a, 0x49, 0x2a, 0x12, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x38,
-	0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x19, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
-	0x9e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb,
-	0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe6,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6,
+	0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x4e,
+	0x92, 0x41, 0x4b, 0x0a, 0x49, 0x32, 0x19, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+	0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x12, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe6,
 	0x04, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0x12,
 	0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf,
-	0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0,
-	0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58,
-	0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e,
-	0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04,
-	0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9,
+	0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44,
+	0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d,
+	0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d,
+	0x10, 0x02, 0x18, 0x64, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
 	0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
 	0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4,
@@ -13941,23 +13973,23 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
 	0x65, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6,
+	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
 	0x61, 0x12, 0x25, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
-	0x92, 0x41, 0x11, 0x2a, 0x03, 0x5f, 0x69, 0x64, 0x32, 0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64,
-	0x62, 0x20, 0x69, 0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x2d, 0x92, 0x41, 0x2a, 0x0a, 0x28, 0x2a,
+	0x92, 0x41, 0x11, 0x32, 0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x20, 0x69, 0x64, 0x2a,
+	0x03, 0x5f, 0x69, 0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x2d, 0x92, 0x41, 0x2a, 0x0a, 0x28, 0x2a,
 	0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0x32, 0x0c, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0xd2, 0x01, 0x09, 0x63, 0x6c,
 	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc3, 0x05, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf,
-	0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0,
-	0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58,
-	0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e,
-	0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04,
-	0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9,
+	0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44,
+	0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d,
+	0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10,
+	0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
 	0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
 	0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0,
 	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
@@ -14007,13 +14039,13 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41,
-	0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x52, 0x92, 0x41, 0x4f, 0x0a, 0x4d, 0x2a, 0x14, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0x1b, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x4d, 0x61, 0x70, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2,
-	0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2,
-	0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xda, 0x04, 0x0a, 0x09, 0x53, 0x65,
+	0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x52, 0x92, 0x41, 0x4f, 0x0a, 0x4d, 0xd2, 0x01, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x14, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61,
+	0x70, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1b, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x22, 0xda, 0x04, 0x0a, 0x09, 0x53, 0x65,
 	0x63, 0x72, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
 	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a,
 	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5,
@@ -14027,17 +14059,17 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5,
 	0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
 	0x61, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5,
-	0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x0c,
+	0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x2a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41,
 	0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32,
 	0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x0c, 0x72,
 	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x63,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a,
+	0x1d, 0x92, 0x41, 0x1a, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9,
+	0x97, 0xb4, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0a,
 	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x75, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d,
 	0x92, 0x41, 0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32,
@@ -14082,9 +14114,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6,
 	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
 	0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x0c,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5,
-	0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x0c,
+	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x2a, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41,
 	0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5,
@@ -14117,9 +14149,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
 	0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
 	0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12,
-	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6,
-	0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72,
+	0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99,
+	0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72,
 	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16,
 	0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
 	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e,
@@ -14127,32 +14159,32 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
 	0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61,
 	0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x4c,
-	0x92, 0x41, 0x49, 0x0a, 0x47, 0x2a, 0x11, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x38, 0x53,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x18, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6,
-	0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe7, 0x04, 0x0a,
+	0x92, 0x41, 0x49, 0x0a, 0x47, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a,
+	0x11, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0x18, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x22, 0xe7, 0x04, 0x0a,
 	0x0c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53, 0x12, 0x7e, 0x0a,
 	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
 	0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94,
 	0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5,
 	0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58,
-	0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30,
-	0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43,
-	0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a,
+	0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d,
+	0x10, 0x02, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a,
 	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
 	0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x52, 0x09,
 	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x72, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0c,
+	0x1d, 0x92, 0x41, 0x1a, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9,
+	0x97, 0xb4, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0c,
 	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0c,
 	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb,
-	0xe5, 0x9e, 0x8b, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7,
+	0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
 	0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
 	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97,
@@ -14166,7 +14198,7 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74,
 	0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
 	0x25, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41,
-	0x11, 0x2a, 0x03, 0x5f, 0x69, 0x64, 0x32, 0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x20,
+	0x11, 0x32, 0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x20, 0x69, 0x64, 0x2a, 0x03, 0x5f,
 	0x69, 0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x2e, 0x92, 0x41, 0x2b, 0x0a, 0x29, 0x2a, 0x0c, 0x45,
 	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53, 0x32, 0x0d, 0x6b, 0x38, 0x73,
 	0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75,
@@ -14194,8 +14226,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0xfa,
 	0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3b,
 	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0x92,
-	0x41, 0x1b, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04,
+	0x41, 0x1b, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6,
+	0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0xfa, 0x42, 0x04,
 	0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x3d, 0x92, 0x41, 0x3a,
 	0x0a, 0x38, 0x2a, 0x13, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x15, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x65,
@@ -14203,12 +14235,12 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc9, 0x02, 0x0a, 0x14, 0x45,
 	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf,
-	0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63,
+	0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94,
+	0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
 	0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf,
-	0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
 	0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42,
 	0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf,
 	0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
@@ -14216,20 +14248,20 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04,
 	0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x3a, 0x52, 0x92, 0x41, 0x4f, 0x0a, 0x4d, 0x2a, 0x14, 0x45, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
-	0x1b, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd7, 0x04, 0x0a, 0x07, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x74, 0x61, 0x3a, 0x52, 0x92, 0x41, 0x4f, 0x0a, 0x4d, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2a, 0x14, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4b, 0x38,
+	0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1b, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
+	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x22, 0xd7, 0x04, 0x0a, 0x07, 0x49, 0x6e, 0x67, 0x72, 0x65,
 	0x73, 0x73, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8,
-	0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc,
-	0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38,
-	0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64,
-	0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b,
-	0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5,
+	0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe,
+	0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42,
+	0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64, 0x32, 0x0f,
+	0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a,
+	0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
 	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
 	0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba,
@@ -14247,9 +14279,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b,
 	0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5,
-	0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x0c,
+	0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x2a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e,
@@ -14285,16 +14317,16 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x45, 0x6e, 0x64, 0x32, 0x12, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe5, 0x88, 0x9b,
 	0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae,
-	0xb5, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x0c, 0xe6, 0x89,
+	0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
 	0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x06, 0x6f,
 	0x66, 0x66, 0x73, 0x65, 0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f,
 	0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f,
 	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95,
-	0xb0, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x2a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d,
 	0x69, 0x74, 0x3a, 0x36, 0x92, 0x41, 0x33, 0x0a, 0x31, 0x2a, 0x0e, 0x49, 0x6e, 0x67, 0x72, 0x65,
 	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x13, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
 	0xa2, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01,
@@ -14314,19 +14346,19 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
 	0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
 	0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x4b, 0x92,
-	0x41, 0x48, 0x0a, 0x46, 0x2a, 0x0f, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x19, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x69, 0x6e, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
-	0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa4, 0x04, 0x0a, 0x09, 0x4e,
+	0x41, 0x48, 0x0a, 0x46, 0x32, 0x19, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2,
+	0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2,
+	0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x0f, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa4, 0x04, 0x0a, 0x09, 0x4e,
 	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73,
 	0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d,
 	0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53,
 	0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7,
 	0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba,
 	0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d,
-	0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a,
-	0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63,
+	0x72, 0x1b, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d,
+	0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09, 0x63,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d,
 	0x92, 0x41, 0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32,
@@ -14350,18 +14382,18 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25,
 	0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11,
 	0x2a, 0x03, 0x5f, 0x69, 0x64, 0x32, 0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x20, 0x69,
-	0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x2a, 0x92, 0x41, 0x27, 0x0a, 0x25, 0x2a, 0x09, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7,
-	0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0xb7, 0x06, 0x0a, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b,
+	0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x2a, 0x92, 0x41, 0x27, 0x0a, 0x25, 0xd2, 0x01, 0x09, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x2a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97,
+	0xb4, 0x22, 0xb7, 0x06, 0x0a, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b,
 	0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41,
 	0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43,
 	0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86,
 	0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8,
 	0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42,
-	0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d,
-	0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09,
+	0x1d, 0x72, 0x1b, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61,
 	0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
@@ -14369,9 +14401,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x42, 0x0b, 0x92, 0x41, 0x08, 0x2a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x52, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
 	0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28,
-	0x92, 0x41, 0x25, 0x2a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42,
-	0x65, 0x67, 0x69, 0x6e, 0x32, 0x12, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe5, 0x88, 0x9b, 0xe5,
-	0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x92, 0x41, 0x25, 0x32, 0x12, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe5, 0x88, 0x9b, 0xe5, 0xbb,
+	0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x2a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x54, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x26, 0x92, 0x41, 0x23, 0x2a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
@@ -14382,8 +14414,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11,
 	0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d,
 	0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x0c, 0xe6, 0x89, 0xa9,
+	0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
 	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x85, 0x01, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64,
 	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x71, 0x92, 0x41, 0x6e, 0x2a, 0x04, 0x75, 0x73, 0x65,
 	0x64, 0x32, 0x66, 0xe5, 0x85, 0xa8, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xef,
@@ -14398,8 +14430,8 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04,
 	0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x05,
 	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41, 0x1b,
-	0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9,
-	0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02,
+	0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95,
+	0xb0, 0xe9, 0x87, 0x8f, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0xfa, 0x42, 0x04, 0x32, 0x02,
 	0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x40, 0x92, 0x41, 0x3d, 0x0a, 0x3b,
 	0x2a, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x18, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0xbd,
@@ -14407,15 +14439,15 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xcc, 0x02, 0x0a, 0x14,
 	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8,
-	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04,
+	0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9,
+	0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04,
 	0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf,
 	0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8,
-	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93,
+	0xe6, 0x9e, 0x9c, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
 	0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a,
@@ -14427,53 +14459,53 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
 	0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x94, 0x04, 0x0a, 0x04, 0x4e,
 	0x6f, 0x64, 0x65, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83,
-	0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef,
-	0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b,
-	0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18,
-	0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d,
-	0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53,
+	0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7,
+	0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba,
+	0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x32, 0x0f, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04,
+	0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
 	0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5,
-	0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x0c,
+	0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x2a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41,
 	0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32,
 	0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x0c, 0x72,
 	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x63,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a,
+	0x1d, 0x92, 0x41, 0x1a, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9,
+	0x97, 0xb4, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0a,
 	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x75, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d,
-	0x92, 0x41, 0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32,
-	0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a, 0x75,
+	0x92, 0x41, 0x1a, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
+	0xb4, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0a, 0x75,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74,
 	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0,
-	0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x03, 0x5f, 0x69, 0x64,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x03, 0x5f, 0x69, 0x64,
 	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x03, 0x5f, 0x69, 0x64,
 	0x32, 0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x20, 0x69, 0x64, 0x52, 0x02, 0x49, 0x64,
 	0x3a, 0x1f, 0x92, 0x41, 0x1c, 0x0a, 0x1a, 0x2a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x32, 0x06, 0xe8,
 	0x8a, 0x82, 0xe7, 0x82, 0xb9, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
 	0x64, 0x22, 0xad, 0x05, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4,
-	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c,
-	0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53,
-	0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32,
-	0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24,
-	0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86,
+	0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4,
+	0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43,
+	0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64, 0x32, 0x0f, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04,
+	0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
 	0x64, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7,
 	0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65,
 	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41,
-	0x16, 0x2a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x32, 0x08, 0xe5,
-	0xa4, 0x96, 0xe9, 0x83, 0xa8, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x16, 0x32, 0x08, 0xe5, 0xa4, 0x96, 0xe9, 0x83, 0xa8, 0x49, 0x44, 0x2a, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
 	0x6c, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
 	0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0x92, 0x41,
 	0x25, 0x2a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67,
@@ -14487,7 +14519,7 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41,
-	0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52,
+	0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52,
 	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x07,
 	0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
 	0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05,
@@ -14504,24 +14536,24 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
 	0x64, 0x22, 0xb4, 0x02, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94,
-	0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f,
+	0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99,
+	0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
 	0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4,
 	0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31,
 	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19,
-	0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94,
-	0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x92, 0x41, 0x16, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
+	0x9c, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
 	0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64,
 	0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x3a, 0x45, 0x92, 0x41, 0x42, 0x0a, 0x40, 0x2a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x16, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x6e, 0x6f,
-	0x64, 0x65, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xdd, 0x04, 0x0a, 0x09, 0x44, 0x61, 0x65,
+	0x61, 0x3a, 0x45, 0x92, 0x41, 0x42, 0x0a, 0x40, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2,
+	0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0x16, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x6e, 0x6f, 0x64, 0x65, 0xe6, 0x9f, 0xa5, 0xe8,
+	0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x22, 0xdd, 0x04, 0x0a, 0x09, 0x44, 0x61, 0x65,
 	0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
 	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86,
@@ -14566,11 +14598,11 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94,
 	0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5,
 	0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58,
-	0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30,
-	0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43,
-	0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18,
+	0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d,
+	0x2b, 0x24, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04,
+	0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
 	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61,
 	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7,
@@ -14597,13 +14629,13 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
 	0x3a, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x32, 0x06, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x52, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x06, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d,
+	0xbf, 0x2a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x52, 0x0b,
 	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x12, 0x43, 0x0a, 0x0c, 0x73,
 	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe7, 0xb1, 0xbb, 0xe5,
-	0x9e, 0x8b, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x0c, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe7, 0xb1,
+	0xbb, 0xe5, 0x9e, 0x8b, 0x2a, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65,
 	0x12, 0x2e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42,
 	0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x32, 0x0c, 0xe6, 0x89, 0xa9,
 	0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
@@ -14644,9 +14676,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9,
 	0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44,
 	0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d,
-	0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02,
-	0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d,
-	0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64,
+	0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b,
+	0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
 	0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61,
 	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7,
@@ -14656,9 +14688,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6,
 	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
 	0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x0c,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5,
-	0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x0c,
+	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x2a, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41,
 	0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5,
@@ -14672,16 +14704,16 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92,
 	0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae,
 	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x03, 0x5f, 0x69, 0x64, 0x32, 0x0a, 0x6d,
-	0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x20, 0x69, 0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x1b, 0x92,
+	0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64,
+	0x62, 0x20, 0x69, 0x64, 0x2a, 0x03, 0x5f, 0x69, 0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x1b, 0x92,
 	0x41, 0x18, 0x0a, 0x16, 0x2a, 0x03, 0x4a, 0x6f, 0x62, 0x32, 0x03, 0x6a, 0x6f, 0x62, 0xd2, 0x01,
 	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xdb, 0x05, 0x0a, 0x0a, 0x4a,
 	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41,
-	0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43,
-	0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86,
-	0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8,
-	0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42,
+	0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7,
+	0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc,
+	0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58,
+	0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42,
 	0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d,
 	0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
@@ -14702,15 +14734,15 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xe6, 0x9d, 0x9f, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52,
 	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x4c,
 	0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0x92, 0x41, 0x1f, 0x2a, 0x0f, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x0c, 0xe8,
-	0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x0f, 0x72, 0x65, 0x73,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0x92, 0x41, 0x1f, 0x32, 0x0c, 0xe8, 0xb5,
+	0x84, 0xe6, 0xba, 0x90, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x2a, 0x0f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x03,
 	0x75, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x2a, 0x03,
 	0x75, 0x49, 0x44, 0x32, 0x04, 0x75, 0x75, 0x69, 0x64, 0x52, 0x03, 0x75, 0x49, 0x44, 0x12, 0x2e,
 	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0x92,
-	0x41, 0x15, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1,
-	0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3b,
+	0x41, 0x15, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5,
+	0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3b,
 	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x23,
 	0x92, 0x41, 0x19, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5,
 	0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32,
@@ -14718,17 +14750,17 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x2a,
 	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99,
 	0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18,
-	0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x2e, 0x92, 0x41, 0x2b, 0x0a, 0x29, 0x2a,
-	0x0a, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5,
-	0xe8, 0xaf, 0xa2, 0x6a, 0x6f, 0x62, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb1, 0x02, 0x0a, 0x0b, 0x4a, 0x6f, 0x62,
+	0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x2e, 0x92, 0x41, 0x2b, 0x0a, 0x29, 0xd2,
+	0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x2a, 0x0a, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6a,
+	0x6f, 0x62, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x22, 0xb1, 0x02, 0x0a, 0x0b, 0x4a, 0x6f, 0x62,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64,
 	0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7,
 	0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9,
-	0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x12,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6,
+	0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
 	0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52,
@@ -14743,13 +14775,13 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe3, 0x04, 0x0a,
 	0x0b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x7e, 0x0a, 0x09,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32,
-	0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8,
-	0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc,
-	0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58,
-	0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d,
-	0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53,
-	0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09,
+	0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4,
+	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c,
+	0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53,
+	0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64,
+	0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b,
+	0x24, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09,
 	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32,
 	0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x6e,
@@ -14763,9 +14795,9 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5,
 	0x9e, 0x8b, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
 	0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6,
-	0xe9, 0x97, 0xb4, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb,
+	0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
 	0x3d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x54, 0x69, 0x6d, 0x65, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9,
@@ -14775,22 +14807,22 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61,
 	0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25,
 	0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11,
-	0x2a, 0x03, 0x5f, 0x69, 0x64, 0x32, 0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x20, 0x69,
-	0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x2b, 0x92, 0x41, 0x28, 0x0a, 0x26, 0x2a, 0x0b, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x32, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0xf3, 0x07, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53,
+	0x32, 0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x20, 0x69, 0x64, 0x2a, 0x03, 0x5f, 0x69,
+	0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x2b, 0x92, 0x41, 0x28, 0x0a, 0x26, 0xd2, 0x01, 0x09, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x2a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66,
+	0x75, 0x6c, 0x53, 0x65, 0x74, 0x32, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53,
+	0x65, 0x74, 0x22, 0xf3, 0x07, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53,
 	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41,
 	0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43,
 	0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86,
 	0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8,
 	0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42,
-	0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d,
-	0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09,
+	0x1d, 0x72, 0x1b, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe5, 0x90,
+	0x8d, 0xe7, 0xa7, 0xb0, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
 	0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
 	0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97,
@@ -14806,20 +14838,20 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0xe6, 0x9d, 0x9f, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52,
 	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x4c,
 	0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0x92, 0x41, 0x1f, 0x2a, 0x0f, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x0c, 0xe8,
-	0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x0f, 0x72, 0x65, 0x73,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0x92, 0x41, 0x1f, 0x32, 0x0c, 0xe8, 0xb5,
+	0x84, 0xe6, 0xba, 0x90, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x2a, 0x0f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x03,
 	0x75, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x2a, 0x03,
 	0x75, 0x49, 0x44, 0x32, 0x04, 0x75, 0x75, 0x69, 0x64, 0x52, 0x03, 0x75, 0x49, 0x44, 0x12, 0x3b,
 	0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x2a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x32, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x32, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
 	0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0a, 0x70,
 	0x6f, 0x64, 0x4d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x20, 0x92, 0x41, 0x1d, 0x2a, 0x0a, 0x70, 0x6f, 0x64, 0x4d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x32, 0x0f, 0x50, 0x6f, 0x64, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe7, 0xad, 0x96, 0xe7, 0x95,
-	0xa5, 0x52, 0x0a, 0x70, 0x6f, 0x64, 0x4d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x43, 0x0a,
+	0x20, 0x92, 0x41, 0x1d, 0x32, 0x0f, 0x50, 0x6f, 0x64, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe7,
+	0xad, 0x96, 0xe7, 0x95, 0xa5, 0x2a, 0x0a, 0x70, 0x6f, 0x64, 0x4d, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0a, 0x70, 0x6f, 0x64, 0x4d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x43, 0x0a,
 	0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
 	0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe7, 0xb1,
@@ -14836,24 +14868,24 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9,
 	0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
 	0x74, 0x12, 0x3b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x25, 0x92, 0x41, 0x1b, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6, 0x9f,
-	0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
+	0x42, 0x25, 0x92, 0x41, 0x1b, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90,
+	0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
 	0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x38,
-	0x92, 0x41, 0x35, 0x0a, 0x33, 0x2a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x11, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0xd2, 0x01, 0x09, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc9, 0x02, 0x0a, 0x13, 0x53, 0x74, 0x61,
+	0x92, 0x41, 0x35, 0x0a, 0x33, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x2a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x11, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x22, 0xc9, 0x02, 0x0a, 0x13, 0x53, 0x74, 0x61,
 	0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a,
 	0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
 	0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
 	0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32,
-	0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1,
-	0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06,
+	0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94,
+	0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06,
 	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41,
-	0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
-	0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x16, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x2a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
 	0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74,
@@ -14866,16 +14898,16 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x73, 0x61, 0x67, 0x65, 0x22, 0xe3, 0x02, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x79,
 	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x79, 0x0a, 0x09,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x5b, 0x92, 0x41, 0x38, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32,
-	0x2b, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0x28, 0xe4, 0xbb, 0x8e, 0xe5, 0x93, 0xaa,
-	0xe4, 0xb8, 0xaa, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4,
-	0xba, 0x86, 0xe8, 0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x29, 0xfa, 0x42, 0x1d, 0x72,
-	0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c,
+	0x5b, 0x92, 0x41, 0x38, 0x32, 0x2b, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0x28, 0xe4,
+	0xbb, 0x8e, 0xe5, 0x93, 0xaa, 0xe4, 0xb8, 0xaa, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x88,
+	0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x86, 0xe8, 0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6,
+	0x29, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72,
+	0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30,
+	0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x09, 0x63, 0x6c,
 	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92,
-	0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xfa, 0x42,
+	0x41, 0x1c, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xfa, 0x42,
 	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
 	0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74,
@@ -14885,186 +14917,413 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x0a, 0x38, 0x2a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x17, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x77, 0x61, 0x74,
 	0x63, 0x68, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xd2, 0x01,
-	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb2, 0x01, 0x0a, 0x14, 0x57,
+	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xac, 0x02, 0x0a, 0x14, 0x57,
 	0x61, 0x74, 0x63, 0x68, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x05, 0x42, 0x0f, 0x92, 0x41, 0x0c, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x04, 0x74, 0x79,
 	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
 	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x33, 0x92, 0x41, 0x30, 0x0a,
-	0x2e, 0x2a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x11, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74,
-	0x63, 0x68, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0x99, 0x02, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x79, 0x0a, 0x09, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5b, 0x92, 0x41,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x78, 0x0a, 0x0f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x4e, 0x92, 0x41, 0x4b, 0x2a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x38, 0xe4, 0xba, 0x8b, 0xe4, 0xbb,
+	0xb6, 0xe7, 0x9a, 0x84, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0xef, 0xbc, 0x8c, 0xe5, 0x8f, 0xaf, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe6,
+	0x96, 0xad, 0xe7, 0xba, 0xbf, 0xe5, 0x90, 0x8e, 0xe7, 0xbb, 0xa7, 0xe7, 0xbb, 0xad, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x33, 0x92, 0x41, 0x30, 0x0a, 0x2e, 0x32, 0x11, 0xe8, 0xbf, 0x94,
+	0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x02, 0x0a, 0x15, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x79, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5b, 0x92, 0x41, 0x38, 0x2a, 0x09, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x2b, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44,
+	0x28, 0xe4, 0xbb, 0x8e, 0xe5, 0x93, 0xaa, 0xe4, 0xb8, 0xaa, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4,
+	0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x86, 0xe8, 0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4,
+	0xbb, 0xb6, 0x29, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d,
+	0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53,
+	0x2d, 0x10, 0x02, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x46,
+	0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x13, 0x92, 0x41, 0x10, 0x2a, 0x06,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3d, 0x92, 0x41, 0x3a, 0x0a, 0x38, 0x2a, 0x11, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x32, 0x17, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe6, 0x9f, 0x90,
+	0xe4, 0xb8, 0xaa, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xae, 0x02, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0f,
+	0x92, 0x41, 0x0c, 0x32, 0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x2a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x78, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x4e, 0x92, 0x41, 0x4b, 0x32, 0x38, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0xef,
+	0xbc, 0x8c, 0xe5, 0x8f, 0xaf, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe6, 0x96, 0xad, 0xe7, 0xba,
+	0xbf, 0xe5, 0x90, 0x8e, 0xe7, 0xbb, 0xa7, 0xe7, 0xbb, 0xad, 0x77, 0x61, 0x74, 0x63, 0x68, 0x2a,
+	0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x3a, 0x33, 0x92, 0x41, 0x30, 0x0a, 0x2e, 0x2a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x11, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2,
+	0x01, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41,
+	0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91,
+	0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06,
+	0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x06, 0xe7, 0xa7, 0x8d, 0xe7, 0xb1, 0xbb, 0x2a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x3a, 0x2c, 0x92, 0x41, 0x29, 0x0a, 0x27, 0x2a, 0x0e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0x15, 0xe4, 0xba, 0x8b, 0xe4,
+	0xbb, 0xb6, 0xe7, 0x9a, 0x84, 0xe7, 0x89, 0xb9, 0xe5, 0xae, 0x9a, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
+	0xaf, 0x22, 0xb5, 0x07, 0x0a, 0x0f, 0x42, 0x63, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x79, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5b, 0x92, 0x41, 0x38, 0x32, 0x2b, 0xe9,
+	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0x28, 0xe4, 0xbb, 0x8e, 0xe5, 0x93, 0xaa, 0xe4, 0xb8,
+	0xaa, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x86,
+	0xe8, 0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x29, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b,
+	0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42,
+	0x43, 0x53, 0x2d, 0x10, 0x02, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x56, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42,
+	0x92, 0x41, 0x3f, 0x32, 0x37, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb1,
+	0x9e, 0xe4, 0xba, 0x8e, 0xe5, 0x93, 0xaa, 0xe7, 0xa7, 0x8d, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
+	0x28, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a,
+	0x70, 0x6f, 0x64, 0xe3, 0x80, 0x81, 0x72, 0x63, 0xe7, 0xad, 0x89, 0x29, 0x2a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x5c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x49, 0x6e, 0x66, 0x6f, 0x42, 0x25, 0x92, 0x41, 0x22, 0x2a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x49, 0x6e, 0x66, 0x6f, 0x32, 0x15, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84, 0xe7,
+	0x89, 0xb9, 0xe5, 0xae, 0x9a, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x09, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x51, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0x92, 0x41, 0x2e, 0x2a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x20, 0xe4, 0xba, 0x8b,
+	0xe4, 0xbb, 0xb6, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x28, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0xe5, 0x8f, 0x91, 0xe7, 0x94, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x29, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x03, 0x65, 0x6e, 0x76,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x2a, 0x03, 0x65, 0x6e, 0x76,
+	0x32, 0x14, 0xe7, 0x8e, 0xaf, 0xe5, 0xa2, 0x83, 0x28, 0x6d, 0x65, 0x73, 0x6f, 0x73, 0x20, 0x6f,
+	0x72, 0x20, 0x6b, 0x38, 0x73, 0x29, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x6d, 0x0a, 0x09, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4f,
+	0x92, 0x41, 0x4c, 0x2a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x32, 0x3f,
+	0xe7, 0xbb, 0x84, 0xe4, 0xbb, 0xb6, 0xef, 0xbc, 0x8c, 0xe5, 0x93, 0xaa, 0xe4, 0xb8, 0xaa, 0xe7,
+	0xbb, 0x84, 0xe4, 0xbb, 0xb6, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0xaf, 0xa5, 0xe4, 0xba,
+	0x8b, 0xe4, 0xbb, 0xb6, 0x28, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0xe3, 0x80,
+	0x81, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0xe7, 0xad, 0x89, 0x29, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x3a, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x24, 0x92, 0x41, 0x21, 0x32, 0x18, 0xe5, 0xb1, 0x82, 0xe7, 0xba, 0xa7, 0x28, 0x6e, 0x6f,
+	0x72, 0x6d, 0x61, 0x6c, 0xe3, 0x80, 0x81, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x29, 0x2a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x4e, 0x0a,
+	0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x30, 0x92, 0x41, 0x2d, 0x2a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x32, 0x20, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x28, 0xe6,
+	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe5, 0x8f, 0x91, 0xe7, 0x94, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
+	0xb4, 0x29, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a,
+	0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1b, 0x92, 0x41, 0x18, 0x2a, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x32, 0x0c,
+	0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x86, 0xe8, 0x8a, 0x82, 0x52, 0x08, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x03, 0x5f, 0x69, 0x64, 0x32, 0x0a, 0x6d,
+	0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x20, 0x69, 0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x2a, 0x92,
+	0x41, 0x27, 0x0a, 0x25, 0x32, 0x06, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xd2, 0x01, 0x09, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x2a, 0x0f, 0x42, 0x63, 0x73, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x98, 0x08, 0x0a, 0x0f, 0x50, 0x75,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x92, 0x41, 0x12, 0x32, 0x0c,
+	0xe5, 0x94, 0xaf, 0xe4, 0xb8, 0x80, 0xe6, 0xa0, 0x87, 0xe8, 0xaf, 0x86, 0x2a, 0x02, 0x69, 0x64,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x2a, 0x03, 0x65, 0x6e, 0x76, 0x32, 0x14, 0xe7, 0x8e, 0xaf,
+	0xe5, 0xa2, 0x83, 0x28, 0x6d, 0x65, 0x73, 0x6f, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x6b, 0x38, 0x73,
+	0x29, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x56, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0x92, 0x41, 0x3f, 0x2a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x32,
+	0x37, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb1, 0x9e, 0xe4, 0xba, 0x8e,
+	0xe5, 0x93, 0xaa, 0xe7, 0xa7, 0x8d, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x28, 0xe8, 0xb5, 0x84,
+	0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x70, 0x6f, 0x64, 0xe3,
+	0x80, 0x81, 0x72, 0x63, 0xe7, 0xad, 0x89, 0x29, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3a,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0x92,
+	0x41, 0x21, 0x2a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x32, 0x18, 0xe5, 0xb1, 0x82, 0xe7, 0xba,
+	0xa7, 0x28, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0xe3, 0x80, 0x81, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x29, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x6d, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4f, 0x92,
+	0x41, 0x4c, 0x2a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x32, 0x3f, 0xe7,
+	0xbb, 0x84, 0xe4, 0xbb, 0xb6, 0xef, 0xbc, 0x8c, 0xe5, 0x93, 0xaa, 0xe4, 0xb8, 0xaa, 0xe7, 0xbb,
+	0x84, 0xe4, 0xbb, 0xb6, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0xaf, 0xa5, 0xe4, 0xba, 0x8b,
+	0xe4, 0xbb, 0xb6, 0x28, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0xe3, 0x80, 0x81,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0xe7, 0xad, 0x89, 0x29, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x37, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x2a, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x32, 0x0c, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x86, 0xe8, 0x8a, 0x82, 0x52,
+	0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x79, 0x0a, 0x09, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5b, 0x92, 0x41,
 	0x38, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x2b, 0xe9, 0x9b,
 	0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0x28, 0xe4, 0xbb, 0x8e, 0xe5, 0x93, 0xaa, 0xe4, 0xb8, 0xaa,
 	0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x86, 0xe8,
-	0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x29, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02,
-	0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d,
-	0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x13, 0x92, 0x41, 0x10, 0x2a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x06, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3d, 0x92, 0x41,
-	0x3a, 0x0a, 0x38, 0x2a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x17, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x77, 0x61,
-	0x74, 0x63, 0x68, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xd2,
-	0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x16,
-	0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x0f, 0x92, 0x41, 0x0c, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x33, 0x92,
-	0x41, 0x30, 0x0a, 0x2e, 0x2a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x11, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
-	0x77, 0x61, 0x74, 0x63, 0x68, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x72,
-	0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d,
-	0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7,
-	0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x32, 0x06, 0xe7, 0xa7, 0x8d, 0xe7, 0xb1, 0xbb, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x3a,
-	0x2c, 0x92, 0x41, 0x29, 0x0a, 0x27, 0x2a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74,
-	0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0x15, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a,
-	0x84, 0xe7, 0x89, 0xb9, 0xe5, 0xae, 0x9a, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x22, 0xb5, 0x07,
-	0x0a, 0x0f, 0x42, 0x63, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x79, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x5b, 0x92, 0x41, 0x38, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x32, 0x2b, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0x28, 0xe4,
-	0xbb, 0x8e, 0xe5, 0x93, 0xaa, 0xe4, 0xb8, 0xaa, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x88,
-	0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x86, 0xe8, 0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6,
-	0x29, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d,
-	0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53,
-	0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x56, 0x0a, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0x92, 0x41, 0x3f, 0x2a,
-	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x32, 0x37, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c,
-	0xe5, 0xb1, 0x9e, 0xe4, 0xba, 0x8e, 0xe5, 0x93, 0xaa, 0xe7, 0xa7, 0x8d, 0xe8, 0xb5, 0x84, 0xe6,
-	0xba, 0x90, 0x28, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef,
-	0xbc, 0x9a, 0x70, 0x6f, 0x64, 0xe3, 0x80, 0x81, 0x72, 0x63, 0xe7, 0xad, 0x89, 0x29, 0x52, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x5c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66,
-	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x29, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x32, 0x0f,
+	0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a,
+	0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x42, 0x30, 0x92, 0x41, 0x2d, 0x32, 0x20, 0xe4, 0xba,
+	0x8b, 0xe4, 0xbb, 0xb6, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x28, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
+	0xb4, 0xe5, 0x8f, 0x91, 0xe7, 0x94, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x29, 0x2a, 0x09,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x5c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
 	0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f,
 	0x42, 0x25, 0x92, 0x41, 0x22, 0x2a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f,
 	0x32, 0x15, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84, 0xe7, 0x89, 0xb9, 0xe5, 0xae,
 	0x9a, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x51, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0x92, 0x41, 0x2e, 0x2a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x20, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe6,
-	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x28, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe5, 0x8f, 0x91, 0xe7,
-	0x94, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x29, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x2a, 0x03, 0x65, 0x6e, 0x76, 0x32, 0x14, 0xe7, 0x8e,
-	0xaf, 0xe5, 0xa2, 0x83, 0x28, 0x6d, 0x65, 0x73, 0x6f, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x6b, 0x38,
-	0x73, 0x29, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x6d, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4f, 0x92, 0x41, 0x4c, 0x2a,
+	0x66, 0x6f, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x32, 0x0c, 0xe6,
+	0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x23, 0x92, 0x41, 0x19, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5,
+	0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x3b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25,
+	0x92, 0x41, 0x1b, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88,
+	0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0xfa, 0x42,
+	0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x36, 0x92, 0x41,
+	0x33, 0x0a, 0x31, 0x2a, 0x0f, 0x50, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x32, 0x12, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x8b, 0xe4,
+	0xbb, 0xb6, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0xbe, 0x02, 0x0a, 0x10, 0x50, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf,
+	0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32,
+	0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1,
+	0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6,
+	0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x4b, 0x92, 0x41, 0x48, 0x0a, 0x46, 0x2a,
+	0x10, 0x50, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0x18, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe5,
+	0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf3, 0x08, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x92, 0x41, 0x12, 0x2a, 0x02, 0x69, 0x64, 0x32,
+	0x0c, 0xe5, 0x94, 0xaf, 0xe4, 0xb8, 0x80, 0xe6, 0xa0, 0x87, 0xe8, 0xaf, 0x86, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x30, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
+	0x92, 0x41, 0x1b, 0x2a, 0x03, 0x65, 0x6e, 0x76, 0x32, 0x14, 0xe7, 0x8e, 0xaf, 0xe5, 0xa2, 0x83,
+	0x28, 0x6d, 0x65, 0x73, 0x6f, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x6b, 0x38, 0x73, 0x29, 0x52, 0x03,
+	0x65, 0x6e, 0x76, 0x12, 0x56, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x42, 0x92, 0x41, 0x3f, 0x2a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x32, 0x37, 0xe8, 0xb5,
+	0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb1, 0x9e, 0xe4, 0xba, 0x8e, 0xe5, 0x93, 0xaa,
+	0xe7, 0xa7, 0x8d, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x28, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
+	0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x70, 0x6f, 0x64, 0xe3, 0x80, 0x81, 0x72,
+	0x63, 0xe7, 0xad, 0x89, 0x29, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0x92, 0x41, 0x21, 0x32,
+	0x18, 0xe5, 0xb1, 0x82, 0xe7, 0xba, 0xa7, 0x28, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0xe3, 0x80,
+	0x81, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x29, 0x2a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x6d, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4f, 0x92, 0x41, 0x4c, 0x2a,
 	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x32, 0x3f, 0xe7, 0xbb, 0x84, 0xe4,
 	0xbb, 0xb6, 0xef, 0xbc, 0x8c, 0xe5, 0x93, 0xaa, 0xe4, 0xb8, 0xaa, 0xe7, 0xbb, 0x84, 0xe4, 0xbb,
 	0xb6, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6,
 	0x28, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0xe3, 0x80, 0x81, 0x63, 0x6f, 0x6e,
 	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0xe7, 0xad, 0x89, 0x29, 0x52, 0x09, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32,
-	0x06, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x06, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x79, 0x0a,
+	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x5b, 0x92, 0x41, 0x38, 0x32, 0x2b, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0x28,
+	0xe4, 0xbb, 0x8e, 0xe5, 0x93, 0xaa, 0xe4, 0xb8, 0xaa, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5,
+	0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x86, 0xe8, 0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4, 0xbb,
+	0xb6, 0x29, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d,
+	0x72, 0x1b, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d,
+	0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x5c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x49, 0x6e, 0x66, 0x6f, 0x42, 0x25, 0x92, 0x41, 0x22, 0x2a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x49, 0x6e, 0x66, 0x6f, 0x32, 0x15, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84, 0xe7,
+	0x89, 0xb9, 0xe5, 0xae, 0x9a, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x09, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x47, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x18,
+	0x92, 0x41, 0x15, 0x32, 0x0c, 0xe8, 0xbe, 0x85, 0xe5, 0x8a, 0xa9, 0xe5, 0xad, 0x97, 0xe6, 0xae,
+	0xb5, 0x2a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12,
+	0x62, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x25,
+	0x92, 0x41, 0x22, 0x2a, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x32, 0x12, 0xe8, 0xbe, 0x85, 0xe5, 0x8a, 0xa9, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0xe6,
+	0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x12, 0x3a, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x32, 0x0c, 0xe5, 0xbc, 0x80,
+	0xe5, 0xa7, 0x8b, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x2a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x42,
+	0x65, 0x67, 0x69, 0x6e, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12,
+	0x34, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x32, 0x0c,
+	0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb,
+	0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x32,
+	0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0,
+	0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x3a, 0x37, 0x92, 0x41, 0x34, 0x0a, 0x32, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2,
+	0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x8a, 0x03, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5,
+	0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9,
+	0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92,
+	0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5,
+	0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x3f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x63, 0x73, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x47, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97,
+	0xe6, 0xae, 0xb5, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x3a, 0x4c, 0x92, 0x41, 0x49, 0x0a,
+	0x47, 0x2a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x18, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe4, 0xba, 0x8b, 0xe4,
+	0xbb, 0xb6, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8d, 0x05, 0x0a, 0x10, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a,
+	0x04, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
-	0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3a, 0x0a,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0x92, 0x41,
-	0x21, 0x2a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x32, 0x18, 0xe5, 0xb1, 0x82, 0xe7, 0xba, 0xa7,
-	0x28, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0xe3, 0x80, 0x81, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
-	0x67, 0x29, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x4e, 0x0a, 0x09, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0x92, 0x41,
-	0x2d, 0x2a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x20, 0xe4, 0xba,
-	0x8b, 0xe4, 0xbb, 0xb6, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x28, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
-	0xb4, 0xe5, 0x8f, 0x91, 0xe7, 0x94, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x29, 0x52, 0x09,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x18,
-	0x2a, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x32, 0x0c, 0xe4, 0xba, 0x8b, 0xe4,
-	0xbb, 0xb6, 0xe7, 0xbb, 0x86, 0xe8, 0x8a, 0x82, 0x52, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x14, 0x92, 0x41, 0x11, 0x2a, 0x03, 0x5f, 0x69, 0x64, 0x32, 0x0a, 0x6d, 0x6f, 0x6e, 0x67, 0x6f,
-	0x64, 0x62, 0x20, 0x69, 0x64, 0x52, 0x02, 0x49, 0x64, 0x3a, 0x2a, 0x92, 0x41, 0x27, 0x0a, 0x25,
-	0x2a, 0x0f, 0x42, 0x63, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x32, 0x06, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x98, 0x08, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x92, 0x41, 0x12, 0x2a, 0x02, 0x69, 0x64, 0x32, 0x0c,
-	0xe5, 0x94, 0xaf, 0xe4, 0xb8, 0x80, 0xe6, 0xa0, 0x87, 0xe8, 0xaf, 0x86, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x30, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92,
-	0x41, 0x1b, 0x2a, 0x03, 0x65, 0x6e, 0x76, 0x32, 0x14, 0xe7, 0x8e, 0xaf, 0xe5, 0xa2, 0x83, 0x28,
-	0x6d, 0x65, 0x73, 0x6f, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x6b, 0x38, 0x73, 0x29, 0x52, 0x03, 0x65,
-	0x6e, 0x76, 0x12, 0x56, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x42, 0x92, 0x41, 0x3f, 0x2a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x32, 0x37, 0xe8, 0xb5, 0x84,
-	0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb1, 0x9e, 0xe4, 0xba, 0x8e, 0xe5, 0x93, 0xaa, 0xe7,
-	0xa7, 0x8d, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x28, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7,
-	0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x70, 0x6f, 0x64, 0xe3, 0x80, 0x81, 0x72, 0x63,
-	0xe7, 0xad, 0x89, 0x29, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0x92, 0x41, 0x21, 0x2a, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x32, 0x18, 0xe5, 0xb1, 0x82, 0xe7, 0xba, 0xa7, 0x28, 0x6e, 0x6f,
-	0x72, 0x6d, 0x61, 0x6c, 0xe3, 0x80, 0x81, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x29, 0x52,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x6d, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4f, 0x92, 0x41, 0x4c, 0x2a, 0x09,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x32, 0x3f, 0xe7, 0xbb, 0x84, 0xe4, 0xbb,
-	0xb6, 0xef, 0xbc, 0x8c, 0xe5, 0x93, 0xaa, 0xe4, 0xb8, 0xaa, 0xe7, 0xbb, 0x84, 0xe4, 0xbb, 0xb6,
-	0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x28,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0xe3, 0x80, 0x81, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0xe7, 0xad, 0x89, 0x29, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x06,
-	0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x08,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b,
-	0x92, 0x41, 0x18, 0x2a, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x32, 0x0c, 0xe4,
-	0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x86, 0xe8, 0x8a, 0x82, 0x52, 0x08, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x79, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5b, 0x92, 0x41, 0x38, 0x2a, 0x09, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x2b, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4,
-	0x49, 0x44, 0x28, 0xe4, 0xbb, 0x8e, 0xe5, 0x93, 0xaa, 0xe4, 0xb8, 0xaa, 0xe9, 0x9b, 0x86, 0xe7,
-	0xbe, 0xa4, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x86, 0xe8, 0xaf, 0xa5, 0xe4, 0xba,
-	0x8b, 0xe4, 0xbb, 0xb6, 0x29, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f,
-	0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a,
-	0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x4e, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x30, 0x92, 0x41, 0x2d, 0x2a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x32, 0x20, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
-	0xb4, 0x28, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe5, 0x8f, 0x91, 0xe7, 0x94, 0x9f, 0xe6, 0x97,
-	0xb6, 0xe9, 0x97, 0xb4, 0x29, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x5c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x25, 0x92, 0x41,
-	0x22, 0x2a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0x15, 0xe4, 0xba,
-	0x8b, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84, 0xe7, 0x89, 0xb9, 0xe5, 0xae, 0x9a, 0xe4, 0xbf, 0xa1,
-	0xe6, 0x81, 0xaf, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3e,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e,
-	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92,
-	0x41, 0x15, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1,
-	0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3b,
-	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x23,
-	0x92, 0x41, 0x19, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5,
-	0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32,
-	0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x2a,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99,
-	0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18,
-	0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x36, 0x92, 0x41, 0x33, 0x0a, 0x31, 0x2a,
-	0x0f, 0x50, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x32, 0x12, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe8, 0xaf,
-	0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0xbe, 0x02, 0x0a, 0x10, 0x50, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x72, 0x75, 0x63, 0x74, 0x42, 0x17, 0x92, 0x41, 0x14, 0x2a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4f,
+	0x6e, 0x6c, 0x79, 0x32, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x04, 0x63,
+	0x6f, 0x6e, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4f, 0x6e, 0x6c, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x08, 0x73, 0x65, 0x6c, 0x66,
+	0x4f, 0x6e, 0x6c, 0x79, 0x2a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x08,
+	0x73, 0x65, 0x6c, 0x66, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x19, 0x92, 0x41, 0x16,
+	0x2a, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x32, 0x09, 0x6d, 0x61, 0x78,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x4a, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x15, 0x92,
+	0x41, 0x12, 0x2a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x32, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x33, 0x0a,
+	0x08, 0x6d, 0x75, 0x73, 0x74, 0x44, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x17, 0x92, 0x41, 0x14, 0x32, 0x08, 0x6d, 0x75, 0x73, 0x74, 0x44, 0x69, 0x66, 0x66, 0x2a, 0x08,
+	0x6d, 0x75, 0x73, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x08, 0x6d, 0x75, 0x73, 0x74, 0x44, 0x69,
+	0x66, 0x66, 0x12, 0x9d, 0x01, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x73, 0x92, 0x41,
+	0x70, 0x2a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x32, 0x5d, 0xe4, 0xbb, 0x8e, 0xe8, 0xaf, 0xa5, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0xe4, 0xb9, 0x8b, 0xe5, 0x90, 0x8e, 0xe7,
+	0xbb, 0xa7, 0xe7, 0xbb, 0xad, 0x77, 0x61, 0x74, 0x63, 0x68, 0xef, 0xbc, 0x8c, 0xe8, 0xbf, 0x87,
+	0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xef, 0xbc,
+	0x8c, 0xe9, 0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe9, 0x87, 0x8d, 0xe6, 0x96, 0xb0, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x78, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x6f, 0x6f, 0x6b, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x2a,
+	0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x32,
+	0x3b, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe6, 0x8e, 0xa5, 0xe6, 0x94, 0xb6, 0xe5, 0x8f, 0xaa,
+	0xe6, 0x90, 0xba, 0xe5, 0xb8, 0xa6, 0xe6, 0x9c, 0x80, 0xe6, 0x96, 0xb0, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0xe7, 0x9a, 0x84, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x52, 0x0e, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x3a, 0x2a, 0x92, 0x41,
+	0x27, 0x0a, 0x25, 0x2a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x20, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x22, 0x8f, 0x02, 0x0a, 0x11, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x79,
+	0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x5b, 0x92, 0x41, 0x38, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x32, 0x2b, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0x28, 0xe4, 0xbb, 0x8e, 0xe5,
+	0x93, 0xaa, 0xe4, 0xb8, 0xaa, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x88, 0x9b, 0xe5, 0xbb,
+	0xba, 0xe4, 0xba, 0x86, 0xe8, 0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x29, 0xfa, 0x42,
+	0x1d, 0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x09,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x06, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x13, 0x92, 0x41, 0x10, 0x2a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x32, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x3a, 0x37, 0x92, 0x41, 0x34, 0x0a, 0x32, 0x2a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x11, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01,
+	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb6, 0x01, 0x0a, 0x12, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0f, 0x92, 0x41, 0x0c, 0x32, 0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x2a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x39, 0x92, 0x41, 0x36, 0x0a, 0x34, 0x2a,
+	0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0x17, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0xa9, 0x02, 0x0a, 0x0e, 0x42, 0x63, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x02, 0x69, 0x70, 0x32, 0x08, 0x49, 0x50, 0xe5,
+	0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x52, 0x02, 0x69, 0x70, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41,
+	0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43,
+	0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86,
+	0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8,
+	0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42,
+	0x1d, 0x72, 0x1b, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0,
+	0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x34, 0x92, 0x41, 0x31, 0x0a, 0x2f,
+	0x32, 0x0c, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xd2, 0x01,
+	0x02, 0x69, 0x70, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x2a,
+	0x0e, 0x42, 0x63, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x22,
+	0x70, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x28, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92,
+	0x41, 0x0e, 0x2a, 0x02, 0x69, 0x70, 0x32, 0x08, 0x49, 0x50, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x3a, 0x34, 0x92, 0x41, 0x31,
+	0x0a, 0x2f, 0x32, 0x18, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba,
+	0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x02, 0x69,
+	0x70, 0x2a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xc2, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f,
 	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52,
@@ -15073,261 +15332,257 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8,
 	0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c,
-	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb,
+	0x93, 0xe6, 0x9e, 0x9c, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x63, 0x73,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e,
 	0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x4b, 0x92, 0x41, 0x48, 0x0a, 0x46, 0x2a, 0x10, 0x50, 0x75, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x18, 0xe8,
-	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe5, 0x88, 0x9b, 0xe5, 0xbb,
-	0xba, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0xf3, 0x08, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x15, 0x92, 0x41, 0x12, 0x2a, 0x02, 0x69, 0x64, 0x32, 0x0c, 0xe5, 0x94, 0xaf,
-	0xe4, 0xb8, 0x80, 0xe6, 0xa0, 0x87, 0xe8, 0xaf, 0x86, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a,
-	0x03, 0x65, 0x6e, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x2a,
-	0x03, 0x65, 0x6e, 0x76, 0x32, 0x14, 0xe7, 0x8e, 0xaf, 0xe5, 0xa2, 0x83, 0x28, 0x6d, 0x65, 0x73,
-	0x6f, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x6b, 0x38, 0x73, 0x29, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12,
-	0x56, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0x92,
-	0x41, 0x3f, 0x2a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x32, 0x37, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
-	0xef, 0xbc, 0x8c, 0xe5, 0xb1, 0x9e, 0xe4, 0xba, 0x8e, 0xe5, 0x93, 0xaa, 0xe7, 0xa7, 0x8d, 0xe8,
-	0xb5, 0x84, 0xe6, 0xba, 0x90, 0x28, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5,
-	0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x70, 0x6f, 0x64, 0xe3, 0x80, 0x81, 0x72, 0x63, 0xe7, 0xad, 0x89,
-	0x29, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0x92, 0x41, 0x21, 0x2a, 0x05, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x32, 0x18, 0xe5, 0xb1, 0x82, 0xe7, 0xba, 0xa7, 0x28, 0x6e, 0x6f, 0x72, 0x6d, 0x61,
-	0x6c, 0xe3, 0x80, 0x81, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x29, 0x52, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x12, 0x6d, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4f, 0x92, 0x41, 0x4c, 0x2a, 0x09, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x32, 0x3f, 0xe7, 0xbb, 0x84, 0xe4, 0xbb, 0xb6, 0xef, 0xbc,
-	0x8c, 0xe5, 0x93, 0xaa, 0xe4, 0xb8, 0xaa, 0xe7, 0xbb, 0x84, 0xe4, 0xbb, 0xb6, 0xe5, 0x88, 0x9b,
-	0xe5, 0xbb, 0xba, 0xe8, 0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x28, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0xe3, 0x80, 0x81, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0xe7, 0xad, 0x89, 0x29, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb,
-	0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x79, 0x0a, 0x09, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5b, 0x92, 0x41,
-	0x38, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x2b, 0xe9, 0x9b,
-	0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0x28, 0xe4, 0xbb, 0x8e, 0xe5, 0x93, 0xaa, 0xe4, 0xb8, 0xaa,
-	0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x86, 0xe8,
-	0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x29, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02,
-	0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d,
-	0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x5c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66,
-	0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f,
-	0x42, 0x25, 0x92, 0x41, 0x22, 0x2a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f,
-	0x32, 0x15, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84, 0xe7, 0x89, 0xb9, 0xe5, 0xae,
-	0x9a, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x03, 0x28,
-	0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x32, 0x0c, 0xe6,
-	0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x12, 0x47, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a,
-	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x32, 0x0c, 0xe8, 0xbe, 0x85, 0xe5, 0x8a, 0xa9, 0xe5, 0xad,
-	0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x62, 0x0a, 0x0c, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x64, 0x61, 0x74, 0x61, 0x3a, 0x50, 0x92, 0x41, 0x4d, 0x0a, 0x4b, 0x32, 0x1e, 0xe8, 0xbf, 0x94,
+	0xe5, 0x9b, 0x9e, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6,
+	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x48, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x02, 0x69, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x0e, 0x2a, 0x02, 0x69, 0x70, 0x32, 0x08,
+	0x49, 0x50, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52,
+	0x02, 0x69, 0x70, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83,
+	0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef,
+	0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b,
+	0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x32, 0x0f, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04,
+	0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x25, 0x92, 0x41, 0x22, 0x2a,
-	0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x32, 0x12, 0xe8,
-	0xbe, 0x85, 0xe5, 0x8a, 0xa9, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb,
-	0xb6, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x12,
-	0x3a, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67,
-	0x69, 0x6e, 0x32, 0x0c, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x74,
-	0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1a, 0x92, 0x41,
-	0x17, 0x2a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x32, 0x0c, 0xe7, 0xbb, 0x93, 0xe6,
-	0x9d, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e,
-	0x64, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x32, 0x0f,
-	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0xfa,
-	0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3e,
-	0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x42, 0x26,
-	0x92, 0x41, 0x1c, 0x2a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x32, 0x12, 0xe6, 0x9f, 0xa5,
-	0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xfa,
-	0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x3a, 0x37,
-	0x92, 0x41, 0x34, 0x0a, 0x32, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4,
-	0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x8a, 0x03, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17,
-	0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94,
-	0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20,
-	0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf,
-	0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb,
-	0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x63, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
-	0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a,
-	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x3a, 0x40, 0x92, 0x41, 0x3d, 0x0a, 0x3b, 0x32, 0x18, 0xe5, 0x88, 0x9b, 0xe5,
+	0xbb, 0xba, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe8, 0xaf,
+	0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x02, 0x69, 0x70, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x2a, 0x0e, 0x50, 0x75, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc2, 0x02, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x0f, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x2a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
+	0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x50, 0x92, 0x41, 0x4d, 0x0a, 0x4b, 0x2a,
+	0x0f, 0x50, 0x75, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0x1e, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85,
+	0x8d, 0xe7, 0xbd, 0xae, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+	0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7c, 0x0a, 0x11, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x14,
+	0x32, 0x0e, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0x49, 0x50, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80,
+	0x2a, 0x02, 0x69, 0x70, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x3a,
+	0x37, 0x92, 0x41, 0x34, 0x0a, 0x32, 0xd2, 0x01, 0x02, 0x69, 0x70, 0x2a, 0x11, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x18,
+	0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7,
+	0xbd, 0xae, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x22, 0xc8, 0x02, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92,
+	0x41, 0x17, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf,
+	0xe7, 0xa0, 0x81, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6,
+	0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16,
+	0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x2a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52,
-	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x3a, 0x4c, 0x92, 0x41, 0x49, 0x0a, 0x47, 0x2a, 0x11, 0x4c,
-	0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0x18, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe6, 0x9f,
-	0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0xf3, 0x02, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x61,
-	0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x04, 0x63, 0x6f, 0x6e,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x42, 0x17, 0x92, 0x41, 0x14, 0x2a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4f, 0x6e, 0x6c, 0x79, 0x32,
-	0x08, 0x73, 0x65, 0x6c, 0x66, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x64, 0x12,
-	0x33, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x42, 0x17, 0x92, 0x41, 0x14, 0x2a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4f, 0x6e, 0x6c, 0x79,
-	0x32, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x66,
-	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x09, 0x6d, 0x61,
-	0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x32, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4a, 0x0a,
-	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x15, 0x92, 0x41, 0x12, 0x2a, 0x07,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x32, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x75, 0x73,
-	0x74, 0x44, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14,
-	0x2a, 0x08, 0x6d, 0x75, 0x73, 0x74, 0x44, 0x69, 0x66, 0x66, 0x32, 0x08, 0x6d, 0x75, 0x73, 0x74,
-	0x44, 0x69, 0x66, 0x66, 0x52, 0x08, 0x6d, 0x75, 0x73, 0x74, 0x44, 0x69, 0x66, 0x66, 0x3a, 0x2a,
-	0x92, 0x41, 0x27, 0x0a, 0x25, 0x2a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x20, 0x77,
-	0x61, 0x74, 0x63, 0x68, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x22, 0x8f, 0x02, 0x0a, 0x11, 0x57,
-	0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x79, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x5b, 0x92, 0x41, 0x38, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x32, 0x2b, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0x28, 0xe4, 0xbb,
-	0x8e, 0xe5, 0x93, 0xaa, 0xe4, 0xb8, 0xaa, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x88, 0x9b,
-	0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x86, 0xe8, 0xaf, 0xa5, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x29,
-	0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d,
-	0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x06, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x13, 0x92, 0x41, 0x10, 0x2a, 0x06, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x32, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x3a, 0x37, 0x92, 0x41, 0x34, 0x0a, 0x32, 0x2a, 0x11, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x11, 0x77,
-	0x61, 0x74, 0x63, 0x68, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82,
-	0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb6, 0x01, 0x0a,
-	0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x0f, 0x92, 0x41, 0x0c, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x39, 0x92, 0x41, 0x36, 0x0a,
-	0x34, 0x2a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x17, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74,
-	0x63, 0x68, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xa9, 0x02, 0x0a, 0x0e, 0x42, 0x63, 0x73, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x02, 0x69, 0x70, 0x32, 0x08, 0x49,
-	0x50, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x52, 0x02, 0x69, 0x70, 0x12, 0x7e, 0x0a, 0x09, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60,
-	0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30,
-	0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9,
-	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f,
-	0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58,
-	0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d,
-	0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6,
+	0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x53,
+	0x92, 0x41, 0x50, 0x0a, 0x4e, 0x32, 0x1e, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe4, 0xb8, 0xbb,
+	0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7,
+	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a,
+	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5,
+	0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe,
+	0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42,
+	0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72,
+	0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x3a, 0x3c, 0x92, 0x41, 0x39, 0x0a, 0x37, 0x32, 0x18,
+	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7,
+	0xbd, 0xae, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x2a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc4, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf,
+	0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d,
+	0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf,
+	0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
+	0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x42, 0x63, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x6f, 0x73, 0x74,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0,
+	0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x51, 0x92, 0x41, 0x4e, 0x0a, 0x4c,
+	0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0x1e, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba,
+	0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6,
+	0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9e, 0x02, 0x0a,
+	0x19, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92,
+	0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42,
+	0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b,
+	0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4,
+	0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa,
+	0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52,
+	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x03, 0x69, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x0e, 0x32, 0x07, 0x49, 0x50,
+	0x20, 0x6c, 0x69, 0x73, 0x74, 0x2a, 0x03, 0x69, 0x70, 0x73, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02,
+	0x08, 0x01, 0x52, 0x03, 0x69, 0x70, 0x73, 0x3a, 0x54, 0x92, 0x41, 0x51, 0x0a, 0x4f, 0x2a, 0x19,
+	0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x20, 0xe4, 0xbf, 0xae, 0xe6, 0x94,
+	0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0x28, 0xe8, 0xa6,
+	0x86, 0xe7, 0x9b, 0x96, 0x29, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x03, 0x69, 0x70, 0x73, 0x22, 0xe1, 0x02,
+	0x0a, 0x1a, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99,
+	0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92,
+	0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94,
+	0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x0c, 0xe8,
+	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x2a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
 	0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6,
-	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x34, 0x92, 0x41, 0x31,
-	0x0a, 0x2f, 0x2a, 0x0e, 0x42, 0x63, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x6f,
-	0x73, 0x74, 0x32, 0x0c, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae,
-	0xd2, 0x01, 0x02, 0x69, 0x70, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x70, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0x92, 0x41, 0x0e, 0x2a, 0x02, 0x69, 0x70, 0x32, 0x08, 0x49, 0x50, 0xe5, 0x9c, 0xb0, 0xe5,
-	0x9d, 0x80, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x3a, 0x34, 0x92,
-	0x41, 0x31, 0x0a, 0x2f, 0x2a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x32, 0x18, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4, 0xb8, 0xbb, 0xe6,
-	0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01,
-	0x02, 0x69, 0x70, 0x22, 0xc2, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0,
-	0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94,
-	0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x42,
-	0x63, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x11, 0x92,
-	0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x50, 0x92, 0x41, 0x4d, 0x0a, 0x4b, 0x2a, 0x0f, 0x47,
-	0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1e,
-	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7,
-	0xbd, 0xae, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x0e, 0x50, 0x75, 0x74,
-	0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x02, 0x69,
-	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x0e, 0x2a, 0x02, 0x69, 0x70,
-	0x32, 0x08, 0x49, 0x50, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70,
-	0x01, 0x52, 0x02, 0x69, 0x70, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85,
+	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x64, 0x92, 0x41, 0x61,
+	0x0a, 0x5f, 0x2a, 0x1a, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x27,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x85, 0xb3, 0xe7,
+	0xb3, 0xbb, 0xef, 0xbc, 0x8c, 0xe8, 0xa6, 0x86, 0xe7, 0x9b, 0x96, 0xe4, 0xbf, 0xae, 0xe6, 0x94,
+	0xb9, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x8e, 0x02, 0x0a, 0x1a, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85,
 	0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49,
 	0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53,
-	0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10,
+	0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d,
+	0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x2b, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x19, 0x92,
+	0x41, 0x0e, 0x32, 0x07, 0x49, 0x50, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x2a, 0x03, 0x69, 0x70, 0x73,
+	0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x03, 0x69, 0x70, 0x73, 0x3a, 0x43, 0x92,
+	0x41, 0x40, 0x0a, 0x3e, 0x2a, 0x1a, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x32, 0x20, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x85,
+	0xb3, 0xe7, 0xb3, 0xbb, 0x28, 0xe5, 0xa2, 0x9e, 0xe9, 0x87, 0x8f, 0x29, 0xe8, 0xaf, 0xb7, 0xe6,
+	0xb1, 0x82, 0x22, 0xe3, 0x02, 0x0a, 0x1b, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94,
+	0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4,
+	0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19,
+	0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94,
+	0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6,
+	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x3a, 0x65, 0x92, 0x41, 0x62, 0x0a, 0x60, 0x2a, 0x1b, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x27, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x9b, 0x86,
+	0xe7, 0xbe, 0xa4, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0xef, 0xbc, 0x8c, 0xe5, 0xa2, 0x9e, 0xe9,
+	0x87, 0x8f, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xcf, 0x02, 0x0a, 0x06, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83,
+	0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef,
+	0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b,
+	0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x32, 0x0f, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04,
+	0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9,
+	0xba, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32,
+	0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x3b, 0x92,
+	0x41, 0x38, 0x0a, 0x36, 0x32, 0x06, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xd2, 0x01, 0x09, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x04, 0x74, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x2a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0xae, 0x04, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf,
+	0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0,
+	0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58,
+	0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10,
 	0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
-	0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41,
-	0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x40, 0x92, 0x41, 0x3d, 0x0a, 0x3b, 0x2a, 0x0e, 0x50, 0x75,
-	0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x18, 0xe5, 0x88,
-	0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae,
-	0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x02, 0x69, 0x70, 0xd2, 0x01, 0x09, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc2, 0x02, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x48,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8,
-	0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41,
-	0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5,
-	0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6,
-	0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95,
-	0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x50, 0x92, 0x41, 0x4d, 0x0a,
-	0x4b, 0x2a, 0x0f, 0x50, 0x75, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0x1e, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba,
-	0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe7, 0xbb, 0x93, 0xe6,
-	0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7c, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92,
-	0x41, 0x14, 0x2a, 0x02, 0x69, 0x70, 0x32, 0x0e, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0x49, 0x50,
-	0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69,
-	0x70, 0x3a, 0x37, 0x92, 0x41, 0x34, 0x0a, 0x32, 0x2a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x18, 0xe5, 0x88, 0xa0,
-	0xe9, 0x99, 0xa4, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe8,
-	0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x02, 0x69, 0x70, 0x22, 0xc8, 0x02, 0x0a, 0x12, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x41, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb,
+	0xe5, 0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7,
+	0xb0, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e,
+	0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92,
+	0x41, 0x15, 0x2a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1,
+	0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x3b,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x23,
+	0x92, 0x41, 0x19, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7,
+	0xbb, 0xe9, 0x87, 0x8f, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0xfa, 0x42, 0x04, 0x32,
+	0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x2a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99,
+	0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18,
+	0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x51, 0x92, 0x41, 0x4e, 0x0a, 0x4c, 0x2a,
+	0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe8,
+	0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb8, 0x02, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
 	0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5,
 	0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64,
@@ -15338,186 +15593,47 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92,
 	0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5,
 	0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x3a, 0x53, 0x92, 0x41, 0x50, 0x0a, 0x4e, 0x2a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1e, 0xe8, 0xbf, 0x94,
-	0xe5, 0x9b, 0x9e, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe5,
-	0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41,
-	0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43,
-	0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86,
-	0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8,
-	0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42,
-	0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d,
-	0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x3a, 0x3c, 0x92, 0x41, 0x39, 0x0a, 0x37,
-	0x2a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x32, 0x18, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9,
-	0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc4, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
-	0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99,
-	0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92,
-	0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94,
-	0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93,
-	0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x42, 0x63, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x6f,
-	0x73, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6,
-	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x51, 0x92, 0x41, 0x4e,
-	0x0a, 0x4c, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1e, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe4, 0xb8, 0xbb, 0xe6,
-	0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb,
-	0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9e,
-	0x02, 0x0a, 0x19, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32,
-	0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8,
-	0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc,
-	0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58,
-	0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d,
-	0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53,
-	0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x03,
-	0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x0e, 0x2a, 0x03,
-	0x69, 0x70, 0x73, 0x32, 0x07, 0x49, 0x50, 0x20, 0x6c, 0x69, 0x73, 0x74, 0xfa, 0x42, 0x05, 0x92,
-	0x01, 0x02, 0x08, 0x01, 0x52, 0x03, 0x69, 0x70, 0x73, 0x3a, 0x54, 0x92, 0x41, 0x51, 0x0a, 0x4f,
-	0x2a, 0x19, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x20, 0xe4, 0xbf, 0xae,
-	0xe6, 0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0x28,
-	0xe8, 0xa6, 0x86, 0xe7, 0x9b, 0x96, 0x29, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x03, 0x69, 0x70, 0x73, 0x22,
-	0xe1, 0x02, 0x0a, 0x1a, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
-	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41,
-	0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9,
-	0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8,
-	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
-	0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7,
-	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
-	0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x64, 0x92,
-	0x41, 0x61, 0x0a, 0x5f, 0x2a, 0x1a, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0x27, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x85,
-	0xb3, 0xe7, 0xb3, 0xbb, 0xef, 0xbc, 0x8c, 0xe8, 0xa6, 0x86, 0xe7, 0x9b, 0x96, 0xe4, 0xbf, 0xae,
-	0xe6, 0x94, 0xb9, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x8e, 0x02, 0x0a, 0x1a, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8,
-	0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc,
-	0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38,
-	0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64,
-	0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b,
-	0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x2b, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x19, 0x92, 0x41, 0x0e, 0x2a, 0x03, 0x69, 0x70, 0x73, 0x32, 0x07, 0x49, 0x50, 0x20, 0x6c, 0x69,
-	0x73, 0x74, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x03, 0x69, 0x70, 0x73, 0x3a,
-	0x43, 0x92, 0x41, 0x40, 0x0a, 0x3e, 0x2a, 0x1a, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x32, 0x20, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4,
-	0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0x28, 0xe5, 0xa2, 0x9e, 0xe9, 0x87, 0x8f, 0x29, 0xe8, 0xaf,
-	0xb7, 0xe6, 0xb1, 0x82, 0x22, 0xe3, 0x02, 0x0a, 0x1b, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8,
-	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf,
-	0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8,
-	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x3a, 0x65, 0x92, 0x41, 0x62, 0x0a, 0x60, 0x2a, 0x1b, 0x50, 0x6f, 0x73, 0x74,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x27, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9,
-	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0xef, 0xbc, 0x8c, 0xe5, 0xa2,
-	0x9e, 0xe9, 0x87, 0x8f, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
-	0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xcf, 0x02, 0x0a, 0x06, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85,
-	0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49,
-	0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53,
-	0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10,
-	0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
-	0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d,
-	0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb, 0xe5,
-	0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a,
-	0x3b, 0x92, 0x41, 0x38, 0x0a, 0x36, 0x2a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x32, 0x06,
-	0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xae, 0x04, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4,
-	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c,
-	0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53,
-	0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32,
+	0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6,
+	0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x4c, 0x92, 0x41, 0x49, 0x0a, 0x47, 0xd2,
+	0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2,
+	0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x18, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
+	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x22, 0xf4, 0x03, 0x0a, 0x10, 0x50, 0x75, 0x74, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60,
+	0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30,
+	0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9,
+	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f,
+	0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58,
+	0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x32,
 	0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24,
-	0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9,
-	0x97, 0xb4, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x06, 0xe7,
-	0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d,
-	0xe7, 0xa7, 0xb0, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x2e, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x32, 0x0c, 0xe6, 0x89, 0xa9,
-	0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x32, 0x0f, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0xfa, 0x42,
-	0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41,
-	0x1b, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32,
-	0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x51, 0x92, 0x41, 0x4e, 0x0a,
-	0x4c, 0x2a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8c, 0x87, 0xe6, 0xa0,
-	0x87, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2,
-	0x01, 0x04, 0x74, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb8, 0x02,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23,
+	0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c,
+	0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2c,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41,
+	0x0e, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x0e, 0x2a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x0c,
+	0xe9, 0xa2, 0x9d, 0xe5, 0xa4, 0x96, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x2a, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95,
+	0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x51, 0x92, 0x41, 0x4e, 0x0a,
+	0x4c, 0x32, 0x12, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe8,
+	0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x10, 0x50, 0x75, 0x74,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc0, 0x02,
+	0x0a, 0x11, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf,
 	0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63,
@@ -15528,113 +15644,172 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42,
 	0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf,
 	0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95,
-	0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x4c, 0x92, 0x41, 0x49, 0x0a,
-	0x47, 0x2a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x18, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x8c, 0x87, 0xe6,
-	0xa0, 0x87, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf4, 0x03, 0x0a, 0x10, 0x50, 0x75, 0x74,
+	0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06,
+	0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x3a, 0x4c, 0x92, 0x41, 0x49, 0x0a, 0x47, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2a, 0x11, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x18, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x8c,
+	0x87, 0xe6, 0xa0, 0x87, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+	0x22, 0x8a, 0x03, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d,
+	0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53,
+	0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7,
+	0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba,
+	0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d,
+	0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b,
+	0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x09, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x19,
+	0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x2a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x0e, 0x2a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x54, 0x92, 0x41, 0x51, 0x0a, 0x4f, 0xd2, 0x01,
+	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x04, 0x74, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x12, 0xe5, 0x88, 0xa0, 0xe9, 0x99,
+	0xa4, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x22, 0xc6, 0x02,
+	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99,
+	0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7,
+	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x4f, 0x92, 0x41, 0x4c, 0x0a, 0x4a, 0xd2, 0x01, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x18, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4,
+	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x22, 0x83, 0x04, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a,
 	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94,
-	0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5,
-	0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58,
-	0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30,
+	0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8,
+	0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc,
+	0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38,
+	0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30,
 	0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43,
-	0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a,
+	0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a,
 	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xfa, 0x42,
-	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0x92, 0x41, 0x0e, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e,
-	0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41,
-	0x0e, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15,
-	0x2a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x32, 0x0c, 0xe9, 0xa2, 0x9d, 0xe5, 0xa4, 0x96, 0xe5,
-	0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x3e, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06,
-	0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x51, 0x92, 0x41,
-	0x4e, 0x0a, 0x4c, 0x2a, 0x10, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x12, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x8c, 0x87,
-	0xe6, 0xa0, 0x87, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0xd2, 0x01, 0x04, 0x74, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0xc0, 0x02, 0x0a, 0x11, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f,
-	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8,
-	0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c,
-	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x4c, 0x92, 0x41, 0x49, 0x0a, 0x47, 0x2a, 0x11, 0x50, 0x75, 0x74,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x18,
-	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe4, 0xbf, 0xae, 0xe6,
-	0x94, 0xb9, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2,
-	0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x8a, 0x03, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92,
-	0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42,
-	0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b,
-	0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4,
-	0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa,
-	0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61,
-	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52,
-	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92,
-	0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5,
-	0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xfa, 0x42, 0x04, 0x72, 0x02,
-	0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2c, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x0e,
-	0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xfa, 0x42,
-	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x0e, 0x2a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x54, 0x92, 0x41, 0x51, 0x0a, 0x4f,
-	0x2a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x12, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6, 0x8c, 0x87,
-	0xe6, 0xa0, 0x87, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0xd2, 0x01, 0x04, 0x74, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0xc6, 0x02, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x42, 0x1c, 0x92, 0x41, 0x19, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba,
+	0xe9, 0x97, 0xb4, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7,
+	0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x0c, 0xe6, 0x89, 0xa9,
+	0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x2a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81, 0x8f, 0xe7,
+	0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
+	0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x2a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x3a, 0x39, 0x92, 0x41, 0x36, 0x0a, 0x34, 0x2a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x12, 0xe6, 0x9f,
+	0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82,
+	0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbc, 0x02, 0x0a,
+	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8,
+	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5,
+	0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8,
+	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6,
+	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x4e, 0x92, 0x41, 0x4b,
+	0x0a, 0x49, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x13, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0x18, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe6,
+	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x22, 0x5e, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x3a, 0x43, 0x92, 0x41, 0x40, 0x0a, 0x3e, 0x2a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x23, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8c,
+	0x87, 0xe6, 0xa0, 0x87, 0xe8, 0xa1, 0xa8, 0xe5, 0x90, 0x8d, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82,
+	0x28, 0xe6, 0x97, 0xa0, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x29, 0x22, 0xbb, 0x02, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64,
 	0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7,
 	0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9,
-	0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x12,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6,
+	0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
 	0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11,
-	0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d,
-	0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x4f, 0x92, 0x41, 0x4c, 0x0a, 0x4a, 0x2a, 0x14,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x18, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x8c, 0x87, 0xe6,
-	0xa0, 0x87, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x83, 0x04, 0x0a, 0x12, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6,
+	0x8d, 0xae, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x59,
+	0x92, 0x41, 0x56, 0x0a, 0x54, 0x2a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0x1e, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe8, 0xa1, 0xa8,
+	0xe5, 0x90, 0x8d, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2,
+	0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2,
+	0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc6, 0x02, 0x0a, 0x12, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd,
+	0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6,
+	0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d,
+	0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d,
+	0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53,
+	0x2d, 0x10, 0x02, 0x18, 0x64, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0x92, 0x41, 0x0e, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e,
+	0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x46,
+	0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x13, 0x92, 0x41, 0x10, 0x2a, 0x06,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x92, 0x41, 0x37, 0x0a, 0x35, 0xd2, 0x01, 0x09,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x2a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x32, 0x0c, 0x77, 0x61, 0x74, 0x63, 0x68, 0x20, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x22, 0xb8, 0x01, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0f, 0x92, 0x41, 0x0c, 0x32, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x32, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x3a, 0x92, 0x41, 0x37, 0x0a, 0x35, 0x32, 0x17, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
+	0x9c, 0xd2, 0x01, 0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x03,
+	0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
 	0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
 	0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf,
@@ -15646,528 +15821,374 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
 	0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4,
-	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x06, 0xe5, 0x90, 0x8d,
-	0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x2a, 0x05, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe5, 0xad, 0x97, 0xe6,
-	0xae, 0xb5, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x32, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x81,
-	0x8f, 0xe7, 0xa7, 0xbb, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0x92, 0x41, 0x1b, 0x2a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x32, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6,
-	0x95, 0xb0, 0xe9, 0x87, 0x8f, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x0a, 0x52, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x3a, 0x39, 0x92, 0x41, 0x36, 0x0a, 0x34, 0x2a, 0x12, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x12,
-	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe8, 0xaf, 0xb7, 0xe6,
-	0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbc,
-	0x02, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32,
-	0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81,
-	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99,
-	0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32,
-	0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
-	0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x4e, 0x92,
-	0x41, 0x4b, 0x0a, 0x49, 0x2a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x18, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
-	0x9e, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93,
-	0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a,
-	0x17, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x3a, 0x43, 0x92, 0x41, 0x40, 0x0a, 0x3e, 0x2a,
-	0x17, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x23, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe8, 0xa1, 0xa8, 0xe5, 0x90, 0x8d, 0xe8, 0xaf, 0xb7, 0xe6,
-	0xb1, 0x82, 0x28, 0xe6, 0x97, 0xa0, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x29, 0x22, 0xbb, 0x02,
-	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf,
-	0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d,
-	0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
-	0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
-	0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x3a, 0x59, 0x92, 0x41, 0x56, 0x0a, 0x54, 0x2a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0x1e, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe8,
-	0xa1, 0xa8, 0xe5, 0x90, 0x8d, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
-	0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc6, 0x02, 0x0a, 0x12,
-	0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8,
-	0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc,
-	0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38,
-	0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64,
-	0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b,
-	0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x06, 0xe7, 0xb1, 0xbb,
-	0xe5, 0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x46, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x13, 0x92, 0x41, 0x10,
-	0x2a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x92, 0x41, 0x37, 0x0a, 0x35, 0x2a,
-	0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x32, 0x0c, 0x77, 0x61, 0x74, 0x63, 0x68, 0x20, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0f, 0x92, 0x41, 0x0c, 0x2a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x32, 0x04, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x3a, 0x92, 0x41, 0x37, 0x0a, 0x35, 0x2a, 0x13, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
-	0x17, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe4, 0xba, 0x8b, 0xe4,
-	0xbb, 0xb6, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0xb7, 0x03, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4,
-	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c,
-	0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53,
-	0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32,
-	0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24,
-	0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9,
-	0x97, 0xb4, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x43, 0x0a,
-	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1,
-	0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84,
-	0xe6, 0xba, 0x90, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x60, 0x92, 0x41, 0x5d, 0x0a, 0x5b, 0x2a, 0x10,
-	0x4b, 0x38, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x32, 0x11, 0x6b, 0x38, 0x73, 0xe7, 0x9a, 0x84, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84,
-	0xe6, 0xba, 0x90, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2,
-	0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xe9, 0x03, 0x0a, 0x1a, 0x4b, 0x38,
-	0x53, 0x47, 0x65, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d,
-	0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53,
-	0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7,
-	0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba,
-	0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d,
-	0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a,
-	0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x19,
-	0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd,
-	0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
-	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x72,
 	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5,
-	0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92,
-	0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xfa, 0x42,
-	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x3a, 0x70, 0x92, 0x41, 0x6d, 0x0a, 0x6b, 0x2a, 0x1a, 0x4b, 0x38, 0x53, 0x47,
+	0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1,
+	0xbb, 0xe5, 0x9e, 0x8b, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba,
+	0x90, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x60, 0x92, 0x41, 0x5d, 0x0a, 0x5b, 0xd2, 0x01, 0x09, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x2a, 0x10, 0x4b, 0x38, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x32, 0x11, 0x6b, 0x38, 0x73, 0xe7, 0x9a, 0x84, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x22, 0xe9, 0x03, 0x0a, 0x1a, 0x4b, 0x38, 0x53, 0x47,
 	0x65, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x17, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x77, 0x61,
-	0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2,
-	0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd9, 0x02, 0x0a, 0x1b, 0x4b, 0x38, 0x53, 0x47, 0x65, 0x74,
-	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f,
-	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8,
-	0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c,
-	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x5b, 0x92, 0x41, 0x58, 0x0a, 0x56, 0x2a, 0x1b, 0x4b, 0x38, 0x53,
-	0x47, 0x65, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1d, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
-	0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0xa9, 0x04, 0x0a, 0x1a, 0x4b, 0x38, 0x53, 0x50, 0x75, 0x74, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd,
-	0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6,
-	0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d,
-	0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f,
-	0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a,
-	0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x41, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97,
-	0xb4, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5,
-	0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
-	0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
-	0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6,
-	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x70, 0x92, 0x41, 0x6d,
-	0x0a, 0x6b, 0x2a, 0x1a, 0x4b, 0x38, 0x53, 0x50, 0x75, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x17,
-	0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba,
-	0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2,
-	0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xd2, 0x01,
-	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd9, 0x02,
-	0x0a, 0x1b, 0x4b, 0x38, 0x53, 0x50, 0x75, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17,
-	0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94,
-	0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20,
-	0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf,
-	0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb,
-	0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06,
-	0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x5b, 0x92, 0x41,
-	0x58, 0x0a, 0x56, 0x2a, 0x1b, 0x4b, 0x38, 0x53, 0x50, 0x75, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30,
+	0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9,
+	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f,
+	0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58,
+	0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b,
+	0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d,
+	0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x52, 0x09, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90,
+	0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c,
+	0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0x0c,
+	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x3a, 0x70, 0x92, 0x41, 0x6d, 0x0a, 0x6b, 0x32, 0x17, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1,
+	0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x2a, 0x1a, 0x4b, 0x38, 0x53, 0x47, 0x65, 0x74, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xd9, 0x02, 0x0a, 0x1b, 0x4b, 0x38, 0x53, 0x47, 0x65, 0x74, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94,
+	0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf,
+	0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x3a, 0x5b, 0x92, 0x41, 0x58, 0x0a, 0x56, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2a, 0x1b, 0x4b, 0x38, 0x53, 0x47, 0x65, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68,
 	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x32, 0x1d, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84,
-	0xe6, 0xba, 0x90, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2,
-	0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2,
-	0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xef, 0x03, 0x0a, 0x1d, 0x4b, 0x38,
+	0xe6, 0xba, 0x90, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x22,
+	0xa9, 0x04, 0x0a, 0x1a, 0x4b, 0x38, 0x53, 0x50, 0x75, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e,
+	0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x60, 0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83,
+	0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef,
+	0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b,
+	0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02,
+	0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d,
+	0x5d, 0x2b, 0x24, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6,
+	0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a,
+	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
+	0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95, 0xb0,
+	0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x70, 0x92, 0x41, 0x6d, 0x0a, 0x6b,
+	0x32, 0x17, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84,
+	0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x2a,
+	0x1a, 0x4b, 0x38, 0x53, 0x50, 0x75, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd9, 0x02, 0x0a, 0x1b,
+	0x4b, 0x38, 0x53, 0x50, 0x75, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8,
+	0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41,
+	0x1d, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4,
+	0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x0c, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6, 0x95,
+	0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x5b, 0x92, 0x41, 0x58, 0x0a,
+	0x56, 0x2a, 0x1b, 0x4b, 0x38, 0x53, 0x50, 0x75, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1d,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba,
+	0x90, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xef, 0x03, 0x0a, 0x1d, 0x4b, 0x38, 0x53, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41,
+	0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30, 0x42, 0x43,
+	0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86,
+	0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8,
+	0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58, 0xfa, 0x42,
+	0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x52, 0x09,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41,
+	0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c, 0xe5, 0x91,
+	0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb,
+	0xe5, 0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26,
+	0x92, 0x41, 0x1c, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x90, 0x8d, 0xe7, 0xa7,
+	0xb0, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x73, 0x92, 0x41, 0x70, 0x0a, 0x6e, 0x32, 0x17, 0xe5, 0x88, 0xa0,
+	0xe9, 0x99, 0xa4, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf,
+	0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x0c, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x2a, 0x1d, 0x4b, 0x38, 0x53, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdf, 0x02, 0x0a, 0x1e, 0x4b, 0x38,
 	0x53, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99,
+	0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92,
+	0x41, 0x1d, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf,
+	0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x0c, 0xe8,
+	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x2a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6,
+	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x5e, 0x92, 0x41, 0x5b,
+	0x0a, 0x59, 0x32, 0x1d, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8,
+	0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
+	0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x1e, 0x4b, 0x38, 0x53,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x03, 0x0a, 0x1b,
+	0x4b, 0x38, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60,
 	0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x32, 0x30,
 	0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9,
 	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f,
 	0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58, 0x58, 0x58,
-	0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d,
+	0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02,
 	0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6e,
 	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23,
-	0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x0c,
-	0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xfa, 0x42, 0x04, 0x72,
+	0x92, 0x41, 0x19, 0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97,
+	0xb4, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xfa, 0x42, 0x04, 0x72,
 	0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x4a,
 	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7,
 	0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x90, 0x8d, 0xe7, 0xa7,
-	0xb0, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x73, 0x92, 0x41, 0x70, 0x0a, 0x6e, 0x2a, 0x1d, 0x4b,
-	0x38, 0x53, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x17, 0xe5, 0x88,
-	0xa0, 0xe9, 0x99, 0xa4, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8,
-	0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x0c,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x0c, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xdf, 0x02, 0x0a, 0x1e,
-	0x4b, 0x38, 0x53, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x68, 0x92, 0x41, 0x65, 0x0a,
+	0x63, 0x2a, 0x1b, 0x4b, 0x38, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1d,
+	0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2, 0x01, 0x09,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0xc8, 0x02, 0x0a, 0x1c, 0x4b, 0x38, 0x53, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
+	0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8,
+	0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x06, 0xe6,
+	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x62, 0x92, 0x41, 0x5f,
+	0x0a, 0x5d, 0x32, 0x23, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8,
+	0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2a, 0x1c, 0x4b, 0x38, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xdf, 0x01, 0x0a, 0x17, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60,
+	0x92, 0x41, 0x3d, 0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd,
+	0xbf, 0xe7, 0x94, 0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6,
+	0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d,
+	0x58, 0x58, 0x58, 0x58, 0x58, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x3a, 0x04, 0x42, 0x43, 0x53, 0x2d, 0x10, 0x02, 0x18, 0x64, 0x32,
+	0x0f, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24,
+	0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x3a, 0x44, 0x92, 0x41, 0x41,
+	0x0a, 0x3f, 0x2a, 0x17, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x18, 0xe6, 0xb8, 0x85,
+	0xe7, 0x90, 0x86, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe6,
+	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0xae, 0x03, 0x0a, 0x18, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
 	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41,
 	0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9,
 	0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a,
 	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8,
 	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
-	0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7,
-	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
-	0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x5e, 0x92,
-	0x41, 0x5b, 0x0a, 0x59, 0x2a, 0x1e, 0x4b, 0x38, 0x53, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1d, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63,
-	0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xbb, 0x93,
-	0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x96, 0x03,
-	0x0a, 0x1b, 0x4b, 0x38, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a,
-	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94,
-	0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5,
-	0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58,
-	0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30,
-	0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43,
-	0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a,
-	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x23, 0x92, 0x41, 0x19, 0x2a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x32, 0x0c, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xfa, 0x42,
-	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x1c, 0x2a, 0x0c, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0x0c, 0xe8, 0xb5, 0x84, 0xe6, 0xba,
-	0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x68, 0x92, 0x41,
-	0x65, 0x0a, 0x63, 0x2a, 0x1b, 0x4b, 0x38, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x32, 0x1d, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x77, 0x61,
-	0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xd2,
-	0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xc8, 0x02, 0x0a, 0x1c, 0x4b, 0x38, 0x53, 0x4c, 0x69,
-	0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0,
-	0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94,
-	0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x42, 0x19, 0x92, 0x41, 0x16, 0x2a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x32, 0x0c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
-	0x06, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x62, 0x92,
-	0x41, 0x5f, 0x0a, 0x5d, 0x2a, 0x1c, 0x4b, 0x38, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0x23, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8,
-	0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0xdf, 0x01, 0x0a, 0x17, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a,
-	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x60, 0x92, 0x41, 0x3d, 0x2a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x32, 0x30, 0x42, 0x43, 0x53, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe4, 0xbd, 0xbf, 0xe7, 0x94,
-	0xa8, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5,
-	0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x42, 0x43, 0x53, 0x2d, 0x4b, 0x38, 0x53, 0x2d, 0x58, 0x58, 0x58,
-	0x58, 0x58, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x02, 0x18, 0x64, 0x32, 0x0f, 0x5e, 0x5b, 0x30,
-	0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x04, 0x42, 0x43,
-	0x53, 0x2d, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x3a, 0x44, 0x92,
-	0x41, 0x41, 0x0a, 0x3f, 0x2a, 0x17, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x18, 0xe6,
-	0xb8, 0x85, 0xe7, 0x90, 0x86, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe6, 0x89, 0x80, 0xe6, 0x9c,
-	0x89, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xd2, 0x01, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0xae, 0x03, 0x0a, 0x18, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a,
-	0x92, 0x41, 0x17, 0x2a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x0f, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
-	0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe7, 0xa0, 0x81, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32,
-	0x12, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1,
-	0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x91, 0x01, 0x0a,
-	0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43,
-	0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x35, 0x92, 0x41, 0x32, 0x2a,
-	0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x32, 0x21,
-	0xe5, 0x90, 0x84, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe5,
-	0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0xe6, 0x95,
-	0xb0, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x1a, 0x40, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x3a, 0x50, 0x92, 0x41, 0x4d, 0x0a, 0x4b, 0x2a, 0x18, 0x43, 0x6c, 0x65, 0x61, 0x6e,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x32, 0x1e, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0x9b, 0x86, 0xe7, 0xbe,
-	0xa4, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xe6, 0xb8, 0x85, 0xe7, 0x90, 0x86, 0xe7, 0xbb, 0x93,
-	0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x32, 0xd3, 0x90, 0x01, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x12, 0xa3, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x12,
-	0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c,
-	0x61, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x92, 0x41, 0x3c, 0x12, 0x12, 0xe6, 0x8e, 0xa5,
-	0xe6, 0x94, 0xb6, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x1a,
-	0x26, 0xe6, 0x8e, 0xa5, 0xe6, 0x94, 0xb6, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0x95, 0xb0,
-	0xe6, 0x8d, 0xae, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe4, 0xbf, 0x9d, 0xe5, 0xad, 0x98, 0xe5,
-	0x88, 0xb0, 0x64, 0x62, 0xe4, 0xb8, 0xad, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f,
-	0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x6c,
-	0x61, 0x72, 0x6d, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xb0, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x6c, 0x61, 0x72, 0x6d, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x6c, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x92, 0x41,
-	0x4c, 0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6,
-	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x1a, 0x36, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0xe6, 0x9d, 0xa1,
-	0xe4, 0xbb, 0xb6, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6,
-	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
-	0x9e, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2f, 0x76, 0x32, 0x2f, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x73, 0x12, 0xda, 0x01, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x80, 0x01, 0x92, 0x41, 0x43, 0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8,
-	0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x1a, 0x2d,
-	0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe7, 0x9a, 0x84, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x12, 0xe4, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x74, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x2e, 0x73,
+	0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x0d, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x35, 0x92, 0x41, 0x32, 0x2a, 0x0d, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x32, 0x21, 0xe5, 0x90,
+	0x84, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe5, 0x88, 0xa0,
+	0xe9, 0x99, 0xa4, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0xe6, 0x95, 0xb0, 0x52,
+	0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x40,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x3a, 0x50, 0x92, 0x41, 0x4d, 0x0a, 0x4b, 0x32, 0x1e, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9,
+	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xe6, 0xb8, 0x85, 0xe7, 0x90,
+	0x86, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x18, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xd3, 0x90, 0x01, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12,
+	0xa3, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x12, 0x19, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c, 0x61, 0x72,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x92, 0x41, 0x3c, 0x1a, 0x26, 0xe6, 0x8e, 0xa5, 0xe6, 0x94,
+	0xb6, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xef, 0xbc, 0x8c,
+	0xe5, 0xb9, 0xb6, 0xe4, 0xbf, 0x9d, 0xe5, 0xad, 0x98, 0xe5, 0x88, 0xb0, 0x64, 0x62, 0xe4, 0xb8,
+	0xad, 0x12, 0x12, 0xe6, 0x8e, 0xa5, 0xe6, 0x94, 0xb6, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6,
+	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x62, 0x63,
+	0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x6c, 0x61, 0x72,
+	0x6d, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xb0, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x61, 0x72, 0x6d, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x61,
+	0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x92, 0x41, 0x4c, 0x12,
+	0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0x95, 0xb0,
+	0xe6, 0x8d, 0xae, 0x1a, 0x36, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb,
+	0xb6, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0x95, 0xb0,
+	0xe6, 0x8d, 0xae, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6,
+	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x12, 0x15, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76,
+	0x32, 0x2f, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x73, 0x12, 0xda, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x80, 0x01, 0x92, 0x41, 0x43, 0x1a, 0x2d, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae,
+	0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe7, 0x9a, 0x84, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe,
+	0xa4, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9,
+	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x34, 0x12, 0x32, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76,
+	0x32, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x7d, 0x12, 0xe4, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73,
 	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x8a, 0x01, 0x92, 0x41, 0x4a, 0x12, 0x1b, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6,
-	0x88, 0x96, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe9, 0x85,
-	0x8d, 0xe7, 0xbd, 0xae, 0x1a, 0x2b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x20, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x88, 0x96, 0xe4,
-	0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe9, 0x85, 0x8d, 0xe7, 0xbd,
-	0xae, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x1a, 0x32, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f,
-	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xc2,
-	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x8a, 0x01, 0x92, 0x41, 0x4a, 0x1a, 0x2b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x20, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x88, 0x96,
+	0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe9, 0x85, 0x8d, 0xe7,
+	0xbd, 0xae, 0x12, 0x1b, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x88, 0x96, 0xe4, 0xbf, 0xae,
+	0xe6, 0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x37, 0x1a, 0x32, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xc2, 0x01, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65,
 	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x92, 0x41, 0x2e, 0x12, 0x12, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe9, 0x85, 0x8d, 0xe7, 0xbd,
-	0xae, 0x1a, 0x18, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x32, 0x12, 0x30, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76,
-	0x32, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x7d, 0x12, 0xd5, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x92, 0x41, 0x2e, 0x1a, 0x18, 0xe6, 0xa0, 0xb9,
+	0xe6, 0x8d, 0xae, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9,
+	0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x9c, 0x8d,
+	0xe5, 0x8a, 0xa1, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12,
+	0x30, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x7d, 0x12, 0xd5, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
 	0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x92,
-	0x41, 0x41, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xa8, 0xb3, 0xe5, 0xae, 0x9a,
-	0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x1a, 0x2b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0xaf,
-	0xb9, 0xe5, 0xba, 0x94, 0xe7, 0x9a, 0x84, 0xe7, 0xa8, 0xb3, 0xe5, 0xae, 0x9a, 0xe7, 0x89, 0x88,
-	0xe6, 0x9c, 0xac, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x62, 0x63, 0x73, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x7d, 0x12, 0xcc, 0x01, 0x0a, 0x10,
-	0x50, 0x75, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x53, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74,
-	0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0x92, 0x41, 0x35, 0x12, 0x12, 0xe5, 0x88, 0x9b, 0xe5,
-	0xbb, 0xba, 0xe7, 0xa8, 0xb3, 0xe5, 0xae, 0x9a, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x1a, 0x1f,
-	0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xe5, 0x88, 0x9b,
-	0xe5, 0xbb, 0xba, 0xe7, 0xa8, 0xb3, 0xe5, 0xae, 0x9a, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x35, 0x1a, 0x30, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xc7, 0x02, 0x0a, 0x18, 0x47,
-	0x65, 0x74, 0x4b, 0x38, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdb, 0x01, 0x92, 0x41, 0x58, 0x12, 0x18, 0xe6, 0x9f,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x92, 0x41, 0x41,
+	0x1a, 0x2b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xef,
+	0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0xaf, 0xb9, 0xe5, 0xba, 0x94, 0xe7, 0x9a,
+	0x84, 0xe7, 0xa8, 0xb3, 0xe5, 0xae, 0x9a, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x12, 0x12, 0xe8,
+	0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xa8, 0xb3, 0xe5, 0xae, 0x9a, 0xe7, 0x89, 0x88, 0xe6, 0x9c,
+	0xac, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x7d, 0x12, 0xcc, 0x01, 0x0a, 0x10, 0x50, 0x75,
+	0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x53, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x53, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x73, 0x92, 0x41, 0x35, 0x1a, 0x1f, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe7, 0xa8, 0xb3,
+	0xe5, 0xae, 0x9a, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x12, 0x12, 0xe5, 0x88, 0x9b, 0xe5, 0xbb,
+	0xba, 0xe7, 0xa8, 0xb3, 0xe5, 0xae, 0x9a, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x35, 0x1a, 0x30, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xc7, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x4b, 0x38, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdb, 0x01, 0x92, 0x41, 0x58, 0x1a, 0x3c, 0xe6, 0xa0, 0xb9, 0xe6,
+	0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f,
 	0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4,
-	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x3c, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe5,
-	0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb,
-	0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x7a, 0x12, 0x78, 0x2f, 0x62, 0x63, 0x73,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64,
-	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x7d, 0x12, 0xe2, 0x02, 0x0a, 0x18, 0x50, 0x75, 0x74, 0x4b, 0x38, 0x53, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xf6, 0x01, 0x92, 0x41, 0x70, 0x12, 0x21, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x88,
-	0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba,
-	0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x4b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d,
-	0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe4, 0xbf, 0xae,
-	0xe6, 0x94, 0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe5, 0x91, 0xbd, 0xe5,
-	0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc,
-	0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c,
-	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x7d, 0x1a, 0x78, 0x2f, 0x62,
-	0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73,
-	0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xd6, 0x02, 0x0a, 0x1b, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4b, 0x38, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe1,
-	0x01, 0x92, 0x41, 0x5e, 0x12, 0x18, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0x91, 0xbd, 0xe5,
-	0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x42,
-	0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef,
-	0xbc, 0x8c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9,
-	0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6,
-	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6,
-	0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x7a, 0x2a, 0x78, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74,
+	0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x9f, 0xa5, 0xe8,
+	0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x18, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
+	0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6,
+	0xba, 0x90, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x7a, 0x12, 0x78, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74,
 	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e,
 	0x61, 0x6d, 0x69, 0x63, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72,
 	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
@@ -16175,1016 +16196,1060 @@ var file_pkg_proto_bcs_storage_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
 	0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
 	0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x7d, 0x12, 0xb9, 0x02, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x38, 0x53, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x12, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x65, 0x7d, 0x12, 0xe2, 0x02, 0x0a, 0x18, 0x50, 0x75, 0x74, 0x4b, 0x38, 0x53, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
+	0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x50, 0x75, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf6,
+	0x01, 0x92, 0x41, 0x70, 0x1a, 0x4b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x88,
+	0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba,
+	0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8,
+	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
+	0x9c, 0x12, 0x21, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5,
+	0xa2, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5,
+	0x84, 0xe6, 0xba, 0x90, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x7d, 0x1a, 0x78, 0x2f, 0x62, 0x63, 0x73,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xd6, 0x02, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4b, 0x38, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe1, 0x01, 0x92,
+	0x41, 0x5e, 0x12, 0x18, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d,
+	0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x42, 0xe6, 0xa0,
+	0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c,
+	0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9,
+	0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x7a, 0x2a, 0x78, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d,
+	0x69, 0x63, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+	0x12, 0xb9, 0x02, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x38, 0x53, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x26,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xca, 0x01, 0x92, 0x41, 0x56, 0x1a, 0x34, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5,
+	0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe5, 0xb9,
+	0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0xe6, 0x89, 0xb9,
+	0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7,
+	0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x6b, 0x12, 0x69, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76,
+	0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0xdf, 0x02, 0x0a,
+	0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x38, 0x53, 0x4e,
 	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xca, 0x01, 0x92, 0x41, 0x56, 0x12, 0x1e, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97,
-	0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x34, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c,
-	0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x6c, 0x69, 0x73, 0x74, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x6b, 0x12, 0x69, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x12, 0x2c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xde, 0x01,
+	0x92, 0x41, 0x6a, 0x12, 0x1e, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0, 0xe9, 0x99,
+	0xa4, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8, 0xb5, 0x84,
+	0xe6, 0xba, 0x90, 0x1a, 0x48, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0,
+	0xe9, 0x99, 0xa4, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8,
+	0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x6b, 0x2a, 0x69, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
 	0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f,
 	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c,
 	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
 	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f,
-	0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0xdf,
-	0x02, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x38,
-	0x53, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x2c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xde, 0x01, 0x92, 0x41, 0x6a, 0x12, 0x1e, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0,
-	0xe9, 0x99, 0xa4, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe8,
-	0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x48, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5,
-	0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97,
-	0xb4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94,
-	0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x6b, 0x2a, 0x69, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
-	0x63, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d,
-	0x12, 0xa2, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbc, 0x01, 0x92, 0x41, 0x52, 0x12, 0x12, 0xe6, 0x9f,
-	0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
-	0x1a, 0x3c, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4,
-	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5,
-	0x9b, 0x9e, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x61, 0x12, 0x5f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
-	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xbd, 0x02, 0x0a, 0x16, 0x50, 0x75, 0x74, 0x4b, 0x38, 0x53,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x12, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd7, 0x01, 0x92, 0x41,
-	0x6a, 0x12, 0x1b, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5,
-	0xa2, 0x9e, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x4b,
-	0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef,
-	0xbc, 0x8c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2,
-	0x9e, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe4, 0xbf, 0xa1,
-	0xe6, 0x81, 0xaf, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6,
-	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x64, 0x1a, 0x5f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76,
-	0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xab, 0x02, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4b, 0x38, 0x53, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73,
+	0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0xa2,
+	0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
 	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbc, 0x01, 0x92, 0x41, 0x52, 0x12, 0x12, 0xe5, 0x88, 0xa0, 0xe9,
-	0x99, 0xa4, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x3c,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbc, 0x01, 0x92, 0x41, 0x52, 0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8,
+	0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x3c,
 	0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef,
-	0xbc, 0x8c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5,
+	0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5,
 	0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
-	0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x61, 0x2a, 0x5f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
+	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x61, 0x12, 0x5f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
 	0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
 	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74,
 	0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
 	0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x7d, 0x12, 0x94, 0x02, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x38, 0x53, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
-	0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xab, 0x01, 0x92,
-	0x41, 0x50, 0x12, 0x18, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x34, 0xe6, 0xa0,
+	0x6d, 0x65, 0x7d, 0x12, 0xbd, 0x02, 0x0a, 0x16, 0x50, 0x75, 0x74, 0x4b, 0x38, 0x53, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x23,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75,
+	0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd7, 0x01, 0x92, 0x41, 0x6a, 0x1a,
+	0x4b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0xef, 0xbc, 0x8c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5,
+	0xa2, 0x9e, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe4, 0xbf,
+	0xa1, 0xe6, 0x81, 0xaf, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
+	0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x1b, 0xe4, 0xbf,
+	0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe9, 0x9b, 0x86,
+	0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x64, 0x1a,
+	0x5f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f,
+	0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+	0x3a, 0x01, 0x2a, 0x12, 0xab, 0x02, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x38,
+	0x53, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x12, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xbc, 0x01, 0x92, 0x41, 0x52, 0x12, 0x12, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4,
+	0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x3c, 0xe6, 0xa0,
 	0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c,
-	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6,
-	0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x6c, 0x69,
-	0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x52, 0x12, 0x50, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e,
-	0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f,
-	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0x93, 0x02, 0x0a, 0x1a, 0x4c,
-	0x69, 0x73, 0x74, 0x4b, 0x38, 0x53, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x6c,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa7, 0x01, 0x92, 0x41, 0x50, 0x12, 0x18, 0xe6, 0x89,
-	0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4,
-	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x34, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5,
-	0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x6c, 0x69, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x4e, 0x12, 0x4c, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
-	0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x61,
-	0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d,
-	0x12, 0xb4, 0x02, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x4b, 0x38, 0x53, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x2a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb9, 0x01, 0x92, 0x41,
-	0x5e, 0x12, 0x18, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe9,
-	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x42, 0xe6, 0xa0, 0xb9,
-	0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6,
-	0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe9, 0x9b, 0x86, 0xe7, 0xbe,
-	0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94,
-	0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x52, 0x2a, 0x50, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
-	0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0xb3, 0x02, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x38, 0x53, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb5, 0x01, 0x92, 0x41, 0x5e, 0x12, 0x18, 0xe6, 0x89, 0xb9,
-	0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8,
-	0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x42, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5,
-	0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba,
-	0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d,
-	0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4e, 0x2a,
-	0x4c, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f,
-	0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x61, 0x6c, 0x6c, 0x5f,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x7b,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0xfb, 0x01,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9b, 0x01,
-	0x92, 0x41, 0x5b, 0x12, 0x15, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe8, 0x87, 0xaa, 0xe5, 0xae,
-	0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x42, 0xe6, 0xa0, 0xb9, 0xe6,
-	0x8d, 0xae, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xef, 0xbc,
-	0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89,
+	0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6,
+	0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93,
+	0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x61,
+	0x2a, 0x5f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32,
+	0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x7d, 0x12, 0x94, 0x02, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x38, 0x53, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x24, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xab, 0x01, 0x92, 0x41, 0x50,
+	0x1a, 0x34, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4,
 	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5,
-	0x9b, 0x9e, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0x84, 0x02, 0x0a, 0x15,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9b, 0x01, 0x92, 0x41, 0x5b, 0x12, 0x15, 0xe5, 0x88, 0xa0, 0xe9,
-	0x99, 0xa4, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba,
-	0x90, 0x1a, 0x42, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0x87,
+	0x9b, 0x9e, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x18, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f,
+	0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x52, 0x12, 0x50, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d,
+	0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0x93, 0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x4b, 0x38, 0x53, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa7, 0x01, 0x92, 0x41, 0x50, 0x1a, 0x34, 0xe6, 0xa0, 0xb9, 0xe6,
+	0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f,
+	0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
+	0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x18, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9b,
+	0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4e,
+	0x12, 0x4c, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32,
+	0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x61, 0x6c, 0x6c,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f,
+	0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0xb4,
+	0x02, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x38,
+	0x53, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x2a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb9, 0x01, 0x92, 0x41, 0x5e, 0x1a,
+	0x42, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0xef, 0xbc, 0x8c, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe9,
+	0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9,
+	0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93,
+	0xe6, 0x9e, 0x9c, 0x12, 0x18, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0, 0xe9, 0x99,
+	0xa4, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x52, 0x2a, 0x50, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0xb3, 0x02, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x38, 0x53, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xb5, 0x01, 0x92, 0x41, 0x5e, 0x12, 0x18, 0xe6, 0x89, 0xb9, 0xe9, 0x87,
+	0x8f, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84,
+	0xe6, 0xba, 0x90, 0x1a, 0x42, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0,
+	0xe9, 0x99, 0xa4, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef,
+	0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd,
+	0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4e, 0x2a, 0x4c, 0x2f,
+	0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38,
+	0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x61, 0x6c, 0x6c, 0x5f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x7b, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0xfb, 0x01, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9b, 0x01, 0x92, 0x41,
+	0x5b, 0x1a, 0x42, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe8, 0x87,
 	0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c,
+	0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7,
+	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x15, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe8, 0x87, 0xaa,
+	0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x37, 0x12, 0x35, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
+	0x76, 0x32, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0x84, 0x02, 0x0a, 0x15, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x9b, 0x01, 0x92, 0x41, 0x5b, 0x1a, 0x42, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xef, 0xbc, 0x8c, 0xe5,
+	0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5,
+	0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
+	0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x15, 0xe5, 0x88,
+	0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84,
+	0xe6, 0xba, 0x90, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x2a, 0x35, 0x2f, 0x62, 0x63, 0x73, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
+	0x63, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d,
+	0x12, 0x8d, 0x02, 0x0a, 0x12, 0x50, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xad, 0x01, 0x92, 0x41, 0x6a, 0x1a, 0x48, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xef, 0xbc, 0x8c, 0xe4, 0xbf, 0xae,
+	0xe6, 0x94, 0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe5, 0xae, 0x9a, 0xe4,
+	0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+	0x12, 0x1e, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2,
+	0x9e, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x1a, 0x35, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x3a, 0x01, 0x2a,
+	0x12, 0xb4, 0x02, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x2a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbc, 0x01, 0x92, 0x41, 0x67, 0x12, 0x1b,
+	0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8,
+	0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb4, 0xa2, 0xe5, 0xbc, 0x95, 0x1a, 0x48, 0xe6, 0xa0, 0xb9,
+	0xe6, 0x8d, 0xae, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xef,
+	0xbc, 0x8c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9,
+	0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb4, 0xa2, 0xe5, 0xbc, 0x95, 0xef, 0xbc, 0x8c,
 	0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7,
-	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x2a, 0x35, 0x2f, 0x62, 0x63,
+	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4c, 0x1a, 0x47, 0x2f, 0x62, 0x63,
 	0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x79, 0x6e, 0x61,
 	0x6d, 0x69, 0x63, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x7d, 0x12, 0x8d, 0x02, 0x0a, 0x12, 0x50, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0xad, 0x01, 0x92, 0x41, 0x6a, 0x12, 0x1e, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9,
-	0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4,
-	0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x48, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xef, 0xbc, 0x8c, 0xe4,
-	0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe5, 0xae,
-	0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6,
-	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6,
-	0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x1a, 0x35, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
-	0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x3a,
-	0x01, 0x2a, 0x12, 0xb4, 0x02, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x12, 0x2a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x65, 0x7d, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4e,
+	0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xb1, 0x02, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65,
 	0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbc, 0x01, 0x92, 0x41, 0x67,
-	0x12, 0x1b, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9,
-	0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb4, 0xa2, 0xe5, 0xbc, 0x95, 0x1a, 0x48, 0xe6,
-	0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a,
-	0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb4, 0xa2, 0xe5, 0xbc, 0x95, 0xef,
-	0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd,
-	0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4c, 0x1a, 0x47, 0x2f,
-	0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x79,
-	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x7d, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x7b, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xb1, 0x02, 0x0a, 0x1a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xb9, 0x01, 0x92, 0x41, 0x67, 0x12, 0x1b, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8,
+	0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xb9, 0x01, 0x92, 0x41, 0x67, 0x12, 0x1b, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0x87, 0xaa,
+	0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb4, 0xa2, 0xe5,
+	0xbc, 0x95, 0x1a, 0x48, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8,
 	0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb4,
-	0xa2, 0xe5, 0xbc, 0x95, 0x1a, 0x48, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x88, 0xa0, 0xe9, 0x99,
-	0xa4, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
-	0xe7, 0xb4, 0xa2, 0xe5, 0xbc, 0x95, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5,
-	0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x49, 0x2a, 0x47, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x2f, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xf4, 0x01,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49,
-	0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x50, 0x50,
-	0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xa0, 0x01, 0x92, 0x41, 0x53, 0x12, 0x14, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6b,
-	0x38, 0x73, 0xe9, 0x9d, 0x99, 0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0x1a, 0x3b, 0xe6,
-	0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc,
-	0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9, 0x9d, 0x99, 0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6,
-	0xb1, 0xa0, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93,
-	0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44,
-	0x12, 0x42, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32,
-	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d,
-	0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x70, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x74,
-	0x61, 0x74, 0x69, 0x63, 0x12, 0xfb, 0x01, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x74, 0x4b, 0x38, 0x53,
-	0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61,
-	0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f,
+	0xa2, 0xe5, 0xbc, 0x95, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
+	0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x49, 0x2a, 0x47, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
+	0x76, 0x32, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f,
+	0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xf4, 0x01, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x50, 0x50,
+	0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x50, 0x50, 0x6f, 0x6f,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xa0, 0x01, 0x92, 0x41, 0x53, 0x1a, 0x3b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe9,
+	0x9d, 0x99, 0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6,
+	0x9e, 0x9c, 0x12, 0x14, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6b, 0x38, 0x73, 0xe9, 0x9d, 0x99,
+	0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x12, 0x42,
+	0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x70, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x12, 0xfb, 0x01, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x74, 0x4b, 0x38, 0x53, 0x49, 0x50,
+	0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f,
 	0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa6, 0x01, 0x92, 0x41, 0x56, 0x12,
-	0x14, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6b, 0x38, 0x73, 0xe9, 0x9d, 0x99, 0xe6, 0x80, 0x81,
-	0x49, 0x50, 0xe6, 0xb1, 0xa0, 0x1a, 0x3e, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6b,
-	0x38, 0x73, 0xe9, 0x9d, 0x99, 0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0xef, 0xbc, 0x8c,
-	0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7,
-	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x22, 0x42, 0x2f, 0x62, 0x63,
-	0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x7d, 0x2f, 0x69, 0x70, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x3a,
-	0x01, 0x2a, 0x12, 0x9b, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x49, 0x50, 0x50,
-	0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
-	0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x50,
-	0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb5, 0x01, 0x92, 0x41, 0x62, 0x12, 0x1a,
-	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6b, 0x38, 0x73, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe9,
-	0x9d, 0x99, 0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0x1a, 0x44, 0xe6, 0xa0, 0xb9, 0xe6,
-	0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa6, 0x01, 0x92, 0x41, 0x56, 0x1a, 0x3e, 0xe6,
+	0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc,
+	0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6b, 0x38, 0x73, 0xe9, 0x9d, 0x99, 0xe6, 0x80, 0x81,
+	0x49, 0x50, 0xe6, 0xb1, 0xa0, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x14, 0xe6,
+	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6b, 0x38, 0x73, 0xe9, 0x9d, 0x99, 0xe6, 0x80, 0x81, 0x49, 0x50,
+	0xe6, 0xb1, 0xa0, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x22, 0x42, 0x2f, 0x62, 0x63, 0x73, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f,
+	0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d,
+	0x2f, 0x69, 0x70, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x3a, 0x01, 0x2a,
+	0x12, 0x9b, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x49, 0x50, 0x50, 0x6f, 0x6f,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x22, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x50, 0x50, 0x6f,
+	0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb5, 0x01, 0x92, 0x41, 0x62, 0x12, 0x1a, 0xe6, 0x9f,
 	0xa5, 0xe8, 0xaf, 0xa2, 0x6b, 0x38, 0x73, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe9, 0x9d, 0x99,
-	0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf,
-	0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x12, 0x48, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73,
-	0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x70,
-	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x12, 0x9f, 0x02, 0x0a, 0x19, 0x50, 0x6f, 0x73, 0x74, 0x4b, 0x38, 0x53, 0x49, 0x50, 0x50, 0x6f,
-	0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x22,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53,
-	0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x50, 0x50,
-	0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb8, 0x01, 0x92, 0x41, 0x62, 0x12, 0x1a, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6b, 0x38, 0x73, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe9, 0x9d,
-	0x99, 0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0x1a, 0x44, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d,
-	0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5,
-	0xe8, 0xaf, 0xa2, 0x6b, 0x38, 0x73, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe9, 0x9d, 0x99, 0xe6,
-	0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94,
-	0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x4d, 0x22, 0x48, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f,
-	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x70, 0x70,
-	0x6f, 0x6f, 0x6c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x3a,
-	0x01, 0x2a, 0x12, 0xba, 0x01, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x64, 0x12, 0x13, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x01, 0x92, 0x41, 0x40, 0x12, 0x09,
-	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x70, 0x6f, 0x64, 0x1a, 0x33, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d,
-	0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5,
-	0xe8, 0xaf, 0xa2, 0x70, 0x6f, 0x64, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5,
+	0xe6, 0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0x1a, 0x44, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8,
+	0xaf, 0xa2, 0x6b, 0x38, 0x73, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe9, 0x9d, 0x99, 0xe6, 0x80,
+	0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5,
 	0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x3b, 0x12, 0x39, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0xe4, 0x93, 0x02, 0x4a, 0x12, 0x48, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
 	0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64,
 	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f,
-	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x64, 0x12,
-	0xbe, 0x01, 0x0a, 0x07, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x64, 0x12, 0x13, 0x2e, 0x73, 0x74,
+	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x70, 0x70, 0x6f,
+	0x6f, 0x6c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x9f,
+	0x02, 0x0a, 0x19, 0x50, 0x6f, 0x73, 0x74, 0x4b, 0x38, 0x53, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x22, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x50, 0x50, 0x6f, 0x6f,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb8, 0x01, 0x92, 0x41, 0x62, 0x12, 0x1a, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0x6b, 0x38, 0x73, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe9, 0x9d, 0x99, 0xe6,
+	0x80, 0x81, 0x49, 0x50, 0xe6, 0xb1, 0xa0, 0x1a, 0x44, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0x6b, 0x38, 0x73, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe9, 0x9d, 0x99, 0xe6, 0x80, 0x81,
+	0x49, 0x50, 0xe6, 0xb1, 0xa0, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x4d, 0x22, 0x48, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x70, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x3a, 0x01, 0x2a,
+	0x12, 0xba, 0x01, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x64, 0x12, 0x13, 0x2e, 0x73, 0x74,
 	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x14, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x92, 0x41, 0x40, 0x12, 0x09, 0xe6, 0x9f,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x01, 0x92, 0x41, 0x40, 0x12, 0x09, 0xe6, 0x9f,
 	0xa5, 0xe8, 0xaf, 0xa2, 0x70, 0x6f, 0x64, 0x1a, 0x33, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
 	0xa2, 0x70, 0x6f, 0x64, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
 	0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x3e, 0x22, 0x39, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
+	0x02, 0x3b, 0x12, 0x39, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
 	0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e,
 	0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x64, 0x3a, 0x01, 0x2a,
-	0x12, 0xe4, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53,
-	0x65, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x01, 0x92, 0x41,
-	0x4e, 0x12, 0x10, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x53, 0x65, 0x74, 0x1a, 0x3a, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94,
-	0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x42, 0x12, 0x40, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f,
-	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x73, 0x65, 0x74, 0x12, 0xe8, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x92, 0x41, 0x4e, 0x12, 0x10, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x1a, 0x3a, 0xe6, 0xa0, 0xb9,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x64, 0x12, 0xbe, 0x01,
+	0x0a, 0x07, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x64, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x92, 0x41, 0x40, 0x1a, 0x33, 0xe6, 0xa0, 0xb9, 0xe6,
+	0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f,
+	0xa5, 0xe8, 0xaf, 0xa2, 0x70, 0x6f, 0x64, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94,
+	0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12,
+	0x09, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x70, 0x6f, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e,
+	0x3a, 0x01, 0x2a, 0x22, 0x39, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x64, 0x12, 0xe4,
+	0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74,
+	0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x01, 0x92, 0x41, 0x4e, 0x12,
+	0x10, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65,
+	0x74, 0x1a, 0x3a, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x53, 0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x42, 0x12, 0x40, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x65, 0x74, 0x12, 0xe8, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x9c, 0x01, 0x92, 0x41, 0x4e, 0x1a, 0x3a, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9,
+	0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93,
+	0xe6, 0x9e, 0x9c, 0x12, 0x10, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x53, 0x65, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x22, 0x40, 0x2f, 0x62, 0x63,
+	0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x7d, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x65, 0x74, 0x3a, 0x01, 0x2a,
+	0x12, 0xed, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4b, 0x38, 0x53, 0x12, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x01, 0x92, 0x41, 0x4e, 0x1a, 0x3a, 0xe6, 0xa0, 0xb9, 0xe6,
+	0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f,
+	0xa5, 0xe8, 0xaf, 0xa2, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0xef, 0xbc,
+	0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c,
+	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x10, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x42, 0x12, 0x40,
+	0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0xf1, 0x01, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x4b, 0x38, 0x53, 0x12, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x38, 0x53, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x92, 0x41, 0x4e, 0x1a, 0x3a, 0xe6, 0xa0, 0xb9,
 	0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0xef,
+	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0xef,
+	0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd,
+	0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x10, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x3a,
+	0x01, 0x2a, 0x22, 0x40, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
+	0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e,
+	0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0xdb, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x90, 0x01, 0x92, 0x41, 0x48, 0x1a, 0x37, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5,
+	0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x0d,
+	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0xdf, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4b, 0x38, 0x53, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x93,
+	0x01, 0x92, 0x41, 0x48, 0x1a, 0x37, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x0d, 0xe6,
+	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x42, 0x22, 0x3d, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
+	0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e,
+	0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x3a, 0x01, 0x2a, 0x12, 0xe7, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x01, 0x92, 0x41, 0x4c, 0x12, 0x0f, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x1a, 0x39, 0xe6, 0xa0,
+	0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c,
+	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0xef,
 	0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd,
-	0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x22, 0x40, 0x2f,
+	0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f,
 	0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75,
 	0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x65, 0x74, 0x3a,
-	0x01, 0x2a, 0x12, 0xed, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x38, 0x53, 0x12, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x38, 0x53, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x38, 0x53, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x01, 0x92, 0x41, 0x4e, 0x12, 0x10, 0xe6, 0x9f,
-	0xa5, 0xe8, 0xaf, 0xa2, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x3a,
-	0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef,
-	0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93,
-	0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x42,
-	0x12, 0x40, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32,
-	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d,
-	0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0xf1, 0x01, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x38, 0x53, 0x12, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x38, 0x53,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x38, 0x53, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x92, 0x41, 0x4e, 0x12, 0x10, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
-	0x3a, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6,
-	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x45, 0x22, 0x40, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76,
-	0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61,
-	0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xdb, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x90, 0x01, 0x92, 0x41, 0x48, 0x12, 0x0d, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x37, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c,
+	0x72, 0x49, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x70, 0x12, 0xeb,
+	0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70,
+	0x4b, 0x38, 0x53, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x99, 0x01, 0x92, 0x41, 0x4c, 0x1a, 0x39, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c,
 	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94,
-	0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f,
-	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0xdf, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x93, 0x01, 0x92, 0x41, 0x48, 0x12, 0x0d, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x37, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5,
-	0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x42, 0x22, 0x3d, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64,
-	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f,
-	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xe7, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38,
-	0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x01, 0x92, 0x41, 0x4c, 0x12, 0x0f, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x1a, 0x39,
-	0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef,
-	0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61,
-	0x70, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d,
-	0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12,
-	0x3f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
-	0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x70,
-	0x12, 0xeb, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d,
-	0x61, 0x70, 0x4b, 0x38, 0x53, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x99, 0x01, 0x92, 0x41, 0x4c, 0x12, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x1a, 0x39, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d,
-	0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5,
-	0xe8, 0xaf, 0xa2, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0xef, 0xbc, 0x8c, 0xe5,
-	0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb,
-	0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x22, 0x3f, 0x2f, 0x62, 0x63, 0x73,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0xd5,
-	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x12,
-	0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x92, 0x41, 0x46, 0x12, 0x0c, 0xe6, 0x9f,
-	0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x36, 0xe6, 0xa0, 0xb9, 0xe6,
-	0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f,
-	0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6,
-	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6,
-	0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b,
-	0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0xd9, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x90, 0x01, 0x92, 0x41, 0x46, 0x12, 0x0c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x1a, 0x36, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6,
-	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x41, 0x22, 0x3c, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76,
-	0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61,
-	0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x3a,
-	0x01, 0x2a, 0x12, 0xe7, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x96, 0x01, 0x92, 0x41, 0x4c, 0x12, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x39, 0xe6, 0xa0, 0xb9, 0xe6,
-	0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f,
-	0xa5, 0xe8, 0xaf, 0xa2, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0xef, 0xbc, 0x8c,
-	0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7,
-	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f, 0x62, 0x63,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8,
+	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
+	0x9c, 0x12, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d,
+	0x61, 0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x3a, 0x01, 0x2a, 0x22, 0x3f, 0x2f, 0x62, 0x63,
 	0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72,
 	0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c,
 	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0xeb, 0x01, 0x0a,
-	0x10, 0x50, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4b, 0x38,
-	0x53, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99,
-	0x01, 0x92, 0x41, 0x4c, 0x12, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x65, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x39, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x65,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf,
-	0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x22, 0x3f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73,
-	0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xd2, 0x01, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x90, 0x01, 0x92,
-	0x41, 0x48, 0x12, 0x0d, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x1a, 0x37, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x69, 0x6e, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93,
-	0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f,
-	0x12, 0x3d, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32,
-	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d,
-	0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0xd6, 0x01, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x93, 0x01, 0x92, 0x41, 0x48, 0x12, 0x0d, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x37, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf,
-	0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x42, 0x22, 0x3d, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73,
-	0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x6e,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xed, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x12, 0x1c, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x38,
-	0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x92, 0x41, 0x52, 0x12,
-	0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba,
-	0xe9, 0x97, 0xb4, 0x1a, 0x3c, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0xbd,
-	0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8,
-	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
-	0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38,
-	0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0xf1, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x12, 0x1c, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b,
-	0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9f, 0x01, 0x92, 0x41, 0x52,
-	0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9,
-	0xba, 0xe9, 0x97, 0xb4, 0x1a, 0x3c, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91,
-	0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6,
+	0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x70, 0x12, 0xd5, 0x01, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x12, 0x19, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x38,
+	0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x92, 0x41, 0x46, 0x1a, 0x36, 0xe6, 0xa0, 0xb9, 0xe6,
+	0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f,
+	0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6,
 	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6,
-	0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x22, 0x3f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b,
-	0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xc0, 0x01, 0x0a,
-	0x07, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x92, 0x41, 0x42, 0x12, 0x0a, 0xe6, 0x9f, 0xa5,
-	0xe8, 0xaf, 0xa2, 0x6e, 0x6f, 0x64, 0x65, 0x1a, 0x34, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0x6e, 0x6f, 0x64, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
-	0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79,
-	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x12,
-	0xc4, 0x01, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x01, 0x92, 0x41, 0x42, 0x12,
-	0x0a, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6e, 0x6f, 0x64, 0x65, 0x1a, 0x34, 0xe6, 0xa0, 0xb9,
-	0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6e, 0x6f, 0x64, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8,
-	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
-	0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x22, 0x3a, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38,
-	0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e,
-	0x6f, 0x64, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xde, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96,
-	0x01, 0x92, 0x41, 0x4c, 0x12, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x1a, 0x39, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf,
-	0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72,
+	0x9e, 0x9c, 0x12, 0x0c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72,
 	0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73,
 	0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x12, 0xe2, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74,
-	0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x99, 0x01, 0x92, 0x41, 0x4c, 0x12, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x1a, 0x39, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63,
+	0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0xd9, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x90, 0x01,
+	0x92, 0x41, 0x46, 0x1a, 0x36, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6,
+	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x0c, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x22,
+	0x3c, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
+	0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x3a, 0x01, 0x2a,
+	0x12, 0xe7, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x4b, 0x38, 0x53, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x96, 0x01, 0x92, 0x41, 0x4c, 0x1a, 0x39, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6,
+	0xa2, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6,
 	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6,
-	0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x22, 0x3f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b,
-	0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f,
-	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xba, 0x01, 0x0a,
-	0x06, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x84, 0x01, 0x92, 0x41, 0x40, 0x12, 0x09, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0x6a, 0x6f, 0x62, 0x1a, 0x33, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6a, 0x6f, 0x62,
-	0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4,
-	0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x39,
+	0x9e, 0x9c, 0x12, 0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f, 0x62, 0x63, 0x73, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f,
+	0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d,
+	0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0xeb, 0x01, 0x0a, 0x10, 0x50,
+	0x6f, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53, 0x12,
+	0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x4b, 0x38, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x01, 0x92,
+	0x41, 0x4c, 0x1a, 0x39, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x0f, 0xe6,
+	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x44, 0x22, 0x3f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xd2, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x90, 0x01, 0x92, 0x41, 0x48,
+	0x1a, 0x37, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d,
+	0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x0d, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d,
 	0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71,
 	0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
 	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x12, 0xbe, 0x01, 0x0a, 0x07, 0x50, 0x6f,
-	0x73, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0xd6, 0x01,
+	0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x93, 0x01, 0x92, 0x41, 0x48, 0x12, 0x0d, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x37, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5,
+	0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x42, 0x22, 0x3d, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xed, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x38,
+	0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x92, 0x41, 0x52, 0x1a, 0x3c, 0xe6,
+	0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc,
+	0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba,
+	0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6,
+	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x12, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0xf1, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x38, 0x53, 0x12, 0x1c, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b,
+	0x38, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x38, 0x53,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9f, 0x01, 0x92, 0x41, 0x52, 0x12, 0x12,
+	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9,
+	0x97, 0xb4, 0x1a, 0x3c, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x91, 0xbd, 0xe5,
+	0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x3a, 0x01, 0x2a, 0x22, 0x3f, 0x2f, 0x62, 0x63, 0x73, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f,
+	0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d,
+	0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0xc0, 0x01, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x92, 0x41, 0x42, 0x12, 0x0a, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0x6e, 0x6f, 0x64, 0x65, 0x1a, 0x34, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6e,
+	0x6f, 0x64, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6,
+	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3c, 0x12, 0x3a, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76,
+	0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0xc4, 0x01,
+	0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x01, 0x92, 0x41, 0x42, 0x12, 0x0a, 0xe6,
+	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6e, 0x6f, 0x64, 0x65, 0x1a, 0x34, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d,
+	0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0x6e, 0x6f, 0x64, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94,
+	0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x22, 0x3a, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x64,
+	0x65, 0x3a, 0x01, 0x2a, 0x12, 0xde, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x01, 0x92,
+	0x41, 0x4c, 0x1a, 0x39, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x0f, 0xe6,
+	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x73, 0x65, 0x74, 0x12, 0xe2, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99,
+	0x01, 0x92, 0x41, 0x4c, 0x1a, 0x39, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94,
+	0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12,
+	0x0f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x3a, 0x01, 0x2a, 0x22, 0x3f, 0x2f, 0x62, 0x63, 0x73, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f,
+	0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d,
+	0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x12, 0xba, 0x01, 0x0a, 0x06, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
 	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x6f,
 	0x72, 0x61, 0x67, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x87, 0x01, 0x92, 0x41, 0x40, 0x12, 0x09, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6a, 0x6f,
-	0x62, 0x1a, 0x33, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6a, 0x6f, 0x62, 0xef, 0xbc,
-	0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c,
-	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x22, 0x39, 0x2f, 0x62,
+	0x22, 0x84, 0x01, 0x92, 0x41, 0x40, 0x1a, 0x33, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
+	0x6a, 0x6f, 0x62, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6,
+	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x09, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0x6a, 0x6f, 0x62, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x39, 0x2f, 0x62,
 	0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65,
 	0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x3a, 0x01, 0x2a, 0x12, 0xea, 0x01, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x1b, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c,
-	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x92, 0x41, 0x50, 0x12, 0x11,
-	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65,
-	0x74, 0x1a, 0x3b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5,
-	0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x43, 0x12, 0x41, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64,
-	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f,
-	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x66, 0x75, 0x6c, 0x73, 0x65, 0x74, 0x12, 0xee, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x12, 0xbe, 0x01, 0x0a, 0x07, 0x50, 0x6f, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87,
+	0x01, 0x92, 0x41, 0x40, 0x1a, 0x33, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x6a, 0x6f,
+	0x62, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d,
+	0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x09, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0x6a, 0x6f, 0x62, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x22, 0x39, 0x2f, 0x62, 0x63, 0x73,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x3a, 0x01, 0x2a, 0x12, 0xea, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74,
 	0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x74,
 	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65,
 	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
 	0x67, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9f, 0x01, 0x92, 0x41, 0x50, 0x12, 0x11, 0xe6, 0x9f,
-	0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x1a,
-	0x3b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75,
-	0x6c, 0x53, 0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
-	0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x46, 0x22, 0x41, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x92, 0x41, 0x50, 0x1a, 0x3b, 0xe6, 0xa0,
+	0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c,
+	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65,
+	0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d,
+	0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x11, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x43, 0x12, 0x41, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
 	0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e,
 	0x61, 0x6d, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66,
-	0x75, 0x6c, 0x73, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xcd, 0x01, 0x0a, 0x0c, 0x57, 0x61, 0x74,
-	0x63, 0x68, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x75, 0x6c, 0x73, 0x65, 0x74, 0x12, 0xee, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9f, 0x01, 0x92, 0x41, 0x50, 0x12, 0x11, 0xe6, 0x9f, 0xa5, 0xe8,
+	0xaf, 0xa2, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x1a, 0x3b, 0xe6,
+	0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc,
+	0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53,
+	0x65, 0x74, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93,
+	0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x46,
+	0x22, 0x41, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32,
+	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d,
+	0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c,
+	0x73, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xcd, 0x01, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
 	0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7e, 0x92, 0x41, 0x34, 0x12, 0x0d, 0x77, 0x61, 0x74,
-	0x63, 0x68, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x1a, 0x23, 0xe6, 0xa0, 0xb9, 0xe6,
-	0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe8, 0xbf,
-	0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x41, 0x22, 0x37, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x77, 0x61,
-	0x74, 0x63, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f,
-	0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x3a, 0x06,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12, 0xd1, 0x01, 0x0a, 0x0e, 0x57, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x92, 0x41,
-	0x36, 0x12, 0x0f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x1a, 0x23, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63,
-	0x68, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x22, 0x33, 0x2f,
-	0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x79,
-	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x7d, 0x3a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12, 0x98, 0x01, 0x0a,
-	0x08, 0x50, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57,
-	0x92, 0x41, 0x34, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x8b, 0xe4, 0xbb,
-	0xb6, 0x1a, 0x24, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xef,
-	0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd,
-	0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x1a, 0x15, 0x2f,
-	0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xaa, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x92, 0x41,
-	0x46, 0x12, 0x0c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x1a,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7e, 0x92, 0x41, 0x34, 0x1a, 0x23, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d,
+	0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe8, 0xbf, 0x94,
+	0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x0d,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x41, 0x22, 0x37, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x7b, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x3a, 0x06, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12, 0xd1, 0x01, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x92, 0x41, 0x36, 0x1a,
+	0x23, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0xef, 0xbc, 0x8c, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe7, 0xbb,
+	0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x0f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x20, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x3a, 0x06, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x33, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x2f, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x30, 0x01, 0x12, 0x98, 0x01, 0x0a, 0x08, 0x50,
+	0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x50, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x92, 0x41,
+	0x34, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x1a,
+	0x24, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xef, 0xbc, 0x8c,
+	0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7,
+	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x1a, 0x15, 0x2f, 0x62, 0x63,
+	0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xaa, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x92, 0x41, 0x46, 0x1a,
 	0x36, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
 	0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xef,
 	0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd,
-	0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f,
-	0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x57, 0x61,
-	0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x92, 0x41,
-	0x44, 0x12, 0x0b, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x1a, 0x35,
-	0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef,
-	0xbc, 0x8c, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xef, 0xbc, 0x8c,
-	0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7,
-	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x1b, 0x2f, 0x62, 0x63,
+	0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x0c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4,
+	0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x62, 0x63,
 	0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x3a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x30, 0x01, 0x12, 0xac, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x17,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x6e, 0x92, 0x41, 0x4b, 0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4, 0xb8,
-	0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x1a, 0x35, 0xe6, 0xa0, 0xb9, 0xe6,
-	0x8d, 0xae, 0x69, 0x70, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4, 0xb8, 0xbb,
-	0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8,
-	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
-	0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x7b, 0x69, 0x70,
-	0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x50, 0x75, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x71, 0x92, 0x41, 0x4b, 0x12, 0x12, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xb8, 0xbb,
-	0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x1a, 0x35, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d,
-	0xae, 0x69, 0x70, 0xef, 0xbc, 0x8c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xb8, 0xbb, 0xe6,
-	0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf,
-	0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x1a, 0x18, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x7b, 0x69, 0x70, 0x7d,
-	0x3a, 0x01, 0x2a, 0x12, 0xb5, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f,
-	0x73, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x92, 0x41, 0x4b,
-	0x12, 0x12, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85,
-	0x8d, 0xe7, 0xbd, 0xae, 0x1a, 0x35, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x69, 0x70, 0xef, 0xbc,
-	0x8c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d,
+	0x74, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x92, 0x41, 0x44, 0x1a,
+	0x35, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0xef, 0xbc, 0x8c, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xef, 0xbc,
+	0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c,
+	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x0b, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe4, 0xba, 0x8b,
+	0xe4, 0xbb, 0xb6, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x1b, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76,
+	0x32, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01,
+	0x12, 0xac, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x6e, 0x92, 0x41, 0x4b, 0x1a, 0x35, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x69, 0x70, 0xef, 0xbc,
+	0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d,
 	0xe7, 0xbd, 0xae, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6,
-	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1a, 0x2a, 0x18, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76,
-	0x32, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x7b, 0x69, 0x70, 0x7d, 0x12, 0xc7, 0x01, 0x0a, 0x08,
-	0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01,
-	0x92, 0x41, 0x52, 0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c,
-	0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x1a, 0x3c, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xef, 0xbc, 0x8c,
+	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x12, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x7b, 0x69, 0x70, 0x7d, 0x12,
+	0xaf, 0x01, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50,
+	0x75, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x71,
+	0x92, 0x41, 0x4b, 0x1a, 0x35, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x69, 0x70, 0xef, 0xbc, 0x8c,
+	0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7,
+	0xbd, 0xae, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93,
+	0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x12, 0xe5, 0x88, 0x9b, 0xe5,
+	0xbb, 0xba, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x1a, 0x18, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2f, 0x76, 0x32, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x7b, 0x69, 0x70, 0x7d, 0x3a, 0x01,
+	0x2a, 0x12, 0xb5, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74,
+	0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x92, 0x41, 0x4b, 0x1a, 0x35,
+	0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x69, 0x70, 0xef, 0xbc, 0x8c, 0xe5, 0x88, 0xa0, 0xe9, 0x99,
+	0xa4, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xef, 0xbc, 0x8c,
 	0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7,
-	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x62, 0x63,
-	0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x68, 0x6f, 0x73, 0x74,
-	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x7d, 0x12, 0xb4, 0x02, 0x0a, 0x12, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd4, 0x01, 0x92, 0x41, 0x9d, 0x01, 0x12, 0x1a, 0xe4, 0xbf,
+	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x12, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe4, 0xb8, 0xbb,
+	0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a,
+	0x18, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f,
+	0x68, 0x6f, 0x73, 0x74, 0x2f, 0x7b, 0x69, 0x70, 0x7d, 0x12, 0xc7, 0x01, 0x0a, 0x08, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x92, 0x41,
+	0x52, 0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9,
+	0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x1a, 0x3c, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe4,
+	0xb8, 0xbb, 0xe6, 0x9c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xef, 0xbc, 0x8c, 0xe5, 0xb9,
+	0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93,
+	0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x62, 0x63, 0x73, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x7d, 0x12, 0xb4, 0x02, 0x0a, 0x12, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xd4, 0x01, 0x92, 0x41, 0x9d, 0x01, 0x1a, 0x7f, 0xe6, 0xa0, 0xb9, 0xe6,
+	0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe4, 0xbf,
 	0xae, 0xe6, 0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb,
-	0x28, 0xe8, 0xa6, 0x86, 0xe7, 0x9b, 0x96, 0x29, 0x1a, 0x7f, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe4, 0xbf, 0xae, 0xe6,
-	0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0x28, 0xe5,
-	0x85, 0x88, 0xe6, 0xb8, 0x85, 0xe9, 0x99, 0xa4, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe9, 0x9b,
-	0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0xef, 0xbc, 0x8c, 0xe5, 0x86, 0x8d,
-	0xe5, 0x8e, 0xbb, 0xe6, 0x8a, 0x8a, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe7, 0x9a, 0x84, 0xe5,
-	0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0xe5, 0x86, 0x99, 0xe5, 0x85, 0xa5, 0x64, 0x62, 0xe4, 0xb8, 0xad,
-	0x29, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d,
-	0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x1a,
-	0x28, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f,
-	0x68, 0x6f, 0x73, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xa3, 0x02, 0x0a,
-	0x13, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50,
+	0x28, 0xe5, 0x85, 0x88, 0xe6, 0xb8, 0x85, 0xe9, 0x99, 0xa4, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89,
+	0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0xef, 0xbc, 0x8c, 0xe5,
+	0x86, 0x8d, 0xe5, 0x8e, 0xbb, 0xe6, 0x8a, 0x8a, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe7, 0x9a,
+	0x84, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0xe5, 0x86, 0x99, 0xe5, 0x85, 0xa5, 0x64, 0x62, 0xe4,
+	0xb8, 0xad, 0x29, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6,
+	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x1a, 0xe4, 0xbf, 0xae,
+	0xe6, 0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0x28,
+	0xe8, 0xa6, 0x86, 0xe7, 0x9b, 0x96, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x1a, 0x28, 0x2f,
+	0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x68, 0x6f,
+	0x73, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xa3, 0x02, 0x0a, 0x13, 0x50,
 	0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xc0, 0x01, 0x92, 0x41, 0x89, 0x01, 0x12, 0x1a, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe9, 0x9b,
-	0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0x28, 0xe5, 0xa2, 0x9e, 0xe9, 0x87,
-	0x8f, 0x29, 0x1a, 0x6b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7,
-	0xbe, 0xa4, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0x28, 0xe7, 0x9b, 0xb4, 0xe6, 0x8e, 0xa5, 0xe6,
-	0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d, 0xe4, 0xbc, 0x9a, 0xe6, 0xb8,
-	0x85, 0xe9, 0x99, 0xa4, 0xe5, 0x8e, 0x86, 0xe5, 0x8f, 0xb2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4,
-	0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0x29, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94,
-	0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x22, 0x28, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x3a,
-	0x01, 0x2a, 0x12, 0xf5, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x92, 0x41, 0x56, 0x12, 0x14, 0xe6,
+	0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0, 0x01,
+	0x92, 0x41, 0x89, 0x01, 0x12, 0x1a, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7,
+	0xbe, 0xa4, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0x28, 0xe5, 0xa2, 0x9e, 0xe9, 0x87, 0x8f, 0x29,
+	0x1a, 0x6b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0xef, 0xbc, 0x8c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4,
+	0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0x28, 0xe7, 0x9b, 0xb4, 0xe6, 0x8e, 0xa5, 0xe6, 0x96, 0xb0,
+	0xe5, 0xa2, 0x9e, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d, 0xe4, 0xbc, 0x9a, 0xe6, 0xb8, 0x85, 0xe9,
+	0x99, 0xa4, 0xe5, 0x8e, 0x86, 0xe5, 0x8f, 0xb2, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe5, 0x85,
+	0xb3, 0xe7, 0xb3, 0xbb, 0x29, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2d, 0x22, 0x28, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x3a, 0x01, 0x2a,
+	0x12, 0xf5, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x19,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x92, 0x41, 0x56, 0x1a, 0x3e, 0xe6, 0xa0, 0xb9,
+	0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6,
 	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0x28, 0xe8, 0xb7, 0xaf, 0xe5,
-	0xbe, 0x84, 0x29, 0x1a, 0x3e, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8c, 0x87,
-	0xe6, 0xa0, 0x87, 0x28, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0x29, 0xef, 0xbc, 0x8c, 0xe5, 0xb9,
-	0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93,
-	0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x51, 0x12, 0x4f, 0x2f, 0x62, 0x63, 0x73, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
-	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x74, 0x79,
-	0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xe8, 0x01, 0x0a, 0x09, 0x50,
-	0x75, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75,
-	0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xa3, 0x01, 0x92, 0x41, 0x46, 0x12, 0x0c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x8c, 0x87,
-	0xe6, 0xa0, 0x87, 0x1a, 0x36, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0xbe, 0x84, 0x29, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6,
+	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x14, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0x28, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84,
+	0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x51, 0x12, 0x4f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65,
+	0x7d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xe8, 0x01, 0x0a, 0x09, 0x50, 0x75, 0x74,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa3, 0x01,
+	0x92, 0x41, 0x46, 0x1a, 0x36, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74,
 	0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x8c, 0x87,
 	0xe6, 0xa0, 0x87, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6,
-	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x54, 0x1a, 0x4f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76,
-	0x32, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xee, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0xa0, 0x01, 0x92, 0x41, 0x46, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99,
-	0xa4, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0x1a, 0x36, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe5, 0x88, 0xa0, 0xe9, 0x99,
-	0xa4, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94,
-	0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x51, 0x2a, 0x4f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xd1, 0x01, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x86, 0x01, 0x92, 0x41, 0x51, 0x12, 0x0c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6,
-	0x8c, 0x87, 0xe6, 0xa0, 0x87, 0x1a, 0x41, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
+	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x0c, 0xe4, 0xbf, 0xae,
+	0xe6, 0x94, 0xb9, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x54, 0x1a,
+	0x4f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x7d, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x3a, 0x01, 0x2a, 0x12, 0xee, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xa0, 0x01, 0x92, 0x41, 0x46, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6,
+	0x8c, 0x87, 0xe6, 0xa0, 0x87, 0x1a, 0x36, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6,
+	0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x51, 0x2a, 0x4f, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x12, 0xd1, 0x01, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x86, 0x01, 0x92, 0x41, 0x51, 0x1a, 0x41, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6,
 	0x8c, 0x87, 0xe6, 0xa0, 0x87, 0x28, 0xe5, 0xb8, 0xa6, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x29,
 	0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4,
-	0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a,
+	0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x0c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
+	0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x62,
+	0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x12, 0xd3, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x7a, 0x92, 0x41, 0x51, 0x1a, 0x3b, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6,
+	0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe8, 0xa1, 0xa8, 0xe5, 0x90, 0x8d, 0xef, 0xbc, 0x8c, 0xe5, 0xb9,
+	0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93,
+	0xe6, 0x9e, 0x9c, 0x28, 0xe6, 0x97, 0xa0, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0x29, 0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8c, 0x87, 0xe6,
+	0xa0, 0x87, 0xe8, 0xa1, 0xa8, 0xe5, 0x90, 0x8d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e,
 	0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x12, 0xd3, 0x01, 0x0a, 0x10, 0x4c,
-	0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12,
-	0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a, 0x92, 0x41, 0x51, 0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
-	0xa2, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe8, 0xa1, 0xa8, 0xe5, 0x90, 0x8d, 0x1a, 0x3b, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe8, 0xa1, 0xa8, 0xe5, 0x90,
-	0x8d, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d,
-	0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x28, 0xe6, 0x97, 0xa0, 0xe6, 0x9d, 0xa1,
-	0xe4, 0xbb, 0xb6, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
-	0x12, 0x1e, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32,
-	0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x12, 0xd1, 0x01, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x01, 0x92, 0x41,
-	0x43, 0x12, 0x0b, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0x1a, 0x34,
-	0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef,
-	0xbc, 0x8c, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xef, 0xbc, 0x8c,
-	0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe7, 0xbb,
-	0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x22, 0x2e, 0x2f, 0x62, 0x63, 0x73,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x7d, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x3a, 0x06, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x30, 0x01, 0x12, 0xa0, 0x02, 0x0a, 0x13, 0x4b, 0x38, 0x53, 0x47, 0x65, 0x74, 0x57,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4b, 0x38, 0x53, 0x47, 0x65, 0x74, 0x57, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4b, 0x38, 0x53, 0x47,
-	0x65, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbd, 0x01, 0x92, 0x41, 0x50, 0x12, 0x11, 0xe6,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0xd1,
+	0x01, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x01, 0x92, 0x41, 0x43, 0x1a,
+	0x34, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0xef, 0xbc, 0x8c, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xef, 0xbc,
+	0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe7,
+	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x0b, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe6, 0x8c, 0x87, 0xe6,
+	0xa0, 0x87, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x3a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x2e, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32,
+	0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x7b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d,
+	0x30, 0x01, 0x12, 0xa0, 0x02, 0x0a, 0x13, 0x4b, 0x38, 0x53, 0x47, 0x65, 0x74, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x4b, 0x38, 0x53, 0x47, 0x65, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4b, 0x38, 0x53, 0x47, 0x65, 0x74,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbd, 0x01, 0x92, 0x41, 0x50, 0x1a, 0x3b, 0xe6, 0xa0, 0xb9,
+	0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe6,
 	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
-	0x1a, 0x3b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8,
-	0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
-	0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x64, 0x12, 0x62, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4,
+	0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x11, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x64, 0x12, 0x62, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76,
+	0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x7d,
+	0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xa3, 0x02, 0x0a, 0x13, 0x4b, 0x38, 0x53, 0x50, 0x75, 0x74,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4b, 0x38, 0x53, 0x50, 0x75, 0x74, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4b, 0x38, 0x53,
+	0x50, 0x75, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0, 0x01, 0x92, 0x41, 0x50, 0x12, 0x11,
+	0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba,
+	0x90, 0x1a, 0x3b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5,
+	0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x67, 0x1a, 0x62, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xa9, 0x02, 0x0a, 0x16,
+	0x4b, 0x38, 0x53, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x4b, 0x38, 0x53, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4b, 0x38, 0x53, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbd, 0x01, 0x92, 0x41, 0x50, 0x1a, 0x3b, 0xe6,
+	0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc,
+	0x8c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6,
+	0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93,
+	0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x12, 0x11, 0xe5, 0x88, 0xa0, 0xe9,
+	0x99, 0xa4, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x64, 0x2a, 0x62, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
 	0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x63, 0x6c,
 	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
 	0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e,
 	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xa3, 0x02, 0x0a, 0x13, 0x4b, 0x38, 0x53, 0x50,
-	0x75, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
-	0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4b, 0x38, 0x53, 0x50, 0x75, 0x74,
-	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4b,
-	0x38, 0x53, 0x50, 0x75, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0, 0x01, 0x92, 0x41, 0x50,
-	0x12, 0x11, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84,
-	0xe6, 0xba, 0x90, 0x1a, 0x3b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0x77, 0x61, 0x74,
-	0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf,
-	0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x67, 0x1a, 0x62, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68,
-	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
-	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xa9, 0x02,
-	0x0a, 0x16, 0x4b, 0x38, 0x53, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x4b, 0x38, 0x53, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4b, 0x38, 0x53, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbd, 0x01, 0x92, 0x41, 0x50, 0x12,
-	0x11, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6,
-	0xba, 0x90, 0x1a, 0x3b, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0x77, 0x61, 0x74, 0x63,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xa0, 0x02, 0x0a, 0x14, 0x4b, 0x38, 0x53, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4b, 0x38, 0x53, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x4b, 0x38, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xba, 0x01,
+	0x92, 0x41, 0x5c, 0x12, 0x17, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x41, 0xe6, 0xa0,
+	0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef, 0xbc, 0x8c,
+	0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x77, 0x61, 0x74, 0x63,
 	0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94,
 	0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x64, 0x2a, 0x62, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0xd3, 0xe4, 0x93, 0x02, 0x55, 0x12, 0x53, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61,
 	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x2f,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
 	0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f,
 	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xa0, 0x02, 0x0a, 0x14, 0x4b, 0x38,
-	0x53, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4b, 0x38, 0x53,
-	0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x4b, 0x38, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xba, 0x01, 0x92, 0x41, 0x5c, 0x12, 0x17, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5,
-	0xe8, 0xaf, 0xa2, 0x77, 0x61, 0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x1a, 0x41,
-	0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xef,
-	0xbc, 0x8c, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x77, 0x61,
-	0x74, 0x63, 0x68, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8,
-	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
-	0x9c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x55, 0x12, 0x53, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63,
-	0x68, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0xcc, 0x01, 0x0a,
-	0x10, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61,
-	0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6c,
-	0x65, 0x61, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0x92, 0x41, 0x40, 0x12, 0x12, 0xe6, 0xb8, 0x85,
-	0xe7, 0x90, 0x86, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x1a,
-	0x2a, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d, 0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0xe6, 0xb8, 0x85, 0xe7, 0x90, 0x86, 0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe7, 0x9a, 0x84, 0xe6,
-	0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2a, 0x2a, 0x28, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76,
-	0x32, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x50, 0x5a, 0x0a, 0x2e,
-	0x2f, 0x3b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x92, 0x41, 0x41, 0x12, 0x18, 0x0a, 0x0f,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x12, 0xcc, 0x01, 0x0a, 0x10, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61,
+	0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0x92, 0x41, 0x40, 0x1a, 0x2a, 0xe6, 0xa0, 0xb9, 0xe6, 0x8d,
+	0xae, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0xe6, 0xb8, 0x85, 0xe7, 0x90, 0x86,
+	0xe9, 0x9b, 0x86, 0xe7, 0xbe, 0xa4, 0xe7, 0x9a, 0x84, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe6,
+	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x12, 0x12, 0xe6, 0xb8, 0x85, 0xe7, 0x90, 0x86, 0xe9, 0x9b, 0x86,
+	0xe7, 0xbe, 0xa4, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x2a,
+	0x28, 0x2f, 0x62, 0x63, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x7d, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x50, 0x5a, 0x0a, 0x2e, 0x2f, 0x3b,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x92, 0x41, 0x41, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x0f,
 	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x44, 0x6f, 0x63, 0x32,
 	0x05, 0x30, 0x2e, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
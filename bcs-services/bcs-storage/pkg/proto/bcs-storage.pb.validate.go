@@ -15417,6 +15417,8 @@ func (m *WatchDynamicResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ResourceVersion
+
 	if len(errors) > 0 {
 		return WatchDynamicResponseMultiError(errors)
 	}
@@ -15716,6 +15718,8 @@ func (m *WatchContainerResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ResourceVersion
+
 	if len(errors) > 0 {
 		return WatchContainerResponseMultiError(errors)
 	}
@@ -16998,6 +17002,10 @@ func (m *StoreWatchOption) validate(all bool) error {
 
 	// no validation rules for MustDiff
 
+	// no validation rules for ResourceVersion
+
+	// no validation rules for AllowBookmarks
+
 	if len(errors) > 0 {
 		return StoreWatchOptionMultiError(errors)
 	}
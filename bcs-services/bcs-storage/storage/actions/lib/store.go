@@ -15,6 +15,7 @@ package lib
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"time"
@@ -525,19 +526,25 @@ const (
 	Chg
 	// SChg self change event
 	SChg
+	// Bookmark event only carries the latest resourceVersion
+	Bookmark
 	// Brk event
 	Brk EventType = -1
+	// Expired event, resourceVersion is too old to resume, client should re-list
+	Expired EventType = -2
 )
 
 // nolint
 var (
 	eventTypeNames = map[EventType]string{
-		Nop:  "EventNop",
-		Add:  "EventAdd",
-		Del:  "EventDelete",
-		Chg:  "EventChange",
-		SChg: "EventSelfChange",
-		Brk:  "EventWatchBreak",
+		Nop:      "EventNop",
+		Add:      "EventAdd",
+		Del:      "EventDelete",
+		Chg:      "EventChange",
+		SChg:     "EventSelfChange",
+		Bookmark: "EventBookmark",
+		Brk:      "EventWatchBreak",
+		Expired:  "EventExpired",
 	}
 )
 
@@ -546,21 +553,29 @@ var (
 	EventWatchBreak = &Event{Type: Brk, Value: nil}
 	// EventWatchBreakBytes watch break event content
 	EventWatchBreakBytes, _ = json.Marshal(EventWatchBreak)
+	// EventWatchExpired watch expired event
+	EventWatchExpired = &Event{Type: Expired, Value: operator.M{
+		"message": storageErr.ResourceVersionExpired.Error(),
+	}}
 )
 
 // Event event of watch
 type Event struct {
 	Type  EventType  `json:"type"`
 	Value operator.M `json:"value"`
+	// ResourceVersion increases monotonically in one resource type, watch can be resumed with it
+	ResourceVersion string `json:"resourceVersion,omitempty"`
 }
 
 // StoreWatchOption option for watch action
 type StoreWatchOption struct {
-	Cond      operator.M
-	SelfOnly  bool
-	MaxEvents uint
-	Timeout   time.Duration
-	MustDiff  string
+	Cond            operator.M
+	SelfOnly        bool
+	MaxEvents       uint
+	Timeout         time.Duration
+	MustDiff        string
+	ResourceVersion string
+	AllowBookmarks  bool
 }
 
 func watchMatch(data, cond operator.M) bool {
@@ -577,6 +592,9 @@ func watchMatch(data, cond operator.M) bool {
 }
 
 // Watch watch some resource type
+// if resourceVersion is set in option, watch is resumed after it with a dedicated listener,
+// otherwise watch subscribes the shared listener of resource type from now on.
+// watch returns storageErr.ResourceVersionExpired when resourceVersion is too old.
 // NOCC: golint/funlen(设计如此:)
 // nolint
 func (a *Store) Watch(ctx context.Context, resourceType string, opt *StoreWatchOption) (chan *Event, error) {
@@ -590,88 +608,126 @@ func (a *Store) Watch(ctx context.Context, resourceType string, opt *StoreWatchO
 	id := uuid.New().String()
 	utils.SetSpanCommonTag(span, "uuid", id)
 
-	dbEvent := make(chan *drivers.WatchEvent, 100)
-	err := a.eventBus.Subscribe(resourceType, id, dbEvent)
+	dbEvent, stop, err := a.listen(ctx, resourceType, id, opt.ResourceVersion)
 	if err != nil {
 		utils.SetSpanLogTagError(span, err)
 		return nil, err
 	}
 
 	retEvent := make(chan *Event, 100)
+	send := func(e *Event) bool {
+		select {
+		case retEvent <- e:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
 	go func() {
 		defer span.Finish()
-		defer a.eventBus.Unsubscribe(resourceType, id)
+		defer stop()
 		eventCounter := 0
 		for {
+			var e *drivers.WatchEvent
 			select {
-			case e := <-dbEvent:
-				if len(opt.MustDiff) != 0 {
-					if e.Type == drivers.EventUpdate && len(e.UpdatedFields) == 0 && len(e.RemovedFields) == 0 {
-						blog.V(5).Infof("watcher %s of topic %s ignore no-diff update event %+v",
-							id, resourceType, e)
+			case <-ctx.Done():
+				blog.Infof("watcher %s of topic %s stopped by context", id, resourceType)
+				return
+			case e = <-dbEvent:
+			}
+			if len(opt.MustDiff) != 0 {
+				if e.Type == drivers.EventUpdate && len(e.UpdatedFields) == 0 && len(e.RemovedFields) == 0 {
+					blog.V(5).Infof("watcher %s of topic %s ignore no-diff update event %+v",
+						id, resourceType, e)
+					continue
+				}
+			}
+			if len(opt.Cond) != 0 {
+				if e.Type == drivers.EventAdd || e.Type == drivers.EventUpdate || e.Type == drivers.EventDelete {
+					if !watchMatch(e.Data, opt.Cond) {
 						continue
 					}
 				}
-				if len(opt.Cond) != 0 {
-					if e.Type == drivers.EventAdd || e.Type == drivers.EventUpdate || e.Type == drivers.EventDelete {
-						if !watchMatch(e.Data, opt.Cond) {
-							continue
-						}
-					}
+			}
+			switch e.Type {
+			case drivers.EventAdd:
+				if !send(&Event{
+					Type:            Add,
+					Value:           e.Data,
+					ResourceVersion: e.ResumeToken,
+				}) {
+					return
 				}
-				switch e.Type {
-				case drivers.EventAdd:
-					retEvent <- &Event{
-						Type:  Add,
-						Value: e.Data,
-					}
-				case drivers.EventUpdate:
-					// send delete event when doing soft delete and meeting delete flag
-					if a.doSoftDelete {
-						if deleteFlagValue, ok := e.UpdatedFields[databaseFieldNameForDeletionFlag]; ok {
-							deleteFlag, assertOk := deleteFlagValue.(bool)
-							if assertOk && deleteFlag {
-								retEvent <- &Event{
-									Type:  Del,
-									Value: e.Data,
-								}
+			case drivers.EventUpdate:
+				// send delete event when doing soft delete and meeting delete flag
+				if a.doSoftDelete {
+					if deleteFlagValue, ok := e.UpdatedFields[databaseFieldNameForDeletionFlag]; ok {
+						deleteFlag, assertOk := deleteFlagValue.(bool)
+						if assertOk && deleteFlag {
+							if !send(&Event{
+								Type:            Del,
+								Value:           e.Data,
+								ResourceVersion: e.ResumeToken,
+							}) {
+								return
 							}
 						}
 					}
-					retEvent <- &Event{
-						Type:  Chg,
-						Value: e.Data,
-					}
-				case drivers.EventDelete:
-					// ignore delete event when doing soft delete
-					if a.doSoftDelete {
-						continue
-					}
-					retEvent <- &Event{
-						Type:  Del,
-						Value: e.Data,
-					}
-				case drivers.EventError, drivers.EventClose:
-					retEvent <- &Event{
-						Type:  Brk,
-						Value: e.Data,
-					}
+				}
+				if !send(&Event{
+					Type:            Chg,
+					Value:           e.Data,
+					ResourceVersion: e.ResumeToken,
+				}) {
 					return
-				default:
-					retEvent <- &Event{
-						Type:  Nop,
-						Value: e.Data,
-					}
 				}
-				eventCounter++
-				if opt.MaxEvents != 0 {
-					if uint(eventCounter) >= opt.MaxEvents {
-						blog.Infof("watcher %s for topic %s exceeds max event %d", id, resourceType, opt.MaxEvents)
-						retEvent <- &Event{
-							Type: Brk,
-						}
-						return
-					}
+			case drivers.EventDelete:
+				// ignore delete event when doing soft delete
+				if a.doSoftDelete {
+					continue
+				}
+				if !send(&Event{
+					Type:            Del,
+					Value:           e.Data,
+					ResourceVersion: e.ResumeToken,
+				}) {
+					return
+				}
+			case drivers.EventBookmark:
+				// bookmark is not counted as event
+				if opt.AllowBookmarks && !send(&Event{
+					Type:            Bookmark,
+					ResourceVersion: e.ResumeToken,
+				}) {
+					return
+				}
+				continue
+			case drivers.EventError, drivers.EventClose:
+				if e.Err != nil && errors.Is(e.Err, drivers.ErrWatchResumeTokenExpired) {
+					blog.Warnf("watcher %s of topic %s expired, err %s", id, resourceType, e.Err.Error())
+					send(EventWatchExpired)
+				}
+				send(&Event{
+					Type:  Brk,
+					Value: e.Data,
+				})
+				return
+			default:
+				if !send(&Event{
+					Type:  Nop,
+					Value: e.Data,
+				}) {
+					return
+				}
+			}
+			eventCounter++
+			if opt.MaxEvents != 0 {
+				if uint(eventCounter) >= opt.MaxEvents {
+					blog.Infof("watcher %s for topic %s exceeds max event %d", id, resourceType, opt.MaxEvents)
+					send(&Event{
+						Type: Brk,
+					})
+					return
 				}
 			}
 		}
@@ -679,6 +735,31 @@ func (a *Store) Watch(ctx context.Context, resourceType string, opt *StoreWatchO
 	return retEvent, nil
 }
 
+// listen get db event channel of resource type, stop should be called when watch is finished
+func (a *Store) listen(ctx context.Context, resourceType, id, resourceVersion string) (
+	chan *drivers.WatchEvent, func(), error) {
+	if len(resourceVersion) != 0 {
+		listenCtx, cancel := context.WithCancel(ctx)
+		dbEvent, err := a.eventBus.ResumeWatch(listenCtx, resourceType, resourceVersion)
+		if err != nil {
+			cancel()
+			if errors.Is(err, drivers.ErrWatchResumeTokenExpired) {
+				blog.Warnf("resume watcher %s of topic %s failed, err %s", id, resourceType, err.Error())
+				return nil, nil, storageErr.ResourceVersionExpired
+			}
+			return nil, nil, err
+		}
+		return dbEvent, cancel, nil
+	}
+	dbEvent := make(chan *drivers.WatchEvent, 100)
+	if err := a.eventBus.Subscribe(resourceType, id, dbEvent); err != nil {
+		return nil, nil, err
+	}
+	return dbEvent, func() {
+		_ = a.eventBus.Unsubscribe(resourceType, id)
+	}, nil
+}
+
 func setDBSpanTags(s *Store, span opentracing.Span, table string, operation string) {
 	utils.SetSpanDBTag(span, utils.DBType, dbType)
 	utils.SetSpanDBTag(span, utils.DBDatabase, s.mDriver.DataBase())
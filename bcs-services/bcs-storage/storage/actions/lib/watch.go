@@ -14,6 +14,7 @@ package lib
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -24,6 +25,7 @@ import (
 	restful "github.com/emicklei/go-restful/v3"
 
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-storage/storage/actions/utils/metrics"
+	storageErr "github.com/Tencent/bk-bcs/bcs-services/bcs-storage/storage/errors"
 )
 
 // WatchServerOption options for watch server
@@ -97,12 +99,17 @@ func (ws *WatchServer) Go(ctx context.Context) {
 	blog.Infof(ws.sprint("begin to watch"))
 
 	watchOption := &StoreWatchOption{
-		Cond:      ws.cond,
-		SelfOnly:  ws.opts.SelfOnly,
-		MaxEvents: ws.opts.MaxEvents,
-		Timeout:   ws.opts.Timeout,
-		MustDiff:  ws.opts.MustDiff,
+		Cond:            ws.cond,
+		SelfOnly:        ws.opts.SelfOnly,
+		MaxEvents:       ws.opts.MaxEvents,
+		Timeout:         ws.opts.Timeout,
+		MustDiff:        ws.opts.MustDiff,
+		ResourceVersion: ws.opts.ResourceVersion,
+		AllowBookmarks:  ws.opts.AllowBookmarks,
 	}
+	// stop watching in store when watch server returns
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
 	defer func() {
 		blog.Infof(ws.sprint("watch end"))
@@ -113,6 +120,9 @@ func (ws *WatchServer) Go(ctx context.Context) {
 	event, err := ws.store.Watch(ctx, ws.tableName, watchOption)
 	if err != nil {
 		blog.Errorf("watch failed, err %s", err.Error())
+		if errors.Is(err, storageErr.ResourceVersionExpired) {
+			ws.Writer(ws.resp, EventWatchExpired)
+		}
 		return
 	}
 
@@ -93,6 +93,9 @@ var (
 	RemoveLessThanMatch = &StorageError{Code: common.AdditionErrorCode + 6320, Message: "remove less than match"}
 	// UpdateLessThanMatch xxx
 	UpdateLessThanMatch = &StorageError{Code: common.AdditionErrorCode + 6321, Message: "update less than match"}
+	// ResourceVersionExpired xxx
+	ResourceVersionExpired = &StorageError{Code: common.AdditionErrorCode + 6322,
+		Message: "resource version is too old, please re-list"}
 	// QueueConfigUnknown xxx
 	QueueConfigUnknown = &StorageError{Code: common.AdditionErrorCode + 6307, Message: "queue config unknown"}
 )
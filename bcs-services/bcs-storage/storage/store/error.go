@@ -22,4 +22,6 @@ var (
 	ErrObjectNotFound = errors.New("object not found")
 	// ErrObjectExists error for object exists
 	ErrObjectExists = errors.New("object already exists")
+	// ErrResourceVersionExpired error for resource version too old to resume watch
+	ErrResourceVersionExpired = errors.New("resource version is too old, please re-list")
 )
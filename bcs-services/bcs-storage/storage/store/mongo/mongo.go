@@ -374,9 +374,18 @@ func (s *Store) Watch(ctx context.Context, resourceType types.ObjectType, opt *s
 	if opt.StartTime != nil {
 		watcher.WithStartTimestamp(opt.StartTime.T, opt.StartTime.I)
 	}
+	if len(opt.ResourceVersion) != 0 {
+		watcher.WithResumeToken(opt.ResourceVersion)
+	}
+	if opt.BookmarkInterval != 0 {
+		watcher.WithBookmarkInterval(opt.BookmarkInterval)
+	}
 	// Execute the watcher and create a storeChannel to return
 	mongoChannel, err := watcher.DoWatch(ctx)
 	if err != nil {
+		if errors.Is(err, drivers.ErrWatchResumeTokenExpired) {
+			return nil, store.ErrResourceVersionExpired
+		}
 		return nil, err
 	}
 	storeChannel := make(chan *store.Event, 100)
@@ -394,7 +403,8 @@ func (s *Store) Watch(ctx context.Context, resourceType types.ObjectType, opt *s
 					return
 				}
 				storeChannel <- sEvent
-				if sEvent.Type == store.EventError || sEvent.Type == store.EventClose {
+				if sEvent.Type == store.EventError || sEvent.Type == store.EventClose ||
+					sEvent.Type == store.EventExpired {
 					return
 				}
 			case <-ctx.Done():
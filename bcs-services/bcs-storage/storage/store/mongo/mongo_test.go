@@ -11,3 +11,72 @@
  */
 
 package mongo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-storage/storage/store"
+)
+
+// TestDecodeMongoEvent test decode mongo watch event to store event
+func TestDecodeMongoEvent(t *testing.T) {
+	testCases := []struct {
+		title           string
+		event           *drivers.WatchEvent
+		expectedType    store.EventType
+		expectedVersion string
+	}{
+		{
+			title: "add event",
+			event: &drivers.WatchEvent{
+				Type:        drivers.EventAdd,
+				Data:        operator.M{"resourceType": "Pod"},
+				ResumeToken: "8262A1B2C3000000012B",
+			},
+			expectedType:    store.EventAdd,
+			expectedVersion: "8262A1B2C3000000012B",
+		},
+		{
+			title: "bookmark event",
+			event: &drivers.WatchEvent{
+				Type:        drivers.EventBookmark,
+				ResumeToken: "8262A1B2C3000000022B",
+			},
+			expectedType:    store.EventBookmark,
+			expectedVersion: "8262A1B2C3000000022B",
+		},
+		{
+			title: "expired event",
+			event: &drivers.WatchEvent{
+				Type: drivers.EventError,
+				Err:  fmt.Errorf("%w: history lost", drivers.ErrWatchResumeTokenExpired),
+			},
+			expectedType: store.EventExpired,
+		},
+		{
+			title: "error event",
+			event: &drivers.WatchEvent{
+				Type: drivers.EventError,
+				Err:  fmt.Errorf("network error"),
+			},
+			expectedType: store.EventError,
+		},
+	}
+	for _, testCase := range testCases {
+		event, err := decodeMongoEvent(testCase.event)
+		if err != nil {
+			t.Fatalf("%s: decode mongo event failed, err %s", testCase.title, err.Error())
+		}
+		if event.Type != testCase.expectedType {
+			t.Errorf("%s: expect type %s, but got %s", testCase.title, testCase.expectedType, event.Type)
+		}
+		if event.ResourceVersion != testCase.expectedVersion {
+			t.Errorf("%s: expect resource version %s, but got %s", testCase.title, testCase.expectedVersion,
+				event.ResourceVersion)
+		}
+	}
+}
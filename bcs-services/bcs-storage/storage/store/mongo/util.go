@@ -14,6 +14,7 @@ package mongo
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
 	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
@@ -35,16 +36,18 @@ func decodeMongoEvent(e *drivers.WatchEvent) (*store.Event, error) {
 			return nil, err
 		}
 		return &store.Event{
-			Type: store.EventAdd,
-			Obj:  &rawObj,
+			Type:            store.EventAdd,
+			Obj:             &rawObj,
+			ResourceVersion: e.ResumeToken,
 		}, nil
 	case drivers.EventUpdate:
 		if err := decodeM(e.Data, &rawObj); err != nil {
 			return nil, err
 		}
 		return &store.Event{
-			Type: store.EventUpdate,
-			Obj:  &rawObj,
+			Type:            store.EventUpdate,
+			Obj:             &rawObj,
+			ResourceVersion: e.ResumeToken,
 		}, nil
 
 	case drivers.EventDelete:
@@ -52,11 +55,23 @@ func decodeMongoEvent(e *drivers.WatchEvent) (*store.Event, error) {
 			return nil, err
 		}
 		return &store.Event{
-			Type: store.EventDelete,
-			Obj:  &rawObj,
+			Type:            store.EventDelete,
+			Obj:             &rawObj,
+			ResourceVersion: e.ResumeToken,
+		}, nil
+
+	case drivers.EventBookmark:
+		return &store.Event{
+			Type:            store.EventBookmark,
+			ResourceVersion: e.ResumeToken,
 		}, nil
 
 	case drivers.EventError:
+		if e.Err != nil && errors.Is(e.Err, drivers.ErrWatchResumeTokenExpired) {
+			return &store.Event{
+				Type: store.EventExpired,
+			}, nil
+		}
 		return &store.Event{
 			Type: store.EventError,
 		}, nil
@@ -67,4 +67,8 @@ type WatchOptions struct {
 	MaxAwaitTime time.Duration
 	StartTime    *WatchStartTimeStamp
 	Env          string
+	// ResourceVersion resume watch after the event with this resource version
+	ResourceVersion string
+	// BookmarkInterval interval of bookmark event, 0 for no bookmark event
+	BookmarkInterval time.Duration
 }
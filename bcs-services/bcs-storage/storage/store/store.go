@@ -30,6 +30,10 @@ const (
 	EventClose = "close"
 	// EventError err event
 	EventError = "error"
+	// EventBookmark bookmark event, only carries the latest resource version
+	EventBookmark = "bookmark"
+	// EventExpired expired event, resource version is too old to resume watch
+	EventExpired = "expired"
 )
 
 // EventType event type for store
@@ -39,6 +43,8 @@ type EventType string
 type Event struct {
 	Type EventType
 	Obj  *types.RawObject
+	// ResourceVersion increases monotonically in one resource type, watch can be resumed with it
+	ResourceVersion string
 }
 
 // Store interface for store object
@@ -17,26 +17,34 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
 	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
 )
 
+const (
+	// defaultBookmarkInterval default interval of bookmark event when there is no event in topic
+	defaultBookmarkInterval = 30 * time.Second
+)
+
 // EventBus dispatch event
 type EventBus struct {
-	cond           *operator.Condition
-	subscribers    map[string]map[string]chan *drivers.WatchEvent
-	sublock        sync.RWMutex
-	topicListeners map[string]chan *drivers.WatchEvent
-	db             drivers.DB
+	cond             *operator.Condition
+	bookmarkInterval time.Duration
+	subscribers      map[string]map[string]chan *drivers.WatchEvent
+	sublock          sync.RWMutex
+	topicListeners   map[string]chan *drivers.WatchEvent
+	db               drivers.DB
 }
 
 // NewEventBus create event bus
 func NewEventBus(db drivers.DB) *EventBus {
 	return &EventBus{
-		subscribers:    make(map[string]map[string]chan *drivers.WatchEvent),
-		topicListeners: make(map[string]chan *drivers.WatchEvent),
-		db:             db,
+		subscribers:      make(map[string]map[string]chan *drivers.WatchEvent),
+		topicListeners:   make(map[string]chan *drivers.WatchEvent),
+		db:               db,
+		bookmarkInterval: defaultBookmarkInterval,
 	}
 }
 
@@ -54,6 +62,7 @@ func (eb *EventBus) createListener(topic string) (chan *drivers.WatchEvent, erro
 	}
 	listenerCh, err := eb.db.Table(topic).Watch(conditionList).
 		WithFullContent(true).
+		WithBookmarkInterval(eb.bookmarkInterval).
 		DoWatch(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("start listener of topic %s failed, err %s", topic, err.Error())
@@ -76,6 +85,24 @@ func (eb *EventBus) createListener(topic string) (chan *drivers.WatchEvent, erro
 	return listenerCh, nil
 }
 
+// ResumeWatch watch topic after certain resume token with a dedicated listener,
+// because the shared listener of topic only has events after it is created
+func (eb *EventBus) ResumeWatch(ctx context.Context, topic, resumeToken string) (chan *drivers.WatchEvent, error) {
+	var conditionList []*operator.Condition
+	if eb.cond != nil {
+		conditionList = append(conditionList, eb.cond)
+	}
+	listenerCh, err := eb.db.Table(topic).Watch(conditionList).
+		WithFullContent(true).
+		WithResumeToken(resumeToken).
+		WithBookmarkInterval(eb.bookmarkInterval).
+		DoWatch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("resume listener of topic %s failed, err %w", topic, err)
+	}
+	return listenerCh, nil
+}
+
 // Subscribe subscribe event for certain topic, here topic is database table
 func (eb *EventBus) Subscribe(topic, uuid string, ch chan *drivers.WatchEvent) error {
 	eb.sublock.Lock()
@@ -53,6 +53,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/perf"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/podmanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/share"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/web"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/route"
 )
@@ -63,6 +64,7 @@ var (
 	appName              = "bcs-webconsole"
 	versionTag           = "latest"
 	credentialConfigPath = cli.StringSlice{}
+	cmdPolicyConfigPath  = cli.StringSlice{}
 	configPath           = ""
 	serverAddressFlag    = "server-address" // 默认启动ip
 	serverPortFlag       = "server-port"    // 默认启动port
@@ -77,6 +79,7 @@ type WebConsoleManager struct {
 	microService  micro.Service
 	microConfig   microConf.Config
 	multiCredConf *options.MultiCredConf
+	cmdPolicyConf *options.MultiCmdPolicyConf
 	serverAddress string
 	listenPort    string
 }
@@ -210,6 +213,15 @@ func (c *WebConsoleManager) initMicroService() (micro.Service, microConf.Config,
 			}
 			multiCredConf = credConf
 		}
+		// 终端命令策略
+		if len(cmdPolicyConfigPath.Value()) > 0 {
+			policyConf, err := options.NewMultiCmdPolicyConf(cmdPolicyConfigPath.Value())
+			if err != nil {
+				logger.Errorf("config not valid, err: %s, exited", err)
+				os.Exit(1)
+			}
+			c.cmdPolicyConf = policyConf
+		}
 		return nil
 	}
 	srv := micro.NewService()
@@ -382,6 +394,12 @@ func buildFlags() []cli.Flag {
 			Required:    false,
 			Destination: &credentialConfigPath,
 		},
+		&cli.StringSliceFlag{
+			Name:        "cmd-policy-config",
+			Usage:       "web terminal command policy config file path",
+			Required:    false,
+			Destination: &cmdPolicyConfigPath,
+		},
 		&cli.BoolFlag{
 			Name:    "confinfo",
 			Usage:   "print init confinfo to stdout",
@@ -445,6 +463,12 @@ func (c *WebConsoleManager) Run() error {
 		return performance.Run(ctx)
 	})
 
+	// 共享会话事件分发
+	shareBroker := share.GetGlobalBroker()
+	eg.Go(func() error {
+		return shareBroker.Run(ctx)
+	})
+
 	if c.multiCredConf != nil {
 		c.microService.Init(micro.AfterStop(func() error {
 			return c.multiCredConf.Stop()
@@ -455,6 +479,16 @@ func (c *WebConsoleManager) Run() error {
 		})
 	}
 
+	if c.cmdPolicyConf != nil {
+		c.microService.Init(micro.AfterStop(func() error {
+			return c.cmdPolicyConf.Stop()
+		}))
+
+		eg.Go(func() error {
+			return c.cmdPolicyConf.Watch()
+		})
+	}
+
 	eg.Go(func() error {
 		if err := c.microService.Run(); err != nil {
 			return err
@@ -0,0 +1,116 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package options
+
+import (
+	logger "github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/pkg/errors"
+	microConf "go-micro.dev/v4/config"
+	"go-micro.dev/v4/config/source"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+)
+
+const cmdPolicyKey = "cmd_policies"
+
+// MultiCmdPolicyConf 终端命令策略配置, 支持热更新
+type MultiCmdPolicyConf struct {
+	watcherMap map[string]microConf.Watcher
+	confMap    map[string]microConf.Config
+}
+
+// NewMultiCmdPolicyConf 加载命令策略配置
+func NewMultiCmdPolicyConf(filePaths []string) (*MultiCmdPolicyConf, error) {
+	multiConf := &MultiCmdPolicyConf{
+		confMap:    make(map[string]microConf.Config),
+		watcherMap: make(map[string]microConf.Watcher),
+	}
+
+	for _, filePath := range filePaths {
+		if _, ok := multiConf.confMap[filePath]; ok {
+			return nil, errors.New("cmd policy config is duplicated")
+		}
+
+		conf, err := makeMicroConf(filePath)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := config.G.ReadCmdPolicy(filePath, conf.Get(cmdPolicyKey).Bytes()); err != nil {
+			return nil, errors.Wrapf(err, "load cmd policy from %s", filePath)
+		}
+		logger.Infof("load cmd policy conf from %s, len=%d", filePath, config.G.CountCmdPolicy(filePath))
+
+		multiConf.confMap[filePath] = conf
+	}
+
+	return multiConf, nil
+}
+
+// Watch 监听多个文件变化
+func (m *MultiCmdPolicyConf) Watch() error {
+	var eg errgroup.Group
+
+	for name, conf := range m.confMap {
+		w, err := m.watch(name, conf, &eg)
+		if err != nil {
+			return err
+		}
+		m.watcherMap[name] = w
+	}
+
+	return eg.Wait()
+}
+
+// watch 监听单个文件变化, 新配置校验失败时保留原有规则
+func (m *MultiCmdPolicyConf) watch(name string, conf microConf.Config, eg *errgroup.Group) (
+	microConf.Watcher, error) {
+	w, err := conf.Watch(cmdPolicyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	eg.Go(func() error {
+		for {
+			value, err := w.Next()
+			if err != nil {
+				if err.Error() == source.ErrWatcherStopped.Error() {
+					return nil
+				}
+				return err
+			}
+			// watch 会传入 null 空值
+			if string(value.Bytes()) == "null" {
+				continue
+			}
+			if err := config.G.ReadCmdPolicy(name, value.Bytes()); err != nil {
+				logger.Errorf("reload cmd policy error, %s", err)
+				continue
+			}
+			logger.Infof("reload cmd policy conf from %s, len=%d", name, config.G.CountCmdPolicy(name))
+		}
+	})
+
+	return w, nil
+}
+
+// Stop 停止所有监听
+func (m *MultiCmdPolicyConf) Stop() error {
+	var err error
+	for name, w := range m.watcherMap {
+		logger.Infof("receive interput, stop watch %s", name)
+		err = w.Stop()
+	}
+	return err
+}
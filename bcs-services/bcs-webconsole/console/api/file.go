@@ -56,7 +56,13 @@ func (s *service) UploadHandler(c *gin.Context) {
 		rest.APIError(c, i18n.T(c, "请先输入上传路径"))
 		return
 	}
-	err := checkFileExists(uploadPath, sessionId)
+	podCtx, err := getFileSession(c.Request.Context(), sessionId)
+	if err != nil {
+		logger.Errorf("get pod context by session %s failed, err: %s", sessionId, err.Error())
+		rest.APIError(c, fileSessionErrMsg(c, err))
+		return
+	}
+	err = checkFileExists(uploadPath, sessionId)
 	if err != nil {
 		rest.APIError(c, i18n.T(c, "目标路径不存在"))
 		return
@@ -88,12 +94,6 @@ func (s *service) UploadHandler(c *gin.Context) {
 	}
 	defer opened.Close()
 
-	podCtx, err := sessions.NewStore().WebSocketScope().Get(c.Request.Context(), sessionId)
-	if err != nil {
-		logger.Errorf("get pod context by session %s failed, err: %s", sessionId, err.Error())
-		rest.APIError(c, i18n.T(c, "获取pod信息失败"))
-		return
-	}
 	reader, writer := io.Pipe()
 	pe, err := podCtx.NewPodExec()
 	if err != nil {
@@ -168,6 +168,13 @@ func (s *service) UploadHandler(c *gin.Context) {
 func (s *service) DownloadHandler(c *gin.Context) {
 	downloadPath := c.Query("download_path")
 	sessionId := c.Param("sessionId")
+	podCtx, err := getFileSession(c.Request.Context(), sessionId)
+	if err != nil {
+		logger.Errorf("get pod context by session %s failed, err: %s", sessionId, err.Error())
+		rest.APIError(c, fileSessionErrMsg(c, err))
+		return
+	}
+
 	reader, writer := io.Pipe()
 	errChan := make(chan error, 1)
 	go func() {
@@ -176,12 +183,6 @@ func (s *service) DownloadHandler(c *gin.Context) {
 			writer.Close() // nolint
 			close(errChan)
 		}()
-		podCtx, err := sessions.NewStore().WebSocketScope().Get(c.Request.Context(), sessionId)
-		if err != nil {
-			errChan <- err
-			return
-		}
-
 		pe, err := podCtx.NewPodExec()
 		if err != nil {
 			errChan <- err
@@ -200,7 +201,7 @@ func (s *service) DownloadHandler(c *gin.Context) {
 		errChan <- nil
 	}()
 	tarReader := tar.NewReader(reader)
-	_, err := tarReader.Next()
+	_, err = tarReader.Next()
 	if err != nil {
 		rest.APIError(c, i18n.T(c, "复制文件流失败"))
 		return
@@ -234,6 +235,15 @@ func (s *service) CheckDownloadHandler(c *gin.Context) {
 	// 检查都返回200, 具体错误在 CheckPassed 中处理
 	msg := "check done"
 
+	if _, err := getFileSession(c.Request.Context(), sessionId); errors.Is(err, errShareSession) {
+		rest.APIOK(c, msg, types.CheckPassed{
+			Passed: false,
+			Detail: err.Error(),
+			Reason: i18n.T(c, "共享会话不支持文件传输"),
+		})
+		return
+	}
+
 	if err := checkFileExists(downloadPath, sessionId); err != nil {
 		rest.APIOK(c, msg, types.CheckPassed{
 			Passed: false,
@@ -285,8 +295,39 @@ func validateUploadFileName(fileName string) (string, error) {
 	return baseName, nil
 }
 
+// errShareSession 协作者 session 复用所有者的容器信息, 不能通过它上传下载文件
+var errShareSession = errors.New("file transfer is not allowed in shared session")
+
+// getFileSession 获取文件传输使用的 session, 拒绝共享会话的协作者 session
+func getFileSession(ctx context.Context, sessionID string) (*types.PodContext, error) {
+	podCtx, err := sessions.NewStore().WebSocketScope().Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFileSession(podCtx); err != nil {
+		return nil, err
+	}
+	return podCtx, nil
+}
+
+// checkFileSession 校验 session 是否允许文件传输
+func checkFileSession(podCtx *types.PodContext) error {
+	if podCtx.ShareId != "" {
+		return errShareSession
+	}
+	return nil
+}
+
+// fileSessionErrMsg 获取 session 失败的提示信息
+func fileSessionErrMsg(c *gin.Context, err error) string {
+	if errors.Is(err, errShareSession) {
+		return i18n.T(c, "共享会话不支持文件传输")
+	}
+	return i18n.T(c, "获取pod信息失败")
+}
+
 func checkPathIsDir(path, sessionID string) error {
-	podCtx, err := sessions.NewStore().WebSocketScope().Get(context.Background(), sessionID)
+	podCtx, err := getFileSession(context.Background(), sessionID)
 	if err != nil {
 		return err
 	}
@@ -307,7 +348,7 @@ func checkPathIsDir(path, sessionID string) error {
 }
 
 func checkFileExists(path, sessionID string) error {
-	podCtx, err := sessions.NewStore().WebSocketScope().Get(context.Background(), sessionID)
+	podCtx, err := getFileSession(context.Background(), sessionID)
 	if err != nil {
 		return err
 	}
@@ -328,7 +369,7 @@ func checkFileExists(path, sessionID string) error {
 }
 
 func checkFileSize(path, sessionID string, sizeLimit int) error {
-	podCtx, err := sessions.NewStore().WebSocketScope().Get(context.Background(), sessionID)
+	podCtx, err := getFileSession(context.Background(), sessionID)
 	if err != nil {
 		return err
 	}
@@ -82,6 +82,15 @@ func (s service) RegisterRoute(router gin.IRoutes) {
 	api.GET("/api/sessions/:sessionId/download/", metrics.RequestCollect("Download"), s.DownloadHandler)
 	api.GET("/api/sessions/:sessionId/download/check/", metrics.RequestCollect("CheckDownload"), s.CheckDownloadHandler)
 
+	// 共享会话, 所有者邀请/取消, 被邀请用户需要项目集群权限
+	api.POST("/api/sessions/:sessionId/shares/", metrics.RequestCollect("CreateShare"),
+		route.AuditHandler(), s.CreateShare)
+	api.DELETE("/api/sessions/:sessionId/shares/:shareId/", metrics.RequestCollect("DeleteShare"),
+		route.AuditHandler(), s.DeleteShare)
+	api.GET("/api/projects/:projectId/clusters/:clusterId/shares/:shareId/session/",
+		metrics.RequestCollect("CreateShareSession"), route.PermissionRequired(),
+		route.AuditHandler(), s.CreateShareSession)
+
 	// 用户命令延时统计api
 	api.PUT("/api/command/delay/:username", metrics.RequestCollect("SetUserDelaySwitch"),
 		route.ManagersRequired(), s.SetUserDelaySwitch)
@@ -0,0 +1,226 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/i18n"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/rest"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/sessions"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/share"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/types"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/route"
+)
+
+// getOwnerSession 查询会话所有者的 websocket session, 只有所有者和管理员可以管理共享
+func getOwnerSession(c *gin.Context, authCtx *route.AuthContext, sessionId string) (*types.PodContext, error) {
+	podCtx, err := sessions.NewStore().WebSocketScope().Get(c.Request.Context(), sessionId)
+	if err != nil {
+		return nil, errors.New(i18n.T(c, "session不合法"))
+	}
+
+	// 协作者不能再次共享
+	if podCtx.ShareId != "" {
+		return nil, errors.New(i18n.T(c, "用户无权限共享此session"))
+	}
+
+	if authCtx.Username != podCtx.Username && !config.G.IsManager(authCtx.Username, podCtx.ClusterId) {
+		return nil, errors.New(i18n.T(c, "用户无权限共享此session"))
+	}
+
+	// 审计使用
+	authCtx.ProjectId = podCtx.ProjectId
+	authCtx.ProjectCode = podCtx.ProjectCode
+	authCtx.ClusterId = podCtx.ClusterId
+
+	return podCtx, nil
+}
+
+// CreateShare 邀请其他用户加入会话
+//
+// @Summary      创建 WebConsole 共享邀请
+// @Description  会话所有者邀请其他用户只读旁观或接管控制终端，被邀请用户通过 share_id 换取 ws_url
+// @Tags         Share
+// @Accept       json
+// @Produce      json
+// @Param        sessionId  path      string            true  "WebSocket Session ID"
+// @Param        body       body      share.ShareQuery  true  "请求体"
+// @Success      200        {object}  types.APIResponse "返回 share_id 和过期时间"
+// @Failure      400        {object}  types.APIResponse "请求参数错误"
+// @Router       /api/sessions/{sessionId}/shares/ [post]
+func (s *service) CreateShare(c *gin.Context) {
+	authCtx := route.MustGetAuthContext(c)
+
+	shareQuery := new(share.ShareQuery)
+	if err := c.BindJSON(shareQuery); err != nil {
+		rest.APIError(c, i18n.T(c, "请求参数错误: %s", err))
+		return
+	}
+	if err := shareQuery.Validate(); err != nil {
+		rest.APIError(c, i18n.T(c, "请求参数错误: %s", err))
+		return
+	}
+
+	sessionId := route.GetSessionId(c)
+	podCtx, err := getOwnerSession(c, authCtx, sessionId)
+	if err != nil {
+		rest.APIError(c, err.Error())
+		return
+	}
+
+	now := time.Now()
+	shareObj := &share.Share{
+		SessionId:  sessionId,
+		Owner:      podCtx.Username,
+		ProjectId:  podCtx.ProjectId,
+		ClusterId:  podCtx.ClusterId,
+		Users:      map[string]share.Mode{},
+		CreateTime: now,
+		ExpireTime: now.Add(time.Duration(shareQuery.ExpireMinutes) * time.Minute),
+	}
+	for _, username := range shareQuery.Users {
+		if username == "" || username == podCtx.Username {
+			continue
+		}
+		shareObj.Users[username] = shareQuery.Mode
+	}
+	if len(shareObj.Users) == 0 {
+		rest.APIError(c, i18n.T(c, "请求参数错误: %s", "users is required"))
+		return
+	}
+
+	shareId, err := share.NewStore().Set(c.Request.Context(), shareObj)
+	if err != nil {
+		rest.APIError(c, i18n.T(c, "服务请求失败: %s", err))
+		return
+	}
+
+	data := map[string]interface{}{
+		"share_id":    shareId,
+		"mode":        shareQuery.Mode,
+		"expire_time": shareObj.ExpireTime,
+	}
+	rest.APIOK(c, i18n.T(c, "服务请求成功"), data)
+}
+
+// DeleteShare 取消共享邀请, 已加入的协作者会被移出会话
+//
+// @Summary      取消 WebConsole 共享邀请
+// @Description  会话所有者取消共享邀请，通过该邀请加入的协作者会被断开
+// @Tags         Share
+// @Accept       json
+// @Produce      json
+// @Param        sessionId  path      string             true  "WebSocket Session ID"
+// @Param        shareId    path      string             true  "共享邀请 ID"
+// @Success      200        {object}  types.APIResponse  "操作成功"
+// @Failure      400        {object}  types.APIResponse  "请求参数错误"
+// @Router       /api/sessions/{sessionId}/shares/{shareId}/ [delete]
+func (s *service) DeleteShare(c *gin.Context) {
+	authCtx := route.MustGetAuthContext(c)
+
+	sessionId := route.GetSessionId(c)
+	if _, err := getOwnerSession(c, authCtx, sessionId); err != nil {
+		rest.APIError(c, err.Error())
+		return
+	}
+
+	shareId := c.Param("shareId")
+	shareObj, err := share.NewStore().Get(c.Request.Context(), shareId)
+	if err != nil || shareObj.SessionId != sessionId {
+		rest.APIError(c, i18n.T(c, "共享邀请不存在或已过期"))
+		return
+	}
+
+	if err := share.NewStore().Delete(c.Request.Context(), shareId); err != nil {
+		rest.APIError(c, i18n.T(c, "服务请求失败: %s", err))
+		return
+	}
+	// 删除协作者 session, 避免邀请取消后继续使用
+	if err := sessions.NewStore().DeleteByShareId(c.Request.Context(), shareId); err != nil {
+		rest.APIError(c, i18n.T(c, "服务请求失败: %s", err))
+		return
+	}
+
+	// 通知会话所有者移出已加入的协作者
+	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Second*2)
+	defer cancel()
+	event := &share.Event{Type: share.EventRevoke, ShareId: shareId}
+	if err := share.GetGlobalBroker().Publish(ctx, share.InboxChannel(sessionId), event); err != nil {
+		rest.APIError(c, i18n.T(c, "服务请求失败: %s", err))
+		return
+	}
+
+	rest.APIOK(c, i18n.T(c, "服务请求成功"), nil)
+}
+
+// CreateShareSession 被邀请用户换取共享会话的 websocket session
+//
+// @Summary      加入 WebConsole 共享会话
+// @Description  被邀请用户校验项目、集群权限后，换取共享会话的 session_id 和 ws_url
+// @Tags         Share
+// @Accept       json
+// @Produce      json
+// @Param        projectId  path      string             true   "项目ID"
+// @Param        clusterId  path      string             true   "集群ID"
+// @Param        shareId    path      string             true   "共享邀请 ID"
+// @Param        lang       query     string             false  "语言"
+// @Success      200        {object}  types.APIResponse  "返回 session_id, ws_url 和权限"
+// @Failure      400        {object}  types.APIResponse  "邀请不存在或无权限"
+// @Router       /api/projects/{projectId}/clusters/{clusterId}/shares/{shareId}/session/ [get]
+func (s *service) CreateShareSession(c *gin.Context) {
+	authCtx := route.MustGetAuthContext(c)
+
+	shareObj, err := share.NewStore().Get(c.Request.Context(), c.Param("shareId"))
+	if err != nil || shareObj.ProjectId != authCtx.ProjectId || shareObj.ClusterId != authCtx.ClusterId {
+		rest.APIError(c, i18n.T(c, "共享邀请不存在或已过期"))
+		return
+	}
+
+	mode, ok := shareObj.GetMode(authCtx.Username)
+	if !ok {
+		rest.APIError(c, i18n.T(c, "用户未被邀请加入此共享会话"))
+		return
+	}
+
+	ownerPodCtx, err := sessions.NewStore().WebSocketScope().Get(c.Request.Context(), shareObj.SessionId)
+	if err != nil {
+		rest.APIError(c, i18n.T(c, "共享会话已结束"))
+		return
+	}
+
+	// 协作者 session 复用所有者的容器信息, 输入输出由所有者的连接转发, 与邀请同时过期
+	podCtx := *ownerPodCtx
+	podCtx.Username = authCtx.Username
+	podCtx.ShareId = shareObj.ShareId
+	podCtx.SessionTimeout = shareObj.SessionTimeout()
+
+	sessionId, err := sessions.NewStore().WebSocketScope().Set(c.Request.Context(), &podCtx)
+	if err != nil {
+		rest.APIError(c, i18n.T(c, "获取session失败: %s", err))
+		return
+	}
+
+	data := map[string]string{
+		"session_id": sessionId,
+		"ws_url":     makeWebSocketURL(sessionId, c.Query("lang"), false),
+		"mode":       string(mode),
+		"owner":      shareObj.Owner,
+	}
+	rest.APIOK(c, i18n.T(c, "获取session成功"), data)
+}
@@ -13,6 +13,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"sync"
 	"sync/atomic"
@@ -113,39 +114,18 @@ func (s *service) BCSWebSocketHandler(c *gin.Context) { // nolint
 	// 赋值session id
 	podCtx.SessionId = sessionId
 
-	terminalSize := query.GetTerminalSize()
-	consoleMgr, err := manager.NewConsoleManager(ctx, podCtx, terminalSize)
+	// 共享会话的协作者连接到所有者的终端, 不新建容器连接
+	if podCtx.ShareId != "" {
+		err = startShareViewer(ctx, c, eg, ws, podCtx, query)
+	} else {
+		err = startRemoteStream(ctx, c, eg, ws, podCtx, query)
+	}
 	if err != nil {
-		manager.GracefulCloseWebSocket(ctx, ws, connected, errors.Wrap(err, i18n.T(c, "初始化session失败")))
+		manager.GracefulCloseWebSocket(ctx, ws, connected, err)
 		return
 	}
-
-	remoteStreamConn := manager.NewRemoteStreamConn(ctx, ws, consoleMgr, terminalSize, query.HideBanner)
 	connected = true
 
-	// kubectl 容器， 需要定时上报心跳
-	if podCtx.Mode == types.ClusterExternalMode || podCtx.Mode == types.ClusterInternalMode {
-		podCleanUpMgr := podmanager.NewCleanUpManager(ctx)
-		consoleMgr.AddMgrFunc(podCleanUpMgr.Heartbeat)
-	}
-
-	eg.Go(func() error {
-		// 定时检查任务
-		// 命令行审计
-		// terminal recorder
-		return consoleMgr.Run(c)
-	})
-
-	eg.Go(func() error {
-		// 定时发送心跳等, 保持连接的活跃
-		return remoteStreamConn.Run(c)
-	})
-
-	eg.Go(func() error {
-		// 关闭需要主动发送 Ctrl-D 命令
-		return remoteStreamConn.WaitStreamDone(c, podCtx)
-	})
-
 	stopWg.Add(1)
 	stopCount.Add(1)
 	defer stopWg.Done()
@@ -186,6 +166,64 @@ func (s *service) BCSWebSocketHandler(c *gin.Context) { // nolint
 	manager.GracefulCloseWebSocket(ctx, ws, connected, errors.New(i18n.T(c, "BCS Console 服务端连接断开，请重新登录")))
 }
 
+// startRemoteStream 建立容器终端连接
+func startRemoteStream(ctx context.Context, c *gin.Context, eg *errgroup.Group, ws *websocket.Conn,
+	podCtx *types.PodContext, query *wsQuery) error {
+	terminalSize := query.GetTerminalSize()
+	consoleMgr, err := manager.NewConsoleManager(ctx, podCtx, terminalSize)
+	if err != nil {
+		return errors.Wrap(err, i18n.T(c, "初始化session失败"))
+	}
+
+	remoteStreamConn := manager.NewRemoteStreamConn(ctx, ws, consoleMgr, terminalSize, query.HideBanner)
+
+	// kubectl 容器， 需要定时上报心跳
+	if podCtx.Mode == types.ClusterExternalMode || podCtx.Mode == types.ClusterInternalMode {
+		podCleanUpMgr := podmanager.NewCleanUpManager(ctx)
+		consoleMgr.AddMgrFunc(podCleanUpMgr.Heartbeat)
+	}
+
+	eg.Go(func() error {
+		// 定时检查任务
+		// 命令行审计
+		// terminal recorder
+		return consoleMgr.Run(c)
+	})
+
+	eg.Go(func() error {
+		// 定时发送心跳等, 保持连接的活跃
+		return remoteStreamConn.Run(c)
+	})
+
+	eg.Go(func() error {
+		// 关闭需要主动发送 Ctrl-D 命令
+		return remoteStreamConn.WaitStreamDone(c, podCtx)
+	})
+
+	eg.Go(func() error {
+		// 共享会话, 接收协作者的加入和输入
+		return remoteStreamConn.RunShareHost(c)
+	})
+
+	return nil
+}
+
+// startShareViewer 协作者加入共享会话
+func startShareViewer(ctx context.Context, c *gin.Context, eg *errgroup.Group, ws *websocket.Conn,
+	podCtx *types.PodContext, query *wsQuery) error {
+	viewerConn, err := manager.NewShareViewerConn(ctx, ws, podCtx, query.HideBanner)
+	if err != nil {
+		return errors.Wrap(err, i18n.T(c, "共享邀请不存在或已过期"))
+	}
+
+	eg.Go(func() error {
+		// 转发所有者终端的输入输出
+		return viewerConn.Run(c)
+	})
+
+	return nil
+}
+
 // WaitWebsocketClose wait all conn close
 func WaitWebsocketClose(timeout time.Duration) {
 	st := time.Now()
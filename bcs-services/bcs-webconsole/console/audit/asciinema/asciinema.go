@@ -23,7 +23,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/types"
 )
 
-// EventType 事件类型: o:输出 / r:终端变化
+// EventType 事件类型: o:输出 / r:终端变化 / m:标记
 type EventType string
 
 const (
@@ -38,6 +38,8 @@ const (
 	OutputEvent EventType = "o"
 	// ResizeEvent terminal resize event
 	ResizeEvent EventType = "r"
+	// MarkerEvent marker event, 记录共享会话的加入/离开/输入者切换
+	MarkerEvent EventType = "m"
 )
 
 var (
@@ -0,0 +1,199 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"path"
+	"strings"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+)
+
+// PolicyDecision 命令策略判定结果
+type PolicyDecision struct {
+	Command string
+	Action  config.CmdPolicyAction
+	Rule    *config.CmdPolicyRule // 未命中规则时为 nil
+}
+
+// RuleName 命中的规则名称
+func (d *PolicyDecision) RuleName() string {
+	if d.Rule == nil {
+		return ""
+	}
+	return d.Rule.Name
+}
+
+// EvaluateCmd 对完整命令行做策略判定, 多条规则命中时取最严格的动作
+func EvaluateCmd(cmd string, rules []*config.CmdPolicyRule) *PolicyDecision {
+	decision := &PolicyDecision{Command: cmd, Action: config.CmdPolicyAllow}
+	if strings.TrimSpace(cmd) == "" {
+		return decision
+	}
+
+	argvs := SplitCmdArgv(cmd)
+	for _, rule := range rules {
+		if !rule.Action.Stricter(decision.Action) {
+			continue
+		}
+		if rule.MatchRegex(cmd) || matchAnyArgv(rule.Argv, argvs) {
+			decision.Action = rule.Action
+			decision.Rule = rule
+		}
+	}
+	return decision
+}
+
+// matchAnyArgv 任意一条子命令匹配 argv 规则
+func matchAnyArgv(patterns []string, argvs [][]string) bool {
+	if len(patterns) == 0 {
+		return false
+	}
+	for _, argv := range argvs {
+		if matchArgv(patterns, argv) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchArgv 第一个规则匹配命令名(忽略路径), 其余规则需在参数中全部出现, 不要求顺序
+// 短参数会合并比较, 如 -rf 可以匹配 -r -f 或 -fr
+func matchArgv(patterns []string, argv []string) bool {
+	if len(argv) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(patterns[0], path.Base(argv[0])); !ok {
+		return false
+	}
+
+	args := argv[1:]
+	shortFlags := map[rune]struct{}{}
+	for _, arg := range args {
+		if isShortFlags(arg) {
+			for _, f := range arg[1:] {
+				shortFlags[f] = struct{}{}
+			}
+		}
+	}
+
+	for _, pattern := range patterns[1:] {
+		if isShortFlags(pattern) && containsFlags(shortFlags, pattern[1:]) {
+			continue
+		}
+		matched := false
+		for _, arg := range args {
+			if ok, _ := path.Match(pattern, arg); ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// isShortFlags 是否短参数, 如 -rf
+func isShortFlags(s string) bool {
+	if len(s) < 2 || s[0] != '-' || s[1] == '-' {
+		return false
+	}
+	return !strings.ContainsAny(s, "*?[=")
+}
+
+// containsFlags 短参数是否全部出现
+func containsFlags(shortFlags map[rune]struct{}, flags string) bool {
+	for _, f := range flags {
+		if _, ok := shortFlags[f]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// SplitCmdArgv 按 shell 语法拆分命令行为多条子命令的 argv, 处理引号, 转义和 ; & | 分隔符
+// 会去掉子命令前的环境变量赋值和 sudo 等前缀
+func SplitCmdArgv(cmd string) [][]string {
+	var (
+		result  [][]string
+		argv    []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+	flushWord := func() {
+		if inWord {
+			argv = append(argv, word.String())
+			word.Reset()
+			inWord = false
+		}
+	}
+	flushArgv := func() {
+		flushWord()
+		if argv = trimCmdPrefix(argv); len(argv) > 0 {
+			result = append(result, argv)
+		}
+		argv = nil
+	}
+
+	for _, r := range cmd {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			inWord, escaped = true, false
+		case r == '\\' && quote != '\'':
+			escaped = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ';' || r == '&' || r == '|' || r == '\n' || r == '(' || r == ')' || r == '`':
+			flushArgv()
+		case r == ' ' || r == '\t':
+			flushWord()
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	flushArgv()
+	return result
+}
+
+// cmdPrefixes 不影响实际执行命令的前缀
+var cmdPrefixes = map[string]struct{}{
+	"sudo": {}, "command": {}, "exec": {}, "nohup": {}, "time": {}, "env": {},
+}
+
+// trimCmdPrefix 去掉环境变量赋值及 sudo 等前缀
+func trimCmdPrefix(argv []string) []string {
+	for len(argv) > 0 {
+		if _, ok := cmdPrefixes[argv[0]]; ok {
+			argv = argv[1:]
+			continue
+		}
+		if idx := strings.Index(argv[0], "="); idx > 0 && !strings.HasPrefix(argv[0], "-") {
+			argv = argv[1:]
+			continue
+		}
+		break
+	}
+	return argv
+}
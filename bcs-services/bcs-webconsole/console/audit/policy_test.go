@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+)
+
+func newTestRules(t *testing.T) []*config.CmdPolicyRule {
+	rules := []*config.CmdPolicyRule{
+		{Name: "rm-root", Action: config.CmdPolicyBlock, Argv: []string{"rm", "-rf", "/"}, Enabled: true},
+		{Name: "delete-ns", Action: config.CmdPolicyConfirm, Argv: []string{"kubectl", "delete", "ns"}, Enabled: true},
+		{Name: "kubectl-delete", Action: config.CmdPolicyWarn, Argv: []string{"kubectl", "delete"}, Enabled: true},
+		{Name: "reboot", Action: config.CmdPolicyWarn, Regex: `^\s*reboot\b`, Enabled: true},
+	}
+	for _, rule := range rules {
+		assert.NoError(t, rule.InitRule())
+	}
+	return rules
+}
+
+func TestSplitCmdArgv(t *testing.T) {
+	testCases := []struct {
+		cmd  string
+		want [][]string
+	}{
+		{cmd: "ls -al", want: [][]string{{"ls", "-al"}}},
+		{cmd: `echo "a b" 'c;d'`, want: [][]string{{"echo", "a b", "c;d"}}},
+		{cmd: `echo a\ b`, want: [][]string{{"echo", "a b"}}},
+		{cmd: "cd /tmp && sudo rm -rf /", want: [][]string{{"cd", "/tmp"}, {"rm", "-rf", "/"}}},
+		{cmd: "A=1 env B=2 kubectl get po | grep x", want: [][]string{{"kubectl", "get", "po"}, {"grep", "x"}}},
+		{cmd: "echo `kubectl delete ns a`", want: [][]string{{"echo"}, {"kubectl", "delete", "ns", "a"}}},
+		{cmd: "  ", want: nil},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, SplitCmdArgv(tc.cmd), tc.cmd)
+	}
+}
+
+func TestEvaluateCmd(t *testing.T) {
+	rules := newTestRules(t)
+	testCases := []struct {
+		cmd    string
+		action config.CmdPolicyAction
+		rule   string
+	}{
+		{cmd: "ls -al", action: config.CmdPolicyAllow},
+		{cmd: "rm -rf /", action: config.CmdPolicyBlock, rule: "rm-root"},
+		{cmd: "/bin/rm -f -r /", action: config.CmdPolicyBlock, rule: "rm-root"},
+		{cmd: "rm -fr --no-preserve-root /", action: config.CmdPolicyBlock, rule: "rm-root"},
+		{cmd: "rm -rf /tmp/a", action: config.CmdPolicyAllow},
+		{cmd: "rm -r /", action: config.CmdPolicyAllow},
+		{cmd: "kubectl delete po a", action: config.CmdPolicyWarn, rule: "kubectl-delete"},
+		{cmd: "kubectl -n default delete ns a", action: config.CmdPolicyConfirm, rule: "delete-ns"},
+		{cmd: "echo ok; sudo rm -rf / ; kubectl delete ns a", action: config.CmdPolicyBlock, rule: "rm-root"},
+		{cmd: "reboot now", action: config.CmdPolicyWarn, rule: "reboot"},
+		{cmd: "echo reboot", action: config.CmdPolicyAllow},
+	}
+	for _, tc := range testCases {
+		decision := EvaluateCmd(tc.cmd, rules)
+		assert.Equal(t, tc.action, decision.Action, tc.cmd)
+		assert.Equal(t, tc.rule, decision.RuleName(), tc.cmd)
+	}
+}
+
+func TestCmdPolicyRuleInit(t *testing.T) {
+	testCases := []*config.CmdPolicyRule{
+		{Action: config.CmdPolicyBlock, Argv: []string{"rm"}},
+		{Name: "a", Action: config.CmdPolicyAllow, Argv: []string{"rm"}},
+		{Name: "a", Action: "deny", Argv: []string{"rm"}},
+		{Name: "a", Action: config.CmdPolicyBlock},
+		{Name: "a", Action: config.CmdPolicyBlock, Regex: "("},
+		{Name: "a", Action: config.CmdPolicyBlock, Argv: []string{"["}},
+	}
+	for _, rule := range testCases {
+		assert.Error(t, rule.InitRule())
+	}
+}
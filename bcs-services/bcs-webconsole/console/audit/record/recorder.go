@@ -224,3 +224,21 @@ func RecordResizeEvent(r *ReplyRecorder, p []byte) { // nolint
 		r.End()
 	}
 }
+
+// RecordMarkerEvent 记录标记信息, 如共享会话中的输入者切换
+func RecordMarkerEvent(r *ReplyRecorder, p []byte) { // nolint
+	// 不开启terminal recorder时, ReplyRecorder返回nil
+	if r == nil || r.Writer == nil {
+		return
+	}
+
+	if len(p) == 0 {
+		return
+	}
+
+	if err := r.Writer.WriteRow(p, asciinema.MarkerEvent); err != nil {
+		blog.Errorf("Session %s write replay row failed: %s", r.SessionID, err)
+
+		r.End()
+	}
+}
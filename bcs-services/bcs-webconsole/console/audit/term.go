@@ -179,8 +179,21 @@ func parseDelete(s []*ansi.S) []*ansi.S {
 	return result
 }
 
-// ResolveInOut ..
+// ResolveInOut 解析出完整命令, 并清空已解析的输入输出
 func ResolveInOut(c *CmdParse) string {
+	cmd := ResolveCmd(c)
+	c.Reset()
+	return cmd
+}
+
+// Reset 清空已解析的输入输出
+func (c *CmdParse) Reset() {
+	c.InputSlice = []*ansi.S{}
+	c.CmdResult = map[*ansi.S]*ansi.S{}
+}
+
+// ResolveCmd 解析当前已输入的命令, 不清空解析状态, 用于回车执行前的策略判定
+func ResolveCmd(c *CmdParse) string {
 	readyParseIn := make([]*ansi.S, 0)
 	readyParseMap := make(map[*ansi.S]*ansi.S)
 	for _, v := range c.InputSlice {
@@ -210,9 +223,5 @@ func ResolveInOut(c *CmdParse) string {
 	for _, v := range dCmd {
 		cmd += string(v.Code)
 	}
-
-	c.InputSlice = []*ansi.S{}
-	c.CmdResult = map[*ansi.S]*ansi.S{}
-
 	return cmd
 }
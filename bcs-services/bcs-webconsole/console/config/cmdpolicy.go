@@ -0,0 +1,168 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"path"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// CmdPolicyAction 命令策略动作
+type CmdPolicyAction string
+
+const (
+	// CmdPolicyAllow 放行, 未命中任何规则
+	CmdPolicyAllow CmdPolicyAction = "allow"
+	// CmdPolicyWarn 放行并提示
+	CmdPolicyWarn CmdPolicyAction = "warn"
+	// CmdPolicyConfirm 需要再次回车确认后执行
+	CmdPolicyConfirm CmdPolicyAction = "confirm"
+	// CmdPolicyBlock 拦截
+	CmdPolicyBlock CmdPolicyAction = "block"
+)
+
+// cmdPolicyPriority 多条规则命中时, 取优先级最高的动作
+var cmdPolicyPriority = map[CmdPolicyAction]int{
+	CmdPolicyAllow:   0,
+	CmdPolicyWarn:    1,
+	CmdPolicyConfirm: 2,
+	CmdPolicyBlock:   3,
+}
+
+// Stricter 动作是否比 other 更严格
+func (a CmdPolicyAction) Stricter(other CmdPolicyAction) bool {
+	return cmdPolicyPriority[a] > cmdPolicyPriority[other]
+}
+
+// CmdPolicyRule 终端命令策略规则, regex 和 argv 任一匹配即命中
+type CmdPolicyRule struct {
+	Name         string           `yaml:"name"`
+	Action       CmdPolicyAction  `yaml:"action"`
+	Regex        string           `yaml:"regex"`  // 匹配完整命令行, 如 "^\s*reboot\b"
+	Argv         []string         `yaml:"argv"`   // 匹配单条命令的参数, 支持通配符, 如 ["kubectl", "delete", "ns"]
+	Scopes       []Scope          `yaml:"scopes"` // 生效范围, 格式同凭证, 多个取或关系, 为空对所有项目/集群生效
+	Enabled      bool             `yaml:"enabled"`
+	Message      string           `yaml:"message"` // 命中时提示给用户的信息
+	Comment      string           `yaml:"comment"`
+	regex        *regexp.Regexp   `yaml:"-"`
+	scopeMatcher []*LabelMatchers `yaml:"-"`
+}
+
+// InitRule 校验并编译规则
+func (r *CmdPolicyRule) InitRule() error {
+	if r.Name == "" {
+		return errors.New("name is required")
+	}
+	if _, ok := cmdPolicyPriority[r.Action]; !ok || r.Action == CmdPolicyAllow {
+		return errors.Errorf("rule %s action %s not valid", r.Name, r.Action)
+	}
+	if r.Regex == "" && len(r.Argv) == 0 {
+		return errors.Errorf("rule %s regex or argv is required", r.Name)
+	}
+
+	if r.Regex != "" {
+		regex, err := regexp.Compile(r.Regex)
+		if err != nil {
+			return errors.Wrapf(err, "rule %s", r.Name)
+		}
+		r.regex = regex
+	}
+	for _, pattern := range r.Argv {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "rule %s argv %s", r.Name, pattern)
+		}
+	}
+
+	r.scopeMatcher = make([]*LabelMatchers, 0, len(r.Scopes))
+	for _, scope := range r.Scopes {
+		matchers := LabelMatchers{}
+		for k, v := range scope {
+			m, err := NewScopeMatcher(k, v)
+			if err != nil {
+				return err
+			}
+			matchers = append(matchers, m)
+		}
+		r.scopeMatcher = append(r.scopeMatcher, &matchers)
+	}
+	return nil
+}
+
+// MatchScope 规则是否在项目/集群范围内生效
+func (r *CmdPolicyRule) MatchScope(scopeValues map[ScopeType]string) bool {
+	if !r.Enabled {
+		return false
+	}
+	if len(r.scopeMatcher) == 0 {
+		return true
+	}
+
+	// 多个是或的关系
+	for _, matcher := range r.scopeMatcher {
+		if matcher.Matches(scopeValues) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchRegex 完整命令行是否匹配正则
+func (r *CmdPolicyRule) MatchRegex(cmd string) bool {
+	return r.regex != nil && r.regex.MatchString(cmd)
+}
+
+// ReadCmdPolicy 加载命令策略, 支持热更新
+func (c *Configurations) ReadCmdPolicy(name string, content []byte) error {
+	rules := []*CmdPolicyRule{}
+	if err := yaml.Unmarshal(content, &rules); err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		if err := rule.InitRule(); err != nil {
+			return err
+		}
+	}
+
+	// 校验通过后整体替换, 避免部分生效
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.CmdPolicies[name] = rules
+	return nil
+}
+
+// CountCmdPolicy 获取指定配置文件加载的命令策略数量
+func (c *Configurations) CountCmdPolicy(name string) int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return len(c.CmdPolicies[name])
+}
+
+// GetCmdPolicies 获取在项目/集群范围内生效的命令策略
+func (c *Configurations) GetCmdPolicies(scopeValues map[ScopeType]string) []*CmdPolicyRule {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	result := []*CmdPolicyRule{}
+	for _, rules := range c.CmdPolicies {
+		for _, rule := range rules {
+			if rule.MatchScope(scopeValues) {
+				result = append(result, rule)
+			}
+		}
+	}
+	return result
+}
@@ -26,18 +26,19 @@ import (
 // Configurations : manage all configurations
 type Configurations struct {
 	mtx         sync.Mutex
-	Base        *BaseConf                `yaml:"base_conf"`
-	Auth        *AuthConf                `yaml:"auth_conf"`
-	Logging     *LogConf                 `yaml:"logging"`
-	BCS         *BCSConf                 `yaml:"bcs_conf"`
-	Credentials map[string][]*Credential `yaml:"-"`
-	Redis       *RedisConf               `yaml:"redis"`
-	WebConsole  *WebConsoleConf          `yaml:"webconsole"`
-	Web         *WebConf                 `yaml:"web"`
-	Etcd        *EtcdConf                `yaml:"etcd"`
-	Tracing     *TracingConf             `yaml:"tracing"`
-	Audit       *AuditConf               `yaml:"audit"`
-	Repository  *RepositoryConf          `yaml:"repository"`
+	Base        *BaseConf                   `yaml:"base_conf"`
+	Auth        *AuthConf                   `yaml:"auth_conf"`
+	Logging     *LogConf                    `yaml:"logging"`
+	BCS         *BCSConf                    `yaml:"bcs_conf"`
+	Credentials map[string][]*Credential    `yaml:"-"`
+	CmdPolicies map[string][]*CmdPolicyRule `yaml:"-"`
+	Redis       *RedisConf                  `yaml:"redis"`
+	WebConsole  *WebConsoleConf             `yaml:"webconsole"`
+	Web         *WebConf                    `yaml:"web"`
+	Etcd        *EtcdConf                   `yaml:"etcd"`
+	Tracing     *TracingConf                `yaml:"tracing"`
+	Audit       *AuditConf                  `yaml:"audit"`
+	Repository  *RepositoryConf             `yaml:"repository"`
 }
 
 // newConfigurations 新增配置
@@ -84,6 +85,7 @@ func newConfigurations() (*Configurations, error) {
 	c.Repository = &RepositoryConf{}
 
 	c.Credentials = map[string][]*Credential{}
+	c.CmdPolicies = map[string][]*CmdPolicyRule{}
 
 	c.Web = defaultWebConf()
 
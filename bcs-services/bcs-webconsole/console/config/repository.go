@@ -18,6 +18,8 @@ type RepositoryConf struct {
 	StorageType string     `yaml:"storage_type"`
 	Bkrepo      BkRepoConf `yaml:"bkrepo"`
 	Cos         CosConf    `yaml:"cos"`
+	S3          S3Conf     `yaml:"s3"`
+	Local       LocalConf  `yaml:"local"`
 }
 
 // BkRepoConf bkrepo配置
@@ -36,3 +38,19 @@ type CosConf struct {
 	SecretID   string `yaml:"secret_id"`
 	SecretKey  string `yaml:"secret_key"`
 }
+
+// S3Conf s3 兼容存储配置, 如 MinIO, Ceph RGW
+type S3Conf struct {
+	Endpoint        string `yaml:"endpoint"` // 格式如 minio.example.com:9000, 不带协议
+	BucketName      string `yaml:"bucket_name"`
+	Region          string `yaml:"region"`
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+	UseSSL          bool   `yaml:"use_ssl"`
+	PathStyle       bool   `yaml:"path_style"` // 使用 path-style 访问 bucket, Ceph RGW 等需开启
+}
+
+// LocalConf 本地磁盘存储配置
+type LocalConf struct {
+	RootDir string `yaml:"root_dir"` // 格式如 ./data/records
+}
@@ -40,18 +40,33 @@ func init() {
 
 var messageKeyToIndex = map[string]int{
 	"%s": 12,
-	"BCS Console 使用已经超过%d小时，请重新登录": 30,
-	"BCS Console 已经%d分钟无操作":        29,
-	"BCS Console 服务端连接断开，请重新登录":    25,
-	"project_id is required":       33,
-	"session_id不合法或已经过期":           16,
-	"session不合法":                   23,
-	"zh":                           31,
-	"下载":                           32,
-	"初始化session失败":                 24,
-	"参数不合法":                        22,
-	"复制文件流失败":                      7,
-	"执行上传命令失败":                     4,
+	"BCS Console 使用已经超过%d小时，请重新登录":   30,
+	"BCS Console 已经%d分钟无操作":          29,
+	"BCS Console 服务端连接断开，请重新登录":      25,
+	"[共享] %s 加入了会话, 权限: %s":          42,
+	"[共享] %s 已交还控制权":                 41,
+	"[共享] %s 离开了会话":                  39,
+	"[共享] 你已获得控制权":                   45,
+	"[共享] 已从 %s 收回控制权":               43,
+	"[共享] 已加入 %s 的会话, 只读模式":          48,
+	"[共享] 已加入 %s 的会话, 可申请接管终端控制权":    47,
+	"[共享] 当前由 %s 控制终端":               46,
+	"[共享] 控制权已移交给 %s, 输入任意字符可收回":     40,
+	"[拦截] 命令命中安全策略 %s: %s, 已禁止执行":    38,
+	"[确认] 命令命中安全策略 %s: %s, 再次回车确认执行": 37,
+	"[警告] 命令命中安全策略 %s: %s":           36,
+	"project_id is required":         33,
+	"session_id不合法或已经过期":             16,
+	"session不合法":                     23,
+	"zh":                             31,
+	"下载":                             32,
+	"共享会话不支持文件传输":                    52,
+	"共享会话已结束":                        44,
+	"共享邀请不存在或已过期":                    49,
+	"初始化session失败":                   24,
+	"参数不合法":                          22,
+	"复制文件流失败":                        7,
+	"执行上传命令失败":                       4,
 	"支持常用Bash快捷键; Windows下Ctrl-W为关闭窗口快捷键, 请使用Alt-W代替":                 28,
 	"支持常用Bash快捷键; Windows下Ctrl-W为关闭窗口快捷键, 请使用Alt-W代替; 使用Alt-Num切换Tab": 27,
 	"文件上传失败":                        5,
@@ -61,6 +76,8 @@ var messageKeyToIndex = map[string]int{
 	"服务请求失败: %s":                    19,
 	"服务请求成功":                        20,
 	"没有权限":                          35,
+	"用户无权限共享此session":               50,
+	"用户未被邀请加入此共享会话":                 51,
 	"用户没有设置命令延时":                    21,
 	"目标文件不存在":                       8,
 	"目标路径不存在":                       1,
@@ -77,7 +94,7 @@ var messageKeyToIndex = map[string]int{
 	"项目或者集群Id不正确":                   34,
 }
 
-var enIndex = []uint32{ // 37 elements
+var enIndex = []uint32{ // 54 elements
 	// Entry 0 - 1F
 	0x00000000, 0x00000023, 0x00000043, 0x00000061,
 	0x0000007f, 0x000000a0, 0x000000b3, 0x000000ca,
@@ -89,10 +106,14 @@ var enIndex = []uint32{ // 37 elements
 	0x00000384, 0x000003eb, 0x0000041a, 0x00000462,
 	// Entry 20 - 3F
 	0x00000465, 0x0000046e, 0x00000485, 0x000004a6,
-	0x000004b4,
-} // Size: 172 bytes
+	0x000004b4, 0x000004e8, 0x0000053a, 0x00000580,
+	0x0000059f, 0x000005e2, 0x00000601, 0x0000062f,
+	0x00000655, 0x0000066e, 0x0000068b, 0x000006b3,
+	0x00000700, 0x00000734, 0x00000763, 0x00000790,
+	0x000007bb, 0x000007ed,
+} // Size: 240 bytes
 
-const enData string = "" + // Size: 1204 bytes
+const enData string = "" + // Size: 2029 bytes
 	"\x02Please enter the upload path first\x02Destination path does not exis" +
 	"t\x02Failed to parse uploaded file\x02Failed to get pod information\x02F" +
 	"ailed to execute upload command\x02File upload failed\x02File upload suc" +
@@ -111,9 +132,21 @@ const enData string = "" + // Size: 1204 bytes
 	"-W instead\x02BCS Console has no operation for %[1]d minutes\x02BCS cons" +
 	"ole has been used for more than %[1]d hours. Please login again\x02en" +
 	"\x02Download\x02project_id is required\x02ProjectId or ClusterId Incorre" +
-	"ct\x02No permission"
+	"ct\x02No permission\x02[Warning] Command hits security policy %[1]s: %[2" +
+	"]s\x02[Confirm] Command hits security policy %[1]s: %[2]s, press Enter a" +
+	"gain to confirm\x02[Blocked] Command hits security policy %[1]s: %[2]s, " +
+	"execution denied\x02[Share] %[1]s left the session\x02[Share] Control ha" +
+	"nded over to %[1]s, type any key to take it back\x02[Share] %[1]s releas" +
+	"ed control\x02[Share] %[1]s joined the session, mode: %[2]s\x02[Share] C" +
+	"ontrol taken back from %[1]s\x02Shared session has ended\x02[Share] You " +
+	"now have control\x02[Share] Terminal is controlled by %[1]s\x02[Share] J" +
+	"oined the session of %[1]s, you can request control of the terminal\x02[" +
+	"Share] Joined the session of %[1]s, read-only mode\x02Share invitation d" +
+	"oes not exist or has expired\x02User has no permission to share this ses" +
+	"sion\x02User is not invited to this shared session\x02File transfer is n" +
+	"ot supported in shared sessions"
 
-var zhIndex = []uint32{ // 37 elements
+var zhIndex = []uint32{ // 54 elements
 	// Entry 0 - 1F
 	0x00000000, 0x00000019, 0x0000002f, 0x00000048,
 	0x0000005e, 0x00000077, 0x0000008a, 0x0000009d,
@@ -125,10 +158,14 @@ var zhIndex = []uint32{ // 37 elements
 	0x000002fb, 0x00000355, 0x0000037c, 0x000003b8,
 	// Entry 20 - 3F
 	0x000003bb, 0x000003c2, 0x000003d9, 0x000003f7,
-	0x00000404,
-} // Size: 172 bytes
+	0x00000404, 0x00000433, 0x0000047c, 0x000004bc,
+	0x000004db, 0x0000051d, 0x0000053f, 0x0000056d,
+	0x00000593, 0x000005a9, 0x000005c8, 0x000005ee,
+	0x00000631, 0x00000662, 0x00000684, 0x000006a4,
+	0x000006cc, 0x000006ee,
+} // Size: 240 bytes
 
-const zhData string = "" + // Size: 1028 bytes
+const zhData string = "" + // Size: 1774 bytes
 	"\x02请先输入上传路径\x02目标路径不存在\x02解析上传文件失败\x02获取pod信息失败\x02执行上传命令失败\x02文件上传失败" +
 	"\x02文件上传成功\x02复制文件流失败\x02目标文件不存在\x02暂不支持文件夹下载\x02文件不能超过%[1]dMB\x02项目不正确" +
 	"\x02%[1]s\x02获取集群成功\x02获取session失败: %[1]s\x02获取session成功\x02session_id不合" +
@@ -137,6 +174,12 @@ const zhData string = "" + // Size: 1028 bytes
 	"\x02BCS Console 服务端连接断开，请重新登录\x02连接已断开\x02支持常用Bash快捷键; Windows下Ctrl-W为关闭" +
 	"窗口快捷键, 请使用Alt-W代替; 使用Alt-Num切换Tab\x02支持常用Bash快捷键; Windows下Ctrl-W为关闭窗口快" +
 	"捷键, 请使用Alt-W代替\x02BCS Console 已经%[1]d分钟无操作\x02BCS Console 使用已经超过%[1]d小" +
-	"时，请重新登录\x02zh\x02下载\x02project_id is required\x02项目或者集群Id不正确\x02没有权限"
+	"时，请重新登录\x02zh\x02下载\x02project_id is required\x02项目或者集群Id不正确\x02没有权限" +
+	"\x02[警告] 命令命中安全策略 %[1]s: %[2]s\x02[确认] 命令命中安全策略 %[1]s: %[2]s, 再次回车确认执行" +
+	"\x02[拦截] 命令命中安全策略 %[1]s: %[2]s, 已禁止执行\x02[共享] %[1]s 离开了会话\x02[共享] 控制权已移交" +
+	"给 %[1]s, 输入任意字符可收回\x02[共享] %[1]s 已交还控制权\x02[共享] %[1]s 加入了会话, 权限: %[2]s" +
+	"\x02[共享] 已从 %[1]s 收回控制权\x02共享会话已结束\x02[共享] 你已获得控制权\x02[共享] 当前由 %[1]s 控制终" +
+	"端\x02[共享] 已加入 %[1]s 的会话, 可申请接管终端控制权\x02[共享] 已加入 %[1]s 的会话, 只读模式\x02共享邀" +
+	"请不存在或已过期\x02用户无权限共享此session\x02用户未被邀请加入此共享会话\x02共享会话不支持文件传输"
 
-	// Total table size 2576 bytes (2KiB); checksum: FD111CD5
+	// Total table size 4283 bytes (4KiB); checksum: CACAB08E
@@ -55,6 +55,7 @@ type RemoteStreamConn struct {
 	inputMsgChan  <-chan wsMessage
 	outputMsgChan chan []byte
 	hideBanner    bool
+	shareHost     *shareHost
 }
 
 // NewRemoteStreamConn :
@@ -72,16 +73,24 @@ func NewRemoteStreamConn(ctx context.Context, wsConn *websocket.Conn, mgr *Conso
 	// 初始化命令行宽和高
 	conn.resizeMsgChan <- initTerminalSize
 
+	// 共享会话, 协作者通过所有者的连接输入输出
+	conn.shareHost = newShareHost(mgr, conn.notice)
+
 	return conn
 }
 
 // readInputMsg xxx
 func (r *RemoteStreamConn) readInputMsg() <-chan wsMessage {
+	return readWsMessage(r.wsConn)
+}
+
+// readWsMessage 读取 web 端消息, 连接断开后关闭
+func readWsMessage(wsConn *websocket.Conn) <-chan wsMessage {
 	inputMsgChan := make(chan wsMessage)
 	go func() {
 		defer close(inputMsgChan)
 		for {
-			msgType, msg, err := r.wsConn.ReadMessage()
+			msgType, msg, err := wsConn.ReadMessage()
 			inputMsgChan <- wsMessage{
 				msgType: msgType,
 				msg:     msg,
@@ -138,6 +147,9 @@ func (r *RemoteStreamConn) HandleMsg(msgType int, msg []byte) ([]byte, error) {
 		return nil, nil
 	}
 
+	// 所有者输入时收回协作者的控制权
+	r.shareHost.reclaimControl()
+
 	inputMsg, err := r.bindMgr.HandleInputMsg(decodeMsg)
 	if err != nil {
 		return nil, nil
@@ -162,6 +174,13 @@ func (r *RemoteStreamConn) Read(p []byte) (int, error) {
 			return copy(p, EndOfTransmission), err
 		}
 		return copy(p, out), nil
+
+	case e := <-r.shareHost.inputChan:
+		out, err := r.bindMgr.HandleShareInputMsg(e.Username, e.Data)
+		if err != nil {
+			return 0, nil
+		}
+		return copy(p, out), nil
 	}
 }
 
@@ -183,6 +202,14 @@ func (r *RemoteStreamConn) Write(p []byte) (int, error) {
 	}
 }
 
+// notice : 向 web 端输出提示信息, 不经过容器
+func (r *RemoteStreamConn) notice(msg []byte) {
+	select {
+	case <-r.ctx.Done():
+	case r.outputMsgChan <- msg:
+	}
+}
+
 // Next : executor回调获取web是否resize
 func (r *RemoteStreamConn) Next() *remotecommand.TerminalSize {
 	resizeMsg, ok := <-r.resizeMsgChan
@@ -233,6 +260,7 @@ func (r *RemoteStreamConn) Run(c *gin.Context) error {
 			}
 
 			r.bindMgr.HandlePostOutputMsg(output)
+			r.shareHost.publishOutput(output)
 
 			outputMsg := []byte(base64.StdEncoding.EncodeToString(output))
 			if err := r.wsConn.WriteMessage(websocket.TextMessage, outputMsg); err != nil {
@@ -246,6 +274,11 @@ func (r *RemoteStreamConn) Run(c *gin.Context) error {
 	}
 }
 
+// RunShareHost 接收共享会话协作者的加入, 输入和控制权变更
+func (r *RemoteStreamConn) RunShareHost(c *gin.Context) error {
+	return r.shareHost.run(r.ctx, c)
+}
+
 // WaitStreamDone : stream 流处理
 func (r *RemoteStreamConn) WaitStreamDone(c *gin.Context, podCtx *types.PodContext) error {
 	defer r.Close()
@@ -277,6 +310,9 @@ func (r *RemoteStreamConn) WaitStreamDone(c *gin.Context, podCtx *types.PodConte
 		return err
 	}
 
+	// 命令策略等提示信息直接输出到 web 端
+	r.bindMgr.BindNotifier(c, r.notice)
+
 	// start reading
 	r.inputMsgChan = r.readInputMsg()
 
@@ -43,6 +43,8 @@ const (
 	ErrorChannel = "3"
 	// ResizeChannel xxx
 	ResizeChannel = "4"
+	// ShareChannel 共享会话控制权申请/交还
+	ShareChannel = "5"
 
 	helloBcsMessage = "Welcome to the BCS Console"
 
@@ -49,6 +49,10 @@ type ConsoleManager struct {
 	recorder       *record.ReplyRecorder
 	meterKey       string
 	meters         []*types.DelayData
+	ginCtx         *gin.Context     // 用于提示信息国际化
+	notifier       func(msg []byte) // 向 web 终端输出提示信息
+	pendingConfirm string           // 等待二次确认的命令
+	inputUser      string           // 当前输入者, 共享会话中可能为协作者
 }
 
 // NewConsoleManager :
@@ -63,6 +67,7 @@ func NewConsoleManager(ctx context.Context, podCtx *types.PodContext,
 		podCtx:         podCtx,
 		managerFuncs:   []ManagerFunc{},
 		cmdParser:      audit.NewCmdParse(),
+		inputUser:      podCtx.Username,
 	}
 
 	// 初始化 terminal record
@@ -92,6 +97,28 @@ func (c *ConsoleManager) HandleResizeMsg(resizeMsg *types.TerminalSize) error {
 
 // HandleInputMsg : 处理输入数据流
 func (c *ConsoleManager) HandleInputMsg(msg []byte) ([]byte, error) {
+	return c.handleUserInputMsg(c.podCtx.Username, msg)
+}
+
+// HandleShareInputMsg : 处理共享会话协作者的输入数据流
+func (c *ConsoleManager) HandleShareInputMsg(username string, msg []byte) ([]byte, error) {
+	return c.handleUserInputMsg(username, msg)
+}
+
+// RecordMarker 记录回放标记, 如共享会话的加入/离开/控制权变更
+func (c *ConsoleManager) RecordMarker(marker string) {
+	record.RecordMarkerEvent(c.recorder, []byte(marker))
+}
+
+// handleUserInputMsg 处理输入数据流, 记录输入者
+func (c *ConsoleManager) handleUserInputMsg(username string, msg []byte) ([]byte, error) {
+	// 输入者切换时记录标记, 回放和审计可区分谁输入的命令
+	if username != c.inputUser {
+		c.inputUser = username
+		c.pendingConfirm = ""
+		c.RecordMarker("input:" + username)
+	}
+
 	now := time.Now()
 	// 更新ws时间
 	c.LastInputTime = now
@@ -103,6 +130,12 @@ func (c *ConsoleManager) HandleInputMsg(msg []byte) ([]byte, error) {
 		return msg, nil
 	}
 
+	// 回车执行前做命令策略判定, 拦截时不再记录输入
+	msg, intercepted := c.handleCmdPolicy(msg)
+	if intercepted {
+		return msg, nil
+	}
+
 	c.cmdParser.Cmd = ss
 	c.cmdParser.InputSlice = append(c.cmdParser.InputSlice, ss)
 
@@ -218,7 +251,7 @@ func (c *ConsoleManager) auditCmd(outputMsg []byte) {
 		cmd := audit.ResolveInOut(c.cmdParser)
 		if cmd != "" {
 			logger.Infof("UserName=%s  SessionID=%s  Command=%s",
-				c.podCtx.Username, c.podCtx.SessionId, cmd)
+				c.inputUser, c.podCtx.SessionId, cmd)
 		}
 	}
 }
@@ -0,0 +1,187 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	logger "github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	bcsAudit "github.com/Tencent/bk-bcs/bcs-common/pkg/audit"
+	"github.com/gin-gonic/gin"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/audit"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/i18n"
+)
+
+const (
+	// cmdPolicyActionID 命令策略审计操作
+	cmdPolicyActionID = "web_console_command"
+
+	// clearLineInput 拦截后发送给容器的按键: Ctrl-E 移到行尾, Ctrl-U 清空整行, 回车显示新的提示符
+	clearLineInput = "\x05\x15\r"
+)
+
+// cmdPolicyResult 命令策略最终处理结果, 写入审计记录
+type cmdPolicyResult string
+
+const (
+	cmdPolicyBlocked   cmdPolicyResult = "blocked"
+	cmdPolicyWarned    cmdPolicyResult = "warned"
+	cmdPolicyPending   cmdPolicyResult = "pending_confirm"
+	cmdPolicyConfirmed cmdPolicyResult = "confirmed"
+)
+
+// BindNotifier 绑定终端提示输出, 命令策略的提示信息直接输出到 web 终端
+func (c *ConsoleManager) BindNotifier(ctx *gin.Context, notifier func(msg []byte)) {
+	c.ginCtx = ctx
+	c.notifier = notifier
+}
+
+// handleCmdPolicy 回车执行前对完整命令行做策略判定, 返回实际发送给容器的数据, 以及是否被策略拦截
+// 命令行由按键及终端回显还原, 历史命令(上下键)及 Tab 补全依赖回显, 回显未及时返回或在 vim 等全屏程序中
+// 可能无法还原完整命令, 因此命令策略为尽力拦截, 不能作为唯一的安全手段
+func (c *ConsoleManager) handleCmdPolicy(msg []byte) ([]byte, bool) {
+	lines := splitInputLines(msg)
+	if len(lines) == 0 {
+		return msg, false
+	}
+
+	rules := config.G.GetCmdPolicies(map[config.ScopeType]string{
+		config.ScopeProjectId:   c.podCtx.ProjectId,
+		config.ScopeProjectCode: c.podCtx.ProjectCode,
+		config.ScopeClusterId:   c.podCtx.ClusterId,
+		config.ScopeNamespace:   c.podCtx.Namespace,
+	})
+	if len(rules) == 0 {
+		return msg, false
+	}
+
+	// 第一行需要拼接终端中已输入的内容
+	lines[0] = audit.ResolveCmd(c.cmdParser) + lines[0]
+	var decision *audit.PolicyDecision
+	for _, line := range lines {
+		d := audit.EvaluateCmd(line, rules)
+		if decision == nil || d.Action.Stricter(decision.Action) {
+			decision = d
+		}
+	}
+
+	// 单独回车才支持二次确认, 粘贴多行命令时直接拦截
+	singleEnter := len(msg) == 1
+	switch decision.Action {
+	case config.CmdPolicyWarn:
+		c.pendingConfirm = ""
+		c.notify(i18n.T(c.ginCtx, "[警告] 命令命中安全策略 %s: %s", decision.RuleName(), decision.Rule.Message))
+		c.recordCmdPolicy(decision, cmdPolicyWarned)
+		return msg, false
+	case config.CmdPolicyConfirm:
+		if singleEnter && c.pendingConfirm == decision.Command {
+			c.pendingConfirm = ""
+			c.recordCmdPolicy(decision, cmdPolicyConfirmed)
+			return msg, false
+		}
+		if singleEnter {
+			c.pendingConfirm = decision.Command
+			c.notify(i18n.T(c.ginCtx, "[确认] 命令命中安全策略 %s: %s, 再次回车确认执行",
+				decision.RuleName(), decision.Rule.Message))
+			c.recordCmdPolicy(decision, cmdPolicyPending)
+			return nil, true
+		}
+		fallthrough
+	case config.CmdPolicyBlock:
+		c.pendingConfirm = ""
+		// 已拦截的命令不再进入命令审计
+		c.cmdParser.Reset()
+		c.cmdParser.Cmd = nil
+		c.notify(i18n.T(c.ginCtx, "[拦截] 命令命中安全策略 %s: %s, 已禁止执行", decision.RuleName(), decision.Rule.Message))
+		c.recordCmdPolicy(decision, cmdPolicyBlocked)
+		return []byte(clearLineInput), true
+	default:
+		c.pendingConfirm = ""
+		return msg, false
+	}
+}
+
+// notify 向 web 终端输出提示信息
+func (c *ConsoleManager) notify(msg string) {
+	if c.notifier == nil {
+		return
+	}
+	c.notifier([]byte("\r\n\x1b[1;31m" + strings.TrimSpace(msg) + "\x1b[0m\r\n"))
+}
+
+// recordCmdPolicy 记录命令策略判定结果到日志及审计
+func (c *ConsoleManager) recordCmdPolicy(decision *audit.PolicyDecision, result cmdPolicyResult) {
+	logger.Infof("UserName=%s  SessionID=%s  Command=%s  Policy=%s  Action=%s  Result=%s",
+		c.inputUser, c.podCtx.SessionId, decision.Command, decision.RuleName(), decision.Action, result)
+
+	now := time.Now()
+	status := bcsAudit.ActivityStatusSuccess
+	if result == cmdPolicyBlocked {
+		status = bcsAudit.ActivityStatusFailed
+	}
+	err := audit.GetAuditClient().R().
+		SetContext(bcsAudit.RecorderContext{
+			Username:  c.inputUser,
+			RequestID: c.podCtx.SessionId,
+			StartTime: now,
+			EndTime:   now,
+		}).
+		SetResource(bcsAudit.Resource{
+			ProjectCode:  c.podCtx.ProjectCode,
+			ResourceType: bcsAudit.ResourceTypeWebConsole,
+			ResourceID:   c.podCtx.ClusterId,
+			ResourceName: c.podCtx.ClusterId,
+			ResourceData: map[string]any{
+				"ClusterID": c.podCtx.ClusterId,
+				"Namespace": c.podCtx.Namespace,
+				"PodName":   c.podCtx.PodName,
+				"Container": c.podCtx.ContainerName,
+				"Owner":     c.podCtx.Username,
+			},
+		}).
+		SetAction(bcsAudit.Action{ActionID: cmdPolicyActionID, ActivityType: bcsAudit.ActivityTypeView}).
+		SetResult(bcsAudit.ActionResult{
+			Status:        status,
+			ResultContent: decision.Command,
+			ExtraData: map[string]any{
+				"Command": decision.Command,
+				"Policy":  decision.RuleName(),
+				"Action":  string(decision.Action),
+				"Result":  string(result),
+			},
+		}).Do()
+	if err != nil {
+		logger.Errorf("audit cmd policy err: %v", err)
+	}
+}
+
+// splitInputLines 返回输入中以回车/换行结束的每一行, 没有回车时返回空
+func splitInputLines(msg []byte) []string {
+	if !bytes.ContainsAny(msg, "\r\n") {
+		return nil
+	}
+	lines := strings.FieldsFunc(string(msg), func(r rune) bool { return r == '\r' || r == '\n' })
+	// 以回车开头时, 第一行为终端中已输入的内容
+	if msg[0] == '\r' || msg[0] == '\n' {
+		lines = append([]string{""}, lines...)
+	}
+	// 最后一段没有回车, 尚未执行
+	if last := msg[len(msg)-1]; last != '\r' && last != '\n' && len(lines) > 0 {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
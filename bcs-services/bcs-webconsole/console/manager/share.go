@@ -0,0 +1,294 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	logger "github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/gin-gonic/gin"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/i18n"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/share"
+)
+
+const (
+	// 协作者输入/终端输出的缓冲数量
+	shareBufferSize = 256
+	// 共享事件发布超时时间
+	sharePublishTimeout = time.Second * 2
+)
+
+// shareViewer 已加入的协作者连接
+type shareViewer struct {
+	shareId  string
+	connId   string
+	username string
+	mode     share.Mode
+}
+
+// shareHost 会话所有者侧的共享管理: 转发终端输出给协作者, 接收协作者的输入和控制权变更
+// 同一时刻只有一个控制者, 所有者输入时自动收回控制权
+type shareHost struct {
+	mgr        *ConsoleManager
+	notice     func(msg []byte)
+	lock       sync.Mutex
+	ginCtx     *gin.Context
+	viewers    map[string]*shareViewer // key 为协作者连接ID
+	controller *shareViewer            // 当前控制终端的协作者, nil 表示所有者
+	inputChan  chan *share.Event
+	outputChan chan []byte
+}
+
+// newShareHost :
+func newShareHost(mgr *ConsoleManager, notice func(msg []byte)) *shareHost {
+	return &shareHost{
+		mgr:        mgr,
+		notice:     notice,
+		viewers:    map[string]*shareViewer{},
+		inputChan:  make(chan *share.Event, shareBufferSize),
+		outputChan: make(chan []byte, shareBufferSize),
+	}
+}
+
+// run 订阅协作者事件, 会话结束时通知所有协作者
+func (h *shareHost) run(ctx context.Context, c *gin.Context) error {
+	h.lock.Lock()
+	h.ginCtx = c
+	h.lock.Unlock()
+
+	sessionId := h.mgr.podCtx.SessionId
+	sub, err := share.GetGlobalBroker().Subscribe(ctx, share.InboxChannel(sessionId))
+	if err != nil {
+		// 共享功能不可用时不影响终端使用
+		logger.Warnf("subscribe share inbox failed, session_id=%s, err: %s", sessionId, err)
+		<-ctx.Done()
+		return nil
+	}
+	defer sub.Close()
+	defer h.publish(&share.Event{Type: share.EventClose})
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case e, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+			h.handleEvent(ctx, e)
+
+		case output := <-h.outputChan:
+			h.publish(&share.Event{Type: share.EventOutput, Data: output})
+		}
+	}
+}
+
+// handleEvent 处理协作者事件
+func (h *shareHost) handleEvent(ctx context.Context, e *share.Event) {
+	switch e.Type {
+	case share.EventJoin:
+		h.handleJoin(ctx, e)
+
+	case share.EventLeave:
+		h.lock.Lock()
+		viewer, ok := h.viewers[e.ConnId]
+		delete(h.viewers, e.ConnId)
+		if ok && h.controller == viewer {
+			h.controller = nil
+		}
+		h.lock.Unlock()
+
+		if ok {
+			h.mgr.RecordMarker("leave:" + viewer.username)
+			h.notify("[共享] %s 离开了会话", viewer.username)
+			h.publishState()
+		}
+
+	case share.EventInput:
+		h.lock.Lock()
+		allowed := h.controller != nil && h.controller.connId == e.ConnId
+		h.lock.Unlock()
+
+		// 只有当前控制者的输入才会发送到容器
+		if !allowed {
+			return
+		}
+		select {
+		case h.inputChan <- e:
+		case <-ctx.Done():
+		}
+
+	case share.EventTakeControl:
+		h.lock.Lock()
+		viewer, ok := h.viewers[e.ConnId]
+		allowed := ok && viewer.mode == share.ModeControl && h.controller != viewer
+		if allowed {
+			h.controller = viewer
+		}
+		h.lock.Unlock()
+
+		if allowed {
+			h.mgr.RecordMarker("control:" + viewer.username)
+			h.notify("[共享] 控制权已移交给 %s, 输入任意字符可收回", viewer.username)
+			h.publishState()
+		}
+
+	case share.EventReleaseControl:
+		h.lock.Lock()
+		viewer, ok := h.viewers[e.ConnId]
+		released := ok && h.controller == viewer
+		if released {
+			h.controller = nil
+		}
+		h.lock.Unlock()
+
+		if released {
+			h.mgr.RecordMarker("control:" + h.mgr.podCtx.Username)
+			h.notify("[共享] %s 已交还控制权", viewer.username)
+			h.publishState()
+		}
+
+	case share.EventRevoke:
+		h.removeShare(e.ShareId)
+	}
+}
+
+// handleJoin 协作者加入, 重新校验邀请, 防止邀请已取消或过期
+func (h *shareHost) handleJoin(ctx context.Context, e *share.Event) {
+	s, err := share.NewStore().Get(ctx, e.ShareId)
+	if err != nil || s.SessionId != h.mgr.podCtx.SessionId {
+		h.publish(&share.Event{Type: share.EventClose, ShareId: e.ShareId, ConnId: e.ConnId})
+		return
+	}
+	mode, ok := s.GetMode(e.Username)
+	if !ok {
+		h.publish(&share.Event{Type: share.EventClose, ShareId: e.ShareId, ConnId: e.ConnId})
+		return
+	}
+
+	h.lock.Lock()
+	h.viewers[e.ConnId] = &shareViewer{
+		shareId:  e.ShareId,
+		connId:   e.ConnId,
+		username: e.Username,
+		mode:     mode,
+	}
+	h.lock.Unlock()
+
+	h.mgr.RecordMarker("join:" + e.Username)
+	h.notify("[共享] %s 加入了会话, 权限: %s", e.Username, mode)
+	h.publishState()
+}
+
+// removeShare 取消共享邀请, 移出该邀请加入的所有协作者
+func (h *shareHost) removeShare(shareId string) {
+	var removed []string
+
+	h.lock.Lock()
+	for connId, viewer := range h.viewers {
+		if viewer.shareId != shareId {
+			continue
+		}
+		delete(h.viewers, connId)
+		if h.controller == viewer {
+			h.controller = nil
+		}
+		removed = append(removed, viewer.username)
+	}
+	h.lock.Unlock()
+
+	h.publish(&share.Event{Type: share.EventClose, ShareId: shareId})
+	for _, username := range removed {
+		h.mgr.RecordMarker("leave:" + username)
+		h.notify("[共享] %s 离开了会话", username)
+	}
+	if len(removed) > 0 {
+		h.publishState()
+	}
+}
+
+// reclaimControl 所有者输入时收回控制权
+func (h *shareHost) reclaimControl() {
+	h.lock.Lock()
+	viewer := h.controller
+	h.controller = nil
+	h.lock.Unlock()
+
+	if viewer == nil {
+		return
+	}
+	h.mgr.RecordMarker("control:" + h.mgr.podCtx.Username)
+	h.notify("[共享] 已从 %s 收回控制权", viewer.username)
+	go h.publishState()
+}
+
+// publishOutput 有协作者时转发终端输出, 不能阻塞终端输出
+func (h *shareHost) publishOutput(output []byte) {
+	h.lock.Lock()
+	hasViewers := len(h.viewers) > 0
+	h.lock.Unlock()
+
+	if !hasViewers {
+		return
+	}
+
+	select {
+	case h.outputChan <- output:
+	default:
+		logger.Warnf("share output too slow, drop output, session_id=%s", h.mgr.podCtx.SessionId)
+	}
+}
+
+// publishState 广播会话状态
+func (h *shareHost) publishState() {
+	state := &share.State{Owner: h.mgr.podCtx.Username, Controller: h.mgr.podCtx.Username}
+
+	h.lock.Lock()
+	if h.controller != nil {
+		state.Controller = h.controller.username
+	}
+	for _, viewer := range h.viewers {
+		state.Viewers = append(state.Viewers, viewer.username)
+	}
+	h.lock.Unlock()
+
+	sort.Strings(state.Viewers)
+	h.publish(&share.Event{Type: share.EventState, State: state})
+}
+
+// publish 发送事件给协作者
+func (h *shareHost) publish(e *share.Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), sharePublishTimeout)
+	defer cancel()
+
+	channel := share.OutboxChannel(h.mgr.podCtx.SessionId)
+	if err := share.GetGlobalBroker().Publish(ctx, channel, e); err != nil {
+		logger.Warnf("publish share event failed, channel=%s, type=%s, err: %s", channel, e.Type, err)
+	}
+}
+
+// notify 在所有者终端输出共享提示信息, 会同步转发给协作者
+func (h *shareHost) notify(format string, args ...any) {
+	h.lock.Lock()
+	ginCtx := h.ginCtx
+	h.lock.Unlock()
+
+	msg := i18n.T(ginCtx, format, args...)
+	h.notice([]byte("\r\n\x1b[1;33m" + strings.TrimSpace(msg) + "\x1b[0m\r\n"))
+}
@@ -0,0 +1,238 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	logger "github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/i18n"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/share"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/types"
+)
+
+const (
+	// 等待会话所有者响应加入的时间
+	shareJoinTimeout = time.Second * 5
+)
+
+// shareControlMsg 协作者控制权申请/交还, 通过 ShareChannel 发送
+type shareControlMsg struct {
+	Action string `json:"action"` // take / release
+}
+
+// ShareViewerConn 共享会话协作者连接, 不直接连接容器, 通过会话所有者转发输入输出
+type ShareViewerConn struct {
+	ctx        context.Context
+	wsConn     *websocket.Conn
+	podCtx     *types.PodContext
+	share      *share.Share
+	mode       share.Mode
+	connId     string
+	hideBanner bool
+	controller string
+}
+
+// NewShareViewerConn : 校验邀请后创建协作者连接
+func NewShareViewerConn(ctx context.Context, wsConn *websocket.Conn, podCtx *types.PodContext,
+	hideBanner bool) (*ShareViewerConn, error) {
+	s, err := share.NewStore().Get(ctx, podCtx.ShareId)
+	if err != nil {
+		return nil, errors.Wrap(err, "get share")
+	}
+
+	mode, ok := s.GetMode(podCtx.Username)
+	if !ok {
+		return nil, errors.Errorf("user %s not invited", podCtx.Username)
+	}
+
+	conn := &ShareViewerConn{
+		ctx:        ctx,
+		wsConn:     wsConn,
+		podCtx:     podCtx,
+		share:      s,
+		mode:       mode,
+		connId:     strings.ReplaceAll(uuid.New().String(), "-", ""),
+		hideBanner: hideBanner,
+	}
+	return conn, nil
+}
+
+// Run 加入会话并转发输入输出, 会话所有者断开或取消邀请时退出
+func (v *ShareViewerConn) Run(c *gin.Context) error {
+	sub, err := share.GetGlobalBroker().Subscribe(v.ctx, share.OutboxChannel(v.share.SessionId))
+	if err != nil {
+		return err
+	}
+	defer sub.Close()
+
+	if err = v.publish(share.EventJoin, nil); err != nil {
+		return err
+	}
+	defer v.publish(share.EventLeave, nil) // nolint
+
+	pingInterval := time.NewTicker(WebsocketPingInterval * time.Second)
+	defer pingInterval.Stop()
+
+	joinTimeout := time.NewTimer(shareJoinTimeout)
+	defer joinTimeout.Stop()
+
+	inputMsgChan := readWsMessage(v.wsConn)
+	joined := false
+
+	for {
+		select {
+		case <-v.ctx.Done():
+			logger.Infof("close %s ShareViewerConn done", v.podCtx.Username)
+			return nil
+
+		case m, ok := <-inputMsgChan:
+			if !ok || m.err != nil {
+				return m.err
+			}
+			if err := v.handleInputMsg(m.msgType, m.msg); err != nil {
+				return err
+			}
+
+		case e, ok := <-sub.Events():
+			if !ok {
+				return errors.New(i18n.T(c, "共享会话已结束"))
+			}
+			if !e.Match(v.share.ShareId, v.connId) {
+				continue
+			}
+			if e.Type == share.EventState && !joined {
+				joined = true
+				if err := v.writeOutput(v.helloMessage(c)); err != nil {
+					return err
+				}
+			}
+			if err := v.handleEvent(c, e); err != nil {
+				return err
+			}
+
+		case <-joinTimeout.C:
+			if !joined {
+				return errors.New(i18n.T(c, "共享会话已结束"))
+			}
+
+		case <-pingInterval.C:
+			if err := v.wsConn.WriteMessage(websocket.PingMessage, []byte{}); err != nil {
+				return errors.Wrap(err, "ping")
+			}
+		}
+	}
+}
+
+// handleEvent 处理会话所有者的事件
+func (v *ShareViewerConn) handleEvent(c *gin.Context, e *share.Event) error {
+	switch e.Type {
+	case share.EventOutput:
+		return v.writeOutput(e.Data)
+
+	case share.EventState:
+		if e.State == nil || e.State.Controller == v.controller {
+			return nil
+		}
+		v.controller = e.State.Controller
+		if v.controller == v.podCtx.Username && v.mode == share.ModeControl {
+			return v.writeNotice(i18n.T(c, "[共享] 你已获得控制权"))
+		}
+		return v.writeNotice(i18n.T(c, "[共享] 当前由 %s 控制终端", v.controller))
+
+	case share.EventClose:
+		return errors.New(i18n.T(c, "共享会话已结束"))
+	}
+	return nil
+}
+
+// handleInputMsg 处理协作者输入, 只读协作者的输入直接丢弃, 是否控制终端由所有者判定
+func (v *ShareViewerConn) handleInputMsg(msgType int, msg []byte) error {
+	if msgType != websocket.TextMessage || len(msg) == 0 || v.mode != share.ModeControl {
+		return nil
+	}
+
+	decodeMsg, err := base64.StdEncoding.DecodeString(string(msg[1:]))
+	if err != nil {
+		return nil
+	}
+
+	switch string(msg[0]) {
+	case StdinChannel:
+		return v.publish(share.EventInput, decodeMsg)
+
+	case ShareChannel:
+		controlMsg := shareControlMsg{}
+		if err := json.Unmarshal(decodeMsg, &controlMsg); err != nil {
+			return nil
+		}
+		switch controlMsg.Action {
+		case "take":
+			return v.publish(share.EventTakeControl, nil)
+		case "release":
+			return v.publish(share.EventReleaseControl, nil)
+		}
+	}
+
+	// 终端大小以会话所有者为准, 忽略 resize
+	return nil
+}
+
+// publish 发送事件给会话所有者
+func (v *ShareViewerConn) publish(eventType share.EventType, data []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sharePublishTimeout)
+	defer cancel()
+
+	e := &share.Event{
+		Type:     eventType,
+		ShareId:  v.share.ShareId,
+		Username: v.podCtx.Username,
+		ConnId:   v.connId,
+		Data:     data,
+	}
+	return share.GetGlobalBroker().Publish(ctx, share.InboxChannel(v.share.SessionId), e)
+}
+
+// helloMessage 加入会话的提示信息
+func (v *ShareViewerConn) helloMessage(c *gin.Context) []byte {
+	if v.hideBanner {
+		return nil
+	}
+	if v.mode == share.ModeControl {
+		return []byte(i18n.T(c, "[共享] 已加入 %s 的会话, 可申请接管终端控制权", v.share.Owner) + OutputLineBreaker)
+	}
+	return []byte(i18n.T(c, "[共享] 已加入 %s 的会话, 只读模式", v.share.Owner) + OutputLineBreaker)
+}
+
+// writeNotice 输出共享提示信息
+func (v *ShareViewerConn) writeNotice(msg string) error {
+	return v.writeOutput([]byte("\r\n\x1b[1;33m" + strings.TrimSpace(msg) + "\x1b[0m\r\n"))
+}
+
+// writeOutput 输出到 web 端
+func (v *ShareViewerConn) writeOutput(output []byte) error {
+	if len(output) == 0 {
+		return nil
+	}
+	outputMsg := []byte(base64.StdEncoding.EncodeToString(output))
+	return v.wsConn.WriteMessage(websocket.TextMessage, outputMsg)
+}
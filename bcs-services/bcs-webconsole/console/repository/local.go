@@ -0,0 +1,179 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+)
+
+// localStorage 本地磁盘存储, 适用于没有对象存储的环境, 过期文件由 audit 的 retention_days 定时清理
+type localStorage struct {
+	rootDir string
+}
+
+// absPath 返回文件在本地的绝对路径, 不允许访问 rootDir 之外的文件
+func (l *localStorage) absPath(filePath string) string {
+	return filepath.Join(l.rootDir, filepath.Clean("/"+filePath))
+}
+
+// UploadFile copy file to local storage
+func (l *localStorage) UploadFile(ctx context.Context, localFile, filePath string) error {
+	f, err := os.Open(localFile)
+	if err != nil {
+		return fmt.Errorf("open local file %s failed: %v", localFile, err)
+	}
+	defer f.Close() // nolint
+
+	return l.UploadFileByReader(ctx, f, filePath)
+}
+
+// UploadFileByReader write file to local storage by Reader
+func (l *localStorage) UploadFileByReader(ctx context.Context, r io.Reader, filePath string) error {
+	dst := l.absPath(filePath)
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return fmt.Errorf("upload file failed: %v", err)
+	}
+
+	// 先写临时文件再重命名, 避免读取到写了一半的文件
+	tmp, err := os.CreateTemp(filepath.Dir(dst), "."+filepath.Base(dst)+".*")
+	if err != nil {
+		return fmt.Errorf("upload file failed: %v", err)
+	}
+	defer os.Remove(tmp.Name()) // nolint
+
+	if _, err = io.Copy(tmp, r); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("upload file failed: %v", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("upload file failed: %v", err)
+	}
+	if err = os.Rename(tmp.Name(), dst); err != nil {
+		return fmt.Errorf("upload file failed: %v", err)
+	}
+
+	return nil
+}
+
+// ListFile list current folder files
+func (l *localStorage) ListFile(ctx context.Context, folderName string) ([]FileContent, error) {
+	folderName = strings.Trim(folderName, "/")
+	entries, err := os.ReadDir(l.absPath(folderName))
+	if err != nil {
+		return nil, fmt.Errorf("folder %s is not exit", folderName)
+	}
+
+	var files []FileContent
+	for _, entry := range entries {
+		// 忽略目录和上传中的临时文件
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, FileContent{
+			FileName:      entry.Name(),
+			ProcessedSize: humanize.Bytes(uint64(info.Size())),
+		})
+	}
+	if len(files) == 0 {
+		return files, fmt.Errorf("folder %s is not exit", folderName)
+	}
+	return files, nil
+}
+
+// IsExist 是否存在
+func (l *localStorage) IsExist(ctx context.Context, filePath string) (bool, error) {
+	_, err := os.Stat(l.absPath(filePath))
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+// ListFolders list current folder folders, 格式与对象存储保持一致, 如 20240101/
+func (l *localStorage) ListFolders(ctx context.Context, folderName string) ([]string, error) {
+	folderName = strings.Trim(folderName, "/")
+	entries, err := os.ReadDir(l.absPath(folderName))
+	if err != nil {
+		return nil, fmt.Errorf("folder %s is not exit", folderName)
+	}
+
+	folders := make([]string, 0)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		folders = append(folders, strings.TrimPrefix(folderName+"/"+entry.Name()+"/", "/"))
+	}
+	return folders, nil
+}
+
+// DeleteFolders delete folder from local storage
+func (l *localStorage) DeleteFolders(ctx context.Context, folderName string) error {
+	folderName = strings.Trim(folderName, "/")
+	// 不允许删除根目录
+	if folderName == "" {
+		return fmt.Errorf("folder name is required")
+	}
+	return os.RemoveAll(l.absPath(folderName))
+}
+
+// DownloadFile open file from local storage
+func (l *localStorage) DownloadFile(ctx context.Context, filePath string) (io.ReadCloser, error) {
+	return os.Open(l.absPath(filePath))
+}
+
+func newLocalStorage() (Provider, error) {
+	if config.G.Repository.Local.RootDir == "" {
+		return nil, fmt.Errorf("local root_dir is required")
+	}
+	rootDir, err := filepath.Abs(config.G.Repository.Local.RootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// 上传成功后会清理 audit 目录中的文件, 不能使用同一个目录
+	if config.G.Audit.DataDir != "" {
+		dataDir, err := filepath.Abs(config.G.Audit.DataDir)
+		if err != nil {
+			return nil, err
+		}
+		if dataDir == rootDir {
+			return nil, fmt.Errorf("local root_dir can not be the same as audit data_dir")
+		}
+	}
+
+	if err := os.MkdirAll(rootDir, 0755); err != nil {
+		return nil, fmt.Errorf("create local root_dir %s failed: %v", rootDir, err)
+	}
+
+	l := &localStorage{
+		rootDir: rootDir,
+	}
+	return l, nil
+}
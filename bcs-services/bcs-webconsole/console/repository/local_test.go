@@ -0,0 +1,63 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	l := &localStorage{rootDir: t.TempDir()}
+
+	if err := l.UploadFileByReader(ctx, strings.NewReader("cast"), "20240101/a.cast"); err != nil {
+		t.Fatal(err)
+	}
+	// 不允许写到 rootDir 之外
+	if err := l.UploadFileByReader(ctx, strings.NewReader("cast"), "../../20240102/b.cast"); err != nil {
+		t.Fatal(err)
+	}
+
+	folders, err := l.ListFolders(ctx, "")
+	if err != nil || len(folders) != 2 || folders[0] != "20240101/" || folders[1] != "20240102/" {
+		t.Fatalf("list folders get %v, err %v", folders, err)
+	}
+
+	files, err := l.ListFile(ctx, "20240101")
+	if err != nil || len(files) != 1 || files[0].FileName != "a.cast" {
+		t.Fatalf("list file get %v, err %v", files, err)
+	}
+
+	r, err := l.DownloadFile(ctx, "20240101/a.cast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := io.ReadAll(r)
+	_ = r.Close()
+	if string(data) != "cast" {
+		t.Errorf("download file get %s", data)
+	}
+
+	if err = l.DeleteFolders(ctx, "20240101"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := l.IsExist(ctx, "20240101/a.cast"); ok {
+		t.Errorf("file should be deleted")
+	}
+	if err = l.DeleteFolders(ctx, "/"); err == nil {
+		t.Errorf("delete root folder should failed")
+	}
+}
@@ -37,6 +37,10 @@ func NewProvider(providerType string) (Provider, error) {
 		return newCosStorage()
 	case "bkrepo":
 		return newBkRepoStorage()
+	case "s3":
+		return newS3Storage()
+	case "local":
+		return newLocalStorage()
 	case "":
 		return nil, fmt.Errorf("repo provider is required")
 	default:
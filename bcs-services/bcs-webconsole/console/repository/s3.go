@@ -0,0 +1,174 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+)
+
+// s3Storage s3 兼容的对象存储, 如 MinIO, Ceph RGW
+type s3Storage struct {
+	client *minio.Client
+	bucket string
+}
+
+// UploadFile upload file to s3
+func (s *s3Storage) UploadFile(ctx context.Context, localFile, filePath string) error {
+	_, err := s.client.FPutObject(ctx, s.bucket, filePath, localFile, minio.PutObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("upload file failed: %v", err)
+	}
+
+	return nil
+}
+
+// UploadFileByReader upload file to s3 by Reader
+func (s *s3Storage) UploadFileByReader(ctx context.Context, r io.Reader, filePath string) error {
+	// size 为 -1 时按分片上传, 不需要预先知道文件大小
+	_, err := s.client.PutObject(ctx, s.bucket, filePath, r, -1, minio.PutObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("upload file failed: %v", err)
+	}
+
+	return nil
+}
+
+// ListFile list current folder files
+func (s *s3Storage) ListFile(ctx context.Context, folderName string) ([]FileContent, error) {
+	folderName = strings.Trim(folderName, "/")
+	folderName += "/"
+
+	var files []FileContent
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: folderName}) {
+		if obj.Err != nil {
+			return files, fmt.Errorf("list file failed: %v", obj.Err)
+		}
+		// 子目录
+		if strings.HasSuffix(obj.Key, "/") {
+			continue
+		}
+		files = append(files, FileContent{
+			FileName:      strings.TrimPrefix(obj.Key, folderName),
+			ProcessedSize: humanize.Bytes(uint64(obj.Size)),
+		})
+	}
+	if len(files) == 0 {
+		return files, fmt.Errorf("folder %s is not exit", folderName)
+	}
+	return files, nil
+}
+
+// IsExist 是否存在
+func (s *s3Storage) IsExist(ctx context.Context, filePath string) (bool, error) {
+	_, err := s.client.StatObject(ctx, s.bucket, filePath, minio.StatObjectOptions{})
+	if err == nil {
+		return true, nil
+	}
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return false, nil
+	}
+	return false, err
+}
+
+// ListFolders list current folder folders
+func (s *s3Storage) ListFolders(ctx context.Context, folderName string) ([]string, error) {
+	folderName = strings.Trim(folderName, "/")
+	folderName += "/"
+
+	// 根目录需为空
+	if folderName == "/" {
+		folderName = ""
+	}
+
+	folders := make([]string, 0)
+	found := false
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: folderName}) {
+		if obj.Err != nil {
+			return folders, fmt.Errorf("list file failed: %v", obj.Err)
+		}
+		found = true
+		// 非递归模式下, 以 / 结尾的 key 为被截断的子目录
+		if strings.HasSuffix(obj.Key, "/") && obj.Key != folderName {
+			folders = append(folders, obj.Key)
+		}
+	}
+	if !found {
+		return folders, fmt.Errorf("folder %s is not exit", folderName)
+	}
+	return folders, nil
+}
+
+// DeleteFolders delete folder from s3
+func (s *s3Storage) DeleteFolders(ctx context.Context, folderName string) error {
+	folderName = strings.Trim(folderName, "/")
+	folderName += "/"
+
+	objectsCh := s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: folderName, Recursive: true})
+	for err := range s.client.RemoveObjects(ctx, s.bucket, objectsCh, minio.RemoveObjectsOptions{}) {
+		if err.Err != nil {
+			return fmt.Errorf("delete object %s failed: %v", err.ObjectName, err.Err)
+		}
+	}
+
+	return nil
+}
+
+// DownloadFile download file from s3
+func (s *s3Storage) DownloadFile(ctx context.Context, filePath string) (io.ReadCloser, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, filePath, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// GetObject 不会发起请求, 通过 Stat 提前暴露文件不存在等错误
+	if _, err = obj.Stat(); err != nil {
+		_ = obj.Close()
+		return nil, err
+	}
+	return obj, nil
+}
+
+func newS3Storage() (Provider, error) {
+	conf := config.G.Repository.S3
+	if conf.Endpoint == "" || conf.BucketName == "" {
+		return nil, fmt.Errorf("s3 endpoint and bucket_name are required")
+	}
+
+	lookup := minio.BucketLookupAuto
+	if conf.PathStyle {
+		lookup = minio.BucketLookupPath
+	}
+	cli, err := minio.New(conf.Endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(conf.AccessKeyID, conf.SecretAccessKey, ""),
+		Secure:       conf.UseSSL,
+		Region:       conf.Region,
+		BucketLookup: lookup,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s := &s3Storage{
+		client: cli,
+		bucket: conf.BucketName,
+	}
+	return s, nil
+}
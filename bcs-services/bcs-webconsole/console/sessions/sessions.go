@@ -106,25 +106,41 @@ func (rs *redisStore) Cleanup(ctx context.Context) error {
 	if err != nil {
 		return nil
 	}
-	var expireSessions []string
+	return rs.deleteSessions(ctx, filterSessions(values, func(podCtx *types.TimestampPodContext) bool {
+		return podCtx.IsExpired()
+	}))
+}
 
-	for key, value := range values {
-		var podCtx types.TimestampPodContext
-		err := json.Unmarshal([]byte(value), &podCtx)
-		if err == nil && !podCtx.IsExpired() {
-			continue
-		}
+// DeleteByShareId 删除通过共享邀请加入的协作者 session
+func (rs *redisStore) DeleteByShareId(ctx context.Context, shareId string) error {
+	values, err := rs.client.HGetAll(ctx, rs.key).Result()
+	if err != nil {
+		return err
+	}
+	return rs.deleteSessions(ctx, filterSessions(values, func(podCtx *types.TimestampPodContext) bool {
+		return podCtx.ShareId == shareId
+	}))
+}
 
-		expireSessions = append(expireSessions, key)
+func (rs *redisStore) deleteSessions(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
 	}
+	_, err := rs.client.HDel(ctx, rs.key, keys...).Result()
+	return err
+}
 
-	if len(expireSessions) > 0 {
-		if _, err := rs.client.HDel(ctx, rs.key, expireSessions...).Result(); err != nil {
-			return err
+// filterSessions 返回需要删除的 session key, 解析失败的数据也会被删除
+func filterSessions(values map[string]string, match func(podCtx *types.TimestampPodContext) bool) []string {
+	var keys []string
+	for key, value := range values {
+		var podCtx types.TimestampPodContext
+		if err := json.Unmarshal([]byte(value), &podCtx); err == nil && !match(&podCtx) {
+			continue
 		}
+		keys = append(keys, key)
 	}
-
-	return nil
+	return keys
 }
 
 // sessionIdGenerator xxx
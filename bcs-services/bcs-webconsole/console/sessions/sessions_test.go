@@ -0,0 +1,59 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sessions
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/types"
+)
+
+func mustMarshal(t *testing.T, podCtx types.PodContext, timestamp time.Time) string {
+	payload, err := json.Marshal(types.TimestampPodContext{PodContext: podCtx, Timestamp: timestamp.Unix()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(payload)
+}
+
+func TestFilterSessions(t *testing.T) {
+	now := time.Now()
+	values := map[string]string{
+		"websocket:owner":   mustMarshal(t, types.PodContext{Username: "owner"}, now),
+		"websocket:viewer1": mustMarshal(t, types.PodContext{Username: "viewer1", ShareId: "share1", SessionTimeout: 10}, now),
+		"websocket:viewer2": mustMarshal(t, types.PodContext{Username: "viewer2", ShareId: "share2", SessionTimeout: 10}, now),
+		// 邀请已过期的协作者 session
+		"websocket:viewer3": mustMarshal(t, types.PodContext{Username: "viewer3", ShareId: "share3", SessionTimeout: 1},
+			now.Add(-2*time.Minute)),
+		"websocket:invalid": "invalid",
+	}
+
+	// 取消共享邀请只删除该邀请的协作者 session
+	keys := filterSessions(values, func(podCtx *types.TimestampPodContext) bool {
+		return podCtx.ShareId == "share1"
+	})
+	sort.Strings(keys)
+	assert.Equal(t, []string{"websocket:invalid", "websocket:viewer1"}, keys)
+
+	// 清理过期 session
+	keys = filterSessions(values, func(podCtx *types.TimestampPodContext) bool {
+		return podCtx.IsExpired()
+	})
+	sort.Strings(keys)
+	assert.Equal(t, []string{"websocket:invalid", "websocket:viewer3"}, keys)
+}
@@ -0,0 +1,240 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package share
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	logger "github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/go-redis/redis/v8"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/storage"
+)
+
+const (
+	// bcs::webconsole::share::{run_env}::{session_id}::{inbox|outbox} 格式
+	channelPrefix = "bcs::webconsole::share::%s::%s::%s"
+
+	// 单个订阅者缓冲的事件数量, 超过后丢弃
+	subscriberBufferSize = 256
+)
+
+// EventType 共享会话事件类型
+type EventType string
+
+const (
+	// EventJoin 协作者加入, 协作者 -> 所有者
+	EventJoin EventType = "join"
+	// EventLeave 协作者离开, 协作者 -> 所有者
+	EventLeave EventType = "leave"
+	// EventInput 协作者输入, 协作者 -> 所有者
+	EventInput EventType = "input"
+	// EventTakeControl 申请控制权, 协作者 -> 所有者
+	EventTakeControl EventType = "take_control"
+	// EventReleaseControl 交还控制权, 协作者 -> 所有者
+	EventReleaseControl EventType = "release_control"
+	// EventRevoke 取消共享邀请, API -> 所有者
+	EventRevoke EventType = "revoke"
+	// EventOutput 终端输出, 所有者 -> 协作者
+	EventOutput EventType = "output"
+	// EventState 会话状态变化, 所有者 -> 协作者
+	EventState EventType = "state"
+	// EventClose 会话结束或被移出, 所有者 -> 协作者
+	EventClose EventType = "close"
+)
+
+// Event 共享会话事件, 通过 redis pub/sub 在多个副本间传递
+type Event struct {
+	Type     EventType `json:"type"`
+	ShareId  string    `json:"share_id,omitempty"`
+	Username string    `json:"username,omitempty"`
+	ConnId   string    `json:"conn_id,omitempty"` // 协作者连接ID, 同一用户可能有多个连接
+	Data     []byte    `json:"data,omitempty"`
+	State    *State    `json:"state,omitempty"`
+}
+
+// Match 定向事件是否发给此连接, ShareId, ConnId 为空表示广播
+func (e *Event) Match(shareId, connId string) bool {
+	if e.ShareId != "" && e.ShareId != shareId {
+		return false
+	}
+	if e.ConnId != "" && e.ConnId != connId {
+		return false
+	}
+	return true
+}
+
+// State 会话状态
+type State struct {
+	Owner      string   `json:"owner"`
+	Controller string   `json:"controller"` // 当前控制终端的用户
+	Viewers    []string `json:"viewers"`
+}
+
+// InboxChannel 所有者接收事件的 channel
+func InboxChannel(sessionId string) string {
+	return fmt.Sprintf(channelPrefix, config.G.Base.RunEnv, sessionId, "inbox")
+}
+
+// OutboxChannel 协作者接收事件的 channel
+func OutboxChannel(sessionId string) string {
+	return fmt.Sprintf(channelPrefix, config.G.Base.RunEnv, sessionId, "outbox")
+}
+
+var (
+	// broker 单例 Broker
+	broker     *Broker
+	brokerOnce sync.Once
+)
+
+// GetGlobalBroker : get global Broker
+func GetGlobalBroker() *Broker {
+	if broker == nil {
+		brokerOnce.Do(func() {
+			broker = &Broker{
+				subscribers: map[string]map[*Subscriber]struct{}{},
+			}
+		})
+	}
+	return broker
+}
+
+// Broker 进程内共用一个 redis 订阅连接, 按 channel 动态订阅并分发给本地订阅者
+type Broker struct {
+	lock        sync.Mutex
+	pubsub      *redis.PubSub
+	subscribers map[string]map[*Subscriber]struct{}
+}
+
+// Subscriber 本地订阅者
+type Subscriber struct {
+	channel string
+	c       chan *Event
+	broker  *Broker
+	once    sync.Once
+}
+
+// Events 事件列表, Close 后关闭
+func (s *Subscriber) Events() <-chan *Event {
+	return s.c
+}
+
+// Close 取消订阅
+func (s *Subscriber) Close() {
+	s.once.Do(func() {
+		s.broker.unsubscribe(s)
+	})
+}
+
+// getPubSub 首次使用时建立订阅连接
+func (b *Broker) getPubSub() *redis.PubSub {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.pubsub == nil {
+		b.pubsub = storage.GetDefaultRedisSession().Client.Subscribe(context.Background())
+	}
+	return b.pubsub
+}
+
+// Run Broker 启动, 分发订阅消息
+func (b *Broker) Run(ctx context.Context) error {
+	pubsub := b.getPubSub()
+	msgChan := pubsub.Channel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return pubsub.Close()
+
+		case msg, ok := <-msgChan:
+			if !ok {
+				return nil
+			}
+			b.dispatch(msg)
+		}
+	}
+}
+
+// dispatch 分发给本地订阅者, 订阅者处理慢时丢弃, 不能阻塞其他会话
+func (b *Broker) dispatch(msg *redis.Message) {
+	event := &Event{}
+	if err := json.Unmarshal([]byte(msg.Payload), event); err != nil {
+		logger.Warnf("share event json Unmarshal failed, channel=%s, err: %s", msg.Channel, err)
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for s := range b.subscribers[msg.Channel] {
+		select {
+		case s.c <- event:
+		default:
+			logger.Warnf("share subscriber too slow, drop event, channel=%s, type=%s", msg.Channel, event.Type)
+		}
+	}
+}
+
+// Subscribe 订阅 channel
+func (b *Broker) Subscribe(ctx context.Context, channel string) (*Subscriber, error) {
+	pubsub := b.getPubSub()
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if _, ok := b.subscribers[channel]; !ok {
+		if err := pubsub.Subscribe(ctx, channel); err != nil {
+			return nil, err
+		}
+		b.subscribers[channel] = map[*Subscriber]struct{}{}
+	}
+
+	s := &Subscriber{
+		channel: channel,
+		c:       make(chan *Event, subscriberBufferSize),
+		broker:  b,
+	}
+	b.subscribers[channel][s] = struct{}{}
+	return s, nil
+}
+
+// unsubscribe 最后一个本地订阅者退出时取消 redis 订阅
+func (b *Broker) unsubscribe(s *Subscriber) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	close(s.c)
+	delete(b.subscribers[s.channel], s)
+	if len(b.subscribers[s.channel]) > 0 {
+		return
+	}
+
+	delete(b.subscribers, s.channel)
+	if err := b.pubsub.Unsubscribe(context.Background(), s.channel); err != nil {
+		logger.Warnf("share unsubscribe %s failed, err: %s", s.channel, err)
+	}
+}
+
+// Publish 发布事件
+func (b *Broker) Publish(ctx context.Context, channel string, event *Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return storage.GetDefaultRedisSession().Client.Publish(ctx, channel, payload).Err()
+}
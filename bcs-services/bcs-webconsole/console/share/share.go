@@ -0,0 +1,177 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package share 终端会话共享, 支持协作者只读旁观或接管控制
+package share
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/storage"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/types"
+)
+
+const (
+	// bcs::webconsole::shares::{run_env}::{share_id} 格式
+	shareKeyPrefix = "bcs::webconsole::shares::%s::%s"
+
+	// DefaultExpireMinutes 共享邀请默认有效期, 单位分钟
+	DefaultExpireMinutes = 60
+)
+
+// Mode 协作者权限
+type Mode string
+
+const (
+	// ModeReadonly 只读旁观
+	ModeReadonly Mode = "readonly"
+	// ModeControl 可接管终端控制权
+	ModeControl Mode = "control"
+)
+
+// Validate 校验权限类型
+func (m Mode) Validate() error {
+	switch m {
+	case ModeReadonly, ModeControl:
+		return nil
+	default:
+		return errors.Errorf("mode %s not valid", m)
+	}
+}
+
+// ShareQuery 创建共享邀请参数
+type ShareQuery struct {
+	Users         []string `json:"users" binding:"required"`
+	Mode          Mode     `json:"mode"`           // 协作者权限, 默认只读
+	ExpireMinutes int64    `json:"expire_minutes"` // 邀请有效期, 单位分钟
+}
+
+// Validate 校验参数
+func (q *ShareQuery) Validate() error {
+	if len(q.Users) == 0 {
+		return errors.New("users is required")
+	}
+	if q.Mode == "" {
+		q.Mode = ModeReadonly
+	}
+	if err := q.Mode.Validate(); err != nil {
+		return err
+	}
+	if q.ExpireMinutes == 0 {
+		q.ExpireMinutes = DefaultExpireMinutes
+	}
+	if q.ExpireMinutes < 0 || q.ExpireMinutes > types.MaxSessionTimeout {
+		return errors.Errorf("expire_minutes 必须大于0, 不超过%d", types.MaxSessionTimeout)
+	}
+	return nil
+}
+
+// Share 会话共享邀请, 绑定会话所有者的 websocket session
+type Share struct {
+	ShareId    string          `json:"share_id"`
+	SessionId  string          `json:"session_id"` // 会话所有者的 websocket session id
+	Owner      string          `json:"owner"`
+	ProjectId  string          `json:"project_id"`
+	ClusterId  string          `json:"cluster_id"`
+	Users      map[string]Mode `json:"users"` // 被邀请的用户及权限
+	CreateTime time.Time       `json:"create_time"`
+	ExpireTime time.Time       `json:"expire_time"`
+}
+
+// GetMode 查询用户权限, 未被邀请返回 false
+func (s *Share) GetMode(username string) (Mode, bool) {
+	if time.Now().After(s.ExpireTime) {
+		return "", false
+	}
+	mode, ok := s.Users[username]
+	return mode, ok
+}
+
+// SessionTimeout 协作者 session 过期时间, 单位分钟, 与邀请同时过期
+func (s *Share) SessionTimeout() int64 {
+	ttl := time.Until(s.ExpireTime)
+	if ttl <= 0 {
+		return 0
+	}
+	timeout := int64((ttl + time.Minute - 1) / time.Minute)
+	if timeout > types.MaxSessionTimeout {
+		return types.MaxSessionTimeout
+	}
+	return timeout
+}
+
+// redisStore 共享邀请存储, 每个邀请单独一个 key, 过期自动删除
+type redisStore struct {
+	client *redis.Client
+}
+
+// NewStore 新建共享邀请存储
+// NOCC:golint/ret(设计如此:)
+func NewStore() *redisStore {
+	return &redisStore{client: storage.GetDefaultRedisSession().Client}
+}
+
+func (rs *redisStore) cacheKey(shareId string) string {
+	return fmt.Sprintf(shareKeyPrefix, config.G.Base.RunEnv, shareId)
+}
+
+// Get 读取共享邀请
+func (rs *redisStore) Get(ctx context.Context, shareId string) (*Share, error) {
+	value, err := rs.client.Get(ctx, rs.cacheKey(shareId)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	share := &Share{}
+	if err := json.Unmarshal([]byte(value), share); err != nil {
+		return nil, err
+	}
+	return share, nil
+}
+
+// Set 保存共享邀请, 返回 share id
+func (rs *redisStore) Set(ctx context.Context, share *Share) (string, error) {
+	ttl := time.Until(share.ExpireTime)
+	if ttl <= 0 {
+		return "", errors.New("share already expired")
+	}
+
+	share.ShareId = shareIdGenerator()
+	payload, err := json.Marshal(share)
+	if err != nil {
+		return "", err
+	}
+	if err := rs.client.Set(ctx, rs.cacheKey(share.ShareId), payload, ttl).Err(); err != nil {
+		return "", err
+	}
+	return share.ShareId, nil
+}
+
+// Delete 删除共享邀请
+func (rs *redisStore) Delete(ctx context.Context, shareId string) error {
+	return rs.client.Del(ctx, rs.cacheKey(shareId)).Err()
+}
+
+// shareIdGenerator xxx
+func shareIdGenerator() string {
+	uid := uuid.New().String()
+	return strings.ReplaceAll(uid, "-", "")
+}
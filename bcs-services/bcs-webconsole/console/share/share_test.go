@@ -0,0 +1,69 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package share
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/types"
+)
+
+func TestShareQueryValidate(t *testing.T) {
+	q := &ShareQuery{Users: []string{"user1"}}
+	assert.NoError(t, q.Validate())
+	assert.Equal(t, ModeReadonly, q.Mode)
+	assert.Equal(t, int64(DefaultExpireMinutes), q.ExpireMinutes)
+
+	assert.Error(t, (&ShareQuery{}).Validate())
+	assert.Error(t, (&ShareQuery{Users: []string{"user1"}, Mode: "admin"}).Validate())
+	assert.Error(t, (&ShareQuery{Users: []string{"user1"}, ExpireMinutes: -1}).Validate())
+}
+
+func TestShareGetMode(t *testing.T) {
+	s := &Share{
+		Users:      map[string]Mode{"user1": ModeControl},
+		ExpireTime: time.Now().Add(time.Minute),
+	}
+	mode, ok := s.GetMode("user1")
+	assert.True(t, ok)
+	assert.Equal(t, ModeControl, mode)
+
+	_, ok = s.GetMode("user2")
+	assert.False(t, ok)
+
+	s.ExpireTime = time.Now().Add(-time.Minute)
+	_, ok = s.GetMode("user1")
+	assert.False(t, ok)
+}
+
+func TestEventMatch(t *testing.T) {
+	assert.True(t, (&Event{Type: EventOutput}).Match("s1", "c1"))
+	assert.True(t, (&Event{Type: EventClose, ShareId: "s1"}).Match("s1", "c1"))
+	assert.False(t, (&Event{Type: EventClose, ShareId: "s2"}).Match("s1", "c1"))
+	assert.True(t, (&Event{Type: EventClose, ShareId: "s1", ConnId: "c1"}).Match("s1", "c1"))
+	assert.False(t, (&Event{Type: EventClose, ShareId: "s1", ConnId: "c2"}).Match("s1", "c1"))
+}
+
+func TestShareSessionTimeout(t *testing.T) {
+	s := &Share{ExpireTime: time.Now().Add(90 * time.Second)}
+	assert.Equal(t, int64(2), s.SessionTimeout())
+
+	s.ExpireTime = time.Now().Add(48 * time.Hour)
+	assert.Equal(t, int64(types.MaxSessionTimeout), s.SessionTimeout())
+
+	s.ExpireTime = time.Now().Add(-time.Minute)
+	assert.Equal(t, int64(0), s.SessionTimeout())
+}
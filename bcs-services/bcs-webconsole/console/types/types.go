@@ -119,6 +119,7 @@ type PodContext struct {
 	SessionTimeout  int64          `json:"session_timeout"`   // session 过期时间, 单位分钟
 	ConnIdleTimeout int64          `json:"conn_idle_timeout"` // 空闲时间, 单位分钟
 	SessionId       string         `json:"session_id"`        // session id
+	ShareId         string         `json:"share_id"`          // 共享会话 id, 不为空时为协作者连接
 }
 
 // CommandDelay 用户延时命令设置
@@ -54,6 +54,25 @@ var auditFuncMap = map[string]func(c *gin.Context) (audit.Resource, audit.Action
 				ResourceID: res.ClusterID, ResourceName: res.ClusterID, ResourceData: res.toMap()},
 			audit.Action{ActionID: "web_console_start", ActivityType: audit.ActivityTypeStart}
 	},
+	"POST./api/sessions/:sessionId/shares/": func(c *gin.Context) (audit.Resource, audit.Action) {
+		res := getResourceID(c)
+		return audit.Resource{ResourceType: audit.ResourceTypeWebConsole, ProjectCode: res.ProjectCode,
+				ResourceID: res.ClusterID, ResourceName: res.ClusterID, ResourceData: res.toMap()},
+			audit.Action{ActionID: "web_console_share", ActivityType: audit.ActivityTypeCreate}
+	},
+	"DELETE./api/sessions/:sessionId/shares/:shareId/": func(c *gin.Context) (audit.Resource, audit.Action) {
+		res := getResourceID(c)
+		return audit.Resource{ResourceType: audit.ResourceTypeWebConsole, ProjectCode: res.ProjectCode,
+				ResourceID: res.ClusterID, ResourceName: res.ClusterID, ResourceData: res.toMap()},
+			audit.Action{ActionID: "web_console_share", ActivityType: audit.ActivityTypeDelete}
+	},
+	"GET./api/projects/:projectId/clusters/:clusterId/shares/:shareId/session/": func(c *gin.Context) (
+		audit.Resource, audit.Action) {
+		res := getResourceID(c)
+		return audit.Resource{ResourceType: audit.ResourceTypeWebConsole, ProjectCode: res.ProjectCode,
+				ResourceID: res.ClusterID, ResourceName: res.ClusterID, ResourceData: res.toMap()},
+			audit.Action{ActionID: "web_console_share_join", ActivityType: audit.ActivityTypeStart}
+	},
 }
 
 // AuditHandler 操作记录中间件
@@ -296,6 +296,22 @@ func (h *Handler) MultiClusterResourceCount(ctx context.Context, req *clusterRes
 	return err
 }
 
+// SearchMultiClusterResources search multi cluster resources with selectors, projections and aggregations
+func (h *Handler) SearchMultiClusterResources(ctx context.Context,
+	req *clusterRes.SearchMultiClusterResourcesReq, resp *clusterRes.CommonResp) (err error) {
+	search, err := NewResourceSearch(req)
+	if err != nil {
+		return err
+	}
+	var data map[string]interface{}
+	data, err = search.Search(ctx)
+	if err != nil {
+		return err
+	}
+	resp.Data, err = pbstruct.Map2pbStruct(data)
+	return err
+}
+
 // GetApiResourcesObject get api resources object
 func (h *Handler) GetApiResourcesObject(ctx context.Context,
 	req *clusterRes.GetApiResourcesObjectReq, resp *clusterRes.CommonResp) (err error) {
@@ -770,33 +770,15 @@ func checkMultiClusterAccess(ctx context.Context, kind string, clusters []*clust
 			continue
 		}
 
-		// 共享集群，如果没有命名空间，则直接返回
-		var nss []string
-		for _, ns := range v.Namespaces {
-			if ns == "" {
-				continue
-			}
-			nss = append(nss, ns)
+		// 共享集群只允许访问项目下的活跃命名空间，命名空间为空则查询项目在集群下的所有命名空间
+		projectNss, err := getActiveNs(ctx, projInfo.Code, v.ClusterID)
+		if err != nil {
+			continue
 		}
-
-		// 命名空间为空，则查询集群下用户所有命名空间
+		nss := filterOwnedNamespaces(v.Namespaces, projectNss)
+		// 集群中没有可访问的命名空间，则忽略
 		if len(nss) == 0 {
-			clusterNs, err := project.GetProjectNamespace(ctx, projInfo.Code, v.ClusterID)
-
-			if err != nil {
-				log.Error(ctx, "get project %s cluster %s ns failed, %v", projInfo.Code, v.ClusterID, err)
-				continue
-			}
-			for _, nsItem := range clusterNs {
-				if !nsItem.IsActive() {
-					continue
-				}
-				nss = append(nss, nsItem.Name)
-			}
-			// 集群中没有活跃命名空间或者命名空间为空，则忽略
-			if len(nss) == 0 {
-				continue
-			}
+			continue
 		}
 
 		// SC 允许用户查看
@@ -851,6 +833,25 @@ func checkMultiClusterAccess(ctx context.Context, kind string, clusters []*clust
 	return result, applyURL, nil
 }
 
+// filterOwnedNamespaces 过滤出项目拥有的命名空间，未指定命名空间时返回项目全部命名空间
+func filterOwnedNamespaces(namespaces, owned []string) []string {
+	requested := false
+	nss := []string{}
+	for _, ns := range namespaces {
+		if ns == "" {
+			continue
+		}
+		requested = true
+		if slice.StringInSlice(ns, owned) {
+			nss = append(nss, ns)
+		}
+	}
+	if !requested {
+		return owned
+	}
+	return nss
+}
+
 // getNss 获取命名空间列表
 func getNss(ctx context.Context, nsgroup, projectID, clusterID string, ns []string) ([]string, error) {
 	var nss []string
@@ -48,3 +48,15 @@ func TestInBlacklist(t *testing.T) {
 	assert.True(t, !inBlackList("c", []*clusterresources.ClusterNamespaces{{ClusterID: "xxx"}}),
 		"InBlacklist failed")
 }
+
+func TestFilterOwnedNamespaces(t *testing.T) {
+	owned := []string{"ns1", "ns2"}
+
+	// 未指定命名空间时返回项目全部命名空间
+	assert.Equal(t, owned, filterOwnedNamespaces(nil, owned))
+	assert.Equal(t, owned, filterOwnedNamespaces([]string{""}, owned))
+	// 只保留项目拥有的命名空间
+	assert.Equal(t, []string{"ns1"}, filterOwnedNamespaces([]string{"ns1", "kube-system"}, owned))
+	// 指定的命名空间均不属于项目时返回空
+	assert.Empty(t, filterOwnedNamespaces([]string{"kube-system"}, owned))
+}
@@ -0,0 +1,475 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package multicluster
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/ctxkey"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/errcode"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/i18n"
+	log "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/logging"
+	res "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/storage"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/errorx"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/hash"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/mapx"
+	clusterRes "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/proto/cluster-resources"
+)
+
+const (
+	// defaultSearchLimit 默认每页数量
+	defaultSearchLimit = 100
+
+	// AggregationCount 计数聚合
+	AggregationCount = "count"
+	// AggregationSumRequests 容器 resources.requests 求和聚合
+	AggregationSumRequests = "sumRequests"
+
+	// searchClusterIDKey 注入到资源中的集群 ID 字段，可在 JSONPath 中通过 {.clusterID} 引用
+	searchClusterIDKey = "clusterID"
+
+	// searchCacheTTL 搜索结果缓存时间，翻页请求复用首页拉取的结果
+	searchCacheTTL = time.Minute
+)
+
+// searchCache 搜索结果缓存，key 为用户及去除分页参数后的搜索条件
+var searchCache = cache.New(searchCacheTTL, searchCacheTTL*2)
+
+// searchResult 过滤排序后的全量资源及聚合结果
+type searchResult struct {
+	resources    []*storage.Resource
+	aggregations map[string]interface{}
+	applyURL     string
+}
+
+// podSpecPaths 可能包含容器定义的 PodSpec 路径，依次为 Pod，常见工作负载及 CronJob
+var podSpecPaths = [][]string{
+	{"spec"},
+	{"spec", "template", "spec"},
+	{"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// fieldRequirement 字段选择器中的单个条件
+type fieldRequirement struct {
+	path     *jsonpath.JSONPath
+	notEqual bool
+	value    string
+}
+
+// searchProjection 已解析的 JSONPath 投影
+type searchProjection struct {
+	name string
+	path *jsonpath.JSONPath
+}
+
+// ResourceSearch 多集群任意资源搜索
+type ResourceSearch struct {
+	req          *clusterRes.SearchMultiClusterResourcesReq
+	fields       []fieldRequirement
+	projections  []searchProjection
+	aggregations []*clusterRes.SearchAggregation
+	groupBy      map[string]*jsonpath.JSONPath
+}
+
+// NewResourceSearch 解析搜索请求中的字段选择器，投影及聚合
+func NewResourceSearch(req *clusterRes.SearchMultiClusterResourcesReq) (*ResourceSearch, error) {
+	fields, err := parseFieldSelector(req.GetFieldSelector())
+	if err != nil {
+		return nil, err
+	}
+	s := &ResourceSearch{
+		req: req, fields: fields, aggregations: req.GetAggregations(), groupBy: map[string]*jsonpath.JSONPath{},
+	}
+	for _, p := range req.GetProjections() {
+		jp, err := parseJSONPath(p.GetName(), p.GetJsonPath())
+		if err != nil {
+			return nil, err
+		}
+		s.projections = append(s.projections, searchProjection{name: p.GetName(), path: jp})
+	}
+	for _, agg := range req.GetAggregations() {
+		if agg.GetGroupBy() == "" {
+			continue
+		}
+		jp, err := parseJSONPath(agg.GetName(), agg.GetGroupBy())
+		if err != nil {
+			return nil, err
+		}
+		s.groupBy[agg.GetName()] = jp
+	}
+	return s, nil
+}
+
+// Search 从各集群 apiserver 拉取资源，过滤后返回当前页数据及全量聚合结果
+// 首页请求拉取全量资源并缓存，携带游标的翻页请求优先使用缓存结果
+func (s *ResourceSearch) Search(ctx context.Context) (map[string]interface{}, error) {
+	cursor, err := decodeCursor(s.req.GetCursor())
+	if err != nil {
+		return nil, errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "游标 %s 不合法"), s.req.GetCursor())
+	}
+
+	cacheKey := searchCacheKey(ctx, s.req)
+	var result *searchResult
+	if cached, ok := searchCache.Get(cacheKey); ok && cursor != "" {
+		result = cached.(*searchResult)
+	} else {
+		if result, err = s.list(ctx); err != nil {
+			return nil, err
+		}
+		searchCache.SetDefault(cacheKey, result)
+	}
+
+	page, nextCursor := pageByCursor(result.resources, cursor, int(s.req.GetLimit()))
+	items := make([]interface{}, 0, len(page))
+	for _, res := range page {
+		items = append(items, s.buildItem(res))
+	}
+	return map[string]interface{}{
+		"items":        items,
+		"total":        len(result.resources),
+		"nextCursor":   nextCursor,
+		"aggregations": result.aggregations,
+		"perms":        map[string]interface{}{"applyURL": result.applyURL},
+	}, nil
+}
+
+// list 拉取有权限的集群及命名空间下的资源，过滤排序后计算聚合结果
+func (s *ResourceSearch) list(ctx context.Context) (*searchResult, error) {
+	nsScope := apiextensions.ClusterScoped
+	if s.req.GetNamespaced() {
+		nsScope = apiextensions.NamespaceScoped
+	}
+	clusterNS := filterClusteredNamespace(s.req.GetClusterNamespaces(), string(nsScope))
+	gvr := &schema.GroupVersionResource{
+		Group: s.req.GetGroup(), Version: s.req.GetVersion(), Resource: s.req.GetResource(),
+	}
+	// 共享集群需要根据 kind 检查资源类型是否允许访问
+	kind, err := resolveSearchKind(ctx, clusterNS, *gvr)
+	if err != nil {
+		return nil, err
+	}
+	clusterNS, applyURL, err := checkMultiClusterAccess(ctx, kind, clusterNS)
+	if err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "search multi cluster resources, gvr: %s, clusterdNamespaces: %v", gvr, clusterNS)
+	resources, err := listResourcePreferred(ctx, clusterNS, gvr, metav1.ListOptions{
+		LabelSelector: s.req.GetLabelSelector()})
+	if err != nil {
+		return nil, err
+	}
+
+	resources = s.filter(resources)
+	sortResourcesBySearchKey(resources)
+	return &searchResult{resources: resources, aggregations: s.aggregate(resources), applyURL: applyURL}, nil
+}
+
+// resolveSearchKind 从集群中获取 GroupVersionResource 对应的 Kind，以第一个能获取到的集群为准
+func resolveSearchKind(ctx context.Context, clusters []*clusterRes.ClusterNamespaces,
+	gvr schema.GroupVersionResource) (string, error) {
+	for _, c := range clusters {
+		kind, err := res.GetResKind(ctx, res.NewClusterConf(c.GetClusterID()), gvr)
+		if err != nil {
+			log.Warn(ctx, "get kind of %s in cluster %s failed, %v", gvr, c.GetClusterID(), err)
+			continue
+		}
+		return kind, nil
+	}
+	return "", errorx.New(errcode.ValidateErr, "resource %s not found in clusters", gvr.String())
+}
+
+// searchCacheKey 搜索结果缓存 key，由用户名及去除分页参数后的搜索条件组成
+func searchCacheKey(ctx context.Context, req *clusterRes.SearchMultiClusterResourcesReq) string {
+	r := proto.Clone(req).(*clusterRes.SearchMultiClusterResourcesReq)
+	r.Limit, r.Cursor = 0, ""
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(r)
+	username, _ := ctx.Value(ctxkey.UsernameKey).(string)
+	return username + "/" + hash.MD5Digest(string(data))
+}
+
+// filter 按字段选择器过滤资源
+func (s *ResourceSearch) filter(resources []*storage.Resource) []*storage.Resource {
+	if len(s.fields) == 0 {
+		return resources
+	}
+	result := []*storage.Resource{}
+	for _, res := range resources {
+		if matchFields(withClusterID(res), s.fields) {
+			result = append(result, res)
+		}
+	}
+	return result
+}
+
+// buildItem 生成单个资源的返回数据，未指定投影时返回完整资源
+func (s *ResourceSearch) buildItem(res *storage.Resource) map[string]interface{} {
+	item := map[string]interface{}{
+		"clusterID":         res.ClusterID,
+		"namespace":         mapx.GetStr(res.Data, "metadata.namespace"),
+		"name":              mapx.GetStr(res.Data, "metadata.name"),
+		"kind":              mapx.GetStr(res.Data, "kind"),
+		"apiVersion":        mapx.GetStr(res.Data, "apiVersion"),
+		"creationTimestamp": mapx.GetStr(res.Data, "metadata.creationTimestamp"),
+	}
+	if len(s.projections) == 0 {
+		item["manifest"] = res.Data
+		return item
+	}
+	obj := withClusterID(res)
+	fields := map[string]interface{}{}
+	for _, p := range s.projections {
+		values := findJSONPathValues(p.path, obj)
+		switch len(values) {
+		case 0:
+			fields[p.name] = nil
+		case 1:
+			fields[p.name] = values[0]
+		default:
+			fields[p.name] = values
+		}
+	}
+	item["fields"] = fields
+	return item
+}
+
+// aggregate 对过滤后的全量资源做聚合，分组时以 groupBy 结果作为 key
+func (s *ResourceSearch) aggregate(resources []*storage.Resource) map[string]interface{} {
+	result := map[string]interface{}{}
+	for _, agg := range s.aggregations {
+		groupBy, grouped := s.groupBy[agg.GetName()]
+		if !grouped {
+			result[agg.GetName()] = aggregateResources(agg.GetType(), resources)
+			continue
+		}
+		groups := map[string][]*storage.Resource{}
+		for _, res := range resources {
+			key := groupKey(groupBy, withClusterID(res))
+			groups[key] = append(groups[key], res)
+		}
+		groupResult := map[string]interface{}{}
+		for key, items := range groups {
+			groupResult[key] = aggregateResources(agg.GetType(), items)
+		}
+		result[agg.GetName()] = groupResult
+	}
+	return result
+}
+
+// aggregateResources 按聚合类型计算结果
+func aggregateResources(aggType string, resources []*storage.Resource) interface{} {
+	if aggType == AggregationSumRequests {
+		return sumRequests(resources)
+	}
+	return len(resources)
+}
+
+// sumRequests 累加容器 resources.requests，工作负载会乘以 spec.replicas
+func sumRequests(resources []*storage.Resource) map[string]interface{} {
+	total := map[string]resource.Quantity{}
+	for _, res := range resources {
+		replicas := int64(1)
+		if _, err := mapx.GetItems(res.Data, "spec.replicas"); err == nil {
+			replicas = mapx.GetInt64(res.Data, "spec.replicas")
+		}
+		for _, paths := range podSpecPaths {
+			containers := mapx.GetList(res.Data, append(append([]string{}, paths...), "containers"))
+			for _, c := range containers {
+				container, ok := c.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				for name, val := range mapx.GetMap(container, "resources.requests") {
+					q, err := resource.ParseQuantity(fmt.Sprint(val))
+					if err != nil {
+						continue
+					}
+					sum := total[name]
+					sum.Add(*resource.NewMilliQuantity(q.MilliValue()*replicas, q.Format))
+					total[name] = sum
+				}
+			}
+		}
+	}
+	result := map[string]interface{}{}
+	for name, q := range total {
+		result[name] = q.String()
+	}
+	return result
+}
+
+// groupKey 计算分组 key，多个结果以逗号拼接
+func groupKey(path *jsonpath.JSONPath, obj map[string]interface{}) string {
+	values := findJSONPathValues(path, obj)
+	keys := make([]string, 0, len(values))
+	for _, v := range values {
+		keys = append(keys, fmt.Sprint(v))
+	}
+	return strings.Join(keys, ",")
+}
+
+// parseFieldSelector 解析字段选择器，格式为 path=value,path==value,path!=value，path 为 JSONPath
+func parseFieldSelector(selector string) ([]fieldRequirement, error) {
+	reqs := []fieldRequirement{}
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		var path, value string
+		notEqual := false
+		if idx := strings.Index(term, "!="); idx > 0 {
+			path, value, notEqual = term[:idx], term[idx+2:], true
+		} else if idx = strings.Index(term, "=="); idx > 0 {
+			path, value = term[:idx], term[idx+2:]
+		} else if idx = strings.Index(term, "="); idx > 0 {
+			path, value = term[:idx], term[idx+1:]
+		} else {
+			return nil, errorx.New(errcode.ValidateErr, "invalid field selector: %s", term)
+		}
+		jp, err := parseJSONPath(path, path)
+		if err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, fieldRequirement{path: jp, notEqual: notEqual, value: strings.TrimSpace(value)})
+	}
+	return reqs, nil
+}
+
+// parseJSONPath 解析 JSONPath，兼容 spec.replicas，.spec.replicas 及 {.spec.replicas} 写法
+func parseJSONPath(name, expr string) (*jsonpath.JSONPath, error) {
+	expr = strings.TrimSpace(expr)
+	if !strings.HasPrefix(expr, "{") {
+		if !strings.HasPrefix(expr, ".") {
+			expr = "." + expr
+		}
+		expr = "{" + expr + "}"
+	}
+	jp := jsonpath.New(name).AllowMissingKeys(true)
+	if err := jp.Parse(expr); err != nil {
+		return nil, errorx.New(errcode.ValidateErr, "invalid jsonpath %s: %v", expr, err)
+	}
+	return jp, nil
+}
+
+// matchFields 判断资源是否满足全部字段条件，= 为任一结果相等，!= 为所有结果均不相等
+func matchFields(obj map[string]interface{}, reqs []fieldRequirement) bool {
+	for _, r := range reqs {
+		matched := false
+		for _, v := range findJSONPathValues(r.path, obj) {
+			if fmt.Sprint(v) == r.value {
+				matched = true
+				break
+			}
+		}
+		if matched == r.notEqual {
+			return false
+		}
+	}
+	return true
+}
+
+// findJSONPathValues 执行 JSONPath，返回展开后的结果
+func findJSONPathValues(path *jsonpath.JSONPath, obj map[string]interface{}) []interface{} {
+	results, err := path.FindResults(obj)
+	if err != nil {
+		return nil
+	}
+	values := []interface{}{}
+	for _, result := range results {
+		for _, v := range result {
+			if !v.IsValid() || (v.Kind() == reflect.Interface && v.IsNil()) {
+				continue
+			}
+			values = append(values, v.Interface())
+		}
+	}
+	return values
+}
+
+// withClusterID 返回注入了 clusterID 字段的资源浅拷贝
+func withClusterID(res *storage.Resource) map[string]interface{} {
+	obj := make(map[string]interface{}, len(res.Data)+1)
+	for k, v := range res.Data {
+		obj[k] = v
+	}
+	obj[searchClusterIDKey] = res.ClusterID
+	return obj
+}
+
+// searchKey 资源排序及游标使用的唯一 key
+func searchKey(res *storage.Resource) string {
+	return strings.Join([]string{
+		res.ClusterID, mapx.GetStr(res.Data, "metadata.namespace"), mapx.GetStr(res.Data, "metadata.name"),
+	}, "/")
+}
+
+// sortResourcesBySearchKey 按集群，命名空间，名称排序，保证分页稳定
+func sortResourcesBySearchKey(resources []*storage.Resource) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		return searchKey(resources[i]) < searchKey(resources[j])
+	})
+}
+
+// pageByCursor 返回游标之后的一页数据及下一页游标，没有更多数据时游标为空
+func pageByCursor(resources []*storage.Resource, cursor string, limit int) ([]*storage.Resource, string) {
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	start := 0
+	if cursor != "" {
+		start = sort.Search(len(resources), func(i int) bool {
+			return searchKey(resources[i]) > cursor
+		})
+	}
+	end := start + limit
+	if end >= len(resources) {
+		return resources[start:], ""
+	}
+	return resources[start:end], encodeCursor(searchKey(resources[end-1]))
+}
+
+// encodeCursor 编码游标
+func encodeCursor(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// decodeCursor 解码游标
+func decodeCursor(cursor string) (string, error) {
+	if cursor == "" {
+		return "", nil
+	}
+	key, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", err
+	}
+	if bytes.Count(key, []byte("/")) != 2 {
+		return "", fmt.Errorf("invalid cursor key: %s", key)
+	}
+	return string(key), nil
+}
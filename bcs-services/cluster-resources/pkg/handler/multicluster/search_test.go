@@ -0,0 +1,196 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package multicluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/ctxkey"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/storage"
+	clusterRes "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/proto/cluster-resources"
+)
+
+func newSearchResource(clusterID, namespace, name string, replicas int64, cpu, mem string) *storage.Resource {
+	return &storage.Resource{
+		ClusterID: clusterID,
+		Data: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"namespace": namespace, "name": name},
+			"spec": map[string]interface{}{
+				"replicas": replicas,
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":      "main",
+								"image":     "nginx:" + name,
+								"resources": map[string]interface{}{"requests": map[string]interface{}{"cpu": cpu, "memory": mem}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestParseFieldSelector(t *testing.T) {
+	reqs, err := parseFieldSelector("spec.replicas=2, {.metadata.name}!=a,.clusterID==BCS-K8S-00001")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(reqs))
+	assert.False(t, reqs[0].notEqual)
+	assert.True(t, reqs[1].notEqual)
+	assert.Equal(t, "BCS-K8S-00001", reqs[2].value)
+
+	_, err = parseFieldSelector("spec.replicas")
+	assert.NotNil(t, err)
+	_, err = parseFieldSelector("{.spec.replicas=1")
+	assert.NotNil(t, err)
+}
+
+func TestMatchFields(t *testing.T) {
+	res := newSearchResource("BCS-K8S-00001", "default", "a", 2, "100m", "128Mi")
+	obj := withClusterID(res)
+
+	reqs, _ := parseFieldSelector("spec.replicas=2,clusterID=BCS-K8S-00001")
+	assert.True(t, matchFields(obj, reqs))
+	reqs, _ = parseFieldSelector("spec.template.spec.containers[*].image=nginx:a")
+	assert.True(t, matchFields(obj, reqs))
+	reqs, _ = parseFieldSelector("spec.template.spec.containers[*].image!=nginx:a")
+	assert.False(t, matchFields(obj, reqs))
+	// 缺失的字段视为不相等
+	reqs, _ = parseFieldSelector("status.phase=Running")
+	assert.False(t, matchFields(obj, reqs))
+	reqs, _ = parseFieldSelector("status.phase!=Running")
+	assert.True(t, matchFields(obj, reqs))
+}
+
+func TestPageByCursor(t *testing.T) {
+	resources := []*storage.Resource{
+		newSearchResource("c2", "default", "a", 1, "1", "1Gi"),
+		newSearchResource("c1", "ns2", "a", 1, "1", "1Gi"),
+		newSearchResource("c1", "default", "b", 1, "1", "1Gi"),
+		newSearchResource("c1", "default", "a", 1, "1", "1Gi"),
+	}
+	sortResourcesBySearchKey(resources)
+	assert.Equal(t, "c1/default/a", searchKey(resources[0]))
+	assert.Equal(t, "c2/default/a", searchKey(resources[3]))
+
+	page, next := pageByCursor(resources, "", 3)
+	assert.Equal(t, 3, len(page))
+	assert.NotEmpty(t, next)
+
+	cursor, err := decodeCursor(next)
+	assert.Nil(t, err)
+	assert.Equal(t, "c1/ns2/a", cursor)
+	page, next = pageByCursor(resources, cursor, 3)
+	assert.Equal(t, 1, len(page))
+	assert.Equal(t, "c2", page[0].ClusterID)
+	assert.Empty(t, next)
+
+	_, err = decodeCursor("!invalid")
+	assert.NotNil(t, err)
+}
+
+func TestSearchCacheKey(t *testing.T) {
+	ctx := context.WithValue(context.TODO(), ctxkey.UsernameKey, "admin")
+	req := &clusterRes.SearchMultiClusterResourcesReq{
+		ProjectCode: "project", Group: "apps", Version: "v1", Resource: "deployments", Limit: 10,
+	}
+	key := searchCacheKey(ctx, req)
+
+	// 分页参数不影响缓存 key
+	page := &clusterRes.SearchMultiClusterResourcesReq{
+		ProjectCode: "project", Group: "apps", Version: "v1", Resource: "deployments", Limit: 20, Cursor: "xxx",
+	}
+	assert.Equal(t, key, searchCacheKey(ctx, page))
+	// 搜索条件及用户不同时缓存 key 不同
+	other := &clusterRes.SearchMultiClusterResourcesReq{
+		ProjectCode: "project", Group: "apps", Version: "v1", Resource: "statefulsets", Limit: 10,
+	}
+	assert.NotEqual(t, key, searchCacheKey(ctx, other))
+	assert.NotEqual(t, key, searchCacheKey(context.WithValue(context.TODO(), ctxkey.UsernameKey, "user"), req))
+}
+
+func TestSearchPageFromCache(t *testing.T) {
+	ctx := context.WithValue(context.TODO(), ctxkey.UsernameKey, "admin")
+	resources := []*storage.Resource{
+		newSearchResource("c1", "default", "a", 1, "1", "1Gi"),
+		newSearchResource("c1", "default", "b", 1, "1", "1Gi"),
+		newSearchResource("c2", "default", "a", 1, "1", "1Gi"),
+	}
+	req := &clusterRes.SearchMultiClusterResourcesReq{
+		ProjectCode: "project", Group: "apps", Version: "v1", Resource: "deployments", Limit: 2,
+		Cursor: encodeCursor("c1/default/a"),
+	}
+	searchCache.SetDefault(searchCacheKey(ctx, req), &searchResult{
+		resources: resources, aggregations: map[string]interface{}{"count": 3},
+	})
+	defer searchCache.Flush()
+
+	// 翻页请求直接使用缓存结果，不再拉取集群资源
+	search, err := NewResourceSearch(req)
+	assert.Nil(t, err)
+	data, err := search.Search(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, data["total"])
+	assert.Equal(t, "", data["nextCursor"])
+	assert.Equal(t, 2, len(data["items"].([]interface{})))
+	assert.Equal(t, map[string]interface{}{"count": 3}, data["aggregations"])
+}
+
+func TestSearchAggregate(t *testing.T) {
+	req := &clusterRes.SearchMultiClusterResourcesReq{
+		Aggregations: []*clusterRes.SearchAggregation{
+			{Name: "total", Type: AggregationCount},
+			{Name: "byCluster", Type: AggregationCount, GroupBy: "{.clusterID}"},
+			{Name: "requests", Type: AggregationSumRequests},
+			{Name: "requestsByNS", Type: AggregationSumRequests, GroupBy: "metadata.namespace"},
+		},
+	}
+	search, err := NewResourceSearch(req)
+	assert.Nil(t, err)
+	resources := []*storage.Resource{
+		newSearchResource("c1", "default", "a", 2, "100m", "128Mi"),
+		newSearchResource("c1", "ns2", "b", 1, "500m", "1Gi"),
+		newSearchResource("c2", "default", "c", 0, "1", "1Gi"),
+	}
+	result := search.aggregate(resources)
+	assert.Equal(t, 3, result["total"])
+	assert.Equal(t, map[string]interface{}{"c1": 2, "c2": 1}, result["byCluster"])
+	assert.Equal(t, map[string]interface{}{"cpu": "700m", "memory": "1280Mi"}, result["requests"])
+	assert.Equal(t, map[string]interface{}{
+		"default": map[string]interface{}{"cpu": "200m", "memory": "256Mi"},
+		"ns2":     map[string]interface{}{"cpu": "500m", "memory": "1Gi"},
+	}, result["requestsByNS"])
+}
+
+func TestSearchBuildItem(t *testing.T) {
+	search, err := NewResourceSearch(&clusterRes.SearchMultiClusterResourcesReq{
+		Projections: []*clusterRes.SearchProjection{
+			{Name: "replicas", JsonPath: "{.spec.replicas}"},
+			{Name: "images", JsonPath: "spec.template.spec.containers[*].image"},
+			{Name: "phase", JsonPath: ".status.phase"},
+		},
+	})
+	assert.Nil(t, err)
+	item := search.buildItem(newSearchResource("c1", "default", "a", 2, "100m", "128Mi"))
+	assert.Equal(t, "c1", item["clusterID"])
+	assert.Equal(t, "default", item["namespace"])
+	assert.Nil(t, item["manifest"])
+	assert.Equal(t, map[string]interface{}{"replicas": int64(2), "images": "nginx:a", "phase": nil}, item["fields"])
+}
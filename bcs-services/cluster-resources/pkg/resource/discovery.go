@@ -224,6 +224,23 @@ func (d *RedisCacheClient) getResWithGroupVersion(kind, groupVersion string) (sc
 	return filterResByKind(kind, d.clusterID, groupVersion, []*metav1.APIResourceList{all})
 }
 
+// getResKind 根据指定的 GroupVersionResource 获取资源 Kind
+func (d *RedisCacheClient) getResKind(gvr schema.GroupVersionResource) (string, error) {
+	all, err := d.ServerResourcesForGroupVersion(gvr.GroupVersion().String())
+	if err != nil {
+		return "", err
+	}
+	if all == nil {
+		all = &metav1.APIResourceList{}
+	}
+	for _, res := range all.APIResources {
+		if res.Name == gvr.Resource {
+			return res.Kind, nil
+		}
+	}
+	return "", errorx.New(errcode.General, fmt.Sprintf("resource %s not found in cluster %s", gvr, d.clusterID))
+}
+
 // getPreferredResource 获取指定资源当前集群 Preferred 版本
 func (d *RedisCacheClient) getPreferredResource(kind string) (schema.GroupVersionResource, error) {
 	all, err := d.ServerPreferredResources()
@@ -347,6 +364,21 @@ func GetGroupVersionResource(
 	return res, nil
 }
 
+// GetResKind 获取 GroupVersionResource 对应的资源 Kind
+// 包含刷新缓存逻辑，若首次从缓存中找不到对应资源，会刷新缓存再次查询，若还是找不到，则返回错误
+func GetResKind(ctx context.Context, conf *ClusterConf, gvr schema.GroupVersionResource) (string, error) {
+	cli, err := NewRedisCacheClient4Conf(ctx, conf)
+	if err != nil {
+		return "", err
+	}
+	kind, err := cli.getResKind(gvr)
+	if err != nil {
+		cli.Invalidate()
+		return cli.getResKind(gvr)
+	}
+	return kind, nil
+}
+
 // GetApiResources 根据配置，名称等信息，获取指定资源对应的 GetApiResources
 // 直接获取 preferred api version
 // 包含刷新缓存逻辑，若首次从缓存中找不到对应资源，会刷新缓存再次查询，若还是找不到，则返回错误
@@ -19,6 +19,7 @@ import (
 	"github.com/spf13/cast"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 const testClusterID = "BCS-K8S-T99999"
@@ -90,6 +91,15 @@ func getResByDiscovery(t *testing.T, rcc *RedisCacheClient) {
 	// v3 deployment (not exists)
 	_, err = rcc.getResWithGroupVersion(ResKindDeploy, "v3")
 	assert.NotNil(t, err)
+
+	// kind of apps/v1 deployments
+	kind, err := rcc.getResKind(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"})
+	assert.Nil(t, err)
+	assert.Equal(t, ResKindDeploy, kind)
+
+	// not exists resource
+	_, err = rcc.getResKind(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "notexists"})
+	assert.NotNil(t, err)
 }
 
 func TestRedisCacheClient(t *testing.T) {
@@ -5999,6 +5999,12 @@ func NewMultiClusterEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "MultiCluster.SearchMultiClusterResources",
+			Path:    []string{"/clusterresources/v1/projects/{projectCode}/multi_cluster_resources/search"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 		{
 			Name:    "MultiCluster.GetApiResourcesObject",
 			Path:    []string{"/clusterresources/v1/projects/{projectCode}/clusters/{clusterID}/res_object/{resName}"},
@@ -6034,6 +6040,7 @@ type MultiClusterService interface {
 	FetchMultiClusterCustomResources(ctx context.Context, in *FetchMultiClusterCustomResourcesReq, opts ...client.CallOption) (*CommonResp, error)
 	FetchMultiClusterCustomObject(ctx context.Context, in *FetchMultiClusterCustomObjectReq, opts ...client.CallOption) (*CommonResp, error)
 	MultiClusterResourceCount(ctx context.Context, in *MultiClusterResourceCountReq, opts ...client.CallOption) (*CommonResp, error)
+	SearchMultiClusterResources(ctx context.Context, in *SearchMultiClusterResourcesReq, opts ...client.CallOption) (*CommonResp, error)
 	GetApiResourcesObject(ctx context.Context, in *GetApiResourcesObjectReq, opts ...client.CallOption) (*CommonResp, error)
 	CreateApiResourcesObject(ctx context.Context, in *CreateApiResourcesObjectReq, opts ...client.CallOption) (*CommonResp, error)
 	UpdateApiResourcesObject(ctx context.Context, in *UpdateApiResourcesObjectReq, opts ...client.CallOption) (*CommonResp, error)
@@ -6102,6 +6109,16 @@ func (c *multiClusterService) MultiClusterResourceCount(ctx context.Context, in
 	return out, nil
 }
 
+func (c *multiClusterService) SearchMultiClusterResources(ctx context.Context, in *SearchMultiClusterResourcesReq, opts ...client.CallOption) (*CommonResp, error) {
+	req := c.c.NewRequest(c.name, "MultiCluster.SearchMultiClusterResources", in)
+	out := new(CommonResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *multiClusterService) GetApiResourcesObject(ctx context.Context, in *GetApiResourcesObjectReq, opts ...client.CallOption) (*CommonResp, error) {
 	req := c.c.NewRequest(c.name, "MultiCluster.GetApiResourcesObject", in)
 	out := new(CommonResp)
@@ -6150,6 +6167,7 @@ type MultiClusterHandler interface {
 	FetchMultiClusterCustomResources(context.Context, *FetchMultiClusterCustomResourcesReq, *CommonResp) error
 	FetchMultiClusterCustomObject(context.Context, *FetchMultiClusterCustomObjectReq, *CommonResp) error
 	MultiClusterResourceCount(context.Context, *MultiClusterResourceCountReq, *CommonResp) error
+	SearchMultiClusterResources(context.Context, *SearchMultiClusterResourcesReq, *CommonResp) error
 	GetApiResourcesObject(context.Context, *GetApiResourcesObjectReq, *CommonResp) error
 	CreateApiResourcesObject(context.Context, *CreateApiResourcesObjectReq, *CommonResp) error
 	UpdateApiResourcesObject(context.Context, *UpdateApiResourcesObjectReq, *CommonResp) error
@@ -6163,6 +6181,7 @@ func RegisterMultiClusterHandler(s server.Server, hdlr MultiClusterHandler, opts
 		FetchMultiClusterCustomResources(ctx context.Context, in *FetchMultiClusterCustomResourcesReq, out *CommonResp) error
 		FetchMultiClusterCustomObject(ctx context.Context, in *FetchMultiClusterCustomObjectReq, out *CommonResp) error
 		MultiClusterResourceCount(ctx context.Context, in *MultiClusterResourceCountReq, out *CommonResp) error
+		SearchMultiClusterResources(ctx context.Context, in *SearchMultiClusterResourcesReq, out *CommonResp) error
 		GetApiResourcesObject(ctx context.Context, in *GetApiResourcesObjectReq, out *CommonResp) error
 		CreateApiResourcesObject(ctx context.Context, in *CreateApiResourcesObjectReq, out *CommonResp) error
 		UpdateApiResourcesObject(ctx context.Context, in *UpdateApiResourcesObjectReq, out *CommonResp) error
@@ -6202,6 +6221,12 @@ func RegisterMultiClusterHandler(s server.Server, hdlr MultiClusterHandler, opts
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "MultiCluster.SearchMultiClusterResources",
+		Path:    []string{"/clusterresources/v1/projects/{projectCode}/multi_cluster_resources/search"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "MultiCluster.GetApiResourcesObject",
 		Path:    []string{"/clusterresources/v1/projects/{projectCode}/clusters/{clusterID}/res_object/{resName}"},
@@ -6253,6 +6278,10 @@ func (h *multiClusterHandler) MultiClusterResourceCount(ctx context.Context, in
 	return h.MultiClusterHandler.MultiClusterResourceCount(ctx, in, out)
 }
 
+func (h *multiClusterHandler) SearchMultiClusterResources(ctx context.Context, in *SearchMultiClusterResourcesReq, out *CommonResp) error {
+	return h.MultiClusterHandler.SearchMultiClusterResources(ctx, in, out)
+}
+
 func (h *multiClusterHandler) GetApiResourcesObject(ctx context.Context, in *GetApiResourcesObjectReq, out *CommonResp) error {
 	return h.MultiClusterHandler.GetApiResourcesObject(ctx, in, out)
 }
@@ -15273,6 +15273,529 @@ var _ interface {
 	ErrorName() string
 } = MultiClusterResourceCountReqValidationError{}
 
+// Validate checks the field values on SearchMultiClusterResourcesReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchMultiClusterResourcesReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMultiClusterResourcesReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SearchMultiClusterResourcesReqMultiError, or nil if none found.
+func (m *SearchMultiClusterResourcesReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMultiClusterResourcesReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
+		err := SearchMultiClusterResourcesReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetClusterNamespaces()) < 1 {
+		err := SearchMultiClusterResourcesReqValidationError{
+			field:  "ClusterNamespaces",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetClusterNamespaces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchMultiClusterResourcesReqValidationError{
+						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchMultiClusterResourcesReqValidationError{
+						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchMultiClusterResourcesReqValidationError{
+					field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Group
+
+	if utf8.RuneCountInString(m.GetVersion()) < 1 {
+		err := SearchMultiClusterResourcesReqValidationError{
+			field:  "Version",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResource()) < 1 {
+		err := SearchMultiClusterResourcesReqValidationError{
+			field:  "Resource",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Namespaced
+
+	// no validation rules for LabelSelector
+
+	// no validation rules for FieldSelector
+
+	for idx, item := range m.GetProjections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchMultiClusterResourcesReqValidationError{
+						field:  fmt.Sprintf("Projections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchMultiClusterResourcesReqValidationError{
+						field:  fmt.Sprintf("Projections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchMultiClusterResourcesReqValidationError{
+					field:  fmt.Sprintf("Projections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAggregations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchMultiClusterResourcesReqValidationError{
+						field:  fmt.Sprintf("Aggregations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchMultiClusterResourcesReqValidationError{
+						field:  fmt.Sprintf("Aggregations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchMultiClusterResourcesReqValidationError{
+					field:  fmt.Sprintf("Aggregations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetLimit() > 1000 {
+		err := SearchMultiClusterResourcesReqValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return SearchMultiClusterResourcesReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMultiClusterResourcesReqMultiError is an error wrapping multiple
+// validation errors returned by SearchMultiClusterResourcesReq.ValidateAll()
+// if the designated constraints aren't met.
+type SearchMultiClusterResourcesReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMultiClusterResourcesReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMultiClusterResourcesReqMultiError) AllErrors() []error { return m }
+
+// SearchMultiClusterResourcesReqValidationError is the validation error
+// returned by SearchMultiClusterResourcesReq.Validate if the designated
+// constraints aren't met.
+type SearchMultiClusterResourcesReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMultiClusterResourcesReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMultiClusterResourcesReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMultiClusterResourcesReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMultiClusterResourcesReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMultiClusterResourcesReqValidationError) ErrorName() string {
+	return "SearchMultiClusterResourcesReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchMultiClusterResourcesReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMultiClusterResourcesReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMultiClusterResourcesReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMultiClusterResourcesReqValidationError{}
+
+// Validate checks the field values on SearchProjection with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchProjection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchProjection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchProjectionMultiError, or nil if none found.
+func (m *SearchProjection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchProjection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := SearchProjectionValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetJsonPath()) < 1 {
+		err := SearchProjectionValidationError{
+			field:  "JsonPath",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchProjectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchProjectionMultiError is an error wrapping multiple validation errors
+// returned by SearchProjection.ValidateAll() if the designated constraints
+// aren't met.
+type SearchProjectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchProjectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchProjectionMultiError) AllErrors() []error { return m }
+
+// SearchProjectionValidationError is the validation error returned by
+// SearchProjection.Validate if the designated constraints aren't met.
+type SearchProjectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchProjectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchProjectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchProjectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchProjectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchProjectionValidationError) ErrorName() string { return "SearchProjectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchProjectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchProjection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchProjectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchProjectionValidationError{}
+
+// Validate checks the field values on SearchAggregation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchAggregation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchAggregation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchAggregationMultiError, or nil if none found.
+func (m *SearchAggregation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchAggregation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := SearchAggregationValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SearchAggregation_Type_InLookup[m.GetType()]; !ok {
+		err := SearchAggregationValidationError{
+			field:  "Type",
+			reason: "value must be in list [count sumRequests]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for GroupBy
+
+	if len(errors) > 0 {
+		return SearchAggregationMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchAggregationMultiError is an error wrapping multiple validation errors
+// returned by SearchAggregation.ValidateAll() if the designated constraints
+// aren't met.
+type SearchAggregationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchAggregationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchAggregationMultiError) AllErrors() []error { return m }
+
+// SearchAggregationValidationError is the validation error returned by
+// SearchAggregation.Validate if the designated constraints aren't met.
+type SearchAggregationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchAggregationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchAggregationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchAggregationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchAggregationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchAggregationValidationError) ErrorName() string {
+	return "SearchAggregationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchAggregationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchAggregation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchAggregationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchAggregationValidationError{}
+
+var _SearchAggregation_Type_InLookup = map[string]struct{}{
+	"count":       {},
+	"sumRequests": {},
+}
+
 // Validate checks the field values on GetApiResourcesObjectReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.